@@ -66,9 +66,13 @@ function initializeGraphs() {
 function getDottedKey(data, k) {
     var keys = k.split('.');
     for (var ii = 0; ii < keys.length; ii++) {
+        if (!data) {
+            // e.g. no cache layers
+            return 0;
+        }
         data = data[keys[ii]];
     }
-    return data
+    return data || 0;
 }
 
 initializeGraphs();
@@ -24,6 +24,7 @@ import (
 	"gnd.la/app/cookies"
 	"gnd.la/app/profile"
 	"gnd.la/blobstore"
+	"gnd.la/config"
 	"gnd.la/crypto/cryptoutil"
 	"gnd.la/crypto/hashutil"
 	"gnd.la/encoding/codec"
@@ -36,6 +37,7 @@ import (
 	"gnd.la/signal"
 	"gnd.la/template"
 	"gnd.la/template/assets"
+	"gnd.la/trace"
 	"gnd.la/util/stringutil"
 
 	"gopkgs.com/vfs.v1"
@@ -54,6 +56,10 @@ const (
 	// DID_PREPARE is emitted when App.Prepare ends without errors.
 	// The object is the App.
 	DID_PREPARE = "gnd.la/app.did-prepare"
+
+	// aeadKeyInfo is used when deriving the keys
+	// returned by App.AEADEncrypter.
+	aeadKeyInfo = "gnd.la/app.AEADEncrypter"
 )
 
 var (
@@ -193,8 +199,10 @@ type App struct {
 	hooks              []*template.Hook
 	started            time.Time
 	address            string
-	mu                 sync.Mutex
+	mu                 sync.RWMutex
 	c                  *Cache
+	cacheGeneration    uint64
+	defaultCache       *config.URL // cache URL from the global config, see reloadCacheURL
 	o                  *Orm
 	store              *blobstore.Blobstore
 	prepared           bool
@@ -1051,6 +1059,11 @@ func (app *App) logError(ctx *Context, err interface{}) {
 		}
 	}
 	ctx.Logger().Error(buf.String())
+	if _, ok := err.(*template.StreamError); ok {
+		// Part of the response was already sent and the template
+		// has written the error marker, don't send an error page.
+		return
+	}
 	if app.cfg.Debug {
 		app.errorPage(ctx, elapsed, skip, stackSkip, req, err)
 	} else {
@@ -1090,6 +1103,14 @@ func (app *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		profile.Begin()
 		defer profile.End(0)
 	}
+	if trace.Enabled() || r.Header.Get(trace.RequestIDHeader) != "" {
+		w.Header().Set(trace.RequestIDHeader, ctx.RequestID())
+	}
+	if trace.Enabled() {
+		defer app.endTrace(ctx, app.startTrace(ctx))
+	}
+	requestsInFlight.Inc()
+	defer app.endMetrics(ctx)
 	defer app.closeContext(ctx)
 	defer app.recover(ctx)
 	if app.runProcessors(ctx) {
@@ -1098,6 +1119,24 @@ func (app *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	app.serveOrNotFound(r.URL.Path, ctx)
 }
 
+// startTrace starts the root span for the request served by ctx,
+// continuing the trace propagated by the client, if any.
+func (app *App) startTrace(ctx *Context) *trace.Span {
+	sc := trace.Extract(ctx.R.Header)
+	sc.RequestID = ctx.RequestID()
+	ctx.span = trace.New("request", sc).
+		Set("method", ctx.R.Method).
+		Set("path", ctx.R.URL.Path)
+	return ctx.span
+}
+
+func (app *App) endTrace(ctx *Context, span *trace.Span) {
+	if ctx.handlerName != "" {
+		span.Set("handler", ctx.handlerName)
+	}
+	span.Set("status", ctx.statusCode).End()
+}
+
 func (app *App) serveOrNotFound(path string, ctx *Context) {
 	if !app.serve(path, ctx) {
 		// Not Found
@@ -1185,7 +1224,7 @@ func (app *App) CloseContext(ctx *Context) {
 		v(ctx)
 	}
 	ctx.Close()
-	if !ctx.background && app.Logger != nil && ctx.R != nil && ctx.R.URL.Path != devStatusPage && ctx.R.URL.Path != monitorAPIPage {
+	if !ctx.background && app.Logger != nil && ctx.R != nil && ctx.R.URL.Path != devStatusPage && ctx.R.URL.Path != monitorAPIPage && ctx.R.URL.Path != app.cfg.MetricsPath {
 		// Log at most with Warning level, to avoid potentially generating
 		// an email to the admin when running in production mode. If there
 		// was an error while processing this request, it has been already
@@ -1269,6 +1308,7 @@ func (app *App) importAssets(included *includedApp) error {
 // Signer returns a *cryptoutil.Signer using the given salt and
 // the App Hasher and Secret to sign values. If salt is smaller
 // than 16 bytes or the App has no Secret, an error is returned.
+// The Config.PreviousSecrets are used as the Signer's OldKeys.
 func (app *App) Signer(salt []byte) (*cryptoutil.Signer, error) {
 	if len(salt) < 16 {
 		return nil, fmt.Errorf("salt must be at least 16 bytes, it's %d", len(salt))
@@ -1278,15 +1318,17 @@ func (app *App) Signer(salt []byte) (*cryptoutil.Signer, error) {
 		return nil, errNoSecret
 	}
 	return &cryptoutil.Signer{
-		Hasher: app.Hasher,
-		Salt:   salt,
-		Key:    []byte(secret),
+		Hasher:  app.Hasher,
+		Salt:    salt,
+		Key:     []byte(secret),
+		OldKeys: byteSlices(app.cfg.PreviousSecrets),
 	}, nil
 }
 
 // Encrypter returns a *cryptoutil.Encrypter using the App
 // Cipherer and Key to encrypt values. If the App has no
-// Key, an error will be returned.
+// Key, an error will be returned. The Config.PreviousEncryptionKeys
+// are used as the Encrypter's OldKeys.
 func (app *App) Encrypter() (*cryptoutil.Encrypter, error) {
 	key := app.cfg.EncryptionKey
 	if key == "" {
@@ -1295,9 +1337,37 @@ func (app *App) Encrypter() (*cryptoutil.Encrypter, error) {
 	return &cryptoutil.Encrypter{
 		Cipherer: app.Cipherer,
 		Key:      []byte(key),
+		OldKeys:  byteSlices(app.cfg.PreviousEncryptionKeys),
 	}, nil
 }
 
+// AEADEncrypter returns a *cryptoutil.AEADEncrypter using the App
+// Cipherer to perform authenticated encryption. Its keys are derived
+// from the App Key and the given salt (see cryptoutil.Encrypter.Derive),
+// so they're never shared with App.Encrypter nor with AEADEncrypters
+// using a different salt. Values previously produced by App.EncryptSigner
+// with the same salt can also be decrypted by its DecryptString method.
+// If the App has no Key, an error will be returned.
+func (app *App) AEADEncrypter(salt []byte) (*cryptoutil.AEADEncrypter, error) {
+	encrypter, err := app.Encrypter()
+	if err != nil {
+		return nil, err
+	}
+	derived, err := encrypter.Derive(salt, aeadKeyInfo)
+	if err != nil {
+		return nil, err
+	}
+	ae := &cryptoutil.AEADEncrypter{
+		Cipherer: derived.Cipherer,
+		Key:      derived.Key,
+		OldKeys:  derived.OldKeys,
+	}
+	if signer, _ := app.Signer(salt); signer != nil {
+		ae.Legacy = &cryptoutil.EncryptSigner{Encrypter: encrypter, Signer: signer}
+	}
+	return ae, nil
+}
+
 // EncryptSigner returns a *cryptoutil.EncryptSigner composed by
 // App.Signer and App.Encrypter. See those methods for more details.
 func (app *App) EncryptSigner(salt []byte) (*cryptoutil.EncryptSigner, error) {
@@ -1449,11 +1519,14 @@ func (app *App) Transform(tr Transformer) {
 // used instead.
 func New() *App {
 	// Make a copy of the configuration
+	config.RLock()
 	cc := defaultConfig
+	config.RUnlock()
 	cfg := &cc
 	a := &App{
 		Logger:         log.Std,
 		cfg:            cfg,
+		defaultCache:   cfg.Cache,
 		appendSlash:    true,
 		templatesCache: make(map[string]*Template),
 	}
@@ -1472,6 +1545,9 @@ func New() *App {
 		})
 		a.Handle(monitorAPIPage, monitorAPIHandler)
 		a.Handle(monitorPage, monitorHandler)
+		if cfg.MetricsPath != "" {
+			a.Handle("^"+regexp.QuoteMeta(cfg.MetricsPath)+"$", metricsHandler)
+		}
 		a.addAssetsManager(internalAssetsManager, false)
 	}
 	return a
@@ -1482,3 +1558,14 @@ func wrap(f func(w http.ResponseWriter, r *http.Request)) Handler {
 		f(ctx, ctx.R)
 	}
 }
+
+func byteSlices(values []string) [][]byte {
+	if len(values) == 0 {
+		return nil
+	}
+	b := make([][]byte, len(values))
+	for ii, v := range values {
+		b[ii] = []byte(v)
+	}
+	return b
+}
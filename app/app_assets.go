@@ -4,4 +4,4 @@ import "gnd.la/internal/vfsutil"
 
 // AUTOMATICALLY GENERATED WITH gondola bake -dir=_assets -name=appAssets -o=app_assets.go -- DO NOT EDIT!
 
-var appAssets = vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xbd\xef\x9e\xdb6\xb2 :\x9f\xfd\x14\xb4r2C\xba%\xb5\xa4n\xff\xebv\xdb?\xc7vNr\xd7q\xb2v6;\x99v\x1f\xff(\x91R\xb3\x9b\x12e\x92\xea\x96<\xf1\xf9r\x9fi\xbf\xddO\xfb\x00\xf7\xbeҭ?\x00\b\x80 \xa5\xb6\xe3\xcc\xcc\xee\xce9q\x8b@\xa1P(\x14\n\x05\xa0P\x18\x87E\xdc?/\xe7韾\xdc\xff\x06\xc3\x01\xfe\xefO\x83\x81\xf3\xef\xdd\xc3\xc3\xc3?\rG\a\xf7\xee\x8e\x0eFÃ!\xc0\x0f\a\a\x87\u007f\xf2\x06\u007f\xfa\x03\xfe\xb7*\xca0\aR>\xbb\x91V\xe3\xfeE\xfe\xf7\xf7\xbf\xef߹\xe5y\xc9b\x92\xae\xa2\xf8ț\xaf\xd6\xfd\x8b\xe2֝\xfd\x8f\x1fo=\xba\xfd\xfc\xc7g?\xff\xfa\xd3\v\x0f\x05\xe4\xf1\xadG\xf2O\x1cF\xf0\xa7L\xca4~\xfc\xf7\xbf{e<_\xa6a\x19{\x1dJ\xeax\xfd\x8f\x1f\x1f\xeds\xf6\xadGE\xb9Ic\xaf\xdc,\xe3\x93N\x19\xaf\xcb\xfdIQt\x1e\xdfڿ\x03X\xcb\xe5\xd1\xfe\xfe<\xde\xc4\xf9u<\xeeO\xb2\xf9~\x9c'\x93\xfd2\xcb\xd2\x02\xe1\xf6\xf3\xb8\x88\xcb} л\x1a\xf5\a\xdeo\xde\b\x85c8\xba\x87I/\x93I\xbc(\x80\xe8E\xb6\x88=\u007f\xb9\x1a\xa7\xc9ċ\xb2y\x98,\x02h\u00ad[Hp\xd7\x1bgѦ\xebE\xc9U\xd7+\x96\xe1\xa2\xeb\x85\xcbe\x1a\x97]/\x1b_\xc4\x13\xf8\x9bL\xf3p\x1ewo\x9d\x0f\xbb\xde\xf9\b\xfe;\x80\xff\x0eΏ\xf0\u07fd\xae\xb7\x04\x1ci6\xb9|\xbf\xca\xca\x18>s\x80\r\x01\xcdx\x9cÿ\x93<[l\xe6\xf0#\x8a\x80\xdc\x02`\x93Yכ$\b:\xc9\"\x80\x8db\xa0\"\x9aB\xcd1\xc0%s\xc8N\x16\x00x9\x8e\xba\xde{\xa0\n\xfe?\x9c/\xbb\xb7\x8ay\x98\x02hQ\xe6\xc9eL\u007f\xb3\x05\x00\x17\xab1\xfe\x03d\x94@\xedU\x98woA\xc2\n\xb0@\r\xf1\xa2\x8c!!\xc2* 7\x02\x94\x19\xfc^\xc1\u007fiҽ5M\xe24*\xb0\xb5\xd3,\x87\xda\xd3p\x8cԤ\xf1,^D\xdd[e8N\x91\xcepY&\x19\x10X2\xb3\xcai\x96A\x91\x12\xbb\x1a\xfe\xe4\xf8\x13\xfe\x83\x02a^&\x13,\x12\x16ID%\x17W!4 \x8a\xcb0I\vl\xe28\x8e\xb0\xde\xd9\n\xf8\xe4\xc1_\x85\x1c\x91\x02\xad\x1eb\xa5\xbf\xb3<\x83Vݚ\xc7\vh\xcc\"\x84\x0e\xcaV\xe5r\x055\xe7\xab1\x90Q@\xf7P\xc9b5\x9f\x87\xf9\x06\xe8M\xa0\xa3<\xf8}\t\x14\xac\xa2$\x03~\x00\x1d\x99\xf7w\x90\bH\x9e%\x8b#op\f\x1fK\xe8\x8fd1\x13_\xe3,\x87*\xc5\xc74[\x94\xbd\"\xf9\x00\xa2\x03\xaa\xe9k\x99t\x04\xbdr\x0e\x02Xb\xc2U\x8c\r\r\xd3^\x98&3\xc0\x89\x8a2M\x16\xf1\xf1\xad\x8f(\xbb\xdf\xfd\xfc\xc3˻ S\x05H\xfe\xa6\x97g \xe2$\xab\xc8e\xe0?\xd4\xe5\x8d\xf3캈\xf3\xc2\x03I\xb4\xb9\xa6\xb8e\xb0\x87Yv\xab\x81M\x9e\xc6&\xc1\x18j\xb5 \xe2\x88e\x14\xe9\xc3>\xa4,$\xb8w\x1e'\xb3sh\xdc\x10\xb3X4Df\x01\\\xc0\xd1\xc9#\x88Jjb\xfe\x9e\xa0\xe8\xa3pA\x1c\x8dchl\xac\x8f\x8d\xa3pJ\xc2\xf8^\xe5\xbd\xe7$B5\x01\x1e\xc7\xc8\xe6\xbf\xfc\xe5X\xff\x94\xa8I\x16\t\x92{\xab7\xc9\xd24\\\xe2\b\x97\xbf\xaa\xae\xec\xc1P\x9e\xc8\xfe\xa5.y\xb1\x88\xbcl*\xba\x01x\xae\xb8@\xdd=\r\xe7I\nL\xfa%Σp\x11\xdabpo\xb9&\xdc\xe1\xe4\x12\xb9\xbd\x88\x8e\xbc\xaf\x9e?\xc3\xffC\xec\xe7\xc3\nѵ`\xe7\x18z\xd9\xc2r0`,,\x87\xbdqV\x96\xd9\x1ce\f\x93\x01\xcbA\x85\x85\v\x8cDN?\xce\xf3\x8c\x99dP\xf0\xed\xb7\xcf\x06Ͼ!\x88\xeb0_@s]0\xdf>\xfd\x96x\xd0O\x16\xd3\xcc\x01\xf0\f\xff\x8f\x00P\x1d9\x00\x06\xdf>\xf8\xf6[\x05П\xaf\xd229Z\x94\xe7\xbd\xc9y\x92F~\x16EAk\xbd,\xa8\x04\xa2F\xddH\xf0B\xf4\x96\xe2\xc5r\xed\x15Y\x9aD:{\xfb\xc5j2\x01\xd5Y\xaf\xe4\xf9\xb7\xdf\x0e\x9e?@\x18P\xba\xbah\xe4a\x94\xac@,\xefr-\xf6\x18@\xe9J3\x18\xef_=\xfb\xf6\xf97/\x9eUC|\x01Z0L\xbd\xe1h\xb9\xde\x1f\xc1?\xde\x0f\xd9\"\x9cd]\xf8\x93\xa1H\xc5\xde\xedd\xbe\xcc\xf22\\\x94\xa6D\xf4$\xca\xd1\x14\xff\xcf\xcaL\xe6\xe1\fz\x14\xc4c|\x99\x94\xbd<^\xc6a\t\x9c\xe8\xe1\x18\f\xf3\xde\f)\x06q\xf7\xcb\f\x86\xf2W`\xf3x\xc0!\xf1\v\t\xe9J\xc4\xd6\xd7\xe1\xe1r\x1d4T6\xcf>\xfcQ5\x15\u007fPE\xd9\x1fS\xcf\x17\xa8\xa4\x9ap\xbc\xe1]]\x11\x80\xd4\xc7s\x9ey2\x98V\xa6iv}\x04\x93W\x99\xa1\\Ӕo\xeb\x85\xe1\xb0I\xacт\x92S\x12O\xfdF=R\xd3\f\xbd\n\xafB\x92,x\x02\xab\x06ǽ{\xf7\x8e\xcdy\x93\aZ\u007f\xb2\xcas\xc0^\x1f\x90\x0f\xbeA\xebV\xc7!\xd5K\xad\xa2\x9e\"\xfa:\x89\xca\xf3j\xae\x95\x13\x12\xf2q\v\xe3\x06^\x8f\x93\x98.\xb4\xb8t-\xa0k\x95\b~ǑN\x0f4\xa2@\x12\x97Y\u008c\x02\x93v\x9f&<0K\xf7\x85\x15\x8b\xb3\xc4\xe3[`\xc5\x02c\xc1\"\xf2\xd0\xee\xdd\xe7D\x80!\x83\xf7O\xff\v\xffo\xbc\x02\r\x0f\xcc\xff\x92k\xc0-\xeb\xbf\xd1\xe1݁\xbd\xfe\xbb?\x1c\xfe\x9f\xf5\xdf\x1f\xb8\xfec\xe1/\xd8\xcc%Y\xd0\x17\x85y\x9cfa\xd4\xe7\xd5T\xb1L\x16\fA\x8bD\x189Q<\x85\xe1\xaeV\u007f\x1f?~#\x84ʃ\xcc\xfe+XZAZ\xbf\xdf\xc7A&F\x18,ƼI\x1a\x16\xc5IG\x18\x0f\xc2\x04\x80\x95\xa1\xe7=:\x1f>n\xc0\x01crH \x88\x81\xc62b\x10\xfad\x80\xea\x0f\x86{\x91\xa0qLt\x83\x8e\xbf\x8a\x8f\t\xab(\x94D'\x1dl\xc3\"\xce;\x8f\x1f\xedC\x12\xe1\xe3\x1f\xf2\x0fi\xcfǯФ\xf5~>O\n\xd0Q\xb3\u0603\xbf\xa8\xb6\xe7!\xad\fҍ`\fh\x9dl\x01\x86\x83F\xa9\x87RU\x16@.c\xbae,\x93\x15\va\xa9L\xec(&y\xb2,\xf5E\xf2E\b\xab*J\x05\xdaa\xc5\xe7e˲\xf0N\x94U\x0f]5\x84U\xea\xfe>\x90\x17{\x8b\x15,\xbar\xb4~)\xcf+3/\xca\xc3k\x84\x8d\x173Խ\xa3\x81\x02\xe6$\x04\x8e\xc3\xc99\x95\xd0ut\x05\x87\x9dZ\x9e'\x93K@Y\x00\x88\xb4\xb9\x0e*\x18NB\\\xb0T\U00108a70\xf2\xcda\xa9C3D\x0e\xdfH)\xc1?\xa3O\x8ff\x12\xc4\xe8\xf9\x83~\u007f\x18 \xe2\xac\f\x91\xd3\x1a^L\xc1\x05N6\x9d\x829O\x93K\xcek\x1e\x89n\b\x13 N0\xd7I\x01\xeb\x8c\x1e~\x02f\xd0\xf3*\xb5\x9a\xa4\xfe\xf2\x15\fɿP\xb1\xaf\xf2\xd9\xd8\x03[\xfb\xab<\x9f\xcd\xc6\xf43\xcc\xf3p\x83m hli\xb1\x8c\xe3HV\xf4\x1a\t\x06\t@!\x8da\xd1\x12\xe14\x9c\xc3\xf2\xedȻ\xc7\x14?\xc5%\xce\f\xa6u\x04\xc2Y\x19\x84\x05\xb1\x9c\x87\x11N\xf5e\xbe\x8a\t\uefdf\xc7\xc0\xa6\x1c\xbb\afW\x94\xfaP\x00\xe1\xacx\x1d\xc2\bH\xdd\xe0\xab\"\xf6\xce\xc3<\x82U\x00̂\b\x17\xe7\xc4 l#\x8e#\x94;h\xa7\x10\xec\xbf(F>{\xf3\x86\x01\x10\v\xfc\x01\xc3\x01\u007fao\tX\xc0\xf0\xe1{ f\rB\x12?T\x05?\xf4\x12L\xf4|\x18\xdd!,\b\b\xc1h \xff\x87\xdd\x06\x96\x12Tyw\xf0\xb5\xa8.[\xaa\xb1\xa7\x86\x1e\x96Z\x86hK\x900NKQ\x02\v\xbc\x84\xcf\xd6\x12\x1f\x8fI\xf4a(\xcd`Iw\x02S\xfcd\x05\x8b\xe0\xb2\x0f\x9f/\xd2\x18\u007f~\xb3\xf9>\xf2U\xb3\x03. >\xa1\xc4\"\xbe\xf6\xde\xf0\x97\x8f#(\xe8c\x9e\xcf\x18\x01\x1a\xc6'\r2k\x80j\nO\f\xd1\xffU\xe7\xff\xe8\xa0\u007f5\xea_\x14\u007f\xfaG\xed\xff\xde\x1f\x1d\xc2\u007f\xd6\xfc?8<8\xf8?\xf3\xff\x1f\xf1?\u007f\xbaZ\x90Z\xf5y\x89/?\xbd\xe8\xe0\x1d\xe9\r\u007fRf9\xeeyfK\xdc\x11\x8b\v\x86C\x15\xb8\x11\xbf<\xda\xf4\xf2q\xe4]\xc6\x1b\x98\x05\x1c\xd0\xf8\xbf\x1fi\xbb\xb5\xcf\xe6\xc2O\f\xb2!\xfc}(Pf8\xfdu\x11EW+\xe4yWa\xba\x02\xddV\xe1<\x05\x90\xb3\xae\x06\x11\xc3\xfc\a\xfap\x8c;Y\xd30%\xcd\xcf\xff\xfbH+3\xfaE\u007f?z\x93\xb0\x84IϏ+\xc2L\x02@iT5qa,\xfa\xd1\xe2\r\xcd\x19ϲ\xe5\xc6_\x16\xf1*\xca\xe8[\xe2DN$\x80\xa8\a\x13\xc8\x02\x11V }\x9e}\xbbb\xd29\xf1Nϸ\x92\xeb\xf3$\x8d=\u007fo/\xf1\x1ey\x8b\x80\xf3\xfb\xcbUq\xae\xd7p\x9a\x9c\x89\x16\xe5q\xb9\xca\x17\fv\xdcD\xe0\x9b4\x99\xc4.\nE\xe9\xa7\\\x89l{\xbf@\xf8>\xda6F!\x17\xfe\x1f\xc2%\x8a\x8c\x9d\x9cD\xa0\x94\x13\xe8\xd6Ȫ*r!\x01\xeb\xa2\xf0-@Ls\xc2´X\x83\x854\x17,\xfd\xcer\xffʆ\x87F\xc2<\u007f坜\x9cx\x1dY\xa2\xe3=\x81\xa4#\xcf\x1a\nZ\xb9+!\b\xae\xba\xf2x\f3\xa5\x98R\xc0<\xceV`\x02\xe0fmy\x9e\xd9LpԀ\xb2B\x12\x0e\xb2\xc0e\xfax<\xb1\xf1%\"\xc0K\x93^\xa1DYu\xbd\xc8\x102\x05\xad\x103\xe5\x11cl!\x9a\rF\u007fmѷ\xf6nÜ\xb9JS\xef\xcf\u007f\xf6n'`U\xbc\xf2\xd7\xce\xee\xff\x90,_R\xad\xf5\x8e\x16\xe4\xb8J\x15\xcb4\x81Uz^/\x04=B\x15\xbbJ\xc9\xcd_\xbf\xb0J\x15\xfd2O\xe6~\xd0\xcfc\x98\xb8A\xd2\xf7\xdf\x16{\xfb\xb3\xae\xd7\xf1:N\xaa\xf3p1\x8b\xdf\xe1n\xc0,\xce߀\x9c\xc7\x15\vH}A'\f\x8d\xf1\xb8\xf6\xee@\xea\xd7\xde0\x80?w w`\f\xbfKW-\xb8\x0f\x82Z\xc65>d\u07bb\xf8\n7\x9b\xe4\xa7$B\x81r\xb6)&\x1f\x80:ܴ\x8fѪ\xee\x1a\x1a\xe6\x83\xd2+\xa9\x94\x12[\xa1$S\xcfO\x11\x14tH?\x83\x84T\b\x1a\x0e\xb8\x161\x93$V\xba\x90\xa9Q\x94\x90\x12\xeb\xaa\xefo6\xb4\x06bۋ\x15\x05\x17\xa5&A͐\xa5\xc6\xc1\x02\xcf\xddTQ\xb3\xb9\xa9\xd6\\F\x8av\x1f\x15\t\xa0\xf5\x92LlWm(<\xf2F\x81l\x00\ts\n\x15\xeb%R}bJ\x99*)\u007f\"Qk\x9f\xfa\xcd\x1a\xd2\x1f0\xf7\xabd\xb2\x95\u007f\x9c\x02ڠ\x0fK\x04\x98d|\xbbL\xe2\xed\x81\x14\x05\xf5\nD\xdb\xf2x\x9e]\xc5\xdc<s\xd2\x12\x14+&U\x98iz\xb0\xf0\x14\x82G\xfa$\x8a\xab&\t&Q\a[:ڐs\xea;\xa7\xa6\xc5\xed\xf4\xf2\xdd\x12\x16gI\x81=\xba\x06[\xc1\x1a\xa6K\xaf\x87\x03\xe9\x897\x04\x16\xfc\x10\x96\xe7\xfdi\x9a\x81v\xa6\x9fi6\x83<\x91\xbe̮}\\\x816\x02\x06\xde>g\xbc|5\x1c\x04\x80w\x19XI\xb0j\vZ\b\xc5\x19\xe09\xafh\x1c\xdao\xcf\xebtZ\n\xd39\x9cO\xba՞\xea)\xb1\x0f\x06S\xf9\xbd\x10\x85N\xbf\x03b:\x85\xbc\xc4{|\xe2\rp\x92!\xa0b5\xc6\x03\xde\xc5\xccO\x90\\_+.\xc6p\aK\x96u\xe3\x00\x10y\xd0Ē\xfb\xbd\x86\xcd\xeb\x9dx\a(\x99{\xdeA`\x9a\t%\x88\xd7\x15H\f̠\xfd\x8b\fV@\x9dn'\x00\xb8isk\u007f\xca\xc1N[\xfb\x11 \xb4U\xa4\xd1W\xf4\x11\x8e\v\xff\x01t\a\x80ށʍ\xba\xa5\x18\x16\xa8o\x8f\x90\x1b\xde\x03`\x86\xd2\x01\x91>\x14\xd5|\xb0Ϻ\x95\x8d\xb6\xa3m\xd0w4hi\x19\x16\x9b\xf98\x83\xf5t\xd4<\r\xc6a\x11\xa3\x99;_\xfaӦ\xa9\xba\xacY\x03\xa5\xf7\x88\xfbs\x00\x84\x95عC\x12n\xa0\xd2/\x83\xe3-\xd5Ɏ\xb8A\x85C\xe0\xec\xd4\xc7\u007fwA?M\xc1ʾ\t\xfa\xfe]\xe0\x9f\x0f\xad\xc2_\xd01\xfe\b\xbeK\x94\xcd\x11U\x8c\xffr\xd2\xd6ڕ\xf9WږWc\x91e\x06sP\xbc;\xb5J\xf8\xc0؊\xb7\x12T$\x8b\x1a-\xc8H\xc22\xc9\nh\xf6\x1d\xfe\xf8\xe9{\x90\xb9QЈ)^/k\x98\x14-#\xd0Y\x03\xe6bOjy\xb7\xb0\xd1\xe6X3I\xc5\xfb\xbc\xe4\x9e&\x867S\x83\xaa&\x99\xf8\xa1\xa6ni\xeb\x83\x19\xd2<).a\xfc\xf6\x0fﶂ\r+\xa6\x87\x95E\x04#\n\xd72\xc0\xa5C\xc1u/\x86\xb5\x96J%\xb1\x11\xac\f\xe4\xcf\x10;`\b\xb9\xa1\xa9\x14Z\xa5}\x0f\xaa\xbdSgn\xafTh\x11+\xf1\xba\xc0\xa4\x91ч˭B\x81'Z\x951\x89L\xb8\r_؎a\xff\xfe`x\xf7\xc1\xf1Κ\xe0\x0e\xfd\xe7\xfb\x05\xcd\xee\xf4\x89Dm\xa5 \x83\x94\xba\x18\xe0\x18Df\x8d\xfa\xf7q$\xde\xef\u07fd7\xba+\xea(I\xd9@\x1f:\xf2\x91\x15H\xfc]\x91Ǆ\xecy\b&J\xa9\xbcz\xb9Q\u007fT+\xf8\xf0\x80\x8a\xd6 \xef9@\x1f\x1c\x02\xb0\xb3\xa9h5<\v\xa1\xa5\xa92e\xa3\x83>\x1b\x82E\x99-q\x13 \x9c\x85\xbc$:6\xf3\x979\xfd\x95\x93u\xd0X\xc1\x1bZ(\xf9\xfa(@\xf3S\"\xea\xca1!&Q\xecf\x986\xa9\xd0\v\x04\b\b\xbc0\xed\x9d\xc6ڞK\xe3^\xec!j\xb5J\x03\xaa\xb2}e\n\x9b\x8b\x03\xb6\xd5\xed\x01\xe7^\xffˢ\xa7\n\x1c\xec\xf63jW\xe3\"BpP|\xf6\xb3\xa9nj\x83\x99\xff\xa12\xf3?\xd4dY\x01\xc6C}\x8aշy\x14{\a\xc8á\xceD\x8d\xe1\xc7\xfa\xcḛ\xafvo\xf9\x87\x9e+K\x106#C6\x1e˃\xc5vV-U\x8c6Te>z\xd3dAg2\u007fo\xa8`\xa0\x01KS\xc1e\xea\xeafp}\x0f\"\\\x14h\x1e\xf9s\xbd\xe3s\xe4ǩ7\xef\x836\x9e\xf7\xc7\x1e\xac\x86\xf2\xa1H\x9a`R\x84I\x97k\xee=\x85\xe4\x15\xb9\xa2$\x1fb?\x1f\x80\xc1w\xf9\xc1\xca\u007f\x9e\x95\x90\x83\xb80w\xd3XZO}\x96\xcd\xc7\xc9\x020\xc2\xc2\x10\xcb\xf6.?\x80\x85\xfc\xdboޠ\xd2\xf8\xf9\xe0tp\x06\xa37\x1f\x9e\x0e\xcf@\xda\xe0/\u007f\x0f\xe0[\x93\v\x06\xc3E\xe6q\x954\xb4\x92\xa0U\xe6\xf7\a\xfd\x9b9\x8d+\xcc>\x1f\xf2@#\xfcK\\\v\xf0\xecP\x86\x8b\x91OX\xbb\\\x1fZ\x1dZV\x8fh\xeb2\xa9\x01\xaa\x1c\x83A\xf1,\x8f\xe5.\x1dUBY)׃\xdcǍ\x98\xfe\xd4;\xd3@\xc8\f\xa5\xec\xcb5\xb1\xd5ȼ\x84\x81{\x82\xa9\x06\x85\x97\x1f\x10\xb2\xa1zOx\x156\n\v\xf6#H\xc6\xd8R\xf6!s}\x8c\u007f`\xc6#\xce\xc2\xd7\xf0\xac\x15Y\xd5\xed\xa1\xd3,'\x03\xc2Q{(\x8d7\x94\x80Km\x82\xc7\xea\xf7O*\xfb\x99\b\xa9\x12>n\xdd\xee\xa8\xc9\x1e\xb6\x15\xf8%\xeb\xa0\x1a\xf6Np\xff\x84\x1a{|KU\xa3R\x87g殦\xab\x1er\xe2\x00k\x11z\x97\x97\xba\xbcV6\x99\xba\xa0\xbd\x87\x13\xaf\xa3\xa8\xc2\xdd=\xd0\x03Z\xe9\x9fe\x16\xf4\x9c\x99\xe3\xaav\xb5\xd0\x00^\xf1\xbe\x99ޛc\xe0\xfc\x18W\xb8h*\xedA\xa7<\xa1)\xdcǴ0\x90\xc2QW\xb3\xeb\x9a\x06\x86u0\x15\x01\x86\xb4\xd8\x0f\x06=\xcfh\xed\xf2e\xc8!q\x9a\x87k_.\xf2\xe6h\xcauu2[\x17\x04\xcf\xf04յ\a\x96\xcf\xc6~\xde\xf5f\xf5!!\xa6\xcd\xd7\x1a\x80\v\xf3\xeb:\x02V1\xd0\xe4\\\x1b\xcd3\xf8\x9ei\xdf\xc4\x1b\xe7\xbe\xe0l\xfc\xee<^\xd7\xf6\x8b\xaf\xd0\x1e\xbb\a,\xec\f:rKBp\xe4*\xe8\x97\xd9\x1b^y\x0f\xef)\xb5\x85<\x1aݽ[\xcbo\xaau\x19\xd2Z\x90Vܠ\xe6f0p\u038bԘ\\\xd8r\x98\xf1\x9f1\xff\x99C?\xccG]\x12wn\xe0\x1c'\x9c}\xff4\xec}8\xdb\v\xde\xfa~\xffN\xf06\xd8O\xfa\xf1:\x9e\x88\n4\r0\xd7&\xf9\xf9\b\xf7\x9e\x87\xa7\xa3\xb3>m\xd0\xd2怲\xf9\xaf\x13:0\x99\x0f\x8d\xc9\xc1\x9b\x80\x19\xebu\x80\xd6Α\x9aW\xf5\xa9Wp\x10\x00|j\xe3\xb7i\x16\x96\xfe|\x84:\xbe\xeb\x99I\x88x\x1f\xdd\xc3쌑\xcc\bꓷ\xa0\x00X\xd6N\x01J\x9b\xcel1\x84%%\xee\xacas\x16\xd0\xe4\xa0棦,\x91\xbf\xac\x87$\n\xfc*h\xf7RtD\xa0S\xb7\xa0\xed?\xee\xcc\xfeL\xfc\x1dk\x9dŅ\xf4\xbdyN\xe9O\xce\xc3\xfci\xe9\x0f\x02>\xd0\xf8\xaaSuPUN\xae\xeb\x10\xe4\xd0\xd8=A\xf3\xd0@4\xd4Z\x96\xa3vΫ\xefY\rz\x14\xe8\xb9{j\xacI5dB\x1f\x04z\xeeމTrj\x1d\xe9\xa6\xf8~\x03\xc5վ\x17\f\x85\x83\xc0Ig\x05s\xd0\xf5\xee\x06N\xea*\x18\x18\xb4\xf7k;\xafX#\t\xc0\xf7`hC\x1f\x89\xa1,+RY3#k\xacg\x8d\xb5,c6ͷ\xa89\xd2J0~,U\x87J\x01Ԍ\x9c\xf0Q\xe3\xe48a\x93ڙ\xa9_c\xf1+\xc0\xeb\x12k\x05\r\xdaK\xe2\xeb\xe2\xb1\ve\xf6\x10!h\x1e\xba\x8a\x82\xfb\xee>\xa6\xeea*\x0e\xc0Q%\x8a\xda\xf6\x1bm\x8c\xcbM#ܰ\xd3\v\x1dq\nn\x1d\xa9\n\x02}\x03>\xc7Y\x1a\xb2\x02\x0f\xd7K\xfe\f@\xc6XW\x04\x18\xe0\xeb\x11\xd0\xffĻ\x87\xf3Up\\\xc9\xc7\xcc,\x85\x93Z.K\x8d\x04\x1c\xe5\xe4\x903\x939\x87\xb2\xe2s\xb4M\xef\r\x8c\xed\v\xbd9Xt\xe0\xe8)\xe8\f\xec\b\xc1\x9f\xc6\xceJ\xc3\xfa\xc4V)\x81\xf5惟\v\x1e\xcc\xcc\xe4\x99H\x1e\x9b\xc9R\a`\x8f\x8b\xc5\x03$S5~\xffp8:\xbc{\xef\x10\xedv\\\x85\x1fܽ\u007f\xf7\xfe\xbd!|\xce\xf0s\xf8`\x80\xabr\x9a\xa4\x91\v\aX\xea\xdd_\xa1\xcf76\xa2\xd1pt\xef\xfe\xe8\xa1Dt\u007fxwxw4\x92\x88\x06\xf7G\xc3\x1a\x9e_\x01\xcf\a\x1b\xcf`\xf8\xf0\xe0\xe0@\xe1\x19\x0e\x1f\x0e\x1f*4\x0f\xef\x0e\x0e\x06\x87C\v\xcf\xdf\xccM!N\xf5\x870\xe5\xde\x01:{0n`\xdc\x0ehc\reh\x03Վ\xf8\x13s?\x04\x8d=\xc1\xbc6's5J\x02ܿ\xed\x0f\x0e\a\x87(\xb99\xce0\xa3>\x90zTm9\xf9Ԅ\x01\xc2B.\xfe\x80\x9a\xfb\x87\xedӸ\x98#&\xfa@\x9dJM\xc0s\xda\xc4l\xf0D*ǉTy\xb8{\xc8\xca\xfc\xeb\x8e\\\x83\x90ǜ?ō\xae>Rt\xe4\u07b6\xd7%\xd4iV}\xb7E\x84\xbf\xab# \xb3\tGŹ\xbeH\xaa\xb6K\xe8\x9b\x0e\xea\x1a(\"c\xcfB\xaa@\xae\xfcss\xc2:\xf7\x1e{\a\xf7\x068\xba\xf1,\x03Fj5\xf4qc\x92r\xf68\xc7(\xf6\xc8\xc3R\xa2\xb9s\xdcA\x84\xa9\x1a\xb5\x0e\xedɝC'\xd6K\xc0\x10\xa9\x8a\x8c\xec\xdc\xd1a\vB\x1fr\xe1\xe3<0\x10+h]\x83T\x8d5Z\xab\xdb\xd8ܿ\x94}\x87\xe4\xd3>jek\x8fS\xa93\xbc\xaf+\x164\xf2\x86\xba\t3\xc4IE\x01̕\a\x15\xa2\xfbR\xa1\xc2%H\xaa\x81\x88\xa3P2\x0eS\x1a18ZRl=\xb2\xa3\b\b\x1e~\x00#0\xb5\xd0\xecP\x1c\xf6)rkd\x8fo\x14\a\xe4\x04n\x97\x8ep׃\xd8\xc2\u007fP\xf8!\xcd)\x9b\xe7\x13\x92\xcdI\xb3pk\x00N\xe1\xae#p\n\xf7\x04\xbe';\t\xf7$%m\xe5\xa6J\xa8\xb2\xb4[\x9d4\xd0\xe4SmS\x93\xf8\xdd\xc1\xb2jG\x9b\x04`\xe2$_ \v\x1b\xd7L/5\x00\x17\x82\x97u\x04f\xfb\xc47.\x1b\xc3]\xd6L\xa8\xbf\xb17-\xa4(\xaf8\xc5\xf8(\x1b\xb80\x82\x96\xa2\x1a\xc7\xf9kC;\xfb\xfb\xa8\xd2y\xfe@->F\x03c dV\xccr\x88\x1bU\xf8Zl\xb9\xd0\xdc\xc5\x10\x1b\x13b\xa3A\xfc\xca\x10\x1fL\x88\x0f\x1a\xc4\xdf\\\xe2(&1\xfcy\xd0\x1f\xe1\xacp\x88\xf2\x8b\x13ΰ\u007f\xf7\xe0\xfe\xf0\xe0\xc1]1\x1f\xf5\x0f\x1f>\xb8{0\xc4I\xf7\x03/\x10d\xc9^\xff\u1f47\xa3{\xf7\xa8 4\xbc\xff\xe0\xfe\xbd\xc1p\xf0\x80\n\xe2Tr8\xbc{\xf7^\xbd\x1cN-\xf7\x0e\x0f\x0eE\x85\xfd\x11LK\xa3\xbb\x0fE1\x9cy\xee\x8fFwGT\xb0I0hp\xb8\x85C\x8c\x1cm+k\x8c[A\xf2\xc8\x1c\x04\xf1\x0e\nbW\xdb;£\x97\x10\xea\x1e\xd3\\\xdd4\x9c\xb4\x1e\xb2\xce\xd0\x1fc#\xee=xx0\x80V=\xf1\xd6\xd42\xfc\xef\x88'\xf1C\xec\xef\x87H\xc2\xfd\xfe\xfd\a\xf7\a\a\xf7]\xf8\xa5Y\xe1\xc4?\x18<xp\xf7\x9e\x9c\x1eq\xbe^wi\x97\xe3 \xa03\vF+\xba\x82\xebk\xaa\x83lpש\x1e+e\xd0ƨ\xf0r\xb6\x18ЄA\xedH\xd6\x02\x9a9Gl\x1b\xe8\x87UyW\x9c\xe0\x1c\xa2{\x02\x99\x10N\x06\x16q\xca\xfe\xec>y\x15\x14\xd5\xf9\x88p\xdd[\x8d\xd9\xff\x92\xb3\xbbF\x99\x9f\xa4ǞiN0hkm\xe2W\x96\xfb\xf2\xc7v\x17\xb5J\x96\xb8\x8c*\xda%\x15Ѷ\aT\xaf\xf7i\x9a~F\xd5z\xe9\x9b\xd4\x1e\x96e.\xbcb\f\xf7\r\x05\x8d\x00\xaf`q\xad\xd5\xcd[J\xe4\x95\xf3\x14r\x93\xf1\xaa4\xdcs>\xba1\xbcz\xb3\x05\a\x00\xd0\n\x9fn\x9b\x8a\xd5~\x9aM´\x19\xef\xb3lQ\xe0\x85T\x1bs\x11\x97&i\xb2y[1թ\xd4q5\x92\xb8\x15\xff\xb7n\x0fǵ\xf2qi\xf79Ccf-}\x01\x83\xb6.`ð\x89\v\xeb\xed\x14\x1a\x1c\xf8\x9dil\xe9\xe2\x06\xba[8n\xb6Em,\xf5\x17E\xff\xfd*L\x93\xe9F\x97J\xb9s\xc9.\xa5b\xc7艆\x10>*Q\x05\r&?\xf0\xa0X\xb8Ǌ\xb2\x96\x8bl\rE\xc5I\x81F&\xc0g\r\xb8\x12<\x01,\x13\xda\a.\xe9\xc08z\xed\xde\xec\a\xd3\xe7u<{\xb1^\xfa\x1d\xff\xc9\xd1\u007f\xfc\xf6\xf6m\xb1\x17\xe0N-\xb0'\x8f)$\x81(\xb7\xe7!\x04\xe6\xff\xf6oA\xa7\xebuf\x9d`\xa7\x9a[\xb5\x86\x80q\f\xcf\xca\x05\xbc\xc9\x1f\x13\xf1\x9e&gB\xccZ\x86\x95\xa8\xa4adI/\xd0]\xa5\xb7\x9d\f\xa7\xacQ_\nO[\xde &?۠?\x0f\x97\xbe\x8bg\xaf*qD\x12\x17\x12\x87~\xdemyd\xbbE\xcej\xbawds|\xa7.\xac\x9d\x15Ѷ\xba؞m\x145\xf71\xc9\x02\x03\xba\x98\xd2\xc0\x1a\x01\xd7\r\x98\xd9'4/\x93\xa2\f̱\b\xad\xe9\x87Q$\b\x81\x968=>\x914\x13\xd5+\x92\xbf\t\xda\xe1\x93>^\xcf\xfc\x05Ɣ\xd8\n\x86\xf4+L\x1f\x13v\x99y$\x170L\x99E,\xb6\xa9rT\xac6\xbc\x94+\fd\x97qQ\xfa\x93\xab 06\u0529.\xdd\x1f\x1b\x12\xf6\xd0\xd7\x1a\xfe5\x1a\xa1x\x02\xe6hE\x16\xd0y%ԟ\xd9:Ωo\xa9Wk\xffɕN\x89\x83\x0e\xe5\x01\x8e1G\xd0\xfb;0\x1b\xf5)\x94|\xdcɬ\xc1+\xa8\xba\x8e\xc0\xab2I\x96'妦-\b\xd6edP\x86\x10\au/\xa6\xd9\xd2 \xf0F\x93\x80p\xc1\xd4b \xaa\x13\u05cc\xf9\vL\xe1-\r\xd4\xe7\xc3\x06\xd2\xd7\rd7Lu\x8a\xcd[g4\xa3\xb9\xb8+\xa23\xb6\xbdߗ\x0e\xee\xd6:\\\x02Y}\x1e\x01\x96\x92\xdb|\x8a\xc5Ϝ}!\v7t4\x97\x94}Ҋ\xe1\xf7\xed\xd0:\xf5U\x17*\xa2\xd6;\xf4\x93Ν\xad]e\xb7\xc5;\xaa1\xa8\xbd\xc3\"X\xfe\xbe\x82\xc1\xee\xe3\x0fˎ\x90\\yGP\xef\xde\x1dy\xf8w\x97\xe1?MR\xbc\xce\xf2\xe9\v\x9a\x1f\xd0\xd5(.\x04\xf3\x15\x9eݖTY^>\xcb\xe6\xcb0\x0fqA7Q?\r\xcfF\xdb\xdd\f\x14\xa1\x02dC2,&\xf1\x02\xefڻ\xa7<}[\xa1ڹ\xae\xea\r\xf1@2\xecK\xe6\xe1\xc9\x13$\x8cU\xc2n\x8dA\x0f5\xba\xff'\xef)pP\xb2U^\x1fV\xd9\xe25Ϝ\xa6<_\xe7 \xc7t\xeb\xd7\x1eY\xcc\t\x91o8\xb7U\xb6;y\xb1\xbd\x14u\vRD\t\xf5\xa3\xffoڴ\xd20\x8aͣ`\x8d\xe6\xa70\xf7Wu\xab\f\x81\xda7fh\xba\xf0\xeft\xa9\xd3=ڪT\xe8\xe3\x02\xbdl\x84\xdd 9\u007f\xdc\xe8\xc5'\x99,\x86>[5\x88\xe5&.uY\xd31\xb80\xfb0\xd8\x14w\x06\xe3\xd6}\x1feQٗ|\xe9P[\xd0\xcb\xed\xc8(r\xf5\x8c\xa1l\xea݄\xf3\xb8\x10\x1ee\xf7\x8a\xbcwڽ\x1d{\x9f]ػ\x9dw\xefP\xe9\xecy\\\x17Z\xd8t;2\xd1nvT{\xef\xf2R\x06\xdf\x1d\xe5k\x94\xeaL\x19/\n\x99\x96\xa4\xac\x1bt\x1a\xc9\x04(2Ƀ\xf6\x01\x82\xc1\x1a\xd4f\x10r\v\xbd\x98\xd5ؐ\x17p/\x84\u007f\bz\xbe\x10\xac4\xba!\xe7\x917\x87?{{A\xfd\xde.\xc1\xaa2\xa7\x17g\x86\xc7*\xa5\xaa\xdb)د\xc7\x1e-\x1f\xe0\x8f\x8eN\xb8>p\xbfS!\xbc\xaf\xaa\x88\x15b\x96t\xbd\x8b\xc05Xn\xb0\x8bE\x91\x8f|\xf5ݸy\xa6 \xba\x8e\xf2\r\xbbh\n\xaa\xd1\xd9-\xd1\xf6\xed\xa0=\x18!1\xa9V\x17m;xUq\xbbr\ntp\x1dǋ¸:\xd7\xf5\xe2ʽV],\xecPx\x11\\Ƃ\xe2\xc6\xcbB\xe8T\xae\xf9\x87R\r/\xc2B\xa8\b!\t\t\x1e\xf7'\x91\x91\x86\x84\xa3\xd8&s\x13\x96H\xa9\xdfԣds\xe1\xd3z\xf7\x8e[T\xdd\xdb\xd3\xf5\xb1\xa8B\x19\x8a\fj,\x8a\x84e\xc19\xd5\xc56\xa6\xc2:v\xab\xc4Fy\xf4\x8a\xb6\b樦8Vo\x8eYRz\x82+.z\x92\xcb\xed\x96\n'\x93g!\xc2\v\r\x1b\x1d\xb4\\\xb1l#\x9d\xdc\xc9+'nc\x8a\xbcAG\x18\x92A\xba\x845\xae\x94MM\x12\xab\x9b\x9avu\xdbɆF\xa3(\xe5UX\x81\x98\x96hQ\xb3\x05T\xd3m\xd6Z\x9bԅ\xa6b\xb4\xc5\n\x8c\x81:~u_2\x9b\\\xf6\xc3\t\x85\x14y\fb\xafX\x81\x17\x0f|c\xad\xaa\x83V#D\xccB,|\xa0)_ \x91\x8a8\nSP[Z\x1bkne_\x13\xa7\xb95t\x9d\xce.!j\x89#\xedb\x9fA\xa0\xe6\xf5f\x045\xa8:\x8b\x98Q\xab\xc8^\x90\xdf.\x13PÒeA\xd5]\x98\xdeEuO\x8a\xec\xb8\xeeE7t8\xe0U]a\xa0m\xed\x89\xdb''\xed}Az\x10\xcf\x0f\x05:\xaf\x87\x96\x16F.\xd8\xf7\xa2\x15\a\xbd\xe9ҍ\r\x1c]~\x19\xf0\x14%\x19\xa8o1\x19\x1b^\v\x9e\xa6MƋR\xa7\xbd\xde\xe2Lg\x9eɂ\x8fV{\xf8ʟ\x8d\xab\xde\x14\xcf\x1cu\xdfG5ᒽ\aZ\xbc\xb5\xef\\\x98\x06&@\xbd\x9ft\xc2?\xba\x86O\xb6\xf4\xeb\x9du\xbbף\xfe\xa2`Jj\xb5'\xccJM\x85\xbc\xdbUH\xd4\xc4\x16G\xe2\xa68\xf5\xa7\xb4U飫\xbaV%\xab\xaeFjP\x1e\x84YA\xb9\xf8O`у\x97\x1c|\a\x9d\"h\x962\x95I\x0e1֔\xbe\xb3\x85\x8d\x854-n\b;\xc75\x98\xd3{{\x15\x8f\x8c\x15\x01\xb4\xeb\x11H\xa6\x10\xdd'\xb6\x8e:\xaaF\x1c\xe5\b^X\xc3N\x8e\xef\x8f\xf6\x88\xdcj Y*\u07b7\xcdD\xda0\x8elC\xe1\xfb\xa8ـ\xa8z\xcb\xc8z^\xeb53[\xa4\xcbI\xc1\x96]2\xed\xe5P\xa8\xd5)\x01h\x80\xabka\xf6t\xc1\xeb\x8a\xd6ɢN\xb2!u\xc7N\xb0\x06A\x94\xc0\x92\xa5\xd5xՅ\xb2kJ\x8ca\xec~\f\x9a\xd8\xd1Ɖ\xca\xfep\xb6FkHS3\xcc\x16l\x8b\xb2\x82#\x95\xf6\xab\"\"\xde\xde*\tq\xeb\xb9\xd3\xc1\x15\xbe\x84n^\xbcP\xb6\xb8\xab1\xe6C\xad\x9a\u007f\x02[\x9a\x98\xdd|\xc5\xc3yRC\xc3\xe8\x1dX&K\xf3\xea\x1f\x8f5\xec\b\xbc\xc9\xf3\x1cPA\xcf\\\xfbx\xa3~(\xe4\x94J\xbe_\xc5r\xdfLL\x11\xa5\xa6\xd3\xe5\x10>!<=(\xdb/\xcfc#\x84\x84\x04\x81\xc9\x00r\xc5$\x05\xbf\xa6)L\xe2\x1e%\xaa\x95\x8f\xd4\x00jm;d\x80E\xbc.\xedU\xa8>\xe0\x88RB\xe8\xa3;\x01Т\xb9\xe2\x12\xdccothځI\xf1m\xb2Hʘ\x01\fcc\x92\xc6a\xfe3 \xcdV|I\x88\U00017720\xefmXY\xe8I\x16\x97\xb5\x82\xc8|\xa1 j\x8e\xd3\n\x88\xfa\xf4\x8av\xddݎ\xb7.\xc8ᱝI!\xfaͪ\x83\xe6\xf0L&\xe3*\xe9(\a\x9e<9qH\x03\x05\xbc\xc7!\xf3\xfdb\x8a,\xdc4\x8a\a\x99\x01\xa2\xabu\x06\x8b\xe9R\xa1\x1do\xbe\x8fN5A\x00\xa5w\xa6\xedʐ\x14\xa0\xf3]9 Q\xa8\x84\x82\xa6\n\x9d6[^jl\xf4$\xf1\xca+\x1c\xbf\xbbRrq;CJ\xa9E\x81O\\\x81\xe6\x99\xe8\xeb+s9\x02lnϳU\x11\xff\x84Qv}\f\xea\x1e&\xb4}g\x1c\xb7\x15W\xe8\xf2\xacr\xfb\xd95\xfc\xfb\xe6\x97\u007f\x17a\xfcp\xf2V\x99\x95\x88C\xa9\xfe$\x8fa\f\x03(U`n\xf9Qd_\x14\xce\x1a\x9co,5%\x8d\xefƫ\xd9\xe1\xe1\xe0\xc1\x01\xf9<\x82\x0e\xf3\xaf\x93E\x94]\xf7\x8bI\x9e\xa5\xe9_\x91\f#\xe5Wc\xf8p#@g\t?\x18\x15\x8f\x10\xe3\x05\a\xb8\xd2\x03C\x0e\x16\xe7W\xb3N\xc0\xa7\x19~G\xc67\xc4\xc5z8.\xb2tU\xc6U.\xd8`\x901P\xdf\x18!\x91\x12\x8e\r\xfbiRι\x95\xa7\x833\xf8\u007f\\O\xbf\x81\xf6Ƌg?\xff\xe0\x9b\xe3\xd6j\xe7\x89wۇ\xd2\xfd)q\x18~\xe8\xa6-\xb2M\xac\xb7\x9d!gj\xe8tf\x10\xef\xfbk\xbaM\x8d\xd1Q\xffzlemT֯\x8d\"\xab#\x99\xa4\x18\u007f\xbb\x01\rg\xfejS)\x05\x80A\xe7a\x99'ku\xbd\xaf\x12F\x8ba\xfdd!\x82\xa1\xd8K\xdaSIQW\xd5~f\xebg\x8cCj\xc82 \xff\x06\xbdŒ\xc5\xec\x19\x91\xf9\x1a\x85ô\xd9N\xab\x06\xe2\x9d\x05\x8c\x83\x87}\r\xbf+<\x9c\x8fQ1\xbbU\x8b%4H\x8a\x03\x18cn:\xef\x8b.\xb2\xcc\x19\x9f\x8dn\xbf\xbe\xc0h\xbf(\xbe\xf4R\x8a>J\xd1(\xa5@\x9b\x98C7oq\x99P\xa5\xf0\x1f\xcdYݼ\xb7\xc9\xe5\x1fq\xa1'\xd0ha\xe5\xd2\xf7\x19\xe8\xb4S\xfa\xd9\x15\x80Nʉ\xc2\xd7\x18\xa8˧\x9fv\xcc/L\xebS /n\x06Z\xd9v\x9aϦ\xb6\xd1Z\r\xc8op\xc9C\x88w\v\f\xd6\xc4\xed\x04#\x02>\f\xe3y\xc0\xbb\xa2\xc9P\xf1DcF\xd7[\x0f*^%Ⱦ\xf5PK\xc0[\xceѺRo\x90\xf9\b\x8ah\x06+\x8e\x84\x04\xf1#\x9ed(+Z\x1f\xeb\x00끨h=\x94\x15\x18\xe7m\xb4\t\v\x84\xe3T\x87\x8dYc<\x11\xa8F\xabGQ(`D\xa0\xa5u\xa5x*\x9a%\xc8$NR\xc0\xe5:\x82e\xe0v\x9e\xaa@\x0eug\xcb\xe6\x82\x1c\x9c_u\a\xf5\x1e>\x9e\xa3\xccĮG\xe1{jgDyL\b\xac#\"F\xa7ϑF\x1f\x8a\n\xd4\x16 \xd8VtyX\x10\x83\x91j\x04\x86J\xbaލ\x13N\xebz\xc6\xcd\\\xd4\x10H\x19#\xa8_\xdae\x14\x8e\xcb\xc5\xead\x84^¡\xd3\n\x17\x0f\x8c\x92\x06K\xaa\x99oa \x10\x05%\x1e\v\x81y\x11\xda\u058b\xd4T\xb7ˀ\x19EO\x95`\xf2\xfdu\xed2\x83|ᇨ\x137\x15hh\x92R.\xf5\xdd\xceM\r+\x15\xf27\x81\xb1\xe9\xc8Ź]z\xf1\xf5\xae{\xbc\x95\xf8V\x92\x8fË\x1c\x9c\xb8Wl\x86(\xf9rPB\x8c\xfe$B\xa8\xa4\xaaI\xa0Yߴj\nX\xddP\u007f]\x8bB\xae\xde\xf3\xbe)\b\x84\xcbU\x13\v\xf7\xa74\x92J\xaa\xe5\xb4@s\x93F\x9a\xa3\xec\xe6\x04X\x17\xfey\xa8\xe8(oB\fn}\x16:\x19s\xe7V\xb7\xa6\xcd~\xc6\x12j8ϛ\x90~˗~o\x8a\x99\x8b\xb5\xa3\x17\x93\x83\xc3=\xc1=\vZռ\xc2I\xf1\x06,\x9adˍ\xbb:w3vP\xf7\xae\xc0-\x06\tm\xd3\xc9k\x8e\xd8JI]\xa1\x1d\xeb\xfb\x12\xb9\x03\nlx\xa2\xa9#\u007f\xd0\xf0\xc0/\x8dF\xfc$*;[)yE\\V\x82\x1b\xad\x9d\xc1\xf5\xf8*\x82\n\x82\x18գ \x0e\xad{\x94k\\ۨC^\x9cڏ\x9c\xe3ļ\xf2 l\x00\xdch\xe7\x8b7\xebZL=\xb4\x01vAŶ\x82\vS\x8b/\x88%\xc9l\x03V\x82\xaco\xf2\xb0\xe5w\xd2`\xd1\xf2;\u007f\xb8n\xa0d\x8c4ғ\xbf٦\x8d\x97NN[\xe1&\t\xcb>V\xad\xf3\x1b\x8f[s4\"\xe6\x90L w\bce\xd7a6^\x18\x19\xde\r\xb8.\x0e\x1c[\xf93J\x80\x03\r\xe0\xae#\xff\xbe\x96/\xaeҩf\xc8\x06\x10\xab\xab\xd4}*\x10\x184Ul8\xd1\xdbY%\x9b\x85\xf0R\x1fU\xea\xf5\xef\x1a\bF\x88\xa0B+OH)s\x97\x1e-꽩\x8d72\xbd\xaa\xd3\xd2\x1d$\"إҚB\xac\xd5̑\x00\xfcN\xb7o\a\xf9蹤b\a\xc2D\xe0\n%3t\x03l\x18\x90\x1b\xfa\xb4M-Hc\xf2\x06֞6,V\xd0=\x06\xa4\xaf-\xe4\xc4O\x8al\x81\xbe\x1d:\x18\xd5\u0081\x96\xe8\xd7\xf0,\xd85JL\xe2\xaf \xb5\xd5\xc7\xcb2\x9e?\xbdm\xa7\xc2-\x05\xff\nG\x97˝\x8d\xf9\x9e\xdcT\xa4\x9d\rf\xc6%\x86\xbfR<\xb2\xd7G\xd5\x12\x8f#\xf8\x06U\x10U\xdbF\xe3\xaa\x1a\xc0>\x9a\x01\xde.phk\x11\x98V|\xea\xeb\xec\xb8\vZc\xabλ\xd0b\x8f$\\\xacލ\xb2\x8c\xf8RE>n\xebQ\xe9?\x04Cb\x9c\x14\xbc\xc1\xc5=\x85\xd7\xeb(\xa2S\xafڙ\x95\xfd\x0f5\xf8+\xfcg\x17)\xc0\xf1#\xa7R\xf8]w\x91nXM\b\xc9\xe1\x18\xc0\xb5%Œ\xb6\xfa!\x13\xb5y\xfb\x92\xa2\x8e\x1a'\x00F/\xc0\xad\x86\xfc\x0e\xeb\v\x81\x9e1\xf8|o\x02\xaaU]\t\x94\xa3Չ\xea\x9b\xefb\xeb\xfcZ\xe8\x8bM\xf8V\xa6\xb3\xa3՞U\x15\xaf`\x90\xd1m\v\xba\xddLC\x13\xb3i(Z\r\xec:W\xfe;\xd3P3\xa9M\x11m\x9a\xfc-\"\xf0\xc0G :5\xfc@\xb9<z1\xe6\x1buX\x12\xd8\ue5c9s\xae\xa4\x90A\x17\xae\xa9\x97\xf4ꊶ\"\xaf\r\xe8+3M\xa9\xd6\xca;bFNDF\x9f\x9b\xe7\xf2\xd4\x0e\xf1\x16\x04`J\x02\xc39\x80\x9c\xf8\xc8\x13\x0f\xa4\xe7\xe2\xd8\v\xb4\xe78\xa0\xee\x87\xc7\xf0\xe7\xb1\a\xe6\xc7e\xaf\x17\xd4qa\xecd\xc3\xeb\xd3ڢ\xad*`\xf4\xe4\xf1g\xd40\xc4\x1a\x1e\x91\x85s\x89\x99۪ho\x90檀\x95\x90\x1b\xe21\x978Mp\x80\xac\xa4ס\x05yA~l\x88X\xba<r!և\xc0\x82\xabc\xef\x02Y\xa0\x15\x94\x12\xc2\xe5D\xa0\xf6\xba\u007fbu\x02\x82p;/\xd8\x16]\x11eh\x17G\xad\xa9,\xae\v\x02\xe3\xdc\x12\xea~\xa8\x14\xcd\xd4\x007\xe2\x02\xa2Eӧ\xd7\x02\xf6\xf5a\x06\xdaH(+\x8eU\xee\x94F\xd0H~L\xf7\xcc\xe2\xdep\xa4\xdb\xca\x14\xc0\x1c\xb3\xf4\x1c\x1c\x16h$\x1f7\x04\x18m\n\xf8\x8d\xa20%\x1d\x1f\xa1\xa5\x84c\x12gJ\fI-l8\xac\x8e#\xb4\xd7\xc3w\xdex\xad\xa9\xa6\"*\x84Z\v\x15\xa53$\xe8\x0e\xeb\xc7\xd69oY\xbbǮž\xaf\xc2o\x98\v\xbbV\x8c\x8b\x1aƞ\x86\xf2\xb1B\xd9\xdb\x15g5\rBϭ\x97\xd9\"\xae\x0e\xdbv\x9d\x9e\x01ǲa~^\xea\x82\r\xdf?\x91F\x14\xd5\xe0a\xcb\xf5\xd8\x01\x817\xe9\x15\xd4'L\xec\xe3\u007f\xd0\xcc>\x0e\xda\xe6cdǧO\x86;\xee/\xe9MC\xd9\xfeB\xfbL;U\xd3lT\x18ۑN\xd3®\xc0\xb5\x17\xe5\xaaS\x8a\xcd'u\xab,\xackR%%&E\x9a5\xd6&\xe3h\xdf\xe9\xb0;J{\x03\x87x\x9b\xe3\xb3ԟf\xf6J\xf5g\xd5\xfd{\xea@ɍ\xa6\vR\xf5\xd1+\xf5bO\xed\xd2\xf4\xd6\xe4SpdD\xfd\x88\xb7\xaf9\xb2<\xc2{,\xe6\xb23\xdfY\xb7\xf12\xca\xf7\xe9\xf6\ay\xb2\xaf)\xa02\u007f\xa3S\xfaZ\xae\xcfآ\x01%\xc3{r\xde\xd7\xc6\x12\xf4\xac\xe1\xcae\xbc,|uN\x1a/\x1d]&6\xea\x8d\xe5-\xeb\x1a\xc5\xc0\xc41\xa7\xf2!\xab\xda\xccIj\xfe\x85ձ&\xb6E-\xc8\xe9\xcf7\xe1\"\xfa\xb2\xc7+\x9aU.n\"\xdb/\x06\xd5_R[\xab\xc5\xfd:i{\xe8\xe86\xf7\xceyX\xf8\xeb\x84\xd6V\t\x86\x8f\xd6\xfa,\xb1:-qk\xe4S\x96\x96~)C\x90\v\x89\x17\xc9\xe1\x1fz\xfe#\xfbE\xf7\xfeeB\f'\xd7\xf2HmSW7Ď\xaa\xeb\a\xa6\xf9\xb4u\x06\"T\xe4Tc\xccC\xe8\"\xc1\x0fw\xefb\xdd\nP\x9dr\xdd\xe1`\xad\xfb\x1a\xac)\x1c\xb8ؤ\xf5\v\xf6| H\xb4e|e\xce\x0e\xbb\xf5#x4\x1c%Y6#y\xa8\x99E\x1e\xd1\x11\xaf_\r\x95lI\x11/\xe96\xb01|d\v S\f\xd4O\xee\x17f\xe6\xef\xd8;XyC\xe7t\xf1\xc05\xce\u007f\xfa\xac\xaej\x00>\b\f\xe4\x14\xea0\xaa.\x92Jw\x19\xda\f\x13\xfd\x8a\x0e\x0f\xa2\xafe\xcfK\x80\x9eg\xca\x00y\xbePV\x9b4\xd8\x12 \xa9ߣ tF\xdb\xdd\xf2`u\xb3^\xc2\xeag\n[\xca\x14\x05B\xb3\xbb7\x04\x85,\b\x8c\xf4\x9c\x8bS&\x1băz\xf3w\x94\x0e:\xa4\xfa\xdfFD\xb4M\x9bϒ\x16>\xe6\xa1;\xd2:\x1a\f\xadހ\xc5<ti\x923\xedH\x8f\xf1\xe3\xabC\x9f)i\x1aN\x97\xd0\xed$u\x95\x94\xfcΊ\xa9\xd5\x1e\xb5l\x8d:\x86\x17r\xb7o\xbbM+\xf6\x01\xe5Ԍ\xdbo\x9fe\"7\x18\x8e.\xeb\xb8\xc9<w[\xa4\xefW\xe1\xa2\x04\xa3\xc5ļ\xa3\x0f\xd3eu/\xd7:\xefx\xaf\x0e\"\xcc[P\xe59`:\xcf\xd2\xc8\xdc\vUv\x13n\u07bd\x0f4\xb0\xd3K\xde2\xa3\xa3\x80\x1a\xb1\x97 \xb1\xef?\xc3A\x88}\xd4\xe9iQ|\xa2`]\x05C\x87\xb4c\x97\xf1]\x1dHT4b\xac\xa2\x9akf\x95\xfd\xa5=\x83D\xe0\x87\xd6=-\xf1\x80j\xa4]\xec\xe9c\xcc\x06_\x8f\xb9\xf0/\xe2X$\xa5\xa0h\x1d:6\xff?q\xe05\x8c\x8fOt\xfa`l\x1f@\x06\xd1or\xd84\xda\xda\xd7\u007f\xce\xc70\x12\xc3m\x00_\xc2\xe1\x10\x97\xe8k\x19\x04\xee\xf5^}Pӻ@\xfa\xb8ŉJ\xfal\xaa\x19\xc1\x86\xa9\x9f\xc4Ն i\x8b\xb5z*\xf5\xb3\a\xc3){\x9e\x0e=\xa5B\xc8\vuo\xad\x1eu\xf1\xd8\x1d\x15R\xd6u\x1f\xe1\u007fv\x11\xbf\xa9|\xd6$\xea\x13\xe5S\r\x9a-\xd3A\xbb\x80:\x0emM\tl\xea\xfew\x9f\xa9\v\xdf\xdd\xc4\x1e\xf8\xa4\x8a\x9d=\xbbk\xb5\xbb\xf7jS?4\xce\xf7\xcd]Z\xbd\x82n\xb8\xd8+@\x95_\xef\xcd=ӕ[\x00V\x1b\xde2\xe5\xd8\xccWݡR>}$\xb5\xba\xc3J\xfc\xf6\xbce\xd2\xf5\xd1\"\xefw\xf6\x924\xf0~\x11GIU\xc3\xee\xf2cw\xbaKnt.\xd5D\xe7j\xf6.\xcc'\xdf/\x16q\xfe:\x8c\x92U\xe1x[=\xa9r[p\xfc\x88\v\x99F\x1cY\x95ۂ\xe3\r\xaeW\x9e.f8\r\xd7P\x14*\xb3\x05ËE\xd4T>\x16YM\xa5\xb1\x9b\xfce\x9e]\x98\xf1]\x14\x18\xe5둼t=\x19\xcf\xe6\xe6\xe3\xe9\x9eL\x13gΝ\x1f:\x86\x9f\x91V\x93O\xd7~\nt\x1e\x88\x17\xf8\xa0uP;\n\xa6}+\x81O8#-\xe5\xee\x98\xf4P\x92\xfb\x95H\xa2\xb2ϣ\xae7\x15\x01ΉXt\xb6\xc4c\u05cd\x99\xb6i\x8c\xd5i\xc6ى\xe2i\x82!\x0fT\xe4$\xbe\xb2\x8eU\x9e&g\xf58\x13L$s\xe0\xd4ۛ\xae\x8d\x92\b߅\xd4M-\xd5;\xab\xbb\a`\xfd\x02\x9fT\x1ezU\xaa\v\x8ek\xf5\x1bK\x90z\xe0(\a\xe2\x1a2\x15\xa7gfl6<\xa9R\xf8\x89\xeb\x0e\x1e\x16T\x8f\xba\u007f\xb4\xdfR\x91\x82\xf6W\xf5(\x8aL\xf9\x15o\xb5\x12w\xe5\xcd\xf6\x95\xe9\x99f\x82\xbf\x14\xe7\xa6\x1a\xc0\u007f\x897\xa6\x8f]\x9f\x02\x86\b\xa1\xc27x\xef\x8b'\x18\xa0,]\x80\xbb\xf9\xbc\xa8\x8c\x9b\xb5c>D\xbc\x86\x06\xa2\x8a6\x9fT\x91\xba)\xbfٹ\xa2\x8a}\x9f`hpY5\x01)T\xbbU\xddp=\xe0\xdd'\\\x0f\x80^4\"\x16q\xe0\x9fwVП\xa0\xb5\xe3\x89n1jl0\xbfQ\xa4\xbeW\x19Y\xce\xf1\x93\xdeѩSM\xea\x02\x14\xac\x9d\xf8R\xc9\xde\xcdy\"ʪ\x1d\x05\x85jk\x97X\xe9M\xca\xfe\xaf\xf5IB\xac*\x9aJ\xfc\xea(1l-\xf1Rz)\x90\x867\xcb\n\x9dÊ\xe3e\x83\x8b\xae\x81\xe7Y\x9aaxh'\xb6\xe6J\xf7\xbc\xce\xdf:m\xc8ߔ\xf1\xf2\x9bx\x9a居Z\x1e\x85\x89\xbd\x1fCIv\xe9YoN\xa2\xad\xd3e\x88ϧ\xe1uV\xfa\xecta\xc2[\xe2\xde\xea\x99\xfb\xb5\xe1%\x1d\xa9\xd2\xdc\xf8\v\x80\xfa\x1a\xba\xe4,\xa0\xf3\x98\xcew\x84c`\xb9\x06SI\xa9o\xb75\xec\xe9\x147M\xfe1\xed\xfa\xce\xd1.\xc2\xf1\x8b\xc0\xf1\x89\xedz\x16\xf26\xe1\x8f\xcbXZ\x0f\x95\xf1\xe0\x142\xb59~(\\=ݢr$)ŇRu\xb8\xef\xe2|\x8e\xf1\x1d\x04:\xf6Z\x1bv-\xf4x\x18\xd6u\x11\xfa3.\f\x00\xb0F\xecN\xcd4\xc4~׆\x1e\xec\xd6\xd0ACC}\xddtQ\xb0\x81l\xf1\x96f\x9eJ\xf46\u007fF 6`\xdf/&\xb0\f\x90\xed9\u0558~\x16ܐ76W\xf0\xb6R\xa6\x85\xc4\xfa2ܑ\x95\xfen]m#.\x05\x16=\xac\xacLӼ\x10qz2\x9bv\xfbĳ\xe1\xfe\xfc\xe7\xed0{x\xc4\xe4Xe9\xf9c\xdbv\xefWad\xab\x11G%J\x95tp\xf4\x0f,-S}\xa2\xe6\xe3\xf0\x18\xa2<\x01P\xa0\x0e|\xad4\xa9b\x95 W\xb0\xf2\x8at\xaaa\x0f\xaa\xf8\xafx\xaf\xc4G\x95\x85\x91d\xc4\xeb\xdb#~\xaff\x0f\x95\x18g\x0fE\xf6Б\xbd\xe4\xee\x97\x1f՞\x9aN\xbb\x96\x8a\x84\x8d\xecK\xe16\xa3\x1f\xebQ\xccJ\xbd\x91\x1a\xa6\n\xfd2\xd1\xf0\xef\xed\x1d\xdb\xcd|\xc6\xed\x180\xa9%?\xe7]\xb5o\xc0\x8a\xac\xe4WƵv3[,h\xc1\r\x03\xb6\x91\t\xca\x1d\x99\x9bM\xae\xcaVc\xc9i\xb8K\x84\x1bQ,\x9cͳ\xb8\xa1g\xa8־\xf9ݨ\xb7ߎ5\xa5\x88\xee\xad7\xf4\x82C\xc0\xf6\x88\x96&\xf9\xda#\x9a깩A\\ZQ\xf7ў\x13wу\x8djG\x8f\xc4#`Ģ\x19\x9f\x1d\xa33K\x05J~\x16K\x1cdCkt\x8e\xcc\xe1Ic\xd0a<\xb8-\x82\xbf\x1b\xe3F\xed\xa1s%\xea\x15@\xbd\x8e\x8a\xdfJ\xa4\xc4\x1a\x1a\x9f\xca\xf2\x97#\x16\x01\x92\xfb\xa0\xab\x12\x87\"\x11E\xe0\xccu\x05Gbk\xe3\xe87a\x91\x14\x96\xc1T[\"\xf31\xfbn\x8aR\x0e\x92\xa1\xdb\xdaJLV\xd3\xfe\xfe2\xa1\xdf\x1b\xf1\x1bY\xbe\\\x93\x11Ɓ(\xf8?_/;$\xdb\xcaC\xc8\rAn\x06]\xf5\x1f\xe1\xf0tc\x0e˓)\au\x9cU\xf1\xdet\x1e|\x13\u007fH\xd0t\f\x89L\xf4]\x90\xdb$\x8d\x1d\x9c\xb8\xbap\xb9\xee\x17\xe7\xc9T\xdbM\x80\x14\xb6+\x92ʶ\x85\xd4M\rn\xa3\xe0\xb4;\x1c\xbb\xd3)Ʒ\xf6&\x8cA\xb96\xe5\xfd\xa3i\xbc\xa9\x05LH5\xf3\xb7MP\x0fo\"\xa8R@\xacM\xb5\x9a\xd0*\x81\x1ch\"7p\xae\t\x0ev\x10\x107\xabk\x8cefU+\f\xadAϳ\xf2\xd0w\xb3\xfd\x00\x89\xad\x14\xef\xee\x856r'\xb2\xd7K\xfe\x15%\xff\x93\xa4ʹȖ\xa2\xd1*\x15\x180\v\x83\x9e\x1d\xea\x02\xa2\xa4\xc3%\x1a\x87\r\x1c\xf4\xbe\xf6\x16\x9f%\x1e$\x8e7\x91\x0e\xa1\ro\"\x1bR\xd8\xdd\xc21߱i\xffZ\x02\xb2ZDi\xdcfc\xd4\xc4¼\x04\xbc\xb0\xdeC1V\x01ڜ\xa7\x928~S\x95\xb48\xe3\xa9\x1f\xe7\xafhc\xa4\xd3쏳\x9d\x14\xd1%P\xe8\xda\xd7?17\xf6\x97\xae\xa1\xcb\xe6h\x02&\xd1B3D9\x06\x80܊\xbb#\xedKӊB\x8f\x04\xbc~^R\x14\x86@/>\xb4\x8b\x0f\x9d\xc57\xaa\xf8\xa6-j\u007f\x93\xd1\xd2փ$ՎWKC\xb6`\xc7ܠ\x90-\xd61\xd3\x17b\x14\x02\xfc\x1a\xf1\xd7\x01\u007f\x1d\x9cm\xd5%\x86\xfc\x81\xf8\xa9\xe8B\xda&ѳ\x9d\x87\x1d\x86\u07ba\xd9@\x1dv\xe9\x01\xf3\x1b\x94\x18ݸ\x8eэ\xeb8\xb8q\x1d\aXG\xfbV_\x9a-a`\x92\xedn?¾\x1c\x1a\x96\xf1>\xa5h\x06t\x1b^\xbe\xaa\xfb<\x99N\xe3<^L\xe2\xa2y+\xf1\xc25\xf4yV\xa0Y\xc0^\xf1\x0f\xcd5\x05n!\xccy\x805\xb5ˡf\xb5\xd0\xc3s\xbc\xab\nK\x9a\b\x87T\xe4FC\v\x10\xb3\xee\x04\x1f\xfd=\xc3\v$\xfb\xe6\xf2]\xe0\x8b\x8c=\xcay\x1b\xb3~\xc8\x16Y\x99-bk1ֲ\x06\x8b\xf8\taz\xac}n\x92\xdc\xc8\xf9J\xc1]4+\xdb&&\xb9\xf9\"\xb5_\xd7b\x8a~\x12\xc3\x0f\x1a\x8f\xe9t\xfb\x11^>\xbd\xa7+Q\xc1\xac\xb9(\xa9\xbb)\u05ee7\x87\f\x881P\xb4\xd0\xecc\xbd\xb8\x91C\xaf\xd8\xe8O$\x9b\xb7\xb9\xf1\x91\xf5\x87\xd6a(\xb6\x12 \x0f\xe4ELzf\xb90\xceF\x05\xc5\x05\"\xb6\xd2e\x13\n\xb3\xb2\x8f\xae̓\x86\xc5ŉ\xcet$G2Yy\xb2]`?BE\x81\x98ь|\xf6zKhG\xf9\x8c6:`\xd4ޓ\x0f\xc1\x13\xe9w\xe8OuVn\xaf\x94\v\xdc\x1c\xc4wi\x18\x98??}Y,e\xbb\xf5,\xe7\v\xee\xaf6\x8d\xadV\xed\x85\x1e\x17j\x8fذe1\xa5\u0558ͻR,\x1am~=\x10\xaan;\xe42Qs\xd2\vU\x9a~\xb4\x80~#\xd3i\x11\x97\xc7:NV\x82\xb9|\xe2\x1a\x1f\xb2\x0f\x03\x13bh@\xe0C\xf6\xa1Ӱ#Қ\xfdE\xe2\xb0\xcd\xe3\x83\xf2\xbf\xa0\xc7\xc7P\xdb\xec\xefz/\r\xe8\xd7\xc2\xf7\xbeVhP\xb9\xe5\x1b\xc5;\u007f\xeb\xec\xee620\xbe\x86;y\x91\f,7\x92\x01\xf9\x91X\xa9\x1bJ%\x8fL,p\x82\x01C\xf12}ݕɫ\xb9\x14|\xe4\xa8#\xaa\x82!U\x80\xa86\x8cj\xb3\rզ\x01\xd5\x06Q\xa1\xf9\xf5%\xbd\\\x06R\xf1\x90\x8b9\xf0\xcb\xe1벡\xac͠\xdd\xe1Eb\x1cj\x8e3C\xb7\xe7\xcc\xf0\x86\xae3\x83\x9b\xfa\xce\f,\xe7\x19ϐ\x99]\x9cj\x06_ҫfPs\xabY\x0f\xeb\x9e6\"\x82\xeef\xf8\a\xb9\xdc\xd4Ǳ\t\x05\x83\x1d\x8f\x81^v\\\xde9\xa8u>\xd5;ghxI\x13+lo\tDoxKp}\x83O\xabp`T\xb8c]\xc3\xcfm\xdc\xee\xed\xfaD\xe7#U\x15\x89\xce\xe6\x06\xf5}\x1a\x1f7\x03\xa3\xc2\x1d\xeb\x1a~n\xe3vo\xd7\x1f\xe2[\xe5\xae\xfa\u007fcߪmzD\x9a\x02\x1c\x1b\xa0\x16a\xf6\xa5\x05͞\x01\xa0^\xc14\xc1\xa86/;;u\xc0\x1f\xe2\xc4U\xab\xdaJw\xd9o\x93\xf3,\x8f\xded\xab|\xe2\xf4\x17\xa6\x8cֲ?\x03\xddq\xe9([RFk\xd9FW\xe7\xbc\xd5˙i\xfe<?g\xc2\xf1\x19\x9eΰ&\x98e\x8b0\xfd\xa9\xb2-m$\xa7\x80\x06cL\xc8\xc7\b\xda\xd0\xf0\"CCV7\xa9\x9b\x1f'\xa60'\xb86Pe\x1a\x9e\x86\xeeҲ\"\xa2\xad\x93\x90~\xb5.$T;\xaceD\xd7^5\xa8\xc7\x16\x9a\x9aYl\xe6\xe3,}\x83wo\xac\xe6\xdc;l/\xf33\xe8\x17\xbbLg\x92\xe4\x934\ued17|F@~\x01\x95\xea\xab6\x15F\x9e\x97\xf3\x90+\xd7\xf7?}o\xba\x92u~\x18\xd0YO\x8e\xa7>Oկ*\r\x96\xc2\xc3\xee\xd0c\xa8\xden`y\xbb+a\xb8N\x8a\xbf\xeaϟ\xaa\xdb\x1f*\xad\x1f\x96e\xeewJ\xf9\\F\xa7\xeb\f\xd1%\x1bAp\xb4v\xc2\xea\xd7\x1c\xa3\xab\xd3\x1d\x04\x1d\xfdy\xab&Z~\xd5i\xd9\xfc~\xb4036\x82\x9a\xddhy\xb3\x1aG\xc9U\x12\xc52\x98\a\xddN\xd1B\xc3\x16\xab\xb1\x1d:\x105\xeb\x1c\xbd\x97\xf4\bs\xa6\x03M[lB3f\x10^\xf7\x16U\x98\v=\x1d9\xafs|\x8ea\xc7\xee$\xf4\x93\xf7_\xf6\xf6\xe6]\xef\xa2\xeb]m_E\xa9\xe0x\xf3c\xaf\u05fb\xe0X\x80\xf5\x87\xff|\fT\xb8\xa7\xe2\xec\xf5\xbc\v\xdc\xf3\x0f\xf0y\xab*\x9c\xa1\xfd\xe6\xa1h\x85x\xb72h\u007f|\xf0\xa3\xee\xad\xd3\xeb%\"\x88\xeb\xb1G1Q=b\xafEŞ\xa4\xe2Q\x15\xc4\xd8$\xbe\x89\x04\xc7\x11\x85\x04u\xc9\xc68>\x0f\xaf\x92,\u007f\xf7!\xcb\xe6\xcf\xe3\xb4\f}\xe3\x15\xf5\x1aHreDM\xb73\x8dw\x82:\xf8>P\xa7z \b\xa4\xafz\x02\xe8*)\x12\f\xfb[n0p\xf8y\x12E\xf1\xa2\xf9\x81\xa0\xf38\x99\x9d\x97F\xd2u\x12\x95\xe7FJ\x06\x86\xc84ͮ{\x84\x92_2j\xaa^!\xec\x8c\x06\x83\xc1r\r9\xf8\x84\x9d\x1f\xf4\x970\xdd/J|3\xdf^\xe9\xc5\xda\xd3\xe4\xf4\xfeW)\xcc\x05\xfd\x99q\aSģJ?S\xf8\x86a|p\xdc\x02*\xdf:\xa6\u05ff\xfd*\xde<\xd5\xc6\xc5\xf1iжJ\xe4kc\x13\xc4\xe3q\xb4\x05\xad\xd3\x04\xa2\xb8\u007f}\x1e\xc7)\xf59\xdan=\xbc\xbcP\x86I\n\x82w\xd7\xf5։l\xae-Bi\xb8\xc9V\xe5\xbb1\x1dK\xfe\x04\x13\x01\xc6\u05fat=\xaf\x83\xe9\xc2$\xeaz1EH\xa0$\xb6t\xba^:\tY\x85\x18(_\xc6a\x81\xef\xfdϳ\xc5S܂\xc7\xcd\x14\x117\np\x04\xfa-$\xfd}\x1d\xa5\x1a8\r\x1f`\x05\xfc\xda~\xb0 \x8a_\x90\xe5N7\xf6\xf7\xc4\xd0\xe2 \x1a\xb5\xfbŭ^WضZ\x85\xd2myI~\xcb\xfc\xecl\xbc\xa8n\xc93C\xf0IT\x9d\x98\x1d\xb7\x12\r\x8eI6\x15\xf4N\xa3\xde\x13\xa1̑{oT\x93|\xc9Q\xafW\xb4D\x00ܖ\xafU\xcb\xc6(D\xcc$\xaaG6D<\x0ei\xb1T\xd6\xc4?\x1cmlF)\xcdp\t\xb0\x95\x01.\x91\xd1\xcf[)h\v\xad\xb8\xc6j\x95\x10V\xfe7!\x0e\xfe\xc2!\x8a\x15cш\x1bo\a\x1b\xa3c\xdc+\xe6\ac\xed/\xf1\x81*Q\x18R\xc7Fjq\x0el\x89DV\xb5\xd9$:C`:\x11\xa54Y\u058b\x85\x95\xea\xf2\x9c\x95\xcb<\x17\t\x0e\xa1\xab\x90\xb7\xf0\x1d\xe6\xb6I\xfc<\x0fg\xfc\xf2\xab\xb2]\xa2\xfe4Y\xc3z\xef7ᖻ\xad8*\xeaZ\xe1?\vg\xe3\xb6\xc2?\xe0\x83m8\x018\xeb>ܭ\xf8\xaatV~\xb0\xad\xf4\xd3\xc9d5_\x91a\x86N\xac\xd0\xe7\xe9\xf2\x1c\x04nr\x8e\x9a\xcd8\xf4\x98\xacy\x1fp\xb2\xa9\x8e\xe7\xb0L\x9fa\xabD\x9a~)'\x8déin-\x84\xe0Q6}\xb0\x1dE?\x95\x1d%-\xac\xc9v[i\"\v\x1b\xde\x17H¤z\xa9\x1e\x1faK\x16\xab\xd8xw\xaf\x81\r\x93\x1a\x0f\xaaRzs\xf7N\xbc\x89\xf8]\x01\x00\x8f\xb4t\x98\x8f\xe0\xe7Z\xcb\xdeԳ7mN\xc5\xfd\xa5\xf9\x84b\x13k\x05i\xf2q\xbe=\x19`(\\\x80\x05K\x0f\x91ʇ!,\xd8M;\xecG+\x8c\rq\x06\xe9f֜j\x98(R\x9f\xea\x02\x8bS\x15\xdc3),\x97\xeaA!\xa2\xf7\x12\xb0\xeamP\xb9\x1bG\xeeF\x1f\xec\\\x15\n\xe7\x04\xdf/\xd1j\xd6e\x14\x85\x16\xfe\xa9\xe5\xb7\r\x8e\x970\xc5?O@/,8b\xfa\xa5\xb5\x1a\x1d\rv\xc1\xf0\xa6\xccI\xac]\x18ڔ\x03\xd4;\xb9tܲc\u07b4\x15r\\\xb4\xebo\xb6\x15\xfa\x11U\x88\xf0\xf9\xad4\t\xef\xe0\x8a\x81\x1dц\xf0vLy\x14\xe7\xf2\xf18\xfd\x00\xb0\x16\x1e\xb3:%\v\xb6\"\xa5\xfd\x89\xbf\xc5yf\xa0\x94/ \xf4\x84\xab\b\x9d2\rΔ&\xd9\f\\\u0383\x17\xeca\xb7\x19\x9c^h\xbe\x06\xc6nr\x1b-?\x84\xeb\xefQ\xda\xfd0\xcfÍ\xed\xcb2TO]\xe0\x9a\x82@\xa4+\xda%+;J3\xac/-`\xfb\x02WW\x899\xe4\xfdK\x85)9#/\x9c\xc7ޕ>\xfa\xb1\xc2D{\x81T\x1f_\x8eu\xd5Ŷ\x16\xbe\x8e\xa3\xd5$~\xb3\x9a\xbbv\xe8(ϷJ\x00\xac|\x05\xb5\r1\xa2\xc4\x17\x88m\a\x00܋j\xb8\f*\x8a\x9f\xc3@\xccfy8\xff&Y\xbc\x81B\xa0~\xe4\x03yWa\xba\x8a\x1d7:\x1d%\xbfřQ\x96\xab\x9e\x04P\x91\xb8\x19\x95ڇU\x81\xb8G\xe4k\x11\xecH\x9fQ\x8b\xe1\xe0\xb8\x16\xb2\x8a\xee*{\xd5#.(\xba\xbe|\xc9\x054\xf0\x18\xab^p\x80\xf7\x9a\xf4\xae\x85\x0f\xe2\xf4tMN3\xa0\x1b\xd7\xe8\xdbb>\x00\xb3\v\xa9\xfc\n\x8e\x93}\xe8\xffJ\x8e&\"\x97\xde\x1bD\xc7\x12\t\xdd\xdeWq\x1e\xe6\x93\xf3\x8d\b:\x9c\x8dqw\xb2\xeb\xa9t\xa5`\u052b]5\x10\\\ag9\xads'\xd0\xf2\b\x94(\xfe\xa6\xea\xe5Y=\x17\xea\xa3b5\xadY\x85\x05\x15pa\x00K\vĵ[\xe5@\xf0\xfd\x02O\x17p\xa7'_\xd9\xf1\xf1}\v%\u007f\x06~\xe4\x8c$¹\xbbp\xed\x99h\xafc\xf0IV\xec\x82\xe6\x17d\x95\x03\a\xb1p\x17\x04o0\xf4\x99\xc3\xdbs\xcc(@N\xc3ݑQO\xd0ڨ>R\xfb\xf3\x18\xc63g\x9aQ\x92y\xb1U\xdbO\x14\xe9\x8a\x1d\xb8\rpzfEX\xa6L\x87\x9f\x83qvOk\xfa#\xb1\xb6\xeb\xea/^\xd0\xd2\xfe\xc8#,\xd5\xceX-$s\xabRX\xa2\xcak\xe0b\xa8\xb88\xde\xcaE\xc4\xf3\xfd\xa2\x88-Ld\x96\xe3\xd4\xd0'\x88w\xd5k\xe0z\n.\x948u,R\x97yLs\x93\x99*`\x85\xd1=1a\xc7\xdbZɛ\x03:u\xbb\xd3\xd0\xda\xea\x12\xcf\xe8&eQk99v\x8f\xfbk\x92õp\xe8\x1e\x83\x8d\x82ߠ?\xa2\x9cX\x83\xfb\xee\xe3~n\xc6F\xc7c\f\xf8\xa7\x87H\xd0\xcf\x1a\u007fm\xc8e\x1a\xa6\xd6\xfe`0\xdc\xd6\xdcd\fzaf\xeeS(\xd81\x05\xf6\\\x18+\xdd\xf5\x0f\x89\xf1\xd6;\xed[`\xbd\xf4#\xef\x12\x80Zk\xac\xc1\xc6\xd0\x1f\x02\x11\xd0{\x1atX9\x87o\\\xb87\x1a\ue34e{\xe3½\xd1po*\xdc\xd5r\xe4\xb6/\xd5\x1c\x81ɑG簐g\xad\xe1T\x88\xc8joB\xac\xf6\x04\x1f\xe4\x83\xfd]\xd9\xd4^\x95\xb2\xb1A65\x10v{\x85\xb5ZB\x9b\xeahXqML\x04\xd8S/\xc2ɹov\x1a\xaa ѮP-\x1b\xa5\x93\x1d{\x8d\xf4B))\xec\xfd LC\xeeP\xe9$GW\x11̣\x84\xb1\xc2W\xdd\x12\x1d\xf7Y@\xf3*a\xa3{\xb62ұ\xe1&\x8bhG\xee\xf5\xed\xe8̵|\xc5v\xfd\x94\x86b\xe8\x01K\xb4\xe5*W0\t\x9a\xcaU\x1aE\x87\t\xcd\x01:\xd9Rz\x82\xa3\xd2\xf4í\xab#\xe3q\xa0\x03e\xe8ZW{[ۥ\xad\xf3\x8dS\v2\xbb\v~\xe1\x1dlnr\xad\x02\x03\xab\x18U\x17\xbd\xadS\x15]\xdf\x1d\x83\xe9\x8c\xfb\x9d\xe3c\xb2\xa1/\xb4,\xc4eӧ^\xd9wk\xa8\v\xe4\xa4]\xc2S\xe4\x19\xe4\x10\xb3\xf28\xbc4\x13?\xder\xff\xe6\x18\xac\x84\xe7D\x17<\xadm\x97\x1a\xeb\xb1\xf3\xf0\x91'lۅ\x95\x06k\x03-\x05YUo斆^\xba\x1b\xeal\x91َ\x1dZ\xe8by\x81\x0f\xafC\x9f\x82\xb2\xc1\x1e>\xc1\xdf\u007f\xfe3\x0e.H\x871\x1b4OGح\x81\xf09q\x03!GL)\xa6j{=\xe3t\xac\xfe\xcc\xd6n\xe3I\x8e\x8a\x89\x95T\x1b\x9c\xcd'p\x1f\xcdM8\x9ft\xe8\x1e\xcf\x01|\xed\x9a\xf67\xfc\r\xa7o\xb4\xf4\xbc\xd28\xfa\xeb\\\xae\x01\xe8\xdaJ\x9b\xe0\x14\x85;+U\u0086\x12Զ\xd5$\xd7\xe7\x92\tL\x1f\x13\x9ar\xab\x93v\xc4q\x870\xedQq\xfc\xbd1\xdf\x1c\xe2\x99\a\x99\x94oW\xe3\xffm\x91JE\xde2C\xa3\xb27\xa6`\xaaðH\xb4\x14\xa1\xec\x16\xed{\xc5U\xed\x06\xe6(N\xe32\xb6+8nȣa\xd8^\xc7\xcf\xf2T\x9d\xaaa\xe7ۮg\x9cL)\xcbך\x8f+\xf6\xd1\xec\xa3\xf6\xd68E˥\xad\x1c#ws\xac\xf7\xc5\x1d\xb5\x85@\x9b\xa9j\xba\xff{\xc3C\x1f\x12\xc2\nem\xef\xdc65T\x16'\x17a\xd9\xdeJDZ\x98e\xce~\xba}8V\xf6\xdf\x04͚v{Q{jtL\xe7\xda\x00\x8e.f\x9b\xc0r\xb7\tyRWXq\xd8H+rOZ\x91\xea\xf03DFF\xea\x8a\b\xe0\xc6\xef\xb1\x1eL\x1f\xc9\xeaߥkGc\xb4CC\xba\xae\x81\xe1\xed\xa3\x89\xf0z\xae\x06\x93~\xc1\x83@(z\x01\x14\xdcCh\x8au?\xc6\x01\x8a\x1fw<\x81\x90\xe0\x02\x1d\xad>\xbeO\xc8\xf6\x01u\"\xdb`6aB\xa2\x12\x92\x85\xb8f\x13\xb9\xc7 2\xf0\xa9\xa9\x19u\x94UC\x15\x96\xad}:IW\x05L4\xbf\xd6dN\ue652\xdf\x12k\x1b\x01\xa1\xf9\x9eX\x8b>\xf9\x82=\xa6\xaa\xba\xdbT\x87\xa8\xfd\xafM\xb5+1\x17\xdb`\xfa\xeb\f\xee\xbaI\xedQ\xfd\x92_\xb8Q\x06}\xe1\x181\xedD\xbd\x8c\xa7e\xed`\xb4]\x11XT\xa3`[\xd5\xf2U\x1bGEjD\xa2\x17\xc9\xd16\xd5(J\xbeF߄\x9diTV\xbb\x83LߡT\x02\x17\xb1\\\xa5\xa2vA\x01\xacw\xa0\xb8\xcc\xe3\xf8M\f\v\xff\x90\xfaϹB\x97g\xbf8\xe2\xc5\xef' \x80G\xadG\x82\x88\xf8Ku\x95\xd6)\xa2\x85}\xaa\xaf\x8f\xb1\x90\xc3h\vU_\xbew\xacn\xf8\x04\x1a\xdfĸ]$&\xbeI6G\xb6\xef\xceD}\xaej\xa4\xd3T\xe8\x94\xdb\xc5\xddV\xfd6\u07b6[.\x822\xae\xcc\xd8{\xd4\x1aaLj\xb2-]\x8f;\xe01j\x01ݜ\x14\xde\aT\xa8\xfdڠ\xe8\x90\x1d\x04\x9cz|\x9e\x15\r;Pk\xda}Z\xef \xccM8\xd4|\xba\x05\xc7\xf38^\xc6MdD\xf1\x92n\x9f\x8e\xf9\xd7\x16T\xbf$ERr`B!%a\x9a\x8e\xc1\x12\xa8\xedà\xa7V)\x80\xd0\xecJ\xb2U!vn\x1c2\xd0(T7\x16+C\xb0d\xc5τ\xa4\xe0\x91\xb4\xe6DX\xf5t\xb3\xc0y\x9e\x143M\xa2\x8cE05\xd4U\xa1y)\xc9\"\xa5U\xd4<\xc5\xd7\x06\x0e\x1a\x9eE\x1a\xa7\xe9\xcc}\xdb(\xa7x\x14\xb6&\xa2(\x15\xc2\xcb\xe0\\DC\x1ft[\x14TR\uf1ae\xde*\xc1\xd2^/A\xafHm\xbc9\xf8\xc9J\xeaX\a\xe8C\x93\xd3d\x8eV2\x91ffγ\xa8\x96\xc3-\xd8\x13\x88\xfb\xe2\x13\x85\x87\x9a\xa32\xf8{\xbb\x81\x8b$\xfd\x90]\x81}+<sX{t\xb9\"\xb5\xd3*2\xd1\xc2\x12?\xf5\xd6\b\xc5Ri\xe2cM\x9b\xca'\x8f\x88R0\x10\tj\xb1\x9a\x8fc\xdc\x1eU\xe88E\xee\x9e\xc9T\xbdYU\xcc\u007f\xd1I\x94\xd5sd)&i\xacc7.\x17\xbb)\xc7\xe6u\v\xbb\x94\xdf\xd4U2\x97ܒ\x04[\x9a\a \xc4ԤZT\xcd\xf8\x9ac\x19LluP\x98\xdb\xe4\xcf-\x14\xcd\xc3\xe5Oa\xf4\n\x86\x85!\xf1\xd6q\xc4Z̕ky\n\xb1\x11\t\x1b\x99\x10I\x90H\xc1D\x12(\xda4\xfb\xe1\xd7H\x91\x03\xda|?L\xaeE\xd4^\xb3\xc8?=8\xe3U\x88\xda(\x96\x19\x03\x19^EPU\xbd`%BFh\b\"\x85\x81\x16\x10\x15\n\xedc\xa4\xf9nG\xf4\x9e\xa8\xb6\u007f\x8e\xfd\x1f\xad\xab0\v\x1eW=\xb07\xaa\x01\xbdQ\x90\x16\xb9\xd1\xc6(\xb8\xb1\v\xd6\xfbB\xef\x06\xa3\aL\xe6\xb7\xf1=*\xae\xfc\b%:\x81\xb9\xaa\xeb͓y\x8c\x97\x1aj\xd3\x14\u00ad\xf2\xb4>\x91\xd1\x01i\x19\xafKΖ\b\xb4\xe5\x0ef\x1a\xdb\xc5Rec\x06\xceT\x80\x1b\x85\xb8\x88\x194p?)\xaaH\xe1w\xbb_g\xd7~\x9e]\xd7\x1f.ɮ\xf9\x88\x8d\xa0\xe8|1\xe0K\xa6\xaa\x99mh\xf9@\xd2$Y\xbd\xba¯;@k\xc1H`\x90'\xde_:\u007f\xc1P6\xf0\x05˭%\xad\xf3\xf7\xdfv\xf6g]\xc8\xe9\xfc\x05/\x10 \xc4\x11A\xd8~\xaey\xfcS\xc8W\xbe\xf0\x91\xd2\xd7\xf1\xec\xc5z\xe9w\xde\xe6o\x17\xbf\x9dR\x1c/I1^C\xc0\xe43<^\x9eu\xf0\x8eK\xac^\xa8\xd0\xca\xfe唨1\xcbA\xa9\xa0[\xa5=cM\xab\xbe\xc9\xd1\b\x13\x9f\x96\xbe|\x1aH\xf5\x88~\bm2\x05\xe9?\aS9έ\x13gU\x16z\xa8\xa0B\x9a,@\xef\xd0\x15f\xd3PMLC\x02Qg\xf8\x9e\xdcǮ\xe18\xc3\xd5Y\x9b7.\xa7\x99\xec\x94AO/\xce(\x8a@v\r\xbf\xf4\x02\xf2\xb8\xbbv\x8fZ'\x83qpyG\xe1ʁ\u0530K\xccsu\x83\x1963\x81/\x8e\xb8\x03ev\x19\x9bw\xdf\xf9\xe1>\x12\x95~\x1a\x16%9\xf6\xa0\xb1@Rg\xdd\xc7{\xf1㷦\x8fa\x9c\xa5&{!\x01)\t\xa1\xbd\x8ef\xbd\xf8\xf1e\xbdU\x95\x13S\x8d\x0e\xb32\xa2H\x13\xa8\x8b\x80<j\x0f\x0e\xeb=\x8cvх\xa3\x17\x93\xbd=\f\xb4\xaa7\xad\xbe\xd7nד\xb8\xebi\x04'W\x19:y\xc0\"\xce\x13\x01-\x18m\xfb\xa1@\xbdkN(\xee\xc9\xc8>\x02\x9ax\xa2\xcbj\x94\x1c[g\f\x13j\xcd\xf0\xc0n\rw\x9d\xee\xe6\xd1\xde\xc6\x11\xb3e8\b\xeaT\x9a\xcd\xd3\xe2\bLT\x99]*\xffX\x17!\xa2\xa4X\x8d\x8b2\x87\x19ӿ\xc0&⨯\x14dG(ȿ\x04M\xb26\xd7d-^\xc7\x13\xd6>\xa6\xb0\xcd]r\x8d\x01\x96L\x95F\xddl\xa8\xbf\xe3\xed4\x83\xc6awQ'\x85\xae.\xd7$\xf6\xf8\xd6\x16\xec\xce8\x1e0\xf2\x84҃1,~\xe5\xac6\xf06\xc1\x82\x17\x1ax1#K+\x9d['d`\xed\x0e\xf8\x14v\x98\xb5\n3\x03\xf0\xdb\v\xc1Њ\xf6 ʖ\x02\xfe%\xddRs\x15\xc6\xc3X\xbaRP\x1aR\xac\xd5\xe9b v\xdf\x14qަ\xa3\xad)\xae\xb9\x17{{\x18\xa3\xaa\xee\b\x8dL\xe0:\xc2\x1a\xe3\xaaY\xbf\xa8)\xdei\xed\r'\x04sY\f\x85f2\x80\xa6\x16\x06\x03L\x9d\x1d\xf7\xb3\xf5\x85\xf3td\x16g\xef\xf0\xc69];/(\xf4\x04\xfa\x97\xb2\r\xd2pOV\xf8c\xd94q21\x1dq\xe1\x9b\xe3?^/~ʳe\x9c\x97\x1b\xe9\xd4U\x92\xa5\xf6\x84aN\xb5\xc43\x85\xf7Ƞ\xa2\xe5\",ƫ{\xc7WW}\xbe\xe0l\xdfk\x9d\x8b;\xa2\x94\x89\x96Eh~Z\xb9\xe6\xfdS\xdc\xd3\xff\xa4\x92{f\xc9\x0f\x9d&\xce\xd3ae\xe1gڬ*·\xabܟ\x9d\xccd>\x06^\x1d\xf44\x93\xecD\xac\xed5\u007f\x1b\x87\xc0\xa7\xd8 \xc0\"\xad\x0f\x1f\xf3\xb8\xcc7;c{\x96\xa5\xe22\xab\x81W\x85&\xc7\xc1\x93\xf5\xa7\f,\xee}\x8a7/\xc2*F\xb9\xe3 դ\f\xa3\xdeԈs/\xfa\xabR\xff.\xe0w\xa3Q`O>\x93\xca]\x89{I\x8f\x83\x90\xb6m!j\x92\xf1s\xbc卨\x9a\x8a\v\xec\xbcK\x16V\xde\x1e\xed$\xfd\x00\xa30\xf9\xa2tI$t\xa8H\xcc\x12^\xdes:\x0f\x94e\xc9J\x86?\xa6K\x85٪1X̎k\xaf[Z\xf7S\x96nf\xedr\xf0;5\x8d\xd6\xf5\u007f\\\xeb\xe8q}\xb3Y\x06B\xa3][\x86\xf6͘D\r\xfdr\xa29\x03ӷ|\x9aO>!Ȇ^\xfc\x13\xe2l\xe8\xc5\xf5\xe0)~\xfdaK-Ě\xb5\x02\xfdF\x1d<\xc3|]ҹ5\x9e)_v\xbd\xa7zN\x84\x17\xddE\x06\x9aHO\xf1'\x85\xb0\xfd\x86~\ry\xf3\x88\x927*y\x03\xc9\x1fDr\xa1\x92\x8bͰ\x16\x82\x82#G\x96\xe1b\xe4o(*¾l!\xce\\\xe1\xa2\xe8\xea \x1f\xba\xdaa\xf9Z\xb8\xc6\xe3i\xf5&\xa8\x97\xf4\xce\xea!\xb1\xf8\n\xcc\x04\xff)\xf0\x9fKL\xba܈wc\x91\xec\t\xfeS\xe0?\xd48jJ\xd4U\xde\x12ZȘH\\\x1er?GI3huI\r\x1d\xb3\x86*\xe2$1Vp9\xc4\xe0\x1b\xea\xe0\x1fW\xec\xcaӓ(\x190\xe7\xf0\x9c\x99~\x03\x81z\xd8\x0e\xf921\xfe\xcf\xdeO0\x9f\xd0\xd4Hg\xd1l\x88ZÞH\x03I\x1fUB\x91\x978\f\xb0\xc9d\xbc\x1cZ\xc1bôW\x92'\x1b\x03\v\xc7o\xe2\xf0\xc1\x16\x16\xb5þ1pm*\\\x97T\x8b`A\x15[\xebrS%\x17Urd\xdcS\xf5\x1c\xf1\x8e\x1ay\xc3㮕7C\x837\xc3V\xde\f\r\xde\f5\xde\x18X8\xde\xd4\x16\xde\x18\xb86C\x8d7Cb\u0090x3\xacxS%\x17U\xf2\ry\xe3\xce\xdeM'\xc55\xb7\xee\x84OS\x9bU\x98\x90MX\xb0\x88\x9eP>\xb4\x89\x1aq\xbey\x01;i h\xfe\x0eWD\x80\x8eb\n\xf9\xb3<\x89\xec\xdd\xf0\x01\xab\xaf\x81q\xa0\x84\vus cQ\x9fbT;n\x1c\x9c\x92G\x93wv\xec\b\xf3\xb7\xa6\x1d\x01cC`ͮY\xba\xbf\xe8\xa65$.{z\xf5\xec\x02\x06\x8e֙x\xfe\xee\x1c\xba\xfbٳ\xff\xee'\xb8\x9b0\x82\xff\x0e\xba\xd5\x153\n=\xac9]\x83\xb6\xc3Mh\x11B\x02j\xba:M\xa4\xdf3N\xb2U\xe8\xd21}\xc9<\x01*\xb7\xf9'\x06h\xe4\x02=\x10\x9f\xb1\x01:5@坐)_\x9c\xba\x83\xbb,=/dg(\x95\x16s\xdaco\xd0(\vK6\x1e\xbe_\x14\x18jf\xc9\r\xb6\xc3a\x8f\xf9\xc8\"$\xb7\x98;\xea堐\xae\xe5=\xc2|\xed\xfb\x8ezo(l\n\x95m\xd6,<l\xfd\x89\n\xeel\xc8#\x8dV~\xe8e\xa4\xe29\xad\x0f\xc8n\xa3\x9f\x87h\xaeq$|\x86\xc5k\x87\x9b\x91\x88\xf8\x04?\x19\x96~2,\xfe\\\x0f\x0f8\xe0!\xcc\x13\x18^|D\x11HG\xf8\x89Q\xd3\x0f)\xf7P\xe4n\bx\x83\xc0\x1b\xfc$\xe0\r\x02\xe3D\xb8!\xe0͡\xc8]\xd13A\x88\xe1\x0e\x15\xec\x11\xc0\x1d\xac\x91\xdc\xd1\xc4\u05c8\xaaf\xa8\xd1\xd0\x1c\xbb\xa7H\xd8\x1eb\"@j\x99\xf8ĺ\x9d\xf7Ю\xb2<[d\xc9;\xb0\xf1\x8a8%5\x83\xafq'\x134\x8c\xedS\x16\xe4웤\xa4\xbb\rrL\xa5\xa0G\x8e<YƼWte\xed\xb2;\xae\x15\xd1\xee֑\x97\xe8\x17\x8a\xe0\xfb\n{FK\xda`\xd2\xf0\xccu\xbb\xa8\x8f\x97ߪ:uI0\\'\xf0\xb0\v}\x18\x9fx=\xf4K\u0084\xc7\"\x81\xbf\xd7\x04\xb0\xae\x00\xd6\x04\xb0\x16\x00\x95R\t\xba\xea^BYfs\xe4\b\x87\r\xd5U=\xed\xa5E\xb3\xf8%\xf0\xa7ƮSո4\x9e\x96/\x16\x11\x97\x97\x899\xfa\xa2\xd4R\xf1BǑ;.\xad\xac\xa8/\xd0A\x85*i\x82SC\xfc]\x98N1I\xd8䝴\xa3\xed\x88)XY\xf1\xa7\x96\x17Փ\x83r\riK}\xfdT/ \xb0\xb8\xf0\xe3?\xab\x05\xbf\xd1a\xc3w\xebx\xab\xd5UW9a\xeb\xad\xd1\xd8\x19Gx\x05\x15\xf5\xd9\x16\x89\x8d\xa9\x1c\x81\xeb\xd7\xe3\xa0\xe0\x11\xfd\xab\xa7\xe2\xb0@\xf1ֻ\x91z\xbd\x9e\x94k\"dJx\xd5\xff\xe84\xaf\x91\x9c\xc2\x1csn\x90{\x1e\x13'S-\x98\xfa9\xbb\x8b\xa7\xda\xed\x1a\x8f\xbe\b\xf2<6\x13\x8d\xa4\x8f\xba\x90~\x83\xabF\xad\xf2\xa5\xbdw{\x1e\xb7\xf6a\x94Y\xc7ZT\x95N\xd4Gi3@\xdem\x87\xfc\xe0>$\xee\xf6p\u008fS\x80\xebz\xcb \xd0\xdb\xcaH\xd3\xdaַ\xa3QQ\x9cjͩ\xf3Q0C\xa7\x10\xbf\x04\xdb\xf4:\xe0\v\xc5\xc12\x05?\x1a\xa3\xb9\xb1*E`U\x91\xc1\xf7\x1d\n\xa6\u0382\xaf\xe3\x19\x14ڡ8\xd3\xceă\xae#\x05߯T\x1b\xa8>\x01\xd4\xcf\t\xe5)|\xa2\xa0\x9f\xb9[\xfa\xe5\xeaզ\xabl\xb9\xcc\n\x00R\xb4\xd8/\x0eVJ\x18EFS\xc0c\xba9\xa3\x91W\xd0\x1d([\x9a\x17\xf1\xf5\v\xeeӿ\x1b\xc74\xdc6\xf3H\n\xe4\b\x90\x18I0\x9a\x8b\x91~>\xa5g\xc7K\a\x06[\x1f\xd45\x82q\xeb\xe5ؠ\x96\xfc;\x8a\x11y\x18\x16C\xe5\xb2\x0f)\x1bN\xc1\x8b\x80\x04\x14\xe1\xa46@\xef\xe050\xb8\x17\xad1\x83\x9e\x88\xdfȌ\rel\xaa\x1a\x04/\xfah\x85\"v\xe9\xfd\x8ex\xd9\xcd}\x8f\xbcPL\xc7~\xb4\xe9\xf4X*\x14\x90\x89\xaa\x0f\xa3\x8d\xe5\xcf)*\b\xed\xab_2c\xec\tǔh\xedʞx\xfb'F\x96\xe3\x1c]G\xe5\xac#\xf4\x84\u05cc\xdet\xbb\x8e\x8d\xfb\x1c\x8e\x8f\xe2\x19ҡ\xb7\x85\xad\xaa\xcf6)H]\x9c\xd6\xc4.F\x13\x112\xfb<\x1d\xc5#\xfa\x1cѧ\xc9\xca\xdb1\xbdJ{;\xa6\xab^\xf1P\f\x12\xd4ZPd\xa4>\x03K\x80[\xbc\x06\xaa(\xa9\x80\x0e\xadF@\x83\xd7\"\xe0k\xcc_\xa1IC\xed\xa9\x12\xfb\xb8v\x87\xea\xd6\xfc\xb8\x83\x0f\x95L\xb4*G\xfc\x055\xd3f\x14ز\x12N\xe5\x84L\x9a(\x15\n\xb8x\x98s\x03$\x03\x90\x8b\x944ғ\xc0\x86\xb1\x18\n\x05\xc9F\x04h\xf8K<\xc5\x1f\xc4M\xfc\x01\xb3\x0f\xa6\xac\x05\xc8\xda:\xf6M\xb9\xe3tщ\x89\x90V\xa9\x14\xc5F\xb5b\xa3&~\x89ɏ4\xe4\ts\x0f\xc3X\xaa\xb6\xf5-_\b\x1d\x1e\x9b\x90\x92\xbe\xe4P\xd4i\x87D\xa8\r$\xefܨG\x1d\xf6\x12z\x88%f\xc4\x00t\x14\x83\xa4\x16[G\x90d\xcc\"8\xd9\xdbÅ\xe7b\x1e,e\xb6,\x98+\xb1\xd6\xd3&\xbf\x96d\xd4\v\xc8-\xac\x12S\x8bd\x95\x93\x0fæc\xe5\xdbm\xc8\x1a\x98:hBFʩ~\x9b\x95F놞U#=/\x9b\x857\xfa\xd7K\xd1\xda*\x19T\xfd4,\x84\xd7p8ήb}\xab\xa4\x89pԘ\x8f`b\x00Aq\xe4<>\xf1j\x1e\x1a\x12\xb5\xa8\f)$\t\x1d\xd3\x04\xb1\xdczUT\x96_\xb2k%\xcd1T\x17\xfc;\xa9{\x9c\b\x02\xeb.7\x12\xcfm\xfa\xd1\xecE#c\x8b#T\x1d\x8bh\xc5p\xf7\xcbǷ\xb1\x88\x8d\x88g\xe9\x82\xfc\x11Dw\xa0\xfa\x92\x82\x9a\xf6\xd7\xc7N&0\xdb|\xecO\xe2\x1e\x85_\xa0ߛ%*]\xc4yG$ћE#\x01\xb7OY{\x02\x01\xfc\x1b|\x01\xd6}l\xd3m\xd8\xe4\r\xe9\xb7\xfe\x84ڊS\xca\x12Ű\x1c*\x99݀\".G.Y-i{$\xd5\xe5Z'D\x92X\xe2\x8e,\xfc\xf3\x18\xd1\xdc\xc1\u007f\xf6\xb0(\xfc:hQP\xd6\xd8\x06\xb3\x87\xf1\x1dYMV\n)^Dt\xfa\xe6\\1\u2fe5!;d\xb6\xc6\xcbS\xb2M\xd1lJ\xca\xda\xfc-@\\V-\x9b\xb4fd\b\xc3i\x15\v\xd7\x17\xb7rOW\xb7n\xbb^ikM\xb6\x16\x99ߕ\xad\xc8*Dg\xb2\xe0UuJT\xb7\xf1\x1a\xadn\x8am\xfb_W\xf1*n\xd9\xfc\xa8\xadh\xcf\x05+\xbb^FQ̬5\x19/\xa7k서\rƤ\x14\x19\xe4x\x9d\x19Qڭg\xd4A\xff\xa5\xbc-S\x91I{\v\x90N\x01k\x8b\xd28\xdfL\x1d\x01\x18x\x91\xb0.\x05\xbcu\xcd\x04{X\x91\xf5\x98\x00\xc5\a\xe8ъ\xde\x13=\aT\xaaP\f\xa2\x007\u05f64<\x87oR\x83&\xady\x14~t\x8c\bj\xad\x88X\x9bP\xc4\xdas\x97h\xb5\xac\x96k\xbc-\x1a9[\x98|\xc56\xa7\x14\r\xfb6m<\x88\x80n\x1aq\x85FڰNV<_\x96\x9b\xa3\xd6ǚ,B\xe4\rA:K\xa8\xe1C\xbeS\x81/\xd0X+\\\x9d\x14\x13n\xff\xc9\tm\xe5\b\x9aS\xf92`\xdbrõ\xd70O\x16\r\xdc s)\x8d\xe7uj\xb5\xd7Ӛ\f8,\xa8\xc4Ѳ\xe4(\x8f$\xb8Ş\x03\xb6\xe6\xe1\xa4\xfc\xa1\x91\xbc\x86β^\xe35Ԍ\xda \u008dQ\tS\xdbE8\x11Iutb\x99\xdf\b\xd5\xc5\xdc\xef\x17%\x9exU%\xd21\xee4\xe6\xf4o*>\xf8\x8bw\x162\r\x16\xc8 \xb3\x14\x9f_\x9a/\xe9\xa1ܫ*\x17\r\xda\xf9\x96ò\xdb\x1aCH\xd6}\xe3\u052c\"\xd0\xecV<\xf0\x0e\\gh\xb2\xbdx3\xaf\x8e\x1a\x95\x93\x80\xa0\xa5P\x85\x9eWDU\x1ei.-\x13\xf0\xc9̵Ypm\x10\x8c\f\xb3\xb7\t\xbf\xe1\x18P\\\\\xb3Rr\v\x96\xccO\x1f1\x18\x91{\xca\x1a\f\xef@ِ\xd8ɴ}\xc8\xfd\xe4S\xe7\xd4k\x95\xbdز\x05\x1e7\xee\u007f\xf3\x84槆<\xe8/\x13K\x1aԞ@3,\xbdlf\xaa\v\xad\xc7@\x1f\xdb\r4\xf2\rJ@\xee\xa8Vu滬Z\xeet\x8c\x15\xdddJ\xf4\r\xb8\x93\xff^ܑP<\xe2v推\xa9*}c\x0e\xb4)\x06\xebty\x87q*\xc5^\x03R\nQwMN]\xe3\xc3\xee\xe7\x9d\xc6E\xee\x86\xca[GO\xb5ml\xa3+\xe9]\x03\xe70\xb3P^\xd1a\xc3\"\x12\xd3E\x95A\xfc\x97f4\xe1\x17\xebv\xfa\xc9f\xb4\xfĕ\t\x9fW\xf0\xb9\x1b^\x11\xe7\x18\x1a\xd6\xc0\xb1H6JZyK\x9c0a\xb4\x9b\x92\a|\xa3\xe3C`K\xdf\xda\xcbDM\x8fC'+\xf5\x91Ɍ.3c\xf5\xcbL\xc5\x02\xc6\xd5c\xaa1︤ҩ\xc3\x00͍\xf5\xd7r\xde6@\x1bG\xa8\xd9'q\xd7s-^\x96\xf33\xb3g\\Z\xefs\xd4\xde.z\xafY\xf1\x01\xcf\xdcC\xbe]\xfd\xfc\xfe\xfa\xa7\x81\x90\x9a%oX\xf1zp\x032ES\xe78\xb7\xcf\xe1\x82c\xd6A\xaes\xb5c/m\xd6(\xdaEp\xb9\xf6TC\xb7ݩs\xfe\x0e\x03\x88\xe3U\xdaW\xf4\xe8\x8a\xfb./Fg?\xa2\xeb<]\xedi\x89B_%.y\xddm\x1e\xb0\xb7VHq\x0f\xfc\xa9\xbc\xd3,\xdd\x11\xd7\xf8\x84\xfc\xc8x\xfbf\xea;AL'\xb5\x82C\x89\xe1\xee\xcaz\xc4\xc7\x1a\xe8LHq\xc40q\xa3\x12\xed\xfb\xf7\xd4\x16Wh\x04\xf2\x89i$[\x83\xaaH+\xd6Xi\xe0\xc46\xdc\t\x1b:\xb0 \x16\xd9\xd0&l\xa3\x9d\xb0\x8d\x04m\xc5F`\x1d\xb9\xb1\x1d\xec\x84\xed\xe0L\xb6Pu\u008e\xd2źh\xd9|W|\xa9\xf9\x92l\xf0S\xf8\x918\xe5\xa8L\xe6\xf1\xbbU9Q\xf2\x8a\xef\xc3\xf5߉\v\xae\xcf\xc9\r\xcfz\x84\x10\xe3[zO(\xaf\xff\xdf~~\xd6\xf0\xae\x1cz\x94ȏ&\x0f'\xaa\x9c\xef\xff<\x1d\x8f1\x88\x04ַ\b\xe7\xf6\xc5\x1dL\xd2nr\xc12\xf4\xa0\x19!_j\x8e\xe8=X\x9cl\xf8eX.ڭ\x9eO'wL\xd2T\x9a\x9f\xb7\x84W!#\xe6\xf4\x9c\x0e\x16u=\x8ac\xc5\xe3@9\xb8\xc0\xcd߹Z\xd9VQc&:z\xfd\xc6\xe0ޞ)H\xb8\xd88\xb8\xaf\xebڥxGW\xb5./N\rT\x02\x8d\xf5\xce\xc5\xed%.b(\xb2\xe5R\xf0\xa3\xe2B\xc0;\xa152\x8d+\x89\xb7U\xe3\xf5ۥxc\xac\xbe\x94\xed\ro\x1aW^\xeb\xfe\xd7\xdc\xed\xf6\xed'\xfd\x82\xf6\u007f\xf8O\x8e\xf0j\x12\xc1\x91\xdf\x15\xc5\b\u007f\xbf\xcaJy\u05fc\xf3[G\xbc\x1d\a6y\xd2\xd9&s/\xb3\xecr\xb54+\xa6[\x90\xe1R\xc8?\xc0\xff\x10.͇ݸ~\x870\xa8\xf0,P\xbe_\xc4%#\xc6;>e\xf62\xbb\x8e\xf3g!\xbe.\x8e>b\x86\x1b\x1b\x80\xb7\xcb\xf2\u007f\x8f\xe3\xcb(\xdc\xf0\x10\xb1:2\xd1.=Q\t\x05\xf8\xday?\x916\x014q\xd2\xc1\xe9Ƨ\xe8\xefj\xb0%\x81I\xee\x02\xc6~\x82\x91\x13\x16\xd5s\nx\x82>ܩ\x11;\x90\xbf3\xe1_\x9a\xe4\x1f\xb2Ey\xbe\x1b\xd7\xe7\x15\xe8n\xe4\x9b\x05vo\x06\x11ҟ\xbb1\xb1@\xd33\xb8\xd4RC\xeeP\xf0,\x1e\x04\xbb2a\xa7\xe6ߠ\xe1\xbfG\x93\xbfDc_f\xf8\xb8\xe3\xb7\x18\x8f\xa5\xa1\xc5\xd5\x1b\x12\xb5\xb9\xc6T.E\u007f\x02$\x89Kg\x81\x8ei\x17\x12h\xe2\xfd|\x12֟A\xc2\xcf\xf0\xfd;\x90\xf0\xd7O\"\x01\xbb\xe0\xd78̷\t\x1e\a\x1f\xdaM\xf2\x14l\x83\xe8\x91\xc8x\x87\x8d\x12\x88\x06\xf0\xdeB\xdc\f\xbb\xb9l\xfdc\x9a3jm\x8e\xc4\x15\xaf\x97\xe1\"\"\n\xa9\x857\x1f>\x1a\x86\xdau4r\x97\x02\xab\xf1\xde\x03t-~8\x18`\x98\xc4x\x9b\x05G\x8a\xe7\x155\xf2\x9f\x89m\xa8\x87\x16\xec\xb9?\xfc4Ax\xbe}\x16\xfc#\x1b\x14}\x9eX\u007f\x97\xad\xf2\xd1\xe1?S\x83\xbe\xfb\xbc\x06\xfd\x90,Ve\\\xfc3\xb5\xe8\x87\xcfkћx\x92\xe1M\xee\u007f\xa2\x16\xbd\xf9\xdc>JS\xdck\xfa\xc74렱Y/?\xafYO\xe7?͛\x9ac\x93\x1a\x02\xacf\x025\xd0z\x02}`YF\x16\xe9\x95C2\xdd\x02\xe1v\xd0\xfa\xa7u\x96\xfe\x90-\xb4{\xcbH\x1c\xdeٍ\x90\x164\x1c0\x9b\x1ff\xc3Y\xff\x03\x9e\x19\u007f\x10\xfe\xb6\x9d^\a\xea\xe9\xec\xc1\x1a\xed\xc39\xa4\xff\xe7\u007fV~\x95\x1fЩ\xf1\xde\x00\x8b\xcc\xd5\x1dWN\xff\x1a\xd2\r\xd2?\x14\xfcL=\x933\x05\x89\x18\xf9\x1f\xce\x03G\xe2|\xdbB\xf0\xfb\"{\x15\x96\xc9\x15\xdb0\xf6\xf4E\x17;\xb3\xef\xdf\xfc(͘Fl\xb4\x91z\x15\xa6~\x8a\x16\x14vb\x8c\xe7.\x1c Ѿ_\x9d\xd31\xa0^\xa1\xf0V\xc1K\xa9\x84\x803\xc1\xa4B\xf7!v\xf0\xf0\xa3\x81\xee\x17\xa0\x91\x88\x8eR\x03t\x90\x1aR`oHx\x82\tG\x90\xe0\f\xbcF\xaf\x9b\x99\xb5#\xb9\x94\xa4\b\x10k\xe0R\x9a\x828\xe9\xa1m\xed\xa4\xc0Y\x8d$\x9b\x84\xfa\xd2]\x97^˞h\xf3\xe5\xce5\xf0\x8b\x81%\x06\xe9\xc1\xeb\xd6\xd6UuD\x8a\xd7ݰ\xb5%\n\x16&\x14F\xd8\x1d\xbam]\x9a\xaf\xb6TG\xd4X\xfe\x11\xa0\xae;2\xdc\xf6\xb9g\t\x06\x05\x14\xea\x0e\x18\xbfx\xeeW\xee\xa0\xed\x11\x84\xb1\x85O\xed\xc7\xe4\xae\x11\x88\xaaqS\xbcNNKE]7z+z\x0f!hf)\xed\n\xbaت\xbf\f\xae\u052c\xa6\x99\xa0\x9c\xe9\xad\x0f\tf/\x9b \xf0A\x1b\x8e\xe5\xa0\xe6\x96\xc1}\v\x00\x8a\x8aj\x9bj\x9a,\xc24u\x93\xf2<\xac\xfc\xa5\xf4\xdd(\x92\xea\xfe4\xcd輈\xbe\x8e\xb5\f\x1a\x92\x14zn%\xaf\xf2p\x06\x8a\x8f\x90\"=\x99\x85[\rN\x03\x95\x88\x9e\x9aW\x11N+V0\b\xff\xb6u\a\xb1\x9d\x00D[\x91=\x92b\xc57L\x94\xd4:1P\xa6\x86A\xb4\xc0\t\x8by\x1a\xa8j\x95\x13X8\xaaid\xe8-\xadzVz\xf6H\x1e\xb7*L\xc2<\x8f\xcb\xf3,j\n\xd9T\xd3 _J\f\xa3P\xf7\xb5\x93{tD\x1bK\xe2e\xd0\u007f\xf7\xc9b\xd8Ȋ\x02\xd7\xdc\xf8\x12,,\xa1\xba\xa2\xbe\xa2+\xe2c\xd6&\x0f\x86^\xd7\"Xqy\xdf|\xa4\x8b\x80\xfb\xc9\x02O\xa8\xf5\xd8\x04\xf5\xe2\x061\xa4Q\x18\xa1(\f%\xcc\xc8\\\x8c9\xca\xe6!\xbd\xcb\xe5\xc2̾\xc6\xd6\x11B`\xd2+0\xf8\x81\xd8ֵ\x88P\x83ބ^\xdbS\x04\x15pзH\xccx\x15\xf3Z\xbb\xf5v`\xfd\xf4\xfd\x0e\xcb\xf9FV\xd0m\xf5\xe6\x9aWWf]|*\x93ɥ\x11\x8ern\b4\xf9H\xadK~R\xde`\xc2\vJ\xb5H1\x0e\r0^V6\xf5\xe6\x14+\xae#+\xe8\xd8\x0eq\x05,\xd2\xd1A\x99\xf0\xf1ut\xf1\x1bMV\x15\xbd\x82\xc0\xf6\xbdyW\x84\\\x90\xc7\xef\x06Qo`\x8a)d!\xeb\xa48A\xb3\xb2\x0em\xf7\xbe\x90\xf2\xfe\x06e\x96\t\x01ѷp\xddNli!>B/n\x91\x16\xed\xce\x0e>\xe0*Z\xb7\xef\xa0\xeb4\x11OC<\xf2\x14\xb8\v\xea\fJ\x8b\xf6\x06^\xaf\xa7\xbd\xab\x8b֪h\x8d\xe1\x19{I\xb1\x11u\xbfƹ\x8c\x96\xa8\xcd\f\xb5\xa9y\xeek\xddR\xcd\xeeU\xbfa\xf8J\xed\x1d\x1c[ʾ\xad\xc5ૉ<+\x16G\xf9I\xb6ܴ\x96ti,*\x85\xc3\xc3V\\\xce@~\xeamWB\xd1\xf5\xa4\xd6\xeb\x10O:\xf2\xc7k\x9c\xc3\xe8(\xa7\n\nB\xaf\xbe\xa6\xe1|\xd9r\xba\xa3\x8f\x19\x1e-F(y\f\xe8\x81bM9\xc4\xe1\x82=SD\n\xff\x91\xc7\x04=ϊ&\xc1\xe5\x1fq\xa1'\xde)'\b$\xf8\xae\xc5)\xfd\xec\n\xc0\xb3v2\x9f\x1bᕴc/\x91\xd1^\x9a\xfbY\x84P,ڦ\xce\xfa\xf3Lr\x83\xb6j'\xbf*,\xd251\x16\xb6\xe7\xed)H\x1e#\v\fH\x18\tg\x96*\x9e\x02_\x19\xf4x\x97y\xefM\\\xd2֡\xf1dv\xa4\x1fBo\xc8S[ڲ\x11\x9e\xaf\xa9=⍹\xa6\x8d\xda\xeb\xfawQ\x97\xb1x\xdd\xc8ūB\x8a뮁\xad\x88+:Ū̝\xabE\x97\x95O\x87\xf3\x1e(\xae\xd1(\xba\x05\xaf\xd2\x06[\xba\xf7\xbf\xfd\xfcl;g\xe49\xbcb\x91\xce#H\xffD6A\xc9vN騝\xcc2\xa8w\xf1K\a\xb89\xcbhn\xa0\xc6/0 \xb9\xfc\xe5R]\x02\xe3\x9eZ(1\x12\xfc\xa7\xb2\"\xa3lB6\x8a\xf0\x1e{\x91\xc6\xf8\xe5w\xa2\xe4\xaa\x13\xf4\x8br\x03\xba\x118\xaa\x02Xv\xb2e8I\xcaM\x87\xd8\xde\x11\xfaț\x84\xe5\xe4\xdc\xf3\xe3<\xaf\x1e\x15\xa0>\x03\xab\x02q\xbc[\xe6Y\x99\xe1|\r\x94>{\xf3\xe6\r&>\x8fA\xa7\xf1sC}\x95߭\xcah\xf52\x13-T:a\xc7r\xf7\xab\x05Fg\x12\x9eR\x8b\x97\xd2\xf1%\x8f$ˡQF0\xc8\x1a\r}\xf4\x8d\x12\x1e\x1fZq<z\xefh8\xac\xa1\x1f\x1d\xa8\xab1`L\x16t\xb1\xbc3\xea\x0f\a\xfd\x83\x8e\xec\x0e\xc1)zў[J?\xdf\xe0\x95\b\xab\xbbD\x96\xaf\xfaM\xfe\x10=Ǐ\x81\xbc\xa2g\xa5q\xbeE\xdf$\f/jvS`\xa1\xd3*}\x06S\x9a\xa4]\xa7\xeb\xcdj\f\xbdU\xf0&B\xff\x1ds\xf8\xdd;\x98\v\xaa\xa0,\b\x87\x9c\x10\xbcW\xcfq\xd0\xfb\xfeU\x91\x93\n\x84\xc9:ڎC]\xc8X\xca\xce\x04\xdb[\x83\xa2r\xa72\xf3L\xaf\xa3JU\xf2O\xef\xa5-uy0c\xe3\xba\xfc!\x8eM:.c\"A\x96\x93\xde\x0f\x90\xdc\x15\x89\xa7\xf0\xfb\xcc\xed\xf4\xc04\xbc#\x86\xfa\xd2݂\xab>\x0f\v\x8d\x1d\x80\xc2e\x89\x00\x1e|7q\x9a\xe0--A\t\n\xa6|P\x8d\xfe\xd0sݭ\x98\xb0ȩ\x03ݙ\x81\xa7\xb0\xf1\b\xc1\xdf\x1d\x1dƮ\xd2B\x02w\x05K\xe6\xd9U\xdcD!6\xea\xc4\xd5Tk\xca\xd5\x1aO\xcf;\xf0\xf3\x92DI\xad!\x90P8/\xa6\x88\xfe47\xc8\xc8%L\xbe\xb8\xe9&\x92Q\xf2n\x14f؏I\xe8T\x9a}C\xech&\x86\xb3w#\xa7\xd6\x1b\x12;\xd3ř\x8d\x941\xa8A\x1bh\x11\f\x9d\xdbH\x9c\xc8\xffd\xeaDy&O\xdf^\x04\xf0#\xfcǈ\xad\x83\xa5\x8f\xf8O\xb5_\xd8\xdc\x1e\x81\xdch\x90\xa0Kk\xd0\xd4\x11\xedU\x93$\xfbA\x04Ȳ¼\x9f\x9cX\xc2\xf9,\x8b\xack\xc5S}\xbe@\f\xd5)\x05\xaeb\x94\x88\x06mo\x9bq\xfb\x84\x1eֆ\x02,w\xdf\x0e:\xdd:\r\xf6\xa0\xa9\xbf\xb8\x01\xba\x8f\xf8@[k\x00+>D\x16/Q\x8c\xd7#h\xe9\a\x86=E\x19\xb4#\x13\n\xf70{\xabC.\x89ܮb\x8c\xf2\x94A\xf4\xd2`~\x9f1Ub\xa9dV.\xff\x8a\x92\x96r\xd5¿\x8aƄ\xc5$^\xe0K:z{\\/\xaeaL0\x15\x11\f\xe3\x81\xc9pa\xe8\u0588\x1fx\xa4\xf0*|\xa5#\x87\x19v7\xecx\xfb:\x94\xd8\xf1\x82{(\xb0\xd3\xc5\xfaЍ}\x1e\x87\xc6ޒ\x98\x17\xa7\xf6\xc1\x18ϭ\x92\xe9!\xbbn\x0e*\u07fd\x8b\xea؏B\xb3\xd8^\xadV\xc0\x01\xbb\xa7DTs\xb1\xe5\x1f\xca\n1\xd6/\xccyx\xd2\xe6c\x80\x8e9ڪ{{\x17\xce\aP\xb7\xe3\x14CE\xb4Qր'qm\xb5\x18\x9e\x96\xc0\xc59p\x11\x96\xce \xf5\x8b82y\x89aA۸\xe9d\r\x1d\x8dH\xf3\x88\xf9Lq\xef\xe4\xa3_F\xce4I\xf1\x01>\xd5.S4\xf5^\xa27;\xfb\xefW\xe1\xa2\x04\xbe0)\x1c\xddN\x97X\xd0\x10\xfd\xbbAc\x8b\x92\xad¡;oքd\xfc\xa9\x12AW\nM9Pg\xa9\xbf\xfd\x06\xc2|\x1br\xa0\xd3\x10\u00a0\xbcI\x10\xfc\xb1\x81\xeb\xb6\xc0\x05\xd5\xd08dL\xe3\x1d䪢\xacE\x9a~\aZ۰\xb7So\b\x84i\x8a\xae\xff\x97\xefM\xd2{\xff\xb2\xbd頾\xb17\xd5f\xf6gthW\xbe\x8e\xff9}:٩_'\x9f\u05f7\xb6\xa9T\x97\xfb\xca\xc3\xff\x11\xe6L\xf4\x9c\x8f7\x91\x84\xc9?\x834\xfc.\xed5ùB\x87O<cm\x9a\x87\x8bH\vg\xb7\xc0\x1dG=0\xc3\xff\xfc\x1f]\xef\xff\xfb\xbfM\xab\xdce\x85\xe9g%\v g\x14@1=̍\xcc\x00i\xfa\x9f\xffC\x8f\x13do\xa6\xdaG*\xfc\"}\xde\x18\xf8q-\xfdX\xb8)>^\x9d\x1a\x99\x11\x99\xbd\xea%\xf76\xa0\x9cb9kq\xeb\x1d\xa1$oS\xc0\x8d\x9cf\xec\xda!&4l\x0f\x9b}\x87\xd0TaN\xe8\x99\x18u\xf0\x91\xa3mQ\xddP\xfch, \x00\xe0\xd5\x1f\xdf\a\x14\aLJ\x82\x92\x8a>K\x83\x1f\xec\xd2Uzl\x97x\xbd\xf4+^H\x967\xb48ɯ\x93\xc5w0\x18\x8e\xda\x0e\x10\x9du\xaa\x05U\xc1\x96([\xa0\xc6S\x19\x05\x9atF\xcf\xd7z\xad\xc0\x938\x9b6m\x88\x14\xab\xf96\x15[h\x0fY\xd8\x1aV\u007f\xda\xfaS\xed\xe2\xdbI\x01F;\xa9\xa6=\xcd*\xa6ƅ;[\xc2:\x96V;\xd8\xc0k\x1c\xcd\xe8\xaaCڕ:sx\x8d\xaf\x05VC\ue83f\xa8\xc8\xd1\x0eCp\b.\xf9\r\xb5s9:\xc9\r\xc3\xff\x0e,\xd2+\xb9\x91S\x9c\xf2\x11Q\x97\xee*\u007f\a\xbf\xcf\r{\x17ݰ\xae0,\x15ƫ\x92%\xf0\xdc\xf5\nM\xda+\x9d\xe0\x12D\xa0Xf\xe6\xf3\x8f\xf3\x10\x16\xca\xeb\x9a\xdf}\xffC\xb2\x14\x17\xef\xa2\x03X\xea0\x94\x8e\r\x00\\[\xe1\xc2q\xc91<\x03u\xcc*73\x8c\xd0/\xf29H\xb6\xb9+1\xa1\x957T\xf6\x92\x91t=\xf8]\x88\xed§\xb4A;\x0f(\x12\fK\xbdc\xbbA>6\xb9\xa0\xb2\xe8)\x98\xf0a\xab\x8edAH.\xf8\xf1\x15}\x90\x01\xf0\xe9ř\xb1t\xbe8\xd3\x0e\xaf\x1c\x13\r\xe2\xd7Y\xa5]!\xaf\xef\x8b\xd4\xee\xef\x1a!ja\x00\xc1\x04\x90f $\xb5\xf75k#\xe9\x91w\x10\x00\xac\x19\x85\xae\x01\xf20\x00\x8c\xfa\xc33\xb6'\x18\xe0ydU*vk\x93\x88\\\x8c@\xe8\x00\xc3\xe3Ǐ\xcdY\x84ހ\x13\xa3\vߪ\x01\xf03|@\x96BZ\xae\x05}\x88\x03_\b\xe0AK\x84@RKp\x9e4\xdb\x1a\x06\xf8_\x93U\x18\xdd\xc5ͮ\xa0\xe2\v\xb3\xc9\xe0\xdcN\xac\xd2\xceG\xb4\xcd-M\x1c5\xe1\xac\xf6\x12k\xf7+\x8e\xd5\xf6\x98\x82Ǉ\xf9y\xfbH\x96\xa7\x9b\xf1\x06\x8c\x8e\xff5v\x96U\x80:P\xee\x93%y\xb1\x93%\xef\x9ef\x94\xbd\x8c\xa7\xed;,ҦL\x9b\xda\x03po\x9e\xe9.Fք\xd1\xf5L\x1b\xfd\xb1\x19\xf5/\xdcb\x8a\x1a˘4\xfc\x97n\xfa\xa3\x93\xcfh\xfb\".\x9cn$\xea\xcc\x18\xf7\x81D\xcdQ\xbcԟ\x8d\xa5\xcd-L½=:\x1d\xb0\xbc\u007f\xf2,MW\xe8B\xc1?\xb8\x15X\xa1\x98\xf0q\x82\xc4ݠ_\xf8\b\xe0\x89\xa7m\x10U\xe9|\xd1\x1c2\x8e-\xff\x06\xf7\x9a\x92OS\x90\x9cS\"no\xef\x8c\x12\u007f\xe1\xf3O>\xaf\x12[\xf5\xc57\x9b\xffB\xe0\xfaU`iB\xf0S\xc9M\v)S\xbd\xa9C\f\r-y\xedˊ\x99$q\xf4fl-\xdaJ\xab:\xce\x10\xe7m\xc7\xed!)\xab\xfa\n\xad\xbe.,\xb6Deͻ\xeefqב\x86\xc0u%:\xa2\xaa9;\x95\xb983\xa3\x8cH\xb6\xb1\x904\x1eZdN\u007fdq\x94\x01\xc6\xd0\xf2\xe6b6\x0f\x97\xbah\x84\xe2aW\x94 \xee]\xf1\xcb\x12\x88c\xddL\x11'\"\x80\xca\x18I78\xb8)\x8e\xf4V\xd0q\x87l\x8a\xeb0Gk\x9c \x0fVֻ\xbd\xfc!\xe0\xfd\xb0O\x87N\xe3~\xeby\\h\xbfs&F<>\x83\xab\x0e\x02\x15\xb7\xf4\xcf_D\x8f\xf2\xe8e4X\xd8>O\x16c\xd9Z0izc`:\x89\x11\x8e\xeadm\v\x1e\x8d\xa9\x15>7N\x1e\xfa\xf5iT?\xbb\x8cl\xfe`\xc9:*\x8d\x1dչy\x1e\xc5Z\xf4q%U\x9aL\xd2J\x01\xdd\xc5\xf3\xfa\x03\xee\xcd\x15\xfd\"\xb5G[U\nhw\xdcB\xff\x9e8\x0f\x03\xab\xccm\xb8\xact9s\\Z\xec\xd1Ǝ\xe3\x90\xd9>{$hב\xb2u\x8c,k\xbb\xaa\xf1Ȫ\xafv\x8e\xec\xacQ\u05efr\x98\x9a\x15W\xa7\xc4j\x97\xb3.\xaaV\xdd\xf5cbg\xe5\xce\xd3`[\xa1\x88S`I\xdc-}P\xd7\x0f~%\x8d\xcb8\x9f\xaf\xca\xd8a\xc5\xd0{Df\xf8\v\x01,Ǻ>\x97\n\xe8\xd6\xe8\x17\xb28\xaf\xe0\xc4L\xc6\xe5\xf0P\xd3 U\u009agT\xf9\xacN\xa9\xed\xdcH\xab\xc2\xcaW\xaa?\xc9\x16\x93\xb0\x14Kb$[\x94\xd21cdխ\x96\x1c\x97\x13mפF\xb9H5\xd9\x16-\xa6\x1d\xeduM\x85\xe7\x16\x12Q\xca!\xba\x83]'g\xce+a(\x98\x9b\xb1\xb6\xab\x83\xe1\x8bP\xb3\bh\xf5olu\x88s=\xddE\xa2У\xdb5\xfbO8,G\xc2dmߚ}\xa9\xf9\xca\xf2%\xa5\xd6sGZ\x05\xea7\xc6\xec\xddB7\xa7\xab\xf6\n\xc7^\xaa\xf5XKe\x0f\xe0\x81\xab-\xb4\x05N\xe5z\f\x88ۡ\\7\x18\xe8\xdf/\xa6\x18}\x15\xe6\xa1\xf2<Ϯ\xc92|\x81\xae~~'ᬘ/\xc2t\xb4\xa0\xab\x92\v(D\x97\xe2P\x9f.ˠ_\xf3,\xceߐ#\xb5\xba\xecHl\t\xb4\x83k\xe1\x9dMT\xdf9\xc1\xd7T\x89>\xf93柕\xfcQ\x12E\xf7\x91o\xd1_H&\xe0+&T\x80c\xf3>&L\x01SȽ|\x01ͽ\fĒv[\xf9G\x8d\xe5oٷ\xb8\f\xb1\xe0\xa8=\x86`X\xe3\xbb\x00\xa0e\x1a\xe2\xe3\xb8\xe8\x02A\xf0\xf0\xb7\xebu\u07be\xfd\xb7?w\x02{\xd1\\\x81\x00\xd6\xfdӷo\xdf\xfe\xc7\xdb\u007f{{\xe7\xed\xde\xdb'o\u007f{{\xfa\xf6\xec\xad\xff6x\xdb\u007f\xfb\xf7\xb7\x1f\xcf\xf6g\x92\x0eq\x83\xaa\xba\xb7ҭ\x06\xa1v\xb9\x977h\xe9\xbe&\xee\x9c\xd3\xf6\x19\xa5-\xb3k\u007f\x88뾀ސ]\xc0ZD\x875\xb4\xcf\xfa\xdc\xd8`Z\xe5)\xee#\xa0\xf3\xa4\xeb\xd99h\x8fXx\xfc\xf5\x87\x97ߕ\xe5\xf254PM\xbdͣE⢭\bD>\xe7\xabH\x14=\xb9\n\xedD\xa9\u007f\xfe3V\xd3Ϯ\xe2<O\xa2\xf8\aH\xfb\x99\\\b]\xa9TD\xf5+\xe4/\xe3\x85\xdf\xf9\xf7\x17?w\xba\x1e\xb5\x85\x82\xf9V\xe4\x118A\xc2\xc2C\x10\xff]\x1c\x82q\xe2w\x9eN&`\xf9v\x988\x1d\xe7\"\a\b\x8c\xad\\Ɠs[w\x98\xda\x11\xe1\t\xfaMI\xb7Hap\x1e֮\xbb\xe0\xb54\xa4\x00@V\x85#\xac\xfc\xedB2!\x8f\x8be\xb6\x00\xee\xfc\xf6\x1b\x14\x83\xa5\xc4h0\xa0\b\xe5\xc8\xd6\xc1\x80\x93\xb1\x92\x83\xc1!.Y\xa1{8D\x9f;V3\xb7\x1b\xc3\xfcJ\x10\xb5\xcb\xcb1\xd4w\x91\x83\xea>&\xb6\x13[\xec\x88N\x88\x94Xm\xf8\x19\xaa0.\x80mW\xae\x96\xd8\x1c\xab\xf0\xdas\U000d9bcf\xc2Y\x16\xa5Y'\x9d(4\xdayQd\x8bZ;\xed\x16\n~pf\a'\xefdB^\xd0\xfbX\xbc\xa3ݶB0G뉛O\xbc\xff\xeb͏\xaf\xfa\x1c\x85\x86\x01\x8d\xbe\xf9h\x10v^\xce\xd3\x1b\x12\x86?\xf7\xb1\\3Et\t\v\x89q\x9c\x8e\xeas\x80\xe5e\xfe\x9a.\xb8\xea\x87>\xa4I\x8b\x18\xdf\xf6\xa78\x92\xaa\xc08\xd3\xde\x1f\xc0\xb8\xab$G\f\xcfȞe\vL]\x85\xe9\xb7y8#\xef\xf5\xb2\x12\x85jUip\xcfɣ\xb5\x83E\xbf\xaf\x8c\x82V\xfbǊ\xa8\x987\x16\xc5Oҏ\x90+*\xaefG^\xe7\x1c4\xee\xd1\xfe\xfe\xf5\xf5u\xff\xfa\xa0\x9f\xe5\xb3}\xd0\a\x83}\xc8\xec\xb0%\xbeFqp\x02\x0e\x1f>|\xb8\xbffia\xd04Y\\\xb6\x80b\xb6\x04m\xc0\t\xdcB\xe0\a\xfb\x14Rn\t\xd3bU`Q4\xd3K\xd9\xfb\x1d}C\xafz˔\xdd\"\x8ft.0\xd2\xf7 A\xc9T\u007ff@\x0f\xc9X\xed\xb0QTF2\xed\u007f\x9c\xfa\x9d\xa3N\xd0\xf5\x94S&\xe6\xe9\x96ZR{:\xc7\x005\x83;&\x9a\x9ccn\x1d(\xd1\xee\x8b\xd4n\xcfi\r\ua7c7ŏ\xd7\vui\x83+\r@ehF\"\xf2\xd3`\xc3)\x83i/\xb2\xd25\xe6#\xa2B\xd9\xd6GZ#\xf5\x03\xd9(\x01\x8cx\xbb\xc01w\x91\xd4U\xf9bcQ\xa6؋\v\xd7\xf9\xb9\xbdb\x90eO\x1d\xae\xa32\xef]|Ew\xe1ħu\x0fH\xa4\xea\xee\xf82M[`\x99ڜ\xef\xa7`\x90\xf0x\x11\xe7\xf6N8\x15P\x82\xd1G\xc1\x10\xdd\xd8\xe9T\x16Bb\xee\xca\v\b\xbe\xaa\xd2\xd0\xd1\xe2ڌ\x05\xa3I\x8c\xb5\x1e\xa9\xaf\x0e\xa0\xe7\xc9\xdf\x18Q\x9c\xf5\x95h\x1f9R\xb5\xf6\xe9\xbaqZ\xbb[Y,\xe3I2ML>\xccE\x17\xa3_1\x95\x00\xd3T\x84\x95Q\xe00\x9b$iJ;\xa4\u0603\xc3343:^\a\x1f\x85\x99-T\xfa\x01\xa7c\xc0\x14\x03\xfe.\xac'\xae\x93\b\xcf\x0e\xbc=N\xba\aI\x93l>\x0f\x15\xd0\xfd3\x1a\x95\x93\x04/ܨ\xd4\axݗ\x99\xc9\t\x0fqW\x0f\xd7!\xec\xc9\\\xac\xa6¹\xba\xd3\xf5\xc42\x85\x9e\xb8\x02\x03\xb2\xeaA\x8570\xaa\xd8S\x1f\x86\xa7wU\x8e\x9a\xa1\x9d\x04s\xa3:\x83\x8e\x11\a\x15\x9b\x11\x88\x06\xf6\x8c\x13x_$\xe2y\xc9>\x98}\xc6e\xf7\xeb\x84n\xf6\xe8Wf`\xee\x00ˮ\xb3\xe8\x1cɹD\xb0\bmVK\xb6:3E\x85\x1e\x88\x9a1|\xad0(^\r\xd4*\xb2\xe2\xd9\xd7\x1d\x1b\xe9\xb4\x05\xe9\xf2S\x91\xe6-H#\x85\xb4\xea=\xbd\xb5z\u007f\r\x9a\xd1\x145ڪ0\xaf\xaddT\xb3:\x83\x11\x1c\x1a\x01\xb75\xa1\xb1Y.\xbe\xab!\x83\t\x05\x9d\x94pw\xc2003\x9f\xc7\xd3p\x95\x96\xc7\xce{\xaf\xd6}\v\xd29\x82\x19@\b\xef\xa7|\x8d2$\nv:\xfaA\xc1\"\x9eQL ylC\xaf¡\v\x9e܈\xe9\t\xfc2\xa4\x11\x8eY]\x80\x99c\xd6{c|qK\xcc|J\x9b\xf0\xb4\xe3\xabKx\x92C\xd6F\f\xdd\xe5\xa2i\x8d\xef^\x9b;2\x9a\xb0H\xa8\xcd|\x9c\xa5\x8d\xbbA\x8c\xf3Ή\x1e8\xc1:\xfc\x11ʶ\x992\xc7p\x16\xefƈSM\xc6#5\xf0\x9ebk\xed\xb0\x9e\xde\x06\x92z\x9a\x06x\xa0h\xf0+G\x109\xf4\x15\xc2a \xa2\xff2\x15{\xfa\x05+K\x97Ht\x95\f\xcd`\xa5\xbe\xac\xf1Q\xc2)\x11\xb0\x90:\xf7\xd5~\xe7:Z\xb8\xf8\xa5y\xf6\xd1q1\n7~H\xba\x8e\xddN\vj\x8e\xc3\xed\x17\xffɑ\u007f\xfa\x1f\u007f?\v\x9e\xf8\xa7\x8f\x1e\x9f\xfc\xc7Y\x80\xbf\xf6\xde\xf6<L\xfa\n\xfe\x1b`\u00a0\xf7\xf0l\x0f~tῷ}\xf5y\x1a\xf6><\xed\xfd\xedk\x80ݯ\xd7@*A\xf8!\x85K\xb1O>;2\xf6p\x96\xb5c\"\f\x84\xfa\x93\x14^_\xbe\x1f!\xafy\xedR\xfa\xc5\x1a\xd6-`L$aj\x97\x9f\xeeR\xfe\xdbd\x1dGv\xc9|[I\xb9IE\xb9\x86T\xa9\xa1\xc8\x05\x03U\x05͓\xf3p\x8df\x11\xff\x86~\x1e\r\bH\x8f1\x1f\xd4x\xcbZ\x88\xf7\x90\xbd\x0e^l\xee|\xc0\u007fB\xfcg\x8a\xff,\xf1\x1f\\\x97w\xfe\xdf\xff\a\xff\x9d\xe3?\xf8\xdf%\xfe\xf3\x03\xfe\xf3\xef\xf8\x0fn\nu~\xc2\u007f^\xe0?\u007f\xc3\u007f~\xedxg2b\x87^_`\xd8Uj5fjq]\xf5\xd8n\x17\x95]\xe1P\xf8Ju\a\x95\xba\xeb\x19{\xc8\x1a\xe2j\xec\n\xae\x8b\xba]\\\xaf\x91U\xa9D\x9c\xc1a\xbch\x06\v>\x15<\x92I\xe8d+(\xdd甗\xaf\x86\x03\xa3\xb8\xea\xc3\xde\xe8P\xefE\xf9!̠\x84\x9c;8\xb85ݣJ\xa4Mt\x80\xee\x8b\a\x81\xcb*\xaeu\xf7\xe9\x03(\x9d`\xa13{T\xc7`\x04\xd0\xc3\x03,z\xf4\xb9\xccҍ?\n\xba*a\xb2\x1a'\x13\x1b\xe0@\x03\x88x\x92n\xb9\x99/!\x93\bG\x18\xdfa\xb7\xe9\xb0\xc7<\a\r9\xb2k၅4\x1c\x19\x14\xa9\xa5mt\xd4\x1e\xdbD\xb5\xd9\x18\xa9\xd4\xc8]J\x12\xa0yk\xb8\xe1\x118\xab \x80\x99Zi\xbdܥX,_\x98\x95\x84&\xf9$\x8dw\xa2\x94 \xcd:\xd1\xd7\t۩\xb0s\x02\xe7\xe2\xeeK\x95\x85_\"\x1d\xc6\xcad\xa7*\x19\xb2A\t\x11\xc4\\\xde\xdc\xd4z\xba\x93\x80\xe5^\x93\x11\xae;[\x95UV\x1ec`\x01\xf1\xb2\n\x94\xeaAnGϞ\u2d9aȆ\xbc\x1e!v\x1e\u007f\x9bt\x8b\x92\xbeU\x11\x94\xa8iT<\x11\x0eM\x1f^}\xf7Ľw\xd2\xc3%2\x0e\x10\xde&\x81\x11\xed\xda\x14\x11;\x0e\xec\x87\xdb\x04\xc9\vf\x8c\xb0\t\x8d\x13fu58ٖ\x96\x86\xb4>n\x18t\xae\x8dc\xec\n\x82\x9f\x1b\xf0\xfa\x18\xad\xcb\x14\x86\xe6\xf1\xe7\xbe<\x89\xa5W\xfek\x87\xb5\x05\x86s\x90^kʏ\x18\x8c\x92\xc0XiӮ\x85\xb6\xb7'}\xa4Q}\x99\x87\x8a\xd8r\x9dó\xfaN\xab\x88\n\xf1\xea\x8dO\xbb`}i\"_ͺ\xb8\x001\xf7D\xfcݪ\x02\xc3\x03O7\x9e\x96\x90:^\x95\xb1\xdfQ\x85:2\x84k\xa0\xaf)\xb05\xb3\ns\u007f\f<\xfb\x05\xe3\vf\x8b\"K\x13\f\x98\xe3\xd7=P8`\x9d,\xe4\xe3\x867z٠˷wd\xe4}/\xfaFm\xea\xfa\x1a\x11rs\xa7\xaa\xbe\xea\x11\x19\xa3\xbdEGs\xdb0\x06\x93\x8f\xcfp\xd0=w\x95D\xafn\x006#\x97\xbf)\xab\xb8\x8c\xaf\xffZ\xe5\xe0'\xa7\xd3:\xa6J\xa7%\x13\xbd\xe0aO\x01\x8a\xea\xe7\xf1,\x8f\xc9H\x19>\x18\xc8\x19\xf4\xa7\xef\xbbNF\xa8\xcd\xce\xf0\xc8\x1b\nmu\xe4\r\x84\xaaT\xbf\"\x95\x1b\xab4\xb0\xea\x06\x9a0j1\xa8\x9an9˱m@gyu\x13|jl\xe1\xf5zb\f\xe3\xaa؟:\n\x9e&g\\A\x10x\xe6\x12\xf7\xd8M\x19\x87Ko\xa2o\x8c\xdb(\xa1{\xad\\\xd6t_\b\xfd\x80Oi\x97\xb6\xc5oV\xf9\xda>C\xfd\xf4\x1a\xb5\xa3SUy\xd0^{]j\xed\x0e\xc1 v]\xef\x02\x86\xe3\x80}t\x8b\xa1\xf8+N\xe4ߋ\xbf\x8b\xae\xf4>\x04AҪ\x10ט]\xe1\xa3ɍ\x87mP\xa99\x1d\x05i\xd3o\x1cX\xcf\xe1\xd2\x19)\xcf\x00\x81'<,ƚ\x1e/$\xa9\x12H\xe9\x85\xf7\xb6\xdbar\xe4)\x19\xbb\xa5\xbdb\x8ba\xe6j~\u007f2d\xac~tY\f\x1a\x89W\xad\xb6\xb7u\n\f6<V^$\xce\x16H\x9a+>\xf1\xce\xf6{\xf5P\xb0\xbf\x85mxs\x11\x86G\xc2\xf7>\f\x06\xa2\x03\xee{\xed\xa2\a\x12\x95\xf5\u05f8\xd54\xa7\xe7\xc6\xcc\xe5y\xd6w\xbcE\\\x9cB2=<,\xefL\xdaoO3\x04\xf9\xed\xed\x9dx\x99\xfdl\xbdz\xae\x19\xa0\xccH\xbe\xaa\xdd\x174Y\xd3m\x8a\vٌ\x8b\x00H\xbf8\xeb'\xbd\xde\xd6G\xadk\x14\xe0R\\\xd1\xddF\xce\xe8\xc6\xe4\xe0p\x1d\x1d\xef\xfa\xe0\xfd\r8H^\x8f&\xf3\x9a\x1b+\x80wl'\xdf\xd1\xfal\xd6;.l\xbcw\xbe\xc5\xedy\v\xbd\xa8\x86\xa7\xd6\"\x12G\xa7\x8a\xf6\xf9\x95\x934\v\xc1\xbc\xe2\a7\xb4\x94\xac\xaf\rw\xdd9\xc9\xfd\xec\x18\x8d\x84\xfe2[\xfaƶ\\{\u05f8\xba\xa5ր\x1d\xba\xa3\xad+$+\x15\xc34\xf5\xd1p\xbdP\xba\xff\xe0\v\x1bUh\xfa\xf7\x18\xc0k\xed\xb5\xbf\xa5=v\\\x92l\x9dvt\xf5\xadk\x98\xe2ԗ\xca%P\xcd\xf7\xcbZ\xb8Y\xf1\xe8Xg\xcb\x1c\xf5\xb3ː\xb4\xa7)\xc7l\xf4\x94e\xa72\xfcB\x90\x92o\xec\xc41\xae\x1c\xf0,\xe3ie\x8dA\n\xde\x01\xf9FO\xc9\x19\x86m2|T\x96\x00\xe4\xe75\xe7\xa2]\x06\x1f\x9c\xc7\x1f\x97\"\x87#u^\x8a\xacjØ\xb6\xf8C\xec\xae\xdb'P-\xfe\x80\xc5\x02\xa4\fe\xcaP\xd3\xc5b\x9a\xd0mɮǋ\x84N\xb7\xfa\x19tZg\xbb\xa11\xcd9\xc7\x17\x91\xd4e\x82\x02u5\xcd3\xd0\x1c\xec\x84f\xc8h\x86\x15\x9a\xc0\xb8\xd2J\fP\xed\xde\xda\\4u\xc7l\xe2\x06Ϋ\xb1\xb2L\xa7\xe6\x1f\x91\xd3e\xfe|l9Ba@\x98\x1c\xe32\x80=\x1c\xe0/\x98&\x0e\xee\r4o/H\xeb\xa1\x00H\x90P\x82\xb4Z\x14\x94V\xb0^A\x82\xa5y\xaf\xf7\x12\xe0\x1dm\xe7b\x1e\xa2\xc0\xb5\xf0OoSs\xc5\xf0;\xb7X\xa7xsM\xbc\xb9\xd6\xf0\xec\xd6(\xb10\xb9y\x9b\xaeC\x1c(-m\xbanmS\xb5 \xbanjҥ\x1cU\x97R\xba.娺4\xc5\fm*G\x15\xbc\xb6\xfa\x84\xf1\xb5\x00\x16\x1cng\xc1%\x8f\xb1˶1\xb6؍\x9b\x97<\xce.\xb7\x8c3\xe6\xc4m\xdc_Ev\x8c\x05;\x86\xad\x9cVK\xccK\x17\xa7\r{tی\xa1\xdf-˚\xee~\xfd\xce\xd3\xc7\xebٸi\xe2\b\xc5^\xf5l\fsñXq\xa9\xa4\xb1\xe6\x82\x1c\xe6tA6\xefz\xe1\x8c~\xcd\xe0\x17\xdd\x13\xec\x8f\x01!\xe6\x8e\xfb9\xf4\x15\x86a\x1e\xcf\xe8s\x86\x9f\x007\x1e\xd3'\xea\x90p\xbc\xebZ\xae\xf3U\x87_b\x01Jޝ\xc7k__\xdc帺\xcbiy\x174C\xcd\x10j\xb6\r\n\xfbt\xccK\xc5-\x8c\xfc\xaeH\xb70\xf2\xbcHmFb\x92\xce\xc8\xf3\x011\xed\\\xac'\xc3~\xd1\xf5R\xfe\x05\x03\xeb|H\xac³\xb6s\xb9~\x83%\x11\xba\x92\xc3gʟ)\x9e\xc4i\xa7\x16PH(f\xf8ճu7\xa4=\x02y\x13ٺ\xde\xde\xde\t\xc02\xa0\x1f\xd9\xe6\x03\xdd{\x88\x00\xf8D\xa4\xef!q\xf4\x91\xe2GJ\x1f4^:\xed\\|\x19n\x13\xc74\xac\x89#&\x19\xe2\x98J\x8e\x85tA\xb6\x1f\x1a\xe2\x98*>\xe1+:c\xba\xb1\xda\xc7i.\f?Q\x1c\x81\x13@\x03q\x02\xea\xde\xc3*\xa8\xf1!m4\x84\xe2C\x93\xa5\x1d8\xf1\xddd\xab<M\xea\xf241\xe5)<\x97\xf2\x14R\x94\xa2\xfe\xa4\xabqg|\xae\xe4)\x04\x901\x85\xd0\xe9O\xf0sb\U000694a7\U00079527\xf1y]\x9e\xc6\xe7J\x9e\xe0\xe7\xcd\xe5i\x92\xbe\xa3\x0e\xc6cd@\xc0\x8c\x9b\xe0\xc7D|T\xfc݅\x8bOE\xf0\xb8F\xcbx-,≴\x8cC\xed\xae?|\xb28\xa8ρ:>\xc3(\x15*]\xedTt\xbd\xa4\xbeyC\x9a{ \xcc\xff\xb5\xb8\nh\x90\xe9\x87t\xe5fL\x97r5\x04\xa2l(\xcaNĭ'\xb5/\xa1\x03\x8dM\xa0\xb1\x02\xba\xc9\x1ae`\"Y\xe3\x1eamn\x99\xb4\xf3\xfcGyϸu\x1b\x13\xaf\x80N\xe4UP\xad5\x97xc-4\x8dPJ3n\xa3&\xa7\x97g\xb5\xbd\x9do6\xaf\xc2y\xec_\x06\xc0\xceKb\xe7\xe5Yи\xfa\x9c0\n\x04u-\x86+b,\x8b\xf8\xb6$1\xa8#\x1b7 \xdb\xd6\x05\x84\x91\xd8Nh\xb0u;\xb0]\xec]\xd7w\xb7\xe8\x92Jo\xef\xec\x89\xff\xe4\xe8m\xb4\xf7\xb6\xff\xe4mt\xe77\xfa\xb3\x17@\xdai\xfc\xe2\x8c\xf2\xf1\xfb\x89\xba\xb0b\xec\x0e\xd31\xbd\xabc\xbb[\x82p&\x8b\xa2\f\x17\x13|0\x84G\x1f\x86\v\xae\x0fJ.Lmi\xc7(_\x1f\ti1\xdf\xe1\xed\xbf\x0e-\x878cld\x04\xf5\xfa\xc4\xcbj\xa1\x88 NvZ\xe7&U\x9b5P`I\xb2\x16\xf8\xb1\xee\xf3\xb0\x00\xa5\x8b\x04\xed\xff\x87\xff\xd5o\x90\xf1\xd6\xff\rfƷ~\xb0\xdf/\xe3\xa2\xc4\xdc#\x933\x80\xe0Y\x96b\f\xf7\x1a\xb5\xafq\x16ّ7Fh$\xc2%RPm\xed\x8d\x1d\u0605E\\U\xc0\x11\xd1$=7;\xb4\xe1s\x18\xa0\xb7R\xc5\xea\x12\x96iW\x82\xdd7săuF\xa9\xc0\v0\x16\xaf\xd0J\x95\\\xf7s44s\xb41\xf3>qV\xf4\x06\xdf\xd0\xe8\xd0B\xae+\x12\xbb\x9a\x95R\x81\xfa\xff\xf9\x9f\x00\xf1\x9f\xff9\xc3\u007f\xc6\xf5\xdb^\xb3\xf1OZ\x18}\xae\xbf\xafG\xd6\x17\xa7f\xc42\xc1>\xbdP\u007fL\xd1M̳\x12u\xa9\xe1R\xbf\xdfտ߭\xb3\xe1\t\xc0\x1c\xa9\x9d.\xba\xe0\x81\xaer\xc4\xed.\x1d?\xd2\xef\x19\a\xe3\xa0\xdfc\xf6\xee>\xd0,\xbf\xdb\xe4\x88x{F\xff\x8e\x03m\x9aE\x16$tr\x91h~\xb3\x04\x9e\xc3,\x80O\xe2\xa0\x16\xaa\xb2\bǌ\xb3ff֘\xe36Rָ\xca2+\xab|L\xee\xde5\x9cLr\xba\xcf\x17\xe8^(\x16\xc4l+Ę!\x8cSH\xa37\xa20\xbf\xfc\x9d\xfa\xc2\xd1,&\xe2\x12-\x12\xea\xa0\xc0 N\xa5\xcf\x1a\xd2\xc7-\x84\x9f\x9bk\x8a\xba[\v-Y\n\x0e~\x8d\xa2!\xc5B`nD\xbcˁ\xac\xbd\xc4\x12\xads$\xce\\\x89\xcea\xc5\x1a\xd3\xf6\xc0\bq\x87wL7\xcf;_M\a\xd3\aө\xb8\x80\x821\xd6ޯbX\x03\x97\x9c\x19\xc6\xe3\xe8\xbe\xcc|\xbf\n1q0\x98N\xab\x12\x908\x0f\xa1m\x04\u007f\x1f2\xa2C\x99\xf5a\x95\xcb*\xaa\x02\xe38\x99q\xea\xdd\xe9\xddh\"S\x93⽠g\x1a\x1fN$\x8aqJN*X'\xfe\xafJ]L\xce\xe3(L\xe7\xd9\"\x12\x85ƓHe3&,QU\v\x89WI\x96b\xcc\xff\xceW\x0f\xc2\xd18\x1eɬ<\xbb\xc6\xc7+\xbe\n\xef\x8e\xc2Q(SWy\xba\xb9\xce2\xc2\x1f\xc5\xe3\a\x0f$\x1f&a\x14\x97\xb2\x92\xbbӇq(\tØ\xe4e\x1e\xaf\nŌA\x95\x95MhB t\xa3{\x0f\x87\xf2\xd2\xcf$\xcb\xf12\n6\xe2\xfe\xf4\xee\xa0J]\x80\xe4^ǹ\xac\xe9\xde\xe1ûq\xa4e\x17Iz\xc9\xe5\xa6\x0f\x14#'y2/\xfe\u007f\xf6\u07bd\xbf\x8d\x1bY\x14<\xffn>E\x9b\xbb\x99\x90\x16E\x93\xd4[\xb2\x9c\x9f\xe3$\x93\xdc\x13'\xd9ȓIFW\xe3_\x93ݔ\xda\"\xd9\f\x9b\x94HO\xf4\xdd\x17U\x85G\xe1\xd1͇\xe5d\xceݙ\x9cc\xb1\xf1(\x14\n\x85B\xa1\x00Ta,\x8e\xff;\xe9w\xf6\xf7t\xfa2\x1e{\x83\a\x13\x95S\xeb\xb8\xc72L\x8d㞕q\x9d\x0f\x93t<%\xca\b\xba\x1c\xb6\xad\\\xa1k 1O\xe0?+#M%\xc0\xc3}M\x17\x99\x13\xaer{\x13\xdff\xd8J\xd2;:䭌\xe2k!4\x90\x1d\x8f{\x0e\xe2\xf90\xbbKuk\a\a\x87\xbd.\xefp\x8e\x8f\xec\x88p\xc7}\v\x91|ڿɰ[''{\xdd~\x9feM\xd3D5fU)\x90\t!+=99<\x8ayV\x1ak,\x8e\a\xbd\xfe1\xc7\x02\xedǊ\xf6\xfb\xc7{\x89\xd5\x05\xccU\x94\xec\x0e\xf6\xc5\u007f~n\x1a\xce\x15\xd2\xe4\xb7y\x9e\x15rT\xfbi\xd2a\xb9f\x0e\x9c\x88AH\xf6TV\x9aN&\xf4\xb0M\x90\xa5\xb3\u007f\xc23\x8aۥ\xe1\x92\x1ec\x9fl\xa4p<<\x81\xffxz\x1aHϓk\xc3ϝ\xf4\xc4L\xcfA6M\xc5\"N\x93\xbdׅ\xff\xa9\x9c!\xcc\x0f#\x91\x06\x83x\xa0\x06@\xe8\xf2B\xdd\xd3D\xeev\x8f{\xa6\u07bc\u007fSd1\xd5a\x82\xe0:\x16\xaaN/\x9f\xe64E\xe0?\x95s\x93\x173\xd3\xd01\x93\x8b\xc0\xf2\x04)9\xd2\xc3o̓$\x16\xd2C\xe7H\xaa\x1c\xb7\xe1?\x9d\xa8\xf9\xff\xd8\xf0\x10\xa6.ӡ\x98\xea8\x05\x92\xc1@s\xab\"\xa2\x05\xe6&\x1f\xa7\xcb$\xbdׂ\xd5d\xcc\xcc\b\x1e\x9e\xf4\x94\f\xcd\xc6\xe0%^\xf2o?9\xe8\x1f\xf4Y\xce5\xd2a\x1f搢\\v\x97O\x97\x92\xd6\x06\xbc\x9e\x8b\x83vzx\xac@\f\xe3\xbbt\x9c\xa4S\x9c\x00\x87\xe9\xe1 v2\xc4h\x177\x12X{p\xa0s\xefǚ\x1eG\xfd\x81\x99\x86\xc3TL'1\v\a\x03\x9aU0\xdeZ\xb2\x0fA\xcbS\xfc\x13'\xc9qz\xc8s\x8c$\xe5\x04\xa3,)\xccR.\xfe0G\x8f\xa2\x19\x04\xc1aqҵ\n\xc9\x01M\xf6\xe0?;G\xf6⤝\n\x86v\xb2J*\x99q\xea\x1d\xf6;<ǈ\x13\xd1\xef\xb6\x16'\x94\xc7\xe4I\xb7\xdd\xeb\xc6v\xae\x99\xa4\xc7G\xfdt`gryrtt||r\x12\xc8N˲gi:T\xc0{\xed\xfe~\x92\xf2lF8\xf1\xbf\xd4\xd0`\x94\xaa\x15\xa7\xcd\x13u'\x84\x88M\xf6\f\x9dǔ\n\xf3[\x8f+\x93\xf3\xd6,\x16*GNt:\xe6\x9a\x01DD\x98\x8fl\x9d\xe4\xf0\xb0\x9fhRQ\x01\xbe\xe6i\xe6\xa2,\xb3\x04\xf4\xe2\x83\x03=l\x949\x99O'C\xacy\xb2w\xd4NzV&\x1f\x9e\xbd~o\xef\xa8cgs\x81\u007f\xd4;<NS;\u007f\x02\n\"\x13\x12\x03\xb1\x18Z%,\xc1\xbe\u007f\x9ct\xf4\x02E\xf9$\xda\xd5D?\xea\x1c\x1c\xab\xe96ʒ1\x9f:\x9d\x93\xceɑ\xa6\x99ح¥ʑT\xca\x06\x9auFY1[N\xf3B\xebe\xa9\xeeR\xde\xef\xc7x\x13\x9a2z\xaa\xa5q|\x17\xbf˙\xb4N\xd281yK\xadi\xa8\xd6\xc5\xec\x1b\xe2\x83]Q8\x19\x1c\xe8\x81\xc7E\\\t\xbfv\x9b\xa7&Ӹ\x87\x03\xdb;N\xbbj\x80\xf8\xaa\x1e\x1f\x98\n\x98,i2\x18\xec\xf3\x1c5\xd0I,\xc6R\xb5;\x89\x87\xa9%\xd8\xd34=\xd6샹zƋE\xfd\xe4\x98\xe5X\x03\x14\x0fD͔\xe5Z\xc3#\xf4\x99\xb6^c'\xf1$^Ƃh\x13I\xcfA\xa2\xe89I\xe3\xfe\xcdd>\x18Hjƽ\x13\x9d3\x9d\x93D?>\xd8S\xd3\xc2\b\x96~\xbb\xaf\xd8s2\x9c\xe3\xd8&I\xdcN\xd4`\x88\xfdWb\xd6\xe2^;5s\xcep\xf91\x1f)MD6\xdf\x04s,\xb5\xfa\f\x1a\x8e\xd6q\xa6\xf92\xd62c\xbfsx\xa2y\xa7\x10\x82{\x98\xeaZǽ\xfd\x83Ξ\xce\xd3\xe2/>n\x1fuu\xf281\xed\f\xf6\xe3\xfdC\x85\x81%\x14\xd3\xe3\xde\xc1\x91\xc9(nҡ\u052c\x05g\xa9\xb1(\xb2t<F\x89\x12\xb7\x0f\xba\xddD'\x0f\xefh\x1d\x13\xa4\x13\xff\xa9d[\xac\xa6\x8a\xa8\xd6t>\x8c\x0f\xcc\"e\xcbZA?\xbd*\xd8b\xd6\xca\x19k\x01\x1a\xeb\xe9\xe7K\x04\xb1APy\\$\xef\x1f\x1ew\xf5r?\xa3\x85.\x11)z\x95\x9e\xa5\xb4.\xb6\xf9\xba\b\xbb\xc6\x19\x8d\xb3P>\a\x89\xe2\xe4Y>\x8ag\xb9T#\xf6\xf6\x15=m\xe9#\xf8%Q\x80\x8cJ)\xa6JW\xd3\xf9\xfe&\x8dg$S\xc4\xf6iO\xa7\x1aEάĘZ\x8c\xf2[\xbd3\u052a\x82\xbd\xbahΣd3\x17c\\Jؽ}f0\\#~\x97U^ǻ\xb3\f]\xe6\xf5\x90k\xe7jX.{\x1d#\x02\x9c-6\xa3ẖ\xb8\x1b\xc7\x12\aǜ\x9f\xcb\xc6\xea7p\xb2t\x03\x87\x947\xada\xd8\x12w\xd3dv\n\x85\xa5,\n vD\x81\x1d\x01`g\xe8\xd9\vD\xb6c\x86\x13\x8d\xaf2\xc3\xf1J\x8fk\x86\xb3N=\xc9\xc6q#--\x85\xfc;\xd4ީ\x1eB\xe8|\x14;T\b\x19m\\\x1a\x96#\xe3\x18h}ے:\xdb\r\xf7\xd4vz\xd3wY\xac\xff!,\xd6W,\xd6\xd7,\xd6\xd7,斆\x13\xe3\xcf\xd5\t,Հ\xa3r8\xf3\xbd\xd1\xe6a\x95W\x97>\x13\x80\x1f\xe1\x9cQ~\xa3\r\xfb\xa6\xd1\x00\x13\xda\r\xd8Ϡf#\x00\a` \xc7\xf6\xc3\x1c\xdb\xf78\xb6\xbf\x9ac\xfb\xebr\xacuNj\x8fK\x9f\xd9G;\xed\xb6\xe6\xc7\x1d\xd5\xfb\xff\x06\xcfb\xa5Le\xdb -\x8c*\x98v\x1d|襨\xc4f\xf7\x83\xb1Y͵\xf2\x04\xd9\xc5Fr-\x9dV\xd8\xfe\xb7-\x88C\xf2q\xbd.\xef\x0e+\xb8\xb1>\x04\x0e\x1a\x02\a\r\x89\x83\x86\x15\x9c\xcek\xc0\xeci\xdd0\x81\x8a\xbc:4\xbc:$^\x1d\x02\xaf\x0e9\x97\xd7w\x04\x80\x1d\xd1\xe2\x8eo\u007f%\xc2\xc3\xc3\x16'\xf1\x17\x91\xd8:9h\xef\x1f5Uү\xe4\xa8C~\xfd\x03\xbeZ\xed\xe3\xe3\xe3=\xbb\xaa\xc3\xed\xa2\xf7\xab\xb8\x9dWZ\x97ۡ_\x1f\xc4ݲB\x1c4\x8c[\x18\xad\xc5\xed\x06\x9f\xad\xb8{}lVr\xbb\xbauB\x18\x94\x03V!\xa2\xd1\xf1\x97\xf5\xe2,\xe0\x92\xb0\xf5\xdb<\x9d./\xb0l>\xad\x17\xea R\x03x9\x1cn\x06CT\b\x80\xf9)\xcfg\xfc%\x9d\x13a\x99\x95|\rNdR\x19G\xd3Tn\xa1s\x99\xb4P\xcd\xc8w\x84\xac\xc0}ڻ\xcdd\xf5\x8ab\xa3\xfc\xfd\xea2\xc5\xca\"\xb9S\xc2\xeb\x81\xe5\xc6w,\x141\u007fD\xad\x0eK\xa7\xf5PP\x0f\xa5rz\"$\xc8E\xf6\xfe\xfd\x90\x9c\x13\xd6\x14\xd8\x1a\xd3 \xab\x87[\xb7J`(O^\x05e\xfe\xce\xcelh\x15c\xef\x00l\xcd\xc7pvs\x01^\xc5y\x13\x8d0`C!Y\xdd\x19\\'V6U\x12(p\x11ty\xa5|\xf1\xaa\xdc\xea飋\xc1\xf0Y\xbe|U\x86%\u0382\xcd\xcay\xebg\xb4\x02\xe4\x97}\xb1\xae\xe6\xcf{\xe8\xc2C;?\x97\xdf\xf8\v\xa2\x8a7#\xf9\r\x1fg\x96\xe3\x1b\xc1\x03\nd\xf4\xc4\xe1\x02\x9da\xe3\xfd\xb6\xd0\xf3Z!\xe38\x8d~\xc7\xc2\xf1\x90\\\x91\xaf\xa9(@\x8e\x15eG#/\xef\xc5\xcaO\xec\x8ay\xff%S\xc1\x85\"\xbc\x91\xa57\xd8uU\x12]c\xbd\xbbj\xb0\xec37\x88\x0fw\\F\xb0\fR\x99\x1f\xb5\aC}Q3X\x1a#U\xf0\xa7@\x06%\x89\xb6,\xadh\"'\x1eR\x1f\xfem\xbd}\x9bĳ\xf8\xed[t\x8b\xecFqQ\xf5\xff\U00097a26\n\xd6\xe0F\xcf\x18\x83\x15\xcbl\r\x03\x16F\xfe]\x19c\xc1\xc6\xd4zW\x17\x8ac\x1c\xe4n=.\x96\xc3\xear\x96u\xe7\xf8F\\\xfb\xb8\\\x8a+\xc7#0\xaa\xae\xe4p]\U000e3c56?%T\x84\xff\xfaz\\f\xb1Yx\x0e\x8d\xd9\\\xf9\b\xdc\x10\xcffS\xd7Ӏc\xa7X\xed&\x9bq\x01\xf9\xe0\xb3\xeej\xd9^\x9d$aq0\xe1w\xdd\xf7\xd4H\xcf\xeb\xa5\x0fI\xf2}\xe0ŴC\x9f\x05\xe8S\x11\x1c\x18H?\x03\xfa\x19\xfd\xf7\x17urj\x00\x8e\x19\x9b\xac0z@pK\xdbM<\xd8\xec\x04\xfe\\2j\xafAX\x83y\xb4nq2\x10Q\xd9j\xa0\xe0%\xfe\xbej\xb8\xb7\xfb\xa0z\xe0eY\x15T>\x1e\xabƲ?\x8c\x8b\"M\xfe\xe4\xe1\xa4iV\xe7^7E\xd5Q\xbdA\xde\xf3\xeb\xcf\xfe\xf9\xfb\xff.v\x9e]\xc3\xe6[^\xad\xcd,\xafp\xdc\xed\x8e\x14\xa3س\xef\xb2bf\xcf\xc3`\f@\xf2s\xd5\xcf\xc738uE<(\x98\xa0\xba\xa0i<\x10Vż\xb1\x9a\xfe\x9e\xf9\"\xb5\x10T\xfe\x17\x8c\xd3^U\xd9\xca>[\x85\xb45\xf0r\x1c\u007fJ5\xf2t\xc5PrAYG\xdc)\u009d\xf5m\xc1\xd4\x12\x8b\xc7\xe7k\x05x\x13\xd6.f\xcbaZ\xc2\xd8\xe0B)˧\xe8S\xdf\r\xe8\x1e\xf2\u007f\xaa\x82\x84\xee\x95s\xfc\x93\x12\x8eg\x81G\xd58\x1b\x97\u007f\x92\xba\n\x99\x95\x04\xc6>\xe9\xe2\x92\xc2\xea\xf3ʢL\t\xb5\xed0?\x121Y\xea>\x1b'\xf9=ȹW\xf9h\"\xa4\\r\x81\xed\xd93\x15X\x16\xca(\xf7\xb6\x18\x04Ɩ\x87\xba?\xa6\xaf\xeb\x8c2\xf5.<L\xabF{\"\xb1\xf9Ȓ\x8cw\x80(r\t\xa5\xae\xce6\x9f,\n\xe3ǟ-\x1a\xf2&\xd3\xc5u\x11o\x91\xadܷmG\xfa\xb6\x95\xd4@0\xe8\x12|<\x93\xfem\tAIR9\x05,uO@\b\xbc\xb0\xc6\xf89Z(\x92c\x1f\x00\xc7l\xe0\xc6C/\xba\x85a-\x9fCU\xfd\x90\xbbVSQOɃ\xb2BB\xe5\aZ\x0f@Ԝ\x1c~\x11\x1e\xc2\xc1\xf87|XE~\xd7A\xfc\x96\xe4\xcf\xc6\xe3t\xfa͛\xd7\xdf\xfd\xc1\xc47\xed>\x0e\xe99\xbcu\bo\xb5\xbf\x01\xd9E\xd7\xd2qR\xe67K\xfb\x99\xa7b\xbe\x8b12\xaca\xe6+\xb1F'\xf5R\xffO4Hʑ\xfa\xdf~\xfa\x96&\xbc\xed,\xcfiOT\xfb\x80\x16K\xb4Y\xfb\x1d\xea\n\xed\x997J\u052b[Z\xb4\xc2\x13\xc2!\xe2\xcfU\xf4\x16\xeaU:\x9d\xf9B\xba\x97\xc2%9\x8f\xeeT\xbc\x84\n\x94\xf9\x05\xd6ܜ\xf0\xcc\nW\xa7\xd6\xc9>Z2\"\xd4X鈬\x87Kɐ\xac\x87ʇ\x8e\x95\xea\x01xlğ\xab\xc6j\x9a\x8e\xf2\xbbt\xd5;\x8f\xd4:\xb2\xb6E\b\xedJ\x95\xae\xef\x1br\xd0\x13&\xa66dY\xd9(\xb16\xd2`͉\f;\xe4\x80\x03O\x88\xe1\xe62UO\xa89ui\x96\xc0?_\x8a\xba\xcesk\u007f\xe3O\x06\x05]\xbe\xe4\xed\xdfX\x14\x03%\xa9\xc3\xfdi\xca\xc4\xf9\x04\\\xadA\xff\x95y$\x85\a8V\xc2\"\x9b\xf1oc\x04\x8069\xd9X\xc7\xf8\xbe\n\xc3f\xfe\xac\xbd\xfc\x9a\b\xa2*4\xa6\x82l\x02i\xbe\vt\xcb\xd1O\xe5f+l\xfa\x88\";\xa0\xa81_0cH\xc0\x92\xe1n\x8el\xf4\xf1\xf9\x94\x02\xdcp\xdd\xfbh:]\xbe\xdbٹ\xf2L\x1e%~~\x9c\x16\xecؤh\x94\v;\xe71=4\x91\x01\u007fv<+?TQl\xb4\x0e\xc5\xf4\x18\x98\x01\xe7##\x83\xaf}\b\xd5\x18\x03\xcaH\xe7\xc6^\xc4`\xf0\x18\xb1\x8e\x83\xa3\x90\xc1\x92s\xa6\x1c\x1d\xcd\xd6Ԍ\x19-jU\x9f\"T\x8c\x95\v\xc3Rp\xa1}\x8cS\xa3\xdaw\xd0\xf4:\xba\n\x83OJ\x99\x84\xe4\xd1\xc6#\xee͑\x15cU\x90\xbd\xa1\x8c\xcb\x11k5\x97\xaa=Hy\xd4\fa\xd7v\xd1s\xa6뙓\x15`\xc6\x10#6\x02\x13n5\xbb\x04\xd9\xf2\xff\x18\x8er\u0604\x1eT\x8f\x1c\xb7r\xeb\xe3Ǉ\xc0\xc2q3\f\x83X\x8d;!\xb4V3\xa1\x8b\xb9?\xa0\xc1\xb6\xd5_S\xbdE5m\x10g~1\xcb\xda͊\xda\x19\x1as;\xd95\x98[\xf0I\xbe\x9b\xa64\x89\xa9\x15\xcaf-\xea|hLVV\xcd6\xdc(\xc7\xfcL\x81\x9dL\x94\x9c\xe4=q\xb7{\\E1\v<\xbd\x9a\x1e{Gg\xed+e,m\xac\x17/\xbc\xf2\xf8\x02[4\xb33tHU\xe1\xc7\xdf%\x04\xd2\xd7eqL\xac_^iM\xc9)@Y@\xdd@\x86w\xb4\x14\xda\xeb\x9a.\x95S\xc2(\x87\x8a\x90\x99\xb2\xab\xb1e\xba\xe9qQ\xf8(\xf0!\xe4kk\xabƃ\x9e\xf9$S*r\x96\xbb\xc2\xc6\x12\xd6\x01\xbb\xaa\xba\xc8f\xd55E\x81P\xe4e\xaa\xbfZ)\x9f\x8f\xcaN\xc8ݐݛZ<\xb4\x95\xcb\x1c\xae\xaa\x90J\xa1<N\xc7\n\x94\a\xd9\xd0!&\xa5\xacw\xcaYu\xd8)!\xbbG\x9d\xbaAk2P\xaaj;p\xc2\xd9\x0e\x1cp\xca\xe3M\xa1\x12\xff9G\xf1\xed\xd0q\xa9Ԇ2\x8e\x94\fx\xebI\x9c\xbf\xfcE\x92\xa3\xfa\b\xb4\xea$\xdc\xd6\xe2\x1f\xfd\xec\x13\x03\x9d\x87&\xccG8~\xe6t\x04W\xac\x82\x14d\xa3\xb57Ug\x91\xf2\xe9\xbc\xe1\x914\x16\x00\x88\x82\xecc\x8a\")\xa5;|]d\xbd!z\x1d\x87\x0f.\xe8,S\x87\x1c\"\x1e\xf0\x91B\xbd#\xa2k\x1fD+\xfbv\xd5qNnۍ\xfa\xf9H \x15\xb3\v\b&Ż6 \xea\xbeҹ\x95\xf6̍\xc6Q\x0e\x17\xe2\xc6\x11\xf2\r2\xc87\xf5\x95\xdcU\x11\xee\x0e\xa2`N\x04\xc0\xf41N\xac\xf0\xe6\xd2\x1a'V\xaaq;\n\x9b\xa6\x93\xc4\b\x8e:(TVɁ\x04\f\x17\x15\xa5\x00p\x97\xf2\xeb\x8aE\xbb\xdb\xf6Ъ>\xb6ϒ/\x85\xc8\a\xbb\xf6\x0e\xb6DBe\xdcҊ\x8aB\xd9\xea\xd0:\x87*\x15\x03\xb2jX\x01\x98źN8ӀDzKv{dQc*\xc5\xc9\x06\x8e\xc5\xfd\x00\xa5U\x12\x13*\xaciF\x03(U\xa8\xea\xe6\xf8\x1c2'j\xe8@7rg\x96?\x1fV\x11l4\xb1O\xf2\\\xff6\xceU\x90\nPR\x02\x96vh\xabE\xb5Zno\xb0\f\xb2\xeb\x10\xfe\xc6\xca\xec\xaa\xd5\x05\x16&N\x03BT\xed\xb2\xaa\x0e\xf9\xc0%lVv\x03r\xdd\x1b\\\x1f\xefN\xe0\xa3\xddǲ\xb5\x02\u007f\x1d\xfcܳ~\xd0%\x9f&KM\xd2a\xbc4\xa1.\x90l_B\x9aUh>\xc5\xd8\xd1n9\x99\xcc\ue304#w;\x02\xc0@0:I\xd3\xce\xf86\x81\xab\xb8;;V\xe2\xf7b\xc1\xfd6iF_\x82R?\xce\xef덒[\xde\xf2V\xab\xb7\x85\x02\xaf^\xf2\xd8\"\xba\x8a\xae\x1a.\x0fA%\xf0\x87mi\x88\xd6=g\xeb\x8el\xd5EA%\a\x9c;\x80\xbe/-\xabqu\xa6a\xa0\x9d\xfa]\xd0\xc0T\x17웻+n0~\x10b֕D\x1f7\xef\x96_\x83c\xe8\x0e\x11m\x86\xab\xef0\xb7\xcaw\xd1ᢥ\x97\x96\x9d\xd6ܡw\xb2͙iՑ\xea* \xfa \xb0\xea\x9cp\x15\x10\xb5NT\xac\"\xab@\x8c\x1dN\xf6\x16\x8fU\x00\x1e\xed:\xf7|R\xb6\x89\xdcJ\xdeJp\xc1}\x9cټ\u007f\xe0\x16\xb1̖\xf6\a\xddЖ}$\xbbT\xd9um\x17\xc7UW\xb7\xff-\xefe\xf3xD\x99\xff\xbe\xc1Y\"h5 U\xc4];\xfcD\x19\xce\x16\x97\xb6\x8al\xb9\xa2\x89\x12݃\x922_\xe9\x88y\x10\x97\xab^ðt\xbb2$Z\xa3\x19XJi\xea\x85qi\x86\x97Բ*2۩\xa5Q\ncˣSeAU\xb8\\O\xe6\xd1\xc1<\x9dJ\xd7Ye\xd1\xfa\xdc\x1b\xa1\xcf##\xb8\x99>\x00\x8b\x8ay\xc0r\xea\xafC\xbc,_\xfbL\xba\xbb\x02\x04\xba]A\x90G\x93t\xffS_\xac<\xeak\x81\xa0\xf5\xab\xf2\xcdI\x8b\xed\xee\x02\xef\x02<ێ\xff\xe8\xa4U\xfd\xf2\xa4D̹R\xcd?\xe3;\xd5ckeI\x05\x1a\xc1&\xae\xe2\xccUg*\xe1\xaa\xcc<\x80\xd4c\v۰\x9eMWy\x12\xf9c\x96\x8d\xd2\x06I2i\x13\x10\xbf\x1a~T\xbb\xec\xd1\x1f\xcbHj\x16\xffy6\xa3(\xb1\xf9\x848[\xeb\xf1ͥ}\x88\xaa;v\xab\xe2-\x18łS\xe4Vt%w\xcfЫ\xe7\x893S\npE\xed\x14X1_֙1\xf6\x9c\t\xde\x0f\xf8\xa3\xe7\xc3\xff/OT\x1e\xcdB\xb4\xf6AI\xf5\xea\xf0XG&\x1f\x91O\x1e\xe5YY\xe9\xfd{\x80\xfe\xe6>M\xc7\xccA\xce\f\xbe\xa5wxv\xfb\xde{F\xb1\xc6%|\x03\x9e\xd0v\xa0\xb3+\xfe\xeb\x11\x02a\xb9\xd4\xf8\xfeBP\x182\xfc\xfbɺ\xf9\x04c\xf6\xf2\xbb\x8c\x10\xe3\x13k\x11\xaf\x90q6A\xb30\xb9\xe9\xf5\x9e\xb5\xb9\x1d\x1f\xa0\tDu\xe9'\xba\x16\xfay$]\xff\xe2\xa7\x03A>\x13\x81\v\xdbȫ\x01\a\xf7\x91\xba\xa9\xea\xb4ތ\x06\xf5\x19;A\x0fߏV\xfd\x85Ⱥ\x1f\xd4\xe3\x95\x17\xa5\xb7\xa2B\xd5]\xdfm(S\n\xaf\x8aZh\\.\xbb4\xfc\xfdE\xe0\x94\n{V\xaf\x01u[p\x82\xa2\x98ξ\xf3mh\x0f\u05fe\xd5\xd7j\xbd\xe8\u007f\xfc{,\xec\x01M3\xf3\x04+$G؋,\xdd/\xf7\x1eG\xe51\xd7Z\x0f\xa8\x18:+\x85\x0eCd\xadq\n\xca\x1f)}\xfc\xc1*\x91\xcbbt\x02\x1d\t0\x1c6\xa99\x8e\x9dxm4\xb7\xab\x9e\xafU\xbf[\xdbh\xaa\x13\xb24\xe1\u007f\xe4/\xad6\x99\xdb\b\xa30\xc80\xd1\xe7\f\x15\x9f\xd6+\xc7n\x9dG\\\x9c\xf0P\xbeVJ\xef\xc0\x93\xa6\xea\x97D요\x19\x98\x86z\xf6\xb3n'6xwPK\xc7\t\xb3o\xf0\xae8/\x11\xf8\xddy:>|\xcb\x1a\x17{`\xd8}O\xfc\xa7\nBu\x9a\xac\xf7(!ԕDڸ*\a\xa4\xec\xdcw\xcdW[\xa1Sa\xb3\x85Џ\xb8\xf8\xad|W_tΊ\xa3\xdfU\xaci8\xb5\xaa[ϓ!\xcf9\x92\xaejV\x11j5\xa5\x8cu\xef\xcf!\x96i_\xbf\xdb\xe84\xb7\xa3]#@<\x0f(\x16\\AI\x83\xd3fĬ>\xe6\r<\x14\x028\x8a\xaf\xb5\xd9\xd0]\x02 \x96\x88\x89\x18\xe3\xe9\xa0X\xfek.I\x9aQ\xec?)\xecy\xf2\xc1\x11\xbe\xfc\xf9 \xc6\u05ec\xd5(^\x8d%\x8dO)\xef\x0e\x03u\xf3\x88Y\x82\xbc&\x90\x8f\xa78\"\b\x19\xf8\xc7CQǠ\x17\x80{\x01\xc0\xbdFh)\xb6b\x01q\xa6\xb3\b\x82\x91~L\xc7To\xbe\x87\xcfS\b\x97\xb2s\x8eQ\x88\x18\x82\xbe\xf5\x9f\xf5\x1f\"d\xf1,\xb1皾͘y\x1f\x13zK\xb4\xf8\xff끥\xfd6O\xe9\xf20F\xe54u\xa1\xafw1O\x82\u007f\xf3\xb9:\xd8Ŵ\xd0U\x8a&\x19.@\xb96[\x93r\x95\x80+l\xe1͜\x92 <\x82ܓ\xac\xf8:\x1bgb 0W\xbb40\xafP\x91Y\xcd\xf9\xb7\xab\t+\xf4-\xda\\\xea\xfb,Yr\xc5\xec\vXV0\x00\xfeh\xa9B8\xc4\xee\x05\x92H\x16\x92\b\xc0\x9f3+C\xf5\x06\xffZ\xc1EKQ\x81(M;lT\xaf8\xdej\xfc\xdc\x1b@\xa7\xfaW\x93\xd1\xe4\x14\xffU)\xeaJ\x037.\xc1]\xbdS\a<\xbf\xf0\xab\x9cM\xd8lb\xbaﲋ@\xad?L\xe3\xe9\x1b\xfa\xac\xbb\xf9z\xbe{0\xc1\x9d\xa0\x979\x98\x82.\xab?\x8bY:ac\xfb\xc0\x99\xb35\x00/\xfee7[D\x9f'E\x9a\x80ؾ\xb78E0n\xc7#\xf0\x19\x0f}>c\xb1^%\x18\\\t\xee\xa3]Q\xb7\xc5ǜ\xf4\x8b\x0e\x8dz\x03r\x15R\xe2\xa7\x1a\xa0\xba\x04b\xdePw\xa8\x00\x8c\x05g\\C\x05\x00R\xf7%\x82!\x91\x00 \x15\xe0i*zP\xcc^\x8e\xb3\x11.\x1f_c\xf6￫|\xf2~\xf7ӊR\xa3\xfc\xfd\xaa\"\xf9J\x18Ei\x89\xd2\vYB)V\x9cc\x04L\xe7\xc8Z\x9aF\xf9\xbc\xb0ou\x91\xeb\x96t\xeak\vX\xf6\xc7\\\xf0\x98)\x852.\xbd\x13\x82\xe7\"\x9fO\xfbi\xe0\xb6\fV{ۛ_\xef\ufdcf\xf7 r\xde\xdf\xd3\xde\u007fg3\x19\xbam\x1c\xdfe\xd7x\x99T\x14\x9b\xbe\xbc\xc6'\xac\x9fG\xbb\x1d!\xccۊ'\xf3\xb9\xeb\u070fa sW\x9b\xb6\f\x18\x17iՂ\xbd\xaf\x92\xc5?7WNTS\x18[\xc9\xecJ \xd5ђ\x81L\xd4P\x98lTG\xbb\xf9\x80l!\xae\x04J\xd9 C\xe9\x8a\x05X\x92\xb3\xb6c\r\xa5\xc6\bRѭ\x16}I\a\x82&\xeb\x95M%\xb4 \xe4B<]\xe1\xb2\x0fJ\xbe\xa5\x92\xf5KX\x03;\x91\xd8}\x9b_v\xb8\xbb&\xdd\xfelx\xad\xb7\x86\xf9\xf5Z-\xe5\xd7u\a\xc1\xba|ǭ\xf2'\xfe\xf5\x1e\x95\xf5u>\x15Ӂ\xec2\x03\xfc]\xaf\xb5\xdaiMߺ\xd20\xc0\xf13\xc7g\xe1 \xa4\x9dCwDG\x17\xf6eH\x05d\xbc\nȮ\x05ew\x11 \x8b\x03\xa1\x8c,\x00#@\x96N\x00b\xf1\xdbtV\tҴ,8=]L\xf2\xb1`\xaaz\xeb \x00+\x9f&\xd98\x1e\xae\x83\xa1,Z\x87S\x06\xbd\x80\x9fF5\f\xc0PSkb,XS0gt\xe5\xef\xf3d\x93}\xf0\x91\x9fO\x97\x9d\xf6:\x9dP\xad6Z\xd8\x0e,g\x06@\x05\xf4\xee\x87B\xefVC\xef}0\xf8^%\xfc\xfe\a\xc3\xef{\xb3Ȣ\xfc%\x84\t\x19\x1c\x1dA\\\x01\x19\x9bL\xcc#\xf8\xd9\xed\xc7\xedn\x1f\u007f&\x87ݣ\xee1\xfe<\xd9?<\xea%\xf8\xf3\xb8\u007fp\xb8\xdfß\xe9\xde\xd1Q\xbf\x8b?\x8f\x06G\x18\xbe\x00\x03E\xf4\x92.\xa5v\x8ezi\u007fP\xa3\xa0\x98\x0e\x1e\xdd\x00\x1eq\xda?J\x8f]\x94\x06\x83^\xef\xe8\xd8\xc5\xee\xe48\x19\x1c\xc7.\xa2\x83\xc1\xc9\xf1ɡ\x8bs\xff\xa0׆\xa0\x1b6\xfa\xfd\xfd\x93\xfeɾۓ\xc1Q\xef0\xf1:\xd5?\x82\xff\xdc\xfe%\xbd\xa4w\x9c\xf0\xaeb\xc3i\x12\xa7\ae\xbd\xee\xc9n\xef\x9d\xec\xf5\x8eN\xb0\xc2A\xf7`?\xdeß\x87\xbdC\r\xa6/\x00\x11\x05\x0e\xf7\x8eN\xf6N$\xfeq\xf7\x80Z\xef\x1d\xf4\a\x87\xb2+i\xd2;\xe9\xcb\x02\x87\xc9^\x87\n$'\xa9\xac\x96\x1e\xf5bY-=\xea\xf7d\xb7\x8f\xf7\xf7\xfa\xb2@\x9c\xec\x9f\xec+r\x1ev$\xdc\xf4\xe8\xe4P\xc2\x15c\xd49\"$ッ\x8e\x84\xd0O\x0f\x13I\xe4$\x15\xf8\x1e\x96u\xbb\xaf\xba\xdd9\xee\xca\n\x87\xbd\x18*\x10\xc9\xfaqJH\v\xf4{)Q =<8hS\xd9Ar\x9c\xec\xf5\xe5\xcf8\x95\xe8\r\x92\xa4\x1dS\xaf\xf6:\xf1\xde\x01\xe1t\xb4\xdf\xdf?\"\xb8q'99\x91$:JO \xee\a\x1488\xec\xf5:\xb2ᓸ\u007f\xac\xc75\x91\x9c\x1f\x8b6z\x92\xf4\xf0\x1f\x95\xc5pg\x92\xb2=\xdd\xed\x13\xf8\xaf\x16]Ys\xf9\xb79\x04[\x1c\xa6\xeb\xc8VU\x16\x85\xeb\xe5U@2P\x89\xf7\x1b@{\x9f\x82+\xed\x8eY\xca\x03Pg7Ӵ\xb8ɇ\xc9:`ua\xa1&\xb4\x0e\xb8\x92\x10\x80L\x8aL\xe5[\x04\rX\x95\xad\x87\xe1\xdd][\x9a\xcd\xddu+\x9e\x06ţ9\xe4\x9a\xf6\x1d+\xe6\x14D\r\xba:\xfa)N\xb2yQ\xf2\x92\xa9\x19Ma?!T\xe8\x95\x05c\x80X\xcc\xe2\xa9Pѯ\x87e\xae\x9eȣ\xba\xc0Y(\x94\xfd\x1f\x06\x03\xa1\xa3\x8blh#\x1d'\x1bWL\xc0\x95@]T\u007f\x0e̓\xdd\x15S\xe2\xb6D'\xeeH\xe0I\xdc\xc0_\xbb\"\x1d\x94\xab\x01\xa6\x89j\xa8\xaf\xfc\xf8-\xf8\x97j\x83\x83\xa9\x1á\xbev\xc4\xd2ϋ:\xd6(tR\x01q\xd9!\xa9߱Ju\xa0T\xc7*\xd5q\rR\xa2\xc9\x17\xe7\xac\x1f\xaf\xe3\x05\xc4\"nC\xf3\xaf\xdbM\f,\xdc\x01\x9b\xd3K\xf3\x93\xa5Fm\xc1\xbe\x9d\x88\n\xee\xae]\x92\x92\x14\xfc\xb6\xa9\xd56\xb5ںV[\xc3_\xb7$%\xfd\x03\xc9\xf7\xb1{\x81\xadH\x82\xc9ԧ0^\xac\xe2S\x18\xac*\x98\x18vv\x80\xe9\x9d\xc8\x00\xe98@\xf0\xfb;\xd9A;\xbf\xad\xf3K\xc9\xc3\x1a\xa1\x0f\x02\xd2v\x80p\xd2\xfd!\xfdi7\xdb5j\xd3\xf3)`\xa4Aę\xf4[\x93\xde\xe4\x92\xc0*\xf4\x83Io2)`\x95\xb9\xd0\xc9M=ۭ\x02_\xc9D\xc2L$\xb4l\x9c\x8c9\xdf\t\x98\xef\xfb7\x90\x13\x8e\xd5\xd7f\x15\xb7\x9b\b5\x9f\n\xa0\xcel\x15\bX\xef\xd5\x01!\xbb\xff\x9b#\xc4\xea\xab\xd6<\x92n\x84\x90E\xeb\xcd\xf11\xd5\xf5\xf5|g\xf06\u0086\r\xeb渤\xd6\xf0G\x0e\x8fl\x84G_\xc0\x9f\xe6Y\x12vH\xa0\xe2\x9d\xd7W/\x80b\xaa\xac^\xfc\x1aѳ\xa8+\x12\x00\xe4:+\xe0\x8a\xa5\x0e\xc1\x05\xd6;\xa7ߗl\xf1i\x88\t>m\xb2\xa5\a\x13\xa2\xab\x90\xbf\x05I/˜\xc0\x9b\x81\xfb\x8ajY\xc4~\xd9\xebչ\xc0\xed\xa9^7w\xa3N\xba{\xc8T\x11ت\xaf\xd0q\x04,(\x05\xbb3\xa5\xe94B\x18A\xa1o\xb5\xa5%\x9fza\xbd\xc9,p\xca\xcb\u007f\x87I\xb4\xf9\xaeQ\xfen\u007f\x98\x17iR\v\x94{\x859\xb24\u0603w\xc9i\x9e]\xf6Bd\xd0#u^2\x1e\b\xcc\xfc\x82/!Y\x06Ԏ\x8b\xac\xb0\n|\x01)\x12\b\xe6\xee\xe6\x93t\\\xf3\xcb\xfc0Q\x96vY.\xd4\a,ɻЛ\x8f\x13\x88o\xc7\xcb`\x92\n\xe3M\xdbc\xab\xc0+\x99([Se\x02\x88\xa9\x92\f7]:\x84\x9e*\xcf1\x1c\xe5\xe3|\x96\x8fm\x1c_\xcbD\x1e\xcb.8\xfe\xebĵ\xa3cV\x91N\x0e\xd1\xceX\"!\t\x06X\x89\xef\xff#M\xb0\xe0\x01\xcfD\xb4s8\x10\xa9\xfcE\xfa>KQ\x15F\x95\xbc+&\xc6\x1eh\xe6\xf8\xa7MV\xc1P\x8d\xae\xaa!\x8bvW\xd6\xd8\xe35\x0e\xad\xa6\x0e͆JN\xb4\xd6T\x88\xa5\xb0\xb5\nz!\xe7\xa23\xe7\xd4\uf7f0\xae\x94\xa6\x1a\x1a.B\xf8\xb5\xc0Ӹt\x96\xcaOV0\x96b\x19?\x96v\xb9\xa5%l \xc92%zs\xaa5M\xef\xd2i\xe1 \xaa\xa7R\xa0\x1e\xa6\x97W#\xb0\xd6\xee(\x8dW\x8b$(\x15\x16I\f\xcc\n\x82˖\x1c\x88A\x82khHp\xfc2\x04\xa7OV\xd0VZ(\xbb\xed\x14o\xb3\xf2\xb6NA\xf9\x1d\xa7|\x87\x95W\x03\x8a\x1fK\xbb\xe0\x92\x95\xb3\x94\x03\xcau\xd0Xr4\xd8\nN\x99\x0e\x0eˎ\xb32\xa5\xb1K\xf5\xfeM>M*7\xb7X\"p\xbf\xab\xa0\v\xf7x\xb3X\xae\xb1\x05\x1ew\xc8\xcb\x02\xcdh\xe6\x97\x10\xfd\xbbƝe\xe0:\x01i\xe8Ek\x02\xea8l\xa9\v\b\x91&\xbe;\xf0/\xee.ş6\xac\xf0\xf5\x14nH\x16\x10\x8f\bOs\xfa\xf3\xe9]j\x97\xa7\x9fm8\xbb\xf0rg\xf0s\x86\xb9ԒJ\xc0<li&[\xa2\xba,\xdf@n\xf8z\xbe&\x9a\xeev\x91\x0e\a\xcdh\xa0.XY\x044\xf7\xd1\atՂ\nK\xea\x81\x06E\f\xcc3\xcd}\xfb,`\x14()\xb8\x86U`\xfd\x9aΨ\x99\xfbrBS\x98\x9aw\x10q\xfb\x14\f\x05\xe6\xbbs\n\xf6\x02\xfd=i\x83\xe5~\xaat\x1dm\t\xd0)\xd2\x10\x10\xb1\xc7\x17\x93\x8e_\xa7\xe3\xd5\xe94\xe4i@ٝd\xc9<<L\xa0\x99#-\xa0)\\~!\x8bG\f\xec@ߝ\xf0\x05g\xc1>\x82#&\xa1\xfb)rOi6\x8alG\xb9S\x8f\xa3\x17J\xcfk\xb0\xdd\xe5$\xd8\f\xf1\xe1TH\x83I\x1b\xacF\xe2o\xc7o\xef\xff\x15+\x1b\x81\x9ft\xdc\xfd'MN#>q^_\xc8\x19K\xf3\xd2\xc9|#'\xeb\xd4ُb\xe6\xe3nF\x11\xa4\x91כon\xa6֞o\xba\xcev\x0f۴4x\xc2B\x13j\x8b\xed\x1eV\xd5[=F\xf1\x8d\xb1\xd0#\xb29\x16TU\xdfX`C\xbb9-\xfe\xb4\xedo\x19F\u007f\xc6\x16\xd8\xc7\xc5\xcd\xe0K\xaa\xd0B\xae\xf3\x92CN=\x9fU\xa1\xc0\xc2:A\xa1\x8e\xbc\xe3\xdd\xc0\x13\xd3\x1e4X\x1a\xd3@.\xbc\x8b\xaaOڭ%\x88\x80\xbd\xd6Rn\x9d\xf1\xcd\x13J\x0e#\xae\x17\xa7\"A(E:ay\x1a\x8d\x94\xdct\v\xee\x95\x17\x14\biW8\xd0\xce\x04w\x90\x93i\xfeN>\xab\x0e.\xf3\x13p@$\x84\xde+\xfa\xe8\xe0\aI\xae\xcb.\xffػz<Af\x902\x05\xd4@\xfc\xa8\xf3d\\C\x99\x1e\x10\a\x8b\xc7\x13\a\vτ-\x9b\xb5c,*\\|\xa1\xb0x<\xa1\xb0!.\x1617\xc7g\xe2\xd0;\xb2Gg\xb1\x0e.\x81\xbc\xd0D\\\xb1\xab`\xf3թXo\xac\xcb3\xbc\x1c\xbc\xd2\x0ePiS\xf2\x95\xbb!`M\xd5\x1d\x8ch\x0fd\xf0\xaa\xdbDm\x80:좴\x1e1\xd5M.u\xa9\x8be\xf1{O\xfc\xa6\x93\xcc.\x96\xa3^^-\r\xa9\x88#\v\x95\xdb8\xd9E*\x83o\xc2\xeaڳ\x03\x97~\r\x15\xd0U\x17~\x95M\xfbôQ/\xb2\xf7\x81\xe2\u07bdS\xe3oGCx\x83\x8e\xe4\n:\b\xb5r.D\x1aA\xa0\uf5ac\xbe\xc5̔\xce\x1cp^j\x1cJg%5g\r\x9dĠp\xcekחS\xba/\x11\xeb\xeb&\x18x9\xae\xb1Q\x8e\x9ekb\xec\xe3\b\x9d\x86\x86M\x1aզyQ\xb0h2\x80\x9eoi&\xe5\xff\xb7\xe9\f\xf3Św\x80\v_p\xe5\xd9\xddCۭ\xd2\xc9w\xf1\xe77\xe6\xe7\xcfv\xa1o\xb4\xe2\xfe\xb3S\\\x17\xf9Y\x17q\n\xdb@vC\x85MCz'\xd9T\x82b\x94\x8f\x93\xea\xae/C}\xaf\x83\x01\xd9\xe6\xe2x\xbc׆\xb8\xd5S\xb0/\x8bZ\xa1|\x8fX\xea\x04qi\x0e\xea\x16t\xe0V\xa3}\f\xedi\x96z\xb1\u07b5\xf2\x9d\xfe\x14b\xd35M7\x1cɊQtG\xf0;{\xabE\x89Qh\xff\xe5T\x0f\x11\xbf6\x9bfh\xb3\xd9M\xf2{\xb0\xd3Va\xbd\b\r\x82-+D\xc6\x1e\x90\x1f\xc6K\x94\u007f\x1a\xc8\x0e\xf6\x94\x93\x98\x8f\x00\x1b\x18\x8b\xf2,\xbd\xb4G\xf3ɟݟ\xddp\x87J\xfa\xe3t\x87Y\xb1\xd9\xea\x02r\xbapEt\x01v\xea\xa2\xde\b\x8a\"B\x92\xf7t\xaf\xd1\fL\vUd\x16\x8f\xeb{mv4\xf3,\xea\x1c\xb79&\xf1\"+\xaa\xaf\x89\x88\x02\xf5kC\xed\xebҐ<\x84\xef\xb5\xd4H\xe8\xe5\x0f{\xcef^J\xf4o\xa1I\xf8\xabb\xc6\xe8\x87+\xf2\xc6\r\x16\xb1\xbe\xe4\xb1\x18\xa64\xb1\xeeK\xb50\xbcE\xd7EX4\xc9GqF\xbe\x8c\fx*\xae/Ś\x8f\xb7\xc1\x86eA?i\x15\n\xf4\xacF6-[\xb0\xbb^\xcc{\xaa\xf7ʦ \xa8{1\xef%\xd9]\x96\xa4\x1c\xb4\xc4Z\xe75\x9a\xaa6xj`N\x01k\x10\x18(\x9f\xd6\x1a\x18\xa3\xa8\xae\x9ahJtL\xbd\xaf\xa4??\xf9IN\xfb0\xa6\x1b\x06\xc1\u00831\xb8\xa9u-@a\xa0\xd7\x1a\x86ń$lV5Ca\x14k\xf9$\xeeg\xb3\xa5Ȇ\xc3>\u058c\xf2\xa0߲\x9d9P\x9b\"\xb3\xde(\x83\xa1\xa2\xa1\x18`\u007f3\x9e\xfa\x03\xe0Bp\x02\xcc\xe6\x12\xecZ\xd1\xca%\x14\xa7R\x90D\xd7@\x8c\x89\x98J\x15d(\xa5\xc1f\x04\xa8\xe8}iש\xd6\x1b(\t\u05femJ\xe0\xe5W\xc9x\xc0f?\xe1eX\xfc\t\x1b\x06\xd1)\x8f\xb1h6)j]\xc2\t\x95,\xaa\xa8\x04\xbf5\x95d\x14;E \x87\x87\x140\x02P\xd25\xc8rF\x101\x04\xab\xaf\xbc\xfc\x9bO\x96\xc8 \xf0\xd5V\x0fjߊ-s<\x9d\xbd}\v\xda4\xd58\xb3\x9fD\x9b\x12\xe7~\x015\xee\xba\v8\x17\x82Ӡ֨\xaa\x87\x0f\x9e\x9d\x0e\x00,\xceUTC\xcaG\xd9R\x13Ki\xa2\x98\xc1\xf7\xca\xc9'\xd8\xc8L*\x8fZŧ\xd4u#{d\xe1\xf2\xa6\xa9\x1a\x95\xaaj\xda\xedTq\x9f\xcd\xfa7Q=\x9ff\xf8.\x85\xf9~\xe9\x83;\xbcZ/\x9f\xcd\xf2Q\xed\x94\xf9\x84\xb1\x9d\x15Ylj\v\xc3_\xce\\\x97G\x8c\xca8\x1cK\xb8\xc8\n\x89\xafA \xc1\x8e\xa6\x11\xac#{A\x95\x16P\xa9\xddX\x17\x84\x1e3\xafB\xfc.XASZU\xa85͛\\\xab\"\xa0\x01\xde\xc3Eڏq\x92\xa8\x87\x98v\xdbk\xe1^\x81\x8a]ߪ\xbe=b\x00XBI\x00L\xadu\xd4IGz\x9a@\xf6n<\x06\xdb\x15d\x8e\xb2$\x19\xa65\a\x86\x99\xfb\n\x12\x94\xa5[n \x90\xa4\x81\ru(\x1a\xf8\x04p\xc3=H\xfb\x1bS\x8cLo?;Ŝ\xc6z\xd34\xbe-\t\xc4E\x9c:\xcb'\x1f\x95Mw\x1f\x81Ow7f\xd4\xddM9u\xb7\xbe6Kl\xc1\xac\xbb\x1fƭ\xdb#\xe71l\xfbc\xb2\xeb\xee\x9a\xfc\xba\xfb!\f;L\a\xb3\xed8\xf6ו\x1c\xbb؞c\x9dZ|\xf4\xdb+8v\xb11\xc7.>\x02\xc7:\bTu ȱ\x9b\xe0\xc3x\xbb\xbdR\xc4\xeeu\xcbyV\xa8\x1c\x1b0\xac˟M\x97\x8b\xbfi\xff\xec2\xec7\x1f̰\xd3\xec\xfa\xe6\xe3r\xecV\f\xfb\x81\xfc\xba)\xbb>\xaa*\xf0\x81\xac\xba6.\x8fƧx|\xba\x01\xa7n˨[\xf1\xe9\x83庋Y\x1cl\xa7\x86\x86\xf5\xc8$\xcf\xd9V\xedF\xacF\x19ߕ\xd6\xe8x5`\xe7\xb8nykB\xac\x89\x95=!6lhm\xdc\x02\xcetє\xb5Л/z\x8b\xf9E\x8cQ\xcf\xf1\xb4w\xc1\xadP\x89\xeb\xfaT\x99\xea\xb12d\xef\bhր\x9e\xad=X\x8bM\xc6i\x11\xf2]\xe9\x9d¨\xa7\xe5\x81\xc7ɴK\x02\aer[\xe4L`\x91s\xe8\xc8#\x93\xa4f\x81N\x90sS$\xec\xb9&(<-\xef\xe0-RnZ\x93\x8eX\x98Mʱ,\xa1'\x14\xba\x9e\xb7\x00\x97=\xb2+[\x1c\xc8@M}\"#\xc1\xb8G\xa2І}3\u007f\x81\xf1\xab$\x916o\x95\xaa\xeaw\x13\n\xd0z\xed*k\\\xc0\x9a\xb9\xe2\xf8\xcb\"\xbd\xf1\xc8\xe2\f\x88\xae\xbf66zض8\x92\xd3\xd59>\x1a\xe0\xfa\x14Ѧ\xd2\xedppL\x9f\x96\xbdu\x03,.܃\xc1f\xb4lF\xef7\xc1DO\xb23vT\x10r\xfb\bO\x168\xbe|v\xee,\xac\x1c6I\xc7\xd1\v\x8c\x17\xb9\xb3\x94F_\xaf={\x0eCyx\xa5\xb6c\x02Z\x8d\xafʪ\xae\xa4\x8f\x11\x05\xdb\r\x93\xac\xcfQ5 w6\x18'&H\xb6\xc3DC\xe0\xb8p\xb0\xab\xb1q\xd2\xf9\x1d\x80\u07b4\xc4k\x8f\x89\xd8\x0f%>\xf0\x8c\xa3\x19\xf5\xae=\x03*x\x97\x81[\xa9\xa0\xa7\xdbFԁ_X\xe8L\x82@n\xc1\xd9{\xaf\xe04\x85\xe3-U\x90\xbe\xb8\x19\x9b\x85ѻVfb\xf4\x8c\x92Nw\xd1\xc1\v\x1a3\xc5Z'@\x88\xce\xd5\xf0f\x04\x1c\x19\x12\xa9D&\xfeE\xad\x8dJ\xe0\xf5\b\xfc\x0e\x151\xcd\xf5\xae=c\xf0T\xa0\xed[R-\xc2H\x14\xef\xb2\"\xebeC2f\xd7n\xc4~<\x1d\x9b\xdc\xfe|Z\x906\x89\x87\xeb7q6\xe5\x1a\xe5`ݖ5\x95=\xb8`y\xb7\xac\xbb\xef=\x90\xfe\xd1H\x99Ƣ\x0e\x0fit\xe85&S$\xfc\xe6W\x00\x92\xdb\"\xa4\xfa+\xacs\x99\\Y\x00\x83\xdd^\xac\x86\xfc\xec2\xbd\xbfR\x8f^\x12\xf42\xb4'\x83\x89\xd9\xf0\xf5f`\x15\xc0\u007f^\x8e\x8b\xabu\x01\xdeg\xc9\f\xf8\xe9P%ܤ\xb8cĔJΰFJ\x96L\xb2b2\x8c\x97\x8a?)FS\x1dp\xa8\x8d\xf3qZs\xa3\xa4\xd1(㙌>{\xb1#\xe4-\x9cp\xe5\xde\t\x8a\xadM\x8a\x19`(\x9fb\x94l\xbb\x9f\xb8a٥\xac3\x8bx\xc94\xbe\xff\xa5~\xdd8+ٚ,Wa\xb2\fc\xb2t0\xd1\x04\xaeF\xe5\xd7\x12T(\x97e>\x84]!\xearL\xaa\x06\xa5\x98\xdc2*\x95\xbb\x94\xc1Ԕ\u0092\xe83\x11f\x15\xcd\xe7˝g\xa9\xe1\xe1+n\x96\xd3\x05\xfeY\xb0\x02\xb4kl\xd4\xd6\xea\xc4/\xa1^p\x89͆\x9cZk_q\xa2\x86\xe4|\xb35~\x01S\xb5\xd9*^XSV3\nA\xea`gvC\x80\x83\xb8\xfe\xba\x1e\xaeK\v\xd7\xce*\\S\x89뽍\xaba%\x8dl\xc7F\xb6S\x82\xacY>\xd8\xe2\xaasqE\xf2V]\xeb\xc6\x1e.d\xad\xfe\rp~\xf2\x86;*+qV\xa6\xee\xf8\xd5\xd5k\x1e\xe5\xb6\f\xe8{\xaao\b\xca\xec\x86\xeb2[\xe3v\x9b.a\xad\xac\xbbN\xbf5N\xa2\xc0+\xf4\xe5*\xf6h]?l\xce\x131J\xd7\xd7\x18\xfa\xd8\xde\xe2\xf6U\xc0=[R\xca]\x8d\xa8\x81\x8cpn1F\xb0X\xc7)\xd6q\x8a)\x04\xe0\xd5n\x89\xedN\xfb\x82{\x15\x8f\xfb\xe9\xb0\xde(\x0f\xc4\xcf\t3\x9f\xacI\x16\xf4\xb8\xaa\xd18\x8f\x1c:\x99\xfe\xee\x94\xf7\xd7\xf4v\xa7\xbc\xb7\xac\xaf\xed\xb3\x0f\xeb\x1f\xb2,-\x11\x0ec*\xbfv\x92m\x9b\x11\x94J\xfc\xa0\xc6@\x8f\x1c\x9f#\xd9\xdd\xc5\xfa\xb2\xb7\x94\xeft\x95\ntx\x01\xdeM{\x9d(a0k0\xe2\xe1\xec\xbfӥˁX\x9bذa\xd8\xf12\xaas\xe1cD*\x8e\x88\xb4\xde\xd4\xf9\x94\xb7\x8at\xa8HtU\xc6\xd2z\xf0v\xea\x9a\x10\xcfe\xf3\xd0@\x15\x9f{u;\xacn\xe7\xaa\u1c834L\x95L5\x9b\x8e\xb8@\t2\xfe\x02\xcc\n#ڡ6\x9a\xd1\x02\f\xa2\xae\xee\xe3/ߑ\xe6\x83\xd9t\x9e\xaej\xe6W\xaf\x99%\xdc\xe9\b5\xf3\xeb\x16\xcd`\x99\x100\a\x16\xf2\xc7['\xb8\x10\\\xf0=\x15Z;\xe9\xfeV\xd6\b\xa3\x0e\xe9i\xf6\xb9ԣ\xc1w\x89\\\xe1\xcb\x02n\x95\xcd3\x8b\x06\xf2FT\xe6N\xb9\xea\xdb,\xe8\xc8HY\x8c\xa5\xbb\"e-n\x8a٤\x9dWKN\x82X\xf7\xf2\n\xb1a\xdc\xcc^ɠ\xcc(\x1b\x8b\u007f\xe2\x85=\xa5\xc3\xf3M\xe0 \xa4\xb0j\xcbү:\x90S\x90u\xdb/`\xc6M4\xc7]{\xc3;:\xfa\xc8\xc6u|L\x87,\x9f]5\x1a\xeb 4B?\ru\x00\xbac\x10\x03K*\xbfI\x1d4\xde\x02P%\x17\x18\xf5\xca1\x83˼r\x16\"\x19E\xba}\x0e\a\x105\xa4\xe7\x98\xef\b#BWd\xd8s\x9fh\x12\"k\x00o\x05%X\xbcd\xa2\xf0\x11\xc7(\x1f\xd0\xe2\xef\xbfی\x81鱻7\xc0\x12_\xe2=\xa7\xc02n\x01\xf6zf\x81?\xb7\x99\xcc\xe83\xc1\t\x1e^\xa9R4\xad\x1b\xfc\xd8\xf2\xb5\x81} \xa8\xb1o\xbd\xd926\x93Z/O\x96\x81]\xb8S\xe1\xde\x18(\x00um}\xa0h\x1b:o>\t\xe4\xa0~WR\x8bt\xbfq\x12Ȓ\xfa]8\xc7m\xc8`\x1a\x90\x9b\\j\xc2Q\xed'!1X\xa6\x88<0\x8b\xa5~\xf7D\x0fA\xb0\xfc\x1b\xf3\x16\xcaxٗ\xab\xbbTaeI\xb0\xbaRz>\xd0\xca/w\xd2\xe6X\xb3Te\xb50\xa9\xea\xd4\"\x18\x9d\xe6\xa3:\xcb\xffE\x14x\xf2\xec\x9f\xf5\xf1\xefEC\xed\xc1T\x1e\x86\x12[\x98\xb2\xbfʲ\xe9\xef\xf7\xe1\xb2\xe0f\xc0\xa8l\xbc]\xb4\xb8\xa4I][p\x9aR\xc24\xa5\x14\xe7\nXn=\xfa\x06\x1a\xde[\x9d$\aҍ\x12\xee\xd2S%\xccbj@\xcb\xd8̩\xee\xf1\x9a]\xdf\xe57\xf9\x1db9\xfcjpW\xe0!I\x1fЬ\xdar\x13\xa9\xb3\xce>\xa9Х\xdar\x17\xa7\xb34O\x1a\u007f\xf9f\xd0\xecu9\x05q\xbb\xf3\xec\xde\x1b\\\xc1\x8cK\xcc\xfa\xe7\xd8\xcdb\xc8(_C\x97Z\x1e\xe2\x1a\xec\xa2ߴ\xb2\x97.\xbe\x91\xdf?N\r\x82WI\x02\x82\x19跧AkU\x92\xa0\xb4\x8aa\xd6g\"\xb6B\xc0\xa2\x8c\\W\xc2Z\xd2f\xb5\x18\xe5\x13\xc7\xce3˰'\xb1\xb8\xbc\xa2#\xfbO\\y\x15XD\xc2\x02\xcc\x1c\x8db&\xf3.\xfe\xa5\xe8S<\xeb\xdf\xd4\x11V\xd3j\xaf\xa9uL&6\x1bt6LǘK\xfdKڽ͕\x11\xac\xf0\x13\xa52\x1eAnB\xe79m퍳\r\x01\xfc\x9bւMh\xd3Bf\x1dE\xaf}\xe0\xa4\x17l\xa8\xfe\xde\x1cXT\xa1\xf9d\x11=\u007f\x1eu\xa2ߣ'\xcb+\xe7\x8c\x03\vZ\x87\x1c\x84\xddr+\xec\x96\n\xfa\xf2\xe3b\xa7\x89\x1eB\x11\xf8\x01\\Ԁ\xdb\x19\xf0\x11\x03\xae_fg\xd5\xf8\x1b\xfe|\xaf\xe7\xa7Ա\xb4VVi\xb2]\xc0f\xe0=\xc9@h\x1a\xbf<k\x02\xb6Ρ\xbb\xfa(\x82\xe1\xf2T\xc2*7\xc7 .\xadl|\x97Nņ\x1f\xeb\xab\xcf\xfa\x02\xbc\x86`}\x93\xd4i\x04\xea\x83\x1bSQ\x18\xdd\xd2 \xe9\x10LGV\x9e\xad\xa3\xd1:\xfb\xfc\xa5\xa6\al\x86\x96\x9a\x1e\x9d\xcd\xe8\xb1l;+\x86\x84UnwB\\4=\xb0\xbe\xfa\xac/\x81\x1eX\xdf$\x85\xe8\xb1\x04z,%=\x90\x85\x10LGV^I\x0f5WQ\xd7\x10\x9a*\x88\x86\x05\x81A\xe1\xb0 HB<\b\xedu\x81\x05\x88a1a\t\xd5.%\xeb\x9aUF\x81w6\x00a\xb1\xc3\xd9\xdd\xe2Tͧ\x8cI\xcf܁\xa4\xe1o\x9bR\x8b\x8e\xc5w\x1c\x05\xb9\xec\x13\xdfX\x19\x1d\x95\xd1qf\x8eí\x9cMm\xfe܌7-5\x04\xcaR\x98/\xcb\xc4\r5Md0CT\x8f\x83\x97\x86N\xcb0\x9d\x16\x8a\xbbچ-\x97\x1d\xab\x98K'\\\xfc\x97\x01:Q\x86C'\x97\x8b9\xfb\xda|\xbb\x19\xcfZ\n\x18\x94u\xe9$\xb1\t\xd1i\x85\\Ƙ9\xe1\x9b.\x15\xfbVg\xe0\x1c\xfc\x9c\xf1s\xd0l\xaf\xbbb\xc0\xb61\x8c\x19\x9f\xad6*\xe7N\xebb)Xڥ:n\xa9N\xd0\xf1\xa5Х\xa6iOl\a\x94>\x82J\x8a\xd0?ry\xc4\xe8\xbe\tgG\xb0:J\xd2X\xa8M\xe3bW\xaaod \x03U*\xbd\xb7ӊ@\xb9\xfb@\xb91$\x8e\xef\x8b\xd4I\x06\x98\xe3\xb4p\xa1\xa6\xc1\xd2ŽS\xba\xac3?i%\x00$Vm\x8c\xc7\xe4xo\x13\xfe\xb9G]\x95\xfe\xa5T\xfa\xf7\xbeFr\x8d\x8a\xea\xaf1Uį+훰\x97\xde\xc4w\x19Q\xec\xc1Ik\xc1&\xa6\xda\xed\x8c(Pw\xe2F\xda\x17\x17\xa0D\xad\x19\xe9\x04\xefڂW x*\xa5\xb3C\x87R\xa8\xc1\xfb\xb6\u007f\xe3\xd8\xc6Du\f\x1fD}\x9b\xd8\aQ\x93FKE\rW]\x9f\x04O\xd4'V\x10\x9c\xf3s\x05\x8e\x1f\xa7;\xa7X\x93\xf2\x03, \x85w\x8cAq\xa5\xdc~h\xed\x11\xea\xa0A\xe9\xcc\xeb\xbc$K\x93nwN\xf8N\xed-\n\xe9d\x89\xe9\x1d\x9e\xceE1Y\xaf\u007f?\a\x00\xbf\x8b\xd2\xee\xc6\f\xec\x17\x93\xd5\x06\x93*\x13\f\x8d>\xd7\tO\x95/\x1f}\xde³\x97\xa7ʻ\x8f>m\xe1ى\xa8\x9e,\xac\x14\x88z\xb6\f\x18x\x82\xd4wMsՈ\x97\x9a\x8eJ\xcc\xfa\xe5\x04r\x0e\x81\x94Y\xe9\xdc2\xb44\xa4ѭ?\x84'\x86r\xde\xe0G\x13\xed\x8f\xc1K\x01X\xc5093\x8a\x00\x80]\xbc\x9c-sO#fq\x91്\xcd\x05\x01f\x93R\b\xf3\x89U\xbf\x94䈼E\xf0r\x12\x05\xfa\x1e\x04^f\x96[il\xf3\x8cw|,\x9a\xba\x8f<K\x1ebc\x90\xca\u0e77\xe0^&!\x94\xf5\xab\xc9珚\xa4ꨨ\xbd\x96ml\xfb\x11UB\xe6CFU2\xbf\xc3vx\x88\x8a\x1d\xb3\x8c^\xcar$\xcd0҉\xb67\x00A[\x13\xfd\x14\xdb~[p\xc9\xe4\xa5%\xb7\"\xd7*\xf4\xaf\x10DR\xf6C\xaa\xec\x13\xd9\xdfF)\x13\x96\xd9e\x80\x1cA\xb3\xcc\x1a\xb6\x16\xa8\xdbTr\x90\x83j\x1a\x19\xd3`\x86T\xa3\x03BnK\xa7ou=\xfbڜth@\x9e\xa7,\xd1L\xb5zF]\bjg\\\x99x\x9f\xe7\xa3Je\x02\nT+\x13P\xc2W&\xf0\xf3\xfe&M\x87V\x01Lq\rɼ\x801\x04\u007f\xf9\xc3\xebאrџ\xe6\xc3R(IoH\xe2G\xe6\xaboO\xa5\x91\xf9&ŵG\xf3\x02\x01s\xb4_?\xa8\x13A\\~W7\xd1\xce\xe6\xebr\xbd\xd7w\xb2\xe4\x8d\x00yl[\x97˾\xc9\xee\x98\xec\xe8*\xd8\x1e\x89\xaaa\xa0\xb1!\xb4\xf5TVޱ\xdal\x8a\xccN8\xd3LY\xd7\xdb-\x14}\x93\xd7\xd9C\x1f\xf5hA\x1flb\xc2WjO\xc1\x0e9y\x06\xec1\x8bFI@`\x85\x87hh\"\xb04m\r\xb5X6\xab\v\xef\x12\x1e\xd2\x12u\x87l\x8boulG+VC\xb3\xbdq]6J\x11\x803Ǟ\xb5bG/6\xdd\xcaiʢ-\x03\xa49\x81\x1c\x17\x81Kx\xf5E٠\x9b\vu\bE\x00\x95[eK\x80\x8b=\xb2\xd8ƪ\x96\x97%-/C-/\xcb\xf8\xc9iy鷬\x97\xcfɔ\xc4c:\x88\xe7Ù#z}\xc9K3\xc5\xf8?\x80֤\xc7\x19\x93\xaaq:5?\xab/\x17V\xee6\xb4\xec\xb0\x14\x17\xb5|s\xe7\x15\x8c\xc3\xdcKl\xc0pLs\xb6X\xe1m\xb9\xc2$\xd7ao\x83 \x95\xcdUړ'\a\xfd\xd3Z\x9e\x11Ph-]g\x1d5U\xc2\v\xa9\xa9\x8f\xa6\x0e\x86p\xfe\xd8\xea\xa0Vךk\x1d`\x86\xd7\x1d\x87\xe8\xf2\x13\x98\x03\xbc\xf5+\xf1\xee\xf2\x8e\xee\xa3\f\xb6;\xc8\xfb\xf3b\xbdc\x1f\x9b\x91pms\x1fVi\xae\x05\xb3\x98\xfe\x1d\xc6\a\x9eW4\xacwe\x82\xd5uLQ\x8aE\xa2\xd6\xff\xb7\xd0\xc9/\xd3\xe1,\xaeC\xb4\x93V\xbb\xddm\xa8e\xc1\x17\xb3֔\xa1W\x1c\f1\xddUk\xde\xe0H\xba\xc1Y\xca{\xefLa\xab\xafF\xcbr\x85\xb6\\\xf3\x9d\xf04\x13\xe6\xc9R\xe1k\x8d\xe1\xc4#\x93\xe6\xa8\xe2&\x1b\xc0\x89\xa5\xf2k\x85w\xf2\xa4\x10\x13\x04\xea\x06\x893\xe1\x02d[2\x18\x05\xc3\xe9N\xc8\x03\xa7\xea\x96\x1bD\xdb\xeaW[=#=\vQ\xf5_\xfa)\xa8\x84\xe9G\b\xb1\xee_\x9aʗ<\xda\xf0\x15'\xec̻\xed^.50»lY\xbe.\x03\xd9\xd5q\xa5\xa9\x8c\xef\r%!\xcev\xf4<:h\xb7\x1bޣى\n{,O5\xad\x017\x19\xf6\x83]\xa5\xdax\xa3[9\xc2\x1b\x8d\xb2m\x000\xdd\xc0\xc0\xe5\xb6|\f1\x843/V\xf1\x03\xba\x97\xb6Ȁ\tf\xe8&m>v|,&\x1dV\x15\xf4\x05ǀ\xd7\x11\xc0:\x0e\xb0N\bXDX\x80\xd6\xdb&\xe3Ѥ\xc3n\xc0N\xdad2\x9atBw^\x87\xb2\xeaPV\x1d\xf2\xaaCYu\x18\xac\xea\xcfe9\xac\xce\xfb\xee\x87\xd0\fn\x03\xa9\x1a\u058cP\xc3\xc4\xec\xfc\x1b\xcem\x1c-\xd5\n\xdb\xf0r\xf1\xd9\xd4\xdatG\xbdD\x97\u007f\xbfRGĮ\xdc\xfev<\xc8\xc6\xd9l\xd9,\xdf\xc8B\xb1\xa6\xd4\xc8\xe0h\xca=LV\xfd#l\xa1X\x8b#\xba\xc5\xfbDUۓ5xv\v\x9a\xe6\xf7\xf3Q/\x9d\xba\xfef\xa1mkG\x8b\xc8<\xf2\xebj\xefadE\xab_y'\xf3\x9b\xb5\xfd\x95u\xd4n\x83\\0O\x8ae\xa3\n\a\xaa\x1b\xd3k˫\x18Z?\xa6\x93T\xfd\x85G\x87҇\xdcZ\xedoy\xd9B\xb7\xbf\xb4\xda_nҾg\x01!ޯ:\x9f\xb2\xb5\xa0\xec\xaeY>\x18r\xd2\xeaouR3\x8c\x97\xf9|\xc6\xcfi(\xa5Eq\xc4*B&\xe9\xf4a6\xe6\xde3P\xc8\nm\r\x0f\x98\x84\x88\xc8 \x96\x9c\x98\xabc\x8a\x15u\xab\xa8\xa7\xf5\xcd\x1b\x88\a\\\xdf\xd9\xc9Ē8nP\xdd\xd6\x04\x9e\xe8\x8a\xde\x106o\t\x9b\x1fE\x165g݂V\xc7&P\x91\x91\xd4\xe9\xd0\xea BӔ\x8a\xd6\xc3!p\n\xa4R3\u008f\x8b\xf9Hu\x10\xbf\xbf\x1d'x\x17\x0e\x86\x0f\xf7\xb6\xe3\x86\tR\xa32\xa1\xf4-\xbe'\x00A\x965\xa3w\xaa\v\x88\x1c\x01\xd4W\xc9T\x9b&閶\x06D\xd12\xfa\xb1\x1b\x06T\xfc\x9dU\x9cUx\xe7V\x80*;p\x19y6\xcd\x16\x82ė\uf0afN4\x01h\x94\xf8\x8bH\xab\xc7j\x105EX\xc1[hh\x11\xb2\x98\x16\xf9t\xf6W\xec;G\x8dA\x85\x02F\xb7\xb3\x03\xe4p\xdf!\x1aN]#|\x19_\xb1\xf1\xbb\xecq5\xca?\xc4Q\xd8\\(\x06\xe0\xed\xd8=\xf5T\xce\xc4y\xc4 8q%\xe2\x16\xea\xbaѺ\x19\x0e\xc0\xde|\xf5l%\xf0\xa1\xb2/\xc0:u;h\xe3D\xbe\xfe\u007f\nL\xf0,\xba\xe5\xf7\xd96\xe12\xbc\x05\xb2\x19\x9bQD\x03x\xe9\xaeI\x88\x0f.\x92w,\x1a\x17%'Ȇ\xcd\xe8.Ҍ\tI\t\xa4Ųi\x8c\x12\x85\xac{'zs\xeb\xf8\xb2!2\x8aZ\xf0\x04\x14\xcf De}\x96\xafUth\xedT`\xd5t=J\xa9\x9cwN\x8e\x0e\x12cǐ\xe2![\xechR:\n\xe1it\x17ve\xe3\xcc1@\xda\xc54\x88'\xc7ea\xe1b0\xb1\x0e0%\x1ah׃+=b\xf8?\t\xe0\x834\x9d؎#\xf4\xecX\x93?`H3\xeepc5k\xe8 $f\xf4\xcc\xe0\xc1\xc0kۋ)\xf0N\x17ȼ\x8bm2N\rv\x1a\xae\x8eȃo+f\xa4~\x96\x89\x92\x98D\x97U\xef\xb9UM(>v=\x15:\xe5T\x85\x8fsr)\xf5T\x96\xb22\x1f\x84\xaaT\x06LF\xab+\x01F\u007fm`\x8d\xea;q\xaep{\x85\xfd\x05U\x06\xc5S\xd9[c\xca\xd4hJ*UK4&ͨ\x95z=nY4݉\xe2\x96=\x18\xb4A\xea\xb9\xc5z~\xb1\x92\a\xe9\xc0?j\xa9\x87՚0\x95R\xbfP\xf2S\xa8#M-\x00Qܙ\xe5\xa2i\xcb\xdf&\xeb\x00\x8f\xf1D\x80\xb6ү\xa9\xaa\xc2\u007f\x8cϴ\x14\xb4\x05\xbe\x8b\xa0OGS\xd2J\x82V\r\xf8\x9enEH\xaa\xc9\a\xb8\x8fq$\x80\x01\xb5x\x14\xd4\f\xe9\xb7\f`\xa4\xaa\x9b F\f\xe0\xe3\xe1x\xc1\x14\xc1\xed\xd0\xd4\x108\xa6\x1c\xac\x8f\xec\x868\xbeR\xf5\xb6C\x90\xb3y\x14Y\x00\x17\xdc\xc8\xd2\x0fJ\x8d\x95\x18\xf6=\xec\x1c\xe4\f\\\xa5\x8a\x87 \x17\x01\xd0\xfe\xc88\xa0\x95\nW\n\x9a\x8f̊\bjrK!\xb4\xbe~\xbabK1\x99\x0f\v\xa1\xfb\xe2\xed*\xe7\x88SW\xfbm\x1e'Ұ\x01\xb7i\x85\x04\\v]\xf3!\x94i\xd1\xcbsx\x8dh\x03\xb4<\xeea\xc9>\xac\xecP\b#\xca.u\xf2R%\xc3\xcb+\x90<\x10T\u0604\x97H0N\x06ĵH0\x00\xcbһNT_tAg耙=\x81\xb7\x9c\xb3\x9bt\x16\xbb\xab( s\xab\x1b\xbd\x01\xd9M\xe5\xe1\x1f\xfb\x9a7b3Y\xc0\x1bYl\xfd6\x94\xbd\xc4쥟\x1d|)i\xfb6p\xa8'\xa4kV|\r\xbbഞ\x8c\x1b\x95\x98c\x8dW\x1f\r\xfd\x8a\x1b\xebO\x18\xe5\xce*\x03\x85\xea\xfb}\xec^X\x02\x18\xb1\xf3%=w\x13\xc0\x85e\xe8\xdbw\xc8\xca\xf0 I\xc8\x06\xffQ\x8fbtXP\x8d\xad\xae\xa5͈5}\xbb\x04}\xe6+_\xb5\xfa\x95\xf5%\x18\x05;\x18\xf0\x1a\xafu\xc4\xc3ɍ\xd0\x16\x06\xd3LE\xfdj\x9d\xa0O\xfc\xdb/3\x01j\xac\"\xd0\xc9\xdd?6\xff\x1d˥\xb2\x17\xb3\xa9\xb4\xb7\x87ʪ\\P\x01p\x00\x85\xba\xba\xd7\x06E \xbe#\x8bH\v,\x88\xc0\xbd\xf0\xfbX\xecx\xe0ŖP0\x9a8jj{\x8f\xf6\x06\xf9;\x91\xedcHQ\x02_(\xf8Rr\x10!e\x1c\x8a\x12YT\xc7\xfeGO\xa1\xdb'\r1\x87Z\xed\xf6\x81wC\x10\xa2H\x86.\t\x02i\xb9B\x88\xc0N\xe9\x0f\xe82\xc1˃\x81y\xf2\xe08\xc9\xc3N\xcbE\x81\x824rsM3\xfa\rM\x16\xb9\xe8\xba \x9b\xa0\x8b\xb2ep\x1e\x8a\xea\x19\xde6\x8b`\x1b0:\x8b\xc4~\xc0\xba\xb6\xa5\x80^r%\x1d\xa8\x9b\xb7\xec8\x84\x11ޞ\xcf[vD@ڜ\xd2խ\xa2ŞL/1y\x89\xc9K\xfb\x84y\x88{ç\xb0\x97\x89`\x06:\xcfc [\x0e\x87\x19Sx\x94\xfdT\x8c\xd3Њ\xbd3\x14\xc2b\xd7\xf0\x00ؠ\x84\xf0\xb4\x1e}/`P\xad\x94\xa5\x97\x82\xe8\x13Nu`\x92\xa2u\x8f~u *\xd6L\xfd\xde\xd1\xc9\xf6Cz\xec\xe4y\xe4\x89\x12A\r\xb4\xa8(\xa0\xf0R\xf2\xd6>$\x82x\x9b^\xcdж\xe0\x96\x91D\xce\x16\u05ec\x04\xf3\x1a\x0e6\x9eq\x972K\x95\xd1q22\xc74\x80\x8d4*\xf6\x8c\xc4)Ȑ\x97\xf6v.\xa7~\xe2\xc6U\xfcn\xb8tȩ\x97xm$\x87Т+{K\xb3\u05f9\x1f\xc0\x85\xc9\xcb~\u007f>\x9a\xa3\a\xaa\xdfH\xee]\xa7\xf9\xa8\x05\x02Z\xf0\v\xad\xedpf%\x85\x9a\x94\x06\x8d\xf2\xeeW\xf5\x1bU\x95:\xef=\xdc\x1a_\xa4\x9e\x87\xdc\xdfZ\xe0&\r\x9eՒ\x86\x917\xd6\xdd\xf8\xad\xb9o\x0f\x8f\x00^\xc7\fa\x94\xa3\xed>\x17\v\x8f;\x1c\x98\xba\xact8\x91Ӕ\xa8縒ʿ\xe78\xc00\x82j\x9dp!\xcb*KY\x85\x9aZ\x96Tq\xe9 o^\xc0\xb1\xbbw\x03\x88V\xb1OB\x12\xd6\xddtr\xa9\xaf\x96\x8d\xcduo\xac\xa97\x84\x12\x8c{\u0603\x8d\x04\x9aU+\xd4\xe6\xcdbMՊ\x02\xb3n\xb3\x8f\x19\xc9q\x93\xae2\x15a\xbb\x1e+\x00\xbc\xe3\xb6\xdeQ\x1aU\xb2\f\xaf\x84\xe1\xe4!\xeav\x80\xe9-\xdbu@\x01\xe0\x1d\xb0\x95\xa1\x8d;\xc0T\xb1\xcdqr'\x1b\x03\xb6\xee\xc0j\x1dm\xf3\xd6m%Y\x03\x82\xa9\x9c\x0f\xf0\xa8\xf2<\xaa)\xb0\xb5\xe8s\x91t\ua7e4\x96!f\xd4\xc5\xcd1\x93u͉\x8e\x02\xb5n\xe3J;\xdd\xe2\x1c\x1bj\xea3l\tf\xddf\x95*\xb9y\xb3X\x93\x1b\n0\xc1\xdd\xcb.\xc01rCk\xac\x8b3Z\x11\xb4\x06\x1bp\xc4 \xab\xb8*2\xdd3\n(\xc9rCR\xae&/Jܳ\xb4\xe0:\xc1\xb4n\xd4\xf8F\xc9\xcb\xcbR\xb1\b-\x87u\u007fv\xe9Y\x06@KDc\xe3\x02c3\xdbA.\xa5Z\x0e\x1a`/\x9f\xa2!H\xfe\xaeC\b\xf5w\xf2Pu\xc42\x8c\xa6\xbe(1\xb5\x8f\x1a4|Y\xf1}\xfc}}\xc1\xeb^\xbe\xbb\xba\xd4\xc8\\5|/\a\xbaߨ\x0fO\xe3q\x82\xf7\xea\x9fZ\xd2ܻ|\xa9\x91\xf6\x1e\x9f\xe9\x96m5\x82\xf7\xf8\xd2\xd2\xfcpw\xf1\x8ev\x17xn\x00\xbb\x8bw\xaeZ\xc4;\xe4\x81x\xa8\x007\n\x82\x83A0\x9b\x96w\xce\vwӘھ\xb4\xf0x抎\x0f\xd4\xee\xa5Q^KZ\xb5\xedZ\x04\xaa\xb1\xa6\xa5\xc0\x80ˮB[;8fn\xae\xb5\xc1\xbb7\x8a}3\xba1\xca|Ӵ!\xf6\u007fe\xbb\xbd\xb1\xb7\xdbs\xd4\xd8L\x9e\x82g\xecQ\x8e\xda\xee\x9c\xfb\x0f\x9c\xf5>\xcb\x1aF\xbdE\xb3R\xb7\xddy\xe2\xdd=Z'r\x1dC_\xac\x16c\xbc\xb2Rk\xb0T\xd9\x11\x95P\x06D\xdf^\xb6\x80\xe8\xf3*\x05\x84\x12\xae\xac\xcb\xdazW)QU\xea\x03\x0f\n\x9eñ2\xdb9\xf3\r빥\aT\xd4\xda\xd9\xd1\xecJ䷳$O\xdaY\x0fk\x8fz\xf9\xbe\x81\xc4\x0e)\xf5\xb4]\xd0r\x10\x9d\xe9\xde7\xc2\xe5\x97X~i\x95\a'97%\xe5'Ԁ\xdaB\x94\x14\"\xa8j\xd3\xe0\xf6S\xee\xe2,.c\x03\xad\xb4\fK\xb5\xe0TXM'\xdd\x06\x8d\xdd\x0e}\xf1aST\xb4G\xef\xa1\xec=\xda\xc6Mڊ\x93\xbfC\xe2\v\x9dk\x1a\xe4+\x1eeU>\xe9gzE\xbd\xd5i\x04\x97\xcd|\xb26\x88v\bBٻr\xe6&\xb5\x11\xc9B\xee{\xf4zC>z\x83;Gt\x17t\xb6\x94\x0f\xb4\xd8\xe39\xc72\xf0\xa5\xca2%ݷ\x90\xfa\xb9]\xb02x\x05;\v\xbeL\x13\xf5\xa7t\xbe\xe0\xd7|\xad\n\xb0\xfb\xfe\xfa8\xa2\xac\xf8\\ \x89\xbc\x85t\xa8\xbey\x86\xd5\xca\x1f\xdf\xc9ӏ\x89\xe8x\xe6\xee\x19\xbc\x13\x10=e\x81\x9b\xd1^\x98\xe0\x89\x84}Ǫ/ԔD\b.);Z\xea\x9bo\x89Qb,\xac\x94\xa5*\x9f\xa4\x13\xb1\xf5yʞ\x93S2Z\xc1\xed:x\x18bʑ\tH\xb6\xfe\x97\xbfD\xf51\xce\fJP\uab6b\x97\xe9;mͨ\xdf\xe4\x9e\xfe\xb1AlF\xdd\x12\x10)\xcfx\xca)wL\\e\t҄\xeb3\x84P\x1a\x00\xfd MB|\xaa(z\xe6^\xe4J\xca\xe6\xb6{\x84\x00\xd4sΨ\xaaF\x85\xdao?\x0e\t˩\x91\xf0\xa7yIS\xa2\xc9H\xd1(\xd3\xcd;&\xfe\x82\xfb\xdcP1\xadsC\v\xb5miw\xb9\xc9\xd2i<\xed\xdf,\xb90N\xb8\x14\xb6\x98\x9a.\xa8\xb7\x9bFw2FPCY\xbc\xb4\xef\xeeƙ\xd9\xc7\x1c\xb6\xe8\xe6IN\xc9٦S\xeb\xfe\xb1\nA\xd0]\xfb\xa8\x96\x19\xddJX\x84(ɣ\xd1\xfd\x89D\x8a\xae\xd64\xfd\v\n\x95\xac\xfa@U\xe4\xd7!\x0e\x8a7xw*\xee\x11\xe4\xda\xef\nݻx\x12\xd9\x1d\xacBc,\xc0:Clv\x85\x00\x1dv\x8c;j\xf17\xb7\xad|ۂ\xc9Soҝ\x8b\xf5\xf0\bG\x97\xb1\xda\xc0+z\xaa\ruo\xcboA\xe5\x94\xc3W%ം\xb5\x05\xec\xb8\xd7\x12Ku\x9dhe5\x9e\xb9\xf7W\x91\x8c\x92=Μ;C\xd1\x13\xba\x97Рjt\r\b3\x00[\xc3\x04\x82\xaa\x17\"\xf5\x8b\xe5\xcfR\x18\xea!\x81MI`H\b1غ\xed\xaa\xdflS#\xba\xb6\n\x00b\x02\xa8\xd3uB\xf8\xf1\xee\xaalL\xa7}G\xbf\v_\xe5\xf4\x9cQ3\xb1\n0.\xbd;z\xd0\xeei\xa4\xd0\xf0\xaf݁`\xd3\xdd+\xbb\xc7\x17\x87\xaf\xf1\xc5(\xd8\xe1Xÿ\xb0\xf7\xe0\xca\x17@\xcf\x15/\xb4h\x9cGt-\x9fn7E\xe5\xa3ִ\xd8\x1d\xddSi\u058c\u0605R)\x832\xb5\xcem#~\xb0\xa6\x19\"\x02\xe3\t\xa0̶\xba@\x93\xb2\x0f\xdb\xddy\xe1\xb7]\xd6m\x8f\x93d\x8bVuu\xb3\xade\x00\xd7\xc1\x80\r\xc2\xe6\xed\xab\xca\xfa\x1d\xb4\x01\xb6\xaam;\x99?F\b\xcfy\xffa\x81\xe8j\xf8lܼԇ\x12J\xcaÜt\xee\xe3\xa7\xd3l3IOs\x8d$=\x02\xaf\x94\xf5x\xe9\x03\xe0SCk\xb5\x90إ\xee\xbc\xdb\xdfګ\xc1\xc2B\x03\v6\xa3\xa5\x9f\x18]\x05\xafs[\x98\xe6\xd3$EK\x19\xfe\xb0`P'\x14\xfd4\x9bi\xd2\xed\xb5&\xe9t4\x9f\xa5uJkJ`LőT`E\x15P\xa7(\xa2\x82\xceP\x10\x17r\xa3\xb2\x1a\x19u\xef@\x92\x99ۥ(\t\xfcܨT\xb2b\xd9ƧJ\xeb\x1d>\xf9`8h\x90x\x9b<\u05c8\x16\xf8M\x18R.\x0f\x8f\xc3v՝\xf2]uI[\x99jk\xe7\\5\x80\x17\xa1e#\xba\xd5\xcco\xb5d+\x0e\x1c\x1f\x94\xe7ҥ\xabڹ\xca!\xb2\xc4:\xe2\xf6\x03$K?\bM\xe3\xc1\xc6-\x85\xe9\xffH\xa7y3\xa2\xd7An\x81\xf9\x8c\xbc\xf4:\u9fd0\xc7^'U\x06v\xc6߭\xbb\xed\x03^\xdeY\xc1.\xef\xca\x02]b;\x96Т\x96\x15I\xb6qj#j\x1a\x9f6\x04\xa6\xfa|)D\xf7\xa2u\x9dΠ\xd5\xdf\u007f\xaf\x1a\x97u\xbb\xa3\x06o\x8b\xfeX\xee\xd25\xa0\xcdzD\xb3\xa7\xa2K\x9a\x89\xd6\xec\xd0\xe3\xbaa\xael\xeaq}*W\x0f\xd3|\xb6Uc\xa2\x9e\x1e \x04\xb1\xbaA7#\xb0:3f\x94\xce\x05ĲE\bղq!\xe4Ǯ(Vc\x8a6\x88\x1cg\x9b\xac\x96^[6S\xe8\r\xbd&;m\xbe\x8e\x17\xf8\x9e\a_ō\x8a\x8a\x92?\xa5ɼ\x9f^\xccG\x8d\xa6\xbf+\x197\xd6{s \x90\xb9\x8c1\x12\x89\xf8\xd13[\b\xf0\xf8\x80vF\xa1\xc7R|f\xfa-\x12\xd5\x1d?J\xae>_\xf0\x17\x01|\xe5\"\xdf19G\x03\xa2\xbd\xe7\x12\xaa\x13c\\\xe4\xec\x9c#=\x9c\xa3%@\x87N\x83\xdeU\x87ٖ}\bC\x91=\t\x00*\xf1%+˃\xb7\x9c)\x04Vh\xf5\xf3q?\x9eA\x17\xd4j\xffД\xac\x86EJ\xf9\x84\x99\x14\xbdݤ\x81o\x81\xac%$\xfej\xa7U\xc2\xf1\x13\xa5\x03\x85\xf8Z\xeb \x16c\x17\xd9\xf0&\x9f\xa7\xb3Y\xba\x19[\x8fd\x02WC\n\xf3\xec\x93\xf8\xbd\xadU\x13\xe9\xe7\xd7a\x9aJ\x1dŰ\x15i$\x86\xbb\xb2\x9d\x9d\x06)\x0er\x17I\x1a\x82s\x0f+zAQi\b\x95\x9c?\xc3TÞ7\x82'8\x95h-\xdbtxZ\a\xb8\xbb\x8a\xb3\x1a\xfcN\x9f\xc39˶5\x94\xf7\xd95\xeeU7\xa1\xf6\xc2P\x9bH\xbf0\x03aS\x1a\x14,\xf01\xd0\x15\xff\xbfG\xe6d\xd0S\xda\xde\x00,\xdb\xe4\xa8\x18\x95\xbd6\xb7Xj\x1at\xd6\x19\x9a\xa2\xe3\xcf|\x91V>8V\xe5.\xfdE\xcb\xf0\x02J\xa2\x8f/\xf1\x8bT\xc1\xf6U\xb9^\x89p\xe4\xa3\xdfb\x0fF\xc4j\x10.\xa3\xaao\x02F\xde$\xf0\x9dg\"\x14\xed\xe8V\x00\xce\x00\xf0\xad{\xb0-\xc0\xed(x\xb7\x0e\xbc[\a\x9e\x13y\x9f\xdb+\xba(z\xf6\xa0\xbd0)\x1e<\xae\xca1\x1a\x16\xc4\xd1\x16\xb5\x9f\xc1/|q`\xd9ŉ\xbb\x9f\x8bQk\xd0\xc8\xe5\xebr1\xb5\xb2\v\x03~Vɣ\xe9b\x12\x8f\x93\x0f\x97\b\xb7\xf2\r\xc5\xf8\xcf\x14\x04\x8d\xd0\n\x85Um\x86y\x06\x94\xa4Ud\xbd\n\xe7\xe6\xf6\xecz\x94\xf7\xfd\x80;t\u007f/\x14\xc2\xd3r]Q\xcbwn\xf9.f\xf9\xf54\xae\xf6\xf3\xa8K\x85\xcd\xc3=\xa1\xddH\xc1\xed[\x8a1eڌ\xa4\xf3\x19\x15l\x0e\xffN\xf9!\x00U\xa5=\xfb\xecf\x9a\x167\xf9\x10\xdf3\t\xf0c\xbb(V\xb6k\xf4 \x9c\x1cw\x87 \xed\x12\x9c\xbd\fT\x16\xaa\x9e\xb8l0M\u007f\x9b\xa7\xe3>\xf8t\xea\x88\xf9\"\xb9n\x11\xbc\xcak\xa9\x1a=\xf4\xc1\t$ [%\xbf\x15#R\xe9\x98̴\x8c\x8fmQ\x1c\xd4!\xd7\xcd\xe4\xfbT\xc8_\x86no\xa0o7\xf7\xce\xd6&\x17\xa1\x17\xdcF\xea>BZD/L\xc0?\x8c9\x15=7!\xff\\YǺ\x0f\xe7\xbeYAQ\xdcU\x8f\xf0@\r\xeeR5P蝹u\xe9F\xf9\xad\x9fN\xee\rh\xbe\xacy\xf9ZiZ\x02\x17\uf34d\x1e^\x8a\xec(yg\xcaL\xb5Đ\xd6\x06[\xb3\xfdO\xc4oĈ\xe1\"_d\xe3\vѼ~\xaa\xa23>\xd4d;\xb5\xf6\xe4\xd3\xc0\x9e\\7emX\f\x02j\xcam\x8e\x00\xd1D7\xc7(Tz\av\x152RVl\x8e\v\x11_\aORCa\xef\xab\xe5\xb5 ~\x1a\x82X\x87\xac\x8a\xc11\xfc\x1a.\xdfץ|1\x9d{\xa0=\xfaֽ\xe6\xfc\xb7\xcd\x15`Y\xdb\xdc\x016\xe0\x9e<Y\x8f\x1fB\x99\x0f\xeeb`\x0eG\xab\x9e]B\xf8+uR\x88\x87\xb0\xf2:M\xe0\x80\xbb\xe0\xa7\xd5(\xc1u#t\x84$!4\b\x843\xb7d\xc9o\xc7\xc3l\x8c\xe7\xfd\xa2\x82\xf5\x9e\xdf\x1c\x06}\xe2\x1cװ\x9b\v\xe7Ԅ\xf5\x02\x80\xe4\v\x9e\xca{'\xed\x85}\xce^\xacsQ\xc1=\xc5Wˡ\xf1\x12C\xb8\xec\x800L֓\xd2p\x92\xa5\x88M\x98\xe0\xc1\xdb;Em\xcb՜\x8c4 \xf1\xe0Y})K\xad\xf2w\xa8\xf5\xb4\xacC!7\xd6\x11\x18\x03\x1a\xa2\xba>\x81t\xae\x84Iw\x01\xec\xfe\x85XU\x02\u05cb=W\x10V\r~R\\\xc2\x1b`\x00+\x8b\xd1bz\xeb\xb9U@\xc0\xf2j\x8c\x04\xb4\xde\x05\x8c\xbbǻ\x80\xe1\x8c}\xf9\xc0\xe3\x80(\x9c\xad\x1b)\xef\x1a\xeb\xd0\xf4\xae\x82\x94\x153\n'ݩ\x9c-\xabH\xber\xd0պ\x15\x1c\x10s\xc9\"\t_\r\xb9dqѤ\x88\xc1\xab\x1f6\xb7W\xdd\xee\xf0\x8e[u\x9bpl\xd6\xe4C\x1e(\xf3\xca0\x80\xecX\xa0\xd0\xcffƘ\xab,\x1f\xf3\x88T\xb7\xe2,-\xfa\x1a\x8d\xe9\xd36\xcf;\xec\xab`\fئx|\xe4\x93\xe9U\xcd˩\xc3\x11\x98\xe6\xf9\x8c[\xcdhnA*\xc4\x1cw\xe0Crx\xbdd\xed\x06\x8c\xbe\xee\x94b\x81\xea\xf9m\xbe\x15\xa7\xb2\x13<\x94\xdd\xe2\xd2T\x13\x11\u05f7\x82\x8dosH\xc6\xddE\xdbJ\xb1\xf6\x13\xa6\x17\xf0\xd6\xf2gx\xf2\xf8r\x001t\x88Ff\xf7n=\xe0lM\xad\x87\xbbr\x11\t;u\xad\x02ώ\xb5E\xef/\xb2\x9e\xa0ߵ}\xf0Y~i\xfe\x96__\x03\x93\f\xf6n*vm\xf7\x141[\u007f\xdb7l\x94{\x15g\xf3\x84c\n\xfd2~\xbbn\xedg\xb6[ъ\xa8\x05+\xed\xf4\xac,b\xf1\a\x90胰\xda-\xc7j\x1b\xe2>x\x83\x0e(\xbf\x01\a\x9f\x83|:\x92\bݓ\x0f\xa2\x1b\xfa\x03\x9b\xec\xdbǹ\xb6GJ\x8aC/PY\\OD\xe1\xcb}\xfdۏ|\xaf\xcf9M\xc2\x16\x03^\x83\xde~\xb0נ\x9d\xb7\xab\xda^\xe3:!\x9c\xb3W\xde$\xec\x0f\xe7\xc5,\x9dV\xca4Y\xe6qĚ\x18F!\xc0\xb3|^|OW\x9di8o\x17\xe2\xff\x97\x9b\xca3\u05ff\xcb:\x97\xa4}E\xd0\xd5\x01\xed\xa7V\xeaN\xb5\xc1I\xd2\xe3\x17\r\xc4R\xc8\xf5\x95k\xaf¯\xa1\n\x81\xe3*\xdd$\xa7\x14\x9e/\x83\x199\x15\xfb\x83\x98\xdd\x15\xe7\x85\x1a\xb6\x81\x98!c\xa5Zp\xdd}\xc6C\xf0\x12\xcd0\x1d\xccB}\xfaN\xa4\xd3\xfa,FZ>R\xf2\n\xfd\x04\x19\xaa\x14zB\x04p\xe4\x9f\xc2t\a\xd2$\x10\xe9\xe1\f\xfd\xd4b\x02\xf83\xe0e1\xb1\x89`\xac\x13\x97m\x19G\x93\xbc.\u007f)\x87\u007f\x10ϓ~?U\v\xd8\xd9'\x1eu\xebP\xa8.\xd7\xe5\xcfU\xfa3\xb5R\x9fF\x9d\x86\x06\xc0\"h?\xa6Ĥp\x12r\xa7\x04\xbf\x9b\x8c^֠\x00e.tV\x89$\x95#ײ`l!Ruus\xb9\x8a\x01tūl\xd5v\\\xa50\xf9\x88b=\xd4\xeeJ\xe9*+\xad\x10\xb0@\xedJ\xe9\x8a\xde1lѪ\xf3\x06ٴ\x98\xfd=\x1e\xde:s]*\x10\xeb\x8a>1Q\x94\x03cLG\x16(\x97\x87+7\xc6R\xf87\t?\xdcm\xf1\xd7\x17\\\xc8cf3\xc2G{3\fui\xea\xc8m\\ӳxW[S\xe4\xe6\xc6~\xefa\u06dd\r\xddd\v\x16:\xceKS\x86[<\x99\x88\xb9\x84\xcfI\x02\xf5L7\x1c\bV)\x85W\xd9i\xa03\x11!\x02\x85m\xbeR\xf4\x1de\t\xf9\xe2:\x8fZ\a\xe0\xb1\xc6P\x8eF\x10\"\xf6\f\xb3\x91\x90\x8c}/\xd1\xf3HV\xc1:\xe8\x1cA\xeeo\b\xe2\xb9\xcbin\x83\xa5\xab\x90\x02\u007f\x16\x82>ʁ:vS\xbb\xba\x9f\x16\xc1\xfcU\xd1\xc71X\xb1ja\xfd\x93\xe9P~\xe0a.\xf5\xa6\xfd|\x9c\xb0\x19\xbf\b\xaeRf\x16\x1bT\x16g\x1f\xa8\x06\xad9\xed\x03O\x92\xe4C*\x86\x95\x18\xe9\xf5\xa73\xeb\xb4\xfd\x82kS\xea\x99\xe9\x1b\x1c\f6\u007f\x9dw\xf6\x95L\x81\xd7G\xb3\x89$d3\xba\xcb\xd9\xef\x10\x93@\x91\x91\xa2;Ys[\x96p,\x10\x9a\x80i\xa8\x05/\f01\xb7\x13\x11\xbeh\xc5.\x89\x89\xb9\x9d\b\x82$@s\xc2\xd0\x16:\xa4\x8f\x89\x9cFS\xf6\xcc\xceG\xa5Nd4\xa8\x83\x827\xe0\xc3}\xf4\x9f\x8fJ*\xe6#g\xfa\x13ł8\xe4\x13\xbf\xac\xec\x16\x93ˮ%<\xa2\xeeZ\xa41s\x12\xa5\x8a\xa1\xae\x164@vkʊ\xcaH\x80@H}\x82\xef\x18\x17B\x02\xfc5:\x15\xb4\x92^J\xc4\t>1\x8a\xe6<\x95\x80\r8\rG\x84\xe2\xb9?\x98dV,\xcb{\xb0I\x93\xe1U7\x8bi\x1c\xe2Q+\x16\x03:%躜\xc5cn\t\x82\x91\x97\xc0p}KJFzR\xc6\x05>{)\xc0p\x06\x1d'4\xd6e\xbc\x02+\vІ\xc69\x9fTc2\t`\xa2Y7\xc0\xe7>\"\x93\xb3\x922\x1a\x91\t\"2*U5\\\x96\xae\xf0]\xa1j\x05]\x12noX\xdcd\xafT\xa9z\x1a\xb9\xef\xbc\xf0R\x88ӱD\xd3ށ\x8a9)6\xab<Q\xd0\xceI\xa1\x88\xbeN\"N\x00'\x8d\x8e\x8bO],\xc5^,\xbc\x80Sy8ȁ\x1ds\xd5\x1b1\xa3J\xe2\xfe\xd5lc\xf4\x9aE\xe4\xdaŽ^P\x05\v\xee\x9fi\x17\x06C\xe6\x99\b\x15;\x8e\xf2\"\xbc\xad^Q\x17'\x95\xac\x9c\xa4\xe9d\x93\xba_\x8a\xf2i\xf1\xd1\xf6\xe9M\x8a\xfd\x02X\xc93\xdd\xdf\u007f7\x87i\u007f\xd2\ue75d0?\x03\xfc\xbc\x8d\xba\xc0,\x1d\xa6\xb34\xb0{\xfaw\xde\xc3#/\xfe\xb1\x1bxC\x9a\a\x8e\xc3Gܺ{-\xaeܷC\x8d56\xed\xa3xR\xfd\xfe\x0eb=\xd5\xcd\x16\xfb\xd66\x8d\xf2\x1bl\x8eR\xad\xf7\xbdB5\x8cW\xbb\x98\x84R\xc0ہ]oC;7\xa8\xc3%ֶ\x90wm!иKS\xda\x1aJ\x18\xe4\xd6\x05>\xf0\\\x18S\x9f\x9f\xebz\x1c\x9f\xa0\v\x84\xe2\xb7y<\xcd\x06\xcb\r\xbc \x94\x9d\xbeW\x18]\x01\xdc4\xed\xcf\xe8\x14\x87ڂU\xec^\xdeŘ\xa6\x10 \x97\xac\xe4\x1aL1\xcc\xfa)\xbc\xfa\x97\xb4\xed\t)&\xf2M\xf4\xb4\xa2\x9fOŸ\xcf\xf5\x91H&ēh\xa5\x05W\xa3\xe9ǲamkh\x84uk\xaa\x14x\x8f\x95\xe5-\xa7\x15\u070fp~\xafH\xee\xfb\xb1\xc0}\x96\x86\xaa\xfb\xef\xe9\x9a\x00\x04/|\xa8\x81\xd7u.\xc7x\a\xcf\xd2\x1eu\x93;\xe7l\xcc=\xbf\xe4H\x05\x01\xeb>\x17˚\x90\xab\xf7\x82\"B\xe5\x11L\x00\x04\xf3#\xed(,'\xf9\xa4\xeeh\xab\x92\xc2\bq\xe5\xde]\xa3\xb7{N\x1d\x03x\x1e\x8a\xccg\x04\xa1F4o\xd2Q\xac\xd3~\xf5H\x9eyͫ\xb0\x93%\xa3ú\xa4\x98f\x95~\x06\x145\x90K\x1c\x93\xd8\x1d\xb1\xa2\xf0\xae\x89ك=\x99\x81\xdb\xd5\xf3{5!\x1b\xd5\xd3\x16<\xf4\xfdY\xd3v\x9dɪ\xe6\xf6G\x9f{\xdeT\"F\xac\x9ax[\xcc2\xcayo\x1c@\xfc\xab\x82\xc7U\xe1\xcfu\x17OU\x17\x15\xd3<\xf1\xa4\xc5\x16\xdc\xfd\xb0\x16?IN\xa9\xe6'Kxد\xce\x19\x02\x02{\xf1\u007f\xfaY\xcat\x84\x17\x8c\x8d@\xe6+\xa4\xe9\xc1\xea\xf5\x90\x9c?O\xa9\x96X\x04\xb111\x84B!\x9fe\xe3\xb9c؞\x8a\xea\xd0tC!0u\xf3_ \x92\r\x85\xaa\xb7\xcd*\xc07\xb9~P;\x87\xaf\xb9\xfb\xcaP\x8c\x9e>g\x9f\x03\x8f\x02,\xf1\a\xf4$x\xbe!\x96\x1fPG)+\x1b\xab\xac\x06\x1c\xd8\xd9\xd2\xe6\xa1\xc41\x94#\x0f\x87\x82?\xcb\x15\x0eCN:`E~Zгc\xfc\xbd\xa4\xbbqs\xe0\xba|.\x94\"\xcd5\xcf`HO\xf1\x89\x05\x97\x01sp\xd6'\x19\xd4\x1d\fD\x06\xf4\x89;\xa0\xa5\x94\xbf\b_~\xacwK2\xd7#z\xe6y\xcc\x0e\xb8\xcb^\xdaI\xe8\xb0\xeaγI\xe6tm\xdf^'`\x93\xa2&\xdb.\xdck\xbf\xd3t\xc8\x15\x15\xee\x90\n\xc1\xb0\xbe\xb9\x98\xad\xe7N0\rlgGQچϝ\x83\x02\xed\xd1n\xe2$&\xe8\xa7۽t\xb9\x92\xce\vN\xe7\xc5\x1fB\xe7\x85K祤\xf3ң\xf3R\xd3\x01\x9c\x8f/\xb7\xa3\xb3\xbe\x85\xc5FZ\x11\xdai\xc0\xa1\xe9\"H\xe8\x85M\xe8`,fR\xffˮ7\x92\x90\x14?\xc5P\xf0\xbb\x90\x1ft\x85\vS\x12\x165\xc0\xce`Q\x03,?C\x12\x95\x86\u007f\x81αW\xe0zzI\x18^5usO5|yZm/\xa7\xba\x01t\xdaDk\x03\xf8fRj\x87݈\xc6HL\u007f]\xf1\xdcv\xdb\xfe\x81>\xbd\x90{\x14\xa3\xe1G |\x8a\xb9D\x03\xcb/~{\xbbg1\xbe?\xc6\xc9\xf7\x98O8+^kjܛR\x80\xab\x038p\x98f\xee\xcb\x1d(Ge\x12\xdcG\xdf\xe6\x8a6\xbc\xbd5\xb4[\x1d\xb4\x8c]ML\xbe\xae\xba83\xc1\xe7\xa4d>$\xc8\xcaVm\f$~\xac\x94\x89\x1d\xe48Ha\xd9\xdci\xb0\x80\xb4/\xca\xe7\x1f\x13\xf7\xf9\xc7e$А\xff\x17]\t\x18\x93F\xa9\x1fe\xd1\xc3W\xf9\x18\xfc\xc1μ\x1ezf\x01\x0f\x83E0\xb4\xe9\xda7\xb70\xfc\xb7\xe8\x04\xbb\xc9\x05\xbbv\x16\x06\xae\xb1\x06\xa9D\aѹ\x19{\x10\xd3\xf0=M\xb0\xa1\x14\x15\xa8\xbcM6\xf4[}\x89At\xe9\xff\xe4\xbcP\xf4\x81\xd1`\x9f\x1bp\x9b\x9a\x81[<B\u009aO\xd4K-#\xe0\b\xe0B)Q\x94p\xea\x16[\x8d\x9a\x99\xc7[8\x9b\x82\xaa\xc6є\x04\xb4\xb0R\xa48\xf3c\xcdU\xd2K\x8a\x90m\x1em\x89\xf2\xe6\xcd\x16\x81YG0\xace\x02\xc3\xf9\x1d\xb6\x82\xf5\x8b;\x92\x97IqW\xaf51bU\xba\x98=\x13\xe95\xf5\xectf\x95\xf9\xbft\x99Y\xdcە&\xc24٥\xb7\x89\xba\xd2u\x9ak_W\xf2\xf6\xb5Hz;\x8d\x93,\xc6\xd7d*\xee\uece8s\xdc6\x95Z\xf1\xfbl4\x9f\xdd\xc4\xc3J\x93\x9c.U\xef\xe7\xf9TL;\x81\x83\xf3\x96\tM\xd5,\x17^F>u\xf1\xd8\xc5\xc8\xd3K\xb7h\xc7/*\xb6o\b\x11\xf1\xee\xe7E}\xd1\x01\xa3\xadI+\x84.\x84i\xfd\xa5Un\x89\xe5\x96V9L\xeb\xc3\v\xa4\x11\\̃\x00v\xb5|:\xbb\x81W^\x93\x9b\xac\x8f\x9e\x13\x97m\xb0O/a)\xea\xe3\xef>Z\xac\x01\x8dS\xb9\xdc\xf5\xe9V5\x02A\xb1\x00הR\x0e\x05\xee\xeb\xe2j\xd6\xef\x83 0%\xaf\xc7\xf9(\x1f\x9bB\x02\x1b\x9e\x9d\xfe6\xcf\xc8\a\xf7\f\x85L_\xa1\x1fC\x9f\x040x@\xf5\xb9\xf8\xffg\xa6W}R\xf0\\8\xf1\x10\x14\xc0\x9a\x9a\xf3\xb8\xa2v\x19ZP\xa1C\xbb\x97[\xdd\xcb\x02\xa3\xffɤz\xe1\xf4\u007fWRDPǵ\xca_\x92\x02$\x03\\\xcd\xe023\x84*\xa2\xd3x\x99\xb3\xb4r\xc4`_\xb9\xfa\tu\xc0\x19\x14\xf0\"\x05>\x91\x15\xa0\xf3\xa8\xdb\x06_)\n\x12\xca\xe1\xfdc\x91\xd4=h\x83\x14F\xdej\x03G\xc05f\xf5\xf0[\xf3n+\x1bߥ\xf6+\x94rnF\xbb\xb0\xcdͻV\xf7\xe0\x14\x04\xf1\"\xba\xd5\x1d~\u07b5z\xcc\nO\xac\xa7\x01vd0z%W\xc1^\xda\xe1a,8\xa5>)g\xb1\xd2B\x0e\xa3MʹǴ\x05̆z\x1a\xc22ip\x8d\xa1\xe8\xf3\x89\xd6W\xf3LOǾ\xcf0\xf5E[\xa9{\x80a\x17\x89P\xf4\x814O\xd5\xd4\xebK\x9a\xc8Y\t\\\xfb̓\x10\x06\x13Q\x9e\xca\xee\n\xbd@\xe0\x8eU\xfb\x12\xdc3\xece;\x00B3\xa2\xcb)\x92\x1f\xb7\b\xad\xcb\x0e\x9f%\x10\x18\xdeZ\xcd\xf5\x8c\xa9\x9a*A\x808\u007fKoaPո\v\x93\x80\x8c\xa3*th\x81\xc9!\x19m\xbc\x97\x98b\x9d\xf2b},g\xa4\xaf\xd9p\x16&\ae\xb0\xf7\x9eh\x15\r\u052c\xdfB瀚\xd6\x06\r\xae\xfd/6l\x9f\v\x9a-\"\xe0\xa8\xda\xda\xc1\xba%\xb7v\x16(\"\xc5\x1f#\x0fW`\xe6f*\x97\xf1\x97`S\x12\x02\xd0\xd24pm\x1f\xf6\xc8\xd1V\xc5\u008eE\xca\xe5 n\xbaaIp&\xce\xd3\xc8\x13\x8e\xc3\xf1u\xbb\xe1ʷW\"\x03\x04\xc9X\t\x93\x82|ܗ\xc3\xea\\\xe1D\x1d\x97\xae3\x13\x0eJ\xa8\xdbe\xabN]\x17\x04Ɯ\x81w\x85I\xbbQ\xb9\x14\xb1\x97\xb1\xc3\\l_lZ\b!\xdc!\xcd\xcc\xc6\x1eT\xb2\xe10\x1d\xcaG\x187Y\xb7\xba\x14<\xce\x12ͷC\xa5\xf4\x84kF\x05\x9f=ж\\\x1et\x970M\xc7\xe0\x12\u052f\x00h\xac\x1ec\xbd\xe9.\xf4\xa6\x9b\x1a\xe8\x1a\xff\xe0\xaf@A\x83\x81\x11E\xd4\xf0\xe9)?i\xaf\x1eb\xe8_p\x1c\x89\xe1\xdc\xf5_K\xa9\xcbh\xf7\xe4\xb8\x19\xed\x1dӮJ\x92\f3\xba'\xad\x83f\xb4\u007f \x90\xbf2*A'ݫR\t\x80,\xf8\xc6\xf8\x95\x00\xa7\x14\x02D\xe1\xdfD\x1bhc\\\x11X\x10\x97M\x9cm|]\xc4\xfcF\x99\xce\x00u\x9fb\t\u007f\x89Ev\x10\xac\x0e#P\xbdt\xe2\xf8M伂1\x1ck\x1fN\xe35\x96L\xa2\xe5\xe3/W+$\xa4\x9a\xa0!d8\xdbl\x13l^\xd66\xe6\x06΅[c\xf5\xd1W3>\xb1\xac\x96\xff\xc4u\xcc\xc7)@)\u007f\xc9\xfa[\x11\xaf\xb1j\x89R\xe5Sv\x88\xb7h\xec9\x8bb\xd7\xdbz:8\x83\xe8\x8a^D\apuC(\xc3\xc5-\xb8\xd3\x00hϣ\xdd\xce>\xa4\xde\xc4\xf7q\x96A\xaa(\xf9<\xea\x82/\xb1\xc9\\Ȓ\xfc\xa7\xac\x9fc\xe9\xfbt\xba\u007fܰ\xb0sE\x9e,\xa4#\xbaʮ+\xe6A\x0f\xf1ԼW\xc2,\xfc\xbb\x9dC!\xde\x0ea\xf17l/\xd2\x0f\x84\xa4<<\x88\xae\x180\x89\xf5J`]\x0f\x98\x90ǝc\v\x16\xebm\x15<\x00\xd7Y\x01N\x0f\xe5\aL\x10IJ\x82`.S\xd8\xc9\xc6\bIdU\xc9Bε\x0eu\x1eQɫb:̪uZ\a\x8d \xc3Co\xf4,\xa9+4LJ#$ \xacJ\x1fD\x06\xd6\x107\xa1&p\xe6\xe3\x90\n\xccA\xe9\x9e\xf6\x0e\bS\x04\x0fD\x16l^\xf8`=R\x9a\xac\xcb\bO\xe6\xf6۰mK\xde#\xb8\x9d\xa8sD\x9fѕKh\xaff\xe7Ī\xd9m{5\xd9X8\xb5w\xa2\x83c\xab\xf6\xfe\x9eW\xdb\x1d\xa8\xa0\x86m\xf3d\xdd!\x9a'\xb4z\xf9x\\\xfd\x14\rKT\xeb\x17k\x9a\xceV[\xce\xe0\xbc\xcerͲ\xec\x84\x0e$\xfa`\x0eZv\xe8\xf8\xf0+\x19\xa4\xdc\xec\xe3\x805&v\xdcsK\xf1\xfe\xaaa\a\u07b6\x94\xed\xaf@\xd9\xeewJ\xcf\\1L9k\x8b\x83z\xca_\xac=|\fcӆ֤e\xa7\xa9\xfb\x82\xe3\xf8\xef\xa09V\x8fo_\x8fo\x99\xee\x88ʽ3\x84\x86#\x9c\x81G{̈́\x9b\x9eP7\xed7\x945\xd2\x1eEo\xb8\x9dA\x05\xa0ς\xe3\xef\r\xf7\"\xa0\xba.W\xe9\xa44Hj\xc9\xd9J\x94.;^#\xda\xe0ё\x1e7\x11\u007f\xb0\xb0\xa1\xady\xe1\xcdCW\xe4 Z\x01D?@w\xbe\x84\xa1\tP\xa8]J\"i\xfeY\xac\xb4\xfc,\xaa\x8c>+z\xf4\xb1Uݲv\xff<E\xd7ǈgx\x86\x1a͜\xf5\xfd\x03o5\x01\v-\\\xa8\x88\xc7\xd7\xf3a\\\xed@\xc2)\xbb\xd1\n\xf3,\xda;\x94+ʮ#t0\xeb\xecc\xcaރ*\xd9K\x95\x9c\xbe\xfd;I]M\x12A& H3ڥ_KG\x14\xb9}\xf8\xd83\xc3i\xaf\x12\x97?o\xb6TaY^\x84ϑQ:\xed\xc7\xf2\xd5{\xe9\xe4P\x85\xb6\x9e\x15u\x14\xf1\xc3\xfc\xba\xaee\xbd9F݇Ut\x85&\xf6,\xea\x06L(\x8d\xad痾\t\xb9\v\xa60}%\f> \x9c\xec#N>E\xbb\u007f\xefYg\x1f\x87\xe1\xaft1\xa9\xeb\xc9\xe8-\xc9\x01{\xd6nt\xd2vf\xad\xee\xfcǞ\xae\xaa\xa1p\xeb\u007f\xde\x04\r\xe2\x15\xc8\xe3Sr\x12\xe3%\xe9*\an\xb3\x1b\xc7\x1d\a\x8bh\x8c\xfe\x06~\x12c2/\xbc\xb0Ƙ\xf7*\x9b\xf6\x87\xd2\xe3 \x80z\xdbǄ:\xabX\x12\x92\xd0\xec\x1cE\xb57\xa29\xe6蓮\xd6\x17\xf3!\xb0xo>\x18\xa4Su\xe1\xfbs\xf5\xfdN\xb4P\xaf\xd5\x1a\xf2\x1a\x80\xf6\xaf\x8b\xb9\x8eSFi\xdc*t\x1c\x1d\xf7\xd6\xf14\u007f\a\ue7c3\x93HY \xa9\x8c;\xd7$\x1e\xcdZ\xa3\xe4>\xf3py\x9d\x8f_N\xd3\n\xabX1\x87\xa7\xe5\xb1S\x06f&\xdd\x1ao\xcbgU&o\xc5\xe5q\x00\xb8\x1b\x80\x98y;nQ\xb2\n\xefWb\xa8\xa6y\x96\x94\xe3.\vj\x8bӨ%S\x9c\xae\xa0KuC\xc3F\xa3\xa9\x1ez\xc9\xf2xA\x16\xdfH\xc86Y\x96J\xaa\xd3\v.|\xc0E\xbf\x9f\xd2%\xf0\x0e\xb0\xc1.\x9c\xc5\xe0\xe2!\x8b\xe3\\Z\xf2\x048\xc1y/i\xbd%m\xcd\x06j\xbd\xaeg~\xd7\xcfܷn6\t\xd8c\xb6\x0f \x05\xdf\xd7\xedZ4\xe1\xfb\xcb]\x8b8&\xe7\xbd\xe2\x9f\xf2M`\x13\xf6\xb1\x87B\x9a\xbf/9\xae\x8bK\x99^\x02\xa1E\xa2W\xd4+\xe8\xe7\x11O\x12ɳ\xdcZ\xa2*ڇ\xd5w]\t\x05g9\xba\x05\xd7v\n\xf6lp\xd3\xef\xca\x15lS\x8a.s\xc4\ar\xb3\xae\xba\xf9u\x1a\x8b~\xa6\xafr\xb1\xa9@\xd8,\xb0D\xeeZ\a\x06T\x18\x83\xe5\xb5ԇ\xfdjD\xa5:\x1c\xea\xf3\xa8\x14\x91\xf8\xa8G\x8bWU\x1b8\x12\xa8\x9dΦK\x16+\xb9i#]\x86\xaa\x06\x97\x1b \x1e\x8c\x1f\x81\xb6e\x108r\xb5\xd75M{\x01\x913\x8b5|~\x13\xaf\x85,\xcf*\xdbA\x93\x8b\x01\x88te\xdf6i\xcb\xe7\u007f5u\xad\x0e8\xa2vE\x0f\xbe\xcb\xc6\xe9\xc5l\x9a\x8d\xaf?z\x0f\x1c\x8c\x1bkrNY\xb7j\xdfq\x10\xaa\n\u007f1i\x0f\xd3G\xef)\x84`\xeaY\xe5!\xf4\xd8z\xcfC\xec\x9a\xce\xd6\xc3~3\xf2\xce\xf3ۅa)-\x00\x1e\xed\xab\xa8\xcf0{G\xe19B\xf4\xb7\x1b\x80\xa0K\xee\x10\x84\a\x81y\x0e2\x13\x13\x05\xec\xffiÀO}K\xc7\x02\xce/\x1a!\xdf6U\x03\xf3xC\xa3ڹ\xb4\x15Yx\xd1\f\x97+\xffQ[\xe1\xf5ɑx\u007f\xfc\x00b\xaa\x9b\x01\x01\\\xff]&\x98;R\x8e\xbb-\x17y\x0f\xa4\x13!\x8f\xfc\b\xdbxH\x1e\x9bP\xdd\xcd\xd8l\x15\xa3\xb1\x0e\x80W\x83I)\xab9X߆\xb9m#~\xb3\xdd\x05Up\xdf_\xe5b\xbf\x9e2#U\x83L\xb2\xa0\xf9\xb49Фo\xa7Ҙ\xfa\xb00\xb9\x8fx\xf9\x99\xbd\xd0\x19\xa5\xa26A\xa5\x93\xf4\xee\x0fV\xda\xd4\xc3\xe3棪o\xeaŵ\u009a\xabn\xfe\x8b$KK_S\x8bcU\xd7P\xe6*E\x8e\xda\x1e\xb3\x8d\xae\xadȝm+\xc8h[\f7\xc7?\x86\x16\a\xd0w\xce\xcb6\xe8AJ\xeb\xcd\xf2\xd6\xf3Bw\xe9\xf1'\x88\xec\x8f\x1eR{nT\xf6EFb\xd3\xcfRq\x15\x91\xbbB>k\xd8v\xb4b\xe6\xac`\x14\x0e\xf8C\xf8\x8e#i\xf6\xc7\xda`\x11\xe6A\xb6\u007fe\x1bbx\xf8\xa1\xbe\xbaWM\xbe#\xb6\xb3\x8cIncf6\x92\xa2\x92\x9bUc\xdaQ\xf8\x92\xfe\xbc\x97\xb1.\xb7dv\xbe U\x10\xadt\x02\xc8\xd7\xe5A#\x82|\x11\x1d4#\x80!\x81\xe7u\x83A \xad\x83\xe5\xf7t\x96\xfc\x9e\xd1\xda\x12\xe7ȉ\xd6v=d\x85\xb5\n\xf8^\xed\x15\xad\x1e8LˀPf\xda-\t\x87\xddp\xec\x0f\x8b3\xf7\xb8\xdd\xce\xdfa/\xcf\xd76S\x94\xd8b\xbc\xee8\xe9\xf6\xa5\x98\xf98\xb1\xfa'\xd7\x16\xd5K\xe6\xbc\u0378\xae\xd0A\x91M\x92\xf2Ҷk\x92(\x86\xf2\xae\xed\xdfA\n\njW5\xc6|\x9b\x81\x19ɦ\xf0B\xb0,9P\x93x,ά\xdc\x17\xca\x17\x9bB\xc1\xca_\xb2\xb0\xca\x1a\xed\xa5]\xe2\x05\xa0ڐ\xf8*?\x14\r\x8bv\x97\xe2?r\xfc&\x81\b\xb1p\x19I\xf7nPQ\xca\x02\xf7i\x1f\xf5\x13d[\xa1\x9d\x03j\xb9h\x95\x91\xa9\xcdOJ\x94\x8ePa\x93O\xd5B\x8b\x8fU\xcf/@\x15\xf9\x8eܪ`2\xa8\xa0\xb7\u007f\xb7J;\xb9\xac\x8a\xb4̬\x86\xadE\xa7\x0fXfQ\xe1\x10@LS\xd9!02ՙ\x03}5\xd5*B@\xd0\xe4\x13\xe9\x8eM\xf3.+\xb2\x9e\x9a\x96\x9eA3\x9e\xf6[\U0010caef\x8b<\x8f\xack\x1a\x0fn\xac\x89lRn\\_\xb5[\x83ړ4\x91~\xc2&b\x81\x98\x88ғn3J \xb8pg\xb5\r;\xe9\xa05\x9c\xa3\xdd\xf5\xb6hN\xf84QGw\xaa\xe1;\x9c\x16\x9b \x89\x97\x8c\xdfF#r-\x16\xc0\xd9\xcbi\xff\xdb\xf1,\x9d\x8a\x85\a\xee\a\x12\xb6\x8dz=\x817b\xec \x96\x12\x92N\xa3a-B\x16\\Q\xd1\n\xf2\x00\xf7c&\x1d\xe7\xc1r\xd0=\x17\x96\x9at]\v\xc2\x13\x01\x02\\>\xc9FB^\xae\xa2\xb5\xbb&\xcb]\xda\xd0p\x17\x06\xa3$\xfal\x8e:\x13rޘt\xe8w\xc3u\xb7N=[\xe5\xdc(\xc1\x97&\xde%=\xacm\x9f\xc5\xe8\x937|\xf1+\x11m[\x8e6D\x96 Ť\x8b\xb1\xb2\xcf\xe1-\x84\x8c\xa9*\x92::\xa9\x83IO\xea\xac\\\xc7/ס\xd7C\x86\x8e\xf60v\xca\xd65\xb1\xe1\x89G\x93\xa1\xa6e\xa3\xe4Q\x90*U5\x8b\xda哈L\x1c\nL\x82O\xab\xc0\x0f\x83sf\x0e\xbe\x18,UM\xd5(\x9a\xe6}\x04̤\"\x9fO\xfb\xec\x96o\xf9\xe4\xd3\x10\xec\x9a\xd6\xe4#\xcfw\xaefƕF'\xc2\xf8;\xea\xed\b\xdd\xe4H\xf8J?\x8c\xa4\x89\xe4,j\x98\x0e\xd30\xe8\xb2`\xc4r\a\x84!G]m\x9c\x85\x87*\xa9xBD\xb7\xac\xc0E\xab\x98/\xd8\xe7\x13\x98\xe4\xadv\xa7\x19\x99\x17\xf1*\xd7\u007fy.\x900g3jʡ+Q\x8eXk\x16O\xafS\x8c\xa8\x94%B\xed\x14\x8a\x88D\x96dz\v\x98\x89\xcb\xfe$\xa8\xdf)\xbc\x1d%\xcf#Ī\xc3m\x1dp#\x9b\xc83n\xf0\xa2c$\xd4\x03\xdbq\xc92\x1f\xed0\t\x8f\xd2T+\x8d\xd6 \x1bb\x94#\x8fP\fo\r\a\xfc\xe5ó\x82\x1fz\xa0V\xb7\xfa@~A\x83F\x93\xc1g&\x0e\x91\xbc\xf1\xc9\x12\xb3\x1b-\xa5\\*\xdb#j\xfct\xf9*\xfc\x18P\xf53\x88_\xe5i\x92\n\xf9'\xd7\u007f{{\t\xcd\xe7\x8f\u007fD\xa5\x86H6\xeaw?`\x84\x14\x98\xf0\x05\vX\xe8T@\x85\xbf\x96\xa7iS\xd3\x12k\x01\xd7\xd0\x1fr^\x85\xea͊\xadx\xb8\x13\xa5\xa3YjK\x0e\x8e\xe9\xe3\x9c?陣gMY\x80:\xe5\x8a\xc4ۑ?\xfc\xc1\x1d\xff\xb0\x83\x02\xd3\xe1*\xac\xe1Z\xc7\xc7\x19\xb1G\xc0~\xe5\b\x05\xba\xf8\xb0\xf6\xf8\x9a\xae\xffyC\xfcH&\xf9-\x96\x05\xcf,\xb9\x8e\xfc\xa5\x96\xb9\xa53/\xb1\xd8˕\xfa#z[\xf0W\xfa'\xabVzoIG$\xed\xd0]\x84w<\xbe\xde\xf2~\xa2T~\x8c\x0f$\xa5\x15ՍҴ\xb3h\xacz\x01\x10@M\xbbHjMe4/,\x83ZU3\xaaM\xa6i_,2\xd0s\xd7b\xa44\xad\xca\xfd\xb2Q\xc7,\xcd\x1b\x94i\x95\xa5w\x98%*\x13\xbd\xb3\x86m\xeb\x8cB\xcc\x10Bp5Ե\x99^¦\xe6\xcaѯ\xeb3\x8cW\x8a;n\u0602\xb2\x99\x87\nn\xc66g3\xa3\xa0y\xe5&\x9e3\x1d3\x8fh5\xad\x99\xf5\xa4f\xd6\xd4\x15+\xea\x83\x1f\xf2\x19\x98\xcb({\x8aN\x17\x98N\xbby\xd2e\xfd2o0]\xee\xf65\xa5\xce\xf16\x96\xab6\xb3~\xfb\x80̎5\x9f\xaa\x1d\x8b7`\xa1\x91\xb7\xa6\x90\xea\x8a3\x85X\xcbj*\xe1f\x94~\xafҜ\x19|E\x86\n\xf8R\xeb\xc7\x1d-\xfd^S3\xe7@\xb4\r\xc4~\t\xaa\t\xa2\x87l\xf3\xb0\xa7T\xd5D̖\x80ޞ\x95R\xf3\xc9F\xd4t\xbb\xa5p\x0ewD\xf3\xd5\xe6\x1d\xa1\xaa\xfa\xf6\xb2\x02\xf4\xb6|؞l4l\x9bu\x843\xff\x16\xb1h\xb9\x90\t\nT\x0e\xff\xed*\xb1\x1bD5\x90\xe7IWf\xfbg\x06JSj\xa4\x9dȩ\x8b\x92\xe0\xfbY\x8c;\xef\xf5\xf5\x14N\x8d\x04\x03OgܖO\x0e\\E\"\xec;\t\xfd\xd1[Y\xfd\x02ұ\xa2tb\x02VDtɪoΒ_\x94D\x1eF%\xf24j\x82\t\xdf\xc7\xdf\xc3ϥ\xfa\x99\x19\xff\xaeIn\x86\x80{}\x85\xf1\x80\xf6\xea\xf8\xa8\x18\x9a\xd8\xc5X\xa1\x19\xc8\xee\x8e_\xca)\xd1-\x85\xa3J\xec\aa\xa8\xdcc\x9e\x9b\xa1@9\xb4\xac\xa1\xf8\xa0\x16zt\x0e\x86\xd7\xe8s\xf8\xe74b\xa6\xb7\xc4rb+m\x8b\x06ډ\a\xad\xed\xd4Eҝ\xc3[E\x17\xb8g\xa8L\x16Z`\xeb!\xfbra]\x99I\x96\x81\"KV\x84\xbb\xf6\x14\xf0\x9e\x10\x02B[\x135\xf1ci\x19\xe6h\t\xa4˽\x11\xb7\xfb҈s\x97\xcb4\xf0\xc6ٵj\t\x0f\nMA<\x1bT\xa5\x1e\xd4b\x8d\x16\xb5'\xf0>Sp%\xbe2\x11\x1fK\xfb\b\xa6\x1f8_atP\x1ep\xc1\x9b\x01\xfe\xdf.}\xec\xd2W\x9b\xfd\x1f\xcb\x11\x8c\x8a6(\x97d\xcaB\xb5\xdb\xf1\xeb\xb55x\rZ\xe6 4>/o\xc0\xa5)\x9b\x94\xf0J'\xeb\x1b\x83$\xc6\xe3\x92iJu~\x1e홧\xa3\xecr\xf1\x10\xa3\x148\xa5ń\xa3t\xf8\x17Y\x1f\x8f\x19\n9y\xc5Lǀ\xfd\xf0;C\xd3\xe6\r\xcdXt$\b\xfe\x05!$Q\x17=\xab߉eQԐ\xb7\xb8\xd0v\bs\xb5s\x16ex\x027\x06s\xa1\xf3,Da#8\f\x06\xed\xb9F\xef\xf2\xe6\n\x1f\a\x19^\x82\x86\xb3\xc0Dq@\x9c\x9f\xaf\x80\xc1˷\xdd&E\xc2\xe7\x118\xfd\xb8\t:\xb8V}jW\xf5\x89f\xee\x8d\xefP\x1f_\x10;\xe8\xee:\xd8\xea\xf7\xbb\x1d\x1f\xd5]\aU\xbd\xa8\xe0\x88\xd1Tcao`\xd7p\xca\x1fs\xe3xu\x1aF\xc5\x14z{\xba8\x8d2'\x16\x13uVB\xc5\xc0J\x9a\xff\xc4\x00\xf7\xfcS*\xb9Cٍz\xf4\xcb>\x01\xa5\a\t\x00\f6\xb9\xac\xc0\x9d\x95\x81\xc8P\xc6\xdc\xc89\x97\x8d&>\xcd\xdf\x190\x99\x05\x86\x86#\x06\x960\xf9\xd8>\xe7\t\x9b\xd2w\x95\x94v\xc7\xf0\xaer\f\x05\xec./\xfdn\x15l\xb7t%l<\xbc\x06ם\v\xf9\xa0\xff)\xfc\xf3\xe2\x1c\x1a}\n\xff\xec\x00@\x91\xd8u\x03\xabX\xa4rn&\x86N\xb7\xa8\xc2<\\\xc1\x1a\xdf\xcc\x1a_\x13f&\xb3b\xf3\x8a\x84w\x81;\xc5n˕p\x1d\xa8\x16L>eQ\x80\xd1\xd4Pη\xcd,n\"\xef\xc8\xc9\xdc\xc5\xd3\f{*\xcb\xe6ߩ\xae?\xc1e\x9cӓ\xc1w\n\xf3CΝ\x9d n\x13ҥ\x04\x00n\xfaA\xfc\u03a2w\x8c\xdbW\xa0u.\xd1r\x05\x8e\\\x1c\x9f\xa8\xd5\tV\x93W\xaf\xfe^\xc76/\v\b\xb1\xd8UB\x9e>\xf10ц\xde\xd4\x1ch\x9d\xb9\xed\xee\x16\x13/҇\x04\xb4\xb3se\xc6\xee\x9d5'\xf9\xfb\x9a\xe1\x92=\u007fqe\xab\x80nOs(O\x84\xd63\x82\xdaˮ\xae,\xc1\xc5.\x10:ڱ~\x10\xb4\xe2\xa1+\xdf\xc3\xcb{V\x81\r\xeb\x1aG\x81\xb1sTJq\xa7\xc8+\x83m\xf7D\xb5\xb8\x17.\xde\t\x14o_\xad\x11\xf8\f\xafJy\x87\u007f~\xf3\x99-Yz%\xf5:\x81z\xed\xab\x92\xc3\xd6zOT\x8a\xc1\xb4\xd4:\xb0\x8dZ\x8cN\xec\xea\x98&\xf0\xedfW\x16\xeckmq\x19\x11\x9bQ\xff\xacz\x0fGw\xb4\xe1\xdc\xfc\xd0\xee\xa6\xfd@\xac\x9a\xe0\xe7Q\x8fӱ\xfcn:\xec\x8eb\x1a\x89\x1e\xc9\xf9\x1e}\xc5\xf6\x02\x02CQǂ;X\x02\b\xda\xe7\x0f\xee(\xbfC\xf9\x1d;?\xe0h\xe5)\\\xb4\x87'ӷ\xcekhkX\x9c#\xcdb\x8eVXg`Ɠ\xf9l\xf5\x03\x00:\r\x8fK\x06\xa5\x87\u07b6\x935\x82\x16AktY\x1e\r\xc22\x02\x16\vx%3t\x1c\xa7\xf6\xfa\x03\x81\xc0/\xf1\xc1\a\xfe\xd4<\x01H\x9a\xa2\xeek\x82\xaag\x02\x89\x06k\xbf\x05\xc0}\x93\x14\xc7R\x18};.\xb2$\x857Ө]\xb9\x17D\x90]\xc3U\xfa%U\f5ح\x12Vy\x96N\v|\x88\x85!n\tDeDd\vZ#\x10\xac\xae\xbc[e8~\x18\x86\x1c?<\xc5\x0f]\x03\xb5\xa6\xe3\x83\xfbN\x19\x9b\x0f\x99V\x9c\xab\x10\xd6\xfaq\x97O\xf3q\x9eUm\xc9\xd8#\xe6\x82\ufdec3$O\x87VˠR\x9a\x05Ed[o\x05\x1eE:D;\xb3\x02\xc6\xee;:\xf1\xe8\n1\x15\x8b.\xed\xcfpo\x06\xfb4\xcb̑\xb6bi0\x10\xbb\xf6\xb4\xd5\x03\x95\xd1zbR\x80\x8a\x9b\xb6\xd2I\x8b\x85\xfa*\xba*qXj_0\x15\x87\xa1\x8aӐ\rAc\xc31@%\xbb\x00\x8bF\xd1i-\xf1\xf9qzxfk\xeci\xab/\xa6'\xe0\x0fz\xaf\xa3D\x8bFE\xdd.ֵ\xabvݪ\xddr\xf7e\x1c\x8b\x85\x87Œc\x11\xa3\x1e\ued23\xb0X\xb8X,\xbbn\xd5n(6\xf5]\x87b\x90\xe0V\x1b\xac\fw]J\xc0=w\xc4\xf6\u007f\xc4m\x97ik\x9a^\v\xa6h\rI庒\xfaR\a\xaa6ʋOˋ;\xd7XU]\x9b\x9be\xaa\xe5\xf4\x01\x8fT\x17\xde&\x16\xdc\xf6{\xbb`\a3\x1dM\xcf\xcc1\xeb\xf0Q\x1f\x88\xb1\xbd\xed\x1dm\xad\xfa\xa0\xae\xd2z\xdagO\x00\\#\xb0j\xa8js\xbbj{\x1b>ݵh\xa0A<\xc9\xd0Z\xaa\x01\xc9\xf6\xa5b%\x93_\b&\xd9\xed\xb4]\xa4\x1f\x1a\xae\xddJ\t\x86|\x82\xd1\xedL\xd4\xf0\xe1iT\x9b\xca#\xa4\xa9\xf8=\xac\xb9\xf2+I\x87\xf1|\x1c/W\t\xb04\xb9N\xb7\x92^\xe0q&\xc3\xfe\x14L\xb9\xdfF\x9a!\n\xa5,\xad\xf8\xc7\xe3a\x87o\x11\x8a\xcfP\x90\xec\xb1\xeb\x9d͘Mɿ\x8ck\x19\xaf\x02\x84\x8fȨ\b\xfeC\xd9S\xc7`\x87\xbd\x15\xf4\x90\xdd\xe5\xc3\x06\xcc%R\xdao\x8eğ\x9d\x1dވ\x1eMeɽkbU\xa1֨\x1f\xe0\xf9\xf3*\xba*9{\xd7~j$\x1coI\xfdm\x1e'2\xf6=\x13(\xb0ut\xad\x8c\xde)q6.R\b\xfb\x8dѭ\xc2e\xa5I\x0e\xe36OZ\v\xbc-\xa7\xbe\xc0\x8cO\xe8\xf1\xf5\x11B\xb3\xc6\x03\xf7\xb6\x03\xf0Ƙ\x9et\xd8&\x98;\xffƲvCq\x87\xa1Աٝ\x88\xa5.1u\t'˭v\xc7S\xf8\xb0W\xaf \xb4h\xb8k+\x83\x05KD\xbd\xab\xcb\x01\xe8w\x95\xd07E\xa6ʌd\x90\x9a\xaca\xfcY\xbb\xdd`\x00\u0095\xb5m\x9d\t]Z\xa1\x19mѥ\x1d+\x04\x96\x81D\x8c\x13\xb4ԉꅈ\x18RP\x9b\x8a\x05{\xca\"\x06\x14\xd3ddֺ\xccx\x8e\xb7\av\xa8\xaa\xf6Ώ<\xe6\x06g\xc4 \xc2-\n~\x98]\x01\xa7\xd6٧9\x97Q3\xe6{\x91U\xb7\x0f\xb8\xe5K\x16R]ԛ!RF,\xa3\xa8z\xd1B\x9a\xd6R\x16$\xdd\xc9<n\xa9\x98^\x9e5\xc7\xde\tJ\xe317k\xe1\xdc\x02\x9dSN?m\xf8]4\x1a\xe6\xd0AV\xc4\xe8\x91Nw\xf1\xe6c\xc3\x00s\xb7\xf0b6\x1d\xd8\xd3\xde+\x01\x9a枥iB\x8f\x91>\x96\x0e\x89\xae>;՚!\x16\xf2#]\x13\xb0\xae\xffpi\xd53\xba\t7w\xba[F`\xb8\xe7`\xbc\xa7\xb6ŧ_\x02^*\x81\x93\xd8%\x95X\x86`\xbc@\x0eG\x1cK`\xbc@v_v]\x18\x0f\x968$O\xd2]pWܑǸK\xf8\xe2T\x94G\x84/\"8\x13\\\x12\x8d\xc5DH,\xb6\xc4Y\xe7\x1e\xfa\x19\xb6\x92\x01AÜ/\x17\x18\x88\xba\x19'\x96\ri\xc2\xf8\x80\x98\x18JU\xf01\x03\x05\x97c\xad\xab\fl-p\xf1\xf8\x19\xca\xd6\a\x14\x0e\xb4\x1a\xb6dl\xa51(\xac\xedU\x12R\xf9\x029\xcbF\xa9\x1b\xf0M\xa6})4\xa8\xa6\xfa~\x9b\xc4ˋ娗\xcb\xf0\x05\xb5\x8b\xf9X$AP\xb9\u05f9\xfa\xf5f\x9e\x16\xf2\xe7\xdf\xd3d\xac?\xde\xdç\xea\xf7\xd7\xd3L\xfe\xba\x80\xbb\xd5\xf0[\x1dHRC\xf3\x19\\\x92\xc8g\xb9\f\xb2H\x94\xb9Ng\x80\xcfiT\xae\"½\x99\xd6[\xb1\xe4\xcf\xfe\xf6\xe6\u0557\xccm\xb9\xbc~\x88 \x96\x1b@X\xfa\x00\xbe\x16\xabݯi<]\x1b\x8a\xaa\xe0\x83\xfa&\x17DY\x1b\x0e\x96\xf6\x81\xbcΆìH\xfbb\bև\xc5+\x85@\x8e\xe7x#lmhX>\x00(\x1f\xcfn\xd6\a\x03\xa5} \x17\x1b\xf6\xed\xa2\xac[o\x04o\xad\a\x05J\x86\xeb\xbf\xcf\xc7\xe9\x0f\x83A\x91Ϊ \xb5\xad\xaa\x18_\xf1\x87\xc1\x1aM˒N\xd3E\x05\xe3\xab)\xa3\xa7K\xab\xd0\xcc\xcf.\x93\xb5\xde\xf2\xebd>\xf4\xe5\x86\xc0\x97kî\x9c.e\r\xa8Jk\xb7R>\x93ʚ\xc0\x1ak\xc3_9\xc9ʚ\xe1\x157h\xad|\xfe\x957\x84u\xd6o\xa3tj\x96\xb6\x005ֆ\u007f\xb1\x05\xb1.6\xa4S\xe9\x84\x0e6\x00\xa5W\x81v\x8c 6\x10\xb9 \x9a\xa5\xc9-)\xd6\xddQ\x8cs\xf5\r\xad\x9f\xb5O\xe3\xe8\xd3^\xf4i\x1a}\xfa\xcd駯O?\xbd\x88>\xfd\xb5\xd6\fT\xc0£g\x9f&\xcf>]z\x0544\t\xa4\xe66,\x96Ђ4\x18g\xa1\xb6\x16\uf5fd\xde4\xbd\xcb F\xed\xd8)\xae\x95a֪.\x9e6\f\x98\x110\x81\\\xff\xffW<\x9e\xc7SZ\xd2\xd3\xdeT\xfd~\r\xb1j\xe1\xc7\xcb\xc94\x1bR\nf\xfc\xaf\xf98\xa5\xbfC\xfc~9\xbf\x9e\x173\xd4\x03\xd2\xc9,Ũ\xc8\xe2\xe3\x87\xfe,\x97?\xbf\xcf\xeft\xf2\x97i\x9f~˫P\x06\x9d\xb2~\x11\xae\xd5=c:P\x8b2\xb9V&\x90\x9a\x80\x15\xc93\nP\xd1z\xc2\xf2\xe46\x0foX\xab\xcbLr\xb3\"-\"\xfdf4X\xe3\x10\n\xee\xa2\xcav[\xfd\x9bx\xfaJ\xe8\xa1/g\xf5\f#S\xef\x1d9~\xaa\xb0=\xb2\x9b\xe8Z\xe0u\t\xd3\xeb\xef\xc0\xfeԌ\xea\x03F\x16½\xb8\x84#\r\xab!\xd1\b\x1c\x86_5\xa2ϣ\x81\xec۩\x15ځ\x8e\xa720\xc6\x04\xb6ן\xac\x8f\x92\xe7ѕ\xea(\xf7\xb8\xee\xc6o\xccQu\xae1\x88\xbe\x80\x0f\xfc²\xed\x10<\xff^?۔\x9dB|\x98\xb6\xb9\xa74:\x8d\xd8W\x02\x81m\xf5\xd77V\xdek\xeb\xeb\xc2\xfa\xfaN|\xe9'/Q\xc6Ȏ8\x82\xedVu\xa4){m\\ب\xab]O\xce\x15A\x9c\xbb\xbf\xdc\xd2\x02Ek\x93\x9a\xd8{DI#JZ\xdf@[\xe2\xdfO\xa3\x0e\\\xcbIZ\x10e\xa3ӵ\xc2\xf6\x90\xa0\x19\xa7\xf7\n+\x95\x9b\xe0\x18\x99պ\x9e\xb4\x96Mxn\x04\xff\x98\xc38U\x8cTQ\xd1\x1ad\xbf\x86\u007f.\xe0\x9f\xef\xdcaM\xb4\xcf\xe8\ak\xbcf\xf9\x85\x9a'\x15:\x91\xa4S\xe8\xf8\x8c\xe0\x9c\x85\xc4\xf5\xfb\x81Xr)l\xa2\x9c\xd1\xf5Z\xbb\x9bԘ\x10\xc3\"{N\x91=\xbfȾSd\xdf*R\x04\x1a\x82v\x02\xc2\xf9\xa7ԛ\u007f?\xa5u.\u007f\x1b\x01I\xbd\xb2\x96%\xf8\\!]]\x9bd\xa3[\xe7\xbb<\xbf\x9dO\xbcz\x94\xbc\xa2\xee:8\xfb\xe2:\fe\x03<lh\xe1\x15\xd9x5\x89O\x83o\xa8M,\xf6 i/\x93\x16\xed\x1c\xeb\x8d+K\ay\xb9.\xb8r\x10\xbd\xb5@\xf8]%\x80r\xcbd\x83\xfcb}\x90U`\xfa\xa7\xce\xcaX\x0f\xeb9\xf2\xaeg\xb2V\xab4;\xeb\x92\x1a<\x80\x19AIׂR\xac\x80\xf2\xcdƸ\xc8͵\r\xe6\xdbm\xc1\x90\x10\xfe\xfdw\xf1\xaf\r\xf1\xdd\xfa\x10\xf70Ⱥ\x1a\x01\xc1C?\fH2;H~\xb7\tH\x1amk\xf3oC\x1bm\xdce\xc9;\xa0\x13ؠ^o\x0eJ\xd9\x11l@\x93j@\x9c\xee/\u0381\xf0\x9fG\xb5\x1f_ׄ\xeeR{\xf9\xbaf\x81\xba\xd8\x18\xa7\x8b0\x9d\xfe\xb6\x11 9\x88\x05\x1a\xccJ\xc6\xf1~u/}{\xd4߷Ac\x94W\xa0\xb1Xc\xd6\xcb\x19\xffˊ\xa2L8,7\xa6\xbb\xb1\x9a\xc1dj\xb7m,\u007f]\x1f\xde~\b^\xbao\xc3\xfbǩ\xa9\x94\x8f\xa5Ӂڧ\xb5\xcafD~ն\x11\xf4\xbd\xa9\xb5\xf4XY\u007fO\xd3[\xbd\xd0\xe8\xe5$TD/\x14V\xe6k\xb3$h\xb1\xef\x17`\xa2\x9ce}\x97C\x18\x11 \x89\x96\xddV\xfe\x97\xaaѴ,\xe3\x1b'\x03&`w_\x8b\xcdҼ\xef\\$\x99,\xd2\x12\xc8\xef\xc6\xf7s\xd8\xfai\xb9\xe2\x80@\xa9\xa1e\x85\x95\xf9r\xf4\xe3HO}+\xe7\x827\xba\b\x12\b\xcd߆ս|`p\xcd\xdfV>p\x9a\xe6T+G\xf1a\x88e\xc6\xd8KT\xa1\x9e\xfd\xf3\u007f\x17O\xffw\xb2\xf3\xcc-\x13\x8b\x0e1\xf5\bw\xb7\x92\xc1\xcc\x0ef\"~v>Q\xe7\xe3\xf6$m\xcdg\xfd\xed\xf7\xb8\xe0\x91\x84=\xf6\xd2'\x05\xcct\u007ff\x1d\xe2Pk\x81-G\x04Y\xad\xb7P\x97E\x96\x89L\x04RA\xe1\xba(\xc2b\x01\x0e\xb2q<\x1c\x86\x11\xf8\x92\x01y\xf0\xe2\xe3\nXD..\xadt\xcf7\xdbKnL\x02\xb9\xedB,\b\xbc\x82\xe9\xf5\x1a\x8b\xc2C7\xd8h\xbdݺ\xe7e\x9b-BA%\xac\xbb\xa3\xa2\xaco\x8b\xdc#!\xf0R\xbd\xf6鯻\x9f\x8ev?M\xde(\xfbT\xeb\xd3\xef\xfeQ\v\xb1^\x860l#\x9a@\xe7ۋ\x1f$\x8a\x9f\xfb\x8d~/\xf4ݻ4:\xf5s\xf8\x91\x91S|\xd5(:\xbba\xd4!\xad1Q\x0f\xad\xf1\x18\x99\xa6\xc0\xe7\xb8\xfd\x06D$\xc1\x1f\xaa\x10`T\xf7\x8aX#\xa0\x95\x03\x94G\xac8>9\xbe\x13\x93\xc0,Al**\x8b\x80\x99Xt%d0\xcc\xf3)\x96\xa4\x80\xbcp\xab\x00\xfe \xc2\xec\x12T\x82\x16\x88\x1c\x8f2\x14L\xb5\xbd\xc7\xe3\x0f\xfc\xd0g!\xean\t\x81W\xd5\xcaA;h*`\xd6ٌ-\x9d\xe4\"\xc0x\x8cRZ\xd3x|\x9d\x06JJA\xe6\x94\x06\xa1\xe2\xd5\x18\xe1\x12\xf1\xb8\xb4=\x14:\x84 \xc0\xa1T%>\x02m\xcb@\x97\xd0\xd6:\x85\xb3iK\xfd紥\x942J\xb9\xb4\x95\xa5\x83\xb4\xbd\xa1'\xdd\xebQ\x16\xe6\xddL\x1e\xa3I\xc9\xdf\xf2N\xd60\x96\xb4\nj\x17\x18\x8b\xc0`\xec\x1d\xa6\a\x10\xf3M\xc2\xc1\xf8u\xfa\xf7S\xcc\xfeX\xa3T\n\xbbd\x98\xd81\xae=H@H>D\xf0\x1d&\xb7;<X288I\xbc\xdchl\xa8<'v\xe7\xe4\xa8m,\x94\"\xdf6\x13\xcaN\x19\xed\xbai\xf8\x916\x86&\xc1کk\xaa,7\x19\x17\x04a\xc3\x13\xe3\"\xcbn0\b\xb2\xea.ٱ\xedqЇ)\xfa3HWw\x10\xa0\\\xd9\x18\xd0^\xcbr\xe0\xe7\x10~I\xf9\xaa\xceR\x11צ\x16c@\xe2\xfc]\xaa\xb8\x1b\xd5K\xa7\x12\x15\te)\x19#\xe6\xcf\xf1\xe1~j\x85\x8fu\x0f\x92\xfc[\xa8\xb011\x97[%\xa3\t\x1a\xcc\xf2\xef \xc6\xf9\xab\xb8\xd0G\xe8`\x06?\x12\x88d杸bE\xd3\xe7KQ\xf9jmf\x8d\xa8\xc76\xfd\xa9D\xa3\x8cQ\x18\x95p'-8'\x83\xdd\xe0Q\x85\xd9z\x05_\xae\xe2̠\xc48j\x84\x81;\xc7\x15r\xea\xba\f\xe1\x18\x02¼\xe1[\x8d\x10\xfe\x0eP`i\xfa-F\xfeH\xd2e\x89\xefN\xb3\x86\xfbBD\x8f\x8d\xa8U+jW\xf8\xe4\x88Fưz\xa8\xa0\x9c\x1e\xba\xb057\xbc\n\x84&\x82\xffw\xa0\t;s\xb0\x85ýؒs\xa5\x03-:n\x81\xc2+\xe1K\x05,\xe7\xaa0T6(E\xa0\xbc\x16#!\x83\x92\xb5\x8a\x83\xe8]{.\x95M\xa43_\xf4v\\\xe9-5\xe15\xc57-\t\xce\x02\xb1\x95\x00gw\x85\x1cUG\x1dP[\t\x01E\x87\x0e\x87\x1d=\a\xcb\x06\xe9\xaf\x05\xf4\xe3Q\x94\xfa\x00\x8e:>\x8c\xaa\x15\xab\xf1V\xb4\xb5/\xac\xd9\xe4\x052\x14\xce\x1c\f\x13˥-\x96\xb4H\xcb\xf7\x98\x18\xd7\xf6b\x96N\xe8n\x81\xd8V4\xa3\x03\xf8\xa7\x83\xff\xee\xa5\xfbMX\xab\xe0\xd7A3:\x81\u007f:\xc7\xf0/\xa8_\xe2w\x1b?\xba\x1d\xfc\xda\xdf\xeb\xc2\x1f\\\xd4D\xdeQ\x173\x0f\xdb\xfbT\xe8छ\x1e6\xa3\xa3\xa3\xa3C\xf8\xbb\xd79\x10@\x0e\xe9\n\xa2\x87\x13Zz^\xa7\xb3\x9b<!\xd4.\x9d\x1d\x87h\x80\xfc\xec\xbb\xc9\a\xe1\xe4NI\xfa^\xdbN'\x9dۃ\xae\x92\x0fJJ\x97\xa4\xbb\xd0Ae\xf4`S\xe2^(\xf10X\xbdk\xa7\xa2J\xd0\t\xa49\xe5@\x96\xf9\x1dCseI\xaa\x83\x13\xf0\x12\x16\xad\x1a\xb4\xaf\xf5\xd9\x1f\x1f4uR\xfb\xe9\xafp\x96\xeb[w\xf5S\x12\xf2p\xf0`7\xack\u007fQY;qET\t\x94^\xf4i\xb2\x1a\x90\xd4&\x9eHgSe\xc0\xe25\x81\x81\xe6\x03&\xa6\r@\u007f\x1b}:Y\r\x9a\xefl\xca\x00\x9d~\xfaz\r\xcaY;\xd90(\xb8\x06\xb5\x12\x90mn\b\x03j}\xfa\xdd:\x18\xb9\xf7e\x1fʙ/\x1b\xebU\x1a\x13ZCL\xa9\xf3\xbb\x03\x0e\x97\xb2\x95\x05\xb3\xbe\xb6\xcfS<\xa6np-ݓSj\xb12/\xd1\x16\xb3t<k\x9a\xf7\xe3\xeey\xaa\xc1\xbb\x95\xe4\xa38SU\xac\xcbSX\xea\xaf\xe9\f\xd6\x06\xa1eϲ\xfemQ\x1f5\xfc2\x17\xb2\x8c{\xcb\xdc\x0f\x82^\x85O}-\xfa\xc9.\x97\x93\xb6\x11\xb4ib)\xb8\u0528%{95\xadg\x89.\t/\xa3\x11\xbc/\x11\v\x9b \xaf\x0e\x80\xfe\xd0(k\xb0J*)s\xea\xf6\x92IBXC:\xd9\xf7\xad\xab\x80\xad'\xa4\xf4\x85\xfbja\"a\xc6\xeb\xc3\xe4\xe2j\xb3F֓Z\xf6\xcd\xfaJxk\t/\xf7>|\x05ĵd\x98{\xa3\xbd\x02\xdeZ\xa2,x\xfb\xbf\\\x9ai\x96]GD\x19\xfe\xf6\x05\x94\x99l\x1fS<\x89V֑PH\xd4*!垒}\x90\xa02]o\x96\x10Wa\xf1\xd0\x10\xe3\xf1_\xff\xf9\xdf\xff\x01\xffK\xa7\xd3\\l\x82nf\xa3\xe1Gk\xa3\xddi\xc3\xff\xfe\xab\xdd\x0e\xfem\x1fu:\xff\xd5\xe9\xee\x1d\x1et\xf7\xba\x9d\xbd\x8e(\xdf\xd9\xef\x1e\xfeW\xd4\xfe#\b0\a\x0f\xae\x02\x95\x0f\xee\xa4ӹ\xff!\xff\xfb\u05ff\x9e=\x15\x93\x1a\x05\x15\xbc\x83\xe8\xc5E\x8a\xdc\xf0\t\xbc\x17\xec\x0f\xe7Iz*\xc4\xc90\x8f\x13J~\xfa\xec\xe1\xe1\x93\u007f\xfd+Jҁ\x10#Qm\x96͆i-zx\xf8\n9)\xeaͳa\x02'\x8a\xa2L\xebG\n\x15\xd8\xfa>\x1e\xa5\xa2\x88H\x12\xad\x88\x1f\x9f<O\xb2\xbb\xa8?\x8c\x8b\xe2\xbcv\x93\xc6I:\x8d\x90\x13k/D\xbb\xcfo:/\xa0\xf6\xab|>\x9e\x89ҔU\r\xfa\xf93Q\xe9\x93\xe7\xcf\x04\xdc\x17\x80\x1enߣ\x96D\xea\x01N\x9c\x03m\xf6\xf3$\x8dF\x10\x15\x01\x1b\x86\xa6\xf7\xa0\xe9\xfblv\x13\xb5@\xbd\xc2;\x06\x0f\x0f/gب\xf8u\x1a\xe9^@\n6@\xed\xef\x11\b]\x1dn\xc4S\xcb\by2M_<\x87\x06_HHϟ\xe1\xd7\xf3g\x90\xa3\xaaJ\xfa\x88\xf2\xba+2I\xfcRW\x01\xa2\x1a\x1b\x91\x1a\x02\xfbd\xdb\xf9\xbf\xc8fi\xf2\xe7\xce\xff\x83\xfd=w\xfe\xef\x1d\x1d\xfdg\xfe\xff\x0f\x9b\xff\xc8I\x1bLq\xaa@\x93\x05'\"\xce'\x91('\x0eMi='\xe5T\xfb=\x9a\xe5o\x01\x13=\xe9\xccL\xa1y\xf7\xc3|\x06\x0eҪ\xe6<\x9b\xedT\xdaL\xdf\xd5\xf3\xf4\x0f\x9a\x99\u007f\xcc\xffF\xf98\x9b\xe5ӏ*\x00V\xcc\xff\xeeѡ\xb7\xfe\x1fv\xda\xff\x99\xff\u007f\xee\xfc/\x9d\xe8\x17\xb3x6/\xd8D\x17\xfb\x89[\x90\x11\xe7\xb5b\xb6\x1c\xa6\xc5M\x9a\xcej\x18\xd0\xe3\xbc6\x13\x90\x9f\xf5\x8b\xa2\x16\xddL\xd3\xc1yMT\x13\x931\x9d\x89\x89\x02۞\x9b\xf8\x1e\x8c\xbf-,\xf1\xf0 \xe6\xe5s\x84\xe1ֆy\x87\xaf\xbffr\x873\x8a\xa7\xd7\xd9\xf84\xea\xb6'\x8b\xa8M\u007f\x8e>\x957\xca\xc4\f\x9c\x9db\x8cY\xe5p\xa0\xc8(\x86\x8f\xc0\x12/>Q\xfa}\x96\xc0\xeb\xd2\xfd\xb6\xacx\x93\x82ϐ\xd3h\xaf-\xc0\xa9\xdbi\xe3\xd9\xee \x1ee\xc3\xe5i\x14\xbd\x9cf\xf1\xb0\x19}\x93\x0e\xefR\xb1m\x03\x1f\xdf\xf1\xb8\xd8-\xd2i6`\xe5\x8b콐\x9a\x9d.\x01y0\xb8\xdf\xec9\xe8\xefJ\xfc\xdbQ\xe7\xc0j\xb2\x14D+^d\x85\x84b\xfa\x15\xf7\x8a|8W\xe7\xc1\xefw\xa5;k\x98x\x944\xcb'\xa7\xea\xc17y<џ\x9a\n\xbc\xad!l~\xa5\v\xd8!>\x8c\x16\xb5n\\\\\xfa\xf1\xf8.^\x85\ro\x1a\xc6D~\b\xc6y\x86c\xfd\"\xb4T\xdc\xc7\xd31\x04\x81Q\x8b\xc5_\xf3q\x92\x0f\xe3H\x90\xfa.\x85\a\x8c\xc0\x83\x96ҷZ\xbd{~\xd3}\xf1:\x1d\xe5ӥ\xa8\xd8}\xe1,\x0fإ\x1a\x1c/Ż\x93a>;\xaf\x8d\xd2Q\xeb\xe5p\x98\xf7\xd9r\xf1j>\x9d\x8a-\xfdp\x19Ő\x13\xc3\n\xd6[\xce\xd2B\xad \x12\x9bu`\u007f\x93\xc6\x13\n\xeaT\xb0\x16\xbe\x83{\x849%o\x01\xf4bɁ}\x01\xa8E}\x8d\xf4,\xbeM\xc7\xd1`\x9a\x8f\xa2bY\x88Uk\x8b\x16^cϋ&\xfc\xfe\x1a\"'\xb1\xf6d^\x14\vрy\xd5\xf0\x81\xb3j/\xd4\x00\xca?\xc5H\x00y\xf1}\x0e\xbe\x04\xde\xdc\bf\x9f\xc4B\x95\x17\u007f\xf31\x90\xfd.ΆqO\x88\bxR\x98\xf6\xe6\xd7\xd1H\fsK0\x13\xd6\x13\xf5\xfb\xd3l2\xe3\x12䝨D\xa9\xb5\xa8\x98\xf6\xb9\x14\x1a\xcd\x17\xadwR\xf6\b\x10XhS\x18\xc9^\xeb\xae\xfb\xc1P,y\xf8\xa1\xc0Բ\xee\xc3)Y\xff\xdf\x15\xff\xf5g\xe9\xff\xfb\a{]w\xfd\xef\x1e\xfcg\xff\xff\x87\xfc\x0f,\xa9\xaf~\xf8\xdb\xf7o\xa2\xf3\bֽ\xb3\xe8\xd93\xb1\x16\xb5#u\xd15\x1f\xe0\xfc\xff\x04\n~\xfb\xfd\x9b\xaf~\xfa\xf9\xe5w\x10\x83\x00W\x96OX\x94\xb2xrs\x91B\xf0\xb9z:L\xc1]\x81c\x8c\x94\xa9`\xdc}9\x9bM\xb3\x9eX \xea\x9fi\xd9\xf2Y\xa3UL\x86٬\xfeY\xf3\xb3\xc6\xd9'\x0f\f6x\x8e\x12\xeb\xaeX\x11\xff\n\xad\x14u\xcb=#\x81ų\x91\xbc?WM|%\x93\xbfX\xbe\x02Y\x03V\x82\xfag(\xcf>k躈4wΈ\xee\xbb\xe2a:\xd3w\xae\u007fR\xb3\xf2U>\x14\xd3\xe4Gʬs\xff\xf9xQL\xb9k\x87\x87\xf2\n%\x1d\x1a8\xcblO\x82\x847x\x1e\x94%/3\xee\xe7\n\xb2q\x95g]\xa2\xa8\u007f\xb2W\x82l\xd9\xddg\xec^>\x94\x86;\x16\x8c\xb0(b?kF\x9fA\x1e/\x9b\x0e\xc1\xbd\x84\xd0\xdd\xc8'\x1cd7\xec\xb6媾v\xebT\xbe\xac}ʭ\xc0\x80\n88\x14\xa9\fjx\xe9\x10\x06\x03\x1dZ\xcc\xd6p\xa24\xa3wG\x19\xcd\xe0\x1d\xb8r4\x03\xf1\xceq\xe9H\x1e:0\xd8\"\x85\f\x19\xc7ૣ\xb8|\xf7\ue287\x9c\xb4=~]\xbb\xbc\x81lY\xb7\xc1ʡ=\x95\xd4iZ\x99R\xe5\x12\x84\xa0{/\u007f\x87o\xbb\x88\xd2Eu\x99o0\xc1.$Vu\xa1\xe7\xa4B;\xfb\f<\xb3\u007ff\xe7\x16\xb3i~+\xba\x03gq\xcd@\x9fO\xed^\x10A[_g\x8b4\xf9r>\x8d\xe9ABJ\xf1$\xe5\xachz\xee\xb5#\xbc\xc2\xfc\xad\xbcht\xaaeD\xd3+7\x8a\x17_\x8aَ\xbe\xebD\xdb(u\x9aAh_\x88Mȩy\xf2\xd0`כ\x9f\xa1\xe4\xb1\x1dt7\x82aB%ƭ>L\\\xee\x1e\xfd\xba\xa5\x86\\\xcft\xf9\xcb津\x9c\x83\xfeP\xb7^\xc2|\xfb\xd5\x19q\xe4\xca\xd3\xe8\xda\xee\x13\x9c\xee\xd0\xe9ɩ\x81\"hLa\xa3\xe9Ř<\x1e\xfb\xef\xd7_8\x04\xd1L\x04\xa8\x04{\x89H\xb6\x88\x0f\xacN\xa2h\x03\xb9\x02\xf3\x85{\xaa\x10\xac\xf4\xad{-,\xaa[^\xaf\x05\xb4\x9f\xd2\xdf\xe6i!\xe6\xfa\xb3\xb7פz\xbf\x95\xaa\xc2\xdbx\x92\x89y\r\xafL\xd8!\xde4\xfd͝X\xf2\xf5\n\xc6.\x81w.\xff\xab\xa0r\x02\xdbb\x92\x8f\x8b\xf4\x8dPb\x1c\x1f\x9ba\x81J\x9d)\x15\xa7\xd6܄\xa2JD8r\xd5+\xf7%a\xf7\xaf\x87p\x19-\x83\x02\x8b\x1b\x82n\xf8\xf5J$P\xca\x02\xba\x86\xc5\x10o\x18\xeenR\x15\x10Dg\xc1r\xba\x03\x97\xb78\xc4\xe9\xecK\xb1\xadK\x93\xffN\xf1\x16\x9fؚ\xde\x06\xd0{\xf0R\xc4*/6kik\x98_\xd75\xcc@M\xccS3'N\x12(\xb6F\x05\x9f3\x1d\xef\xa8b\x85x'\x18\xe33s\xdbXI\x10G\v\b\xf6\x90)\x02\xb7)^\x88\xbfUjD\xeb\xb3\xcaE\x1a\x8a\x97r\x94d\xdb\x04\xc9+\n\xc2`_\x05\x02\xab\xa0f$\x90\xf45\x94\xb3O\xfeg\xe8\u007f\xb4\xff\xf9\xb8m\xac\xd2\xff\xbbݶ\xa3\xff\xb7\xf7\x8e\xba\xff\xd1\xff\xff(\xfd\xff\xaf?|\xff\xe5\x0f\u07fd|\xfb\xe5W?\xbf\xbd\x10S\ufadf\xde^\xbcy\xf9\xe6o\x17b\n0\xf9\x9f\xa4wo\xc9\xfc\xf2\x96\xcc/\x9f\x89\r\x00\xd4\xff\xe5\xf5w\xdf\xccf\x93\xaf\xe3\xbeX\x1e\xa4\xd6f?҅\xe5\x85=\x91\x92\x15\xd4\x1aӐ\xcf\xdbˊ\xbf\xec\x83\xc9\xee\x172\x95\xd4k\xaf\x8b\xc5h\xd8m\x01\x907o~\xacmS{o\xeb\xdaY\u007f\x9a\x17\xf9\xe0\xffc\xef\xdf\xfb۶\x91\xc5q\xf8\xfc\x9dW\xc1\xb0][\x8c(Y\x92-ۑ\xc2\xe4\x9bK/9\xa7M\xf24\xe9n\xfbq\xbd\xf9P\x12e\xb3\x91E\xad(\xc5V%\xedk\u007f\xe6\x02\x80\x00HJ\xb2\x9b^v\u007f\xed\x9e\x13\x8b\xb8\f\x80\xc1`03\x18\ff\x1a\x80{\xe7\xba\"Ģ\xb2\xc8\x15\xd5t6\x05\xad_\xc2؍\x80\xd4\xc5\x1c\xcaFk)\xb72\xaf\xfa\xd2EqՈ\r\x049Y\xa5P\xb2퇳\xfe\xa5S\x89\xec\x8d\xc9~IK\xafӃ\xc1~(\xe0\x8b\xa2}\x93\x81\xebb\xc5|:\xa2\x1bW\xc0\xc4\xfb\xe1h\xd4\v\xfb\x06/\a)\x01ߩ)Be\x16\xf4\xf8\xbe%s\xe8\xf1˳[\xd5W\xe4\xd2\"\x989\xc5\xdcx\xfd\xf6\x1dE\xdd\xf8\xea\x8bw\xae\xf4h\xffW=\x01\x85\xa4\u0085}\x87\xba\x87b\xb3\xd6\x1c\xd67\x9b\xfb\x17\xaa<bD_\x93ű\xb2\xff\x1c\xb0\x05\xe2A\r\xcd4\xa0\xc9b \xbf\x98O\x91\x0fnj\xd7\xd7\xd75\x14\xf5j\x00?\x1a\xa3ur \xb4\xd1u֏1\x8cz\xb0\xc0\x15\x16\x81\xbe\x8a\xa7\u0601S,\xa7Q\x94k\x92\xa7\xa0\x02Z\xe5\xf1\x9dh\xe7(7\x81\x02\xc1$\xa3ٳ\xb8\xbeW\x02\xe9\xbe\x05\x89\x87;\x1e0\x1e$\x96\xf5\xf9̈́<\xb4N\xe9\xcfr\xfe\xef\xdb\u05ef\xd0;\x0e\xff\xf2\x9d\xe7\x82\xe0\xfdY&\xd7/ \xab\be\xd6\xfd\xca>ޛ\x84\"\xf0g߳\x8d\x05\x80q>\xa0\xa8\u0604\xa5\xd3_)˳\x05[ǖl\x99<\xf1\x8cώY\x9e\xa1\x91\xd9#MF\xa3|:\xb0!o\xe3s\x83\xb25z\x1dog\x19Z\x9b\xeft\x92\x9fp\v_\x93d4\xfa\x8e\xc6c\xb0)\xf4\xb5\x98\x897\x8f#\xf1\xa4P\x86ZM\xecO'6I\u07b7\x13\xf1\xbf\x83\x03\xe7i\x9aί\"gv\x19!$\xe72L%x2\x17\xa71\xbe\x87\x8e\xb9S\x9e$\xbb>(c\xbd\b\xdd?`\t]\x87SX<\xce,\x91\xe0|\xfa\x01\xebe0\x8a\xa6\xc4P1z\xa6\rb\x12\xce.\x9dA\x02[\xd48\x999\x11\xa8N\xb3\xbaQf$\\>\xea<\xc5\x15\x8d\a\x94\xce\x11\xa1\n&\b\x87]\xa7\x8f\xae\xa5\x86\xf3 E\t\x03\xa5\xf9G\"\x8a\xd43c\xd5\xef0\v\xc6LP\xf0|\xee#,\xc1\xac\x93\xb8\xc2\xe9\a=\x04Q\x91\xbd\x94eʾ\xef\ar@^\x91\n\xb3\x05\x81\x96\xf0\x9f\t\xfdm\x8a\x9d\xb3\xbe\xf7\x97G\xde\xef\xfb\xdf$\x1c\xc7\xfd\xdf\xd6\xfdg\x9b\xfc\xdf<j\x1f\xe5\xec\xff\xcd\xe6_\xf2\xff\u007f\x96\xff\xcf\x1b\xa4\xa4[\xb8\xff\xc8\xf2\xec\xb4\xf3v\xde#H\xe8\xee'N\x00\x95\xfb\x8d\xfadз\xf7\v\x02^\xa8;\xf3\xed\xe4\x17$<\xfe\xb4j\xbf\x8b\x8b\x9f\xc0\x06\xbd5^\xd6S\xf3l\xfc\xf01\x95V\a\xadw\xf2_\xe2f\x85tT\xdap<\x1e&\xaaUQ\xf8W\xb4\xbb\xe5\xa4\xf7\"\x1aG\xd3p\n{\x8ey\xd2\xeb\xfc\xe32\x1a;\xd3\xf9\x18\x91\x80y\x93i2\x98\xb34\x83\x05|\xe9/\nu\x81t'\t\xed[RT\x18\\\xc5c\xd8\xf6\xa7!\xe8CNt\x15ƣ\xec\xe8\xf8?ّ\xeb\xae\xfc\u007f\x9a\f\xe3Q\x84\x0e8\u007f\x18\xff/\xf0\xff\x02\xb9\xf9/\xfe\xff{\xfc\xf7\x99\xb0\xee\xd4\x04!\xd4z\xf3\xd9\f\x16\x92\xb8\xbb\x1d\xa7\xb0\x1a\x16\xb0-\x80\\\xf7AsO\xba\x16\xa7S\xbdd4\x90NE \x91Ok\xd3p\x10\xcfa\x1fiIo&\xd4D.\xa6\xc9|<\x80}\xe4\xa2\x17\xe2Mr\xf1\u007f\xf5\xb6'\x1f\xdc\x1d%ӎ\xf3Y\x14E\xb6\xc3\xd6\x10O\xa34?\"\xe5%5\xe5\x0el\xf2\x9a\xc23\xa0\x01:\x8ck\xc5Po\xac\x85\xa3\xf8\x02`\xe3\x93\xcbѴ\xc0e\n\x8f\xb6AG\x92\xb8y/p\xf3\x1e\xf9\x1f\xc5ǲ\xd13N\xc6ц*\xf1p\x1a^Eﯧx\xec)ݪvA\x8c٫\xe66\xe4䝬r\xce^\x02m\xa6\xeb\x17@\x17\x1ep\xc9\xc7h:\x1c%\xd7\x1d'\x9c\xcf\x123\xad\x06\x03M\xfb\xd3DZ\xad\xcaҁ8z\x1f\xe2Y\rx\xecX\xf64\x99\x84\xfdx\xb6\x80\xa1\xb5R'\x02\x19\x03\x06&J_\xa5;\x96\xdc^j\xcb\x04\bė\xa2J`\xe34\xf3\xfe\xbb\noj\"\xf5\xa1D\x91\xe1y\x98aɢ\u007fEpEKh3\x86\xda;c\xa8\xbd\x13\x86\xda\x06\x86J\xf8\xff\x1f\xe8\xff\xdb89\xc9\xf1\xff\xf6\xf1_\xfe\xbf\xbf\x9b\xfc\xef\f\x92\xf1\xfe̙\xa7Q&\xfe\x90\x1fY\xea;i\xe2\\G\xe8Àv\xe0\x01\xd1\x16\x85xL\xae\xe2\x19\nTW\x0e)\x04\xb7\xf5\x01V\x87\n\x14\xa1\x04@\xbe\x17\x8ek\x9a<\xa2\x1c\x82wr|\xdb\n\xf1\xb6.u(\xb2\x02\xdcϑ\x818\x9d k@\xe7,\xce\xe7\u007f\xc7\x18\"\xcfg7,4\xa3\xe9\xec\xfd\xfb⢁\xb3\xaf\xe0\xad\x1c<\xf4ċI\xfb\xdd{Z\x8fB\x81\xa2\xac\x1f\x9d\f\xdc\xdb\xcb\xe4\xfa\rC|\t@*\x9e\xebă\xc0-\xde\xc1\xddǲ\xb1\xfa\x17\xa3p\x92F(r;ULxt\x10*?\xc0\f;\u007f\x98\xfe\xdfj\x1f\x1e\xe7\xf4\xffÿ\xf4\xff\xdf\xe5?e\a\xde@f\x9a%?+\xf5u<\x88\x8cRۏb\xa4\xfc\xb3\x93c\x99(\\'vR\x97\xbbY\xa0\xf6j\x91\x1d\xa3]u\u007f\a\xa9k_\x8b\xea\xc6\xd2@y7\xb8\xc0\xbe:\xfc\xc1\xaf\xb2\x8e\x88\\\xde\xfes\xc9\xf4\xef\xb3⼭]\xdf7\x8a\x8f\xb9Ϫr6\x1e\x92H\xcbG\x83ٚ/\xc4զ\x86\xa9\xacV4d\xe2\xc0s`\xd2\xc0\x0fDi\xbd\x8c:a\xdbǣ5=\aV\xd6E4\xcb:\x8dCЃ\xeb\xe15\xad\r\xb3\x80\xf9j\x12\xf0\xa3.\u07b7\xdaG^\xbd\xafg\b\xe4\x90;\xa9\x91Ao\xd5AN\tSև\xaa\xb9$R]\x8b\x10\x8d|\x1a\x0f\x1fݩ\xee\xf7\x92\xc1\xc2(\x85`e0\xad\xd2B\x02\xbcIk\xc9X\x1e0\x15\x9d\x00\x18\x04\xc9~\x82\x19\x8e\xfb|\x00\xf9\x0fP\x1c\x92\xeb\xba\xd9.K\xe9\xecH\x88\x87g\x93\x9b\xfdn1Ԍ̛\xfa*&D\xa5\xf3\x1el\xfe\x15\x9d\xa2&!\xde/\xc0\xa7H\xeb\xd3\xe8\n\x14\x83\x1c\x02ʖs\xd3<\x8d\xda\xc0_\f\x9f\xdfbo\xdfg\x8b\x97\x83\xcaN\xcc@;\u05cdF:n\xa3Q\xd9`\fGS+ʉuN)\x8e\x05\xff\x13,F:\x92~+%`\xcb\xfe\xdfn7l\xf9\xbf\xd5>\xfe\xeb\xfe\xef\xef\xf2ߣ\xfb/^?\u007f\xf7\xe3\x9b/\x1c\x9c|\x90@Ʉ>\n\xc7\x17\x81\x1b\x8d\x85\xb17\n\a\xc20\x0e\xbc>tЍ\x1eD\xeb\xc0\x9dφ\xb5SW\xcfB\xaf\x8fZ\xf4\xafy\xfc1p\u007f\xa8}\xff\xb4\xf6<\xb9\x9a\x84\xb3\xb8\x87g\x04\x825\x05\xee\xcb/\x02|/ߨ\x89\x1c<p?\xc6\xd15\x1al\xb5¤\u007f\a\x83\xe8c\u070fX\x19\xf7區\x1a\x85'\t\x9a\xf5F\x01\xa8A\xc4\x024\xb0\x15\rZAA\xd0\xe1/\x93iA\x99b\x9d\x86\x05\xf4\x83\x83q4\x1b\x8cC`\xac\xc9\f\xcdɓ\xfe`\f\xcc\xf7\xea@%\x1c\x1c֛\xf5&*>Y\x9a\xba\xec(\x9a\xa03\x8f\xc7\xcc\xe7К\x8d\xac\x0e]\x81\xa1ӏ\x0e8\x93\vnUU\f'\xa6Yrq1\x8a\xde\xe15)\x8b\xbfQhk\xba>\x15X\xbcN\xfb9\x06\xf8oa\xce\xf0m7\xed\xb7\xe9\xe8@P\xea}y\xbb\xa2N\x96\xaa\xd7\xc3\xca~\x1f\xf6\x18R8\xf6饛\x86yLlU\xc3'\xf3,@\xd3\bT\xd0~T\xd9w2H\xbe\xb3\xbfo\x1c#C\xdf\xe3\xf18\x9a~\xfd\xee[\xbc\x8e\xb2\xbf\xf7\xd9\xc3\xe3v\xa3\xbb\xbf\xf1\xac\xden\xbb\n\x15\xb5F\xb6\xc1?6\xe1\x1b\x8f\x19d:\x1cOW\xd9%V\xfc\xaf.56R\x05\xb3\x0e^\x83\x9cX\xc3\x1d\xaaÎU5L\xc8\x1aԌQ\x99\xc1.\xeb\xc7lZ\n\xb7\xbc\xd2d\x1a\x95ֺ\x8cgQ-\x85\x9d:\xea`9\xea\x99]_U\x8e>\xa2\xd7\xd3(Ng\x1a\b\xdd\xc4\xc9&R\xab6\xd7\xe2\x83?}\xa2Jl̂\x84\xa3)\u07bd\x1dI3\xee,\x99\xe8\xd9\xc2\xe6[\x13\x96Φ2\xe6\x99\xd9d\x05|Xog\xb9Y\xaf\x04A80\xf8\xb1\xd1/e̋\xc7\x18D\xa9\xa6\xd9\xf4v\x85\xbe\xd9\xe0\x9e\xb3*;\xf7\xe3+d\x88\xe1x\x96맢\\\xad\xb2\xbc6\xd2\xe8\xe6\xe1Y\xbd\xcdl\xbd\x97\xf1`\x10\x8ds\x04ElBw3̬\x8b\xe1hD\x96E\xbbN\b\xab+1V\x9df\x97?l\xe9S\xc1vԢiR+\x8ao\a\xe3O\xb9\x05=B9V\xac\xb1P\x9eNN\xe6\xa3\x11\xc3q\xa8u7oDa\xe6V\xdf ^\xba\x8f\xf7\xf0\xeaI\xda%\xeb\b5\xa0\xdfT\x85Q\x840\xe3S\xb5\x88\xf5\\<\xb6\xac\xf1\x19\xa9\xca\x17\x87\xdcE\x9c]\x1epϒY8\xe2\xa8\x17\xcaB#\xcf\"\xe5!7cBܝ\x15\a\xc8\"\xb6ͻ\x186\x93\x8b4;\u007f\xe6\x1b\xce\bO\x04\xc5\xd1N\xd2'\xd3x<\x1b:\xee\xdf\x06\x0e-\xba\xd4u*\xa3h\fMӗ\x87\xa5kԗw\xd4+\xac,\x8dQ\x9f\xb9N2\xee\x8f\xe2\xfe\a`f\xda\u0382\x1eV^\xd7\x14z\x01\x8d̉\x11\x8d\xda`Z\x1a^\x98\xb0\x04\xee\xf8\x83\xfe\xad\xe1\xdb&\x93h\xa0\xe1P\x1f/\xf7T\x1f.C\x9b>\xb6\xbc\x90\x1e\xcd\x06\x12\xba\\\xca\xee\xe3G\xb8\x98\x1f琍\x89\xb0\xd3\x0e6\xc0\xd0\xf9\xa3\xfb8\xe7\xf1d\x0e'\xcf\x0f\v\xaa\xe8\xa3\xc2\xd3\xefܠ6\f\xaeh\x90\x19\x17%\xab\x1fP\x06{R\x14\rL\xab\xff\x18\xcf\xec\x8bG\tP\xf0\x95[\xa7B\x97)\x00\"\xba[\xba\xbe\xeb9\xf8\xc81\x81\xa6\xf3\xfe\xd2\x06 gZ2p\xe5\xff\x90\xab\x81\x88\xccMD\xae\t\x1bt\x1e\xa4\x05\xca.\x80\xab$\x99]\xe2\xb3g\x9a\xab\xc9w\xe8\f@.\x05\xc6*\x14\x84\x9b\xdd_?`\x16\x04Y$0\xff\x87\x9f\xffk^\x0e\u007f\x94\xfd\x17Խ\x9c\xfd\xf7\xf0\xaf\xf3\x9f\xdfI\xff\xdb\xe5\f$s\x8a\x91\xe1\x12꼚t\xe7a\xfd\x1c\xe3/\xbf\xca\xff\x98\xf5oE\xe1\xf9\xfd\xd7?\xac\xfe\x86\x1d\xff\xa1ux\xd8\xfek\xfd\xff\x1e\xff\xd5%\x01\xbc盳\xf5A\x04\x82\xeeh9I\xe8\x1c\x95嘴Cڛr<Q\xf1}P\xcdit\xa5\x97J\xab\xab)|¥\x05\xffWoz]\xe1\x86\xd2\xe8\xb22\xda\x04i_\xd3'\xa4=\xb8\xdej\xa7\x0e\x87\xa7\xed֮\x92_j\xdb\xca$[K\xe4]-\n\x8a\xadK\xd0P\x8f\xc7!]\x00[\x8aJ\x9dFYQ\xa7\x0e\xea2\x1f\x98hś\xe5\xc5oޏ\xc2^4Z\xea\x11\xa68\xbe\x94\x16S\xca\xf4*9\x04\xacI\x97\xa6c\xf8-[\xa9\xb7EAī\x03s\x13\x0f\x9cϢ\x06\xfe\xaf\x9b\xe9_\xe4\x16\x95\x9fCm\xce>\x1b\x0e\x87]]\xef\x1f'\xa8\xf4o\x1e\xf22\x0fR#\x88\xd2\xfe\x03\xf6\xa3+\xbbw\x1bq\xa1\xa6\xa0\x8c̎\xbc.{\x92\xd18r\x18\xb1\x8a\n\xfd\x93<\x80\x9a\xd0\x15\xf1\x8d$]\xc3\xef\xdb\xe2!7\xf5e\xdd<\xf56\x03\xea\xf4\"P\x15\xa3\xa5\xb0GvܟZ\xed~\xcb-\x98:\xea{\xad\x8e\x88\xe4\x81\xeb͜xb\xadm \xd9A2[r\xa1#\xc0\xbe\xb0\x1d\xe0O\x1d95-\x81\xb0\x83\xd3hNl\xbb\x98\xb2\x92\x9b\x1a\xb4:H\xae;\x18\xe1\f&ؘ\x83c/G{\x02*w\xa9\x955C\x96\x80\x0eMcW\x9a3\x88%e\x00j\xa0\xa0N:\xd2\xfe\x02Mo\x1c\xb5\x9c,\t\x8cL#v\x8d\x8c\xb2eظ\x1c\xcc\xf4\xe3\x85\t\xa3\xab\xac*lT\xc9\xf7\xe2\xe6=\x86\x84X\x961S\x8bQ6$\n\x98N\x01\x85\x03\xba\x04o`\xb2\xe5u\v\xf8uY\xd3N\x9dTł\x1e\xdce5\x02\xefɯ\x14\x83~\x98\\hT\xd0\u007f\xadW\xe1x\x9c\xcc\xc8\"B\xc1Ƒ\x15/\x05\r6\xb3\xb9G\xb4\xe4ٚF\x8fd:\xda4U\x05\xed8\xf5,\xb1\x00\x11\xa2\x17\xc8cy\x1a\x8e\xed5\x81\xf8ٰ\x18t\xba̭\xccV\xbb\x80\x03h\x9d$Dl\xa1\x8fZ\xd67\x93DZ\x85\xfc\xee\xd03\xd6\xcd\xd6\xe6wZ\x1fF-2g,\xcb\x05\x00c\xd9\xee0\xb8\xed\x8dݱ\x8f\xa2\x1aE\xd5\xe9O\xa3h\xbc,\xc1\xcc\x16\xb2q\xe4Q\xfb\xd2\xe6bb\x13B7b\xb5J\x1e\xaa\x8d\x8f\xc8c3g<\xf5\x8a6N\x9b³m6\xbf\xcbgB\x82s\xca\xffO\x18n\xe2\x0f&\xe0fS\x11P\xf3\x18W\x901A\xfd\xf94\x851\b\xaer\a\xa4\x14mb\xbd\xc2M\x8c7\\썶y\x93\x9b\xb6\xc2P\r\xd7\u007f3\x87\xa4ݻ%\xe6\xdc߹B\xe7\x12\xf9\xf8r\xe32\x86Y\x12h\xba#\xe1\x88F\xe44\xb6\x1b\xb7\x1ePF\x83e\x8b`\xa7q\x96\x80\xe9\x16\xf6L,\xab\xc5{\x8c<\xe4\x17\xec1\x98\xfe~p\xb8\x1cƣQ\t\xb7Y\xd0F\x94:\xf4\xf6\x85_\xb2O!\x10.\xb1\x14\x91\xac\f\x96\x02\xe2\x03'k\xe2\x02\x00\x99D5\x8e9\x83\xf4ߟ\xc2xjx֜\xee\xb6C.\xe0\a\xb0\xce\xd2~an\xd6-\x89\x9fZ\xb3[\xd0Ö\xd5A$q\x910\b\x01\xect\n\x88n:\xcd\xd2^\xe0m\xe5M\x9d\xc0\xfc\f˲\v\x9b1^\x06S!\xbc\x18(\x94[\x94\x03\xc5պ\x11(\x16Xj\x12CN)\xd9Yx\xa9_\x80l%$\x18\xbf\xacC\\\x88\x1a\xa5\x91\x10+ָ\xb2\xce]\x14oi0wi\xb5۾\xfc\u007fط|̪m\xc9ߐ\xdd\u061c\xb9\rr\xa3,\xb3\xb6=w\x03\xecR\xec\xc6c`\ai\xb4\x15\xc1\xb2\x9c\x86cm\xe7\xb39\xb8\x8dc\xc5@s\xd85s\n3\x1ae\xc9\xe5p\x1a\xf9\xe4ڦ\xf4BH\x1a\xceF\xd1\x05\xb0\x98\xbc\xe6nӵ\xae\x8dj2\xc2Q\x03\xff\xa76\\\xe3,Y\xee\xdcX\xdfi\xe7d\xcb\xd6\x16Q\x97{fm,\xcdF\xae\x84SO\xaf1ދ\xd0\xfeH\x84\x96\xa2wC\xb5Z\xa2<\xb7\xbc\x02x$\xb4r\xa8h\x8a\x14Mc\x92 1¶y.\x8f\xe1\xaeK\xa0\xa8\xbe\x15\"\xc886>,BP\x11X\xb2\xb4\x88\x9b;\x8d\"\x85\xc5lK\x9b\xc8x|\t{\xc9,/ѓE\x89ϕ\xc5Dˢ\xba\xf7\xc2\x11\xb0\x1b\x1d\x15c<\x04\x1e\xa9i6y\x91\xc5\xf8d\xef\xd9\tWL\xaa\xe2\xa2ǥ%\x97\x06\x8e\xea-\xd3\xce\xd2\xd0\rG-K\xd2\xd3\v\x1e\x95\xceP\x1d\xb0\x85\al\x83\xac7G\xf9\xa2\xf3\xd1\x12\xcf?Yy\xa7\x808\xbc\xa5\xa8iȐ \x92h͔I\x9e\x02\xea(^\xaaz\x8e\x10\x9e\xbbW0Z\xa6\xe4S\x1c\xdeFӍ\x82\x93\x93\xf1:yV\xd9(\x12\xc5\v\x81\ted#\xb4V\x11\xb0?\x91\xfd\xff7\xba\x04\xb2\xd1\xfe\xdf<>i\xb5\xed\xf8o\xad\xe6\xc9\xe1_\xf6\xff\xdf\xe3?\xf4\a\x94aI\x03\n\u007f\xcb\xcbF9\x9e\xab$?\xe9\xfd\xec-9B\xf2t\x96\x06*C\x04\\t\xeb\xae\xd7\x15\xd9\xe8F)\xa1\x02K\x99rp\xb3\xa0\xe9s\b\xb3\x80 \xc8xf\xf1#\xf9\xa3Z\xe5\x06D\xbc\xfa7P\x8a\x8b\x9e\xc5\xe7]\x06{\xa6\xe5\x9d\a\x05i\xab\xd5r-\xca\x16e\xaf\x85\xb7\ng\xad}\x8c\xee\x98\rV\r\x11\x93\x83\xb3s\xd5w\xf8\xc6H\x03\x98O\x81#)Z0\xfc\xf2\xba\x02\x1e\xa6\xae}\x0e\xa3\x91\xc1\x1bD\xe9,\x1e\x93\xa6\xe5\xa7\xc9|ڏ\xbc\xa5\x049\x99&\x93h:#\xb82O+\u007f&\xf3\xcf\x03\xce\xcd\x12\xe4\x18\xb4\xd2k\xbf?\x02\xdej\x8d$\x1fY\x8b~\xa2\x9b\xcd\xf8\"\x1e.\xa8\x94\xb7^w\xe3a\x05\xf9s2\xc4\x00\n\xf3Qt?\b\\`a\x14\xe2c\xe0\xee\xedqj=\xbaAW\xb4\x94Q48\f0PS<\x8d*\xee\xe0\x10\xe6\xde,\xa4\xe6\u007f\x9d\xc5)\x02\xb1\xbc\x17\x8e(D\xda͌\xa1\xbc\x9f%o\xa9;\x01\ax\xab\xc3(g\tv\xa6.s\xfcW\xdf\u007f\xf3\xcd{\xf4Q\x0e\xdcW\xf3\xd1\xc8\xf5\xbf\u007f\xf5\xe2\x8b/_\xbe\xfa\xe2\x85H\xfd^u\xd5\u007f\xf6\xfa\xf57_<}%2\x9e%\tH$c\x17@|\xfb\xec\x8b\xef\x14\x10\f\xba\xeb\xfao\xdf}\xf7\xf2\xd5W\"\x91\xdbr\xfd\xd7\xcf\xfe\xf7\x8b\xe7\xefD\"\xf7\xc9\xf5\xbf\xfc\xfe\xd5\xf3w/_\xbfz\xff\xfc\x9b\xa7o\xdf\x06\xee\x19\xbf\xcf\xe0|)Fv\xeev\xb3h\xe5\xe9\x97\xda$@)5\x0fj\xacu\f\xd1%s\x83 0\xc1\xaf\x15(&\xa7\xdbR\x11L&\xff\xaa_\x86\xe9\xeb\xeb\xf1\x1bQ\xa4\"\xcbz\xdent\xd6-\xa03\xd57$x5@h\xf2\x1dL\x99\xfc\x06\xf2\xd1\xd0\xe8-g\x97S\xd0\xc70\x9c\"\x96\xa2x-k\x11\xd9l>\x9a\x19\vM\x1f\x8f\x06\x9d\u007f\x96\x0fh)@\xf1\xc2T\xe9k\xb9TDv\xd6}\uebb7L\xafc\x105\xf1W?\x04}\x06c\xb2uD\x1dEv]\xca\xfa\x98\x80\x18ܐ\x99&\t\xd2hȅB,\xa3\xa4+\x00\xe37\xc3v{\x82\x18%\b\x9dT\xa9\tw̄\x99u@\x11-\xe7\xf3\xc2U\xf9\x1a\xfd\xcaqjh_k\x14\xa9\x16\x88M\x92\xb2\xbb\x94\x1a\x18\xab\x9eƔ\x8e\xe2~\x14<ES\x85\xb62)5\xa3x\x10\xde\xe8&\x0ez\x1d\xa6\x95\xe1\x98W6\xed\x0f\xc1p\xac\xd6q\x05_8E\x9c\x1c\xfc\xf3\xec\xa7\xf4\xa7\xca\xf9\x03\t\xe1\xec\x9f\xf0\xf1S\xa5r\xf6O\xef\xfc\x81\xf7\x93w\xe0\x9d5ϕ\x1f\xf8\xc1O\xf0\xbf\xfa\x83'g?M\u007f\x1a\x9f\xaf\xe0\xebA\xe5I\xa7\xbe\xe2o\xef\xc1\x93\x9f\x1e\xfctppỮ\xa7\xd5I\xab\"I\xecN\xbe\xabx5\xf5L\xec>A\xd0\xdcۻO)g\x8d\xf3'g\xe7\x1d\xfa\x9d!\x0f\xa5G\x18\x93/\xefi1\xd7zϗ\xde`x\x12hvS\x8bJ\x84\xc1|2\xc0\xe0\xe5g=P\xc2*\xb2\x82O>\x93\xe7\xbeDY\xaa:\xa5]3\x1b-ȵ\xd2\x0f\x81~U?\x048\xb2\x1aau\xc1\x85\xe9\xfb\x1b\x1e\n\xfd\x16\xe3\x92;-\x96\x94\x1b-\x88ţ\xa8\xc2\x1f\xb5\x9aG\xc5\xcf4\x00U\xce:\xa7Jg\xe2C\xf6\x8f\xcaeݹ\x8a\xa6\x17fo\xe8w@\xa4\xc1\xfc\x8d3\x1bj\x84\xf9\x11d\xe0\bI\x80\xe5\xbe\xdc\x1b`\xcd+\x1c\x89\xfe?j\xed\xed餬\xf2a\xe2<O\x124 \xaekM\x115f\xf6L\xd4\xf4[^\xe9\xfc\xc9\x11^\xa4\x05\xb3%\xa1\x8b\xe9\x12ݦ\x19\xa3\vjW\x93\xd9Bn\x03\x81\x06zM}\xa3')\x02\xabŗo\u07ffx\xfd\xea\xdd\x17\xb0\xea\xdf?\xfb\xfe\xab\xaf~\xd4\v(\xf6\b|q)\x17T\xa7\xb9&DMp\xe1\xcaD\xd7\xd3\xfdq\xd7\xdaE\xb5u\xc5\xcb\xd6l:\xef\x91\xe3'\xf6Ɋ][QR\x1e\xcaP\x14\x0eS0\xd48B^\xcd\xcb_\x8c\\C\r\xf4D\xdb\xfb\xb2*4=R\"S\xa9\xf5\xf42\x1e\xce\xf4.}\x10\xfd\xc1)\xacg\x11\xb5\x8d\x15\xa1Z[\x8b\xbd\x91*\xf9\x84кx\xbd\xd8\xebRb=\x9dCS\xec\xdd*\xd4t\x99\xceC\xa7\xc1`\xaf9\x17\xf6\x02\x91\x91q:Q1K\x10 \xb2\x02\xb8\xa9\xc9z\xdd\xd9t\xb1\x145\xb2F\x84\xac\x88\x1fޚ\x82\xecV`G\x80\x95\xad\xc4\xe2\x86\x12\x8b3\xec\x14\xc9\xc6\xdc6(\xbfb\xa0:\x8e1\x18=\f\xe5\xbe\xd5?\r\x8f^yV`Ы=F<\x1c\x80\x9dg\x8e\x11\x84\x03\xcaSB/~d\xe4\xa3\xf5L\x8a)\xb4\x94\xc6}\x10 \xa0.Ml6+{{VB֤Np$h\bx8\xc4\xdc:\xa15 $\x1e\xb9\n\ue5cb\x93\x9e\x86e\x9a\x1am\xe9t3Htq\xf8\xf5\xb0\x00\x90\xc8\xc9\xc3\x11\x19\xaew\x87\xb9]\xea\xc2O`L\xacOp\xf3\xd2\x19\xb2H\x81Zd\x8cj\xe5Qqoo\xcfܖ9\x15\xd6\"\xf0\x8a\xcf\t\xe7.7*\xd8$\xe5w\xb9)\xde\xf3$\xbc+%-h\fI\xee\n\xa2\x03gW\xe7%\xebt\x9dIc>7\xe5q+\x12\x8f\x01\xf1~\xc1NE\x9aUR\xe9\bzQ\xa5\x1e\x88\xb2k\xa2&5M\x9a\\K@\xd6\xda\x06!~/\x99\xdfu\xf8\x8f/h\xb7\xb3\xcc踓\xfd\\\xaf\x91\u007f\x02\xce\r%&S\x89\n\x93\xeb\xcc\xe9\xe9\xdf5^G\xb3\x8a\xe9\xd9k\xaf\"U&\xaf\xab^\nQZv\xc5՞\"\xc2;\x95\\\xf9\x1b\xbca\xa0\x95\xb7\xf3\xf4M$\xd3\xf2\xe4\xddi[ϻ_q\xfb\n*F\xdd+\xbe\x9a\uf1ae\a2wF\"xm\xd3[\xba\x18A\x06eTP\x99h\x9fS\xb0Pb\x0f4\xd0>M\x13\xa7\xaa\x19s}|[\xe3\xf9l\xfa\x06S\x02\x02Z\xc7\xfbw\xa2\xd9ՊRėw\xa6`\x9c\xa3i\x02ꉥ\xeaC\x17\xdeM㫀\xe9C+W\x87\x84\xab\xd5*O\xc6D:Jv\xfc'\b\x8f+\xf8\xff\xcfY\x82\\\x039O_\xf2\xedF\x16\x85u\x90\xe2ڣ\x06\x15}\xb8xi\x89\xe5όO,vd\r\xbc\xf4y\xdd㮉\xca\x0e\x96a\x8e\bK\x1e6t\x86\"\xfa\x17KE\xa6\xd6\\\xfb/^\u007f\xfb\xc5M6\xaf\xc4/\x81H\xd2\xf0\"\x12\x9b'\xddk\xa5\xed\x8a>1\fc@\xb5\xfa\x11݇=üs\xce\x145\x03\xf1w\xed\xf7/\xa3\xfe\x87wɇh\xfct<\xf8\n\xa3\x11\xc3\x00\xb3\xe6\xd4$\xfa3,\xc3T\x85\xbfP\bqu]\x8fZ\xac\xb8o\u007f|\xf5\xee\xe9\x0f\xef\xbf\xf8\xee;\x90\xc1\x9f\xe2k^\xb0\x1eA\x95J\x00?\xa3Qt\x11\x8e\x1c\xd6k\x9ck\x8c\b=\x89\xfa\xf10\x06j\xf4\xd6\x00\x19\xe4\xf8\x83\xfa\f#vsk\x05\xf0_\xbe\xfa\xfb\xd3o^\xbex\xff\xfc\xeb\xa7\xdf=}\xfe\x0e\x14&n\x8ag\xdf\x117\xb7\xf0IB\xd54^`\x0e\xfb3d\x81\xebL\xbc\x15S\xcc\"\xa2=̵_\xb0\xa4\x90^y\xa6\x91\xb0\xae\xa2\xc1s!W\b\ndPX*\xbbt\xea\xf9R\xf80\xeb<1?\x85\xdaBz\x8c\xd79;\xf7%-I\xa9\xa2\x84\x9c\x98\t\xe2v$\n\xa2d\xc0\xac\xf1=\v\xe0\xea=4\x9d5\x98}d\x82\xcd\xf4\xb6\xf5\xdaח2,N\x85\fm)\x804\x95\x15\xfb\n\xdfS\x9a\x06\xf9\xa5\x863\xa7j\xf3\x15\xb2u\x97\xa6R\aE6\x02-\xa1k\xb6O~\x92\x19\xf0\xd8X\xc80\xe2\xd5\n\xc5ֵ]K҂\xb6x\x98\x86\xe9O\x15\xe8WJ6\x85\x8b\x80\xf76\xae\x02\x9c\x13֢\xdd\x00l\x17\x1b`\x03=o\x85\x1b \\}\x1a9\xb9[8\x8383v\x178dņ^p\xe8\x15\\\xd3[;C\"=&\xde\xd7z\x85\x84\t\\\x92\xd2\xfd\xe6-z\xc6W\a?!~\x00\xdb\x02\b\ufb42\x8b\xe3\xf8ezA'\x84(Q\xb2\t୷\x8a\xeb\x00c\xe8\xb2\xd9\t\xf6\x95:o\x90\xd2\xec$l\xd4\xfa\xde\xf6\"J\xfb\xc1\xf2\"\x9au\xac\x05\xe0Gc\xd8@\xa7xX֡g\u0600\x04\x87\xf1\xc5<KY\x93\xd6P\xd8PE\xdf\x0f\x8d\x158\xf1s\xcd+\xdd\xe2\x86XrtSgk\x12\"\xac\xd5<:>m\x1d\xb6\xda-o\x99\xabXϺ\x18\xf0\x1d\xceOљ5M\x89\xa3p\xa8o\x99\xef\xdf3hF\xd1\xfb\xf7\xcc~$\xec\\v\xa5\xa41\xce\xf7Pp\xba\xa6h7\xa6%\xfb\xbb\x97\xcf\xff\xef\xed\xd7O\xff\xf1\xfe\xd5\xeb\xf7\xcf_\u007f\xfb\xe6黜\xb4\x93/\xb2Z\x95\xd66\xadc\xde\xd2z\x1d\xd2\x14\xbb\xd6\x1b\x058z$J\x97\xda(!#I6\xa8\xa0:G\xe6\x1b\xf9\x9e&m}\x16\x14\xe0\xa7\xd1\x0067g\x1a\r#\xd0<\xf1\t\x85\x04\xf7:Q\xc9\xe5\xf5)\xbe\x02\x1d\x1e\xe7\xf0\x8b^\x9c\xc1\xbf9\x1d\x06\x1a\x92qvI\a\xb1\x93d\xc4\x0ecn?\x9c\x0e\xe2q8r}~\x97\xb0\xe3\xfe\x12M\x13\u05ff\x8a\xc7\x1d\x85\x1f\xff*\xbcѾ&ӈ^\x0e\xea\xb0Q\"\x1b8)wF{\x1e>\x86\xf7E\b\x94\xac\xd0\xf1\x81\xd7\xfb\xd9\aa\x99\xfa\x00\xfcݨ\x03)k\xb6\xacqߙ\x1c\x82\xe5\x9a?\x05o\x12\xcfb\x90\xd6\xcfϻ\x8d\x86\xb4\xd3u-\x8b\x83=\x11\x94M\x92\x03\x80\x11\xcf\xc0i\xf8\xf2tD\nǼ\x02\xf6\x82\xcd\xc9B\xc3x\x048\xcdF\x98\xcaR\xf7Su\xb6\xbe\xf6\xd6\x12\xf0\xec-\xf4\xaa\"|5\xa8e\x8e\x83\"|\n(\x85\u007f\xaf=c\xbe\xebj\xb1\x10\xb3tMw\x1eW\x14\xfe\x18\xa7\xc1\xe0\x10\xda\x19\xe1\v;z}O\x84\xaa\xaa\xb8\xe9ǋ\x0e\xfc\xbf\v)\xb3ٴ\xc2QY\\_\xa0\x1b~\xcb\f\xee\x87\xc8\xe1\x0fOY\xf7)L\x17\b\xbb\xd6s\x8a߉g,\x99\xe6\xb1\xd0ju\xbf\xa4\x8c}\x06\x80\xa5=O=RD\xc7\nAI\xdd3,L\x8a\xf3\xfd)\xb40ʹE\xf3K\xbcZ\x97A\xa5\xb9\x9bF\x17\x80\xc8h*\xa1U\x90\x03L+K~\xf7\x11\x8b\xac=!jѻ@\xa2\x14͎|\xa8s\xb5rS\x8c\xd6\xee\xb2\x01T,\xb58\xed\xa3+\xc2w\xe8\x9e\\\x91\xd3n\x12\\FP\x82\xe8\bUl\x98\x8fS\xfa+sP\x8d\x13O\x17\x82\xac3C\x15\x1d\x19\x9a\xf9̧ǻ\x18\x97{\x1b_\x8cC|\x90\xb2\xdcj\"\xb4\xfb\x8c\xe2\x91\x15\xc9V\xf89\x95P\x18\x89;\x8e[\xb5\xe0\x92Q\x94\\:\xd2\xe7\xc9\x1cX\x8f\\\a\xf6R\x17\xe3\xaa\x18]\xe7NI\xd9_\xb6*HTk\x9dO1\xa8y\xd4\x1dp-\xe1[Ef5|vf\x9c\xd0kPX\xd2:\x10MI\xeb\xb0\xf1\xcap,@\xf4\x9eT\xc1\xd0m\x88\\\x990p\x13\xf0\xd7Y\xe3\xbc~C\xa4\xba\xd0R\x16\xda\xe6\x05\x12\x97<\rR[\xd2\"K\xdbۃ\x0f2\xcd\xcaN\xdd\xd0\x1b:\v'\xb3\x1c\xe1\xcb͌r'\xbdL棁\xd3\xc3\xf3-\xac\xcf؍\xc2\x01\x96q\xab\xb2ͪK@T\xc2\x02q\xc1\xfd\x13\xea\xc6\xe3\xe0\x90\xadm\xdc\xe9\x16\f\xe3\x91\xf8݄߫\x95\xf6\xf1H\x1b\xab\xca0\xa0\xd5\xecbE\x18\xe6\xfd\r\xf64\xe8}ʡ\xfc\x93\xb1s\xe3\x90Q'\xa5\x97~Dq~\xbb\x17&\x9f\xf6\\\x90@|\xa1]\xf0\"\x03`/\x12\xbc\xe2o\x1b\xde1'\xd0Y\xf8\x95n\xfdJ3\x06N0\xd6\xec\xe4\x00\xbb\x1d\xf2L\xf8C\x0fb\x99u\x06\xaa\u0380\xc6\x05|\x81+\x857T)\xbc\xd9Ri\xa0c\bks\xce\x194\x87;\xeb\xf9\xba\x80q\xe4\x86Ń\x15B\xb1`\x9e\x9cX\x11\x8c\x87zC1\xb4\xea|\x0f\xb2\xe2\xc9\x12\xfc\a\xa6\xa4N\x97&*g\r\x8dן\x8b\xfa\x8b\xad\xf5\x17\xaa\xbe\xb6\x1f\xf8\r\x05\x00Pp\x01\xfb\xee|\x10\x95\x83:\x93\xb0\x00\x975\xed\xb7\xaf~7\x8d\xf4s\xbb\xcf\xdc깤\x04F\x86\x8d.b\xcb\x11=k*\x88\x01\x13\xe8\x95\xd3b6\xdd51\xab\x1e:-\x129\xf2\x8cN=N\xb6T\xcfuyj\xc7\xe7\xda\xfa\xd4u\xcd.m%a\x96B\xe8ĵ\x98\xc4\x04-\x97\xe7\xdb\"\xca\xc0ԓ\xde\xd3!\x10\xa4\xcaU&\xe4/6c\xb3\xb4\x17\b\x8b,\u007fѶ+?\xa4.Y\xb6\rd{\x9c(B\xa4\xe1-3Ш:\x01\xc2h\xe42\xf5\x89\xda\xe4\xc8\x1b\x86\x9f\xaa\xeb\xe0\xbf\xddB䔴\xeb\x93n[\xd0z\xb6c\xd2$\x10ߢ\xb2$Sd9\xde2\xfb\x9do\x0409\x80\xa5\xa3\x83\xae@\x02N\xffZ\xcaoj\xa6\xeb\x97I\xf2Ap\xcd\x1c$\xd8\xfe\xa6\xa0\x8e\x12\x0e\xe8w}\xa8\xf6\xe9\xd1\xd0?\xc3\f {\x9e\x15\x8d\xc0i*L\xf2\x9d\x8f)_\xcc\xcb{%|`\"\x05\xfba \xa3p\x91\xccg\xb8V\xf9\x17w\x142\xc5'\xab\x04Լ\xf8\x8d\x97 \xb2\x95\xc5\xc5xb\xd6z\x86\xf6{\xb5\xd2f\xcc\xc2C8\x9c\xf1sم\x88\xd0\x01n\xc5G\x1c4\xba\xb7$B)\x9a\x8b#V)\xc5P\xb3\xfa\b\xce\xe2j\xf5ܘ\xca\xfc(\xa5\xa5IK\x12\xab?\x8f\xfc\x1c\xafb\x91P\xeb}\xf7\x16\x02\x96V,\xd0~\xcb=Z\x1dO\r+Z\xeeޞ\x91{_\xaf\xa9\xb6m\x1e\x8a\u070f\xfbt\xcd\b\x04\xae\x8f\x913\x88\x87C\xba\x92\xa3$\x10%\x9d\xe0\x96\xad\x01\x03!\xe4cJ\xbb\xb8\xd6\\\xd5\xed\x02\xd4(\xf7V=\xde<\xa8x\xae\xb5\xd5[D\x13\xd0\x02A\x1b*Q\x0f\xfc\x90\x88f\xef\x16}a\xa2\x04\x96i\x90\xf5\x9bo\xe3\xb1\xd05UJx#\x8c\xfb\xe9\xcb1o\xbc\x14\x05\xb6\xa0\xe6\xde\xde\x00\xe4\x1b;\xd5\xcb*\xb2\xb9%W3\xbc\xa1\x9a\x8fs\xed\xda5\xe5\xe9\x80L6N\xfby\x8c\xc9\xf8{\xdeL\nv\x9e\u009d\x8amǲ\x8c\xda2M\xd5'О\x1e\x94\xaa\x1b\xa3T~\v\xea̾\xc9/\xd3J;S\xec\x87\x14\xb3@~\xae\xe5\xb9\x05\xdbʢ\x02\xcbH\xa6\x05\xafV\x9a\xfe\xab\xf6\x15V\x99\xd9\xcb\xe4\xceֆ\x0f\xa8\xab\"\x8c'\xc2\xee\xd0\xf9 \x8fj\x9e\x882\x9d\x8d\x86\x88\rz\xa0\xc9~q\xfc\xc2'&WSC\xb6\xaf\xc6/D\xf4i\x10\xb82\xdb\xf5\x96\x06Р@?-\x9eΊQOXS\xd5\x1e\xa1\xcf\u05f9\\\xeb}\xd4(\xf0Ո!Ч#K\xc0\xba\x9d\xae\xcdN\xe4A\xac\xb3\xee\x93cN\xe0\xb2\xe2f\xf7?\x9b\u007f1\x8f\xa6=\xc4&\n\x82\x85\xff\x10\xa5em\xf0\x02\x02uI\x99\xa3\xa4\xc0\xb7\x00\xce*\x96\xd7E4C\x13\xde\x1cԋ\xb7\x98nXA|~\xa9\x95#OS\xca?(&-\xb9Ծ\x1c\xc3vGA\xad\x01\x86\xb4J\xfc\x1d\x95\x13i$\xf1\xfcfè\xcd\x01\xb8\xb7W\x17\xa6\x14\xaa\xbfΤ\xef@\xa7}\xbdC\xab\x15\xde6\xd1D\xde@[\x19\xaa\xe8\xd7\xe2\xb3\xd5n(\xf3\x8fp\xc5\xf8\x88F\xf5ۛwֶ\x01M\xcc\xd7\xe6S\xec/\xe3\x1b4\r\xa4\xf5\xe7xyF\xb7\x86\x9a9\xfa\xe6\xc7\xf3\u07ff\x8cеP\xf2\x13\xf1]ǣC\xe0|Wͣ\xe0\xcc\xfd,\xea\xf7N\x1e\x1e\xbb\xbe\xfb٠\u007f:<i\xe0\xaf^+<\xe2_\x0f[\xa7'\xed\x10\u007f\x9d4\x8f\xfbG\x0f\xa9\\+\x1a\x9c\xb6\xa8\\/::>\xc5_as\xd0h\x0f\xf0Wt\xd2\xefE\f\xef4\f\a\xf4+<==\xedS\x8d\x87\x83~kp\x88\xbf\x8e\x8f\x1eF=\x82wxz\x12\x86\x87.:2\xd2e9\xa9\x1b\xd8\xfdm5\x1a\r\xecq\xfb\xe4\xb0q<Ě\xed\xe6Q\xff䘡\x1d\xb7O\t\xee\xc9\xe1\xe9\xe1\xc3#J\xeb=\xec\x9fP\x9fN\x8fz\xc7\xc7m\xea\xc9I?l\xf3\x18\x87\xd1\xc9\x11ՍZ\xc3v\x8bF1\x1c\x0eOZ\x9c\xd6\x1f\f\x1aTnp\xd4k6\t\xca :=\x15iё\xf8\xd5\u007f\xd8l7\t\xf2\xc3\x10\xaf3P\x0fz\x8d\xa3\x16\x8d\xac}ڀ\xce\xd0\x18\x9b\x8d\xd3V\xcf=/\x1dY\x13G\xd6\x1a\xb6\x8e\x0eid\x87\xfdV\xbfMp\x8f\xc2\xc3\x13\xc6q\xfb\xb8\xdd\xe2Y9\xee\x1d\xf7N\xfa\xd4V\xeba\xfb\x84j\x9c\x1e\x03\xb2#\x9e\x8b^\xbfM\xbfz\xa7\x83\x87m\xc2\xc5\xe00j\x1cQZ\xbf\x1f\x0eZ!\xff:=jҬ\xf4\x9b\xed\x16\x8f1\x1c\x1c\x9e\xb6\x9a\x04/l6\x9a\xdc\xdai\xf3\xa4yB=8lFM\x82r8h\x9e6\xa9W\x87\xad\x064h\x8f\x8c\xec\xa9q\xff!\x8e\xea\xa8u88\x1a\xf2X\x8eO\x8f\x1b<#\xa7C\xc6L\xd8\xea\x9d\x1c\xd2X\x06\x83~\xafMs\xd8o\x87\x87-\xaaq2\x80i\xa5\x19yx|\xd8k1~\xfb\xadc\x9e\xa5\xa3\x87\xcd\xc1!\xf5\f0\xd7>\n\xcbi\b\xe3m\xf3k\xc0\xc1\xb2\xdd8\xec\xc0\f\x03\x1d\xe0,\xc1\xbf\x1d\xa4\xe9\xc3a\xf3\b\xbf\x1a\x1d\xa4\x8d\xc3\xe3&\xf4䨉_\xb0g\xf5#\xc0\xd8Q\xe3!~\xb5\xa0\xd1>~!\x94\xe1Q\xfb$:ůS\xcck\x02\x1a[\xf8\xd5D(\x0f[\x830¯6\x95<\xe9G=\xfc:\xea\xe0\x8ah\x85\x0f\x87\xf8E\xed\xb5\x8e\x0f\xfb0\xa2C\xaaw<\f\x1b\xad#\xfc\u009e\x9d\x9e\xf4\x0f\x81r\xe0\xeb\x04\xeb5\x06\xa7G}\xfcB(\xad\xd3^\xbb\r_-\x82\xd2\f\x8f\x86'G\xf8u\x8cy'@zC\xfcB\x98\xedV8\xe8?\xc4/\x84y\xd2\u007fx\xc2y8\x86\xb0\xdd;\xed\r\xf0\va\xf6\x9b@\xfaM\xd7\xe2\x19tq\xef\xfa2\x8aF8\xa3\xbdv\xef8\xa4\xd9;m\x9f\x9e\x9c\x10\r\x9d\x9c\xb6\x87G\x87<Sm@\n\xcd\xcf1\x10=\xaf\xc5v\xefa\xc8\x14{\xd8o\x1c2\xfd\xf5\xda\xcd\xc3\rs\xd6O\x12j\xad\x1d=\x1c\bjPu3\xc8'\xbd\xfea\x8fy\xd0\xc3\xd3SA\xe3M\xc0(s\x9e\xa3\x93\xa3\x87LS\x8d\x87\xc3^ۦӫ\xf98\x1ec#\x8dF\xbf\xcf˷\xd18>\xee\x1d2;8\x15Kz8\x94\xb9\x87\x87\x8d\xc6C\x1a\xfaÇ\xf2W\xbf?\x1c\xcar\x92\t\xc0ʇ\xff1\xbcӡ\x84|t\xca\f\xa9w\xd8\x0e9\xadw(s\x8f{\xd0r\x8f\xea\x0e{\x872W\xc2\xebE\xf8?\x86\x8c\xfd\xe2_\xfd\x87á\xe8\x9fh\rԇc\xfe\x15\x86X\x92\x98Y\x84\xa3\x93#\xe2\xdcc\xf8O\xf6\xb97\xe4r\x8d\x06\x8e\x93Gt|,G\x89\xe3t\xcfwݤ@\xbe\x1e$W\xa8T\x14\xeeTY\xb6v\xbe\x1d_E\xf2)o\xe1\t9`\xd5O\xcf\t\xf4\x8fժ)\xd4\xect\xc6 I\x14\b`!P:\x96}\x16\xa6Q\xf0m8\xbb\xac\x0fGI2\xad\xd01\x04\x80\x8d<\x14!\xde\xc5x\xf8~Ќ\x0e\x05Չ65-\x87\f\x14tN\xa2\xb5@\x009\xa1\xe2=h6\x1a\xd5f\xbbjuCs\x1d\x90&[\xa1+ҁ8jo \xf05\xbb\xc5\xc6\n\xa9N\xeb-Oc4\xf1[\x8d\xb7x\xac\x1f\x03\xad\u007f\a\xadvU4P\xad:\xd5\n\xd5\xe8')\xbb!<\x10Y\x0f\x9a̓\x87\xc7\r\xafڂ!\xb4\xedR\a'\x94q\x92KorFS*ͤ\x02-o:\f[\x9f\x9f\xaa\x9c\x00\u007f\xd1\xf9X5G\xb1FÂ=q\xda\x10\x1e\xd4O\xdbJ\x9dB\x1f\x85\xa2iٌ:io`\xcfi\x94\xe6Ew\xaa\x06\x15\xadw%j$\x96Br\xc6\f\xdb\xe80\xfb\xe55\x19t\x82<\xc1\xfd\x92\x8c#΄\xe9;n\x14\x9d\xae^%\xe3\xd9e\n\x1c\xe9\u007f\xf1:\x96\xfb%n\x19\xee\xb7\xe1\x14}\xc2&S\xfa\xbd\x80\u007f\xffw>\xa6\u007fG\x98>\xbf@7\xaeh\x02\xff\xbe\xc6[X\xee\xab\xe4#\xfc\xfb\"\xeaKv\a\\\x0e\xaf\x0fѽŎ;\x88@\x06\x00^\x92F\xf8V]\xda9=\x86m\xe8\xc1\xe1q\xbb\xdej\x03I\xfb\x1c\x80\x1bM\x00\x05VM%\x8d\x0fpT\u07ff{\xfe%\b\xfd?\x92!\xfa\x00\xea\x82\b\x0e \xd6k_\xb4\xb5\x88\xb0\xefE-mn&\x0f=\x83I8\xca\x01mԷ\x80$c\x1b\xe3\xf7L\x82\xff\x16?+\xdey\x06\xfc:\x8a>ذOv\x00\xcc%p\xba\xd1ث\xc0\rp\xba\fh\xbb\x8d\x9b\x00ip\x8e\x9d\xcbd\xaea\xf2\x10\xb8\xf7\x83\xe3\x9d\xfbE\xecN\xefW\x1e\xda\xdda5\xdbx\xcc\x03:a\x06\xf0\xb8\x01\x8c\xe5\xee\x10\xf3\xe0v\xc3ڷT/\xad\x98\x9dc(\x19\xb4掴\xf7\x96\xcbW\xbc\xaa\x9b\xba\x19\xc4\x1c\xb8;A\xd3Vf\xc6A\xf0\x8b\x9c\x1f\x8d3\x04Z\xbd\xb9\x83\x06LU\xe5dE\xd4\xdec~\xe6k\xed)\x06\xc8Me\x14\x1a\x14u\U000f03bcQ\x94\xaa\xb8\u007f\xeb9\u007f\x03\xa6\x87ӢW79\x9e>\xcaY\x82\x83\xb4n\xb0U~\x1aT;\xf0\xff^\x87\xee\xaaI\x83U\x14\x8f\xcc\xfd\xdf\xe7ѐ\xef\x03\xac\xf6/\xe7\xc8_\xd9I\x01c\x04D\x034T\xa8\xa1\x05\x81\xe0\x01\xe8]$K\a\x92\xddV\bb\x95J\x8b9\xaa5\x81+\xe1V/\xa0ee\x1b*\r\xed%:\xc3\xc9 \xe7X\x91]\x87\xb9H\xae\x82`.vij\xb7i\xa7~\r\v2\xcdwGRt.C\x92SA\x8d\xd1(N\xb5\\q\xb3S\x142d\x9f\xb5\x85U\xe2\xd6\u007f*\xa46\xfe\xcc\xd8\x13\x99,&\x01M\x13\x8e\x0et\x1cy\x0f\xf4\xaf[\t\x1c\xf5o\x92~8*\x15;8\xfb\xff\xd3\xc2\xc7o(y\xfc\x96bǟS\xe6\xf8Kี\xc0\xf1\t\xa5\x8dO(j\xfc\xf7\xcb\x19\xbf\x9b\x90\x81\v\xe9S\uf1b9\r&\xcb*ޔ\x8a\xf7\xb0\xa2\r\x8fZ3wX^\xd5&\xbc\x02a\xa5HR)ٳ\x8d\r\xfb\x16\xa2\xc5o#\xb0\xfd\xd6\xd8l\xfe\x19P\xf7\x9bHe\xbb\xf4\xf1\x8f\xc5\xf3\x1f&}\x89\xb87E\x92\x17g\t\x1e\xf5\u007f\xdf>{\x97q\x18q\xdf%\xec\xa5\xef\x17l\xa9\x83\x9f\x90J\xf7\x8f1\xf1qЌ\x9a-\xc5\xc9\x16\a\xf8Yu߹\xea\n\x88*\xf6\xd0(\xf5\xb0\xea>+(tl\x14:\xae\xba\xdf\x16\x14:4\n\x1dV\xdd\xff\xb3\n=j\xee\xed-\x1e7\xb2b\xc0`a\xb8Ѡ\xd2\xf2̒A\x10\xa8b.CQ\x95t\xdc\x16#\v\xcdo\xcdF\xeb\xe8\xff\xbe\xfd\xeaݛ[a\xadqs\xd4\xd0\xfe\xd3\x06d\xe5T\xdd7y\f4\x1e>l7\x9bǭ\x93\x93\x13\x03azz\xe1$4N\x0eO\x8e\x9a\xa7\xad#\xa3\x96L\xac\xba_\x15T9:m[\xadPJ\xe1\xd44,\xc8\b\U000f77dc\xe2{ңdZ\u007f\x13\x8e\xf0\xa2\x90yIZ˰\xfd\bؖ\x8d\a\xcf\xc6\r\x1f\xf3P\xbakz\x1bl8\x97\x0e\b\x96\xcc<\xe3{#\xf4q.<W\xf8k\xb52\nj'S\xc2f<\x1f\xe3\xe3d|\x1bW\x9c\xdc_D\xe3h\x1aΒ\xa9L\x95\x8e1ٕ\x1d\xf4gH&\xc2M\x8bT#\x82\xcf\xde_Z7\x95\xc31\x1b\xf9\xfd\x9f}\xd1}\x11\x9b\t\xbd\xe5\xe2GZe\xben**\xf3M\xc5\f\x1a\x85\xa3@@\xaa\x87\xe82\xa8\xf5\xea\xebtd\x95\xf7\x8d\xcfj\xf3\x9co\x8d\xfc\x1c4\xbb??*\x1fT\xf7g\xbb\x1b\xaa\xc9J\xf3\xa0\xbc\xde\x03\n|V\xd6\xfd\"Ĝ\x1b\xc7x\x01\xff\x11\xce.\x18M\xbb\x14\xa7ҙ\xc9\xf0e\xc0\xd8q\x86\x90*Z\x86ty\xbbI\xef\x8b>\xfbժ,\xa1\x8d\x8c\xa8\xb2\xe2\xadV\xea\x88Nye\x98e\xac\x80\x00\xd6U\a\xada\x19R\xa7\xab\x16\x84\xf2T\xb3\xe8.\xb0\x91\xf0\xa0\x85w4yě\xe7\xbd\x00\x9cI\t\x8ds\xafRo{%\xf4\x9ek8h1\x8b\xf8$m\x17\x14@\xba,\xebO\xb5\xba\xd6'\x9f\x1d\xe9\xb0\x1fj\xbf\xa7\xafm\\\x8b\xaf,=\xfd9\xbc\xc9\xdd\x10\xa4\xd4\xecr\x13G\xbd\x11A{\x96\x99\xfbM\xa7\xe8\xfe\x1a\x9e,}\xff\xdd7\xec\x12$>\xa4\x8b \x9dDQ\x06\xffւ\x19h\x1a\x8a\xf2(DO)`\x9d\x91\xac\"\xbf\x8d\xc8\n\xaa4]\xe5\x96E飨\x9c\xe2\xa7\xf2\x84\xec_s\xbc\xf5\xef\xad}\xf1\xb3\xa3\xd5\xf9\xbc\x1e\x02\"*\xcb\xf9t\xd4\xd1\a\xe7\xe3_\x8c\xec\xd6q\u007fN\x931\xe8\x97\xf3~?Jӎ\b4C\x1fu\n\"BNz~\x84\xdd\xe1\\\xfa\xa9孡i\xce\xd6\x1a\xc6H8\t^QH.*.\xe5:\xf8\xf8\x1d\xfa\xb5\x8a.\xa07\xab\xde%\xcf@\x83\x00\xad\xfae\x9c\xfe\xf9)9r\b\u007fnmj\xf8l0\xc3S]\xf7\xff}/\xeec\xb3\x93+á\xec\x8e]^z\xff\x91?`\x90\xfd\\\xad\x8c\x8d\x8e(\xad\xa2*땬K\x0e\xd9\xcc\xcbq\x89\xcet\n\xb6ԁ\xa21\xf2\xee\xd6ܘ\xf5\xab\xa7\xeaΫ8\U00094d07U6\xb84g\xd0\xfe/\xc2+U\xc0Eѝ\x19\x97\x17\x81Ty\x9eq\xc1Hޝ\xc5\xe8\x9e0\x95\xfc \xbc[r~=\xc8Ɓ\x8d\f\xb8\x91A\u0588\xc8\x11Mh\x97\x97r\r(\x8fr\x04\x14\xc8zz\b \x8a\xb4\xe5\x12\xbfp}\x97j\xf9\x04\xd2=\xefj\x91\x84r\x9d\x9c\x10S\x1f\x9cMν\x14\xfe\t\xf0\x17\xde+\xc0\xc3be.\"bX{;\xb1!~)\"*`E\"\xa7H|\xb2)\x8c\xa7\x98~\x1a\xd7SAV\x92\x8fRt\xf4\x9b\xc9\xeb\"#.\x11\x90d\x17\x12@]\xd6/\xbd\xe9Z\xf0\x00\x86\x9b\xb9FX\x86\x14\xf1\f\x86\x0fdN\xa5\xf1\xc8}4\xe4\vj\xf8}\x1eX\xdf \xfd\xf5\x92\x1bXl\xe8Tn\xe5\xd5)Gx\x13\xc8'?\xb4\x16:\xb2\x15\xfbޏ\xc6pL\xd7e\xd5@\x81\xdb2w\x97\xe3m\xc9\x11۽eߒh8\xe3\f^\xd37\\\x15\x89\x12\xb3\x1e5V+\xfc\xfbX/\".T\xa1\x15\x8a\xbd\xaf\xb3\x87H\xe4MᥖV4!\xea\xb9\x1d\xc0~\xbez\x9d}`E\x14\xf6\xc0\xc5@\xe1\xeeZ+Ǹ̍\x1a\x92\xa9C\xf0\x97\xfb(\xa3\x03\xd9\t\x1b\xba\xa3\x96\x06]\xfd,\x1a\x9a\xe6\xbb\x1b\xe4\xf3\x83\xb2(I\x83\xf8#\f\xb6\xe4*v\x06\xc7\xf5\xcah\x9a/_?\xbf\x8cG\x83\x8a쌂\aP\xe8El\x04\x89r\b\x86n\x8a\xf1fqv+\x86\x03+\x98\x8ds\x14\x0eh\x9f.A\x99\x93a\xcdۦ\xa2\x9fp>\xecfp\xe7\xa5\xfb\r\u07ba\x94P\x88\x88\xf1\x9f\xaa;\xb9qw (z<\xc0\xddu\x04\xfa\x84c\xcfe\xc4\x04$\x10E\x15\x05\x05\xeeF\n\x82'h\x05\xe21L(\x86\xbe\"\xe0\"\xbfh\x94\x85\x14b\xcd\xe8\x96NmY\xb7\xd6\xc3`PAc\fEݰ\xe0\x11c!\x14\x8da\a\xb5\xc9`K߶\xadb\xfbm\xaf\xed\xbd\xcb\x11\xe6z\xad\xf7\xcf\xe4\x9ft\xa9\xe6\xed\fÐ#\xadu\vr\xbf\x18\x0fؼ\x837\x81\r\xae*a\xfae\x8c\xb4k0Ҭ\xad\xc7\xf9&t\uea9a\xa5.\x15v\x96;\x04\x12\xf2-:\x04\x8aֺ`||\xd3!\xdfz\xad\xa8\xe1\xfc|i\xab\xb5\xa8\x02\xafޒZ\xd7EMS\u007fJ\xaae\xc4!\x82\x00\x19\\\xbe\x9c\xce\vJ\xe7VE\x11\xdbY{\xe6\xbd3F\xa9\xbcqUѶq¸\x88\u007fK\xd7|w\x11\xbdn⼇\x9e\x95U$|Yb\xd3\x16\x01\xecL\x9c\xa3ѳ>\xefp\rfqb̴\xd5\xca\x05V\x1a\x8fED\x99!\x9a\xeb\xb0\x0f\xdf\xe3\t\x9c(\xcf_\xcaU5K\x16\xd63K\xd50\x0e\xfe\x85L6A\xf1v\x1a\x03\xa6\x14p\xcb\x17`.\x9b\xe0\x03x:u\xa3\x9f]\xfbڻ\xa4qu\xeb]\xf8\x9e\x02\xf5\xb1\xe9<\xe0\x9c\xec\x029,\x83\xae8.\xb47\x869ml\x9a\xe3\xad\x0e\xe8`\xael\U000cf0d6\xb7\xa4cI\xfcg\xb5\x9a\xaf3ٛS\xb2\x1e\x9fe?3;\xd3:\x9b\x11\xf6kH7]\xed/\x1c#5\x9f\x9f&a6*\xc0\xb1\xa8G~\xb4Av\xf2P)\xc0\x8fg\x1eE0N\xd9<\xf5\x0e\xba\x1cd\x88ĜD\x8c@\v\xf1Φ\xc4~fDdr\xe9\u007f`\x97YB\t\xb5\xaeA\xe5#ήގ\xaaa\x1c\x13\x1d\xb4\xba\xa2I!xS\x9c\x88\x8e*L\x80:\xf8\xcfZ\xc5\xfe\x13\x15J\xc3\x03\x98\xd2Y\xfe\xf21\x88\x02u\x0e\xfb\xfb*\x19D\x82\x9d\x88=ٳ\"\xf2\xa8\xe8C\x121\xf6\\\xc3L\xc8\x01\xe4ZJ\xc4ed\x8d\xa9'\x1c2\xd5+\x15\xd75#\xde\xed\x84\x14\x8d\xe3\x15\xb6\xc8L\xb8D\xa4\xe1\xe7\xc4JE\x1e\x02h\xb2\x17Op\x8d\xd9h\x9b\xc0Be\xec\x06)Qef\xf2SB\xab\xab\xae\xdc\v\x94\xff\x90\x1c\a\x1d*jR\xb5&+\x10\xac\xad\x12\x85\x12\xbb\xa8\x9c\"\x13\xa2>\x99\xb7\xdeq\x8b\x90\xf6\x95\xdbl\x11?\x94\xec\x0f?l\xde\x1c\xf0\xab\x17Mg\x97ߡ\xcaYo\xee\x14\x04\xabx3I\xa61\f\x925N\xb6\xf3e\t\xb0k̒\x89\xd83&\xc0\x8cF\xe9\x9bhJ\v\x98\x8a\x1aI\xab\xd5I[\x9da\x10yx\xf2B7|a\x89Tۙ\x8c\x92\xb4\xb8E\xf1\xec;\xb0\xf23\xceJ7HU.~\xadVG\xb7\xdf\ne\x17\x94R\xb0%\xda\xda{\x19DD]OV\x97L5c\x9b\x19\x90\xcbh\xa04 W\xd1\xc5\xccm\x979i\r\xb9\xbe\x15\x16\xccQOxZ\x9ai ;\a\xcc\x1d\xf9\xbb\x11i\x8cم|\x9b.p\xe5\xe3t\xee]\x82\x98i\xf1\x1c\x11\x17\xdaҁ\xcf\xf5mWҭn\r\xdf7\xe2\x9f\t\xeeY0w\xc6\xe03\xeeoM\xe3\x1d&\xe7A\xa5YU\vS\x04\x0e\xa2o\xfdҏV\\\x95=hyESB\x1c\xbc\xd6\xe4r,\xb7\x96mt\xea|\x87pK\xe0\xef\a\xc6\rn\x12\xbf=sF\xc3\xf9,\xe1P\x96\xdcY$\x1e\"ߏ\x17u\xfc]\xf1D\x90\x13]^\xf1\x04\x93\xa8\xd8\x1c\x04D\xef\x1b\xb1\b\xbfdW*\xb5\x00\xf9[;=\xb8Q\x87\x137kO\x9dNi\xec@\x81\xe2\xef\\\xbe\xb6\xe0\x03\x9bӬV6\xbe5\xb4\x1c\xe4\xf9\xd9\xedfj\xb5\x127\xba\xf0\x15AܝT\xf7un\x1a\x10\xf3dֽ\x10\x1e\xb0\x1a\r\ty\x8e\xfb\xc5I]\x05\x10\xea\xe2O<\xf9\xaa\xb8U1\xfb\xbe[\x15p\xaa\xae'\x8eӷ\xd4p\x1aPPv\xce\xe4s\x1f\x89\f\x90I=\x1d\x8d*\xee\x03z\x80\x85t)q_\xfd#K\x9b\x8aae\xecJ\xb0\x9e37{%V,\x02\x93՞g\x11YeU\xd5_\xa8 \u007fz\xe2a\x0f9\xdf\xdaT\xa7\x1e\xb3\xf8y\x0fćx\x80\xfeB\x8a\xe7W\x8c\xfd@,6|^\x97XE\xd1|\xf0\v\xe5\xee\x93f\a\x1d\x92\n\xd0o\xb0\xaa̓w\xd5S\xbe\xee]\xfb/\xfb\xeayFl\r\x11a \u05fdu\x01'3\xc3Y\xab\x9dhY\x10A\xc1\x88\u007f\xba=\x86Bi\xf8\x84\xbb\x04@Ȏ\x86\x90\xdf\x18&\xba\xdbGcX\xeb\xe1\x14*\xe5\xf1\x14\xece\xef\x99\xccy\xe7X\vG\x8d\xbb\xc5H\xd0Ĺ\x1fKļ\x1f\xefx\x1e|'Q\x8e^\x14\xfd\xaf\x15\xe6T\x05\xdeh\x82\xf2\x8d'\xf3\xddX\x88\x895D\xe9\xdbJ\x85w\x91\xf7Jd7~\x96\xfdO*\xb8\x95\x99\xa7v\x12\xe3|ѓΝ$\xb8_\xcf\xcd\xca\x04\xbeO\x11\x1c\xa6\x9c\xb7\xdd)V\xccn\xc1bl\xe6\xf6\xc0$䝙[\xc9&x\xb7`2\xc8`\xcd~\x18\"\xb0^\xd2\xean\xb1\xfc\x84үk.@A\xf1 YYb1\xfa\x9a \xb9uJEbnْ\x89\x19\x0f\x1b\x84\xe2;\b\x99\xdcP\xa9\x94I\"\xb6\xd8ʧ\xe15n\x04z텔\x060\xf3+\x10\x11H\xb0\xd8QD\xf0\x15\xc8\f\x0fZ\xfc\xc3R\xe9\x9e\xcb\xec.\xd6[\xdc\xf6n\xf2\xfb\xc6I߉l\f!ܔ\x803\x82E1XIĿ\xa98,\x1e\xd8\xff3\xc8\xc2\xf2e\x14\x80#\x88\x02IIc\xbfHS˭\x123\xcb\fy\x81\x99\x10{;yyA\xf2\xf2'\x10\x96w\xf5~!\x01\xab\xfe\x16){P&\u007f\x89\xec\x121\xac\xb0\x8a_(\x9c\xf1\xab\\9\xe9[\xf8\bC\x1b\xf6\v\x04\xd9S8\xec\\\xe6rO\x1c\xf1,o\xeaP-W\xc6$'\x10\x19\xb4\xae\xd5\x04b'\xdfB,2\x88\x95e\x9b;\xa5\xa9jk\xee\xba\x10i\x8b\x18\x88\x18\x9b\xceG\x06?\xcfS\xdc\\\xa9[Y\x0f\xeb\xfdd\xb2\xa8\xc8\x10\xbe\x9c$\xccي$es:\b\xaf\x90F%N\x89T3^\x1e\x0f\xbc\xa5\x04\x82Y\xeb\x1d\t\xe2Yt\x19~\x8c\x93\xa9\x8c?\xf95\xd0\xf6\x887\xde\xee\xaeEoaX\xe5\xd7\xe5\xe5\x1b\x9e\xf8\xbb\x9b7⒓\xd8\xdbp\x18\x05\xe2uI\xf6\xa3\xf8\x06O\xfeQ6\xe1S1\x11\xb9\x94]\x80\xd9\rOO\xd2d[\xf6\x0f\xa3T\xfdr\x9b\xe6H\xfb\xddEO/\xe5C\xee\x14\x9202\x1a\xfe\x0f\xb8S\xa5~\xea\xe9\xef\a)\u007f+\x85\x05r]\xd1\x0e\xb6F\xderT/wm\xb9J\xe6i\x84\x1a\xab\xe9ނ\x82\xb6\x1a\xad\x14\xf5\xe8\xfe\x80\x86\x84\x11[\xe6\x19\x81t\x9a[p\xb4\x82\xc9Z\xdc\xdf\x11\xac\x04\xf2^X\xca'p\x1e7\xf6\xf6\xb4\x93\x00;p\xee\xde^\x85ωy\xb6e\xf6\x93\xa2DY\x87\xe4\x03#\x10?\xfb5k͈\x9a\xe2l\x90:Rkv\xef\x11T`\xb3\x17\xf8\x10\x87\xac\xa4\x00\xe8ΕyX\xc2_T+\xef7\xf1\xf8\xbf\x9b/Jg\x18\xc2\x13S\x1c\x96\xad\x15\xb9\x9d\xe9C\xe3\xf8\x9d\x1b\xf2V+=\x89\xdd\xc9s)\x81A\x93\x95\\>\x1e\xa6i\xbdT\a\xea\xc2]\xa8\xbb\x9d\x82\xe6\xb3\x1c\x01\xdd\xdfNA\xb4\x8cv$\"\x83|\xf6\xf6h\f֓\x15\xae1s\xaeA\x01\x05\x136I&\x15\xaf[:\x91yZ\xf0\x1b\xbe|\xa7a\x10\xa1{\xae\x93/\x83\"̆\xe9\x82\u0558\x9b\x9c\r\xc5כ'f\xad\xe44Ɵ`z[\x90)T\xc0<׀,\xf4i\xbd\v\xc3~=\x1d\x987\xe06\x14\xfb\x94\x8c\xdaV?\xeb\x9f\x17\xed\xe5VT՟\xff\u007f\xf3h\xbap\u0099\xaa\xe4\xae\xf3\x80\xea\xf3xwX\u07ff\xd4\xc1AUw\xfd\xb9\xaet\u007f^1\xc9<\x9d\x81x\x9fLg\x14\x02|)\xdeO\xc3\x15\xd6qY\x05\x06\xb93\x19ES\f\x80\x05I\t\xed\x12\xae\xcf\x04\x90m\xc3\x11Λ?\x8f\rJ?;\xef\x165\x87ݭ\xb8\xa3\x18ļ\xc8 \b~q\x8c\xdfE\xc4u\x8b\xbfL?q#\x80\x97\x9e\xbd\xf64\xef\x83R\xf6\xda\xecƏ\xd17\xa1V\x13\xa4\xa7\x17\xc2W\x19\xad\xf8[E\x14\x0f-\x15\rJ0\xb6\xb7\xa4\x1d\x10\xaa\ro\xfcB\x03\b\xf6\xf6\xb2\xd4\x1c\xa1\xb7\xa1\x8cEB\xff.\xc8\x13J\xafP\xe7/w<`\xb6\xd7\xc5;r\xd5ܾ~\xdeYO\xbd\xfd\xfa\x05$\x05\x88\xa7\xe3\xfe\xa5~\x89\x89Y\xaexf\x18s6<Y\xda\xe5\"\x9aw\x80\xbb\xf7Y\xb3\xd1h\x1cu]\x99g;\x18\x8a\xc8ʼWe\xee\x99@\t\xb3g\x11\xd0TTኾQ`\x18O\xd3\x19\xb9\a\xa8F\x931\xb9\xe7\x06\xd6\ue8f3R\x15^\xde`\xbe\xd1X\xc4\xdf7Z\xc8{\xaf\xc9\xdan\x16\xbfY#\xb0[=<%\x1f\\\x0f\x9ara\x15t\xb3\xbcG\x849\xad;k\xedZ\x8d\bo؋F\x81Q\x1b\xe8[LU\xfal\xf1.\xbc\xa0G\x04]\xa0J@>\n'T\xa5\x10\x89\x9ax\vj\xf7\xeb\xd9e4\xc5M;\r\x8aP\xcb\x175\nJgO\xac\x8b\x9b\x8e\xb9\xdd\xde~\xacy\x14\xe4\xca\x00\x8f\xe8\x9as\x8ar\x80dBKu\xb3u\x94\x9fq\x9e\xb3\xc2qPx\xf9\x1eP\xf3\x8759\xab\x16w\xffב\xccNҍM\xb0\xf9\xc1-G\x05D\xb2\v\x85\b\x9aݭ\x17\xa3\xfc8w[\x17\xa8\xe7\xe5D\x91lG\xfd\xfc\xbe\xf9>\xe0\xe7\x15SL\xd17C\xdc*\xcbs+\xf8\x14\xc5tV\xb4\x01\xcecz\xbc\x94\x97\x84;N$M\xebr\xe9G\xf6/\xff\xc8O\xcf\xd3\xdf\x17\x1ca^X\xc2gɤ\bv\xcaq\x04\xf0\x85\rm\x1f\x91-\xceǹ6\x01V\xab\xdd \xfc\xdfN\"c6̂\x18[\x15!Q\xee\x029\xdf9\x93\x05\x15\xdc\xdfR\xf4by#\x94m\xd5ș\x84\x98\x93\x8cG\v'\x19G\xf2\xd5\t\xb2\xfd\xad\xb3\xa5\xc5˧h\xab\xeeJ\x02\xd2\x1b\xd5*\xf2\x1b\vś\xbc<H\xd5Fm>\xfd#R\xe7yd\x98uv\x13c\xbfF\xd5\xf7E\x04\xc2\xd7(\xbf\xf9j\x99\xb7?\v\xdc\xe9\xba\xd6͗\xd2}\x8e\xb3\xb2\xefBg\f\xe5bwC\xceuf\x04=\x81\xb9\x85\x05rQ\x04R;f\v\xf8\xb9\xb3'\x8b\x8e\x11+\xc0\xf0k4\x8e\xbcnq\x13\x83\x02\xff\xb8\x03F\xaf:\xbc\x88\x912\x88xv\xf0\xfd\xe3\xd5\x13\xa6\xac\xa1\xb0¨f[*\xa1ivq\xf2\xeder-\xef\xc4\xe2o\x99\xfeu<P\xd7j\xf1\xb7L\xe7\x97\x1ad\xcewڛPC\x13\x8f\xc3\f\x8df\xf6\xba(Ēx\xef\xe8\xc6_\xa8\xdc\xc1\x0f\xd9\xcf\x1f}=ʔR\xf7\xaango\xdcK']\xb7\x9a\x15]\xe7\x85~`aA$\xfa\xa1p\xc3wh\fǪ\fo|\x912\xaa\x03\xf3\x04\x81\xa0>N\x06\x11N\x8e\f\x88\xf4\xcf\xca$\x9c]\xaeҏ\x17\xab)\xc8ѫ~<\xed\x03+\xfe\xfc\xc0\xb8\xabn\x933\xd3\b\xc2\xff!\x88īD?\xacV\x11>a\x14M\u007fȲ\u007fT\xd9?\xaa\xec\x1f)\xfb砡\xbd\xb0#]x1\xc2L\x94\xce\xde`b7\xcf\xe8\xb6=3e?\xdd%*k\xcf\b\xfd\\\xad\x9e\xab\xab\xa5\x82\xf5\xe9#eW\xeb\x1f\x02\xe9\xf6\x1b\x8f\x81\x13\xccxC\xf8\xc1ӊ\x90\xcaϖ\xd6\rϬ\xc9g\xf8\xe8\x1au\x9d\x1f\xf8\xaa\x91m\xa8~\xa3?\xaaf>\xe1'N\xa4Ч\xfc&\x86\x89\x8a\xd8\x1e\xc51\x9d1\xc0M\xc5\xee\x83H\xf8\xc1\xe3+0v\xcd\xc0\x80\xef\xd9\xf9\xb5ZW\xe2Mk\t\xaf\xdf\xd8%W+\xab\xaf\xba\x16h\x17\x06\x99\xcf(\xdbe%\x93\xdf\xf8:_\xad\xc4/\f\x00@\xb2\x18v]d\xd6o\x1e\t\xaf\xf7\x1f\xf6\xf6T\xb1\xfa\xcdc9Υ\xd5Y\f\x05#\xf2j\n\x86\xf7\xa8$\x8f`yO\xe2\x0e\xfc\x12r\xa0j\x9b\xf2T\xeb\xd0\xe5jUH\xff\xb5\xdaZ\x16\xa3\x96\x1f5\xbc\xac\x17\"\xc26\xf9\xdb\x13 \x95u.\xcdų,2w\xfa/ *\xfa5I\xae+\xaa\x97\xd2!\x9c\xc0@\x0fk\x82\xee\xfc\x96W-+\xbd\x10\xa5\x17U\xf1\xb7!\xaa\xfd\x88\u0558\x94\xb4\xddF\xac$}\xbf\xb16$~E3Wca\xd7X\x985h!\aˌ\xe9сb'\x83\xabF\xa5qC.\x93A2^\x87{\xa2\u007fȕ(\x06\xe9u\xe4\x0fy7_0^\xbe\x9c@\xff\xfa\x12\xe7\x1d\xf9\xc3O\xd0\n\xd5\xf9٧\x98y\x1a\xffe/\a\x9d\xfb\xacV\xb2\xd2#=\xb9.S9ȖL添\xd6\xcc\xcc\xd8XB\xbf\xd5#~6x\xc9s\f\xd8\xe2\xa1c\xda!ufd\x14\x12X\xa4\x02\x16\xb2͂V\xa6颢)\xecn\xa9\x8b\xab$G\xb9\x16\xf8~\x82\xbe\x9b\x8bWn\x84O\x89x\a\xad\xc3\u007f|\xde\xfe\xe5\x17Y\x8b\u007f\xe80E\xf3\u05cf\xfc\xf5\xa3o\x13\x8d\xf5-\x9e\xa5\xfc\xa1#\xfe\xa2\xc0~\t[y\xc7\x16\x04\xa5\x8c\xc1r\xe6\xa6g\x9b㱺\x82\x9bi+\xbaĐ\u007f\x04\x8a3(\x84c\n\xf2Ei\xe34}%mK\rjS\xf3$\xc8\x145\x8f\x19Լ\xedSQ.w\xea[,\xfb\x93\xd1om\xc1\n\x92\xb5\xad\x18\x93,\x90\xaa \xcb,x\xa3z\xc7NТ\x94$\xf5}Ԧ\xccI!I\x1a%\u007f,,\xf9\xe3\xaf%^\xbd\xa77\x82\x84\x89'~C&\x93\xcdB-\x17\xd2eڛ\xf7d7qeV֗\x8d\xebM\x17q\xb9\xa6x2\x11\xd4\xc8-} MS\xeb\x01~\xbb\xfa\x81\x14\x8fP{\xb5\x10&l\x1e\x8e~\f\xb62Sc\x16\xbd\x8e\xf1\xc9\xedf\xc33e_)\xe7\x1a\xd8\xf5E\xc3\xf6z\xb6\x19>\xd7\x12#\xcb\\\x87tw\x1b\xb3k\x8d\xaa\xd9S\x8d\x11\x15!\x98\fڂ\x83nS[\xa0\x84\xa1\xb2$3\x97Ҳn\x99\xbd\xd42{\xb4\x9b\xf0\xf9\xafq\nW\xda1\xa8\xab\xad\x1e^W a\x14L\xb08+s\v\xfa'\xb3\x84\x0f\x021\x85<\x17\x11\xeaM\x11\x1f\xe1,\xf9\x02\x9co\xe8S\x9dbCÖC@dj\xe6)`\tG\x14\xfa~\xe4\xe9\x06Lyָ\xc5x\xaf\x030\xea\x1b5\xefp\\\x89\xf7\v)\x94\xd9;ґ\xf0\xb1w+\t\xe6\xf5\n\x0fg^\bRz#\\;+\xf9\x86\xbd=\xba\xb1\xf8\xe2\xf5\xf3\xef\xbf\xfd\xe2ջ\xf7o^\xbf}\xf9\xee\xe5\xebW\uf7ff~\xf5\xee\xe9\xcbWo=1\x92K\xb1\x99\xe4\x11\xb1\xa3\xe7\x04\xbe\x8a\xfe&o\xbb\xa0\xe4\x1d]g~\x0eo\xfc\xe5ݣAM~m8\xa8\xdd\x06\xfa\r\x99\xd0\xf2#\xe5\xf4\xa2;y\x85\xe6\v\xc3Ix\x9b\x91\xc6<*)\x11\"\x94\xa3\xf0H\xf6\x90\xacؐ\xcfP\xe9W\x19\xfb\x99\x8f\xdc\xe2;\x92d\xf7\xd4\xf9\xbb\xa1\x02\x97\xbd\xa4\xbe\xd6\\^\xc7!\xa4\x85#:r\x95O\xdc\x04\xcacB\xbc\xa0%̓d\x0f\x97W։\x13\x8c5띮Vo\f|\x81\x87\x8b\xdd,\b\x003+\x8an\xd1-x\xecwi\x96\xac\x06\xaer\xa7q٥\xf9\x1a\xcd\x13[X7\x17\xd2\x1b\xe4\x14W\xe6\b6\r\xda\xff\x05\xa9\xcd\x05\xac:\x17\x14\x86\xab\xea\x87*e\x9d\xe0\x93\x14q\x8c\xa2\x8f\x9a僑%\x1eh\xdaF\xbe\xd5\x11K\x04#\"-\x83\x160܇~@\x90E\xf5\x92\x00\x93o\x84\xd9]\x8b\xaa`E\xfd\xc1\xc1P\xac\x91@\x86X\xe9\x90w\x8e\xa1:\xad\xbb\xd2<\x9c^F\xa3\x8f\xf1\xf8B\x18\xa7\xe5\xa7f\xa5~\xcf\x1d3\xb3-3-\x90\x98\x04x)\xbd\r\x98\x1e͔\"w\x04\x86\xcf\xc7\xc1l:'\x9dJ$o\x8a[&\xcd\xe9H\xc7\x15:\xa6.\xdfQv<\xb2\xe5\xe0\x1a\xa3\xb8\xd0\xcfA˼\x9b\xa9\xf8\xd7\xf3*v\xbf\x9d#\xbdl\x1am\xf9\xfe\xb9\xf6\xa9v\xc7:)/\xeaY\xb99PDP\xe0\xf6q\x9fx!\xc3'X\xee\x10\xeah=%\xacU\x96d\x05#\xcf-\xff*\x1ewT\xb4\x03\xff*\xbc\xe9\xa8p\t\xac٧\x9d\xb3,_\xe5\x9d\xfb\x04\xab\xe8\x00\a\x03d\xc7,8\xa6P\xf2Q\x90}5\xd4U~\xe9>\xab^\x946J\xd9\xd9\xe1M\xa0\x83\xec\x9aG\x19[\x90\x81\x06$9\x80\xc0\xec̲\xa0\x1b\xf2\xecl\x9d\xd5k\x1a\xf5\x9a\xf9z\xd8?U\x8f\x03\xd5\t\x9c\xa3\xddQ\x8f\x04\xa3\xb9\r\xb3\xbf~\xce\xe2|\x17J\xe0\x9e\x05\xf9\x99v\x93\tI\xa2\xbe\xcbE\\o+唃\x00Rq\xfd,\x84\xc6\xe6\xb2\u135bQ\vMB\x1e\u05ec\xc3.\xd5|da7\xd6\xf9\xf2\x80c\xb3|\xf3<\x8b|\xb2\xdea肜w\x95\x81\xe4\xbb\xd2\x05\fH>\v\xfd)/\xad\x81Ȝ\xaa\vk\xe2C\x98\xfdė.s\v\xe7K\xf94\xb5\x95X\x14\f\xb3\xfe\xbd̕\x8fig5\xa5\x1b\x8f\xfdV\xb5حބ\xb3\xcb/A\x05J\xa6\v\x9dLe\xf6\xdb\xd94\xf9\x10\x15\x16\x90O\x8a\xeb\xa9\xcc\x00\x96bP\x9d\xfa\xa9\x9f\x12\x00\xba\xd0\xd3i\xf9\xba_\xa9?\x81}\x056\xba\xce\x12\x0f\x8b덦O\x8e\xf8\x9d\x86\xcfWX)\tm\x0f\x9d\xc6Z\x80\xe9\x90D\xef\xe3\xa3\xfa\xfc\x13\xf4-&\x93N\xc1\xeb\x91\xdaA\x05ِ\x8d\x8b@Zf>\x9db\x9a\xf2j\"G\x196J\xea\a\x1f|M\r\bY\xcb7\x1e\xf0\x16\x98\xb8\xe9\x9c!]\xd3\x1d\xacs\u007f\xa1\u007f\xadI\x8e\xa0\xb5\x92\x01\x11\x96HZ\x11\xb9ԅUvQ\xd5?\x1a\xa2\x8cY\xd3.\xa3\rb\xeb3\x8eE\x11F\x91q\xa2\x89ʶPa\xbc\xd1Eu\x80M@\x91\xc5#\xec\xabG\x1d^P\xc2c\xec\x98G\xbd[\xb0p-\xbc\xd6\xf0\xec\xe6\x11b\xc1#Th\xa9Z!\xcb\r\x0e\x8f.\x10E\x1eᩴ\f\xb4\x830kA\x05\xcbը\x11\xbe\xe0!H\x8fL[]̬\x96\x97!\xaa\xec\xd2P4:\xb9B\x16\x17\xb8x\x8b\xca}\x82\x99\x1d3\x13\xaf\xcf\xd3h\xf5dds\x19\x91<\xc1\xfc\x8e\x99\xdf5\x9d\xa2\xf4VV+l\xe6Q\xc3[.xPX\xbd\xb6\xc8w\x98\x97\xcfڂd\xb6\x8d@h\xd4e@`M\xae3\x1aF\xb4\xf8\x88 \xa2a,\xeec\xc5\xf3R\xeb\xa9y\xe7\xb1to\xcb\a\xd8]\xadt\xfd\x8c7\xc0X\x94\x80\x1f2\xfb#\xde-(\xbfͤ\x0e\xe0\x82۹\x95\x95\xa9\x83\xcc\x16D\xf8\b<\xf4M\x03\xabu<\xf7\x85\x0e\fT4\xe4z\x84\x9e\xa6\x15\xf3~\xac(ŗ\x86\x06\xe2\x0eU\x8e\x11W\xc49\x13:z\x95\xca\xe6b\xa9\x16\xa8\x83\x96\xab)\xc0\r\xa8\xcbȴb<\xb0\x15a\x99QrawH\xe5B\xef\xab0\xc9Yf\xa7P}E\x1e,\x03x\x8dFOt-\xb0\xc3j\x12O0\xf1my\xab\x10\u007f\x17\x16\xd5\xfa\x8a7\x14\x9f\x02~\xe2\xde\x1c\xef\xb8!t\x978\xbe\xb7\xa1\x14\x83v\xc56\xb1\xbddM\xbf\xf1\xa9mQ\x9bj\x8a;^\xb2\xfbRC\x05l\xa9\xadu\xf3*0C\xe5\xb2!H\xec\xa10\xe3\xf9)\xa6\b\xfc\xca\na\xb9\xe8c&\xcb\x1c\x14\x91?P\xbf\xf4\xddLOȚ\xa2T-\xa2\xacn\xe9\xb4J\x01\xefIz?\x03\x8d\xbbv\xa4ߢ\xd2Ec\xd0\xfax\xf6ᜐ!?\xee\xeb\xbcP\xcf\xe8\xe8U\x8cRzF\xa7\xa8\v\x90.\x89X\xdd\x05㑨\x06\x8a\x9b\xcd\xda,n\xb0ca5WJ\xcb+\xec\xd9Z-.\xbc\x82\xabIE\xd9\xf2\xd2\xe8\x90\xd6u\xf6}\xdf`\xdd6\xd5\x06\xda\xef5\xc1\u007f'\x04\xb0,\xf82'\xb03!\xff.\x80)\xe5T\xf1w}Ki\xba\xfe\r\xc7_ݔ\xbd\x9b)UV\x91ϱ\x91\xe9\xab@\xe2\xe4+r\x8aO+(\xd1\xcd\fy\xae\xb8A\xe7\xf9KC\xf0\xcc\xe4G)W\xf2=\xe8-2q\xe9.6\xe4\xb2\xf2\xd21v\x16\xf8\xfeM\xa5\xe0\x8d\x02u\x06\\\xbf\x01E\xb2\xbe(/\xb3@9\v\xcbh\xf7\xda\xf4\r=K\xc6y\x95SW\xd1\xe7\xd1늞\xd5\xc54\xef\xedY\t\x95\u0087\xde\x16\xf7Y\xbe\xcb\xc2B\x8aj\xb7\xa5\x87\xb7\x88\xf3\r\x04A\xf9w\xa3\b\x9a\xce_E\x12D\x05D\x0f\x86r!)\x85\xf5\x8bOE\x13!\fjW\x9ahl!\x8a\x06\x95jn)U\x95%\xff\x83\xe9\xe7Y\xb8AC\xc7ܻ\xd1N\x0f\xdf'-\xa7\x1c\x92\x1dEnPN?\x17ᄢ}\xd4\x1bm\x9bh\xe4\xad6\tEi\xf9\x12E\"}\xedo\xbdf]\xf0\x8c\x93hXX\x19\xf8C\xee\xfa\xfc\xd55\xaf;\xdb*\xb21P\xb6\x16\xc9a1\xed\xd2\x19\xd6x&\x9f\xeb\x17\x81\x1c\xec\xe4J\xb1:\x9d\xf9\xe5I\x0f\a<\x16\xc6V\xea7g\xcd\xf3j\xc0\xaf\x93Ulp |_\x006\xe6\x83H\x91\rW[\xfb0a\xf6^\xa9\x8b\xa2;vW\x1e\xd9cWE\xc8\x0e\x01\xd7\bTk\x94#E\xb4Z\xde\xd5\a\x95fM\xc7|v\x05\\\xc2\xfe3kH\xe6\xf8\xe5W\xc5r\x0f%{\xa0(\xfe\x83\x88\xf1\xd6\xd0.r\xbf\x157\xad12\xef]n\xf1h\xe3|ftH\xac\xaa'\xb2c\a\xb9\xf6:2ˊ\x92a\xbf\x89r\x15\x82\x18\u007f\x13\x9c5\xfd\x06\xfc\x0f8ԣƓZ\xb3\xd3T\x1f\x82qf\x13\x9b\xf9\x05\xe26\xec=hu\x1aRfv\x19Zŭ\xf2\x0f\x11\xf4\xc2w=\n\xbd\xf1+T\xb6\xads\xb2\xa3\x02\xaaSpn.\x8a9u\xb1\xbe:%\xd1_\xe8\xab7\xdaQ\u007f\xf1\xeeU\xcd(d-k-6\xd4\xe2\xed\xacj\xa1\xba\x92͏\xb7\xb6\xa2P\x9adb\x87\xe9)o\xa8tb\xd7\x1b\x03\x95t\xf9\xf1\xb1\xc94\x99%\xa8&\xc9I\xe5\xf8\x1eR\xab\xce\xda\x05\x01{\\\xa8\xc0ڗĥqF\x90\xb8\x97!\xae\x1a\x98c\xcctr\x9b\v\x15\xd9<c\x91G\xab\x83^\xfa3o\x8d\x99\x1e\xc4ꪘ\xac\x16hN\xbb\x99\xe3oׄz&?σ\xb2\f\xb4~\x95\xe5U\xab\xebB\xae\xbd\xa4\x80\u06dd\x86\xaf\xa6\xaaӴ\x95f\x13f\x81\xbe\xc9GT9~M\xa0\x1f\xd9=:\xf7\x96e\xbd\xc8\x15\xd5z\x15\xaf\xb5\x80\xe96\x80ۊ8OAb\xd9 \xe3`\xf6݄\x1c\x948?\x89\xcad\xdb\xdc\rq\xf9\xbfM@\xfe\x03e\xe3m'.\u007f\x80\xfa\xf9\xa7CQ>\xfcY!V\xb2pM\x9b\xa4\x9f\xabhv\x99\fLQ\xc3\xe5\xdd\xcf\xed\xb8|\x85\xda\xd56\xdcB\xa8\xfa\xb6[,\x0f\xab\x9d\xf5\x8c\x1b<\x97\xc1\xa3|\xf7\x02V>\xc1\xbe\x9b\x91\xd8\n>\x152+\x91[\x8b\xb0\x85.oՀA}\xf9&\xf8\x81\x9d\xb5\xdcO\xb6\\\x9b\xfc\x03M\xd4ٛz\x04]6\x98Ű\xd43e\x9a[g\x14\x8aA\x17\xec\xdc9\xe4n\x03\xc8\b3\x00\xb2\xc9[\xa6)˵\x14\xd9\xf0s\xb5*\x8bg\xc4\xfd\xf0\xddވ,+\x95z\xb3\xd5\xf6LX\xa5\xb6\xedu6\x11\x99\xf5zy\a\x83\xf7\xad\xcd@}rr~3Jf\x9b\x8cAY\xa9;\x9a\x84\x18\xc0\x04\x9b\xf9\xb4\xb6¼\x95\xa8\xe4\xec\x1a\u007f٧\xd7\xf0\x03Up\x0e\rz\x84\x1b\xe6V\xa5\xdfT\xdf\xff@\xfd\x91}\xbe\xc9\xda\xc06m\xfeبX\xfe:\xf5\xe7\xf7\xd3m\xf8nf\x16\xc2o\xbbz\xa3t\x85\xfeV\xa5&+;-*\n\xa91\xf4\xf1ɠ>\xed\b\x9c\xae\u007f3eC\xb2\xcc[\xae\xdaoa\xf9\xc4\x1b\xd6+\xe5\xdfm\xa5^\x11\xe8ۮ\x83\xdf\xde\xfc_f(\xdb\xe0\x1f\xc2Uԍ[\xf4\x8c\x9d\xc8ȧ\xfc!\xefT\xbf\xa7\xaa\x15ND\xcfB\xfc\x9b_&\x94\xaeݼ\xa5\xef\xfa'>\xc6\xdezeW\x1a\x04\xf9a_1H\xf6\x1a\xe5\x0fO\x1c\x86\x93\xd3\x06\xecVx\xbdU\x163\x9aח\xe4\rz\u007f\xc9K\x94\xe8\x1c\x81\x15Û]*6U\xc5\xc5mZ\\h-.n\xd3\xe2B\xb4\xb8\x93\x0f\x84\x98靤u\xe6\xe6_1\x9d\x00\xe7\xfe\xb52\x14N\xa5\x0e\xf3̊\xe5w\xce}\x01\x12u/g\xb3I\xe7\xe0\xe0\xfa\xfa\xba~}XO\xa6\x17\a\xadF\xa3q\x80A\xbb\xd56P\xec\x9e\xfd\xeame\x9c\xb2\xbc\xaav\a\xf6\x812\xfc\xab?\xca\xf89\xb2q!3\xbf\x97\xdf\xf9\xdeu7\xf6=Xʟ\x1d\xf9CzY\x9f\x9d\xfb\xd0\\'\x13\xc0>\x12\x8f\xdb\b\xae0l\xa0\x9f\x85\xfd\x11\xab\x17\xd6\xf2k:\xf5f\xa3\x83\x0e\xb2\xec\x98^\xd5\x0e\x8c\x0e\xd0I\xbbX\xe9\xfc\xf6?\xad\xeeL\xb3\xe1<\x92fI\xd76\xb8@\x8a\xbe\xa8[\xb9\x84\xbaְ\x89O\b2\x92\xcc\xc2$\xa5u\xde_\xc6\xe4 FmG߁\x05/\xe1\xc6\xed\xf0\x91\xe2\xa2C\xb8H\xe63\\x\xfc\x8b+\n\xf6\xa9\xfb\xe8)\xf6\xdd\x1f\x81\x84\\\xe1\xd2́\xb6I\x1520\xa6(\xc5ش\x01\xea\xfe{T\xfe\x9c.͈\xf9\xb7&$\xafi\xee\x12(&\xaf}H\x9c\x14\x04\xc3ź\x8e\xf5з,\x8e\xf78\x99\u007f8\xfb\xb4[\xee\xab,\x8aFd\x87%ޤ\xe7\x16\xefIF\uab77\xa6OBt\x16\xd5\xc8k\xcf\xd6\rtX\xe4\\\x10\x03\xf4{\x1b/+0i\x05r@\xf8º\xfa\x81t\xb4\xde\xfd\x96\x94ᴰEs\x91E\xee\xee\xdb\xf0\x1b\xe8,9\x01\xe7\xce:ˡ\xe9\xc4{\a\r\xe6/\xb7\x8a-v-\xb1A\xbeHf\xe9\x8eƭO\xa1e\x15\xb2\x8f\x9b\xffZEˊ\x8b\xfck\xf4.\x8a?\xa1\x82NXJ\x18b\xafFʗm=*V\xd7\xdc\xeb\xcbx\x86~S\xe32\xafƍ@\xb6Y}̫I\xd2z\xc6h\xa0\xd0|;-\xc6Oc\x02\xfd\x95ΰ\u007f\xa4\xbd\U0005365e\xf57.\b\xffV\x1a2\x81ʮ\xdb\xd4۫$\xc1\x18\x8b\xf9\xddI\xe6|ҋ,\xe5/\x04\x85\x17\x17\xd3肸&\x87qj\x9a\x17\xe9\x8aT\xe8\xfae\x92|\xe0\x9dY\xbc\x85+\fyrP\x1c`F^\x0f\xef\xb4\x1b\xfe\x90}+\xd5\tmd]\x81.\xbezg\x8696\xde\xc5(\n.\x9c\xbbR\x87\xf7\xe8\x9at\u007f\xae\xd9h\x94_\x8e\xe3\xfb\x9bьcH\xc9Ke^Y\x84>\x8f}5\xa9t\xee%\x13$b\xfc\xf5H\xc5\x11\xa4O\aDb'\x19\xf2\x1b\xd1\x1dǭR\xea\xbax\n\xf8\x15\x05\xfd\x12\x91\n{\xad!\xd0>F\x96(ʁS\xc1VIS`\a\x10QFH\xf0\xa08\r6\\,y\xa1\x8e\xa9\xb3zoU\x0e\xd6\x06N8\x8a\xb4\xd2\xd2>\xc1\x95>^\xfc\x104|\xf8\xf3\xa3\xf0?!O\xa3@+.\"\x8e7\xfc\xc2\x11\xc0L`v\xe1\xbd\x16\x04^\r`\xf39\xe02\xc25\x05\x1b\xc3䅑\fk\xb3h\x04\x82\x88o:\b\xcc_\xe0\x9f\x1fa\x96M$q\xa1\xa2\xeaV\x14\x06\xf9\xba\x89Q{G\xb6\xa0\xee\x83\xe5\xf9\x82\xca\xda-\xf8q\xe9\x8bX\xf6*\x0e\x87x\xf9X_\xc6\xea\xca\x1al\xcc\x05Dv\u007f\xc3c\x05\x06\xa1m')\x8d\x02\x8a\xe6\x16O1\x83\xc6ZS\xbb\a\x8c\xca-\xf7\x95yjt\x14rJ\tK%1\xa2\xf8\xe5\x14l\x0f\xe4\xefQ4\x9bE>\xdf]\x04\x8c\x8b\x1f\x81\xf8\x9by\xf8\x89\x92\x81qӏ\xae\xdb\xd7\xdfpVE\x14Qo7]E\xcf\xc24\n\x84/\xbf\x80\x98\xa5\x1b\x01؟hO:\xa9\xe7\xa6=\x8cp\x8c\xc1Y+\xde\x01\x06\xc5\xec\xd80ԣ\xf9\x99\x9f]\xbe1\x95g\xb4\a\xf0:E\xa5\xd4\x03n\xef\xb4Ċ^\u0093Q\xec\xf6\xf6Dkd\xa5P\xd7\x12\xf6\xf6Xx\x8bS\xfa+\xac\x05\xcbb\x92\xe1\xc8\xec24\xc3K\xf8\xe2$e \xf7ErǪ\x92]\x92\xa0\xe02H+\x85Oݸ\x12\xac\xa7\x14z,\xeb\xae9&\r\xc5K\xc8~\n\x9fM1\x93\x9cVQ\r\x88x>4\\\xf5k\xb5:\xa3[\x87*A0$\xe8MC\x04\x0f\xe3\x04\xf1\x01SJA\xf3*\xdeㆸ\x89\xd18\xaf\x17c\auP\x11=g\xa033L\xaf\xdf\x00;\xa3\x15\xa4BD\x17u\xa1\xa8\xbaA\x9f\xb5\x8a\xfa\x943\xbcZ5<\x01\x9c\xd1!C\xe3{\x1b\xdfd\x901\t\xf8\xd1J(\xfelA\x11\xb93\xfc\x89\xf9D\xae`-\xc4\x1b\xe9\xf4\x84\x91\x06-<Y\x0eG|\x95+\x87+j`\xa9\x9e\xf2\xd3\xda\xe7\xa6M\"c~H\x81\xf12{\x93\f\x00UD\xa2y,ެV\xfc\xbc\xc0\x83\x02\x046\xbd\xaa\x81e@'\xfbnI\\\xa0?Vv\x0e\xe4\xcb\xd1\xe6o\xee:\x82D\x98|$\xad\x98_b\xbe\x9f\x14\xa4\xe1M\xdd\f\x17\x1d\v]\xa6'\x9aF\xac\xec\x87&f\x1b=\xcex\x81Q-\xbd_\xf1\xb9\xb8ݢ\x8d^[\xaa\x1f\xe5-\x16\x9d\x0f\xc5\x1f\x91\x9b\xa9j\x88\x1e\xedV\x8cV\x83\xd9\xe7\xda\x1f̯&\xb6\xfcH\x8bp)K\x99$\xed\xeb\xcdur\x1d |\xa0\xb5z\xbdq\xc69Z\xeb5\x92\v\xba\xa0\xe1ո\x8cQp|E5\x9b\x146\x88 ftR\xb2\x9a\x05Ȓ\x05M?\x16\xb4/R\x01\xea*\x17\x13\x15\xed-\xd3\x1f%\xe1\xa0`/\xa7\xea\x06\xdf.\x98\x8a\\\xa1\xb5^\x13qiO\x87\x91\xa9JS7\x05\x8f\xe7.o\xe2\xf4\x9f\x94\x9f\x88}b\x9d\x13\t\xea\xbfD\xd3\xe4K\xbc\x13\x99SG풨\xf4K\xb3uC\x17A\xb4|\x1b\n_\x80$*\xb9Q\xda\xf0f\x9b\xbdv\xeaǦO!\\\xc7\x1c\xc5\x15\x94\x19T\xc6G\x8b\n\xddH'd\x96\x1e\x86I\xa9\x17\x18[\x16\xcd6W}\x83\x8d\b\x97\xaeW\n\x9f\x9cL\xf1\xb0mP&\xc1\xd1y%E\xb9\xe5\xc2\xf7\x83\x1b\x14넸\x1f\xfb\r\x1fy$\x904\xe2\x89m\xbc\x18hv'\xf9\xaeN\xb1\xba_̧\xa1xIcc\xfe6\x1b/\xd7\xf9\xb5R`&p\x14\vW[\x9e\xd93z,\x8f\x18ԣ{:(\xd7[\xe7\xdb\x02\xda\xc0]\xf3\r\a\xba\xfcu\x8d\x19\xb0\xe4K\u007f\u007fN\xf9\xb6P\x18-¿\xe2$\xbb픂\xd1\xf4\xe7S|\x8fH9\xd3X\xa5\xbaz\xa1\x97J4\xb1Ji/\x1c\xea\xf0\x1a\x05\xb5\x1b\x8cks2\v\xa7\xf8w\x94\xb1\xbbv\u05eb\xd2P\xa4\xf7\x1d\x12צ\xdc(\x1e\xee4\xba]\xb3ayy\xe1Ȏ\xe4h\x000\x9fX\x16\"\xb3\x06\xefQ\xbe\x8a.\xc5\xec\xd0#z\xb1\xa9\xc9\x0f6\xed:\xf2\x1d%CД\xe9v\x150\xbeJ%VO\x97n\x95\f\x1b~܉5\xa7\xa0\x02!Y\x9c\xe4HYYs\x05\x81ϝgPm\xb76ε[Ǽ%\xd9\x10\x1f\x17\xe1\x9d\xfdϦɄ\x9do\xb0\xe3\xf2+wJ\xbb\x15y\xcaN\xd4T\xcf\\\xe9\xb3\x06\xe4\xb7MH6F\x8b\xfb\xcd\xff\xfc\xf5\xdfo\xf2_:\x01Q\xe3rv5\xfa\r\xdbh\xe0\x1b[\x8d\xc6\xff4\x1aE\u007f[\x87G\xc7\xed\xffi\xb6\x0e\x8fۭ\xc3V\xf3\xb0\t囍\x93\xe3\xffq\x1a\xbf\a\x02\xe6\xf8R\x0ft\xe5W\x0f\xd2\x1a\xdc\u007f\xc8\u007f\x8f\xd2\xfe4\x9e\xcc\x1e\xdf;x\xf0\xe0\x9e\xf3\xc0y\x9eL\x16tp\xedT\xfa\x9eӂ\xa9\xa8\xc1?GΗ\xd1(\xbeq\xbe\x1a\x83P\x86ž\x81\xf5=N\xa3\x81\x83\xdcf\nK6r\xbe}\xf9\xce\x19q2\x948\xb8\x97\xb1\x87i\x92\xcc|y\x1a\xeb9\xcb{\xf7\x1c\xe7\x00\x9b\xba\xbaJ\xc6\xff\xfb\x16\v;N<t\xe4V\x12\xddL\x92\xe9,u\x82\xc0\xd9\xe7Mr\xdfs\x9c\xabd0\x1fEu\x95)\x01V<\x01\xef\xe9\xb7/D!\x86(L)\n,\xb3E\x82*\xbb\xb6\xef\xec\xed\x89\xf4zx5\xf0\xc4\xef\x8a쫀\xfc\fd2\x90\xbc\x9c\x8bQ\xd2\vG\x1at\x1cY\xfd\xed\x84\xe2f\x1a=Z\xdf#\xb6\v\xa3V\xec\r\xc6\xed8\xee\x1cj\xa5\xb3i\f\x1b\u007f\x17\xa1Sl\xf3)\xb4z\x13\xe1\x98\xce\xf6\xaf\xa3އx\xb6\xef;\xfb\xdf&\xbf\xe0\x9f\xab\x14\xff}\xbd\u007f\x8e=\xf9;(S\x89V\x83\xba\xe28\xbe\x13\x8e\xf1)\v\x14>\x00\xcarM\xf8\x90I\xce\x14p\x92:\x1f\xa2\x05LXo\x81\xb3\x15O\xc9t\x96Շ~=Oӧ\x19\x14\x00\xf0\x8f\xcb\bω\x9cY\x82\xd9\xce\xf3\xb7o\xf5f\x12\xb4\\\xcb\xf7\xa6\x10\x10\xe1\xea\x01\xc2{\xe0|?\x8bG\xf1l\xa1\x86\x8f0X\x96\x97\xe1\xf9Һ\xf3r\xe8\x8c\x13g\x16^p_\xe2Թ\x88?Fc\x9fA\x84\u038b\x97\u007f\xc7D\xae7\xa8;\xafI\xba\nG\xa3\x05 \x80\x02\xb5\xa0,\xdc\x0f\xc7N/r&@\x99P\x88\xea\xe2\xa0T\xcb\xd2Ӯ\x02\r\xf9T\x91\xa7\x82q\x1f\x8d\x00_%AS\xb1g\xab\x95\xb3?\x88?\xee{T\x0315\xbeG?QP\x19;\xf1X@\x8cFg\xe3s\x00\x85_\xf0\x8b\x8a\x88\xfd,\x1a\xc1\xd7\xda@\xcfS:\xa7\x85\xbe\xa3_\x1f\xecs\x80ׁ(\x9e\xd2b\xe2\xc75\vF\x13\x8f\xd3\ng\xe2\fQ\xfd\xa6\xcf\u007f[\xbeS\xaf\xd7\x1f\x1c\xc8ᡏ\x93\x90\xa5\xa0\b\x85Ǜ3\xe6\x85H\xecď\xc6\xf0O\xb5*\a'Z\xd5}\x0eU%\xbc\xcfwO\x1f\x16\x17\xce\r\xed%]\xecA\xd3,h\x06t\xe2\x9b^F\xd1\f\t\xe02\x19\rht\xff\x0fHq8\xc59\x87.\xfe\xfd\xdbo\x98@\xb3\xd1ҩ\b\xd5\n\x9c\x8a\xb5\x82\xb4iS3\xbbO\xed\xc02Y\xe2Rw:\xce\xfe,\xba\x99\x1d\xf4\xd3t\u007f\xcdcC\xb4\xa9Y.|kp\xff2\n\a\xfbx\x1b\xdb\a\xe8\x9e9\x81u\xee\r\x10\x03\xfe\xa6clL\xc0\xc1W<\xcf\x18\xffs\xeaT\n3\njT\xd8\xc7E\xa0F\x1b\x1a+Ҙ\xf4\x18X\x1a\xae\x02F\x82\xf36\x1e\xf7#\xe0f\xe9\f\xfe\xe9\x81\x14\xe7\xfc\x83\xf8B\xea\\\x86\x1f#\xd41\xe3\xf1\x05,\x8et\x0eM]ǳ\xcb\fvm\x10\x8d\u0085XDב\\vi\x04\x13\x86?\x98\x19 q\xe0\xf3\xaa\x14(\xf9 \x8d.\xae\x8a鍢\xe8\nȕp4\xb9\f}\xbc\b\x1e\x8f|'\xf6\xa9r\xaa\xcf\v-ddcb\xec\xfb\xaa\xf4\xbf\xff\xcd\xd5\x1f\x800\xe0e\x95\xcf\xf9z\xf7~M[a\xf4v\x1e@i\xd4\x1bM\xa7\xea\xc4\aT\x12F\x03UU\xa1_\xa0\x804\xb5T\x9aNͩ4kԀ\xe7\x1cp\x9bP\xa1\x025j\x04\x0e\x9a\xe4\\\x05\x80y(@\xb1Y_=\x9d\xf7R~\xad\xac\x91g\x8cu\xb2\x13\xbf\x1eV\xf6Uھ\x87o\x9c}\x93\\G\xd3\xe7!F\xab\xd6\xdb \x9e@-\xc1V\x03Ä\x01\x89\xef*}\"\x83\xd9煅{\xd5\xfd\x8cÞ\xf1K\xcc\x02\xbb\x0e\xaf\b\xf1\xf6\xe8w0\x89\x15\x91\xee8\xfb\xffO\x80E\x98\x92\xdaR\a\x13iB u\t\x1fY\xf9\xc6ߖb\x82:X\xe8\x17,\xb1\xd6K\xf0\x14@\xaaY\x90P\x98+\\\xa1\xd2U\x9c.Ϭ\xd3,*G\x93\xe39\u007f\xc3\xe9ܵ\x89}(\xbb\xad\xcf\xf0\xe5\v$\xc1\xca\xff\x8eX\x8a0\x11\xdc\x13\x85l\xe4\xc2\xe44)km\xb06\xcc\xcb1\xb6wdy\x01\"\x8f\x939\xfc\xb56b\x9b\x81SXH\aT;\x94V`\xdb\x15\x1b֢`\x891\xa8J4\xca\xefN\xb8\x99K\x8e\x93\x11\xd5D\xfd\x8c\xb9\xdbXM\xec=\xf5\xfee8}:\xab4\xe8\xd9=`\xe4\x82$\x89> \x9b\xa3\x874=\xb5\x87\xa1\xa9\x136\x029\x90lo\x80mA\x91\xded\xa2Ș^9\xad\",\x91\x87\xae\x14g\x93ɹ\x03\xba\xa7\x93)\x9fj\x9b\x98\b\x04ge\xa1nii\x86kb\xfem\x04|\x8f\xdct'\xa3\x88\xf7\x14]\x00\bgN\x02ܲh\xebO\xd3\x1cZծ\x98m\xdeb \x12\xd1gڄ\x8c\xbd\xd5\xca\xd8\u05f7l\xech\xa3N\x11\xb0p6\x15\xe1\x85\v\xfav\x15M/\xa2\n\x88\xb7E\xdb5\xa0\xdfy\xe4\x14l\xd8\xfa\xa4\x88@:\xd09}\x93\x16\x99\xe60\xa1\x98\xa7\xd6\t\xb2\x19h\x97\xe4\x15s\x12d*\x96\x97B\xccZ\x1f1\x14\xc8\r\xf9;\x8d\xea\xc3^\x9a\x8c\xe63\x94_.\xa2\x1a\xbf\xa5\xe7\x90q(b\xd9NJ\u007f\x05\b\x99$8Y:\xf5'(\xca:7\x1d|\xf8\x97@}\x13\rA\x91Xd\t\xef\x80\uee43lꨐ\\\xa0\xb2ߐ\x88\xe2ɡ'\xf5\x9bj`\x01K\xea\v-\r\xe0\x193\x9cl\x1c-nK\x0e\x1d!9\xc3ir\xa5\x8d\x92\xb7\x0f\x94nB\xb2\xed\xe5GK\xefy\xe3\x894\x1fA9\xf1\xe0F\x0e]\x9aAXka\xf8\xa8\xb40\xd0}\xe7\x89H\xeb\xf0\xdf3\xa8\n̔~\vB9W\xdd>p\x9e\xcd\xe3Ѭ\x96Qd*\xb5\x0e\xf9\x8dH\xa6vi\x97\xed8M\x90#\xb5\xff\x00\xc4;\x18ט\xa2\n\xe1\\\xf2f\f\xe2\xdc`\x1a^sE>\x9dtN\x8a*r&VT\xe2\x06O\x18\xb9;;m\xa3\x8e\xaa\x84\x98\x05\x05\xaa\xff\x01\xdaJ\x19+\xe1 \x9ec\xf7\x1a~\xbe<gJJcu\x8c\xfdb\xb9n2\xc38\xe1N\xc3\xee\xe0w\x98\x81\xd3\xc1\xd3O\x85\xfbɔޖq\x9a\xbe]\x18\x96\n\xf6ǩ4\xeau\xc1B\xf90\xd1\xd9\xff\ft\xfe}_+\xfd\xd9\xf4\xa2\x87\x04\xf0\xd9tzq\xd1\xebQ\xe1A<\xe5\xe7\xdf\r\xe0P\xb8\ts9J\xfa\x1f\xae\xe34\xf2\x9d\x1a~bd\x8ch\xaaR\xa9~:\x89\xa2\x81ձ\xack\xa9\x03\x1c\x11\xe4\xbc~2\x1ePq\xdah\x11c6ʞ\xa23\x11(\xb3\xd7X\x034vPt\xb8\x01\xb9\xc1:̓#\xa3\x83\xaf\x85(+0L4\xc0\\k\x02\x98j\x99\x88\xa5\x1a_\xb2\f\x92u\t\x96(\xac\x8cy\x8a\vC{\xa8\x98\xd1\xf8\v\x1b\x03\x9dV\xf4\xd07\xc0|\x0f\x8ag\xe8\xe0\x03\f\xce/5\x12\xbdP\x83\x15\xb4\xcb3 oN\xc3,\xa4\xac\x8a\xc3D@U\xd4W)\x13\x89\x15\xfe\xc4\x17\xa4\x86b\xff\x05\x17b,%\x13\xa8\xd9n\xfcm\xdf\xc2R\x9f\xbcj\x1d|{*F_\xe5\x85 \xf6\xe1,_>+~\x99L\xe3_\x12@\xa9\xac0\x91\xfe\x95ξd\x8f\xfbTAtB\x15\xd0x\r\x115 \r\x96\xfc\x1cM\n\x16\xf3\x10\x16\x87J\"Y\x06\xbf\x954\xa1\xc5,6\x16\x14,\x97k_\x96U\xf12}\xb5\xf0=\x8dK|Ŗ\r\xc5\x13f\x97\xb8\xab\xc2Ч\xf1 \"\x94\xf5\x04\x1fI;P\xc3\x06J6\a\x04ō\xcbl\xe5\xf3\xed\xb3\xd1G\xf1Qҁ\a\x03f\xa3b\xd2\xe4\xdc0\a\xe5\x17`\xd5v\x81\xb6\x02\x1c$*r\xf4\xa2!\x1a\x06\xc2\x11\xa86\x83\x85\x04Hp\x80\xba\x80\x99\xce({>KPڣ\xb9s\xd8Ax\xc0\x9c\x1a\x15)|X\x9bD9\xd1T\xcf\xc1\x82P_\xc1\x03\xca\x00\xc1\x89\xae,\x90ա.r\x0e\xc4b\x8caN3g\a\x82\x92\xed\xce\xc2S\x1cA\xdc\xd36l\xf4\xca\x03la\xaeڐ}\xda\xe9\xc8_\x0f\xe7PK\xa7\xedL8͢\xc2\v\xf2\x8cRza\xcd-5\xdaO\xb2\x10\x02kPv\x96\x19\xd9%u\xf9ۗ\\\x17\xea\xca\x15\x97\xd4\xf9\xd7\xda\xd3ڽ\x8a\a\xd0\\Rg\xbeZMľ\x02?\b\x80\x1c\x90\x94\xaf\x96\xaa*\xaf\x8e\x84\"\u007f\xfb*\x95\x96X\x82A\xafE\xd2Z\xe1\x84\xecs\x16\xea\x1c1%B\xd7y\x16\x810\x13QC\"\x9d\x84k\xb2O\x80t\x86\x91\xd2%\xd8{\x9a(\xa7{\xf1\xefO\x13\xb2\x0f\xec\x03E^L\x81\x85\xf7©R8\xb3w[\xa8\r5\x0fF\x1f\xef\xdbz\xa0\xde]X@\xaf\x12\xd3>&E\u007fR \r~G$\ft\xabj\x93̇\xea\uef4c\x9fdJp%ᾁ\x86\v\xda\x15\xaa\xb4\xf0+\xa9\xab\xad\x04\xd5ݖQ\x934(#\x05\x984M'\xfc5\xd3\xd1f9I\x0f\x92:\xed,F^\x02\x9dD\xa9\x1f\xd4\xea\xa4.\xf6\x05l\xab2|\x90\xd4Y\xbf>\xc0\xad\xc53\xdb\x16\xb5\x86\a\xa2\xdb\xf7Tv\xb7\x92\x19\x15\x00K\x15\x1d\x81\x0eJ\xb6]\xedS\x89\xaf?#b\xba\xf0\xe7\x91# \u0087.\x05\vŎ\xb4F\xdb\"\x10\x83֣\xe1\xefg\xc4\x1f\xf5\x10\xb5\xcf!|\xd0 Q\b\x94\x03\xbcg@\x15\xa4@YD\x1b?c\x9d\f\xf7\x00\x9e\xa6I\xe0\x1c\x00\xe9\xd8X߳ ̈́\xad4[\xd2{{:i Z\xc8P\x82\xe7%\a01^\x06n\xedU2*\xe7\xbf\xea\xbe\xe8hȒ\xba\x9f\xe3\xb1oa\xc9I\xdd\x14\xd9\x1f3\\ɞMvF\x8b4gl\xd3\xccm³_[\x14\x99\xb8\xce\x1bq\x14N\xe5XԹ(|\x98\xaa\a\xacM6\x1d\xe2\xd3sh8\xd5>\xc55\x0e\xf9\xb8zVQ\xb4\xedh\xeaJ16\x14c-\xc0\xc6K\xc1\xc9Eh\"\xde\xe4P\x8aM\x85\xd88\x88?ƃ9\x14\xe0W\x9c\x9c\u007f\x806\x87\xb6e\xdc\b\xaf\xa7\xf1l\x16\x8d%,\x10\xa8\xd1p9\x84}\x01\x9f\xec\u0083\a\xd8##\x90\xa9L\xb4\n\xa1:\xbb\xce0B*1\x90k\xae\xfd\xbb\xae|d\\\x17\x92|\xd5J#o(\xa1b\xa4\x97\xe1 \xb9\xd6gL\xe0\xcc\xd8V<\xdfXZEҋ\xaf\xe5\x8b\r\xa5bo\x11d\b\x9a\xdc\x18e9\x8e \xee\x04T\xa4\xa0D\xf6\xfa\x99Pn\x8c\xcc\xe4\xe6-\r\xa1#\x86\xa2g\xaa\x1b\x17\xaf\xa7\xf1\x05n\xcc\xfb\xb8\x05\xed\x17\x16\x81L\xd6\b*hH\x82\xf5vxܐ\xfc\xeaA\f#\xe0\\\x1a\xc2 \xba\xf0\xb8\xe6HVP\xdb\"\xf6ީ\xee\xfb\r\xcf\x1c\x04]\xab\xf9N\xe8+\x80\x19\xa1Q\x10\uf811?~ܔ\xf8\xd1\x16\xb8\xbd\x89\x11\x0fdK\x80b}\xb1\xc9\xfa`\xcam\xb1\xe0\x96\xf3G\xab\xbeY\xfdwEt\xed\xa0U4s\x1a\xea\x92\xfa\xe5u\xd8\xef\xc3Z|\xe2\xec+\xc4\x1c\x0e*\x18\xfa\x130\x81\xd6w\xa3\xae\xd2)\x14\x97\xd5sՆ\xd9\xc9\x1fA\x01w4\xcc\xcf\x19\x00GlA\xbe2T\xc6&g\xf6%\xcah4d\tm\xcam\x0eSR\x87\x9f\xbf\x87u<D_\xb2Ș\b\xd3TR\x97\v\a\x0f\x11\x00\xe3>!\x9cV\x96T\xfb\xf6\x1bN\xc39\x9a\xdcPC\x9c\x88\xf3@\xc8m\x11\x9d\xac5^\x8ep\x90\x0f(x\x04KY\x04\x92\xba\xb4\tx\x02r\x13 \x83:\x192\x8e}\xd0=\xf7=\xafd;\x10\fzW\xf6\x17\x0e~\x9e\xa73f\x80I\xa6\xe4\x85\x0e\xeai#\xd6\xf4$G+\xe2\x87;3BE\x06\x06+\x8c}\xb4\x90e$\x8d\bG\x82\xc7G\nq\x13xE\xd1Մ\x15\xd7LEOE~\x1eL\xf6;@X\x999\x17'\xd2<:\x8bg\xd0Պ\x97)#\x9b\xce)C\x1a\x17\x9e\x01\x8a\x11d\x06\xdb+q\x98\x88w+\xb3\xbeKn\xaaN\xa5\x1e!5 \x00$\xc1\x9b\xab\x11\x06\t\x81\x12\x9d\xb4\u007f\x19]\x85i\xed*\xeeO\x934\x19ҋ\xad\x1d\x80겾\x1a\xb8\xa8[\xd40\xe1\xf1\xbehE\xb7Rg\xe2\xa6Z\x97)\xe8g3값;a\xe9\xc0\x18\xb0\x14\x1d6\r\xc5Q\x9b<~\xed\xdc\xcb\xce\x14\xa0(\x1d(\xec\xd7e\xc3H\xd3=~\x1bqڙOG\x95τ\xaa\xf7\x19\xc0\x00\xea\xe3\x8e\xe4\xf4<\xb1a\x05\x1b\xf7\xbb)ɢ\x96F\xa1mc\x90\xddz0\xcdme\x17\xd3I\xa5d\xf3\xd2\xf8\t\xce\xcd>\x054\xd8\xf7-\x19\xb1\x9f\x00cN\xd1e\x14ڐ\\!\xf5\xf3e\x12\xb1\x85Ԧ\xaaXm\xaa\vv\x9e^i)\xf7@P\xad\xe5\x0e\x97j\xc2_\x8e\xafs\xc4\xe4)4\x01#\xadIƫ\x94,\xefA\xcb\xde\x1b|G\xb2yB\x82\xa1\xddil\x9d\x999\x83\xf6\x85*\xc6_\x86r\x17\xe7P\vL\xa8\x02+qpC\xac\b\u07fd\xd4P\x87\\\xeaB\x1f0&\xe8}\x99\n#Zǁ}\x14d\x12)\xb6\x80\x90$v\xd0}ٛ\u007f\xff{pcb/\x03G3G{?\xf2p\x9c\xbdp\xda\xe7\xe9R[\xe8ڳ'U\x93A\xa6~.ǒ8\xf2\x05\xa4\xbe\xca;z>\x9f0Z˶\xed|\tFXG\xfc\xb5\xb2\xed\xb1\n\xfaD~\x8f\x03\x14\xc6\xc3bΟ\xdf7K\xa0\xf1ut\x84\xa7\xaa4\xd6\x1er\tb\x0f\xc2[\x80K!\u007fóG\xb6 \xf7F\xb0\x1d\xa2=n>I\xb2\xe3\xea\xfe%\xde~5Z\xd2\xd5\x1a\xaf\x9c\xb2\x8d\xed\xf2\x9e\xa1ˡ\x90\xcb\xc7\x1a\x81)\xcd\x18J\x12\x91a\xad%\x14\xf5x\xd0y\xf1\xc3˫\xf0\"z'\xf9\\\xfd[\xc53\x9f\x8d\xe6\xd3\xca$\xbe\x89F<yA˿\n?D\xdcz\xd0\xf4\xf9\x87\x18TP?T\\k[\x8f\xb8\x1f\x9e\xc9\xd5\xe5\x9e}ap\xe2<\x03\xcc\xf6\xa2\x82}.\xc7\n\xfb|Z\x91\xd93\xe4I\x05\xb1H\x1e\x01\x8aArI\xadVJS@\\\xf71\x0f\x04V\xd80\xfbE\xfb\xa5\xa6\x9b!\xff06\xcfjr\xde\xe5d\x04\xd2\xd7\xfaP\x92l\bE}\x0f\xf2\xf2\xfb\xae\x94F\xd6Ҵ\xc8\xeeMI/\xb2\xc5T\xc1\xa3q=\xab}\xc6\xd9\xcf\xef4,\x8d\x91\x05F\x1c\xcb\x118_ȞH\x13\xfb\x1ev\x95\x92a'\xfb\xd9\xcb6z馕\x93,\x03\xc7\x02\x16fg\xf9؞\x98t1\xbd\xf7\xee\xe1\xed\x89{\x8f\x0e\xa4\xaf\xdc_n\xa3\u007f\xfd\xf7\xd7\u007f\u007f\xfd\xf7_\xf0\xdf\xff?\x00\x00\xff\xffw\xc4\xfd\xa2\x00L\x05\x00")
+var appAssets = vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\xfd\xddz#7\x92(\x8a\xce\xed\xa9\xa7\x88b\xafqgJI\x8a\xa4\xa4\xfa\x91\x8a\xa5\xcf.\xdb\xd3>ˮ\xf6vyzu\x8f\xac\xa9/\x99\t\x8aYJfҙI\x89,\xbb\xd6\xcdy\xa6}w\xae\xf6\x03\x9c\xfdJ\xe7\x8b\xc0?\x12IR\xaa\xaa\ue7b5\x97{\xa6\xc4\x04\x02\x81@ \x10\x00\x02\x81\xc04\xae\xd9`\xde,\xf2\x7f\xf9|\xff\rG\xc3\xe1\x93''\xff2\xe4\xff\xb9\x7fOONN\xfee4>~r:>\x1e\x8f\x8eG\xff2\x1c\x8d\x86\xa7'\xff\x02\xc3\x7f\xf9;\xfc\xb7\xaa\x9b\xb8\xfa\x97\xe1G\xd7\xe56\xee\xbf\xc8\x7f\xbf\xfdvt\xf0\b +\x92|\x95\xb23X\xacփw\xf5\xa3\x83\xa3\x0f\x1f\x1e\xbdx\xfc\xf5\x9f_\xfd\xfc\xb7\x1f\xbf\x01\x14\x90\x97\x8f^\xc8?,N_>z\xd1dM\xce^\xfe\xf6\x1b4l\xb1\xcc\xe3\x86A\x8f\x92z0\xf8\xf0\xe1\xc5\x11\xcf~\xf4\xa2n69\x83f\xb3d\x93^\xc3\xd6\xcdQR\u05fd\x97\x8f\x8e\x0e`\xde4˳\xa3\xa3\x05۰\xea\x8eM\aI\xb98bU\x96\x1c5e\x99\xd7\bwT\xb1\x9a5G\x8f\x00\xe0v<\x18\xc2\xef0F\xe1\x18\x8d\x9f`\xd2\xf7Y\u008a\x9a\x9dAQ\x16\f\x82\xe5j\x9ag\t\xa4\xe5\"Ί\xf0\xd1\xc1ѣGHp\x04\xd32\xddD\x90f\xb7\x11\xd4˸\x88 ^.s\xd6DPN߱\xa4\x89 \x9bU\xf1\x82E\x8f\xe6\xa3\b\xe6\xe3\b\xe6\xc7\x11\xccO\"\x98\x9fF0\x7f\x12\xc12\x82i^&7\xbf\xaeʆE\xb0\xacX\xf4(\x8e \x9eN\xab\b\xe2\xa4*\x8b\xcd\"\x828M+V\xd7\x11L\xb3\xeb\b\x92\fA\x932eѣ\x94\xe5\x11\xa4\xb3\"\x02\xb6\x88 [\\G\x90\x15u\x047\xd34\x82_#\xa8#\xa8\xe3\xc52zT/\xe2<\x8f\xa0n\xaa\xec\x86\xd1߲\xb8\x8e\xa0^M\xf1\x9fe\x04M\x13\xc1m\\E\x8f\xa6\x11\xac\"\xc8\"HXѰ*z\x94b\x15M\x04i\x1aA\x99G\xb0\xca#ȳ\xe8\xd1,cyZckge\xb5\x88 \x8f\xa7HMήY\x91F\x8f\x9ax\x9a#\x9d\xf1\xb2\xc9\xca\"\x82\x863\xab\x99\x95e\x13A\x83]\x1dAS\xe1\xcf\b\x9a4z\x14WM\x96`\x91\xb8\xceR*Y\xdc\xc6u\x04)k\xe2,\xaf\xb1\x89S\x96b\xbd\u05eb\x8aE0ˮ\x15rDʪ\b\x10+\xfd\xbd\xae\xca\xd52z\xb4`\xc5*\x82\"\xbe\x8d\xa0\\5\xcbU\x13A\xb5\x9an\"\xa8Y\xc2K֫\xc5\"\xae6ѣ&[\xb0\b\x16qu\x13A\xbcJ\xb32\x82\xdb,e%\xfc\xf6\b0\xf9:+\xce`x\xfe\b`\x19\xa7iV\\\x8b\xafiY\xa5\xac\x12\x1f\xb3\xb2h\xfau\xf6\x9e\x9d\xc1h8\xfcW\x99t\x06Y1gU\xd6`\xc2-Æ\xc6y?γ\xeb\xe2\fPQ\xe6Y\xc1\xce\x1f}@\xd9\xfd\xd3\xcf?|\x7f\niV/\xf3xӯʜ\x01\xc9*r\x19\xca<e\x15L\xab\xf2\xaefU\r\aG-\xae)nY\xec\xe1,{\xd4\xc1&0\xd8$\x18C\xad\x16D\x9cq\x19E\xfa\xb0\x0f)\v\t\xee\xcfYv=o\xce`\x84Y\\4Df\xdd\xf4it\xf2\x11D%\r1\xff\x95\xa0\xe8\xa3\xf6A\x9cM٬\xc4.6\x92\xe2\x19\t\xe3\xaf*\xefW\x9eD\xa8\x92\xb2h\x18\xb2\xf9\x8f\x7f<7?%j\x92E\xf8M\xf5V?)\xf3<^\xe2\b\x97\xbftW\xf6\xebe\x9c\xc8\xfe\xa5.\xf9\xa6H\xa1\x9c\x89n88\xd2\\\xa0\xee\x9eŋ,ߜ\xc1_X\x95\xc6E\xec\x8a\xc1\x93\xe5\x9ap\xc7\xc9\rr\xbbH\xcf\xe0\x0f_\xbf\xc2\xff!\xf6\xf9H#\xba\x13윖y\xea`9\x1er,\\\x0e\xfbӲi\xca\x05\xca\x18&\x7fx4?\x86\xdf\xec\x02c\x913`UUr&Y\x14|\xfb\xed\xab\u1aef\b\xe2.\xae\x8a\xac\xb8\xf6\xc1|\xfb\xe5\xb7ăAV\xccJ\x0f\xc0+\xfc\x1f\x01\xa0:\xf2\x00\f\xbf}\xf6\xed\xb7\n`\xb0X\xe5MvV4\xf3~2\xcf\xf24(\xd34\xdcZ/\x17T\xf8\xcd\x1cuc\xc1\v\xd1[\x8a\x17\xcb5\xd4e\x9e\xa5&{\a\xf5*IX]\xb7+\xf9\xfa\xdbo\x87_?C\x98ee\x89F\x15\xa7٪>\x83S^\x8b;\x06\x00%\xa6\xac\xce\xe0\x0f\xaf\xbe\xfd\xfa\xabo^\xe9!^\x94\xd5\"\xcea4^\xae\x8f\xc6\xe3\xe5\x1a~(\x8b8)\xa3\x1fʢD\x91b\xf08[,˪\x89\x8bƖ\x88\xbeD9\x9e\xe1\xff\x9c\xccl\x11_\xb33\xe8߱\xe9M\xd6\xf4+\xb6dq\x93\x15\xd7}\x1c\x83qտF\x8aY\xd1\x04M\xb9\x8c\xe0\x0f'''0\\\xae\xc5/$$\x92\x88\x9d\xaf\x93\x93\xe5:\xec\xa8lQ\xbe\xff{\xd5T\xff\x9d**\xff>\xf5|\x86J\xf4\x84\x03\xa3SS\x11\x9c\xc1\x88-\xf8\xccS\u07b2j\x96\x97wg\x10\xaf\x9a\x12嚦|W/\x8cF]b\x8d+(9%\xf1\xa9ߪGj\x9a\x11h\xbc\nIV\xf0\tL\x0f\x8e'O\x9e\x9c\xdb\xf3&\x1fh\x83dUU\xach\xda\x03\xf2\xd9W\xb8\xba5qH\xf5Ҫ\xa8\xaf\x88\xbe\xcb\xd2f\xae\xe7Z9!!\x1fw0n\b}\x9e\xc4\xe9\xc2\x15\x17\xfc\xe6\xd7*i\xd94,5\xe9IVU\x8d$.ˌ3\xeaã\x17G4\xe1\xbd|\xf4\xe2H\xacbq\x96x\xf9\xe8\xb7߀\xad\x1bV\xa4\x80\xeb\xde#\x9e\xf8\xe2\x88/x\xff\xe5\x7f\xe1\xff\xa6\xab,G\xe6\x7f\xce=\xe0\x8e\xfd\xdf\xf8\xe4t\xe8\xee\xff\x9e\x1e\x8f\xfe\xf7\xfe\xef\xef\xb8\xff\xe3\xc2_\xf3e.ɂ\xb9)\xacX^\xc6\xe9\x80\xef\xa6\xeaeVp\b\xda$\xfe\xf6\x1b\xa4l\x96\x15z\xf7\xf7\xe1\xc3WB\xa8\xe0\xb7\xdf`\xf0:^0\xf8\xf0a0\x18\xe0 \x13#,\xcdn!\xc9㺞\xf4\xc4\xe2A,\x01z/\x1f\x01\xbc\x98\x8f^v\xe0xq4\x1f\x11\bb\xa0\xb1<\xe9)}2D\xf5\a˲\xcepqLt\xc7Mv\xcb\xce\t\xab(\x94\xa5\x93\x1e\xb6\xa1`U\xef勣4\xbb%|\xfc\x87\xfcC\xda\xf3\xe5k\\\xd2\xc2\xcf\xf3\xac\x86e|\xcd \xabIm/b\xda\x19\xe4\x1b\xc1\x18\x96BY$̤\x14P\xaa\x9az\xf0\xe2\x88czdm\x93\x15\v{0 v\xd4I\x95-\x1bs\x93\xfc.\xbe\x8dyj\xef\xe5\xa3۸\x82r\xd9\xd40Q\xab\xfa\xfa\fF\xc7\x11\x1c\x1d\xc1\xcfs\x06\xc5j1e\x15\x943\x9e\aM\ti\x15\xdf!,+\xaeQ\xf7\x8e\x87\n\x98'!0\x8b\x939\x950u\xb4\x86\xc3Nm\xe6YrS\xb0\xba~\x04 \xd7\\\xc7\x1a\x86'!\xaef\u0380\x98\nIV%9\xa3\x19\xa2*X\x85\x94\x12\xfc+\xfa\x04\x9aI\x10#\x04\xc3\xc1`\x14\"ⲉ\x91\xd3\x06^L\xc9\xca\x02\xca٬f\rM.\x15\xdf\xf3Ht\xa33Hp\x82\xb9\xcbj\x16A\x1f?\xcb\x15\xeay\x95\xaa'\xa9?\xfea8\x1c\xfe\x91\x8a\xfd\xa1\xba\x9eBY\xc1\x1f\xaa\xea\xfazJ?㪊7\xd8\x06\x82Ɩ\xd6K\xc6RY\xd1OHp\rK\x14R\x96\x94E\xfa\b\xa0\xa9\xe2,?\x83'\x9c\xe2/q\x8bs\x9d\x97w\b\x84\xb3r|\x8d\x95\xd7\xf38ũ\xbe\xa9V\x8c\xe0\xfeǜ5sVASB\xc5\n\x94\xfaX\x00=\x02\x98\xdf\xc5I\xc2r?\xf8\xaaf0\x8f\xab\xf4.\xae\x18\x10\x1c\xab\x88A\x8f\x80\x8f#\x94\xbb3\xf8\xa3\x10\xec?*F\xbez\xf3\x86\x03 \x96\xb8\xae\xb3\xeb\x02\x7fao\t\xd8G\x00\xef\xbf+R\xb6>\x831{\xae\n\xbe\xefg\x98\bA\xcaf\xf1*o\b\xc1x(\xff\xc3nk\xca\xe5\x19\xfc\xf1t\xf8\xaf\xa2\xbar\xa9ƞ\x1azXj\x19\xe3Z\x82\x84qֈ\x12X\xe0{6k\xb6\x96\xf8pN\xa2\xdf\xc4\xd55k`\x02i\x99\xac\x16\xach\x06\u05ec\xf9&g\xf8\xf3\xab\xcdwi\xa0\x9a\x1d\xf2\x02\xe2\x13&P\xb0;xÿ\x02\x1cA\xe1\x00\xf3\x02\x8e1<\xc7\xf5\x00\r2g\x80\x1a\nO\f\xd1\xffU\xe7\xff\xf4xp;\x1e\xbc\xab?g\x1d[\xe7\xff\xa7\xe3\x93\xe1ӱ3\xff\x0fON\x8f\xff\xf7\xfc\xff\xf7\xf8/\x98\xad\nR\xab\x01\xdf\xe2\xcbOH\x8fߒ\xde\b\x92\xa6\xac\xd0\xe6Y.\xd1\"\xc6j\x0e\a\xd0T\x1b\xf1\v\xc8\xe8\x15\xe0Ȼa\x1b\xc8\n\x0f4\xfe\xf7g2\xb7\x0e\xf8r\xe1G\x0e\xb2!\xfc\x83eU6%N\x7f\x11\xa2\x88\x8cB\x00\xb7q\xbebg\x06\xce\xcb\x1b\xb6\xb9\x8a\f\bV\xac\x16\xacB\xeb\xd1\x19\xcc\xe2\x9c4?\xff\xef\x03\xed\xcc\xe8\x17\xfd\xfd\x00I\xdc$s\b\x98&\xcc&\x00&FM\xe7\x8fd\xd1\x0f\x0eoh\xcexU.7\xc1\xb2f\xab\xb4\xa4o\x89\x139\x91\xc1\x04\xfa\xa3\b\nD\xa8A\x06|\xf6\x8dĤ3\x81\xcb+^\xc9\xdd<\xcb\x19\x04\x87\x87\x19\xbc\x80\"\xe4\xf9\x83媞\x9b5\\fW\xa2E\x15kVU\xc1\xc1λ\b|\x93g\t\xf3Q(J\x7f\xc9+\x91m\x1f\xd4\b?\xc0\xb5\x8dUȇ\xff\x87x\x89\"\xe3&g)+\x9a\xac\xd9\x04\xa9SU\xeaC\xd2̳:p\x001\xcd\v[\xadX\v\xb6Z1\x1f,\xfd.\xab\xe0օ\xdf,Y9\x83[\x98L&Г%zp\x01\xb7p\x06\xceP0\xca\xdd\nA\xf0\xd5U\xb1iV\xa4bJ\x89\xa0.WU\xc2\"X\xb0f^\xbaL\xf0Ԁ\xb2B\x12\x0e\x13Qf\x80\xc7\x13\x9b@\"\x8a\xabk\x9a\xf4j%ʪ\xebE\x86\x90)\xb8\x903\xe5\x19Ǹ\x85h\xbe`\f\xd6\x0e}kx<\x81b\x95\xe7\xf0\xc5\x17\xf08\xab_ǯ\x83\xb5\xb7\xfb\xdfg\xcb\xef\xa9\xd6vG\vr|\xa5\xeae\x9e5\r\xabڅ`\xc2+\xf6\x95\x92\xc6ߠvJՃ\xa6\xca\x16A8\xa8\xd82\x8f\x13\x16\x1c\xfdR\x1f\x1e]GЃ\x9e\x97\xea*.\xae\xd9[\xb4\x06\\\xb3\xeaM\x12\xe7L\xb3\x80\xd4\x17L\xd0Ln\x8c\xc75\x1c\xc0\r\xfc+\x8cB\xb8\x81\x83\t\x8c\x86\xd6\xf0\xbb\xf1Ղv\x10\xd42\xbe\xf1!\xf3\u07b2[46\xc9OI\x84\x02\xe5ٶ\x98\xbc\x87\t\x19\xed\x19\xae\xaa#KüWz%\x97R\xe2*\x94l\x06A\x8e\xa0\x97\xd9ՠ,Bȅ\xa0\xe1\x80\xdb\"f\x92D\xad\v95\x8a\x12Rb\x91\xfa\xfejC{ \xbe\xf6⊂\x17\xa5&\rJ$W\x8d\x83\x02\xcf\xddTQ\xbb\xb99L\x1c\xa4\xb8\xee\xa3\"a\x04\x99$\x13\xdb\xd5\x1a\n/`\x1c\xca\x06\x900烲0K\xe4\xba.\xa0L\xd0\xf2'\x12\x8d\xf6\xa9\xdf\\C\x06C\xce}\x9dLk\xe5?ς<\f\aIY$q\x13\xb8e28\x84Q\x18\xb6+\x10m\xabآ\xbce\xbcy\xf6\xa4%(VLҘizp\xf0ԂG\xe6$\x8a\xbb&\t&Q\x87;:ڒs\xea;\xaf\xa6Esz\xf3vY\xb1$\xab\xb1G\xd7\x11,\x9da\xba\x84>\x0e\xa4\v\x18\xc1!\xfc\x107\xf3\xc1,/\xcb*\xa0\x9fyy\x1d\xace\xfa\xb2\xbc\vp\a\xda\t\x18\xc2\x11\xcf\xf8\xfe\xf5h\x18B\x1f\x96\xa1\x93t\x06\xa3p\v\xa18\x03|\xcdw4\x1e\xedw\b\xbdޖ\xc2t\x0e\x17\x90nu\xa7zJ\x1c\xe4q\xdd|'D\xa17\xe8\x85\x11\xcc`\x02\x19\xbc\x9c\xc0\x10.\x04P\xbd\x9a\xe2\x01oq\x1ddHn`\x14\x17c\xb8\x87%\x9b\xf6\xe2 \x83\x970\f\xa1\xe1\xfd\xde\xc2\x06\xfd\t\x1cG\x80\x82v\x1c\xda˄fP\xb1[V\xd5,\b\a\xefʬ\bzQ/\x84C\x98u\xb7\xf6ǊͲu\x90F\x90\xb9*\xd2\xea+\xfa\x88\xa7u\xf0\f\xfa\bz\x00\xc7vݼ,@\x8d\xfa\xf6\f\xb9\x01\xcf\xe0B\xeb\x80\xd4\x1c\x8aj>8\xe2\xba\x15\xff\xfb\x00g\xbb\xa0\x0f\fh\xb92\xac7\x8bi\x99\x9fA\xda=\r\xb2\xb8f\xb8\xcc],\x83Y\xd7TݴV\x03\r\xbc\xe0\xfd9\x843h\xb0sG$\xdcg0\v\x9a\xf0|Gu\xb2#\xeeQ\xe1\b\xfa0\v\xf0\xdf}\xd0\xcfr\x964\xf7A?8\x85\x03\b\x1ax\x81\xbf.`\x16\x8c\xe1\x00\x1a\x94\xcd1U\x8c\xff\U000a4775\xab\xe5_\xe3T\xdft\x16Y\x96\xf9&`\x0e\xf8\x16j\x95\xf05\x11\xb0\x9d\x04\xd5YѢ\x05\x19IX\x92\xb2\x0e\x1a8\xe0\x1f?~\aG0\x0e;1\xb1\xf5\xb2\x85I\xd12\x8e`4\xe4\\\xecK-\xef\x1762\x8eu\x93T\xffZ5\xbc\xa7\x89\xe1\xddԠ\xaaɒ 6\xd4-\x99>\xce\x1f\xc9\t\xc3?).a\x02\x83\x93ӭ`#\xcd\xf4X\xaf\x88\x00j\x98\xc0\x12\x8e\xe0Dp\x1dX^3\x95Jb#X\x19ʟ1v\xc0\b\x8e \xb6\x95\xc2Vi?\x84\x18\x0e\xda\xcc\xed7\n-b%^ט4\xb6\xfap\xb9S(\xf0DK/&\x91\t\x8f\xeb\x90\xda1\x1a<\x1d\x8eN\x9f\x9d\xef\xad\t\x0e\xe8\xff\x83\xa0\xa6ٝ>\x91\xa8\x9d\x14\x94\xab\"i\x8b\x01\x8eAd\xd6x\xf0\x14G\xe2\xd3\xc1\xe9\x93\U00069a23!e\xf3\x02ƞ|d\x05\x12\x7f*\xf28!\x87\x80`\xa2\xd4ള\xdcx0n\x15|~LE[\x90O<\xa0\xcfN\x8e\x9f\x9ez\x9b\x8a\xab\x86Wq\x91\xb0\\-e\xd3\xe3\x01%\x0f\xea\xa6\\\xa2\x11 \xbe\x8e\xf9\x96\xe8\xdc\xce_V\xf4WN\xd6ag\x05oh\xa3\x14\x98\xa3\x80\xc1D!\x8a\xa0\xb6&Q\xecf6\u0eebo\x10 $\xf0\xda^\xeft\xd6\xf6\xb5\\\xdc\v\x1b\xa2Q\xab\\@鵯L\xe1\xcb\xc5!_\xab\xbb\x03ο\xff\x97E/\x15\xf8evu\x05\x13\x13\xad\xbb\x89\x10\x1c\x14\x9f\x83rf.\xb5\x9by\xf6^/\xf3߷dY\x012c\xf8\xdbf\x1e\xc5\xde!L\x80\x8dL&\x1a\f?7\xa0\xd9h\xa0\xac\xb7\xfc\x87\x99+K\x106+C6\x1e\xcbo\x96\xecJoU\xac6\xe82\x1f`\x96\x15t&\xf3[G\x05C\x03X\xfc\xfa\xe0[\xea\x9a\xcb\xe0\xb6\r\".j\\\x1e\x05\v\xb3\xe3+\xe4\xc7%,\x06q\x04\x8b\xc1\x14\xae\"\xa8F\")\xc1\xa4\x14\x93n\xd60\xb1\x90\xbc&W\x94\xec=\v\xaaa\x18\xc1\xcd{'\xff\xeb\xb2\t\xaa!\xe2\xc2\xdcMgi3\xf5U\xb9\x98f\x05\v\xaaQ\x04X\xb6\x7f\xf3>\f\xe1\xf7\xdfa\xa85~5\xbc\x1c^\xc1\x01T\xa3\xcb\xd1\x15\xbc\xc0\xbf\xfc{x9\xba2䂃\xe1&\xf3\\'\x8d\x9c\xa4\x9b\xb5\xf3\xfd\xde\xfc\xe6\x9c\xc6\x1d\xe6\x80\x1f\xf2\xc0\x04\x82\x1b\xdc\v\xf0١\x89\x8bq@X#^\x1f\xae:\x8c\xac>\xd1\x16qRCT9\x16\x83\xd8uŤ\x95\x8e*\xa1\xac\x9c׃\xdcg\xc8\xfd\x19\\\x19 \xb4\f\xa5\xec\x9b5\xb1\xd5ʼaw0\xc1T\x8b\u009b\xf7\b\xd9Q=\b\xaf\xc2Na\xc1~\x8c#\x98:\xca>\xe6\\\x9f\xe2\x9fC\x88\x89\xb30\xbd\x1c]mE\xa6\xbb=\xf6.\xcbi\x01\xe1\xa9=\x0eC-\x017\xc6\x04\x8f\xd5\x1fM\xf4\xfa\x99\b\xd1\t\x1fv\x9a;Z\xb2\x87m\x8d@\xd5A5\x1cN\xe0F4\xf6\xfc\x91\xaaF\xa5\x8e\xael\xab\xa6\xaf\x1er\xe2X\x96ػ|\xab\xcb\xf7\xca6S\v\xb2=L\xa0\xa7\xa8B\xeb^z<0J\xff,\xb3\xe0\xcc\xc9\xf1U\xbb*\f\x80\xd7\xdcnf\xf6\xe6\x14&0\xc5\x1dn\f\x138\x8cC\xda\n\x1cA\x80iq(\x85\xa3\xadf\xd7-\r\x1c\xacy\x91\x03\x98nY?X\xf4\xbc\xa2\xbd\xcb\xe7!\x87\xc4i\x11\xaf\x03\xb9\xc9[\xe0R.2\xc9ܺ!x\x85\xa7\xa9>\x1bXu=\r\xaa\b\xae\xdbCBL\x9b?\x19\x00>\xcc?\xb5\x11p\x15\x03\x13\xa8\x8c\xd1|\r\x13\xb86\xbe\x897>\x84\xd5\xf5\xf4휭[\xf6\xe2[\\\x8f=\x81\v\xe8\r{phq\xe46\x1c4\xe5\x1b\xbe\xf3\x1e=Qj\vy4>=m\xe5wպ\x8ci/H;\xee\b\xaa\xebi\x04\xf3:\xb7&\x17\xber\xb8\xe6\x7f\xa6\xfc\xcfb\x14\xc1b\x1c\x91\xb8\xf3\x06.p\xc29\n.\xe3\xfe\xfb\xab\xc3\xf0\x97 \x18\x1c\x84\xbf\x84Gـ\xadY\"*04\xc0\u0098\xe4\x17c\x98\xc0bt9\xbe\x1a\x90\x81\x96\x8c\x03j\xcd\x7f\x97с\xc9bdM\x0e\x90\xc45\x83\u07bc\xce{g2Igj\x0e\xce\xeb<\xa06~\x9b\x97q\x13,ƨ\xe3#\xb0\x93\x10\xf1\x11\xba\x87\xb9\x19c\x99\x11\xb6'oAAu=\xddN\x01J\x9b\xc9l1\x84%%\xfe\xacQw\xd6\xf8*\xf4P\xf3\xc1P\x96\xc8_\xae\x87$\n\xfc\xaa\xc9z):\"4\xa9+\xc8\xfc\xc7;sp-\xfeN\x8d\xce\xe2\x85L\xdb<O\x19$\xf3\xb8\xfa\xb2\t\x86!?\xd0\xf8COw\x90.'\xf7u\brbYO`\xe2 \x1a\x19-\xab\xe0P\x8d&\xfc\xef\xba\x05=\x0e\xcd\xdcC5֤\x1a\xb2\xa1\x8fC3\xf7p\"\x95\x9c\xdaG\xfa)~\xdaA\xb1\xb6{\x8d\"8\x0e\xbdtj\x98\xe3\bNC/u\x1a\xe64\x82\xa7-\xcb+\xd6H\x02\xf0]Ѡ\xce\x11CYV\xa4\xb2\xae\xad\xac\xa9\x9955\xb2\xacٴڡ\xe6H+չ\xab\xeaP),\xb2\x02&Z\xe3T8a\x93ڹV\xbf\xa6\xe2W\x18\xc1\"^+\xe8x\xad\xf0E\x90\xe2\xc0\x8fQ\x9f/\xb2\"\x829]E\xc9a\x02\x01\xa6\x1eb*\x0e\xc0\xb1\x16E\xc3\xfcF\x86qi4J\xe1\xc8*t\xc6S\xd0t\xa4*\bM\x03|\x05\x13\xaa;\x849\xd6w\r}l\xe1\x11\xa4p\x88_/`\n\x17\xf0\x04\xe7\xab\xf0\\\xcbǵ]\n'\xb5J\x96\x1a\v8ʩ\xa0\x0f\xd72\xe7DV<ǵ铡e\xbe0\x9b\x83E\x87\x9e\x9eJ\x8f\xa9#\x04\x7f:;+\x8f\xdb\x13\x9bV\x02\xeb\xcd\xfb\xa0\x12<\xb8\xb6\x93\xafE\xf2\xd4N\x96:\x00{\\l\x1e֛\xf7TM08\x19\x8dON\x9f\x9c\xc0\x01\x8eT\x18\x1c\x9f>=}\xfad\x04\a8\x14a0z6\xc4]9M\xd2ȅc,\xf5\xf6\xafa\x04\x1b\x17\xd1x4~\xf2t\xfc\\\"z::\x1d\x9d\x8e\xc7\x12\xd1\xf0\xe9x\xd4\xc2\xf3\xb70\x82\xf7.\x9e\xe1\xe8\xf9\xf1\xf1\xb1\xc23\x1a=\x1f=Wh\x9e\x9f\x0e\x8f\x87'#\a\xcf\x7f\xd8F!\x9e\x1a\x8cFO\xe0\x006hI{\x12\xc1\xe9\x90\fk(C\x9b0\x821\xff\xc4\xdc\xf7agOp^ۓ\xb9\x1a%!\xdao\aÓ\xe1\tJn\x853\xccx\xf0|\fg\xda\xe4\x14P\x13\x86\b{\x04#\xfc\x11\xc1xp\xb2}\x1a\x17sDb\x0e\xd4\x19L\xcc9-\xb1\x1b\x9cH\xe5\x98H\x95\x87\xd6C\xae\xcc\xff\xb5'\xf7 \xe41\x17\xcc\xd0\xd05@\x8a\xce\xfcf{SB\xbd˪?\xed\x10\xe1?\xb5\x11в\tG\xc5\xdc\xdc$is\t}\xd3A]\aE\xb4\xd8s\x90*\x90\xdb`nOXsx\t\xc7O\x868\xba\xf1,\xe3\xc9\xd0\x18\xfah\x98\xa4\x9cC\x9ec\x15{\x01XJ4w\x81\x16\xc4`A\xba\x87lrs8\x82v\x89\xd13\xa3\xc8\xd8\xcd\x1d\x9flA\x18\x8cO\x86Їyh!VЦ\x06э\xb5Zk\xae\xb1y\xffR\xf6\x01ɧ{\xd4\xcaW{<\x95:\x03\xfeU\xb3\xa0\x937\xd4M\xf0B\x9dT\xd4\xf0R\x1dT\x88\xee˅\n\x97 \xb9\x01\x92\x9f?R\x8bÜF\f\x8e\x96\x1c[\x8f\xec\xa8C\x82?\x84\x1a\xfa\x94Z\x1b\xebP\x1c\xf69\xf4\x15\xd1z|\xa38 '\xd0\\:F\xab\a\xb1\x85\xffA\xe1\x1f\x0f\xfd\x83z\x9e\x90l&\xdd\xc2m\x00x\x85\xbb\x8d\xc0+\xdc\tL \xd9K\xb8\x93\x9c\xb4\x95\x9f*\xa1\xca\xf2H\x9f4\xd0\xe4\xa3\xcd\xd4$~\aXVY\xb4I\x00\x12/\xf9\x02Yܹg\xfa\xde\x00\xf0!\xf8\xbe\x8d\xc0n\x9f\xf8\x8ea\x02\xb1\xf1ݹgB\xfd]]\xb7\x90\xa2\xbc\xe2\x14\x13\xa0l\xe0\xc6\xe8\bF\xa8\xc6q\xfeڐe\xff\bU:\x9f?P\x8bOq\x811\x142+f\xb9<\xe6*|-L.4wq\x88\x8d\r\xb11 \xfe\xc6!\xde\xdb\x10\xef\r\x88\xff\xf0\x89\xa3\x98\xc4\xf0\xe7\xf1`\x8c\xb3\xc2\t\xca/N8\xa3\xc1\xe9\xf1\xd3\xd1\xf1\xb3S1\x1f\rN\x9e?;=\x1e\xe1\xa4\xfb\x9eo\x10d\xc9\xfe\xe0\xf9\x93\xe7\xe3'O\xa8\xe0!\x8c\x06Ϟ>\x19\x8e\x86Ϩ N%'\xa3\xd3\xd3'\xedr8\xb5<99>\x11\x15\x0e\xc6Ó\xe1\xf8\xf4\xb9(\x863\xcf\xd3\xf1\xf8tL\x05\xbb\x04\x83\x06\x87_8\xc4\xc81LYS4\x05\xc9#\xf3\x1f\xbf\x83\x03\x14\xc4Ȱ\x1d\xc5p\x001\x1c\u0094\xe6\xea\xae\xe1d\xf4\x90s\x86\xfe\x12\x1b\xf1\xe4\xd9\xf3\xe3\xe1\xf1\t\\\xc0\x1a\x0e\xc4\xff\x9f\xf1I\xfc\x04\xfb\xfb9\x92\xf0t\xf0\xf4\xd9\xd3\xe1\xf1S\x1f~\xb9\xac\xf0\xe2\x1f\x0e\x9f=;}\x02\x17z\xbe^Gd\xe58\x0e\xe1L\xa1\x15]\xc1\xeb몃\xd6\xe0\xbeS=\xae\x94ǧt\xf8Q\xf1\x15\x03.a\xe0B\xac\x16\x0e\xa0\x823\xbe60\x0f\xab\xaaH\x9c\xe0\x9c\x84\xd0\xe7K\b/\x03k\x96s\x7f\xf6\x80\xbc\nj}>\"\\\xf7VS\xee\x7fɳ#\xab̏\xd2c\xcf^NpЭ\xb5\x89_e\x15\xc8\x1f\xbb]Դ,\xf12\xaahD*b\x9b\r\xa8]\xef\x97y\xfe\x11U\x9b\xa5\xefS{\xdc4\x95\xf0\x8a\xb1\xdc7\x144\x02\xbc^\xe5\xb9Q77)\x91WΗMSe\xd3Uc\xb9\xe7|\xf0cx\xfdf\a\x8e\xd7o\xf8\x8e\x9fn\x9b\x8a\xdd~^&qލ\xf7UY\xd4x!\xd5\xc5\\\xb3\xc6&M6o'\xa6\xd7o\xb6\xe1\xea$q'\xfeo\xfd\x1e\x8ek\xe5\xe3\xb2\xdd\xe7\f\x173k\xe9\v\x18n\xeb\x02\xbe0\xec\xe2\xc2z7\x85\xaf\xdf|>\x1a\xb7tq\a\xdd[8n\xb7E\x19\x96\x06E=\xf8u\x15\xe7\xd9lcJ\xa5\xb4\\r\x97RN$\\\x18\b\xe1\xc2\x10U8S\x1fp\xa6\xdccEY\xc7E\xb6\x85Bs\x12ά\x048k\x03k\xc1\x833+a\xfb\xc0%\x1d\xc8ҟ\xfc\xc6~v\a?\xb1\xebo\xd6ˠ\x17\\\x9c\xfd\xe7\xef\xbf\xfcR\x1f\x86h\xa9M\x8f\a\x15\xa3\x90\x04\xa2\xdc! \x04\xe6\xff\xfe\xdf\xc2^\x04\xbd\xeb^\xb8W\xcd[\xb5\x86\x80\xf1\fO\xed\x02\xde叉x/\xb3+!f[\x86\x95\xa8\xa4cdI/\xd0}\xa5w;\x19^Y\xc3?\xd2Ӗ\x1b\x88\xc9\xcf6\x1c,\xe2e\xe0\xe3\xd9k-\x8eHb!q\x98\xe7ݎG\xb6_䜦Ù\xcb\U0007deb0uV\x84DU\xc2<\xdb)j\xfec\x92\x02\x03\xba\xd8\xd2\xc05B\x82\x8d\xc4\xe8\t\x84\xe6\xfb\xacnB{,^@2\x88\xd3T\x10r\x06\x89\xd7\xe3\x13I\xb3Q\xbd&\xf9K\xa60\x81d\x80\xd73\xff\x12\xe7\xd2\x14\x1cAr\x8b\xe9S\xb802\xcf\xe4\x06\x86S\xe6\x10\vP\x19\x8e\x8a\xda\xe0\xa5\\a*6hX\xdd\x04\xc9mh\x96\x02^\x97鏝\xdc\xe2\xb8\x02\x1cpV#\x14O\xa6\xa1A\xd6\x04\x92[\xa1\xfe\xec\xd6\xf1\x9c\xb6I]\xef\xfd\x93[\x93\x12\x0f\x1d\xca\x03\xbcb\xdc\xfb;<\x7f\xf4\xb1\x94|\xd8kY\x83WPM\x1d\x11\xc1\xb2\xca\xca*k6-mA\xb0\xbeE\x06e\bqP\xf7b\xbaW\x1a\x04\u07b9$ \\5k,Dm\xe2\xba1\x7f\x86)|K\x03\xcd\xf9\xb0\x83\xf4u\a\xd9\x1dS\x9db\xf3\xce\x19\xcdj.\x9cٌ\xdd\xde\xefK\x0fw[\x1d.\x81\x9c>OY\xce\x1a\xde\xe6K,~\xe5\xed\vY\xb8\xa3\xa3yI\xd9'[1|\xda\x0emS\xaf\xbbP\x11\xb5ޣ\x9fL\xee\xec\xec*\xb7-p\xd6b\xd0\xf6\x0eK\xe3&~]\xa6,\xc0\x1f\xce:\x82\x7f\x00\xbc%\xa8\xb7o\xcf\x00\xff\xee3\xfcgY\x8e\xd7Y\x1e\xbe\xa1\xf9\x01]\x8dX-\x98\xaf\xf0췥*\xab\xe6U\xb9X\xc6U\x8c\x1b\xbaD\xfd\xb4<\x1b]w\xb3\x104 _H\xc6u\xc2\n\xbck\xef\x9f\xf2L\xb3\x82\xca\xd58\x82\x18\x0f$\xe3\x81d^\x04SL\x98\xaa\x84\xfd\x1a\x83\x1ejt\xffO\xdeS\xe0A\xc9VU{X\x95\xc5O|\xe6\xb4\xe5\xf9\xae\x8a\x97K\x86mrG\x16\xe7\x84\xc8ׅ\xac\xed\x19y\xb1}/\xea\x16\xa4\x88\x12\xea\xc7\xe0\xbf\x19\xd3J\xc7(\xb6\x8f\x82\r\x9a\xbfLS\x83`\x95!P\a\xd6\f\x8d\xcd)\xbd.u\xa6G\x9bN\x8d\xab\xeb\x1a\xbdlĺAr\xfe\xbcӋO2Y\f}\xbe\xaaA,\xf7q\xa9+\xbb\x8e\xc1Ų\xafL\x99\xe8\f\x8e\xdb\xf4}\x94Ee_\xf2K\x87Ɔ^\x9a#\xd3\xd4\xd73\x96\xb2iw\x13L\x94\xf0\xa8u\xaf\xc8{k\xdc\xdbq\xed\xecb\xbd\xdb{\xfb\x16\x95\xce!\xf0\xba2l\x04ގ̌\x9b\x1d\xda\xf6./e\xf0\xbb\xa3\x04\xa8ϔ\U0006243d\x92\x94u\xc3\x05\x97\t8S<\xd8>@0X\x832\x06!\xb7ЋY\x8d\ry\x01\xf7\x9d\xf0\x0f\x81\x89\xb0\x06\xc9E7\xbc\x83\x17\xb08\x87w\x87\x87a\xfb\xde.\xc1\xaa2\x97\xef\xae,\x8fUJU\xb7S\xb0_ρ\xb6\x0f琙\xe88CD\xbfS!\xbc\xaf\xaa\x88\x15b\x96E\xf0.\xf4\r\x96{X\xb1X\xa1\xd5nV\x16\x9d\xc63\x05\x11y\xcawX\xd1\x14T\xa7\xb3[f\xd8\xed\"\xc8\xd2\b\x9al\xc1:\x890,x\xba\xb8[9\xf6Bs\xc7XQ[W\xe7\"`ڽV],\xecQx\x11\xdcƲ\"\xc5\xcbB,\xae\x19L\xec\x1a\xbe\x89k\xa1\"\x84$d)L K\xad4$\x1c&D\xbf\x9d\x8e\xa4\xb4o\xeaQ\xb2\xbd\xf1\xd9z\xf7\x8e\xb7H\xdf\xdb3\xf5\xb1\xa8B-\x149\xa8\xb5)\x12+\v\x9e\xa3/\xb6q*\x9cc7-6ʣW\xb4E0G5ų{\xf3̒\x02-S\\\x04\xc9\xe5\xed+\x15\x9eL\x9e\x85\b/4lz\xbc\xe5\x8a\xe56\xd2ɝ\\\x91nO\x91\xf7\xe8\bK2H\x97p\x8d+eӐD}Sӭn7\xd9\xe91IT\xa5\xc3\n0ڢ\xa5\xdd+\xa0\x96ns\xf6ڤ.Tik\xb3\x12WM\x1b\xbfd\aF\x81\x19\xc4\t\x85\x14y\tY\xaaX\x81\x17\x0f\x02k\xafj\x82\xea\x11\xc2\xff\x13\xc27+\xabo\x90HE\x1c\x85)hm\xad\xad=\xb7Z_\x13\xa7yk\xe8:\x9d[B\xd4\xc2R\xe3b\x9fE\xa0\x9eX\xed\xa0\x06\xba\xb3\x88\x19\xad\x8a\xdc\r\xf9\xe3&Kn\x14\xcbB\xdd]\x98\x1e\xa1\xba\xc7O\xab\x98\xbc\x94Ӟ덮\xb0\xd0n\xed\x89Ǔ\xc9\xf6\xbe =\b\x13\x90\xe8\xa0\x0f)\xcb1r\xc1\x11\xa4+\x1e\xf4&\x02\x06\x13\x1a\x8dA\x13\xf2)J2\xd041Y\x06\xaf\x82O\xd36\xe3E\xa9\xcb~\xbf\xb82\x99g\xb3\xe0\x83\xd3\x1e~\xe5\xcf\xc5\xd5n\nأ\ueef4%\\\xb2\xf7X\x91n\xed;\x1f\xa6\xa1\r\xd0\xee'\x93\xf0\x0f\xbe\xe1S.\x83vg=\xee\xf7\xa9\xbf(\x98\x92\xda\xed\x89e\xa5\xa1B\xde\xee+$jbc\xa9\xb8)N\xfd)ת\xf4\x11\xa9\xaeUɪ\xab\x91\x1a\x94\a\xb1\xac\xa0\\\xfc't\xe8\xc1K\x0e\x81\x87N\x114K\xfe\xc7\xe5\x10cM\x19\x89\xd4\xd83\x18j\xf2\x85s\\\xc7r\xfa\xf0P\xf3\xc8\xda\x11\xc4\x1b<)\x94\xa2{\xe1\xea\xa83=\xe2(G\xf0\xc2\x19vr|\x7fpG\xe4\xce\x05\x92\xa3\xe2\x03w\x99H\x06\xe3\xd4](|\x97v/ toYY_\xb7z\xcd\xce\x16\xe9rRpe\x97\x96\xf6Y\xea\xc9\xfeFL\xb1\b@\x03\\]\vs\xa7\v\xbe\xaf\xd8:Y\xb4I\xb6\xa4\xee\xdc\v\xd6!\x88\x12X\xb2T\x8fWS(#[b\xac\xc5\ue1f0\x8b\x1d\xdb8\xa1\xd7\x1f\xde\xd6\x18\r\xe9j\x86݂]QVp\xa4\x92\xbd*%\xe2]SI\x8c\xa6\xe7^\x0fw\xf8\x12\xba{\xf3B\xd9\xe2\xaeƔ\x1fj\xb5\xfc\x13\xf8J\x13\xb3\xbb\xafxxOjh\x18\xbd\xad\x1b\xb6\xb4\xaf\xfe\xf1\xb1\x86\x1dq\a\x13\xf8:n\xb0g\xee\x820\x82f\x04\x13]\xf2\xd7\x15\x93v31E4\x86N\x17h\xa8o\xef\xa0\x0f\xcdh\xd0̙\x15BB\x82\xbc\x9c`\xae\x98\xa4\x9a\xd1`\x96\xaf\xea9P\xa2\xda\xf9H\r \xcb\x13)\xcdhP\xb0u\xe3\xeeB\xcd\x01G\x94\x12\xc2 \x84>\xd2b\xb8\xe2\x12\xdcK\x18\x9f\xd8\xeb\xc0\xac\xfe6+\xb2\x86q\x00k\xb1\x91\xe4,\xae~\xce\x16\xac\\5\x81\xc2\xdf\xf0\x04Ӷ\xe1d\xc1\x04jִ\n\"\xf3\x85\x82h9N+ \xea\xd3\xdb8\xd7S\x96\xe3x\xeb\x83\x1c\x9d\xbb\x99\x14\xa2߮:\xec\x0e\xcfd3NKG3\x04yr\xe2\x91\x06\nx_\xc0\x04\xbe+f\xc8\xc2M\xa7x\xd02@t\xb5NUF!\x85v\xba\xf9.\xbd4\x04a\x90\xa5W\x9a\xcd\\\n\xd0\xf9\xae\x19\x92(h\xa1\x803\x8d\x84hs\xe5\xa5\xc5F\x90\xc4+\xafp\xfc\x8e\xa4\xe4¡\x96R\x87\x82\x80\xb8ҌB\x1b}{g.G\x80\xcb\xedE\xb9\xaaُeV4\x01\x06u\x8f32\xdfY\xc7m\xf5\xed5L@\xe5\x0eʻ\x82Uo\xfe\xf2o\"\x8c\x1fN\xde*S\x8bx}{=H*\x167\xec\xcd_\xfe\x8d*\xb0M~\x14\xd9\x17&І\v\xac\xad\xa6\xa4\xf1\xedtu}r2|vL>\x8f_|\x01\xc1]V\xa4\xe5ݠN\xaa2\xcf\xff\x8adX)\x7f\xb3\x86\x0foDz<\x10~0*\x1e!\xc6\v\x0eq\xa7Ǌ4\xe8շ\u05fd\x90\x9ff\x04=\x19\xdf\x107\xeb\xf1\xb4.\xf3U\xc3tnS.{\x11\f\xd5w\xcef\r%\x9c[매Y\xf0V^\x0e\xaf.\x87W\xb8\x9f~\x93T\x8c\x15\xaf~\xfe!\xb0ǭ\xd3\xce\t<\x0e\x92\x06\xef@\"\x87\x9b\xc5\xc0\\\xda\"\xdb\xc4~\xdb\x1br\xa6\x85\xced\x06\xf1~\xb0\xa6\xdb\xd4\x18\x1d\xf5\xaf\xe7N\xd6Fe\xfd\xadSdM$I\x9e\xb1\xa2\xe9@\xc33\xff\xe6R)\x05\x80\x83.\xe2\xa6\xca\xd6\xeaz\x9f\x16F\x87a\x83\xac\x10\xc1P\xdc-\xed\xa5\xa4(R\xb5_\xb9\xfa\xb9bIc\xc9\xf25k\xbeBo\xb1\xac\xb8~Ed\xfe\x84\xc2a\xaf\xd9.u\x03\xa1O(\x06\xd8\xd7\xd07\xf0\xf0|\x8c\x8a\x19\xe9\x16K\xe8\xa6\\z\x801\xe6\xa6\xf7\xbehQ\x96\xde\xf8lt\xfb\xf5\x1b\x8c\xf6\xdb\x04\xe2\xa5\x14s\x94\xe2\xa2\x14E\x9cr\xe8\xe6-n\x13t\n\xffc8\xab\xdb\xf76y\xf9\x17\xbc\xd0\x05\\\x82X\xe5\xd2\xf7\x15\x9cQJ\xb9\x8c\x04\xa0\x97r\xa2\xf0'\f\xd4\x15\xd0O7\xe6\x17\xa6\r(\x90\x17o\x06\\\xb4\xd3\x02\xbeԶZk\x00\x05\x1d.y\b\xf1\xb6\xc0`M\xbc\x9d\x11\xe0\x87\xb5x\x1er\xabh6R<1\x98\x11\xc1z\xa8y\x95!\xfb\xd6##\x01o9\xa7k\xad\xde\xd6#x\x01kcW\x9a\xe2H\xc8\x10?\xe2\xc9F\xb2\xa2\xf5\xb9\t\xb0\x1e\x8a\x8a\xd6#Y\x81uކ\xa8\x91p\x98\x10\xfdXM\x1f\xab1\xeaQ\x14\n\x18\x11hi\xad\x15\x8f\xa6Y\x82$,˃\xf5\xc8w\x04ˁ\xb7\xf3T\x05rh;[v\x17\xe4\xc1\xf9UwP\xefE`,\x13#\xa0\xf0=\xad3\xa2\x8a\x11\x02爈\xa33\xe7H\xab\x0fE\x05\xe2+ĵ\x15\\hb0R\x8d\xc0\xa0\xa5\xeb\xed4\xe3i\x11X7sa\xc2)\xe3\bڗvᬕ\xce\uf3e8\x93\x11z\t\x87N+|<\xb0JZ,\xd13_a!\x10\x05%\x1e\a\x81}\x11\xdaՋ\xd4T\xbfˀ\x1dEO\x95\xe0\xe4\a\xeb\xd6e\x06\x9e\x11q\xeax&\x1f\x9a\xa4\x94\x1b\xd3ڹia\xa5B\xc1&\xb4\x8c\x8e\xbc8o\x97Y|\xbd\xaf\x8dW\x8b\xaf\x96|\x1c^\xe4\xe0\xc4{\xc5e\x88\x92/\x0f%\xc4\xe8\a\x11B%UM\x02\xcd\xfa\xbeUS\xc0\xea\x8e\xfa\xdbZ4X\x87f\xcf\a\xb6 \x10._M\\\xb8\x1f\xd2H*\xa9\xb6\xd3\x02\xcd}\x1ai\x8f\xb2\xfb\x13\xe0\\\xf8\xe7C\xc5Dy\x1fb\xd0\xf4Y\x9bd,\xbc\xa6nC\x9b\xfd\x8c%\xd4p^t!\xfd\x96_\xfa\xbd/f^l;z19x\xdc\x13\xfc\xb3\xa0S\xcdk\x9c\x14\xef\xc1\xa2\xa4\\n\xfc\xd5\xf9\x9b\xb1\x87\xba\xf7\x05n\xb1H\xd86\x9d\xfc\xc4#\xb6RR$\xb4c\xdb.Qy\xa0\"\xe8\x11M=\xf9\x83\x86\a~\x194\xe2'Q\xd9\xdbI\xc9k\xe2\xb2\x12\xdct\xed\r\xaeǯ\"\xa8 \x88i;\n\xe2ȹG\xb9ƽ\x8d:\xe4ũ\xfd\xcc;N\xec+\x0fb\r\x00G\x90\xf2\x8b7\xebVL=\\\x03샊\xaf\x15|\x98\xb6\xf8\x828\x92\xcc׀Z\x90M#\x0f_\xf9M:V\xb4\xfc\x9d?\x98\b8\x8c4җ\xbf\xf9\x9a\x96-\xbd\x9cv\xc2M\x12\x96#X\xd8\xfc\x0e#`U\x05\x13X\xc0\x11\xaf\xe8\x800\xeau\x1d\xab\xf8\x85\x91\xd1iH9\"p\xac\xf6g\x94\x00\xc7\x06\xc0\xa9'\xff\xa9\x91/\xaeҩf\xc0\xc4`\xb5N=\xa2\x02\xa1E\x93f\xc3\xc4l\xa7N\xb6\v\xc1\xa1\xa8\x14\x06\xa7\x16\x821\"\xd0hE\x87\xf3\xcc}z\xb4n\xf7\xa61\xdeh\xe9\xa5OK\xf7\x90\x88p\x9fJ[\n\xb1U3\x8f\x04\x10\xf4\xa2\x81\x1b\xe4\xa3\uf4ca=\b\x13\x81+\x94\xcc\xd0\r\xb0QHn\xe8\xb3mjA.&\xef\xb1\xda3\x86\xc5\n&6d`l\xe4\xc4O\x8al\x91\xc1\xc4\xc4\xc1\x97\x86\x04\xc5\x7f\x8d\xae\xc2}\xa3\xc4d\xc1*Xo\x8d\x03\xe3.\x9e\x1f\u07b6Kᖂ\x7f\x85\xa3\xcb\xcdދ\xf9\xbe4*\x92e\x833\xe3\x06\xc3_)\x1e\xb9\xfb#\xbd\xc5\xe3\x11|C\x1dD\xd5]\xa3\xf1\xaa:\xc0>\xd8\x01\xde\xde\xe1\xd06\"0\xad\xf8\xa9\xaf\xb7\xe3\xde\xd1\x1e[u\xde;#\xf6HƋ\xb5\xbbQ\x96\x11_W\xa1EƖ\x1e\x95\xfeC\xe9\xf1`\x9a\xd5\xdc\xc0\xc5{\n\xaf\xd7QD'\xc5D\xdd\xff\x97ﮂ\x15\xfe\xb3\x8f\x14\xe0\xf8\x91Si^^\xb7]\xa4;v\x13Brx\f\xe0֖bI\xa6\xfe\xbc\xbcFm\xbe}K\xd1F\x8d\x13\x00G/\xc0\x9d\x86|\x82\xfd\x85@\xcf1\x04\xfc\xdeĲ\xbcS]\x99\x97\xd70\x815\xaao~\x17\xdb\xe4Wan6\xf3\xf2Z-\x9d=\xad\x06\xa7*\xbe\x83AFo\xdb\xd0\xed\xb74\xb41\xdb\vE\xa7\x81\x91w\xe7\xbf7\r\xad%\xb5-\xa2]\x93\xbfC\x04\x1e\xf8\bD\x97\x96\x1f(/\x8f^\x8c\xd5F\x1d\x96\x84\xae\xfbe\xe6\x9d+)d\xd0;\xdf\xd4KzuE\xa6\xc8;\v\xfa\xd6NS\xaaU{G\\\x93\x13\x91\xd5\xe7&5\xc0\xdb!ނ(\xef\x82,4P\b'>\xf2ă\x17\xf0\xee\x1cB\xe39\x0e\x98\xc0\xf3s\xb8A߇s\xb8\xe9\xf7\xc36.8\x80\x1b\xcb\xeb\xd31\xd1\xea\n8z\xf2\xf8\xb3j\x18a\r/h\x85ssx\xb8\xbb\x8a\xed\r2\\\x15\xb0\x12rC<\xe7%.3\x1c +\xe9u\xe8@\xbe\x83\x89@,]\x1ey!\xae\x0f\xe1%ܞû~\xdf*(%\x84\x97\x13\x81\xda\xdb\xfe\x89\xfa\x04\x04\xe1\xf6ް\x15\x91\x882\xb4\x8f\xa3\xd6L\x167\x05\x81\xe3\xdc\x11\xea~\xa4\x14\xcd\xcc\x02\xb7\xe2\x02\xe2\x8af@\xaf\x05\x1c\x99\xc3,\b\xa5\xb2\xe2\xb1ʽ\xd2\b\x17\x100\xbag\xc6\xfa\xa3\xb1\xb9V\xa6\x00\xe6\x98e\xe6\xe0\xb0\xc0E\xf2yG\x80Ѯ\x80\xdf(\n3\xd2\xf1)\xae\x94pL\xe2L\t\x17\xa2]\x01yP\xf0\b\xed\xd0\n\xdfyｦ\x9a\x8a\xa8\x10j-T\x94ސ\xa0{\xec\x1f\xb7\xcey\xcb\xd6=v#\xf6\xbd\x0e\xbfao\xec\xb6b,Z\x18\xfb\x06ʗ\ne\x7f_\x9cz\x1a\x8c\x80\xad\x97e\xc1\xf4a۾\xd3\xf3\xb2\xbc[v\xcc\xcfKS\xb0\x97\xe5ݏ\xa4\x11E5x\xd8r7\xf5@\x8c\xe0H\x13\xf3\x80\x89}\xfa\x0f\x9a٧\xe1\xb6\xf9\x18\xd9\xf1\xf0\xc9pO\xfb\x92ٴ \xec0\x04}\xbc\x9di\xafj\xba\x17\x15\x969һ\xb4p+\xf0٢|uJ\xb1yP\xb7\xca¦&URbSd\xacƶ\xc98L`m\xc2\xee)\xed\x1d\x1c\xa2?\x1fgj3\x96\xbdR\xfd9u\x7fJ\x1d(\xb9\xd1uA\xaa=z\xa5^\xec++M\x7fM>\x05gV\xd4\x0f\xb6{\xcfQV)\xdec\xb1\xb7\x9d\xd5\u07ba\x8do\xa3\x82\x80n\x7f\x90'\xfb\x9a\x02*\xf3otJ_\xcb\xfd\x19_ѬÐ\xdb\xe4\xe0_\xad-\xe8UǕK\xb6\xac\x03uNʖ\x9e.\x13\x86zk{\xcbu\x8db`\xe6\x99S\t\xa96\xe6d-\xffB}\xac\x89mQ\x1br\xfa\xf3U\\\xa4\x9f\xf7x\xc5X\x95\x8b\x9b\xc8\xee\x8bA\xed\x97\xd4\xd6js\xbfζ=t\xf4\x98\xf7\xce<\xae\x83uF{\xab\f\xc3G\x1b}\x969\x9d\x96\xf95\xf2%\x97\x96A#C\x90\v\x89\x17\xc9\xf1\xdf\xf5\xfcG\xf6\x8b\xe9\xfd\xcb\t\xb1\x9c\\\x9b3e\xa6Vi\xf1\x99\xbe~`/\x9fv\xce@\x84\x8a\x9cj\xacyh\x1dɇ\xbb\xf7Y\xdd\nP\x93r\xd3\xe1`m\xfa\x1a\xac)\x1c\xb80\xd2\x065\xf7| H\\\xcb\x04j9;\x8a\xdaG\xf0\xb8p\x94d\xb9\x8c\xe4C\xcd.\xf2\x82\x8ex\x03=T\xca%V2\xa6\xdb\xc0\xd6\xf0\x91-8\x82\xb1\x18\xa8\x0f\xee\x17\xce\xccO\xd8;XyG\xe7DP\xae\xf0\xae\xd3GuU\a\xf0qh!\xa7P\x87\xa9\xbeH*\xdde\xc8\x18&\xfa\x15^\xa8\xbe\x96=/\x01\xfa`\xcb\x00y\xbeP\xd66ip%@R\x7fHA謶\xfb\xe5\xc1\xe9f\xb3\x84\xd3\xcf\xc8\x05AQ(4\xbb\xdf (dA`\fF\x9a\xacp\x1f\xf1\xa0\xde\xfc\x84\xd2A\x87T\xff\x8f\x11\x11\xc3h\xf3Q\xd2\u008fy莴\x89\x06\xfa\x9dX\xecC\x97.93\x8e\xf48~|u\xe8#%\xcd\xc0\xe9\x13\xba\xbd\xa4NK\xc9'VL[ף\xceZ\xa3\x8d\xe1\x1bi\xed۽\xa6堁\x9c\x9a\xd1\xfc\xf6QK䎅\xa3ouܵ<\xf7\xafH\x7f]\xc5E\x93\xe5\xccƼ\xa7\x0fӍ\xbe\x97\xeb\x9cw\xfc\xaa\x0e\"\xec[Pͼb\xf5\xbc\xccS\xdb\x16\xaa\xd6Mh\xbc\xfb54\xc0.o\xb8Ɍ\x8e\x02Z\xc4\xde\xc0\x11\xfc\xfa\x11\x0eB\xdcG\x9d\x9e\x16\x85\t\x1c\xaeu0\xf4\xd7\xf1\xebs\xdf\xe2[\x1fHh\x1a1VQ\xcb5Sg\x7fn\xcf \x11\xf8a\xabMK<\xa0\x9a\x1a\x17{\x06uI\xcfs\xe8\x98\v\xffE\x1c\x8b\xa4\x14\xd4[\x87\x8e\xcb\xff\a\x0e\xbc\x8e\xf1\xf1@\xa7\x0f\x8e\xed=\v\xc8or\xd45ڶ\xef\xff\xbc\x8fad\x96\xdb\x00\xbe\x84\xc3C\\\xa2\xafe\x18\xfa\xf7{\xedAM\xef\x02\x99\xe3\x16'*鳩f\x04\x17\xa6}\x12\xd7\x1a\x82\xa4-\xd6\xea\xa9ԏ\x1e\f\x97\xdc\xf3t\x04J\x85\x90\x17\xea\xe1Z=\xea\x02@\uea07\xeb\xcbu\xdbG\xf8\x9f]\xc4\xef+\x9f-\x89z\xa0|\xaaA\xb3c:\xd8.\xa0\x9eC[[\x02\xbb\xba\xff\xedG\xea·\xf7Y\x0f<\xa8bo\xcf\xee[\xed\xfe\xbd\xda\xd5\x0f\x9d\xf3}w\x97\xeaW\xd0-\x17{\x05\xa8\xf2۽yh\xbbr\v@m\xf0\x96)\xe7v\xbe\xea\x0e\x95\xf2\xf0\x91\xb4\xd5\x1dV\xe2w\xe7-\x9b\xae\x0f\x0ey\x9f\xd8K\xd2\xc2\xfbY\x1c%U\r\xfbˏ\xdb\xe9>\xb91\xb9\xd4\x12\x9d\xdb\xeb\xb7q\x95|W\x14\xac\xfa)N\xb3U\xedy[=ӹ[p\xfcy\xd5H(\x0f\x8er\xd5\xec\x81\xe3\r\xeeW\xbe,\xaes\xe6AQ\xab\xcc-\x18\xbe)Ү\xf2\xacH\xb7\x96\xc6n\n\x96U\xf9Ύ\xef\xa2\xc0(ߌ\xe4e\xe4\xd5\xecza?\x9e\x0e2M\x9c9\xf7~\xe8Y~FFM\x01]\xfb\xa9\xc3\b\x1aV\xd4Xu\xeb(\x98\xecV\x02\x9fpFZ\x96\x99\xfe0\xed\x95H\xa2Z\x9f\xa7\x11\xccD\x80s\"\x16\x9d-\xf1\xd8uc\xa7m:cu\xdaqvR6\xcb0䁊\x9cį\xacc\x95\x97\xd9U;\xce\x04'\x92s\xe0\x12\x0egk\xab$\xc2Gp8۴R\xe1\xaa\xed\x1e\x80\xf5\v|Ry\x98U\xa9.8o\xd5omAځ\xa3<\x88[Ȥ\x0e\x96}\xca\x01\xe1B\xa7\xf0'\xae{!\x9c\x19\x8f\xba\x7fp\xdfR\x91\x82\xf6W\xf5(\x8aL\xf9[\x04\x82\xbb\xf2f\xfb\xca\xf6L\xb3\xc1\xbf\x17\xe7\xa6\x06\xc0\x7fg\x1b\xdb\xc7n@\x01C\x84P\x01\xfavr\xaa\xb0<]\x80\xbb\xff\xbc\xa8\x167k\xcf|\x88x-\rD\x15m\x1eT\x91\xba)\xbfٻ\";\a,4xY5\x01)T\xfbU\xddq=\xe0\xed\x03\xae\a\xfcw\xb6\xb1\"\x16\xf1\xc0?o\x9d\xa0?\xe1֎'\xbaŨq\xc1\x82N\x91\xfaNe\x94\x15\x8f\x9f\xf4\x96N\x9dZR\x17\x0en4\x91[\xf9\xa2e\xef\xfe<\x11ee=\x1a\xd5\xce.qһ\x94\xfd_ۓ\x84\xd8Ut\x95\xf8\x9b\xa7\xc4hk\x89綾\x02ix\xbb,O\x14\x8a\xe3\xfb^\xb8\x1bϫ\xbc\xc4\xf0\xd0^lݕ\x1eB\xef?zې\xbfi\xd8\xf2+6++栖Ga\xc2\xf6c)\xc9\b\x96*\x89L\xa7˸\x99\xa3\xa6\x85%}\xf6\xa2^\x04K\xb4\xad^\xf9_\x1b^ґ*͍\x7f\xe9E\x10\x18貫\x90\xcecz\x7f\"\x1cC\xc75\x98JJ}\xbb\xaba_\xce\xd0h\xf2\x8fiן<\xed\"\x1c\x7f\x118\x1eخW17\x13\xfey\xc9\xe4\xeaA/\x1e\xbcB\xa6\x8c\xe3'pabrD\xe5LR\x8a\x0f\xa5\x9ap\x7fb\xd5\"k\xa4|\b\xaf\xb5Q\xe4\xa0\xc7ð\xc8G\xe8ϸ1(\x9a\xbaE\xec^ʹ\xc4~߆\x1e\xef\xd7\xd0aGC\x03s\xe9\xa2`C\xd9\xe2\x1dͼ\x94\xe8]\xfe\x8c\xaf\xe0j\x90\x94E\x127\xaa=\x97\x06ӯ\xc2{\xf2\xc6\xe5J\x04\tq\xeb\xf3rGV\xfaɺ\xdaE\xdc\b,fXY\x99\xa6[1\xc2\xe9\xc9n\xda\xe3\t\xb8p_|\xb1\x1b\xe6\x10\xc6\xde]\x96\x97?\xee\xda\xee\xd7U\x9c\xc2dg%J\x95\xf4p\xf4\x0f\x1d-\xa3?Q\xf3\xf1\xf0\x18\xa2<\x014\x98\x80\xaf\x95f:V\tr\x05+פS\r\x87\x13\xe8\xfd\x1fx\xaf$@\x95\x05}h\xc4\xeb\xdbc\xfe^\xcd!*1\x9e=\x12\xd9#O\xf6\x92w\xbf\xfc\xd065\x93v#5\x03uc\xe8Cg\xa7\xbd4\xa3\x985f#\rL\x1a\xfd23\xf0\x1f\x1e\x9e\xbb\xcd|\xc5\xdb1\xe4\xa4R;\xcd\xf6\r\xb9\"\xa3\x06Z\xed\xe6lq\xa0\x057,\xd8N&(wd\xdelrUv\x1aKN\xc3\x11\x11nE\xb1\xf06\xcfᆙ\xa1Z\xfb\xe6\x93Q\xef\xbe\x1dkK\x116+\xef\xe8\x05\x8f\x80\x1d\x12-]\xf2uH4\xb5ss\x8b\xb8\\S\xf7\xc1\x9d\x13\xf7у\x9djǌ\xc4#`Ħ9\x86\t?\xb3T\xa0\xe4g\xb1\xc4A6rF\xe7\xd8\x1e\x9e4\x06=\x8b\a\xff\x8a\xe07k\xdc(\x1b:\xafd\xac>\xc7\xe6BA\xa6*\x91\x12{\xe8\x18\x0e X\x8e\xb9\b\x90܇\x91J\x1c\x89D\x14\x81+\xdf\x15\x1c\x89m\x1bG\xbf\x8a\xeb\xacv\x16L\xad-2?f\xdfOQ\xcaA2\xf2\xaf\xb62\x9b\xd5d\xdf_f\xf4{#~#˗k\x98\xc8\xe3\x00\xf1\xff\x81YvDk+@\xc8\rAn\x86\x91\xfa\x7f\xc2\x01\xe6bn=\x14K\xb9\xcdP.\xe4\\\x1e|\xc5\xdeg\xb8t\x8c\x89L\xf4]\u0604;:8\xf3u\xe1r=\xa8\xe7\xd9̰&,\xd7b]\x91\xe9\xb5-\xc0rӂ\xdb(8\xe3\x0e\xc7\xfet\x8a\xf1m\xbc\tcQnLy\xffh\x1a\xef\xbb\x02&\xa4\xc6\xf2w\x9b\xa0\x9e\xdcGP\xa5\x808F\xb5\x96\xd0*\x81\x1c\x1a\"7\xf4\xee\t\x8e\xf7\x10\x10?\xab[\x8c\xe5\xcc\xd2;\f\xa3A_\x97\xcdI\xe0g\xfb1\x12\xab\x15\xef\xfe\x856\xd2\x12\xd9\xefg\xff\x15%\xffAR\xe5\xdddK\xd1\xd8*\x15\v\x98@\x81\xcf\xff\x99\x02\xa2\xa4\xc3'\x1a'\x1d\x1c\x84\x7f\x85\xe2\xa3\xc4\x03+\x84\xfbH\x87І\xf7\x91\r\xb8\xda&\x1c\x8b=\x9b\xf6_K@VE\x9a\xb3mk\x8c\x96Xؗ\x80\v\xe7=\x14k\x17`\xccy*\x89\xc7o\xd2I\xc5\x15\x9f\xfaq\xfeJ7V:\xcd\xfe8\xdbI\x11]F\xd0\xf8\xec\xfa\x13۰\xbf\xf4\r]\xbe\x1c\xcd\xe0\b\n\x9dD+\xb6\x89l6\x1c\xc8\xf5\xa5\xbd\x8aB\x8f\x04\xbc~\xdeP\x14\x86\xd0,>r\x8b\x8f\xbc\xc57\xaa\xf8f[\xd4\xfe\xaeE˶\x1e$\xa9\xf6\xbcZ\x1a\xf3\x15\xec\x947(\xe6+\xd6)\xa7/\xbe\x1c\xf3\xaf1\xff:\xe6_\xc7W;u\x89%\x7f\xeb\bTt!\xc3H\xf4j\xefa\x87\xa1\xb7\xee7PG\x11l\xeeWb|\xef:\xc6\xf7\xae\xe3\xf8\xdeu\x1cc\x1d\xdbM}y\xb9d\x01_\xbb;]\x1b,G\xd6\xca\xf8\x88R\x8c\x05\xf46\xbc\xfc\xaa\xee\xd7\xd9l\xc6*V$\xac\xee6%\xbe\xf3\r}>+\xd0,\xe0\xee\xf8G\xf6\x9e\"\x85\t,\xf8\x00\xebj\x97G\xcd\x1a\xa1\x87\x17xWu\x02A\x8aC*\xf5\xa3\xa1\r\x88]w\x06\x870\xba\xc2\v$G\xf6\xf6]\xe0K-\x1b\xe5b\x1b\xb3~(\x8b\xb2)\v\xe6lƶ\xec\xc1R\xfe\x840=־\x80\xc9^\x9c\xd7\n\xee]\xb7\xb2\xedb\x92\x9f/R\xfbE\x0eS̓\x18\xfe\xa0\xf1\x94N\xb7_\xe0\xe5\xd3'\x1a\xabb\xd6B\x944ݔ[כc\x0ex\x05G`\x84f\x9f\x9aŭ\x1cd\x96\xf5D\xb2}\x9b\xbb\x86\x97\xf0\xdc9\f\xc5V\xc2\x01\x1cÑ\xf1\xccrm\x9d\x8d\n\x8akD\xec\xa4\xcb&\xd4ve\x1f|ƃ\x8e\xcd\xc5\xc4d:\x92#\x99\xac<\xd9\xdea?\x1e\xc2(\x143\x9a\x95Ͻ\xde2\xe8\x8b|\x1a\xb5O\xe4C\xf0D\xfa\x01\xfd\xd1g\xe5\xeeN\xb9F\xe3\xe00\x92\xc0\xfc\xf3\xe1\xdbb)\xdb[\xcfr>\xa3}\xb5klm\xd5^\xe8q\x11\xe7\x0e\xc9*\x8a\xed\xd6\xc5l\x15A\xdc\xeeVk\xcdo\x06B5\xd7\x0e\x95L4\x9c\xf4b\x95f\x1e-\xa0\xdf\xc8lV\xb3\xe6\xdc\xc4ɕ`\x05\a\xfa!\xfb8\xb4!F\x16\x04>d\x1f{\x17vDZ\xb7\xbf\b\x8b\xb7y|P\xfeg\xf4\xf8\x18\x19\xc6\xfe\b\xbe\xb7\xa0\x7f\x12\xbe\xf7\xadBC\xed\x96o\x15\xef\xfdGo\x7f\xb7\x91\xa1\xf55\xdaˋd踑\fɏ\xc4I\xddP*ydb\x81\t\x06\f\x85\v\x9f+\x13\xb4\\\n>\xf0\xa8#\xaa\x82\x11U\x80\xa86\x1c\xd5f\x17\xaaM\a\xaa\xcd(\xe4˯\xcf\xe9\xe52\x94\x8a\x87\\\xccg\xeb\xa1\xc7\xd7eCY\x9b\xe1v\x87\x17\x89qd8Ό\xfc\x9e3\xa3{\xba\xce\f\xef\xeb;3t\x9cg\xc0\x92\x99}\x9cj\x86\x9fӫf\xd8r\xabY\x8fZI\x1b\x11Aw3\xfa;\xb9ܴǱ\r\x15\xc1\xf7x\f\xf4}\xcf睃Z\xe7\xa1\xde9#\xcbKz=\xf2xK z\xcb[\x82\xd77|X\x85C\xab\xc2=\xeb\x1a}l\xe3\xf6o\xd7\x03\x9d\x8fTU$:\x9b{\xd4\xf70>n\x86V\x85{\xd65\xfa\xd8\xc6\xed߮\xbf\x8bo\x95\xbf\xea\xff\a\xfbV\xed\xd2#r)\xc0c\x03\xb4\"\xcc~\xef@s\xcf\x00\xb8\x80\xde\x0f=\x8cj\xf3}o\xaf\x0e\xf8\xbb8q\xb5\xaav\xd2}\xeb\xb7d^V\xe9\x9brU%^\x7fa\xca\xd8Z\xf6\xe7\xb8®h\x97m(ck\xd9NW\xe7j\xab\x973\xa7\xf9\xe3\xfc\x9c\t\xc7Gx:\xa7Y|]\x16q\xfe\xa3^[\xbaH.!\xc5\a\tR\xf9\x18\xc164|\x93a k/\xa9\xbb\x1f'\xa60'0\x01]\xa6\xe3i\xe8\b\xe8\xe9`2\x9d\xc4\xf4k\xebFB\xb5\xc3\xd9FD\xee\xaeA=\xb6\xd0\xd5\xccz\xb3\x98\x96\xf9\x1b\xbc{\xe34\xe7\xc9\xc9\xf62?o\x96\xad2\xbd$\xab\x92\x9c\xf5\xb6\x97|E@A\x9d\xbd\xb7\x02R\xaa0\xf2|;\x9f\xbdgr\x7f\xff\xe3w\xb6+Y\xef\x87!\x9d\xf5Tx\xea\xf3\xa5\xfa\xa5\xd3`\b\xa3h\x04\x1c\xaa\xbf\x1fX\xb5ݕ0^g\xf5_\xcd\xe7O\xd5\xed\x0f\x956\x88\x9b\xa6\nz\x8d|.\xa3\x17yCt\xc9F\x10\x1c흰\xfa5\x8f\xd1Ջ\x86a\xcf|ު\x8b\x96\xbf\x99\xb4l>\x1d-\x9c\x19\x1bA\xcd~\xb4\xbcYM\xd3\xec6K\x99\f\xe6A\xb7S\x8cа\xf5j\xea\x86\x0eDͺ@\xef%3\u009c\xed@\xb3-6\xa1\x1d3(\x8cT\x15\xf6F\xcfD\xce\xf79\xf42c-\xdcI\xe8'\xb7\xbf\x1c\x1e.\"x\x17\xc1\xed\xee]\x94\n\x8e\xb78\x87~\xff\x1d\x8f\x05\xd8~\xf8/\xb8\xc5\xfd\x90\x8a\xb3ׇwp\x00i\b/':\xe6\xb0]J3J\xbc[\x19n\x7f|\xf0\x83\xe9\xad\xd3\xefg\"\x88\xeb9PLT \xf6:T\x1cJ*^\xe8 \xc66\xf1]$x\x8e($\xa8O6\xa6l\x1e\xdffe\xf5\xf6}Y.\xbefy\x13\a\xd6+\xea-\x90\xec֊\x9a\xeefZ\xef\x04\xf5\xf0}\xa0\x9e~ (\xcdn\xf5\x13@\xb7Y\x9da\xd8\xdff\x83\x81\xc3\xe7Y\x9a\xb2\xa2\xfb\x81\xa09ˮ獕t\x97\xa5\xcd\xdcJ)oY5\xcb˻>\xa1\xe4/\x19uU\xaf\x10\xf6\xc6\xc3\xe1p\xb9\xee\x85\xf4\x90]\x10\x0e\x96qŊ\x06\xdf\xccwwz\xccx\x9a\x9c\xde\xffj\xc4r\xc1|f\xdc\xc3\x14\xf1\xa8\xd2\xcf\x14\xbeaĎϷ\x80ʷ\x8e\xe9\xf5\xef@Ǜ\xa7\xdaxq\xe8o\xafD\x90\r\t\xe2\x01\x1em\xc1\xe84\x81\x88\r\xee\xe6\x8c\xe5\xd4\xe7\xb8v\xeb\xe3\xe5\x85&\xcer8\x80S\xdf['\xb2\xb9\xae\b\xe5\xf1\xa6\\5o\xa7t,\xf9c\xdc\xcc1\xbe֍\xefy\x1dL\x17K\xa2\bX\x91\xca$\xbe҉ Ob\x98\xb4P~\xcf\xe2\x1a\xdf\xfb_\x94ŗh\x82Gc\na$\x1c\xa1y\v\xc9|_G\xa9\x06\x9e\x86\x0f\xb0\xe6\x89aE\x93D\xd1_\xd1\xe9\x96}O\f-\xcao\xb9[\xdel\xf5\xba¶\xb5*\x14\xe0\xf5\x92\xfc\x96\xf9\xb3\xb3\xacз\xe49C\xf0IT\x93\x98=M\x89\x16\xc7$\x9bjz\xa7\xd1\xec\x89X\xe6H\xdb\x1b\xd5$_r4\xeb\x15-\x11\x00\x8f\xe5kղ1\n\x11g\x12\xd5#\x1bR\xf0\xc7!\x1d\x96ʚ\xf8\x0fO\x1b\xbbQ\xcae\xb8\x04\xd8\xc9\x00\x9fȘ\xe7\xad\x14\xb4\x85v\\S\xb5K\x88\xb5\xffM\x8c\x83\xbf\xf6\x88\xa2f,.⦻\xc1\xa6aı\xc1D`\x1d,\xf1\x81*Q\x18&0\xb5R\xeby\\\xb1Tdic\x93\xe8\f\x81i\"J\x19\xb2l\x16\x8b\xb5\xea\x02o\xe52\xcfG\x82G\xe84\xf2-|\x9f\x95U¾\xae\xe2k\xfe\xf2\xabZ\xbb\xa4\x83Y\xb6f)\xfc.\xdcrw\x15GE\xdd*\xfc\x85p6\xdeV\xf8\x87rU3\x9c\x00\xbcu\x9f\xecW|\xd5x+?\xdeU\xfa\xcb$Y-V\xb40C'\xd6\b\xe2|9\x8f#H\xe6\xa8٬C\x8fd\xcd\xed\x80\xc9F\x1f\xcfa\x99\x01\x87Չ4\xfdRN\xce♽\xdc*\x84\xe0Q6}\xf0u\x14\xfdT\xeb(\xb9\xc2Jv\xaf\x95\x12Y\xd8\xf2\xbe@\x12\x12\xfdR=>\u0096\x15+f\xbd\xbb\xd7\xc1\x86\xa4\xc5\x03]\xcal\xee\xe1\x04\x12\xf1[\x03$k3\x1d\x0e\xf0\xe7\xda\xc8\u07b4\xb37ۜ\x8a\aK\xfb\t\xc5.\xd6\x02h\xe8\x01\xd1\xc0\x03\f\xc5EZ.\xe8!R\xf90\x84\x03\xbb\xd9\x0e\xfb\xc1\tcC\x9cA\xba9k.\rL\x14\xa9Ou\x81\xc3)\r\xf7J\n\xcb\xcd\xf9#\x93g7p`\xb5A\xe5n<\xb9\x1bs\xb0\xf3\xaaP8\x935\x1c\x89O\xa3_x\x02\nm\xb2i\xe7o\x1b\x1c\xdfg\xc5\xcd\xd7Yݠ\xfa\xb6\x96\x06B\xbf\x8c\x87\xfb`x\xd3T$\xd6>\f۔C\xdd\xc4ɍ\xe7\x96\xdd`\xbd\xab\x90\xe7\xa2\xdd`\xb3\xabПQ\x85\b\x9f_\xadI\xb8\x05W\f\xec\x94\f»1U)\xab\xe4\xe3q\xe6\x01`+<\xa6>%\vw\"%\xfb\xc4\x7f\xb0\xaa\xb4P\xca\x17\x10\xfa\xc2U\x84N\x99\x86WJ\x93l\x86>\xe7\xc1w\xdc\xc3n3\xbc|g\xf8\x1aX\xd6\xe4m\xb4\xfc\x10\xaf\xbfCi\x0f⪊7\xae/\xcbH=uq\v\x13 \x10\xe9\x8avÕ\x1d\xa5Y\xab/#`{\x81\xbb\xab\xcc\x1e\xf2\xc1\x8d\u0094]\x91\x17\xceK0v3@\x15f\xc6\v\xa4\xe6\xf8\xf2\xec\xab\xde\xedj\xe1O,]%\xec\xcdj\xe1\xb3\xd0Q^\xe0\x94x\xb3Z\xc8WP\xb7!F\x94\xcb\b\\\xacK\xb4Eu\\\x06\x15\xc5\xe7Yݔ\xd7U\xbc\xf8*+\xde4+T?\xf2\x81\xbc\xdb8_1ύNO\xc9oqf\x94\xe5\xf4\x93\x00*\x127G%\x85R\a\xe2\x1e\x93\xafE\xb8'}V-\x96\x83\xe3Z\xc8\xea\x147\xcd\xfa\x11\x17\x14\xdd@\xbe\xe4\x02}\x98b\xd5\x05\x0f\xf0ޒ\u07b5\xf0A\x9c]\xae\xc9i\x06\x0e`\x8d\xbe-\xf6\x030\xfb\x90\xca_\xc1\xf1\xb2\x0f\xfd_\xc9\xd1D\xe4\xd2{\x83\xe8X\"\xa1\xb7\xf7\x15\xab\xe2*\x99oD\xd0\xe1r\x8a\xd6\xc9\bT\xbaR0\xeaծ\x16\b\xee\x83ˊ\xf6\xb9\xc9<\xcbӊы\xbcT\xbd<\xab\xe7\x85\x06\xa8X\xedլ\u0082\n\xb8\xb6\x80\xe5\n\xc4g\xad\xf2 \xf8\xae\xc0\xd3\x05\x98\x00\x1eo:\xe6\xd1\xc0A\xc9?\xc3 \xf5F\x12\xe1\xb9\xfbp\xed\x95h\xafg\xf0IV\xec\x83\xe6/\xc8*\x0f\x0eb\xe1>\b\xde`\xe83\x8f\xb7産\x80>\xc4\xfb#\xa3\x9e\xa0\xbdQ{\xa4\x0e\x16\xac\xbaf<ӎ\x92\xcc7[-{\xa2HW\xec@3\xc0\xe5\x95\x13a\x992=~\x0e:\x01\x80\xef\xe9\xcf\xc4\xde.2r\xf8\xd6\xfe\f\b\x8bJ\xff\xd0\nɼU),Q\xe5up1V\\\x9c\xee\xe4\"\xe2\xf9\xae\xa8\x99\x83\x89\x96\xe50\x81x@\x10o\xf5k\xe0f\nL\xa4n\x98\x8a\xd4e\xc5hn\xb2S\x05\xacXt'6\xectW+\xb9q\xc0\xa4n\x7f\x1a\xb6\xb6\xbaaU͒\xa6n\xb5\x9c\x1c\xbb\xa7\x835\xc9\xe1Z8tO\a\x1b\xfa\xdeD\x90VĚ\nu㠲c\xa3Wp\x80\xff\xf4\x11\t\xfaY\xe3\xaf\r\xfe\xc2\xf7\xf9\a\xc3\xe1hWs\xb3i\x9e\x15\u05f6\x9dB\xc1N)\xb0\xa7\x99\x05\xb0\xfe!\xb3\xdez\xc7\\\xa2\x9d~T\x11\x01\xa8\xbd\xc6\xfa\x87xm>\x04\"\xa0\x0f\r\xe8X;\x87o|\xb87\x06\ue349{\xe3ý1po4n\xbd\x1dy\x1cH5G`r\xe4\xd19\xec\xe3\xc0\xddé\x10\x91\xda6!v{\x82\x0f\xf2\xc1\xfeH6\xb5\xafS6.Ȧ\x05\xc2\xdd^\x93\b22\xaa\xe3\u008a\xd7ĉ\x98\x95\xd57q2\x0f\xecNC\x15$\xda\x15\xabm\xa3t\xb2\xe3^#\xfdXJ\n\xf7~\x10KCޡ\xd2I\x0e\xd9Q\xd8G\tS\x85O\xdf\x12\x9d\x0e\xb8\x80V:\xc1@)\x91N-7YD;\xf6\xefo\xc7W\xbe\xed+\xb6\xeb\xc7<\x16C/\x82$\xd4P\xbc\x82$\xec*\xa75\x8a\t\x13\xdb\x034\xd9Q:\xc1Qi\xfb\xe1\xb6\xd5\x11\x98\x8f\x03\x1d\xab\x85\xaes\xb5wk\xbb\x8c}\xbeQ\x9dXv\xd7\xfc\x85\xf7a\x04\xe4Z5\x8a\xa0\x1e\xeb\x8b\xdeΩ\x8a\xa9\xef\xce\xe1\x1d\xd9;\xa7紆~gd!.\x97>\xf5ʾ_C\xbdCN\xba%@\x917:w2\xa6\x15\x8bo\xec\xc4\x0f\x8f\xfc\xbfy\fV\xc231\x05\xcfh\xdb\rL\xac\xce;\x87\x1bj\xdb;'m\x027F\n\xb2\xaa\xdd\xcc\x1d\r\xbd\xf17\xd4\xdb\"\xbb\x1d{\xb4\xd0\xc7\xf2z\x04/\xb0O\x7f\xff\x9dzx\x82\xbf\xbf\xf8\x02\a\x17\xbc@\x1d\x1fvOGح\xa1\xf09\xf1\x03!Gl)\xc6\xff\xb2~\xdfL\xf0<\xb3\xb5\xdfx\x92\xa3\"q\x92Z\x83\xb3\xfb\x04\xee\x83m\x84\vH\x87\x1e\xf29\x80_\xbb&\xfbF\xb0\xe1\xe9\x1b#\xbd\xd2\x1a\xc7|\x9d\xcb7\x00}\xa6\xb4\x04\xa7(\xb4\xac\xe8\x84\r%(\xb3UR\x99sIRE\x90Д\xabO\xda\x11\xc7\x01a:\xa4\xe2\xf8{c\xbf9\xc4g\x1edR\xb5[\x8d\xff{\x91KE\xbee\x86FeoM\xc1T\x87\xb5\"1R\x84\xb2+\xb6ۊu\xed\x16\xe6\x94\xe5\xacan\x05\xe7\x1dy4\f\xb7\xd7\xf1\xb3<U\xa7j\xb8\xf3m\x04\xd6ɔZ\xf9:\xf3\xb1f\x1f\xcd>ʶ\xc6S\x8c\\2\xe5X\xb9\x9bs\xb3/\x0e\x94\t\x81\x8c\xa9j\xba\xff\xad\xe3\xa1\x0f\tᄲv-\xb7]\r\x95\xc5\xc9EX\xb6W\x8b\xc8\x16fٳ\x9f\xb9>\x9c\xaa\xf5_\x82˚\xed\xebE\xdd\xd6tJ\xe7\xda\xe9\x9a\\\xcc6\xa1\xdd\xe64\xa6R\x1a+\x0e\x1b\xb9\x8a<\x94\xabHu\xf8\x19##SuE$\x9d\xd2\xf7\xd4\f\xa6\x8fd\rN\xe9\xda\xd1\x14ס1]\xd7\x18#\x9eDx=\xeb\xc1d^\xf0 \x10\x8a^\x90N\xb1\xea$\xa4X\xf7S\x1c\xa0\xf8q\x00\x02!\xc1\x85&Zs|#\x93\x90t\xd5\x06\xbb\t\t\x89J<\xd8H\x10d\xd8\xc6x#\xdaь&\xcat\xda²\xb3O\x93|U7\xac\xfa[K\xe6\xa4\xcd\x14\x0e\xa5-BB\x18\xbe'ΦO\x94\xa1TUwx\xbe\xb3\xf6\xbfvծ\xc4\\\x98\xc1\xcc\xd7\x19\xfcu\x93ڣ\xfa%\xbf\xd0P\x06G\xbe\x11\xb3\x9d\xa8\xef٬i\x1d\x8cnW\x04\x0e\xd5(\xd8N\xb5p\xd1Q\x91\x1a\x91\xe8Er\xb6K5\x8a\x92?\xa1o\xc2\xde4F\xd0Mf\xe0Q*!\\tU\xa9\xa8-(\x80\xf5\x1e\x147\x15co\xd82\xaeb\xea?\xef\x0e]\x9e\xfd\xe2\x88\x17\xbf/`\x04g0ށ\xf8su\x95\xd1)\xa2\x85\x03\xaao\x80\xb1\x90\xe3t\aU\x9f\xbfw\x9cnx\x00\x8do\x18\x9a\x8b\xc4ė\x94\vd\xfb\xfeL4\xe7\xaaN:m\x85N\xb9\x11\x14\x91u\x1bo\xd7-\x17A\x19\xaf\f&\xfeFX\x93\x9alK\x04\xbc\x03^\xc2\xd0D\xaa\xbc\x0f\xa8\xd0\xf6k\x83\xa2C\xf6\x10p\xea\xf1EYwX\xa0\xd6d}Z\xef!\xcc]8\xd4|\xba\x03\xc7\u05cc-Y\x17\x19)[\xd2\xed\xd3)\xff\xb5\x03\xd5_\xb2:kx`B!%q\x9eO\xe3\xe4\xa6e\x87AO\xadF\x00\xe1\xb2++W\xb5\xb0\xdcxd\xa0S\xa8\xee-V\x96`Ɋ_\tI\xc1#iÉP\xf7t\xb7\xc0\x01H13$\xca\xda\x04SC}\x15ڗ\x92\x1cR\xb6\x8a\x1a(\xbevp\xd0\xf2,28Mg\xee\xbbF9ţp5\x11E\xa9\x10^\x06s\x11\r}\x18mQPY\xbb\x1b\"\xb3U\x82\xa5\xfd~\x86^\x91\xc6x\xf3\xf0\x93+\xa9s\x13`\xb0\xacX\x9e-p\x95L\xa4ٙ\x8b2m\xe5\xd0\a\xa6r\x10\xf1\t\x81h\x8e\xca\xe0\u07fb\x17\xb8H\xd2\x0f\xe5-\v\xa4\v\x11\xd7\x1e\x11\xafH\xb6Gf\xc2D\xfd4[#\x14\x8b\xd6\xc4\xe7\x866\x15l\xe6\x94\x1e\x01\xb7\x06\x16\xabŔU\xd0\xd7\xe8x\x8a X\xa5\x9a\xcd\xd21\xffE'QVߓ\xa5\x98d\xb0\x8e2\xbc\xec\xa6\x1c\x97\xd7[إ\xfc\xa6n\xb3\x85\xe4\x96$\xd8\xd1<\xb7\xd9BLM\xaaEz\xc67\x1c\xcb\xe0\xc2\x03\ng\n\xed\x0e\x8a\x16\xf1\xf2\xc78}\xbd\xcasK\xe2\x9d㈵\x98+\xd7\xf2\x14b#\x1262!\x95 \xa9\x82I%P\xba\xe9\xf6\xc3o\x91\"\a\xb4\xfd~\x98܋([\xb3ȿ<\xbe\xe2\xbb\x10e(\x96\x19C\x19^EP\xa5_\xb0\x12!#\f\x04\xa9\u0090n\x8c\xac\xa1\t76|\xb7SzO\u0530\x9fc\xff\xa7k\x1df\x01x\xd5C\xd7P\x9dn살\xc9M7V\xc1\x8d[\xb0\xdd\x17f7X=`3\x7f\x1b\xdf\xd3\xfa6HQ\xa2\xb3\x86U\x11,\xb2\x05\xc3K\r\xadi\n\xe1VUޞ\xc8耴a\xeb\x86gK\x04\xc6v\a3-s\xb1T٘\x813UZߢ\x10\u05cc\x83\x86\xfe'E\x15)\xfc\xdd\xee\x9fʻ\xa0*\xef\xda\x0f\x97\x94w\xfc\x88\x8d\xa0\xe8|1\xe4\x97LU3\xb7\xa1\xa5\x02\x0e\xc9\x123\xe3\xaf;\f\x1a\\$p\x90\v\xf8c\xef\x8fp\b\xf85\xa8ؒ\xf6\xf9G\xbf\xf4\x8e\xae#\xf8c\xaf\xf7\xc7\x10\x0e\t\xe2\x8c \\?\u05ca\xfd\x18\xf3+_\x05\xbb\x83\x9f\xd8\xf57\xebe\xd0\xfb\xa5\xfa\xa5\xf8\xfd\x92\xe2xI\x8a\xf1\x1a\x02&_\xe1\xf1\xf2u/\x8c\x141v\xd9?^\xf6\xfe\xd8*W\\a\x01\x95\xf6\x8akZ\xf5M\x8eF\x98\xf8e\x13ȧ\x81T\x8f\x98\x87\xd06S\x90\xfe9\x8bSV9'Ϊ\xecO\xe5]M\x85\fY\xa8\xca;\xba\xc2l/T\xad\x04\x8e\xba\x84\t\xfc\xf6!\xb2\x1cgxu\x8e\xf1\xc6\xe74S^r\xd0\xcbwW\x14E\xa0\xbc\xbb|g\xadE\x04\xa9e\xeb\x1e\xb5I\x06\xc7\xc1\xcb{\nk\aRk]b\x9f\xab[\xccp\x99\x19\xc1\xcc\x13w\xa0)oX\x11\xb8,\x12\xa22\xc8\xe3\xba!\xc7\x1e\\,\x90\xd49\xf7\xf1\xbe\xf9\U000f7d8f!+s\x9b\xbd\xac̑\x928\xaf\x99\xa7Y\xdf\xfc\xf9\xfbv\xab\xb4\x13S\x8b\x0e\xbb2\xa2\xc8\x10\xa8w!y\xd4\x1e\x9f\xb4{8C\xa3\xb7\xa7\x17\xb3\xc3Cxa7\xadmkw\xeb\xc9\xfc\xf5t\x82\x93\xab\f<\x16E\xbc'\x02F0\xda\xed\x87\x02\xed\xae\x99\x00V0v\x8f\x80\x12\x98\x80\x9f\x92s\xe7\x8c!\xa1\u058c\x8e\xdd\xd6\xf0\xae3\xdd<\xb6\xb7q\xcc\xd92\x1a\x86m*\xed\xe6\x19q\x04\x12Uf\x9f\xca?\xb4E\x88(\xa9WӺ\xa9\xb2\xe2:x\x87M\xc4Q\xaf\x15dO(\xc8?\x86]\xb2\xb60d\x8d\xadYµ\x8f-l\v\x9f\\c\x80%[\xa5Q7[\xea\xef|7\xcd\x11,\xb8\xbb\xa8\x97B_\x97\x1b\x12{\xfeh\avo\x1c\x8fo\xfe\xfc\xbdPz\xdf\xfc\xf9[\xf1\xab*\xef\xe4m\x82\x82o4\x9a\b\x1bz\xfe\xa8\x9b\x90\xa1c\x1d\b\x1a\x98H\xad\u0099\xf1͟\xbfu7\x82\xb1\x13\xedA\x94m\x04\xfc\xf7tK\xcdW\x18 \xe6W\n\x1aK\x8a\x8d:}\f\xc4\xee\x9b!\xce\xc7t\xb45\xc3=wqx\x18\x86>Ghd\x02\xaf#n1N\xcf\xfauK\xf1\xceZo8!\x98o\xc5P\x1bK\x86\x9f\xca;\xb1`\xe8\xfdR\xf4\xfc\xcf\xd6\xd7\xdeӑkV\xbe\xc5\x1b\xe7t\xed\xbc\xa6\xd0\x13\xe8_\xca\xd7 \x1d\xf7d\x85?\x96K\x13O&\xa6#.|s\xfc\xcfwŏU\xb9dU\xb3\x11\xa5\x06\r\xad\xd4.8̥\x91x\xa5\xf0\x9eYTl\xb9\b\x8b\xf1\xea\xde\xf2\xab\xab\x01\xbf\xe0\xec\xdek]\x88;\xa2\x94\x89+\x8b\xd8\xfetr\xed\xfb\xa7h\xd3\x7fP\xc9C\xbb\xe4\xfb^\x17\xe7鰲\x0eJcV\x15\xe7\xc3:\xf7g/39\x1fCh\x83^\x96\x92\x9d\x88u{\xcd߲\xb8YU\xcc\"\xc0!mp\xcd\xca\x05k\xaa\xcd\xde\xd8^\x95\xb9\xb8\xccj\xe1U\xa1\xc9c\x98@9\x98q\xe0:2\u07fc\x88u\x8cr\xcfA\xaaM\x19F\xbdi\x11\xe7\xdf\xf4\xebR\xff&\xe0\xf7\xa3Q`\xcf>\x92\xca}\x89\xfb\x9e\x1e\a!m\xbb\x85\xa8\xa4\xe4\xcf\xf16\xf7\xa2j&.\xb0s+Y\xac\xbd=\xb6\x93\xf4\xc3*o\xb2\xcfJ\x97DB\x87\x8a\xc4,\xe1彠\xf3@Y\x96V\xc9\xe7\xf0\xce,\xeb\xb6jz\xf9\xee\xcas\xeduG\xeb~,\xf3\xcduY\xfc\x1d\x9av9\xfc\xbb\xb6\x8e\x1e\u05f7\x9be!\xb4ڵchߏI\xd4\xd0\xcf'\x9a\xd7\x15\x8b\x9b/\xab\xe4\x01A6\xcc\xe2\x0f\x88\xb3a\x167\x83\xa7\x04\xed\x87-U.\v\x9c\x1d\xe8W\xea\xe09+\x82\x86έ\xf1L\xf9&\x82/͜\x14\xfaЈ\x8c5L\xe0K\xfcI!l\xbf\xa2_#n<\xa2\xe4\x8dJތ\"x/\x92k\x95\\oF\xad\x10\x14TQ\xdc\xc4\xc58\xd8PT\x84#\xd9B\x9c\xb9⢎L\x90\xf7\x91qX\xbe\x16\xae\xf1xZ\xbd\t\xdb%\xe1\xaa\x1d\x12\x8b_\x81I\xf0\x9f\x1a\xff\xb9\xc1\xa4\x9b\x8dx7\x16\xc9N\xf0\x9f\x1a\xff\xa1\xc6QS\xd2HyKh\x86\x0eRqy\xc8\xff\x1c%͠\xfa\x92\xda\rL`\x04G&c\x05\x97c\f\xbe\xa1\x0e\xfeqǮ<=\x89\x92!\xe7\x1c\x1c\"\xd9p\x80\x04\x9aa;\xe4\xcb\xc4\xf8\x9fkO\xb0\x9f\xd04H\xe7\xa2\xd9\x11\xb5\x86{\"\rabT\x82_oy\x18`\x9b\xc9a\x04\xb5\x86ņ\x19\xaf$'\x1b\vˆc\x19y\xb0\xc8\x12\xf5\xc6µѸn\xa8\x16\xc1\x02\x1d[\xebf\xa3\x93k\x9d\x9cZ\xf7T\xc1\x13﨓7|\xdcm\xe5\xcd\xc8\xe2\xcdh+oF\x16oF\x06o,,\x9b\xd1\x1e\xbc\xb1pmF\x06oF0\x11\x82\x91\xe8X`7\x1b\x9d\\\xafG\x0f\xe4\x8d?{?\x9d\xc4Zn\xdd\x19L\xba\xc1Q\x85\t\xd9\f\xe2P\xf4\x84\xf2\xa1\xcdԈ\v\xec\v\xd8Y\aA\x8b\xb7\xb8#*W\x15\xc5\x14\n\xae\xab,u\xad\xe1C\xae\xbe\x86ց\x12n\xd4큌E\x03\x8aQ\xed\xb9qpI\x1eMpu\xee\t\xf3\xb7&\x8b\x80e\x10Xs\xd7,\xd3_t\xb35$.\xf7\xf4\xea\xbb\x05,\x1c[g\xe2\xc5\xdb\xf9*\xcf_\xbd\xfa\x1fA\x86քq\x04\xd9q\x04\xb7&/\x1a\xc3\xe9:\x8d\x80E\xfc\x8a\x11ߍ\xde^f\xd2\xef9\x86\t\xe8ХS\xfa\x1a]Y\xa0\xd2̟X\xa0\xa9\x0f\xf4X|2\vtf\x81\xca;!3~q\xea\x00\x82\x04\xfa\x10sg(\x95\xc6x\xdaK\x18v\xca\u0092/\x1e\xbe+\xea,e\xc1\x927\xd8\r\x87=\xe5G\x161\xb9\xc5\x1c\xa8\x97\x83b\xba\x96\xf7\x02\xf3\x8d\xef\x03\xf5\xdeP\xdc\x15*ۮYx\xd8\x06\x89\n\xeel\xc9#\x8dV\xfe\xd0\xcbX\xc5sZ\x1fӺ\x8d~\x9e\xc0\x84\xa2\xb0\x8bp\x8d\t];܌Eħ\b6\x1c\x96~rX\xfc\xb9\x1e\x1d\xf3\x80\x87}Xcx\xf11\x96]\x8f\xf1\x13\xa3\xa6\x9fP\xee\x89\xc8\xdd\x10\xf0\x06\x817\xf8I\xc0\x1b\x04ƉpC\xc0\x9b\x13\x91\xbb\xa2g\x82\x10\xc3\x01\x15\xec\x13\xc0\x01\xd6H\xeeh\xe2kLUs\xa8\xf1\xc8\x1e\xbb\x97H\xd8!b\"@j\x99\xf8ĺ\xbd\xf7\xd0n˪,\xca\xecm\xc3\xea\x9a\xe5\xa4fnY\xd5d\t\xabۧ,\xc8\xd97Y\xc3j\x98\x88\x14\x80<\xab\x9b3\x90e\xec{E\xb7\x8e\x95\xdds\xad\x88\xac[g\x90\x99\x17\x8a\xd6gp\x8b=c$m0it\xe5\xbb]4\xa8\xf1ڐ\xaaӔ\x04\xcbu\x02\x0f\xbbЇ\xf1\x02\xfa#8\xa3\x84\x97\"\x81\x7f\xaf\t`\xad\x01\xd6\xf0R$\xe0\xb7V*a\xa4\xee%4M\xb9@\x8e𰡦\xaa'[Zz;\xcf\xea\xa6ŮKո\x9c͚o\x8a\x94\x97\x97\x89\x15\xfa\xa2\xb4R\xb3\"k\xce\xfcqieE\x03\x81\x0e&:)\xc1\xa9\x81\xfd)\xceg\x98$\xd6佼gX\xc4\x14\xac\xac\xf8\xa1\xe5E\xf5\xe4\xa0\xdcB\xba\xa5\xbeA\x0e\x93\x16\x16\x1f~\xfcgU\xf07:\\\xf8\xa8\x8dW\xef\xae\"\xe5\x84m\xb6\xc6`'K\xafY\x04\xa8\xcfvH,\xa3r\x04n\xa4b\xc13\xfa\xd7L\xc5a\x81\xe2mv#\xfe\x97\xb7\x93*C\x84l\t\xd7\xfd_\xb3ʔ\x80|\x1a\xc1\xdc\"wΈ\x93\xb9\x11L}\xce\xdd\xc5s\xe3v\r\xd0\x17AΙ\x9dh%}0\x85\xf4+\xdc5\x1a\x95/]\xdb\xed\x9cm\xedôt\x8e\xb5\xa8*\x93\xa8\x0fr\xcd0g\xf0\xd8#?h\x87Dk\x0fO\xf8\xf3,\x98\xe3\xc9|\x18\x9e?r\x91\xe6-ӷ\xa7Q)ˍ\xe6\xb4\xf98\xa8Z\x14Ιb\x9bYǜ\rP\x1c\x9c\xa5\xe0\ak4wV\xa5\b\x1cT\xad\x92\xc8\xc4=\n\xe6ނ?\xb1\xeb\xac,\xf6(\xcei\xe7\xc4\xc3\x05W\xf0\x03\xad\xda\xe0L\x02\r*By9g\x03\x14\xf4+\x7fK?_\xbd\xc6tU.\x97e\x9d5L\xd1\xe2\xbe8\xa8\x950\x8a\x8c\xa1\x80\xa7ts\xc6 \xaf\xa6;P\xae4\x17\xec\xee\x1bާ\xbfY\xc74\xbcmf\x1a\x8d\xe6z\x14YI\xd5\x19\xd4c#僙͖\x1e\f\xae>hk\x04\xeb\xd6˹E-\xf9w\xd4c\xf20\xacG\xcae\xbf\x1e\x93\xcf~=\xc2K\x9e1\x01\xa58\xa9\r\xe1\x02\x7f\x9cA?]c\x06¦\x1b\x99\xb1\xa1\x8c\x8d\xaeA\xf0b\x90 \xca\xd1@y\xbf#^ 7\xf7C\xf2B\xb1\x1d\xfbqMg\xc6R\xa1\x80LT}\x9cn\x1c\x7fNQA\xec^\xfd\x92\x19S\x10\x8e)\xe9ڗ\x9d\xc0\xd1\xc4\xca\U0009c8db\xa8F\xe7\x1d\x95\x93\xd7L\xba\xf1e\xf3:6\xfes8~\x14\xcf!=z[\xacU\xcd\xd9&\x1fE\xc0\xf2\x96ر\x11L\x80\xe5\xa3\x01\x9f\x8eؘ>\xc7\x03f \x16\xb7L\x19\xbdJ\xfb\x98\xd1U/6\x12\x83\x04\xb5\xd6\x04\xd8X}\x86\x8e\x00o\xf1\x1a\xd0QR\xd9h\x80\xabF6\x1eL\xa1\x8f_S\xfe\x15\xdb4\xb4\x9e*\x19\r\xef]ݚ?\xee\x10\xb0\xd1 1\xaa\x1c\xf3\xafр\x02<\xa4\x11l$\x9cʉ9i\xa2T,\xe0ب\xe2\r\x90\f@.V`\xf1\x04Y\x1f\x81\xc3P6\xaah\x8d\xc8\xc6\xf8\x97x\x8a?\x88\x9b\xf8\xe3\x8b/(e-@\xd6vKY\xce;\xee\xdcL#B\xb6J\xa5(6n\x15\x1bw\xf1KL~\xa4!'\x9c{\x18\xc6R\xb5m\xe0\xf8B\x98\xf0\u0604\x9c\xf4%\x0fE\x9d\xf7H\x84\xb6\x81T\xbd{\xf5\xa8g\xbd\x84\x1eb\x99\x1d1\x00\x1dŲ\xa2ٲ\xd6\x11$Y\xb3\bN\xf6\xeep\xe1s1\x1f,M\xb9\xac9W\x98\xd1\xd36\xbf\x96\xb4\xa8\x17\x90;X5g\x06\x1fr?\x1fF]\xc7ʏ\xb7!\xeb`\xea\xb0\v\x19)\xa7\xf6mV\x1a\xad\x1bzV\x8d\xf4\xbcl\xd6\x06}\r\x97\xa2\xb5:y\x1d\xc1,\xae\x85\xd7p<-o\x99i*\xe9\"\x1c5\xe6\v\x18\xa2\xa0xr^N\\\xdf|P\xa8EeH!I\xe8\x94&\x88\xe5Ϋ\xa2\xb2\xfc\x92\xbbV\xd2\x1cCu\x01\x1b$m\x8f\x13A\xa0K\x85\xc6\xf3\x98~t{шv\x13T\x1b\x8bh\xc5h\xff\xcbǏ\xb1\x88\x8b\x88\xcf\xd25Ltw@_\vj>X\x9f{\x99\xc0\xd9\x16`\x7f\x12\xf7(\xfc\x02\xfd\xde,Q\xe9\"\xce\x03\x91Do\x16\x8d\x05\xdc\x11e\x1d\n\x04l0\r?\x03\xeb>l\xd3m\xd8\xe4\r\xe9\xb7ABm\xc5)e\x89b،\x94\xccn\xf2\b\x9a\xb1OV\x1b2\x8f\xe4\xa6\\\x9b\x84H\x12\x1b\xb4\xc86#\x1c\xd5\xd8\xf4f\f\x87X\xf4\x00\x9a\xe3-\n\xca\x19\xdbp!\xf0\x9d9MV\n\x89\x15)\x9d\xbeyw\x8c\xf8oc\xc9\x0e\xe6\r\xd8\xf2\x12s酫\xaci\xcd\xdf\x02ķ\xaa\xe5KZ;2\x04\x98N\xabX\xb8\xbd\xb9\x956]su\x1bA\xe3jM\xbeZ\xe4\xfc\xd6kE\xaeBL&\xf3ڍS\xa2\xf6\x1a\xafs\xd5M\xb1m\xff\x8f\x15[\xb1-Ə֎v.X\x19AIQ̜=\x19\xdfN\xb7\xd89g\x83M\xddĕ\xc8 \xc7\xebҊ\xd2\xee<\xa3>\x8c\x88\x14\x98\x18d\x92m!\x02\xda>\xf3\r\xac>\xdf\xcc=\x01\x18\xf8&a\xdd\bx\xe7\x9a\t\xf6\xb0\"\xeb%\x01\x8a\x8f\xdf\x7f7蝘9_|\x01B1\x88\x02\xbc\xb9\xeeJ\x03<\xbeI\x1d\x9a\xb4\xe5Q\xf8\xc13\"\xa8\xb5\"bmF\x11k\xe7,\xbc\xd7n\xb9\xc5ۺ\x93\xb3\xb5\xcdWlsNѰ\x1f\x93\xe1A\x04t3\x88\xab\r\xd2Fm\xb2\xd8b\xd9lζ>\xd6\xe4\x10\"\b\xe0g\t-|\xc8w*\xf0\x19\x1a넫\x93b\xc2\xdb?\xc1\xf6\xcb\x01O<\xa1\xc7\xf1\xb6m7|\xb6\x86EVtp\x03If9[\xb4\xa95^O\xebZ\xc0aA%\x8e\xceJ\x8e\xf2H\x82\xb7\xac\xe7غ\xa9\xe2\xa4\xf9\xa1\x93\xbc\x8e\xcer^\xe3\xb5Ԍ2\x10\xa1aT´\xac\b\x13\x91\xd4F'\xb6\xf9\x9dP\x11\xe6~W4x\xe2\xa5K\xe4\xd3\"\x8d\xa0\xa2\x7fs\xf1\xc1\xbf\xb8e\xa14`\xa7eC\xcb\xd2\b\x1a\xb6X\xd2C\xb9\xb7:\x17\x17\xb4\x8b\x1d\x87e\x8f\r\x86\x90\xac\a֩\x99&\xd0\xeeV<\xf0\x0e}gh\xb2\xbdx3\xaf\x8d\x1a\x95\x93\x80\xa0\xad\x90F?\xd8\xd8y\x93\x89\x9d\xf9\xc5\x17*sm\x17\\[\x04#\xc3\\3\xe1W<\x06\x14/\x1e\x1a\xa2\xe8\xc0\xd2\xf23@\fV䞦\x05\xc3-P.$\x83\t7\x1f\xf2~\n\xa8sڵ\xca^\xdcb\x02g\x9d\xf6o>\xa1\x05\xb9%\x0f\x06\xe0RҠl\x02ݰ\xf4\xb2\x99\xad.\x8c\x1eKY\xee6\xd0ʷ(YF\xbcVu\xe6\xbb\xd4-\xf7:Ɗn\xb2%\xfa\x1eܩ>\x15w$\x14\x1fq{sGԤKߛ\x03\xdb\x14\x83s\xba\xbc\xc78\x95bo\x00)\x85\x18\x18\xf5\xe7\xbe\xf1\xe1\xf6\xf3^\xe3\xa2\xf2CU[G\x8f6\x1b\xbb\xe8\x9ari\x02\x9a\xc3\xccAyK\x87\rE*\xa6\v\x9dA\xfc\x97\xcbh\xc2/\xf6\xed\xf4\x93/\xa3o\xc3.\xf8J\xc3W~xE\x9cgh8\x03\xc7!\xd9*\xe9\xe4-\x17@;\x04[\xf2\xa6eCǇM\xb9\x1c8\xb6L\xd4\xf48tJc\xf1)\x19ݔ\xd6\xee\x973\x15\v\x98\xa9\xbcƪ\xe7\x93J\xaf\x0ek\xca\xe5\xbd\xf5\xd7r\xb1m\x80v\x8eP\xbbOX\x04\xbe\xcd\xcbrqe\xf7\x8cO\xeb}\x8c\xda\xdbG\xefu+\xbei\xd9\xf8\x87\xfcv\xf5\xf3\xe9\xf5O\a!\xad\x95\xbc\xb5\x8a7\x83\x1b\xd0R4\xf7\x8es\xf7\x1c.<\xe7:\xc8w\xaev\x0ey\xb7F\xd1MT{O5t\xb7;u.\xdeb\x00\xf1\xa6b\xec5=\xba\xe2\xbfˋ\xd1\xd9\xcf\xe8:Od<-Q\x9b\xbb\xc4%\xdfw\xdb\a\xec[+\xa4\xb8\a\xc1L\xdei\x96\xee\x88k|B~l\xbd}3\v\xbc \xb6\x93Z\xcdC\x89\x8d\xe0\x10\xd6c~\xac\x11A\xcd\xe3\x88a\xe2F%\xba\xf7\xef\xf1\x9f\xda\x17\x1a\x81|b:\xc96\xa04i\xf5\x1a+\r\xbd\xd8F{a\x1b]q,\xb2\xa1]\xd8\xc6{a\x1b\v\xda\xea\x8d\xc0:\xf6c;\xde\v\xdb\xf1\x95l\xa1\xea\x84=\xa5\x8b\xeb\xa2e\xf7]\xf1\xa5\xe1K\xb2\xc1\xcf\xd1U\xb7\x1c5ق\xbd]5\x89\x92W|\x1fn\xf0V\\p\xfd\x9a\xdc\xf0\x9cG\b1\xbe%\\P\xde\xe0\xdf\x7f~\xd5\xf1\xae\x1c\x9c\xe9\x8f.\x0f'\xaa\x9c\xdf\xff\xf9r:\xc5 \x12X_\x11/܋;\x98d\xdc\xe4\x1aFp܍\x90_jN\xe9=X\x9cl\xf8˰\xbch\xa4\x9fO'wL\xd2T\x86\x9f\xb7\x84W!#\x160\x11E}\x8f\xe28\xf18P\x0eޡ\xf1w\xa1v\xb6:jLb\xa27o\f\x1e\x1eڂ\x04x?\xf2\xa9\xc6\xca5\xb5պ\xaa\xbe\xb4P\t4\xce;\x17\x8f\x97\xb8\x89\xa1ȖK\xc1\x0fͅ\x90[B[dZW\x12\x1f\xabƛ\xb7K\xf1\xc6X{+\xdb\x1f\xdd7\xae\xbc\xd1\xfd?\xf1nwo?\x99\x17\xb4\xff3\xb88ëI\x04G~W\x14#\xfc\xd7U\xd9Ȼ\xe6\xbd\xdf{\xe2\xed\xb8\bzYo\x97\xcc}_\x967\xab\xa5]1݂\x8c\x97B\xfe\xd3\xe3\xb7?\xc4K\xfba7^\xbfG\x18Tx\x96E\xbc\x1cԬ\xe1\x88\xf1\x8eOS~_ޱ\xeaU\x8c\xaf\x8bG\x90\xd9nl\x8bx\xb9]\x96\xff\ac7i\xbc\xe1C\xc4\xe9\xc8̸\xf4D%\x14\xe0O\xde\xfb\x89ؾ\x02&^p\xba\xf1)\xfa[\x0f\xb6,\xb4\xc9-\xe0\x0228\x9c@\xa1\x9fS\x803\xd1\xfd;\x1b\xb1\a\xf9{\x13\xfe\xb9I\xfe\xa1,\x9a\xf9~\\_h\xd0\xfdȷ\v\xec\xdf\f\"d\xb0\xf0c\xe2\x02M\xcf\xe0RK-\xb9\v\xa3\x16\x0f\xc2}\x99\xb0W\xf3\xef\xd1\xf0O\xd1\xe4\xcf\xd1\xd8\xefK|\xdc\xf1[\x8c\xc7\xd2\xd1b\xfd\x86Dk\xae\xb1\x95K=H\x06M).\x9d\x85&\xa6}H\xa0\x89\xf7\xe3IX\x7f\x04\t?g\x8bOA\xc2_\x1fD\x02v\xc1\xdfX\\\xed\x12<\x1e|h?\xc9S\xb0\x1d\xa2G\"\x03'\x9d\x12\xb8\x81\t\x1c\x16\xe2f\xd8\xfde\xeb\x1fӜ\xf1\xd6\xe6H\\l\xbd\x8c\x8b\x94(\xa4\x16\xde\x7f\xf8\x18\x18Z\xd7\xd1\xc8]\n^\u0093g\xe8Z\xfc|8\xc40\x89\xec8\xdcC\xf1\xbc\xa6F\xfe3\xb1m\x01\x9c-Їѽ\xb9D\r\xfbz\xf7,\xf8\xf7lP\xfaqb\xfd\xa7rU\x8dO\xfe\x99\x1a\xf4\xa7\x8fk\xd0\x0fY\xb1jX\xfd\xcfԢ\x1f>\xaeEoXR\xe2M\xee\x7f\xa2\x16\xbd\xf9\xd8>\xca\xf3\xac\xfeG5븳Y\xdf\x7f\\\xb3\xbe\\\xfc\xb8\xe8j\x8eKj\xbc\xf8qa,\x81:h\x9d\xc08tVF\x0e\xe9\xda!\x99n\x81\xf0v\xd0\xfeg\xeb,\xfd\xbe,\x8c{\xcbH\x1c\xde\xd9M\x91\x16\\8`6\x7f\x98\rg\xfd\xf75L\xe0\xbd\xf0\xb7\xed\xf5{p\x06\xbd\xc3^\x04\xef\xe70\x81\xff\xf9?\xb5_\xe5{tj|2\xc4\"\vuǕ\xa7\xff+<\xb1_c{_á&g\x96\xe5\xf98x?\x0f=\x89\x8b]\x1b\xc1\xef\xea\xf2u\xdcd\xb7|\r\xe3N_Ȑ\xa6\xfc\xee͟\xe52\xa6\x13\x1b\x19Ro\xe3<\xc8q\x05\x85\x9d\xc8\xf0܅\aHt\xefWWt\fhV\xc8\xf9\x98\x0ea\x02\x84\x80gF\x90\x8e`\"\x1c<\x82th\xfa\x05\x18$B\x1fK\xbe@\xe0>O\xb8\xc0\x843HG\xde\xc0k\xf4\xba\x99];\x92KI\x8a\x00\xb1\an\xe4R\x10'\xbd0\xec\xa0\xc0[\x8d$\x9b\x84\xfa\xc6_\x97Yˡh\xf3\xcd\xde5\xf0\x17\x03\x9ba\x04\r^\xb7v\xae\xaa#R\x98\xf0\xd66(X\x98P[aw\xe8\xb6uc\xbfڢ\x8f\xa8\xb1\xfc\vhFmG\x86\xc7\x01\xefY\x82A\x01M\x9b0\xe4\xf8\xc5s\xbf҂vH\x10\x96\t\x9fڏ\xc9&3\xb7\x18\xc5\xdb\xe4l\xa9(\xf2\xa3w\xa2\xf7\x10\x82n\x96\x92U\xd0\xc7V\xf3ep\xa5f\rʹj\x12\xdb[\x7f\xd5$v/\xdb \xab&!\x83c3l\xb9e\xf0\xbe]5\x89\xa2B\xb5\x05fY\x11繟\x94\xaf\x95\xb0\xd8\xd6(\x92\xea\xc1,/鼈\xbe\u038d\f\x1a\x920\xe1C\xd3\xcc@\xf1\x11Rd&s\xe1V\x83\xd3B%\xa2\xa7V:©f\x05\a\xe1\xbf]\xddAl'\x00\xd1Vd\x8f\xa4X\xf1\r\x13%\xb5^\f\x94i`\x10-\xf0\xc2b\x9e\x01\xaaZ\xe5\x05\xe6\xb9\x06\xb8\xd5RݳҳG\xf2x\xab\xc2$\xcc\v\xd6\xcc˴+dSK\x83|.1L\r\xd9\xd16:\xa2\x8dK\xe2M8x\xfb`1\xecdE\x8d{n|\t\x96\xc5U$\xea\xab#\x11\x1f\xb35yp\xe8u+\x82\x15/\x1f؏t\x11\xf0 +\xf0\x84ڌM\xd0.n\x11C\x1a\x85#\x14\x85\x83uhG\xe6\xe2\x98\xd3r\x11gE\af\xeek\xec\x1c!\x846\xbd\x02C\x10\n\xb3\xaeC\x84\x1a\xf46\xf4ڝ\"\xa8\x80\x87\xbe\"\xb3\xe3U,Z\xed6ہ\xf5\xd3\xf7[,\x17XYa\xb4՛k\xa1\xaf\xcc\xfa\xf8\xd4dɍ\x15\x8era\t4\xca([7\xfcIy\x8b\t\xdfP\xaaC\x8auh\x80\xf1\xb2\xca\x19,(V\\OV\xd0s\x1d\xe2\xeae\x8c\xfd\xc4k\xe1\xd7\xd1\xc5o\\\xb2\xaa\xe8\x15\x04v\x04\x8bH\x84\\\x90\xc7\xef\x16Qo\x1a\xb6\xace!\xe7\xa48\x83\xc9\x04\xda\xd0n\xef\v)\x1flPf9!\x11,\x1c\\\x8f3WZ\x88\x8f\xc1b\x97\xb4\x18wv\xf0\x01WѺ#\x0f]\x97\x99x\x1a\xe2\x05(p\x1f\xd4\x15\x1c\xc9\xf6\x86\xd0\xef\x1b\xef\xea.`\"[cy\xc6\xde`\xfa\xe5\xe8ʁ\xc4m\x8113\xb4\xa6\xe6E`t\x8b\x9e\xddu\xbfa\xf8J\xe3\x1d\x1cWʾm\xc5\xe0k\x89<W,\x9e\xf2I\xb9\xdcl-\xe9\xd3XT\n\x87\x87\xab\xb8\xbc\x81\xfc\xd4ۮ\x84\"\x02\xa9\xf5zē\x9e\xfc\xf1\x13\xcea\xf8e\xc4\x0f\xc1\xcf$\x8f\x17\xcb-\xa7;\xe6\x98\xe1\xa3\xc5\n%\xdfĤ\x06y\x0eq\xb8\xe6\x9e)\"\x85\xff\x11\xb2J\x82aQ\xcf˿\xe0\x85.\xe0\x92'\b$WpF)\xe8\x03\xc9\x01\xaf\xb6\x93\xf9\xb5\x15^\xc98\xf6\x12\x19\xdbK\xf3~\x16!\x14\xebmSg\xfby&Q\xc8h'\x7fUX\xa4\x1bb,֞\x8fg\x97\xa3+\x8e,\xb4 \xfb}\r+\xeb\xbe\x1c\n\xd0\xf3}\xe6\xbd7\xac!ӡ\xf5dvj\x1eBo\xc8S[\xaeeS<_S6⍽\xa7M\xb7\xd7\xf5o\xa2.k\U000fa45bW\x854\x8c\xf8N\xac\x83N\xb1+\xf3\xe7\x1a\xd1e\x05M\xfc\xca(\xed\xd1(\xba\x05ߥ\rwt\xef\xbf\xff\xfcj7g\xe49\xbcb\x91ɣ\x7f\xff\xf9\xd5\x03\xd9\xf4\xef?\xbf\xda\xce)\x13\xb5\x97Y\x16\xf5>~\x99\x00\xf7g\x19\xcd\r\xd4\xf8\x02\x03\x92\xcb_>\xd5%0\x1e\xaa\x8d\x12Gr\xfe\xc8\\E\xa6eBk\x14\xe1=\xf6M\xce\xf0+\xe8\xa5\xd9m/\x1c\xd4\xcd&g\xc8Q\x15\xc0\xb2W.\xe3$k6=b{O\xe8#H\xe2&\x99C\xc0\xaa\xaa\xacL\xbe\xe1\xaa\x02q\xbc]VeS\xe2|\r\x13x\xf5\xe6\xcd\x1bL\xfc\x9a%\xb9xnh\xa0\xf2#]ƨ\x17&:Y\x81\x9a\x84\x9dK\xeb\xd7\x16\x18\x93IxJ-^J\x8f`Yee\x955\x1b+\x18d\x8b\x86\x01\xfaF\t\x8f\x0f\xa38\x1e\xbd\xf7\f\x1c\xce\xd0O\x8f\xd5\u0558[V\xd5t\xb1\xbc7\x1e\x8c\x86\x83\xe3\x9e\xec\x0e\xc1)z\xd1\x1e&\xea\xe7\x1b\xbc\x12\xe1t\x97\xc8\nT\xbf\xc9\x1f\xa2\xe7\xf8c \xaf\xe9Yi\x9co\x8b2\xa5\x18\xf9v7\x85\x0e:\xa3\xd2W\xe5r#i7\xe9z\xb3\x9a&y\\s#\xc2\xe0-\xe7\xf0۷p\xa1yJp\x11(\xde\xcbZ(\xdd(2\xd1 \x9c\xac\xb3\xdd8ԅ\x8c\xa5\xec̬0\xa1\xa8ܥ̼2\xebЩJ\xfe齴\xa5)\x0fvl\\\x9f?ĹM\xc7\r#\x12d9\xe9\xfdp\xc36\x91H\xbc\xbca\x9b+\xbf\xd3\x03\xa7\xe1-14\x90\xee\x16\xbc\xeay\\\x1b\xec\xb8a\x1b\xdfJd\x11/\xf1\xdd\xc4Y\xb6\x86CI\t\n\xa6|P\x8d\xfe\xd0s\xdd[1a\x91K\x0f\xba+\vO\xed\xe2\x11\x82\xbf?:\x98\xc0\xad\x11\x128\x12,Y\x94\xb7\xac\x8b\xc2\x1b&\x84\xd2E\xe6L\xb9F\xe3\xe9y\a\xfe\xbc$Q\xd2j\xc8\r\xdb\xd4ދ)\xa2?m\x03\x19\xe2P/n\xfa\x89\xe4(\xb95\n3\xdc\xc7$L*\xed\xbe!vt\x13ó\xf7#\xa7\xd5\x1b\x12;\xa7\x8bgvR\xc6A-\xdaXA\xa1s;\x89\x13\xf9\x0f\xa6N\x94\xe7\xe4\xe9db\xe6\x19\xfec\xc5\xd6\xc1\xd2g\xfc\x8fJ\xfe\xd0\xdd\x1e\x81\xdcj\x90\xa0\xcbh\xd0\xcc\x13\xedՐ$\xf7A\x84\x1b\xb6q¼O&\x8ep\xbe*\xed\x90B\x003s\xbe@\f\xfa\x94\x02w1JD\xc3mo\x9b\xf1\xf6\t=\xack\x83\t\xf4~\x19\xf6\xa26\r\xee\xa0i\xbf\xb8\x91\x1e\x0f\x88\x0fdZK\x8fߊ\x0f\x91ŷ(\xa6f\xe4[\xbf\bx\x94A72\xa1p\x0fsM\x1drK\xe4w\x15\xe3(/9\x88Y\xfa2\xa3G4\xd2\xe3\xb7b\xabdW.\xff\x8a\x92\x8er5¿\x8a\xc6\xc4u\xc2\n|I\xc7l\x8f\xef\xc55x\x01S\x15\x11\f\xe3\x81\xc9pa\xe8ֈ\x1fx\xa4\xf0:~m\"Oٞ\xd8\xf1\xf6u,\xb1\xe3\x05\xf7X`\xa7\x8b\xf5\xb1\x1f\xfb\x82ŖmI̋3\xf7`\x8c\xd2\x15\xd3c\xee\xba9Ծ{\xef\xf4\xb1\x1fJ\xb2\xdbOn\xc0\x01\xb7\xa7DTsa\xf2\x8fe\x85\x18\xeb7\x04z\x99*\x88\xa1\x0f\v\\\xab\x1e\x1e\xbe\xf3>\x80\xba\x1b\xa7\x18*\xa2\x8d\xb2\x06<\x89\xdbV\x8b\xe5i\t\x17\xb0\x803X\x15)\x9be\x05Km^\xa6\xd9vnzYCG#ry\xc4\xf9Lq\xef\x84\xd4\xd99\xb3,oX\xa5\xdbe\x8b\xa6\xd9K\xf4f\xe7\xe0\xd7U\\4Y\xce8)<\xba\x9d)\xb1a\x04\x83Ӱ\xb3E\xd9N\xe10\x9d7[B2}\xa8DЕB[\x0e\xd4Y\xea\xef\xbfC\f\x8f'\x10\x87! \x84Ey\x97 \x04S\v\xd7c\x81\xeb\x8b/\xf88䘦{ȕ\xa6l\x8b4}\x02Z\xb7a\xdfN\xbd%\x10\xf6Rt\xfd\xbf|o\x92\xde\xfb/ۛ\x1e\xea;{S\x19\xb3?\xa2C#\xf9:\xfe\xc7\xf4i\xb2W\xbf&\x1f\u05f7\xeeR\xa9-\xf72'\xc1I6\x84\xc4\xcc\xf9p\x1fIH\xfe\x19\xa4ᓴ\xd7\x0e\xe7\x1aG\x90\x80\xb57\xad\xe2\"5\xc2\xd9\x15hq4\x033\xfc_\xffg\x04\xff\xf7\xff\xc7^\x95\xfbVa\xe6YI\x01/`\x1c\xc2\xff\xfd\xff1\xc3\xdcȌQ\b\xff\xd7\xff\xa9W\nmc\xaa{\xa4\xc2_\xa4\xaf:\x03?\xae\xa5\x1f\voJ\x10\xc2\x01\x8c\xed\x88\xcc\x00\x9b}\x80*\x98\x80\x15\xb7\xde\x13J\xf21\x05ܨh\xc6n\x1db\xfe_\xff'\x1cb\xb3\x0f\b\x8d\x0es\xd2\x1f\xcbO<\xf8\xa8pm\xa1o(~\xb06\x10yy\xfd\xfa\xef\xdf\a\x88QI\x82\x92\x8a\x01\x97\x86 ܧ\xab\xcc\xd8.l\xbd\f4/$\xcb;Z\x9cUwY\xf1\xa78\xcf϶\x1d z\xebT\x1b\xaa\x9a\xafD\xf9\n\xd4z*\xa3\x86C\xa7\xe7[\xbdV\xe3I\x9cK\x9b1D\xea\xd5b\x97\x8a\xad\x8d\x87,\\\rk>m\xfd\xd0u\xf1\xe3\xac~\x1d\xbf&\xd5th\xac\x8a\xa9q\xf1\xde+a\x13\xcb\xd6u\xb0\x85\xd7:\x9a1U\x87\\W\x9a\xcc\xe1{|#\xb0\x1ar\a\xfdEE\x8eq\x18\x82Cp\xc9\xdfP\x9b\xcb\xd1In\x18\xc1\x9f\xc2\bn\xa5!\xa7\xbe$\xe8\xab\b\xb0\xa2?A\x1f\xe6\xd6z\x97\xe1\v\xb1p\b\f\x0ed%\x97s<w\xbd\xc5%\xed\xadIpS\xc5E\xbd,\xed\xe7\x1f\x17qSe\xeb\x96\xdf\xfd\xe0}\xb6\x14\x17\xef\xd2\xe3\b\x04\x94\x89\xed}\xb6\xf4\x99\u0085\xe3\x92gx\x86\xea\x98U\x1a3\xac\xd0/\xf29H\xbe\xe6\xd6bB;\xef\xf7\xd9\xf2{\x8e$\x82\xf7ٲ\x16\xe6\xc2/\xc9@\xbb\b)\x12\f\x97z\x8f\xb9A>6YPY\xf4\x14\xcc\xf8a\xab\x89\xa4 $\xef\xf8\xe3+\x1a\t \xf0\xe5\xbb+k\xeb\xfc\xee\xca8\xbc\xf2L4\x88\xdfd\x95q\x85\xbcm\x17i\xddߵB\xd4\xc6\x11N\x00y\x19\xc1\xbc\xf5\xbefk$\xbd\x80\xe3\x10\xf2\xd2\xd4l\x9d\x90'!\xcc3\xf3\xe1\x19\xd7\x13,/\xe1\x85S\xa9\xb0\xd6f)\xb9\x18\xc1!bx\xf9\xf2\xa5=\x8b`}rtE\x10_.\xb2\xf4*\xc2Bx\xfa\xbd\x16\xf4!\x0e|!\x80\x0fZ\"d\x91\xa5[\x82\xf3\xe4\xe5\xce0\xc0\xff5Y\xb5\x86\x17\xe0gW\xa8\xf9\xc2\xd9dqn/V\x19\xe7#\x86q\xcb\x10GC8\xb5-\xb1u\xbf\xe2\\\x99\xc7\x14<>\xcc\x0f\x13\x13\x1b\u074c\xb7`L\xfc?ag9\x05\xa8\x03E\x89YV\xd5{\xad\xe4\xfdӌL\xa1\xd3\xf6=6i3N\x9b\xb2\x01\xf8\x8dg\xa6\x8b\x913aD`\xaf\xd1_\xdaQ\xff\xe2\x1dKQk\x1b\x93\xc7\xff\xa5\x9b\xfeb\xf2\x11m/X\xedu#\x91\tx\x8e#kNٲ\x99ۤQ\x12\xda\xf6\xe8t\xc0\xf1\xfe\xa9\xca<_\xa1\v\x05\xff\xc1[\x81\x15\x8a\t\x1f'H\xb4\x06\xd1\x1b\x8a5\\\x80a \xd2\xe9\xfc\xa2y\x15o\xce\x1d\xff\x06\xff\x9e\x92\x9f\xa6 9\x97D\xdc\xe1\xe1\x15%\xfe\x85\x9f\x7f\xf2\xf3*a\xaa\xaf\xbf\xda\xfcw\xb6\x11Ӑ<\x9b\x92K\b\xfeTr\xd7F\xcaVo\xea\x10\xc3@K^\xfb\xb2bN\x928z\xb3L\x8b\xae\xd2\xd2\xc7\x19\x1c8<\xdf\x1e\x92R\xd7W\x1b\xf5Ep)\x9a\n\xddVw\xbb\xb8\xefHC\xe0\xba\x15\x1d\xa1k./e.\xce\xcc(#\x92m\\H:\x0f-J\xaf?\xb28\xca\b\x16\xf1Rb\xd8_\xcc\x16\xf1\xd2\x14\x8dX<\xec\x8a\x12\xc4{W\xfcr\x04\xe2\xdc\\\xa6\x88\x13\x91El\x85@\x89\xefqpS\x9f\x99\xad\xa0\xe3\x0e\xd9\x14\xdfa\x8e\xd18A^\b\xf1~/\x7f\b\xf8 \x1e \x1d0\x1dl=\x8f\x8b\xddw\xceĈ\xc7gp\xd5A\xa0\xe2\x96\xf9\xf9\x17ѣ|\xf4r4X\xd8=O\x16c\xd9\xd90\x19zch;\x89\x11\x0e}\xb2\xb6\x03\x8f\xc1T\x8dϏ\x93\x0f\xfd\xf64j\x9e]\xa6.\x7f\xb0d\x1b\x95\xc1\x0e\x85\xaf\xacRfD\x1fWRe\xc8$\xed\x14`\x02\x04\xbawE\x7f\x91\xdac[U\nh\x7fܼ㼋^\x00\x9d\xb9\v\x97\x93.g\x8e\x1b\x87=\xc6\xd8\xf1\x1c2\xbbg\x8f\x04\xed;Rv\x8e\x91em\xb7-\x1e9\xf5\xb5Α\xbd5\x9a\xfaU\x0eS\xbbb}J,\xab\xf6\x88\xaaSw\xfb\x98\xd8[\xb9\xf74\xd8U(\xe2\x14X\x12\xf7\xc8\x1c\xd4\xed\x83_I\xe3\x92U\x8bU\xc3<\xab\x18z\x8f\xc8\x0e\x7f!\x80\xe5X7\xe7R\x01\xbd5\xfa\x85,\xcewpb&\xe3\xe5\xf0P\xd3\"U\xc2\xdagT\xd5u\x9bR\u05f9\x91v\x85\xdaWj\x90\x94E\x127bK\x8cd\x8bR&f\x8c\xac\xbas%\xc7ˉ\xb6\x1bR#>\xa2εŖ\xa5\x1dٺf\xc2s\v\x89hX\xe5g\x9eg]'g\xce[\xb1P\xb0\x8d\xb1\xae\xab\x83!d\x9e\x15\x01\xed\xfe-S\x878\xd73]$j3\xba]\xb7\xff\x84g\xe5H\x98\x1c\xf3\xadݗ\x86\xaflD\x97\x94\xb6\x9e;\xd2.м1\xe6Z\v\xfd\x9c\xd6\xed\x15\x8e\xbdT빑\xca=\x80\x87\xbe\xb6 ڀ\xca\xf59 \x9aCyݓ\t|W̲\x82\xfc\xe3\x9ayU\xde\xd1\xca\xf0\x1bt\xf5\vz\x19\xcfb\xfc\"L\xcf\b\xba*\xb9\x80Bt#\x0e\xf51\x89\xae\xbf\\\xb3\xea\r9R\xabˎĖ\xd08\xb8\xe6\x988\xd5\a\x13\xb8\xe1\xfcS?\x19\xff\xa9叒(\xba\x8f|\x8b\xfe\x9dd\x02\x1c\x8a\x02<6\xefK\xc2\x14r\ny/\xbf\x83#\xf4+璳\xab\xfc\x8b\xce\xf2\x8f\xdc[\\\x96X\xf0\xa8=\x96`8\xe3\xbb\x1eTl\x99\xc7\xf88\xee\xf1[\x01\xff\xb6b\x11\xf4~\xf9\xe5\xbf}\xd1\v\xddM\xb3\x06\x81\t\x1c]\xfe\xf2\xcb/\xff\xf9\xcb\x7f\xfb\xe5\xe0\x97\xc3_.~\xf9\xfd\x97\xcb_\xae~\t~\t\x7f\x19\xfc\xf2\xdb/\x1f\xae\x8e\xae%\x1d\xe2\x06\x95\xbe\xb7\x12A\xe1\x10R\xc0\x850\xd0\"0=\x1cK\xe63J[\x96w\xc1\b\xf7}!\xbd![\xc0\x99\x05ki\x9f\xf5\xdc20\xad\xaa\x1c\xed\b\v\xe6\x7fv\xaeb\xbf\x8a\x8d\xc7_\x7f\xf8\xfeOM\xb3\xfc\x89\xfd\xbaRSo\xf7h\x91\xb8`\"\x90/\xf8U$\x8a\x9e\xacC;Q\xea\x17_`5\x83\xf2\x96UU\x96\xb2\x1f\xb2\x059H\x86\xdeT*\xa2\xfa\xf5\xd7A\xb9dE\xd0\xfb\xb7o~\xeeE@m\xa1`\xbe\x9a<\x02'Ț5\x82\xf8?\xb18eU\xd0\xfb2Iز\xe9q\xe2L\x9cE\xc5\xe2\x14c+7,\x99\xbb\xba\xc3֎\bO\xd0o\x1a\xbaE:\x99\xc0\x89\x86\xd0vo\xa2\xa0\x89\x9bU\xed\t+\xff\xb8\x96L\xa8X\xbd,\x8b\x9a\xe1\xf1I\x8d[\x89\xf1p\x88y5\xb2u8\xe4\xc9X\xc9\xf1\xf0\x04\xb7\xac\xecW\xe0!\xfa\xfc\xb1\x9ay\xbb1̯\x04QV^\x1eC}\x1f9\x900@\xed\xc4\x16{\xa2\x13\"%N\x1b~f\xebƺ\x00\xb6[\xb9:bs\xae\xc2k/\xecg\xbe>\bgY\x94f\x93t\xa2\xd0j绺,Z\xedt[(\xf8\xc13{8yg\tyA\x1fa\xf1\x9eq\xdb\n\xc1<\xad\xc7d\xb8\x80\xff\xf7\x9b?\xbf\x1e\xf0(4\x1c\xd0\xea\x9b\x0f\x16a\xf3f\x91ߓ0\xfcy\x84\xe5\xba)B\x16c\x8a\xeftԜ\x03\x1c/\xf3\x9f0\xd9:\xf4\xc1\x84A\xcdr\x964\x14GR\x15\x98\x96\xc6\xfb\x03\x00B\x8e8<G\xf6\xaa,0u\x15\xe7\xdfV\xf15\x16\xe2D\xba\xbbJ\x8b{^\x1e\xad=,\xfa\xb42\xfa\xd7\x1f\xbe\xffǊ\xa8\x987\x8a\xfaG\xe9G\xc8+\xaao\xafϠ7o\x9a\xe5\xd9\xd1\xd1\xdd\xdd\xdd\xe0\xeexPV\xd7G\xe3\xe1pxT\xdf^\xf7\xf8J|\x8d\xe2\xe0\x05\x1c=\x7f\xfe\xfchͥ\x85\x83\xe6Yq\xb3\x05\x14\xb3%h\aο\xfe\xf0=\x02?;\xa2\x90r\xcb8a\xba@Qw\xd3K\xd9G=Ӡ\xa7\xdf2\xe5n\x91g&\x178\xd2_Wq\x9e\xcd\xccg\x06̐\x8c\xda\u0086\xa9\x03Z\xda\xffy\x16\xf4\xceza\x04\xca)\x13\xf3̕Z\xd6z:\xc7\x02\xb5\x83;f\x86\x9ccn\x1b(3\ue2f4n\xcf\x19\r\x1a\xcc\xe3\xfa\xcfw\x85\xba\xb4\xc1+\r\xe1\xc2\\$\"?-6\\r0\xe3EV\xba\xc6|FT\xc8:\xe1\xcch\xa4y \x9bf\xf5\x92n\x17x\xe6.\x92:\x9d/\f\x8b2\xc5\xdd\\\xf8\xce\xcf\xdd\x1d\x83,{\xe9q\x1d\x95yo\xd9-݅\x13\x9f\xce= \x91j\xba\xe3\xcb4c\x83ek\xf3\x86\xee\xa7\xe4Yݰ\x82U\xae%\x9c\n(\xc1\x18\xa0`\x88n\xec\xf5\xf4\n!\xb3\xad\xf2\x02\x82\xcavu\xb4\xb86\xe3\xc0\x18\x12\xe3\xecGڻ\x03\xb8\xe0\xfeƈ\xe2j\xa0D\xfb̓j\xb4\xcfԍ\xb3\xd6\xdd\xcazɒl\x96\xd9|X\x88.F\xbfb*\xf1\xb6\x92ae\x14x\x04\x18\xa1\x84,\xa4\u0603\xa3+\\f\xf4\xa0\x17A\x9d]\x17*\xfd\x98\xa7c\xc0\x14\v\xfe\xf4*\x82\xbb,ų\x038\xe4IO\xae\"H\xca\xc5\"V@O\xafhT&\x19^\xb8Q\xa9Ϯ\"\xc9L\x9e\xf0\x1c\xadz\xb8\x0f\xe1\x9e\xcc\xf5j&\x9c\xab{\x11\x88m\n69\xcek\xa6{P\xe1\r\xad*\x0eՇ\xe5\xe9\xad\xcbQ3\x8c\x93`ިްg\xc5A\xc5f\x84\xa2\x81}\xeb\x04>\x10\x890\xc2U\xf7\x89\xd5\xf7\xf5]F7{\xcc+3\x90\xc45\x83^\xd1;\x93s\x89`\x11\xaeY\x1d\xd9\xea]+*\xcc@\xd4\x1cÿ*\f\x8aWC\xb5\x8b\xd4<\xfbמ\x8bt\xb6\x05\xe9\xf2\xa1H\xab-HS\x85T\xf7\x9e\xd9Z\xb3\xbf\x86\xddh\xea\x16m\xfd\xd1^d\xe8Y\x9d\x83\x11\x1c.\x02\x1e\x1bB\xe3\xb2\\|\xeb!\x83\t5\x9d\x94\xf0\xee\xfc\xfdw'\xf3k6\x8bWys\xee\xbd\xf7\xeaܷ \x9d#\x98\xf1\xc5\x17\u009e\xf2\xaf(C\xa2`\xafg\x1e\x14\x14\xec\x9ab\x02\xc9c\x1b\xdcQc9\x8e\x169!\xf0ːF8fM\x01\xe6\x1cs\xde\x1b\xe3\x17\xb7\xc4̧\xb4\t\x9fv\x02u\tOr\xc81\xc4\xd0].\x9a\xd6\b\xb7c\x911\x84EBm\x16\xd32\xef\xb4\x06q\x9c\a\x133p\x82s\xf8#\x94m7e\x9e\xe1\xcc\x1b)O59\x1e\xa9\x81\x0f\x15[[\x87\xf5\x88I\xe9i\x1aࡢ!Ў r\xe8+\x84\xa3PD\xff\xe5T\x1c\x9a\x17\xac\x1c]\"\xd1i\x19\xba\xae\xcaղ\xc5G\t'iu\x91z\xedj\x9f\xb8\x8e-\\\xfc\xdc<\xfb\xe0\xb9\x18\x05\x87B\xba\xce\xfdN\vj\x8eC\xf3Kpq\x16\\\xfe\xe7oW\xe1Ep\xf9\xe2\xe5\xe4?\xafB\xfcu\xf8K\x1f0\xe9\x0f\xe1E0Ąa\xff\xf9\xd5ax\x11D\xe1E\xf0\xcb@}^\xc6\xfd\xf7_\xf6\xff\xe3_\xaf\u008b\xa3v\r\xa4\x12\x84\x1fR\xbc\x14v\xf2\xeb3ˆ\xb3l\x1d\x13a \xd4\x1f\xa5\xf0\x06\xf2\xfd\by\xcdk\x9f\xd2߬\x97e\xc1\x8a&\x8bs\xb7\xfcl\x9f\xf2\xdffk\x96\xba%\xab]%\xa5\x91\x8ar-\xa9RC\x91\x17\fU\x154O.\xe25.\x8b\xf8\xef\xac\b\xc6C\x022ċ-\xder-\xc4m\xc8\xd0Ëͽ\xf7\xf8O\x8c\xff\xcc\xf0\x9f%\xfe\x83\xfb\xf2\xde\xff\xef\xff\x8b\xff.\xf0\x1f\xfc\xff\x1b\xfc\xe7\a\xfc\xe7\xdf\xf0\x1f4\n\xf5~\xc4\x7f\xbe\xc1\x7f\xfe\x03\xff\xf9[\x0f\xaed\xc4\x0e\xb3\xbe\xd0ZW\xa9ݘ\xad\xc5M\xd5\xe3\xba]\xe8u\x85G\xe1+\xd5\x1dju\u05f7l\xc8\x06b=v\x05\xd7E\xdd>\xae\xb7\xc8\xd2*\x11gp84\x17,\xf8T\xf0\x18\x0e\xb5\x93\xad\xa0\xf4\x88\xa7|\xffz4\xb4\x8a\xab>\xec\x8fO\xcc^<\x89L\xac\x18~~B1\xf5h\x95\fg\x90\xc95\xd11\xba/\x1e\x87\xbeUq\xab\xbb/\x9f\xc1!dX\xe8\xca\x1d\xd5,\xae\x19=<\x00\x13\xf5\xb9,\xf3M0\x0e#\x95\x90\xac\xa6Y\xe2\x02\x1c\x1b\x00)\x9f\xa4\xb7\xdc̗\x90Y\x8a#\xac\xd9\xf8\xe8p\xc7<\x0f\x1ar\xe6\xd6\xc2\a\x16\xd2pfQ\xa4\xb6\xb6\xe9\xd9\xf6\xd8&\xaa\xcd\xd6H\xa5F\xeeS\x92\x00\xad\xa2uV\xecS\xb0\xce\n\xab\x18[/\xf7)\xc6\xd6K\xabX\x92UI\xce\xf6\xa2\x94 \xed:\xd1\xd7\t۩\xb0\xf3\x04\x9e\x8b\xd6\x17\x9d\x85_\"\xbd\\\x15\xc9^Ur\xc8\x0e%D\x10\x8b2m\xf5t/+zg-\x19\xe1u\x97\xabFgU\f\x03\v\x88\x97UzY\xd1/WM\xcf̞\xa1YMd\x97\xab\xa6O\x88\xbd\xc7\xdf6ݢd\xe0T\x14\xcc\xda\x1a\x15O\x84cۇ״\x9e\xf8m'}\xdc\"70\x01n&\x81\v\xafQDX\x1c\xb8\x1fn\x17$\xdf0\xc3\x19qM,\xab\xf5\xe0\xe4ki\xb9\x906\xc7\r\a]\xc0\xc4\xea\n\x82_X\xf0\xe6\x18m\xcb\x14\x86\xe6\t\x16\x81<\x89\xa5W\xfe[\x87\xb5u\x9e%Lz\xad)?\xe2Q(&(u\xb2~˯\x0fI۞\xf4\x91F\xf5e\x1f*b\xcbM\x0e_\xb7-\xad\"*\xc4\xeb7\x01Y\xc1\x06r\x89|{\x1d\xe1\x06Ķ\x89\x04\xfbU\x05p\x8d\xa7\x1b_6M\x95MW\r\vz\xaaPO\x86p\r\xcd=\x05\xb6\xe6Zc\x1eL\xe3\x9a\xfd\x05\xe3\v\x96E]\xe6Y\x1a7,h{\xa0\xf0\x80u\xb2P\xd0\xc0\x054\x03\xee\xf2\rgV\xdew\xa2o\x94Q70\x88\x90\xc6\x1d]\xbd\xee\x11\x19\xa3}\x8b\x8e\xe6mˑ\xc4\x1e\x1c\xf2{\xee*\x89^ݨ\xca\xc6\xca\xe5ߔU߰\xbb\xbf\xea\x1c\xfc\xe4鴏\xd1\xe9\xf8I\x19=w\nPT\x7fͮ+F\x8b\x94ѳ\xa1\x9cA\x7f\xfc.\xf22B\x19;\xe33\x18\tmu\x06C\xfe+Q\xbfR\x95\xcbT\xda\xec\f\x86\x860\x1a1\xa8\xban9˱mA\x97\x95\xbe\t>\xb3Lx\xfd\xbe\x18ø+\x0ef\x9e\x82\x97\xd9\x15\xaf \f\xc1\xde\xe2\x9e\xfb)\xe3\xe1һ蛢\x19%\xf6\uf55b\x96\xee\x8b\xe1\x10\xa6p\x00\x8d\xbbⷫ\xfc\xc9=C}x\x8d\xc6ѩ\xaa<\xdc^{[j\xdd\x0eYD\x90E\xf0.\x82z\xc8}t\xeb\x91\xf8+N\xe4\x7f\x15\x7f\x8bHz\x1f\xa6\xc7o\x8d*\xc45f_\xf8hr\xe3\xe1kP\xa99=\x05\xc9\xe87\r\x9d\xe7p錔\xcf\x00!\b\x0f\x8b\xa9\xa1\xc7kI\xaa\x04Rz\xe1W\xd7\xed0;\x03%c*q}Fa\xe6Z~\x7f\xa2*\xeb\xe8\xb2\x1e\xc2dW\xab]\xb3N=\x84\x170U^$\xde\x16\x84\xa1\xcb'n\xd9\xfeU\x14;\x87`\a\xdb\xe20\xc4\xe1\x91\xf1{\x1f\x16\x03KDd\\\xf4@\xa2\xca\xc1\x1a&<\xbe\x9e\xeb\x83[\x0e<o\x11ח\xe5\x80?<,\xefL\xda0\x00\x1c\x82\xfc\xf6\x0e'P\xba\xcf֫\xe7\x9a\xcbAfG\xf2U\xed~G\x935ݦx'\x9b\xf1.\x84_/\xdf]\r\xb2~\x7f\xe7\xa3\xd6-\n\xe0\x104\xdd\xdb\xc8\x19ߛ\x1c\x1c\xae\xe3\xf3}\x1f\xbc\xbf\a\a\xaf\xa0ż\xee\xc6^\xc1}\xda\ttG\xeb\xa3Yﹰ\xf1\xab\xf7-n\x80\xc2,j\xe0i\xb5\x88\xc4ѫ\xa2\x03\xfe\xcaI^\xc6M\xb0\xe0\x0fn\x18)\xe5\xc0\x18\xee\xa6s\x92\xff\xd91\x1a\t\x83e\xb9\f,\xb3\xdc\xf6\xae\xf1uK\xab\x01{tǶ\xae\xf8\xf0\xc8a\x98\xa1>:\xae\x17J\xf7\x9fˡ\xa2\x1a.\xe0W\fെ\xedoiO=\x97$\xb7N;\xa6\xfa65L}\x19H\xe5\x12\xaa\xe6\aM+ܬxt\xac\xb7c\x8e\xfaٷ\x90t\xa7)\xcfl\xf4%\x97\x1d\xbd\xf0\x8b\xc3\b\xber\x13\xa7\xb8s\x88a\x02_\xea\xd5X\x04\xcd\x14&\xf0\x95\x99Rq\x18\xbe&\x8b\xa0\xe2\x00\xf2\xf3\x8e\xe7\xe2\xba,\x82;\x9e\xc7?nD\x0e\x8f\xd4y#\xb2\xb4\xc1\x98L\xfc1v\xd7\xe3\t4S\xfc\xf1\xfb\xef\xd0ė#\x9922t\xb1\x98&̵d\x04|\x93Ћ\xf4ϰ\xb7u\xb6\x1bYӜw|\x11I\x11'(TW\xd3\xc0Bs\xbc\x17\x9a\x11G3\xd2hB\xebJ+1@\xb5{gs\xe1\x10;\xe7\xd0h\xa33\xead\x99^\xcb?\xa2\xa2\xcb\xfc\xd5\xd4q\x84\x8a\xa1\x8f\xdd\xf9\x12\xd7\xc3!\xfe:\x9c\xc0\xf1\x93\xa1\xe1\xedUM\x11$V \xb1\x04ٺ\xa2\xa0\xb4\x9a\xeb\x15$X.\xef\xcd^\x82>\x8cws\xb1\x8aQ\xe0\xb6\xf0\xcflSw\xc5pH\xad3Y\xa7xsG\xbc\xb93\xf0\xec\xd7(\xb11\xb9\x7f\x9b\xeeb\x1c([\xdat\xb7\xb5MzCt\xd7դ\x1b9\xaan\xa4t\xdd\xc8Quc\x8bY\x01\x13_\x15|o\xf5\x80\xf1U@\x1fNv\xb3\xe0\x86\x8f\xb1\x9bmc\xac؏\x9b7|\x9c\xdd\xec\x18gT\x152`D\xec\x98\nv\x8c\xb6rZm1o|\x9c\xb6֣\xbbf\f\xf3nY\xd9u\xf7\xeb\x13O\x1f?]O\xbb&\x8eXت\xaf\xa7A,\xb0Lu\xd2\xd4pA\x8e+\xba [E\x10_ӯ\xeb\b\xe2)\xfd\x9aF0\xc5\xdc頂>\xc4U\x04\xd3k\xfa\xbc\xc6\xcf\xeb\b\xa6S\xfaD\x1d\x12O\xf7\xdd\xcb\xf5\xfe\xd0\xe3/\xb1T\xd7ӷs\xb6\x0e\xcc\xcd]\x05\x87X'n\xef\xc2n\xa8k\x84\xba\xde\x05\x85}:\xe5[\xc5\x1d\x8c\xfcS\x9d\xef`\xe4\xbc\xce]Fb\x92\xc9\xc8\xf9\x90\x986\x17\xfb\xc9xPG\x90\xf3_y\x04\xf3\x11\xb1\n\xcf\xda\xe6r\xff6\x1d\xd4\xd0\a\xdc\xce\xe5\xfc3\x87>\xe4Ʃ\xc5|$\x15\xf3|\x04}\xae\x98\xb5\xc8\xcfG\xf0\x02\xfa2\xdb\xd4ۻ;!=~;\xafsd[0\x1f\xc2!\"8\x80\x86H?\x84Z|\xe4\xf8\x91\xd3\a\x8d\x97\xdev.~\x1f\xef\x12\xc7<n\x89#&Y\xe2\x98K\x8e\xc51\xfd\x8a-q\xcc\x15\x9f\xe2<\x82iL\x9f8\xcd\xc5\xf1\x03\xc51=~\x9b\xc7S\xe2D\x9c\xa3\xc4\xe4\xbc\xf11\x19\x1ab\xf1a\xc8\xd2\x1e\x9c\xf8S\xb2S\x9e\x92\xb6<%\xb6<\xc5s)O1E)\x1a$\x91\xc1\x9d\xe9\\\xc9S<\x8f`\x9a\xd0g\x82\x9f\x89\xc3'-Oӹ\x94\xa7\xe9\xbc-Oӹ\x92\xa7\xe9\xfc\x01\xf2\x94\xe4o\xa9\x83\xe7ȫ\xb9`\\\x82\x1f\x89\xf8\xd0\xfc݇\x8b_\x8a\xe0q\x9d+\xe3\xb5X\x11'\xe2o\x11\x1bw\xfd#(\xb88\xa8ϡ:>\xc3(\x15*]B\x84\x11dm\xe3\ri\xee\xa1X\xfe\xaf\xc5U@\x8b\xcc \xa6+7S\xba\x94k \x10ecQ6\x11\xb7\x9e\x94]\xc2\x04\x9a\xda@S\x05t\x9f=\xca\xd0F\xb2F\x1baknI\xb6\xf3\xfc\xcf\xf2\x9e\xf1V3\xe6o\x1f8\xd3\xf1\xef\x8dњ\x1b\xc8\n\x88\xedE(\xa5MCs\ueffc\xb9j\xd9v\xbeڼ\x8e\x17,\xb8\t\x83\xf8\xf2\x86\xd8y\xa3\xef\x1f\xb7v\x9f\tG\x81\xa0\xbeͰ&\xc6Y\x11?\x96$\x86md\xd3\x0ed\xbb\xba\x800f\xa1D\x83\xadۃ\xed\xc2vݶn\xd1%\x95\xfe\xe1\xd5Epq\xf6Kz\xf8\xcb\xe0\xe2\x97\xf4\xe0w\xfas\x18\x06\x17g\x97\xec\x9b+\xca\xc7\xef\vuaŲ\x0e\xe3x\xf0vl\xb4#\bgV\xd4M\\$\xac\x9c\xf1\x93\x19\n\x17\xdc\x1e\x94\xbc0\xb5e;F\xf9\xfaHL\x9b\xf9\x1e7\xff\xf5h;\xc43\xa6VFخO\xbc\xac\x16\x8b\b\xe2\xb4N\xebݧj\xbb\x06\n,I\xab\x05\xfeX\xf7<\xae\x83)\x1db\x1d\xfdg\xf0\x87߫\xeb\xe9/\xc1\xef\xf3:\xff%\b\x8f\x06\r\xab\x1b\xcc=\xb39\x93\x1e\xbf}U\xe6e\xe5\xa1\xf6\xa7\xeb)\xa7e\x0f\x02\xad\xd0H\x84K\xa4L1e\xea\xc1.Vĺ\x02\x1e\x11M\xd2s\xbfC\x1b~\x0es=\r\xb4*V\x97\xb0\xecue\x15\xc1u\x9b|\x7f\x94\n\xb8\x80\xca\xe1\x15\xaeR%׃\n\x17\x9a\x15\xae1\xab\x01qV\xf4\x06\xbf\xa1ѣ\x8d\\$\x12#c\x95\xa2A\x83\xff\xf9?\xab\b\xfe\xe7\xff\xbc\xc6\x7f\xa6\xed\xdb^\xd7\xd3\x1f\x8d0\xfa\xbc\xfe\x81\x19Y_\x9c\x9a\x11\xcb\x04\xfb\xccB\x83)E7\xb1\xcfJԥ\x86\x1b\xf3~\xd7\xe0i\xd4f\xc3\x05\xdc\xc0\x99\xb2t!U\x88\x89s;\x82k\xf9\xfb\x9a\a\xe3\xa0\xdfS\xee\xdd}l\xac\xfc\x1e\x93#\xe2\xe3k\xfaw\x1a\x1a\xd3,\xb2 \xa3\x93\x8b\xcc\xf0\x9b%\xf0\n^@\x16R}\x99\xce\"\x1c\xd7<\xeb\xdaΚ\x02\xc5m\xa4\xac\xa9β+\xd3>&\xa7\xa7\x96\x93IE\xf7\xf9\xc2\b:!\xaewBL9\x84u\ni\xf5F\x1aW7\x9f\xa8/<\xcd\xe2D\xdc\xc0\x01\xef\x88*\x8c\xc0\x97~ݑ>\xddB\xf8\xdc\xdeS\xb4\xddZh\xcbR\xe7\x81\x14\r)\x16\x02s'\xe2}\x0ed\xdd-\x96h\x9d'\xf1ڗ\xe8\x1dV\\c\xba\x1e\x181Zx\xa7t\xf3\xbc\xf7\x87\xd9p\xf6l6\x13\x17P\xe2\xa2\xc9~]\xb1\xbby\xd6\xf0̘Mӧ2\xf3\xd7U\x8c\x89\xc3\xe1l\xa6K\xfc\xba\x8a\x17q\x95\x15\x04\xfft6\x9b\xa5'2\xeb\xfd\xaa\x92U\xe8\x02S\x96]\xf3\xd4\xd3\xd9i\x9a\xc8Ԭ\xfeU\xd03c'\x89D1\xcd\xc9I\x05\xeb\xc4\xfftj\x91\xccY\x1a狲HE\xa1i\x92\xaal\x8e\tK\xe8j\xf3\x15\xbb\xcdʜ5\x98\xf5,\x1eO\xd9XfU\xe5]\x81\xa9\xf1\xe98\x1e\xc72uU囻\xb2$\xfc)\x9b>{&\xf9\x90\xc4)kd%\xa7\xb3\xe7,\x96\x84aL\xf2\xa6b\xabZ1c\xa8\xb3ʄ&\x04B7~\xf2|$/\xfd$e\x15\xe7\xbc\x11Og\xa7C\x9dZ\xccr|\xe8U\xd6\xf4\xe4\xe4\xf9)K\x8d\xec:\xcbox\xb9\xd93\xc5Ȥ\xca\x165\xbd\xc5\xf1\x874\x19\x9d\x1c\xab\xf4M\\\xb4:\x0f\a\xaaɭgS#C\x97x6\xb52\xae\xcb<eE\xc593}\xf6\xec\xc9\xd0ʭ\xe2\r1\xf39\xfe\xcf\xca`L |r2\x1c\xda9\xfe\"7\xf3\xf8&\xa3Z\xd2\xe9\xd3'f-\x8b\xf8\x9a\x15\r\x89㳩Cx\x99g\xb7L\xd5vz\xfad:6\x1b\\\xd2%;θg\x89EHY%\xf3\x8c\x9a\xf5\xfc\xf9\xf18I\x8c\xac\x8a\xa5\xb22\xabHMB\x88Y\xec\xf9\xf3'Oc3\x8bŊ\x8ag\xb3i\xf2̤\x82\xecǒ\xf7'ώ\xd3gS7Wrr<;\x99\x9d\xccڹ̟۬\xaa_WeV\x8b^MX:2r\xf5\x18x~2\x1c\xa6\xc72\x8b\xb1\xe5\x92_l\xfb\xc3l6:ynf\xd47\x1b-%SC|\xb2\x85\xa4\xf1\xc9s\xfc\x9f\x99\xce<\xe9ez\xad\xe5yĞ\xeb\xe19\xcb*6\xad2>اc\xfcO\xe6\xe48>\xb4F\x9a\xcd\xe2\x99\xec\x80YY\xb1\xbaQL\x1e\x8f\x9fMu\xb9U2\xaf\xb3\x98\x971\x14\xc1u\x9c\x15\xf5\xb4\xacJ>D\xf0\x7f2g^֍\xae虡\x17Q\xe49\xa6\xf4\xe9ph$\xaaq\x90\xc6\xf1\xe9X\xe5\b\xae<\x1b\xe2\xffT\xa2\x92\xffgá\x95\xbaay^\xdea^\x9c\xcefJZ%\x13-4\xf3\xb2`\x9b\x94\xdd)Ū3\x1a݃O\x9eO\xa5\x0e\xcd\n\x8c\x12/\xe47IO\x93\xd3\xc4ȹ&>\x9c\xe0\x18\x92\x9c\xcbn\xcbj#x\xadѫ\xb18\x1b\xb2'\xcf$\x8a<\xbeeE\xca*\xcc`OؓY\xecdL\xf3U=\x17Ȇ\xb3S\x95{W(~<Mfz\x18\xe6lQ\x16\xc9<\x9b\xcd\xcaB\xf6\xb7\xd2\xec9\xae\xf2\xa4\xfc\xc4i\xfa\x8c=1s\xb4&5\x19Ƴ\x842c\xa6\xfa\xa3\x1cՋ\xba\x13f\xf1,N\xc7\x16\x90\xe8\xd0\xf4\x18\xffg\xe7\x88V<\x1f2\xf6|\xe8du\x14\xd2\xfd4}\x92\x8c\xcc\x1c\xadNf\xb3x\xf84\xb6\xf2\f}2\x1eNǱ\x9d\xab\a鳧\t\x9bٙ\xa6>y\xfa\xf4ٳ\xe7\xcf=٬+\xbba,\x97ȧ\xc3\xe4$ef\xb6\xc1\xb8\xd9l\xc64\x0f\x16L\xce8C3Q5\xe2x\x9c\xa4ǚ\xcf\x05O\xc5\xf1\xad\xfa\xd5\xd0\xf3\xd6(^\xc4U\xc9\xf9\xf4\xcc\\\x19\xe0\x8b\b\xab\x85\xbd&y\xf2$I\xe3\xd8\x020\xe7\xbc$\xb5\xb2\xf4\x140\x8dOO\xd3c+s\xb9\xaa\x969\x95|~\xfct\x98N\xadL\xb3{\x8e\x93\xe9\xf1ӑ\x9dm*\xfc\xa7\xd3'\xcf\x18\xb3\xf3\x97\xb8@4\x94\xc4,~nSm)\xf6\x93g\xe9(I\xac|\xae\xda\xe5@\x7f::}&\x87\xdb\"K\vs茞\x8f\x9e?U<ˊ\x06\x9d*\x17bQ6S\xa2\xb3\xc8\xeafS\x95\xb5Z\x971դ2Ib\xf2\x84\xe6\x19SYS\x11\xdf\xc6\xefJC[\xa7,Nu\xdeF\xad4d\xede\x9e\xe6ta\xb7\xf7\x87Y:;U\x1dO\x93\xb8T~á\x99\x9aV\xf1\x94:v\xfa\x8c\x8de\a\x99\xb3z|:\x1cZɂ'\xb3ى\x99#;:\x8d\x9f\x0eSY\xef2Ι\xa5\xd8\x19c\xcf\xe2\xd8\xccU#\xfe\xd9l\xfa\xfc\x99\x91cuP<c\x8c1#\xd7\xea\x9et\xfat\xa8\xe6\xd8e\xbc\x8c7\xf1\xdd<[\n~\xceR\xc9\xcf%\x8b\x93\xf9r5\x9b\tn\xc6\xd3\xe7*\xa7Zq\x8d\xfe\xec\xf4X\x0e\v\xadX\x92a\"\xc5s\x99\xaf\xa8o\xd34\x1e\xa6\xb23\x96\xe5]\xaa\xe7\xe2\xe9\x90\xe91\xa7\xa5\xfc\x99\xd9S\x8a\x89\xc6x\xab\xcaz\xa3\x96ϸ\xc2Qk\x9c\xaa\xdc\xc4Jg\x9c\x8c\x9e<W\xb2S\xc7i\x9a3U\xea\xd9\xf4\xe4tt\xac\xf2\x94\xfa\x8b\x9f\r\x9f\x8eUr\x91\xeazf'\xf1\xc9\x13I\x81\xa5\x14ٳ\xe9\xe9S\x9dQ\xcfY.VֳS\xd5\x17uƊ\x824J<<\x1d\x8fS\x95\x9c\xdf\xf2y,\x19\xe2\xffd\xb2\xadV\x99d\xaa5\x9c\x9fħz\x92\xb2u\xed\xf0\xd9\xf0\xf9\xd0\xcea\x9e\x9cB)\xd0X\r\xbf\xb6F\x98=\x95\xec\xb5T\xf2ɓgc5\xdd7|\xa2K\xc7\xd3\x135K7\x8cϋCs^\xc4]c\xc3\xfb9}6\x9d\xa5R\x92\x9br\x117\xa5XF\x1c\x9fH~\xda\xdagȆ\xa9D\xa4\x97\x94\x8c=\x1b+>\xdf\xcdY\xdcp\x9d\x92\xb2\xe9\xb1J\xd5\v9=\x13Sj\xbd(o\xd4\xceP-\x15\xec\xd9e8\xb4\x92\xf5X\x8ci*1\xfc\xf6\r\x83\xe1\x1e\xefwY\xf0\xea\xbd;\xcbХo\x0f\xb9v\xae\xd0\n\xd9\xeb\x18\x11\xf0l1\x82|_K\xdcܱ\xc4\xe11煨,\x98\xe3\xc9\xd2\x1c\x0f)\xe7\x83\xdco\x89\x9bG\x86\x9dBR)@\x11\xc5\xe1<\x82\xc3:\x82üe/\x98\u05f9c\x86\xfbS\x9d\xef2Ù\x85>\xad\x19\xce:\xf5\xe46\x8e\xb9\xb0\xb4\xd4\xe2o\xae\xa2S}\xf0\x91\xf3Y\xecP>b\x94q)\xef&\xc61жmK\xf2l\xd7\xdfR;\xe8M\xe2\x8aX\xf21\"\x96H\x11K\x94\x88%J\xc4\\h<1\xbe\x90'\xb0\xbc\x04\x1e\x95\xe3\x99\xef\\\x99\x87e^ b&\xa0<\xe29\xa3\xf8&\x1b\xf6<\fф6\x1f\\\xf3\x92\xa1\a\xcf<\x11\x12\x9b\xf8%6iIl\xb2[b\x93}%\xd6:'\xb5\xfb%1죣\xe1P\xc9\xe3\xa1l\xfd\x7f\x87\x03\b:\x85ʶAΓ\xfd\x84v\x1fz\xf8MQAM\xff\xa3\xa9\xd9-\xb5\xe2\x04٥FH-?\xad\xb0\xe3o[\x18s\x1e\xe3z_\xd9ͷHc\x90\xa3\x04\xe5(A9\x97\xa0|\x8b\xa4\x9b%p\xf4\f\xe6\x86B%Y͵\xac\xe6\\V\xf3\xc1\xb5\xc2-\xea<\xcc#8\x8c#<\xfcq\xa5\x933\x1e/\xb68\x89\x7f\x85\t\f\x9e\x9f\x0eO\x9eF2\xe9o@\x81:\xc4\xd7\x7f\xe0\xd7`\xf8\xecٳc\xbb\xa8#\xed\xdf\xc7;\x8fI\xccB\xfbJ;\xb6룤[\x14\x88\xbd\x86q\x8b\xa2\xbd\xa4]\xd3\xf3 \xe9ޟ\x9a\x9d\xd2.\xbdN8\x05݈E\x8f\xf1\xc0_&\xca\xda\x13\x92p\xf0\xeb\x8aU\x9b7\x04[VA-\x0f\"\x15\x82/\xf3\xfc~8\xbe\xccs\x0f\x9a\x9fʲ1o\xd29/,\x1b\x90?`\x10\x19&\xde\xd1ԅ\a\x14\\\x86ղ\x1aq\x8f\xd0\x00\xb8cӛ\xac\xf9a\x17آ|\xbf\x1b\xa6\xde\tR:\x10\xad\x16Xa|\x8b\b\xeav\x8fZ\r\x16A\xeb#\x10\xcc;\x7f\xa4\x83\x9e\x943x\x93\xbd\x7f\x9f\xf3\xe0\x84=\x89\xb6g\xac \xb7w\xb7\xaa\x95\xa3\xe1y\xc2\x15Ԉwvnc\xdb\xd2\xf7\x0e\xc2\xc1\xaa\xc0\xb3\x9b7\x18Uܬ\"\xf4#\xd6\x1c\x12ŝ\xceu\xde\xca慲\xb20U\xd0啌\xc5+s\xb7\x0f\x1f\x05\x86\xddg\xc5\xf2\x95\x19\x96:\xf3V+\xc6m;c\xe0a\xbfh\x8b嚿\x9aR\b\x0f\x15\xfc\\|ӯ\xa2LY\x04\xe2\x1b?έ\xc07\xe5\f$Jx\xecH\x81ʰ\xe9~[\xabq-\x89q\x82F\xbf3\x9e\xe3\xe1zEܦ\xe2\x0f\xe4X\xaf\xec(\xe2\x85_\xac\xf8\xa4\xa6\xe8\xfb_\"\x15C(\xe2\x1dY~\a;\x90\x90X\xc7廫\xd0\xc8>w\x1f\xf11\x03\x97q\\\x9a\xa8\xac\xfdj\x0f\xf2\xa7\xe0\xd5\x104\xbdT\xa1\xb3M\x92\x04\xd9\x02Z\xf2D\f<\xe2>\xfe;x\xfb6\x8d\x9b\xf8\xed[\n\x8b\xec\xbe\xe2\"\xcb\x7f\xf1\x05\xf4$`\x0f\xb2\x82\x8a\x86\xb2\x1f\x15\x0e\x98\xd88\xb7\xbe\xb1`Sjݫ\xf3\xbdc\xec\x95n\xd5/V\xc0\xean\x91u\xc7\xf8\xbd\xa4\xf6\xd3J)\xcd\x1c\x9f@PU!G\xea\xa2\xcf&Z\xed!!_\xf8\x0f\xf6\x932K\xcc\xfcc\xa80\xc6\xcag\x90\x86\xb8i*7Ҁc\xa7\xd8\x1d&ې\x82\x82b\xf0Y\xbeZvT'\xc1X\xeaL\xfc\x1d\xb4#5\xf2\xeb\xf5\"\x86$Q\xd4~\xd3\x0eS\a\x14S\x11.8c\xaf\x8dk\xf4\xaf\xdfP\xb1\x01\x05f\x8c\f`\x8a\x80\xe0B\xdbU|\xb0\xc5)_1\x1a\xe6\b©F\xf3h`I22Q\xdaj\x10\xf0\x92~_\x85\xaew\x1f\x16\xf7\xdc,ۆ\xd5\xec\x8f]}\x99\xe4q]\xb3\xf4\x1fܝ|\x98\x05f\xd4ͦ\xca\x16Aȣ\xe7\aG\xff\xf9\xfb/\xf5\xe1\xd1u\x18\x8a\xca\xed\x17\x04\xed\xb0;B\x8dR˾\xcf\xea\xc6\x1e\x87\xde7\x00\xa9 \xc6Ch\xf0ԕ\xe8\xe0\x8f\tJ\aM\x1d\x81\x10\xb6\xbcycU\xfdڈEj\x11(\xe3/蠽\xb2\xb0\x95}\xbe\x8bh\xab\xe3E?\xfe\xc4\x14\xf1\xdc\xc5PHAWC\xdc!b\x06\xeb{\x80P\v*>\xbd\\K\xc4\xf7\x11\xed\xba\xd9\xe4\xacC\xb0#XVYYQL}\xf7Aw_\xfcS\xf9H\xe8q\xb7\xc4?\xee\x90x\xe3\xe1Q\xd9\xcf:\xe4\x9f\xe0\xae$f'\x83\xa9M\n\\pX~^Y\x9c\xe9\xe0\xb6\xfd̏ L@\xddeEZޡ\x9e{U.\x96\xab\x86\xa5o\xa8>{\xa4\xa2\xc8\"\x8c\foK\x8f\xc0\xd8\xfaP\xb5G\xb7u\x9f^\xe6\xad\xf3wӮ\xde^\nj>\xb3&3\x1b\xc09r\x89PW\xe7\xf7\x1f,\x92\xe2O?Z\x14\xe6\xfb\f\x177D\xbcŶ\xeeض#\xb80\xb9Ah($x\xd1\xc0\x99A\xa0`\xa9\x18\x02\xd6r\x0f.|7\xac\xe9\xfd\x1c\xa5\x14y`\x1fDg\xd8\xc0\x15\x97\xa8\x1a\xb3\xe6\t\x16U\x17\xb9{=\xf9\xea)\x8f\xa0,\x89\x90\xf9\x9e\xda=\x18\x95$\xc3ٞ%\x8c\xf8\x86\x1fv\xb1\xdf\r\x10\xff@\xf6gE\xc1\xaa?\xfd\xfc\xc3\xf7\x7fg\xe6\xebz?\r\xebM|\xfb0ު\xff\x1el\x8f\x97KV\xa4]q\xb3d\"p\xb0v\x881\xaa\x9bg\xbe\x9agy\x1at\xc6\x7f\xe2\x9d$\x03\xa9\xff\xfbO\xdf\xf1\x01o\a\xcbs\xea{\xfd\xe6cj\xecX\xcdZ\x15\xeeZ=\x9b\x95r\xee\x05\xd6*Z\xd2\tg\xe2\xe7.~gEͪ\xa6\xad\xa4\xa7\f\x9d\xe4Z|\xe7\xe0\x1d\\\xe0\x99_Q\xc9\xfb3ް\xc2\x05\xbcvn\x1f\xed\xe8\x11^Yg\x8f\xecGKG\x97\xecG\xca\xc7\xf6\x95l\x01\x9c\x89\x9f\xbb\xfa\xaab\x8b\xf2\x96\xed\xba\xe7\xc1\xac#k[\x85\xf0]\xa9\\\xeb\xb7\r9\x14\t\x93RC\x01+*\xe5\xa2M<\xd8s \xe3\x0e\xd9\x13\xc0\x13\xdfps\x85j\x9a\x15i \xcc\x12\xf4\xe7븉m³\xf6Ɵ\x1b\x14\x14|\xc7ݿ\"\x82\x05.\x92Ff<M\x91\xb8Z\xa6xӦL\xd5\xe3^\xachXe%\xac\xb3\xc6\xfc\xd6F\x00\xac\xd3d\x9b\xd10s_E\xcff\xfeEE\xf9\xd5/\x88ʧ1%f\xf9\xcd\x1f\x88w\x9b\xe5\xacO\xc5f\xcbo\xfa\x00\xb0\x1f\x14\xd5\xe6\v\xc3\x18\xe2\xb1d\xb8\x9b#\x9b|\xba>%\x11;\x0f\x92\x82\xe6\xd3\xe5\xbb\xc3ë\x96ɣ#ΏS\x83\xfd6)f\x86\xfe\xe0<\xba\x85\xfae\xc0\xbf8\x91\x95?l\xe3\xd8b\x1f\x8e\xa9>\xd0\x1dn\xf6\x8cx|\xedc\xb8f\b\xa0x\xe9\\ۋ\f\x1c\xe6\x1b\xb1\xe1y\x8b\x83\xae\xc1ҔL\xfe\x9f\x16k^\x8d\xee-^\xab:E\xd8\xd2W.\x0ek\x81\x8b\xf5c^ \xeb\x0fϷ7t\x17\x05\x8f:\x85\x84\xeb\xa3{\xf7xk\x8c\xec諚\xdb\x1b\xba\xa4<\xbb\x92\xa2`\xc4\x1e\xf3G\x90jq\xd3G\xdd\xd0%\xcf\x19\xae\xe7N\x96G\x18}\x82\x18\xc2o\x0f\x10\x17\xafX\xfe/#Q\x8e\x98\xf0\v\xd5\v'\xac\xdc\xfe\xf4\x99]`\xd1x?\n\xbdT\x15#\x1fY\xbb\x85Х\xbcݡ\u07ba?<r\x8b\x0fxI\x1b\xc5y\x1b̲v\x1b\xa0v\x86\xa2\xdcNv\r\xe6\x16~\xae\xdfuU\x8aż\x16\x9emԨ\xf2\xb12QXV\x1b\xba\xaf\x1c\x9bg\n\xc6\xc9D\xc7I\xdecw\xbbg.Q\xf4\x04\xcfoM\x17\xad\xa3\xb3\xe1\x954\x96\x86\xfb\xbd\x17\xbe\xf5\xf8\x82jԣ\xd3wH\xb5%\x8e\xbf\xcb\b\xe2\xaf+\xe2\x94\x18\\^\xa9\x95\x92\x03\xc0\xb3\x90\xbb\x9e\x8c\xd6ђo\xaf\xab\x9b\xd4\xcd\t\xbd8\x94\x8c̤]͘\xa6\xa3\x96\x14\xf9\x8f\x02?\xf8bm=\xa8rod>!\x94\x92\x9dݡ\xb0\t\xc2:`\x97E\xd7Y\xb3\xbd\xe4:\xf3\xbe\xbc\xcc\xcb\xef^\x94\xaf\x16]'\xe4\xee\x93\xdd\xf7\xb5x(+\x97>\\\x95O*\xf9\xf2L>n!y\x96\xe5\x0e3y\xca~\xa7\x9c\xdb\x0e;\x05f\xf7\xa8SUh\r\x06\x9e*\xeb\xf6\x9cp\x0e=\a\x9c\xe2x\xf3\xdd\xe1\xe1?\xe6(~\xe8;.\x15\xab\xa1\xcc$\x8a\xf3\xa5\xadq\xbe\xf8B\xb0c\xfb\x11趓p{\x15\xff\xc9\xcf>\xe9\xa1s߀\xf9\f\xc7\xcf&\x1f1\x14k\x04\x05\xb7\xd1ڛ\xaas\x901\x9d\xefy$M\x00\x88\xf1\x8b/8f\x14M\xe26~\xbdɦ9E\x1d\xc7\x0fS\xd1Y\xa6\x0e\xd1E惏\xf8\x9f t\xef\x83hi\xdf\xdev\x9cS\xdav\xa3\xa4\\,\xe3*6\x1c\x10tJ\xcbm\xa0\xac\x9aW*w\xab=\xf3^\xfd(\xba\x8bh3\tj\x1bdHn\x82\x9dҵ幻\b\x92x٬*\xf6)N\xac\xf0\xcf>'V\xb2r\xfb\x156\xc5'A\x11d\x05a\xec<\xea(\v\t\xca\x1f\x80\xbb\x14_W\xbay\x0f>\xb4\n\n\xfb,\xf9\xb2\xf7\xf6mYP\xc4K|W\x8f\x94J1P\v\x15I\xb2ՠ}\x0eU\xb6tȮnEd\x96\xe8:ϙz4\xd2[\"\x80\x8b\xa8*\xc7\a\x1b\x06\x16o?P\xbaMcb\x81=\xcdh\x88e\x1b\xa9\xaa:s\f\xe9\x135\n\xa0\v\xee\xc8j\x8f\x87]\f[,\xed\x93<7\xbe\x8d\xe3\n\xb2\x05\x95Ѐ\x9d\rzФ\xba]o\xdfc\x1a4\xdc!\xda\x1b+\xbd\xab\x16\xcd6թG\x89\xca]ֶC\xbe*.\xea\xac\xcb\x03r_\x0f\xae\xcf\xe7\x13\xf8\xc9\xfc\xb1\xecUA{\x1e\xbchY?\xb8\x93Od\xa4\xa6,\x8f7\xfa\xa9\vb\xdbטf\x01\xad*z;څ\x13\xc9z\xf2\xebx\xb9\xdbQ\x00\x1a\x83^\x93Dv\xc6w)\xba\xe2\x1e\x1eZ\x89\xafٺ\xf9.\x8d\xe0k\\\xd4\x17\xe5]\x10vxy\v\xaf\xd6\xd6\x16\n.\x95\xc75\\\xc1U\xe8\xca\x10\x16\xc2x\xd8\xd6\n\xd1\xf2s\xb6|d\xb79\nJ=\xe0\xf8\x00\xb6ciY\x95\v\xc4\x06\xb6\xb3v\x13d\xa6j\x82\xed\xb9\xbbÃ\xf1\xa3\b\xb3\\\x12۴\xb5\xbc\xfcB\xb8\xea\xee\"\xbe\x19\xde\xee\xc3<\xe8\xdeE\xfbA;\x9d\x96\x9d\xdaܮw\xb2\xf5\x99\xe9\xb6#\xd5]H\xd4A\xe0\xb6s\xc2]H\xe4<\xb1e\x16م\xa2p$\xb95y\xecB\xf0\xc9ܹWˮM\xe4\x83\xf4\xad@\xe7\xdd\xc7\xe9\xcd\xfbGn\x11\xbbli\x7f'\x0fm\xd1Fn\x97\xear\xd7vi\xdc\xe5\xba\xfdO\xe9\x97m\xbeG\x94\xb5\xef7D\xe0\x99\r\xf8Rĝ;ډ\xe29[\x9aڶd\x8b\x19\r&0>\xed\x80\xf9F\xbd\x98\xc7b\xbc\xe9K\xcf\xd2\xf5œha\xe4\x99Ja\xe2&jZ\"\xff\x94\xdaUDd;\xa5\x14I~j\xcdש2\xefR\xb8{\x9dl\xbe\x0e\xd6ZS\xa92\xbb,Z\x17\xad\x1e\xba\x00\xad\xb8uF\x80\x93\x8aʀ\xb3\xf6<d\u009as\x9fNwg\x00O\xb3\xb70\xe4\x93i\xba\xff\xaa7V>\xe9m\x01\xaf\xf5k띓\x81\xb1\xbb\xf3\xdc\vh\xd9vڗN\x06\xdbo\x9et\xa89W\xab\xb5\xcf\xf8\xceT\xdfZYb\x01MhSw\xe1l.\x9d9\x84\xbbd6\x1f\x90\xfa\xd4\xcaֿ\xce\xe6\xae<\xa9\xf8\xd1d\v\x16rM&l\x025\v\xc2\xf6\xabv\xd9'\xbf,#\xb8Y\xff\xefk3\x92\x13\xf7\x1f\x10\xe7{]\xbe\xb9\xb4\x0fQU\xc3nD;J\xbd\xb009r\x03/\xa0\xb4\x9b\xb2k\x9c8#\xa5\xc6P\xd4\x0e\xc0\x8e\xf1\xb2ψ\xb1ǌ\xd7?\xe0\xef=\x1e\xfe\x1fy\xa2\xf2\xc9,D{\x1f\x94l\x9f\x1d>Ց\xc9g\x94\x93Or\xad\xac\xd3\xff\x1e\xb1\xff|\xc7Xa\x04\xc8i\xf0[D\x877\xbc\xef[\xd7(\xf6p\xc2\xd7\xe89\xd9\x0ev\xc3\xc5\x7f?F\x10.\x97\x1b\xaf\xdfD@X\xdb\xfeɪ\xfa\x14\xbb\xdb\xf2e\x9c\xc1\x84\x97\xe2\xb2\u008d\xb3)\x99\x85\x89\xf4\xf6\xb56\xb7\xe132\x81\xc8&\xfd\xc4\xddB/\x80\xf7.w\x90r0\x88k\"\xe8\xb0M\xb2\xea\tp\x0f\xd2Sթ=\x82Y\xd0\x18'\xe8~\xffh\xd9\xde\xd7o>\xb2\xc5;\x1d\xa5\x1fąm\xbe\xbe\x0f\xe1L'\xbem\xdc\"\xe3r\x97\xd3\xf0\xeb7\x9eS*jY\xd0C\xee\x0ezp\bR\xe8l\x9fo\xcd{8\xd3_\xbb\xd7E\xff\xe5\xefcQ\v\xf80\x93\x10~=b\xdc\xc8R\xedr\xfd8\xb6\x1es\xedu\x81\xca g\xa7\xd21\b٫\x9f\xbc\xfaGh\x9fvgu\xe8\xe5\xe3\xd0\xd7\x10\x8f\xc0Q\x95J\xe2\x8c\x13\xaf{\x8d\xedm\xd7\u05f6\xdf[\xbb\xd7P\xe7\xc4\xf2\x01\xff\xa3y\xd3\xea>c\x9bpԬ\xb1\x10\xf0\xc1\xect\x959\xacw\xf6\xdd>\x97\xb8L\xc6#|\xaf\x93ߞ+M\xdbo\x12\x19nR\xbacBy\xedg\xdfF\xdc\xe3\xdeA\x8f\x15\xa9a\xdf\xe8E\xed2\xe2&\x82\xe9;Ϗ\x0f\xdf\x1a\x95\xbf}K\xbb\xefe\xfb\xaaB\x18\xc2r\xbfK\t\xbe\xa6\xa4\xc2Ƶ\xb5C\xba\xce}\xf7\xbc\xb5\xe5;\x15\xd6[\b\x98\x98\xbdb8|\x0f\xbaϊ\xe1w\xf9\xd64\x9eZ\x05\xd6\xf5d\xccs\x8e\xa4\xb7U+\x19\xb5\x9bSں\xf7\x8fa\x96\xae_\xdd\xdb\x18E\x0f\xe3]\xe8a^\v)\x01\xeeद\xe9~\xcc\xdc~\xcc\xeb\xb9(\x84x\xa4\\+\xb3\xa1;\x05L#0^\x8ci\xadA\t\xfe[S\x93D\x10\xb7\xaf\x14N[\xfa\xc1Q\xbe\xe6\xf5Az_\xb3\xd7\xe3\xef\xd5X\xda\xf8\x8c\xe7\xddb\x96\xf5bV\x04\xb7\xfa!\x9f\xd6\u0091P\x88\x87\x7fZ$\n\x02\b\xf1ԃx\x1a\xfa\xa6b\xeb- S\xe8,\x86\xd0K?\xbaa\xb25\xaf\xf1\xf3\f\x02z?\xb5\u05cbL\x02\xdb\xd6\x7f\xa3\xfd\xf8B\x96\x99\x95-X\xf563\xcc\xfb\x940\xdd|\x97\x8aǴTگ+Ɲ\x87\xe9UN]\x16\xdbz\x1b\x9bI\xf8o\xb9\x92\a\xbb\x94\xe6s\xa5\x88\xb8\xe1\x02\x17\xd7zkҽ$0\x17l\xfe͜\xd4 \xe6\vr\x8f\xb3\xfa۬\xc8\x1a\x16P\xae\ni\xa0o\xa1\x92\xb0\xea\xf3ow%,ɷxs)\xdb0\xc8\xd2+þ@\xb0_|\xc1\v\r$\x10u\xb1\xeb@\x02\x02H\x10\x80\x7fέ\f\xd9\x1a\xfak=.\xdaI\nL\xc4\xf9\xbe\xe8\xd5+\x98\xb4\xfb\xcf\xf5\x00:S\xbf\"\x83'g\xf4\xafL\x91.\r\xa6q\t}\xf5\xce\x1c\xf4\xa6ï\f6a\x8b\x89n\xbe+.0\x81$gq\xf53\xff\f\xdc|5\xde[8a\x02\xa3V\xe6\xacµ\xac\xfa\xac\x1b\xb64\xfa\xf6\x83)\x9c\x83\x19F\xf1\xef\xf2lay\xbc\xacY\x8aj\xfbΒ\x94\b\x9aQ\x8b\xc1\xe7\xe6\xd3\xe7\x8d\xf1֫@C3\xc1\x1d\xf4\xa1\x19\r\xcc>\xe7\xeb\x8b\x11\xef\xf5\x10s%Q\xcdH\tR \x90(V\x10\x01͈\x1c/M\xc1\xd5\\@$A[#h\x16\xc1D.\x80+\xf6\xeb\x8a\xd5͗E\xb6\xa0\xe9\xe3[\xca\xfe\xfdw\x99ϣ\xdf\xfd\xb4\x03jQ\xbe\xdf\x05R\xee\xc4QwBt:dլ\x91\x92\xa3\x15\xcc\xe8\xa955-\xcaUm{u\xf1\xd0-\xacj\xaf\x16\b\xf6\xc72+\x1a\rE:\x8eݲ\xa2yS\xae\xaa\x84y\xbce\xa8\xd8\xdb\xe9\xea\xfa\xe4d\xf8\xec\x18&p\xf4?\xd8\xf4\xbfg\x8dx\xba\xad\x88o\xb3kr&]լ\xfa\U0009abb0^@\x7f\x04g0\x942Y\xae\xdc\xe0~\x06\x05\"w\xb7iK\xa3q\x89\x965\xd8\xfb*\x01~\xa1]NdU\xf4\xb6\x92\xa2\x85R\x9dU2\xb2\t&\xaa\xfd-\xb6\xf12*\xcc\af\x0f\xb2\x94\x15M6\xcbH\xbb\x12\x80\x91\xe4\xcc\xedTB.c\xe0Lx\xb5\xc8n\xa5G\x93\xd5\xcc&\x13\x06yV\xb0\xb8\xda\x11\xb2\x0f!\xdfr\xc8\xe0\x12\xe7\xc0\x11\\E\xa0\x7f\xd9\xcf\xddE\xdc\xfb3l\xd5>\xc8\xcb\xeb\xbdj*\xaf\x03\x87\xc0@\xdc\xe3\x96\xf9˶{\x8f\xcc\xfa\xb6\xac\x161g4F\xfd^\xc4M\xd0\x1b\fYOy])\x1c\x18\xf8٤g\xed\x10\xa4\x82C\x8f\x86\x11\xacmgH\x89\xa4\u0605\xa4oa\xe9\xaf=lq0t\xb1\x05qx\xd82\xf2`\xac\x7f\xad\x9a\xad(u\xcdA8`\xebeY\xb0\xa2\t\x06\xa7\x1e\\e\x95fE\x9c\xefC\xa1\x00\r\xf0\x94AM\xe0gУ\a\x18zrN\x8c\xcf\xe0\x12.\xafઽ\xcf\x13U&qî\xcbj3\x1a\xee\xd3\bYk8\xa0zp:\xd3\b\xb6`\x1f\x7f,\xf6\xf1v\xecӏF?݊?\xf9h\xfcIk\x14Y\x9c\xbf\xc4gBfO\x9f\xe2\xbb\x02\xe2m\xb2!\xa3\x9f\xe3$\x1e\x8e\x13\xfa\x99>\x19?\x1d?\xa3\x9f\xcfO\x9e<\x9d\xa6\xf4\xf3Yr\xfa\xe4dJ?\xd9\xf1ӧɘ~>\x9d=\xa5\xe7\v衈i:橣\xa7S\x96\xcczp\xe5\xa1c\xec\xa1#f\xc9S\xf6\xcc%i6\x9bN\x9f>s\xa9{\xfe,\x9d=\x8b]Bg\xb3\xe7Ϟ?qiNN\xa7\xc3\xf4\xd4%?9y\x9e<?q[2{:}\x92\xb6\x1a\x95<\xc5\xff\xb9\xedK\xa7\xe9\xf4Yj6\x95*fi\xccN\xbbZ=\x15\xcd>~~<}\xfa\x9c\n\x9c\x8eOO\xe2c\xfa\xf9d\xfaD\xa1I\x9e\xb3\x94s\xe0\xc9\xf1\xd3\xe7\xc7\xcf\x05\xfd\xf1\xf8\x94\xd7>=MfODSX:}\x9e\b\x80'\xe9\xf1\x88\x03\xa4ϙ(ƞNcQ\x8c=M\xa6\xa2\xd9\xcfN\x8e\x13\x01\x10\xa7'\xcfO$;\x9f\x8c\x04^\xf6\xf4\xf9\x13\x81\xf7\xe9\xf4d\xf4\x94\x13\x19\x9f\x9e\x8e\x04\x86\x84=I\x05\x93S\xf6\x9c\xa5O\xba\x9a\x9d\xc8f\x8f\x9e\x8dE\x81'\xd3\x18\vp\x96%1\xe3D'O\xd2)\xe3\x1c`ONO\x87\x1cv\x96>K\x8f\x13\xf13f\x82\xbcY\x9a\x0ecު\xe3Q||\xcaizz\x92\x9c<\xe5x\xe3Q\xfa\xfc\xb9`\xd1S\xf6<\x19r\x80\xd3'\xd3\xe9HT\xfc<N\x9e\xa9~M\x85\xe4\xc7i̦\x82\xf5\xf8?\x0eKϝ\t\xceNU\xb3\x9f\xe3\xffzpe\x8d\xe5_Wq\xd1d9\xdbG\xb7JXR\xae\x97W\x1e\xcd\xc0!\xde\xdf\x03\xdb{\x86\xa1\xb4Gz*\xf7`m\xe6\x15\xab\xe7e\x9e\xee\x83V\x01\a\x9708\x85\xab\xad\x98\xf9B\xa6\xd9\xec\x83X\xc2\x06~|\xb7\xd7\xd6\xca\xe6\xf6z\x10W^\xf5(\x13 \xae\x12ǊY\xa1\xaa\xa1PG?\xc5i\xb6\xaa;n2EP\x8d`\x02\xe5\xaa\xd9\t\x18#ƺ\x89\xab\xe6\xcb\xe2:\xef\n\xf5\xc4#\xaa\u05f7\xd7o\xe3*\xf9\xf3lV\xb3&\x82\x18\xeb`Ez\xef\x82i\f\x13\b\xe2\x11\xbc\xc0\xea\xd1\xeeJ)\xf1P\x90\x13\x8f\x04\xf24\x0e\xe9W\x1fb4\x95\xa53J\x83\x17|\xd5\xf3\xe3wp\x01\xbda\x0fΠ\x87c Q\x81X\x92\xb2\x0e\xa8D\xad\x92\xea\xac\xe0I\xc9Ȃ\x1a!\xd4Ȃ\x1a\xb9\x06\xa94\xc6\xdbu\xba\x1d?\xc4k\xb8\xc0\xbe\xb8\x80\xde\x0fÈ\x1e\x16\x1e\xc1!\xf4\xbe\xd4?\x8dT\x18\xc2(\x1a\x01\a\xec\xef\rɓ$\xfe\xa1.5ԥ\x86\xaa\xd4P\xe1\xdf\x17\x92'\xfd\a\xb1\xefs\xb7\x82j\x11\f\x13\xa9\a\xd8_F\xc1\x03\xec\xacm8\xf1#\x9dQ\xfa\b4\x92\x91\x83\x84\xbe\xbf\x17\r\xb4\xf3\x87*\xbf\x93=F%CP\x85\x92\xa1\x83\xc4d\xddߥ=\xc3h\xd8\xe3u\xb6b\nhm\x00\xa6\x90~\xa7\xd3#S\x13X@\x7f\xd6鑡\x05,\x987*9R\xa3\xdd\x02\xf8F$r\xca\xe2*\x19\xd84is\xbe\xf3`~;\xbe\x81\x18pFyeVq\x9bIX\xcb*\xb8uGk\\%\xd6}u$\xc8n\xff\xfd\t2\xca\xcb\xdaZ,\xbd\x17A\x16\xaf\xefO\x8f..\xebr;\xef^\xd4\x18\xddz\x7fZ\x98\xd5\xfd\xe0\xc8Ƚ\xe8HX\xd1Te\xe6\x9d\xc6\xf5{\xe7\xc1\xee\t\x10\x0e\xf7\x98\xfcB8\x82q\x044\x19\xed3\x03\xee\x98\xea\b\x9dg\xbes\xda}iL>!\x1c@\x15\x19S\x0f%\xc0\x95/ނ\xe0\x97eN0\xab\x81\x89\xd8\xc6\xff\xf8\x1do\x97=_M`\f\aj\xde\xecÈ\xf5\x9f\x18K\x11ܪ\xefX\xe3\xdc^\x93y\x05wgr\xa5\x13\xfa(B\xa0\uf525\xa5\xacZ\xcfzs\xb3\xc0\x99\t\xff=%\xf1\xcdw\x8f\xe7\xf7\x93\xbc\xacY\xda\xf3\xc0\xbd\xa2\x1c\x01\x8d\xf6\xe0>\x0f\x9agþiؒ_R7!\xe3Yê6\xe0\x97\x98\xcc\xe1\xa6q\x9d\xd5\x16\xc0W\x98\"\x90Pn\xbf\\\xb2\xa2׆\xf9\xf3\x92\x15\x16\x9c\xaf\r\x04i6a\xba*Ҝ\xd90\x94\x14\x89\x1b\xb7|{l\x01\xbc\x12\x89\xa26\t\xe3!LB\x1a\xb4)h\x1fy\x12ޤpQ\x16eS\x166\x8d?\x88D\xf3-;o\xff\xef\xf3\xae\x1d}\fn؆\aD;7\x129\x91h\x80\x15\xf4\xfe7a\x82\xc5\bx\xfaE;G\x02\x89\xcb_\xb1\xf7\x19\xa3\xa50-\xc9\xc7p\x04\xc7\x11\x8c\xf8\x9f!\xb7\n\xfaJ\x8ce\t\x01:\xdeY\xe2\xd8,\xf1Ī\xea\t\\9\x03mP\xc5i\xe6\xb7Va+\xc4XtƜ\xfc\xfd\x13\x95\x15\xdaTa\xa3I\x88\xbe\xd6t\x1a\xc7\x1a&>\r\xc0X\xa8e\xfa\xd8\xd8p\x1bK\xd9`\x92eJl\x8d\xa9A\xc5nYU;\x84\xaa\xa1\xe4)G\xe9\xdd\xc58Zkw\xc4\xe2\xdd*\t\xa1\xfc*\xc9@\xb3\x83\xe1\xa2&\a\xa3\x97\xe1\n\x1b1\x9c\xbe4\xc3\xf9\xa7\x01h/Zx\xf6\xd0\x01\x1f\x1a\xf0\xf6\x9a\x82\xe7\x8f\x1c\xf8\x91\x01/;\x94>66\xe0ƀ\xb3\x16\a<\xd7!cc\x92a\xcc\xe0<ӡa3rf&\x16\xbb\\O\xe6e\x95n\xdd\xdc\x12\x84ǿ\xab\xe6\x0e\xf7\xe4Y,\xe6ؚ\x8e;\x84\xb3@\x04M\x1b\xa2\x89\xabk\xd6\b\bg\xc2\xe5+\xf4z\xb0\xc4\xe58n\xa9\xebA\x15\xe1\xf7\b\xff\xa5\xdde=\x88\x878\xc3\a\f=$\xeb\x001\xe2iN\xb2\xaan\x99\r\xcf\x7f\x0e\xf1좕\xdb\xe0φryM2\x81\xf2\xa8\xa6F\xd4\xc4\xcb\x1a\xf9\x1as\xd8^\xe7+\xa6\xa9f\xd7,\x9fE0\x8b\xc0\xc3@\xed\x8f>\xe3\xae\x16\x1cXp\xaf\x82\tp\x0163e!\x0e\xe3\x1a\x05:\x00\xf7\xb0\n\xec_\xd2\xe95\xd9\"\x80\xea\f*}\x0f\"\x1e\x9e\xa1\xa1@\x7f\x8f\xce\xd0^\xa0\xbe\x97C\xb4\xdcWp`,\xb3\x86a\xa4S\x84!\x00\x8c\xcb\x17\xcbQ\xbb̨Uf\x14\x8aӀ.\x9fd!<\xe63\x81z\x8c\f\x90\xa7\xe8\xfc\xc2-\x1e1\x8a\x03\xff\x1ey\x91\xa1\xf8T\x11,}\xfe)bO\xa97\x8aƎ\xf20\x88\xe1\xa5\\\xe7\x85\xc6\xeer魆\xcba5\x8c`9D\xabQ\x04\xcbQ\xbb\xbe\xff\x03\x86b;\xbc\x1c\xb9\xfbO>8\xb5\xfa\xa4q\xfdF\x8cX>.\x9d̟\xc5`\xad\x9c\xfd(e~\xda\xcd(\xa1\xd4\xfa\xfa\xfe\x9b\x9b\xca\xda\xf3U\xfbl\xf7\xa8Nk\x05ϩP\x8cz\xc0v\x8f\x8a\xcazL\x8eߛ\n\xd5#\xf7\xa7\x82\x17\x95\xf5\x98]{\x7f^\xfcö\xbf]\x14\xfd#\xb6\xc0mZ\xdc\fsJM\xb3\xf8\xba\xec8\xe4\x94\t \x81<\x13뒔:\xc9N\xcb\x03/\x82\xe51LD\x9fzr\x170\x81`9\x1clP\x05\x1c\x0f6b\xebLw\x9eHsȊ\x00\xd6g\xb0\x1c\x0e\xd6Z\xbbn\xce`!\xf5\xa6\vx\xdc\r\xb8<\x06\x15\n\a\xebY\xd2\x0erY\x95\xefĵj\xef4\xbf\xc4\x00D\x87\xd0{\xc5?F\xf4\xc15\xd7\xe5\xd8\xfc8\xbe\xfat\x8aL\x13\xa5\x01dG\xfc\xa8\xf2x}2ݣ\x0e֟N\x1d\xac[&lQ\xad%m\x8a\x96\xb6RX\x7f:\xa5pOZ,fޟ\x9e\xa5\xc3o\xb0{g\xbd\x0f-\x9e<\xdf@ܱ\xab0ƫS0\b\xf7\x95\x19\x13\xee-\x02\xb6\xb9t_\xf6u\x87!0\xaa\n\x1c\x8a\xf8\x1eH\xd3\x15\xd8L\rq9쒴\x1f3\xa5'\x97t\xea2\xb2L\xbf'\xd3\xd3IdכŴܮ\r9\x88\xa3\v\x05A\xb2\x89\x1c\x86\xee\x84\x05*\xb2\x83\xa9\xfdB\xf9\xa0\xab\x02~\x95UI\xce\u00a0\xce\xde{\xc0[~\xa7:ގ\xc2\xf03\x05\x92\xab\xb3\xf7\xad\x9c7\xd9{1\xb2\xf9\xf7@\x14\x7f\xc0\xc8\x14\xc1\x1c\xf0?MC\xe7\xa8\xe4\xd5Y]'(\xa8\xb3\xf7\x0f\xa3\xa0Vm\x01\xa3\xad\xf7\xa1\xa0\x95\xe3\x1a\x1bE\xef\xb9&Ƅz\xe8\xcc\xd7m¨V\x95um\xbc&\x83\xe4\xb5-\xcd|\xf1\xffk\xd5P>\x1c\xc1)M|ޙ\xa7\x7fL\xb6[\xb9&\xef\xd3\xcf?\xe9\x9f\x7f\xb1\x81\xfe\xa4\x16\xee\x7fq\xc0\x15\xc8_\x14\x88\x03l#\xe9\xfb\x80uEj'\x19IE\xb1(\x8bt{\xd37\xbe\xb6\ah@\xb6\xa58.\x8e\x87!n\x94\xd60\xc1R\xbe\xfc\x16\xb3\xe4\t\xe2F\x1fԭ\xf9\x81\x1b\xfe\xee\xc9sÍ\x9a\xac\xfbV\xbeӞ\xfa\xd7U\\\xb1{\xf6\xe4\x96^t{\xf0{{\xabկ\x14Y\xee\xfe\xcb)\xeec~\xaf\xa92\xb2\xd9\xf4\xd3\xf2\xae\xe8m\xa7z\xed\xeb\x04[W\xfcZ5\xc7a\xc4\xfb\xabZÁ'\xdb\xdbR\x93\xc5f\x0f\x18\x1dcq\xdeH\xefl\xd1j\xf9\x8fnO\xdfߠ\x8e\xf68\xcd1\xac\xd8\xc6\xec\x82z\xbavUt\x8dv\xea:\b\xbd\xaa\x88\x13i\xb6\xf48\x8c<\xc3B\x824q\x11\x1c\x0f\x8d\xa3\x99#\x18=\x1b\x9a\x94\xc4\xeb\xac\xde\xee&\xb2\xce\xea\xe0Z&\x03\\w>\xc9\xc3\xe9\xbd\x16+\x12~\xf3ǸΦoJ$7X%\xfe\x95oƨ\x8b+\xc2\xe3\x86@\xac/q,F)\x11\x95\xfdRN\fo)t\x11\x81\xa6\xe5\"\xcex,#\x8d\x9e\x83+\xa7X\xfd\xf1\xd6[\xb1\x00l'\xed\"\x81_\xab\x11U\x8b\x1a\xec\xa6\u05eb\xa9l\xbd\xb4)\xac\xb3\xfa\xcdj\x9af\xb7Y\xcaLԂj\x95\x17F\xb24L\xe0\xda\b\n\xd8Ç\x81ʪ\x17\xd2\x1bE\x81\xac\"\x12\xe4\xe8r߈x~\xe2\x93\a\xed\vB\x11\v/\xa0\x83\xb1^\x04\xbd\xeb^H1\x02\x82\x1e=\x8b\x89IT\xad\xac\x86?\xa3\xd8+\x97q\x925\x9b^D\x87}F52\x82\xfe\xc0\x0e\xe6\xc0\xeb\\gM\x10vᐯ\xa1hd\xff\xae#\xf5{\xd0\xf9\xf0x\x84\xcdeص\xe4\x95\xcb(\x93K^\x16]#3\x96q3\xdf\u0086N\x1e\u070f\x01[Z\xdf\xd9t^\xeag\x84D\xb7o\x9b\x13\xe4\xfc*\x04\x0f\xc5\xec'\xfc\xe6\x12\x87\x1b\x86\xb8\x99\xb7\x04\x8b\x8f&ɭK<\xa1\x12\xa0\x92K\xf8[qI\xbcb'\x19\xe4ȐD\xc6\x11t4\r\xb3\x9c\x1e$\nG0\x01\xe1\xfc[.7$ \xf85\x94\x17j߾M\xe6qռ}\x8b\xabi^\xe2ܾ\x12\xad!&m\x00\xd9\xef\xaa\t4\x16\xbcà\x17n+G\x17\x9e\x9d\x06 .S\xaax\t\xa1\x1fEM\x11A)\xa6\xe8\xceo\xc1\x89+\xd8$L2\x8f\xd7JW\xa9\x03\xad{\x04pwռ\x18\x87\xdaV\xb5ۨ\xfa.k\x929\x04e\x95ѽ\x14\xad\xff!\x89k\x06\xbdi\xd94\xe5\xa2w\xa6\xd3M\x18\x00[Lme\xf8\xd7s7\xe4\x91\xc1e\xea\x8e\r:\xb2b\xe2\x0f\xa8\x90pG\x13zˈV\xf0Bk,4\f\xf7E\xa1\xfa\xacU ~\xe7-\xa08-\v\xf4\"}'\xd7*\x88d\xc0!!\xfb1NSy\x11Ӯ{/ڷ\x90b\x97\xb7\x8a?\x9c0D,\xb0\xa4\x88\xa67x:b\v5L0\xbb\x1f\x17h\xbb\xc2\xccE\x96\xa69\xeb98\xf4ؗ\x98z\x91X\x1c\x93\x82\x12\x06\xb6\xa8'(\xf9\xa6H\x916ڃ\f\xff\xa4\xc1FWj[b\x809\x95M+\x16\xdft<\xc4\xc5%\xb5)\x97\x9fUL\xfb\x9f@N\xfb\xf7\x16\xd4\xfe}%\xb5\x1f\xec-\x12\x0f\x10\xd6\xfe\xc7I\xebÉk\t\xec\xf0s\x8ak\x7fOy\xed\x7f\x8c\xc0\xe6l\xd6<Lb\xff\xb6Sb\xd7\x0f\x97X\xa7\x94\xd9\xfb\xc3\x1d\x12\xbb\xbe\xb7Į?\x83\xc4:\x04lk\x80Wb\xefC\x8f!\xdbÝ*\xf6x\xdc-\xb3\xacH\xef!\xb0\xae|F\xae\x14\xffi\xf8\x17W`\xff\xf4\xd1\x02[e\xd7\xf3\xcf+\xb1\x0f\x12؏\x94\xd7\xfb\x8a\xeb']\n|\xa4\xa8\xeeM\xcb'\x93S:>\xbd\x87\xa4>TP\x1f$\xa7\x1f\xac\xd0]\x86\xc5\xc1Z\xd8\x1a\xcbgn\x927\xc5V\xeeF\xacJ\r\xb9\xeb,1j\x95\xc0\x9d\xe3\xbe\xf0րؓ*{@ܳ\xa2\xbdi\xf3\x04\xd3%S\xd6Zm\xbe\xf8]̯bz\xf5\x9cN{צ\x15*\xb5\x99\xafM\xf5T\x18\xb3\x0f!][\x1dz\xbewg\xad\xef\xd3Ok_\xec\xca\xd6)\x8c\xbcZ\uee5c\xccwI0Q\xdb\"g\x00\xc3\x04\x9e\x88\xa4\xacp\x93\xe4(P\tbl\xc2\x04\x8e]\x13\x14\x9d\x96\x8fȋ\xd44\xad\x89@,\x86Mʱ,Q$\x94G\x00@\xa6>uK\xfe\x01\a2XR\x9d\xc8\b4\xee\x91(\xd6a{\xe6\xaf\xe9\xfd*\xc1\xa4\xfb\xd7ʋ\xcaZ\x14\xa2\xfd\xea\x95\xd68\x8f5s{\xad6\xebuD\x16\xa7CT\xf9\xbd\xa9Q\xddv\x7fN\xe8\xe2&=\n\xe1\xfe\x1cQ\xa6҇\xd1\xe0\x98>\x8d\xa4{Q\xf1\xc6=\x18\x8c`\x13\xc1\xfb\xfbP\xa2\x06ٹqT\xe0\v\xfb\x88W\x16Lz\xcd\xd1y\xb8\xb6r\x8cAZ\xc0Kz/\xf2p\x03gv1\xb3\x84\x1e\xc3\b\x8f\xb7\xd4\x0eU\xf5\x97\xc5UWѝ\xfcѪ\xe0a\xdd$ʛ\xa4j\x94\x87\xf7\xe8'C\x91<\x8c\x12\x85\xc1\xa4\xc5D\xbb\x9b\x1a'\xdd\xf4\x01\x98V\x1dQ{d\x02\x10\xc4G\x9eqD0\xbdn\x19P1\xba\fz\xa5\x16\xa9kD\x9d\xb5\x81ٺaE\xe3\x026\xef[\x80\x15\xc3\xe3-\tȿL36\xd33ֵ4\x13Sd\x14V\xf5)\xc0\v\x193\xe3<\uf143\xb2\bz\xe4\x19\x81G\x86\x9cU\xbd\x883\x84Vm\x1c\x82\xdc#\xe8\xdb\a\xa2\xab\x9b^\xb7\x8c\xc1\x15K\x9a\xb6%\xd5b\x8c \xf16\xab\xb3i\x96scvo\x9e\xa5)+tn\xb2\xaaj\xbe\x9a\xa4\xc3\xf5y\x9cU\xe6\x8ar\xb6o͊\xcb-\xbchy7Q6\xef[(\xdbG#]+\x16yx\xc8{\x87\xdf\xc64\x16\x12\xed\xeaw \x12\xdb\"\xe2\xfa+*s\x99^Y\b\xbd\xcd^\xef\xc6|t\xc9\xee\xae䥗\x94\xa2\f\x1d\x8b\xc7\xc4l\xfcj3\xb0\v\xe1\x7f^\x16\xf5վ\bﲴAyz\"\x13\xe6\x8cv\x8c\x94\xb2U2\xac\x9e\x12\x90iV/\xf3x#哿\xd1\x14 \r\xbd\xa2,X\xcf}%\x8d\xf72\x9dɨ\xb3\x17\xfb\x85\xbc\xb5\xdd\xc6\xf6\t\x8a\xbd\x9a\x9c^\x1b\x9cg\xf4J\xb6\xddNڰ\xf4yֹż\xb4\x8a\xef\xfe\x1a\\{֛\x9c\x92\xcd.J6~J6\x0e%\x8a\xc1\xdbI\xf9[\a)<\xd7\xc8\xfc\xe0\x0f\x85\xa8\xe0\f\xad\xea\xd5b\x9c\xaaF.\xb9;\x05L\x0e)\x82\xa4\x98\x898\xaa\xf8x\xbe<<bZ\x86\xafL\xb3\x9c\x02\xf8\xcf\xda\x00\xe0\xbbư\xb7W#\xfe\xeak\x85\xa9\xb1\x8d.\xe7\xb5\r\xafL\xa6\xfa\xf4|4(^\xe2P\x8d\x06\xf5Kk\xc8*A\xe1\x98FԘ\xbe\x0f\xb1\x97ֿ\xedG\xebƢu\xb4\x8bV&h\xbd\xb3iբ\xa4\x88\x1d\xd9Ď:\x88\xd5Ӈ1\xb9\xaa\\\x9a\x91Z\xb3\xae\xe5\xb1G\x13\xd9 \x99\xa3\xe4\xa7?\x9b\x81\xca\xc0\x1f\xacL\xfa\xf8\x05\xf26\x8f\xf8\x0e\x91\xbfg\xcaCPd\x87n\xc8lE\xdb\r\xdb\xe0\\\x19\xb8A\xbf\x15M7l\xf3\x8ab\xb9N\xe0x\xdc~6\xe7qZ\xc5\xd7\xd78S[Y\x00\x89|p\xcf֔bWs\x9d\x15$\b\x13K0\xbc`#\al\xe4\x80I\x02`\xa2\x9d]\xec1\x0e*\x16ܫ\xb8HX\x1e\x84\xdd\x0f\xf1\x9b\x8cY-\xf7d\vE\\UdL\xc0\xe1\x93n\xefaw{uk\x0f\xbb[\xab+\x81\xe1\xf9ǵ\x8fD\x96O\x11\x8e`ʸvBl#@\xa8\xb4\xfd\xa81\xf2\xa3\xa4\xebHvs\xa9\xbch-\xcfw\x9a\xca\x01F&\x80\xd9\xcc\x0f\x8f\xf6\x100\xab3\xe2\xbc\xf9\xef̙PDi.\x86\xa1\x16\xc7K\bL\xe5\x03\x87V\x8f\b\xebM`\x0ey\vd\xc4A\xe0\xaaK\xa4U\xe7\x1d\x06\x8a\x11/D\xf5X\xc169o\x95\x1d\x19eGW\xa1+\x0e\xc20\xd51\xd4l>\xd2\x04\x95\x15\xd7\x7fEa\xc5\x1e\x1d\xf1:\"X\xa3A\xd4]\xfb\xb4\xa7oPr\xd0T+\xb6\xab\x9a\xbf\xb5\xaa٠O\x87\xaf\x9a\xbf=\xa0\x1a\x82\xf1!sp\x91|\xbcu\x1e\x17B\a\xdf3艵\xbf\x95\xb5\xa0W\x87\xd40\xbb\x10\xebh8\x93k\xdf^׃[]\xe3\xcc\xe2\x81\xf0\x88\xca\xdc!\xb7ݛ\x85\x02\x19I\x8b\xb1\bW$\xad\xc5\x11,K\x15\xbcZH\x12\xbeu/\\\x88\xb5\xe0f\xf6L\x860\x8b\xac\x88`\x11\xaf\xed!\xed\x1fo\xd5\x10\xb5\xb0\xac\xcbZ_\x8d0\xa7\xe6\xd6\xed6\x80\xee\xb7Ef\xc5\v\xc7{t\xfc#+\x02\xbaLG\"\x9f]\x85\xe1>\x04-(NC\x80H\x0f5a!\x1cZ\x9e\xd4^\xe3-\"\x95z\xc1\xe0^7e\xe8\xcc+F!\xb1q\x91\x15ax\xee`T\x98^P>\xd82\xc7\xc9]d\x16\xeb$O|l\xf5\xd0-\xb1x\xc1;\x06\x8a\xd9\xe3\xf4\xca\a\xd6\xf8\xfb\xef\xb6`Pz\xec\xee\r\b\xe2k\xf2s\xf2L\xe3\x16\xe2V\xcb,\xf4\x13[\xc8\xf4z\xc6;\xc0\xfd3\x15#Ӻ\xa6Ϙ\xbe\xeea\x1f\xf0\xae\xd8\x1f\xbc\xd9\xd26\x93\u07b4L7\x9e]\xb8S\xe0N\x1b(\x90te} 0\x9d\xb7Zzrh}\xd7Q\x8a\xf2X\x91z\xb2\xc4\xfaΟ\xe3V\xa4)\xf5\xe8MSk\xe2Q\xed#\x9f\x1a\xecZ\x88|0,\x96\xea\xde\x13\xbf\bB\xf0?\xeb\xbbP:ʾ\x98\xdd9|( \xdf\u0084\xff\x18\x943\xb5\xf85\x83\xb49\xd6,YXNL01kD\xa3\xd3j\x11\x18\xf9\x7f\x85\t<>\xfaϠ\xf8\xbd\x0e\xe5\x1eL\xe6\xd1Sbk\r\xfb7\x01\xcb~\xbf\xf3\xc3n\"s\xc9f\xd6K\x16\x17\x96\x06ʂ\x13\t\r\x13\t-n.\xc0J\xeb\xd27\xf2\xf0\xcej$\x0f \x1dvH\x97\x1a*~\x11\x93\x1d\xda%fN\xf1\x96\xac\xd9\xe5]y\x13\xdf>\x91\xa3\xaf\xd0\f\x05\xee\xd3\xf4\x9e\x95\xd5Pl\"U\xd6\xf9\xa3-k\xa9\xa1\xd8ũ\xac\xf3G\x96\x865W-\xee\xbc\xccP\xdd\x1e\x1eݵ:7\x02\x8a\xberx\xf4\x9f\x85\x9be\x10#c\r]*}Hs\xb0K~deo\\z\xe1\xea|\x1b78\xbe\xad,\xe08=\xedn\xad\xa0\xd5R\x92c\x19\xd4y\x96\x18*v\x8b\x82%\x1d\xb9\xaf\x86\xb5\xb4\xcdn5j\x0e\x1c;OO\xc3-\x8de\xea+~d\xff\xc8\xd5W\x9eIį\xc0\xf4\xd1(e\x1a\xd1ſ\xce\xeae\xdc$\xf3\x80pEV}\x91Zc\x1aj3\xe4g\xc3\xfc\x18s\xa3~\t\xbb\xb7v\x19\xa1\x02?\xf1TCFH\x9a(x\xcePE\xe3\x1c\xc2\x15\\Eք\xcd\xc9\xe6\x13\x99u\x14\xbd\xf7\x81\x93\x9a\xb0\xb1\xf8{\xf9\xb1\x9d\xcc\xc7kx\xf1\x02F\xf0;<\xde\\9g\x1c\x04h\x1drp\xea6\x0f\xa2n#\xb1o>/u\x8a\xe9>\x12Q\x1e0D\r\x86\x9d\xc1\x181\x18\xfa\xa59\xdfN\xbf,\v\xf0\x1e&V\x97\xe9U\xd9V\x93\xedz\x88-\xe6:\x10\xab\xa6\xaf\x965\x81j7\xb1\xdbH\x04\x1aS\x9f\n\\\xdd\xe6\x18\xa2e\x90\x15\xb7\xacjB^^~\x06\xeba(\xca\xeb\xa4Q\xe8)?\x82\x17\xb0\x1e\x86@g\xda\xc3H\xa0\x19\x89\xc2\xcd>+Zg\x9f\xbfQ\xfc\xc0\xcd\xd0F\xf1ct?~l\x86Ό!pu\u06dd\x88\x16\xc5\x0f*/?\x83\xcd0\x14\xe5u\x92\x8f\x1f\x1b\xe4\xc7F\xf0\x83D\x88ЌD\xe1\x9d\xfc\x90c\x95\xd6\x1apA\xaaa\xcdѐrXsLW\x806\xc15\\\x88\xec\xf5\x88\x126X\xecR\x88\xae\x9ee$zg\x03\xe0W;\xe7\x8f:$Uɩ!\xa4\xe7nG\xf2\xee\x1fj\xa8\xf5Ȓ;\x93\x041\xeds\xb9\xb12F2c\xe4\x8c\x1cGZM1\xb5\xe5\xf3~\xb2i\x0e\x1b\x82\xe5\xcf|\x99C\x87J\xea\x97\xc14S[\x12\xbc\xd1|\xda\xf8\xf9\xb4\x96\xd25\xd4b\xb9\x19Y`.\x9fh\xf2\xdfx\xf8\xc43\x1c>\xb9Rl\x8a\xaf-\xb7\xf7\x93Ys8\x11\xac\xcb'A\x8d\x8fO;\xf42\xbd\x99\xe3\xf7tٲou:Ρ\xcf\xe9?\x87\xcc\xe1\xbe3\x06n\x1b\xfd\x94\x99\xa3\xd5&e\xe2\xd4\xfe\xfb\xef|p\xda\x14N|\xeaȉ\x1cp<\xa8\xd84+R\xb9\x1e\xa1EJ\x04\xbdR\x1c1\xbaw\u008d#X\xf5JRq\x06\xbd\xa2\xee\x8b\xe5\x1b7\x90\xe1R\x8a\xdd\xd9i\xb5\a\xee\xce\x03W`bqW3'\x19q\x16\xacv\xb12/t}\xe7@w5\xe6'\xb5\b@\x8d\xd5+\xe8\x98\x1c\xff\xa1e\xea\x1d\xfeS\xf0\x7fy*\xff\xf7\xae\xc7\xf5\x1a\aU_\x05/H_W*6\xe1\x94\xcd\xe3یs샓6\xc0M\xcc\xf6\xb03U|\x1d8\xefFڎ\v\bы@%\xb4\xdc\x16Z\x00\xdeS)\x95\xed;\x94\xa2\x15|\xdb\xf6\xaf\x03\xdb\xe8W\x1d\xfd\aQߥ\xf6A\xd42\x1c\xc8W\xc3eӗ\xde\x13\xf5\xa5\xf5\b\xced\"љ\xc7\xe9\xce)ֲ\xfb\x00\vY\xd1:Ơ\xe9\xbe\xd5\x0e\xb5z\xc42dP:o5^\xb0%\xe2ޝKs\xa7\xf6\x96\x94t\xba\xa1\xf4\x91\x99n\xaabn\xbd\xfe}\x82\b~\x87t\xe3n\xcc\xdeb\xf1\xdd\x06\x93m&\x18\xde\xfbF\xfa\xfa\x8cSz\xa8\xcf[\xcc\xec͙\x8c\xee\xa3N[\xcc\xect}\x06\xe9\xdaJ\xc1W\xcf6\x1e\x03\x8f\x97\xfb\xaein;ᝦ\xa3\x0e\xb3~7\x83\x9cC iV\x9aX\x86\x96P\x18ݒ\x1c\xaf\x18\x8aqC\x1f\x11\xd9\x1f\xbdN\x01TD\v\xb9a\x14A\x04}r\xce\x16\xb9g`X\\\x04zecsQ\xa0٤\x13\xc3ji\x95\xefd9\x11o1\xbc\x9bE\x9e\xb6{\x91w\x99\xe5v\x1a\xdbZ\xc6;\xb3/\"\xd5F3\x8b\xab\v\xfeH\xa5\xf7\xdc\xfbrxeh\bi\xfd\x8a\xcc\xf1#\a\xa9<*\x1a\xeee\x1b{x\x8fJ%\xf31\xbd*\x84\xdf\x11;:D\xa5\x86YF/i9\xe2Y2\x88v\xab\x03\xbc\xb6&\xfes\xb0v\x14\x97H\xdeXz\v\\\xab\xd0o>\x8c|\xb1\xef[\xca>\x16\xed\r;\x85\xb0\xcb.\x83\xec\xf0\x9ae\xf6\xb0\xb5`\xd9H\xeaA\x13U\xa4uLh\x18R\xf5\x1a\x10s\a*\xfdA\xee\xd9\xd7\xfa\xa4C!jEʪ\xe2\xeb\xed\xcb3\xde\x04\xef\xea\xcc\\L\xbc/\xcb\xc5\xd6\xc5\x04\x02l_L D{1A\x9fws\xc6r\v\x80R\\C\xb2\t\xa0\r\xc1_\xff\xf9\x87\x1f0\xe5MR\x95y'\x96t\x9as\xf5#\xf2\xe5wkI#\xf2u\x8ak\x8f6\x01<\xe6\xe8vy\xef\x9a\b\xdf\xe5w\xd7&*\xd8| \xe6{\xe5\x93%<\x02ım \xa6}\x9d=\xd2\xd9p孏\xab\xaa\xdcSY\x8eu\x1d\x88\u0087V\x9d\x11\xe4\x97#\x7f\xe6誣\"\x02\xfd\xb9\f\x8c\x8b>\xbc\xb4q\xb0I\t\xdf\xc8=\x85q\xc8if\xe0\x1e\xb3\x0e\xfd\xdcSt\xfc\\\x06\xcb\b\x8cf\xe5J-\xeb\xd9\xc5l\x12\x1d\xd2r\xee\xe6\xc6\x16\xdfjءZX\xe5z{\xe3\x86l\x14*\x80F\x8e=jף\x10\xd6#\x194e=\x14\x0f\xa49\x0f9\xae=Nx\xc1\xba\xabӵC\x1daY\x0f\xe5V\xd9R\xe0\x9bQ\b\x1bU\xf3\xa6\xa3捯\xe6M\x97<95o\xda5\xab\xe9sYq\xf5\xc8f\xf1*o\x1c\xd5\xdbּ|\xa4\xa8T\xaaMD\x9cѩ\x8a\xa63\xfds\xbbs\xe1\xd6݆\xd2\x1dF\xa6\xf6\xf40\x83W\x18\x12\xe6:\xb1\xa1\xc0iH[\x14\xdev/\x98\xc4<\xdc\xda \x88\xc5\xe6\xae\xd5SK\x0f\xb6Ok\xcd\fς\xd6Z\xeb\xec\xb3L\x15\xf8|\xcb\xd4O\xb6\x1c\xf4\xd1\xfc\xb9\x97\x83j\xb9\x16\xedu\x80\xe9\x9fw\x1c\xa6\x8bO\x14\x0e\x98h\xf5\xeeʎj#\xafj0+\x93U\xbd߱\x8f-H4\xb7\xb9\x17\xab\x94ԢYL\xfd\xf6Ӄ\xd7+B\xeb^\xd9\xcfe\xa0\xde\x14\xe5o\x91\xc8\xf9\xff-6\xf2k\x967q\x10\xc2\x01\f\x86\xc3q(\xa7\x85\xb6\x9a\xfd\xb9t\xaa\x89\fbtS\xadqC=\xe9>\xce\xd2\xddzg\b[mի,\xa7\xa4\x9c\xf3\x9d\xe7i\x96F$KI\xafՇ\xcb\x16\x9b\x94D\xd5\xf3l\x86'\x962\xae\x15\xf9\xe4\t%\x06\a0\xf62gi*\x90\x87\xb2A/0\x9c\xe6\xf8\"p\xcaf\xb9\x8fh[\xed\x1a\xcak\xa4\xe7>\xae\xfe\xa6\xae\x82\n\x9c\xed\x17B,\xffK]\xf8\xd2|m\xf8\xcadl\xd3\xf2v\xef\xd6\x1a(߲f\xbe\x18&\xdd5r\xb5\xa9x\xdf\x1b!\x9bl\xc1\xe0\x05\x9c\x0e\x87a\xeb\xd2\xecR>{,N5\xad\x0e\xd7\x19\xf6\x85]\xb9\xb4i\xf5\xee\xd6\x1e\xbeW/\xdb\x06\x00\xdd\fz\xb8\xdc֏>\x81p\xc6\xc5.y\xa0\xf0\xd2\x16\x1b(Aw\xddrh\xf6\x9d\xd9\x17ˑQtt\xa5\xeb\x14\xfc\x1dE\x90\x8f\x1cd#\x1f2\xe0T\xe0\xaawȍGˑ\xe1\x01\xbb\x1cr\x93\xd1r\xe4\xf3y\xcdE\xd1\\\x14\xcd͢\xb9(\x9a{\x8b\xb6ǲ\xe8V\xe7~\xf7\a\xdf\b\x1e\"\xab\xc2\xf3G\x9en2\xec\xfc\xf7\x1c\xdb\xd4[\xb2\x16c\xc3k\xaa\xcfH\xad\xa6G\xe2\xa7L\xfaF\x1e\x11\xbbz\xfb\xbbb\x96\x15Y\xb3\x89\xba7\xb2\b\x16\x89\x15Y\x18\xf1S'\xf30Y\xb6\x8fS\x8b`\x03\x93\xd0\a\xdcO\x94\xa5[\xba\x86\xcenq\xa5\xf9z\xb5\x98\xb2ʍ7\x8bu[;Z\"\xe6\x13߮n]\x8c\xdcR\xeb7\xad\x93\xf9\xfb\xd5\xfd\x8du\xd4n\xa3\\\x1b\x91\x14\xbbz\x15\xce\x1e\xc0\xaf\a\xbab\xa8\xf51?IU_tt(b\xc8\xedU\xff\x03\x9d-T\xfd\x1b\xab\xfe\xcd}\xeaoY@\xb8\xeco;\x9f\xb2WA\xd9m\xd4\xdd\x19bЪoyR\x93Ǜr\u0558\xe74<e\xc0\xdf\x11\xdb\xf2d\x92Jϳ\u008c\x9eAJ6n\xe6t\xc0t\x15A\x06\x13\xe8\x8f\"(\xf8[Q7\x92{j\xbd9\xcfr\x06\xc1\xe1a\x06/\xa0\by\xd9\xc1\x12\xaf\xe8\xa6\xc7o95o95?\xc6͜WgyA\xcbc\x13,h\xb0\xd4i\xd0\xeeG\x84*\xc6A\x03\xff\x1385q)\x02\xfax\xb3Z\xc8\x06\xd2\xf7wEJ\xbep\xe9\xb1\xd8\xdb\x16\xa1~\xa4Ff\"\xf4\r\xdd'@E\x96E\xf0N6\x81\x88\xe3\be\x92\xaaS'\xdd\xf0\xad\x01\xe7h\x17\xff\f\x0f\x03\x0e\xfe\xce\x027\n\xbcs\v`\x91CtFn\xaal}\x99]]\xbe\xf3\xde:Q\f\xe0\xbddވ\xb4Z,;Qq\xc4\x00\xbc\xc1\x8a\xd6>\x8bi]VͿQ\xdbM\xd2\f\xac\b\xa0\xd7v\xf6\x039\x868h<\x81\"\xf82\xbe2\xfa\xefrj.\xa3>\x84]Լ\x91\x02`\xd6c\xb7\xb4\xb5\xe4L\x9dK\f\x00\xe9N\xc2-\xd2U\xa5\x81\xee\x0e\xa4^\x7fY\xd4;\x97-ZmA\xd1\t\xecG\x1b\x97\xe2\xf6\xff\x01\x14\xb8\x06\xb91\xfd\xd9\xee#e\xe4\x05r?1\xe3/\x1a\xc0\xc4\xe8V\xbap\x91\xbe3^\xe3\xe2\xc9)\x89a\x04\xb7\xa0\x04\x13\x93RL\x8bE\xd5\xf4J\x14\x89\xee-\x1c膘\xddT_\xa6\x19\x1cB\x8f\xce \xd2wW\xea,_\xfe\x97amg\x90f\x91\x1bQJ\xe6\xbcsr\xd4#1\xf6\x1bR`<\xd9b\xbf&\x05\xf2\x15\xc23\xb8\xf5\x87\xb2q\xc6\x18\x12\xedR\xea\xa5ӤemѢ)\xb1\x0e0\x05\x19d\xd7C\x97\x9e#\xb8y䡇x\xba\xb4\x03G\xa8ѱ\xa7|`\x97ff\xc0\x8dݢ\xa1\x1e!ѽ\xa7;\x0f;^\xd9^4\xc0;\x05\x90\xb5\x1c\xdb8\xbe\x015\x1a]Gx\xf1\x81\xf5f$\x80\xa9\x89\xb9\xea\xb2ʽ\xb0\x8a\xc1\x85SN>\x9dr&\xc0\"'\x97\xa7\x9e\t(+\xf3\x03\x9cu\"\xe3\x7f\xbb\x90\xf1\xbf6\xb2p\xbbO\x9c\xab\xdc^Q{C\xa8\x18~\x05]w\x8dy\xa6\"Spi\xbbF3\xb4\x19\xaf%\b\xe2\x81\xc5\xd3C\x88\avg\xf0\r\xd2\xd4\x05\x9b\xb6\xc1:.\xa4\xa3\xfcȩ\x1egkN\xa9\xd0\xfa\xb5ԟ\x11\x14\x91R\x80\xa4\xee\xf4t\x11\xd9\xfa72\x1a`\xbe\xf1\xc4\x11=h}͋J\xfa\v\xba\xa6%\xb1\xad\xe9^\x04\xfftVJj\x91\xa0\x96\x06\xe6\x9enǓTˏ\b\x1f\xe3h\x00\x8dj\xfdIHӬ\x7f\xe0\x03F\xb2\xb8~\xc4\xc8@\xf8\xe9h|c,\x04\x1fF\xa6\xc2`Rj\xa2m\x13{O\x1a_\xc9r\x0f#\xd0\x14s\x00\v\xe1\xda4\xb2$^\xad\xb1\x93¤E\x9dC\x9c\xc6+\x97\xe2>̵\au\xbbg\x1c\xd4r\t\u05c9\xda\xec\x99\x1d/\xa8\x89-Ŭt\x1e\xdd\xf2l)\x96\xab\xbcfAA\xdeU\xce\x11\xa7*\xf6\xeb*N\x85a\x03\xbdi\xc7\x11lƮ\xf9\x10a\x06\xfc\xe69\xdeF\xb4\x11\x82\x19q\x8f \x13\x9c\xd9\x11\x88^\x94ݨ\xe4\x8dLƛW\x05L\xe8Qa\xfd\xbcDJ\xefd\xac\xe1\x10\xcb\x1c@\xbai\xb9\x13\x05\xeb1\xae\x19FhfO\v\x9c\x15笉\xddY\x14\x89\xb9Q\x95\xceQwsx\xfc\xe7\xdc\x02%j\x96k\xe8\x93G\x98\xb3|\x93\xd9\x1b\xca\u07b4\xb3\xbd7%\xcdy\xafŽ/\xbe\x80\xac\xfe\x16w\xc1,H\x8bp+\xe5T\xe2\xd5g#\x7f\x8b\xc7\xfac\x83s\xe7[\x1f\nU\xfe}\x86_X:X\x8a\xcd(7𩱛\"-F\x86\xf2\xbe#Q\xc6\vI\xab\x05k_ꑂ\x8e\x13\xaa\xb6\xd5\r\x94\x19\xb1\xa7\xbcK(f\xbe\x8cU\xabnY_\xc2(\x82\x11=xMn\x1dq\xbe\x9c\xc7\x11̪L\xbe\xfa5xN1\xf1o\xbe\xce\xea\x06\r\xec\xdc\x18(v\xffT\xfd\xf7F.\x87}\xd3T\xc2\xde\ue0d5\xb9\x11p.\xe2r\xf5x\x88\v\x81\xf8\x96[D\x06\xa3\x88K/\xfe~\x16\x01\xddغ\x8d\xf3\x88zMn\xef\xb1&\xf9;\x15\xf5ӓ\xa2\x1c}-\xf1\v\xcd\xc1\x19)ޡ\xe8\xd0E\x01\xb5\x1f\x0e\xb0\xd9\xcfCx\x81\xe7V\xa7-\x0f\xc1\x01\xfa\rz\x9c\x04\x91\xb5悐\x90\x9d\xf1?0\x81\xa1\xd7y\xd03N>8A\xf2\xa8\xd1bR\xe0\x8f4\x9a\xe6\x9a\b~%\x93E\x19A\x1dA\x13A.m\x19\xa6\fA\x90!\t\xe7\x80ۀ\xc59\x1c\x1eZ\xbb\xe0R\"\xbd4\x17\xe9\xc8\xddr`\xbfC\b\xd0P\xaa\xfd\" ߜr\u05edZK5\xbf\xe5\xc4]\xb7j-Ӝ\xd99\xed\r\x0fp/\x038\x02\x9d\xeb1\x98-\xbaC\xf7)^\xca>\x80\x80\xcaj嘇!\xf4\xb5\f\xa0\r\n\x8e\xc0\xba\xf4\xbd\xc6N\xb5R6\xad\x14\"\x9f\xd3\x14\xa0\x90ԃ;\x8a\xab\x03G\x104\xf2\xf7\xa1J\xb6/\xd2S#'\xd0R%\xf5\x80\xf6j\n\xe9\b\xfap\x13\xda\x10\x1b8l\x95\xf4m\vn\f\x96\x88\xd1⚕p\\\xe3\xc1\xc6\x11\x8c\xedN\xa0\x8c\x91\x93\x919\xa6\x01\xaa$ܲg\xe4\x92B\x02yio\xe7J\xdeNڸ\x96\x83u\xe8\xf2\xa1\xe4\xad$\xb7\x91r\xb0q\xf2}\xad\xe5\xa3\xd7\xf1\x0f0\x95ɗI\xb2Z\xac(\x02կ\\\xef]\xb3r1@\x05\xddT\x8c\xcf\xedxf%\x94\x9a\xd0\x06aw\U000f7d5b\x96*\x81\xd9z\xf4\x1a_\xb3V\x84\xdc_\a\x18&\xad\t\xe4\n\xa3\f\xf7\xdd\xf8\xed\xb9o\xf7\xf7\x00\xd2W\xfa(*\xc9v_\x0e\x96k\xb7;(u\xb35\xe0DɇDP\xd2L*\xfeN\xa8\x83\xb1\a\xe5<\xe1b\x16E6\xa2\b\xafj\xd3Q\xc4\xe5\x83\xf0\xbc\xc0c\xf7\x96\a\x10\x9f\xc5\x1e\xf94\xac\xbb\xe94\xb5\xbe\x9c6\xee\xbf\xf6\xa6\x92jC(и\x87=T\x89\xa7Z9CݿZ*)k\x91h\xf6\xad\xf6S\xbe\xe4x\x9f\xa6\x1aK\x84\x87\xb5X\"0\x1bn\xaf;:_\x95\xec\xa2+5hj\x11\xea6\xc0X\xb7<\xac\x01\x12\x81\xd9\x00{1t\xef\x06\x18K\xb1\xfb\xd3\xe4\x0e6\x03پ\x1d\xab\xd6h\xf7\xaf\xdd^$+D8\x94\xcb\x19\x1dUN\xa0'\xd1\xf6\xe0\x02\xd6p\xd6>I\xed\"L/\x17\xefO\x99(\xabOt$\xaa}+\x97\xab\xd3\xfbWM%ϕ\xd33G\xb3o\xb5r)y\xffj\xa9\xa4i(\xa0\x04w/\xbb\xc6\xc0ȡZ\xb1\xae\xcf\xf9\x8c \xbf\x87\x9e@\f\xa2\x88\xbbD\xe6~F\x9eE\xb2ؐt/\x93\xd7\x1d\xe1Y\x06\xe8NP\x05z\x19\x1fvܼ\xecT\x8bX\xb3\x7f\xedo8=\x8b\a\xd0\xd2l\xc1\x8a\x9a\xdef\xb6\x1f\xb9\x14\xcbr\\\x01Nˊ\fA\xe2w\x90\x85\U000b016f\xd2\x15\x90Z\xa9\xaf;L틐w_V\xbf\x8e_\ak\xb3\xec廫KE\xccU؎r\xa0\xdaM\xeb\xe1*.R\xf2\xab?\xb0\xb4y\xcb\xf9R\x11ݺ|\xa6j6s\xc0j\xf1\xa5\xb5\xf2\xa3\xdd\xc5;\x12\x0f\xc0\xc6\x14\xb8\xbbxg\x97\x06\xabA-\x14\x1f\xb6\xa0[x\xd1a'\xe8M˻\xab\xf3\x8e\xca\xe4\xf6e@\xc73W\xfc\xf8@\xee^\xc2\xeeR\x1c\xc0)\xc5Q\x85{Z\n4\xba\xecʷ\xb5\xc3c\xe6h\xaf\rޝ^\xd8G0\u05cb\xf9H\xd7\x11Aٵ\xdb+Z\xbb=g\x19\x9b\x89S\xf0L7\xac\x94\u06ddI\xfb\x82\xb3\xdagYݨ\xb6hV\xeaCw\x9e(\x89b\x9e(\xd5\x1b\xfa\x13\xe8\x15\xe4\xb2\xd2\v\x8dT\xd1\x10\x99ЅDy/[Hd\xaaB\xc2\x13\xae,gm\xb5\xab\x14\xa4\xca\xe5\x83\xf9(x\x89\xc7ʺ\x94\xb5a\x9dX\xeb\x80-\xa5\x0e\x0f\x95\xb8r\xf6\xdbYB&\xed\xac\x0f{\xf7z\xf7\xbe\x81\xab\x1d\xbe\xa8\xe7\xdb\x05\xa5\a)\x98\xee]\xe8\x87\xdf\x10\xfcƂ\xc7 9\xf3\x0e\xf8%\xaf@n!:\x808V\xb9ip\xdb)vq\x96\x94\x19\x1d-W\x19\xd6\xd2\xc2\xe4\xc2n>\xa9:x\xdf\x1d\xf2/\xb3\xdb$\x17\xed\xde\xfb\xd0u\x1f\xed\xdeU\xda\v\xa7\xf6\x0eɜ\xe8\\Ӡ9\xe3\xf1\xac\xadW\xfa\x8duE0\x18\x85\xdei\xb3\\\xee\x8db\xe8\xc3\xd0u\xaf\xdc\b\x93\x1a\x82\x00r\xef\xa3\a\xa1\xb8\xf4\x86>G\xdc\x17\xb4و\vZ\xc6\xe59\xc72\xf0\xb5\xccҐ\xee]Hu\xdd\xce[\x98\x15ix\uef59V\u07b2\x8a\x9f/\xb4K\xfe \x01\f\x7f\x7fu\x1c\xd1\x05\xbejB.[ć\xed\x9egT\xac\xfb\xf2\x1dG\x8e\xf7ƛ\xcc\xdd3\xb4N@ԐEi&{a\x8a\xff\xbf\xb1}\xac\x92y\x96\xa7\x15\x93\xb3\xd4@~\x9b[b\xd2\x18k+e#\xe1S\xb6l\xe6t`a\xe5\xd3yHj\x97\xa1\xc3\x10\r\xc7M@\xa2\xf6/\xbe\x80\xa0\xa0\x91\xc1\x13\xe4\xf2\xd6]\x97)\x9f\xb6\b\x92Ȍ\xf4O\x15R5\xd2K ]Ñ\x99r\x06C\xcf\xea\xccc\tR\x8cK\f\x82H\x1b \xff0M`<\x90\x1c=w\x1d\xb9Ү\xb1\xed\x1e! \xf7\x9c3\xaam\xbd\xc2\xeb\x1f~\x1a\x16vs#5\xaf楑 \xd3`Eص6\x1f\xe9\xf7\x17\xdc\xeb\x86Rh\x1d\x0f-Zm\v\xbb\xcb<cU\\%\U000cda4cSS\v[B\xcd\x1dԇ\x91^;i#\xa8\xe6,B\xb5\\\x16\r\xb3\x8f>lQ\xd5\xc3\xc4\x18m*5h\x1f\xabp\f\xaai\x9f\xd52\xa3j\xf1\xab\x10\xa9y\x14\xb9?q\x95\xa2\x8aE\xba}^\xa5\x92m?P]f,H\xe3&nuޭ|\xf7\bs\xed{\x85vO+b\x0f\xa9\b\xef\xe3eƜ.ֻB\xc4\x1e\xe3\f-'\x7f\xedmն-\xe8<y'\xddq\xac\x873\x03ƪ\x83\\\xf4d\x1d\xd2o\xab]\x83\xcc\xe9\xc6/!B\xe8\x9bu\xa18\x1e\x0f\xea\xd5\"༲*\xcf\\\xffUb\xa3\x10\x8fs\xc7g\b\x1es\xbf\x84\x90\x17\xe3n@\xf8\x0fQ\xab\x85`\x99\xb17e\xd5|\xb5\xf9\x8bP\x86\xaaKpS\xe2\xe9\x12N\x18n\xdd\xfa\U000b7c69\x81\xb3\x9d\b\x88\x12$\x9d\xbb\x13\xe2\x8fwW]}Z%\xce\xfa\xce\xef\xca\xd9\nFm\xa8U\xc4q\xd9\xf2\xd1\xc3z\xcf@\x92\xd1v\xbbKa\xa2\x9b\xd7\xe5\xc7\x17\xfb\xdd\xf8bR\xecx\xac\xd1v\xd8\xfb\xe0\xea\x17$\xcfU/T1L\x80\xbb\xe5s\xef&\xe8\xee\xb5\xc8\x12wTsZ4\xc1p(\x15:(\x93\xf3\xdcC\xd4\x0f\x95\xd4]\x94\xaf\xbc\n(\xb3\xad.X\xa5h\xc3\xc3|^Lo\x97}\xeb3Y\xf2\x80ZUqU\xb7\x89p\x1f\n\x8cN\xb8\x7f\xfd\xb2\xb0\xac\xc7@\xb6\xabn;ټ\x8c\xe0\x1f\xf3\xed\x8b\x05u\x13\xfb\xcf\xc6e\x02\x10\x84\xd4\xf28&\x1d\x7f|Ve\xf7\xd3\xf4|\xacqMOȷ\xeazr\xfa@\xfc\xbc\xa2\xbdjHm\xa8[\aJ\xc1]\xc2\xda\"\x83\x00#ش\x13\xe1\xca\xeb\xcemQZV)#K\x19\xfd\xb0p\xf0FH\xfe)1S\xac;\x1e,Y\xb5X5,\xe0i\x91@f,q\x04\x17\fP\x89\xd4\x01%R(\x18\n\xd1B\xbf\xf6 F\xfa\x1d\b6\x9bv)\x9e\x84qnd*\xb7b\xd9Ƨ\xadֻreӠP\x927y\xa9\b\xad\xe9\x9bS\xc8s\xcd\xe7q\x8c]\xf5\xa8{W\xddQW&\xeb:\x9c\xc8\n\xc8\x11ZT\xa2j\xcdڵvl\xc5Q\xe2\xbd\xfa\\\x84t\x95;W\xd1E\x96Z'\xda\xfe\x8c\xc9\"\x0eB\xa4#ظP\x94\xfe\x1f\xac*#(W>\x80Uã\xf4:\xe9\x7f\xe5\x11{\x9dT\xf1\xb03\xfd\x1e\xdc>\xfc\xc1\xcb[\xeb\xb1\xcbۮ\x87.\xa9\x1eKi\xf1\x9a%K\x1e\x12\xd4&e\x95\x8ei\xc3\xd1l?_\xf2\xf1\xbd\x1e\\\xb3\x06k\xfd\xfd\xf7m\xfd\xb2osd\xe7=\xa0=V\xb8t\x85\xe8~-\xe2\xa3gK\x93\x94\x10\xed٠O\x1b\x86ykU\x9f6\xa6\xf2\xf6nZ5\x0f\xaa\xac\\\xe9\x0eZ5{U\xe8fxfgC\x18Ep\x81x)\x8e\xcfzYQg)뗫\xa6g,\xb4Q\xe58\xdbd9\xf5ں\x99?\xbd\xa1\xe6d\xa7\xce\x1f\xe25\xdd\xe7\xa1[q\x8bz\v\xe4O,]%\xec\xcdj\x11F\xed]I\x11\xeew\xe7`\x11\xaf/cz\x89$^_N\xf5\x16\"\x8c\x80\xdb\x19\x87\x11\xf0\xf7\x99\xf9\xef\xa6\\J\x1f?\x9e\xbc\xfd|\xa1=\t\xd0-\x17q\x8f\xc99\x1a(\x97\xf0B`5K\x00Qr8!~8GKH\x0e?\rz\xb7\xfd\x99m\xd1\x06?\x16\xd1\x12\x0f\xa2\x8eX\xb2\x02\xbeb\xb7\xac\u0087\x15\x06IY$q\x83M\x90\xb3\xfd\x87H\x88\x1a\x81tʉaRl\xed&5~\ve/\xe5\xea\xafw\xb6M9>\x92k \x9f\\\xab5\x88%\xd8u\x96\xcf\xcb\x15k\x1av?\xb1^\x88\x04s\x19R\xebk\x9f\x8bX^\x94\xe3K\x13\x11\xe7\xd7\x11\x9a\xadk\x14-V|E\xa2\xa5+;<\f\xf9\xc2A\xec\"\xf9\n\xc1\xf1Â\x97\xfcU\x1aNJ\xa9sku9\xb3\f\xbd'8[\xc9\xda\f\xf9\xe1i\x80x\xfbR\xb2Bӧϑ\x9c\xcd\xd0\xeaʻ\xec\xfa:\xbf'\xb7ךۜ\xf5k\xdd\x116\xa7q\x815\x8a\xa0\x1eGP\x1fss2\xaeS\x86\xad\x0e\xd8\fy\xa0bZ\xec\rM\x8b\xa5\xe2\xc1h\x9f\xae\xa9G\xed\x91_\x8f\xb6t\x8eUx\xcc\xff\x92ex\x8d\x90\x14\xe3k}\xf9\x8e/\x05\x87W\xdd\xebJ\xc2#.\xfd\xd6\xc7\xd8#V\x85\xd0\xd7\x04pd<\x9a\x04\xdd\xf3L\xd7\xe19\xdc\xc0\v\xc8\x10\xf1\x8d\x8d\x18\x10ݡ\xc4w\xe3\xe0\xbbq\xf09/\xef\x1b\xbf\xeb1\xa9\x9ec8\xe0%۬\xf8В\xaa\x92^\xc3\xc2w\xb4\xeb1\x1c\xe1/$ֶ\x8bs\xe9~\x01\xe50\xe4=W\xee+ż\x96>v\xf8\xf9V\x19e\xebe\\\xa4\x1f\xaf\x11n\xc4\x1d\x8a\xe2\x1f\xa9\bB\xdf\fEE\xadRp\x84\x9c\xe4\xb3\xc8~\x05&ps?\xceO`\a\xdf߳\xaa<\xeb^+*\xfdnZ\xbe림\xae\xe2\xedq\x1e\x15\x94\xdf<<\xcd\n\xa9\xb8ۖbJ\xa9\"\x10\xc1g\xe4cs\xf4\xb72\x0f\x01xQ\xbego\xe6\x15\xab\xe7eN\xf7\x99\xa6YQؠT\xd8.1\xcd\n;\x1c\x02ϴ\xc4Kc5\x9e\xaa\xe7R6\xabد+V$\x18\xd3i\x04gR\xea\xd6^W^k\xa91\xcd\nN\xa3\xb0U^\x1a\xf23\xcd\n~L\xa6k\xa6˶\xa4\x0e\x02\xccu3\xcd}*\xe6o|\xde\x1b\x14\xdb\xcd\xf5ٺ\x8f#\xf4ڴ\x91\xba\x97\x90\xd6\xf0R?\xf8GoN\xc1\v\xfd䟍\xc8j>\x9e\xfbf5\x7f\xc5]\xb6\x88\x0e\xd4З*$\xa5w\xee\x96\xe5\x1e\xe57\xedt\x1eހ\x8f\x97=\x9d\xaf\xe5J++ZvYݽ\xfceG!;\x95a\xaa\xe5\x02im\xb0\x95\xd8\xff\xc4\xe5\x8d\v\xa2\x1f䫬xӬ\xf4U\x15\x95\xf1\xb1&\xdb\xcaړW\x9e=\xb9\xaa\xcaڰh\x02䐻?\x01\x9c'\xaa:\x83C\x9d>\xb0\xbb\x88\x11\xba\xe2\xfe\xb4p\xe6\xcb\xdaTW\xd8\xfbj\xe1\x16d\x9e\x86\x10\xd5>\xab\xa2\xb7\x0f\xbfE\xe7\xfb@\xe8\x97uh\x1e\x8f|L\xabM\xf9{\x88\v\xb0(\xad}\x805\xbaǏ\xf7\x93\a_\xe6\aw2Ї\xa3ۮ]\xe2\xf3W\xf2\xa4\x90\x0ea\x85;\x8d瀻6O\xabI\x83\xabJ\"00\x84\x1c\x853\xb6\x04\xe4wE\x9e\x15t\xde\x1f7\xb1u\x9f_\x1f\x06=r\x8ek\fυ\t\xaf\xc2\xcc\x11\vx\xfc\x19\xb6N\xdak\xfb\x9c\xbd\xde\xc7Q\xc1=ŗӡ\x8e\x12\xc3i9De\x98\ue9e5S\x98(fsJ\xe8\xe0\xed\x9d\xe4\xf6\xb9\t+^\x1a\x10t\x98Y\x89Х\x16\xfc-\xadz\x06֡\x90\xfb\xd6\x11\x1a\x03BH\xf4\t\xa4\xe3\x12F\x85C\xd3\xffb\x02\xb7\x1e\xf7\xe2V(\b\xab\x84yR\xdc!\x1bh\x00\xebz\xa3E\xb7\xb6\x15V\x81\x10\v\xd7\x18\x81h?\a\x8c\xdbO\xe7\x80\xe1\xf4}w\xc7S\x87H\x9a-\x8f\x94w\xe1><\xbd\xdd\xc2\xca-#\n[\x0egb\xb4\xecb\xf9\xceN\x97\xf3\x96\xb7CT\xe5\xe6eZ\xd35\xe4\xd2x\x17M\xa8\x18r\xfd\xb0\xa5}\x9bwG\xeb\xb8UՉ\xc7f\x91\xd9\xe5\x1e\x98WZ\x00D\xc3<@\x7f\xd1#F\xa5}\xd6#RU\x8b3\xb5Ⱥ\x8d6=\xe4z\x87\xed\nf \xbb/\x1d\x9f\xf9dzW\xf5\x15k\x11P\x95ecZ\xcd\xf8\xd8\xc2T|s\xdc\xc1\x8f\xc9\xfe\xf9Ҩ\xd7c\xf4u\x87\x94\xf1P\xbd\xe9ͷ\xe3TvI\x87\xb2\x0fp\x9a\x8a\x88p\xe5\x15\xacc\x9bc2\xed.\x86V\x8a\xb5\x9fЭh*\xc6\xfe\x92\xd5Y\xf3\xe5\xaca\x95\xe0\x91\u07bd[\x178\a\x95uqWL\"\xfe\xa0\xae\xdb\xd0\xebll\xfd\x9bl\x9agŵ}\xf0\xd9\xed4\x7fc\xba\xaf\xa1I\x86ZW\xc1\x11\xdcE`}\xdb\x1e62\xbc\x8a\xb3y\xa2>\xc5v\xe9\xb8]7\xf65\xdb\a\xf1\x8as\vg\xda\xea\xbc\xeb\xc5\xe2\x8f`\xd1GQ\xd5\xef\xa6\xea!\xcc\xfd\xd0\xeat$\xf9\xe7*.\xeaYY-\x04Aw<\x06ќ\xff\xc1M\xf6ͧq\xdb\xe3\x8b\x14\x87_\xb8dq#\x11\xf9\x9d\xfb\x92\x9b\xcf\xec\xd7\xe7\x9c&Q\x8d\x9e\xa8Ao?:j\xd0\xe1\xdb]u\xef\xe1N\x88\xe7\xec[=\t\x93|U7\xacڪ\xd3\x04̧Qk\x11\xe0;\x03Y\xb9\xaa_sWgޝ7\xeb\bn6\xf7\xd5gn|\x97}\x9c\xa4\xdb\vAw\rh_\xb5\x92>՚&\xc1\x8f\xbf*$\xe1\xb9[`\xe3+\xf07_\x01\xcfq\x95\xaa\xd2\xe4\x14\x9d/\xa3\x19\x99-\xe3*6|\xc5M\xa0\xd06\x10\x1b\xc4X\xa9\x16^w\x9f\xf1\xc1\xebD\x93\xb3Y\xe3k\xd3\xf7l\xd6\xf0\xf99\x82J\\Rj\x01\xfd\x84\x19\x12\x8a\"!\":\x1e\x9fB7\a\xd3\x04\x12\x11\xe1\x8c\xe2\xd4R\x02\xc630a)1\"4։\xcbC\x05G\xb1<\x10\xbfd\xc0?|ϓ\xff>\x90\x13\xd8\xf9\xa3\x16w\x03\x04\nļ|!ӏ\xe4L}\x06\xa3P!0^\xd0\xfe\x94\x1a\x93?'!vJ\xf8;2\xf8\x05\x13\x873oTV\x87&\x15=7\xb0p<@\xa5\xaa\xe2ڹ\xca@\xe8\xaaWQ\xab\x1d\xb8JR\xf2\x19պ\xafޝ\xdaU\x14ڡ`\x91\xdb[\xb5+\x028\xaaU\xe5Ͳ\xaan\xfeG\x9c\xdf8c], \xf6U}\x11\xa8\x00ƔN\"Э\x0fwn\x8c\x85\xf2\x8f8}\xb4\xdb2JXJ\x9e2#\xa0K{MI\xf3\x8c*#\xb6qQ\xcb\xe2\xbdݚ\"67\xf6}\x8fs\v@\xf3M\xd4`\x91\xe3\xdc45h\x8b\x97˲\xe2\xd7I<\xe5t3\x1c\f\x16\x94\xa4\xab\xeb4\xd0\x19\x88\xf8\x02\x85m\xbe\x92\xfc]d)\x8f\xc55\x81\xc1)\x1c@\xa09\xc7{\x10_\xecɳ\x05\x1cB\xd2JlE$\xdb\":\xf8\x9f\xdc\xdfp\x8c\x13W\xd2\xdc\n;g!\x89\xfe܇}Q\"w\xec\xaa\xfa\xaa\x9d\x16\xc3ڳb\x9bFo\xc1m\x13\xeb?\x98\x0f\xdd\a\x1eک\x97%e\x91\x1a#~흥\xf4(֤\xac\xcf?r\x19\xb4\xe7\xb0\xf7\\I\x12\x17\xa9\f\xaa\x16e\xba\xffp6\x1am\xdf\xe0\xba/\xf7\xf4\xf0\xf5v\x861~\x9d{\xf6[\x85\x82\xdcG\xb3\xa5`d\x04\xb7\xa5\xf1\xdb'$\b\xb2\x90|\xe7\xd6܁\xa5\x1ck\xc2v\x9b-5\xb7\"\xa8\t\xedmi'\x12\xfe\xdblaCRbi'\xa2\"\xf1\xf0\x9cSh+\x1d\xbe\x1e\xbb\xcd\xd0y\x8c\xb7\xccΧE\xddm\xb6\fy\x03\xbf\xf8\x02\xa1Z\x97\xfe\xcbEG\xc1r\xe1\f\x7f\xce1/\r\xe5\xb2\r+\x9ae\xe8e\xd7\x12\x0e\xbc\xb9\x16k\xf4\x98$\xad\xa2\xb9\xab\x14\r\xb2\xdd\x1a\xb2\xb7ق\x18\xe0yR\x9f\xe3w\x8c\v>\x05\xfe\x03\x05\x15\xb4\x92\xbe\x14\x84s\xfc\\P\x94\xe4\xc9\x04\xaa\xc0\xa9\x188\x89\x93vgR^ٕ\xf7ᑍ\x83\\\xdd,\xa19\x7f\xe4\xa9\xc5\x12@\a\x82\xbb\xcbY2\xe6Bp\x1ce\a\x0e7\xb6\xa4\x10\xa4\xc7]R\xd0\x16/\x89\xb8\x99W,Ny_w\xc9\n\xce,\xc8\x1b\xde\xcf\xe5r;%K\x0f%Jt=r\xde&dy\xde\x01\xa3\bY\x12!\x8bΥ\x86+\xd2[bW\xc8Rސ\x84\x0f7,\xdeg\xaf\xb4u\xe9\xa9\xf5\xbes\xc3K\x12Ώ%\"{\a\x9ag\x8b3\xb0\xa2\xb0/\xca\xd4I\xe1/\xfa:\x894\x00\x9c4~\\|\xe6R\t\x17\x1d\x138\x87\x87Cr\x11\x19n\xbb#\xa6\x97\x92\xb4\x7f=\x7fԚ\xb38\xbb\xfa\xf8ǿ\x04\xf3\xee\x9f\xf9.\f\xbb\xace\"\x94\xe2\xb8(k\xff\xb6zGY\x1aT\xa2p\xca\xd8\xf2>e\xbffl\xc9\xea϶O\x8f\xf8\xdb/H\x958\xd3\xfd\xfdw}\x98\xf6\x0fڽ\x1b'\xccGH_k\xa3\x0e\x90\xb2\x9c5̳{\xfag\xdeÓ,\xfe}7\xf0\x9a5\x1fL\x1a>\xe3ֽU\xe3\xce};\x96\xd8cӾ\x88\x97\xdb\xef\xdf%q\xae\x0fx#\xb8\xb1M\xa3\xa6\a\x9b\xb3\xa8V\xfb\u07b8b\xf1\xee\x10\x93\b\x85\xb2\xed\xd9\xf5\x86*\xb8A\x80N\xacC\xb8\x80!\x9cY!M\tz p\xf0\xb0.\xf8A\xe7\u0094\xfab\xa2ʙ\xf4xC Կ\xae\xe2*\x9bm\xee\x11\x05\xa1\xeb\xf4}\x8b\xd1\x15\xd1U,i\xf8)\x0e\xaf\vg\xb1;\xe1\x8bQ1| \x97[\xc9\x15\x9a:\xcf\x12\x86\xb7\xfe\x05o\xa7\xacF\x04\xfa\xf5\xb4:)+\x16\xc1J\x1d\x89dE\x80\xb5\f\xd05\x9a\xff\u0604ֶ\x86\xf7\xb0\xaaMB\xad\xe1@\xc2[A+\f\xa6W\xe5\x9ddy;\x8e\x05\xed\xb3\x14V\xd5\xfe\xd6Z\x13\x91\x90Ç\xecxU\xe6\xb2 \x1f\xbc\xf0܁\xa6*\x0f'F\x9f\xb7\xe2\x92\x13\x17`\x02weU\xa3I\xf6.\x82U\x18\xa2\x10 \xc3\xda/\xedH*\x97\xe52\b\x1d\xe7>\xcea¸s\xef\xae\xc8\xebOx\xc3\x10_\x8bD#f\x04'\x8d\xf3<\xe2G\xb1N\xfd\xdb{\xf2\xbcU\xbd|v\xb2\xa3w\x8c&I\xa1ٵ>C\x8ej\xcc\x1d\x81I\xec\x86X\xaf\xf0\xeeI\xd9\a{0\xa3\xb4\xcb\xeb\xf7r@\x86ۇ-F\xe8\xfbG\r\xdb}\x06\xab\x1c۟}쵆\x12\x17\xc4m\x03\xef\x01\xa3\x8c\xe7\xbc\xd7\x01 ~\xdb\"\xe3\x12\xf8B5\xf1L6Q\n\xcd㖶x\x80t\x7f\xd8K\x9e\x84\xa4l\x97'Kyط\xce\r\x02\"\xa8\"\xa8Ե\x94j\x91\x15\xc6ز}\xbcu\vvχ<\xf8s\xc5K]fWTY\x18BR\x16MV\xac\x1c\xc3v\x05/\xa8\xeaP\x12P\xb9\xf9/\x89\xc8P\x92\xda\xdaf\xd5p0\x01u\xa1v\x85_+\xf7\x96!\\\xe8s\xf6\x15\x1cp\\\a@\xeb$8\x82:\x82\x1a\x97\xa3<++dV\x88\av\xb6\xb6\xf9\xd0\x11\x18\xcaч\xf9\xaa\x9ew/84;\xf9\x01+\xc9Ӛ_;\xa6\xdf\x1b\xee\x1b\xb7B\xa9+WE\x1a(\xa99\xc2.\xc5ݕ\xbeM\x82lZ\xc1d\"\x05\xd4\xed\f\"\x06\xd7\x13\xb7\xf0R\xc0lB\xc2/>\xf6\xf3\x92,U\x8f\x9e\xb7\"f{\xc2eo\xec$\nXu۲I\x96\xdcmߞ'p\x93\"ڂ\xdb\x03\xe4\x85\xe4C)\xb9pK\\\xf0>\xeb[\x0e\xde\v\xef\xf3s#-]sǿ6\xfescC\x8f\xbc\x87C\x8bR\xc1$\xe8\x1b\xa9\xad9\xb4\x83\xcfk\x93\xcf\xeb\xbf\v\x9f\xd7.\x9f7\x82ϛ\x16\x9f7\x8a\x0f\x1b\xe8\xc3\xe6a|V^XFOKF;\x158<]{\x19\xbd\xb6\x19\xed\x1btb\xf9\xdf\xe5\xdeȕ$\xab\xb1+L_ȏrᢔ\xd4x5\xc0\xce0^\r0\x87\xa5$%l;\xd09\xf6\n\x9aO/9\x85W\x91\xaa\xee@\xe1\x17\xa7\xd5\xf6t\xaa*\xa0\xa0M|n\x803\xb5\x0f\b\xedJ\x14E\x9bP3ib\x87m\xffȘ^$=R\xd0\xe8\xc3\xf3|\x8av\xa2\xc1闾[\xbb\xe7E\xbc\xfc1N_S>\xa7Y\xcaZ\xa4h\x8f\x84\x02\x97\ap#84\xfc\xe5Ne\xa02\x81\xee\xb3os\x17\U00072d77\xc6z\xb7?Zf\xb8&\xa6\xdfns\x9cYb\xb5\xc2|\xc81K[\xb56\x90\xb4\xdfJYڏ\x1c{9,\xaa;\xf3\x02\x04\xbc\x0eq\xfdc\xe9^\xff\xb8\x84e$\xff\x0f\xae\xe0\f\x96ag\x1c\xe5e\x9c\xbe*\x8b\xba\x89\x8b\xa6\xd5\u0096Y\xa0E\xc1\xda\xfb\xb4\xe9ޞ[\xc8Bl\x84\xe1\xc9\x05\x13\xed\xa7Ho\xd6\xedf\x15\x9c\xf1\xe8\xa9ƅ\x98\xb0\x1di\xc2\xe8J8\x03\x0eo\xb3\x8d\xe2V_\xd2#\xba\xfc\xffĸ\x90\xfc\xc1\xde0>\xef!mr\x04\xde_\xccy\xc9\xc7\xf2\xa6\x96Vp\x1c\xe1Z.\xa2x\u0099\v\xb6\x9b4=\x8e\xef?\x04\xa9\xa8\x1a\x84\x12\xd1\xdaJ\xc9X\xc7[s[\xf9%T\xc8\x03\xf8\x85%UM\x02\xcd>\x8aa/\x13\x18\x8do\xbf\x15,\xa9o\xb9\xbeL\xeb۠\x17ыUl\xdd\x1c%\xf5mO^;m,\x98\xff\x97\x82i\xe2i_\x98\bY\xda\xe7w\x13U\xa1kV\xaaXW\xc2\xfb\xfa\x9a\x95o\xab8\xcdb\xbaM&\xdf\xdd=\x82ѳ\xa1.4\x88\xdfg\x8bU3\x8f\xf3\xad&9\x05\x15$eY\xa5Y\x117\xee]&2U\x1b\xb9x3\xf2\xc0\xa5\xa3O/Oo\\\xd0Q\x1b4\x82d=\x92t'e\x1d\xacGh\xb4\xd5iuV\xf0\xb4dc\xc1m\bnc\xc1QZ\x92\xc0\x04OB\x18=`\xd7+\xabf\x8e\xb7\xbc\x96\xf3,\xc1q]o\x86p@\x05\x0f!\xa1\xdf\tY\xac\x91\x8c31\xdd%ܫ\x9a\x90\x90Z\xa8\x1bV1\x13\v\xfa\xeb\xd2l\x96$\xa8\b4\xe4uQ.\xcaB\x03%\x89\x95\xcd~]e<\x06wCJ&\x91\xe4\xc7ئ$A\xfa\xe1\x02\x12\xf5Z^V\x04\t_\xe0\xb9x\xe2\x1c\x17\x80=\xb80fԱA\x16\x16\x18\xf1\xddˍjeM\xaf\xff\x89\xa4\xa0v\xda\xdf\x17\x1c\xa97#\xd7*\x7f\xc9\x17@\u206b\xa6\x8a\x8b:\x8f\x1b\x11\x87T\xe4l\xac\x9cѕ4\xa3\xeb\xf5\to\x80\xd3)\x11\xf0\x98\xc8\x12\xd1\x04\xc6\xc3a\xa41\x91\x1e>y6\x8c`|:D-L\xb25D\x89@7fy\xf1[\xc9\xee +n\x99}\v\xa5[\x9a\xc9.lKs\xdfj\x1e\x9e\x82\x10]\x9co\x81#\xcf}\xab\xc5\x06\xf0Һ\x1a`\xbf\f\xc6o\xc9m\x11/\x15\xf00n\xe2\"Xv\x8bX'\x90#h\xcbn\xe9\xd1u\xa1\xb0\xd1:\x8dp\xe94tc\xa8\x13s\xa0%r\x9c\xa9ᘴ\x05&X\x0f\xe1PS8&&\xd4\t\xb2\xe6@\x0e\xbdD\xf0D\x8c\xca$\xe4\xe1Cm\r\xa1)I\x12\x01ۇ`\t\x17\xbch\"\xd0\x1dQ+\x87\x1e\x14J\x10]I\x11\xf2\xf8\x80\xa7u\x8d\xc3g\x81\x04\xbb\xb7\xd7s\xb8\xa0\xaa\xea \x80K\xfe\x03\xa3\x85aQ\x1d.L ҁ\xaa(\xa0\x05%\xfbt\xb4\x8e^\xa2\xc1F\xdd`\t\xc1i\xed\xab7\x9c\xb5\xce!\x1dܺO\xb4\x8b\ar\xd4?`́%\xad\r\x1aL\xdaO\xee\xec\xaa\xdfT4\xf7\xa7A\x95\x96\xb5\xdaz\xebpM*\xf2pm\xe8\xc3\x1d\x94\xb9\x992d\xfc%\f#\x18\u0095\xb5Ҡ\xb9=\x9f\xf2@[[&v\x02\xe9փ\xb4\xe9\xc6)\xc1\x198\a\xd0R\x8eyq=\f]\xfd\xf6\n\xfa\xa4H\n8Т\xb0\x15\xd7\xe8\x8a\x06j\xd19\xcf,MTM\xd89\xeb\x04\n\x10\x05\xb3\t\xa1\x0f\xcba\xb8u*2n\xc6\xe6e\x9c\x066/\x96\xf3l\xc4Wf6\xf5\xb8$\xcbs\x96\x8bK\x18\xf3l\xbc\x1d\n/g\xe5q3\xf4A\xa9\x01\x17Am\x8e\x1e\xac[L\x0f\xaaI\x94&ل\xdc߂P[=\n\xb5\xe9\xae\xe1Ъ`\xacヿ\x82\t\x90\xfa\x84C\xd5}j\xc8/\x87\xbb\xbb\x18\xdb\xe7\xedG.p\xee\xfc\xaf\xb4\xd4%\xf4\x9f?\x8b\xe0\xf8\x19\xdfU\t\x96Q\xc6\xf8\xf9\xe04\x82\x93\xd3\xc1)\\\xe9%\xc1\x88\x1do[\x12 [\xe8\x8e\xf1\xab\b\x96rA@$\xfc\x93\xac\x06\x86\b\xbe\xc4\tq\x13\xd1h3\xe7E\xca\x0f\xbb\xd6\fX\xf6\x80 \xdaS,\xb6\x1b\x0e\xa1\xc1\x1e\xd8>uR\xff-Ÿ\xc2>,T\f\xa7b\x8f)\x93\xf3\xf2\xd3OW;4\xa4\x1c\xa0>bL\xb1y\xc8c\xf3\xa2\xb467\x98R\xf8`\xaa>\xfblf\x0e,\xab\xe6\x7f\xe0<֦\xc9é\xf6\x94\xf5\xefu\xbcǬ\xf5\xefu\xdc=ds\xf2\xa2\xb1\xc7,\xa9\xdd\xd6\xd6ӡ\x19U\x17\xbc\x84St݈\U000f8f89ጰ\xbd\x80\xfe\xe8\x04S\xe7\xf1]\x9ce\x98\x1a7\xf0\x02\xc6\x18Kl\xb9bUS\xfe\x94%%A߱\xea\xe4YhQ\xe7\xaa<\x01\x04\x13\xbb\xe9Rx\x10DT߂\xd0\x13\x7f\x7f\xf4d\x18\xc1\x13\x9c\xfc\xb5\xd8\a\x97pz\x1a\xc1\x93S\xb82\x90\t\xaaw\"\x1b\xb7\x90=\x8b`\xf4\xcc\xc2e\xb4v\x1b>D7ځNu\xe5G\f\x10\xc1J\x8e!\xd0S\xa2\x95\xac\x8d\x90\x9c\xad2\x19\x0e`\xf0D\xe5q.\xb5\x8a\xe8\x06\x1b\xc5F\x83\xd3\xd0+\xf0\xd8\x1a5J\x02I\x86N\t}\n\xc2*\xf4Ql0*2M\xa8)\x9e\xf98\xac\x82#>\x81\x8a\xe8\x808D\xe8@dm\x8c\x8b6\xda\x16+u\xd6%\xd0\xc9\xdc\xc9\x10\xb7m\xe9{Bw\b\xa3\xa7\xfc\x13\xae\\F\xb7J\x8e\x9e[%\xc7\xc3VI\xa3/\x9c҇p\xfa\xcc*}r\xdc*\xedv\x94w\x85m\xcbd\xe00\xad\xa5\xb4\xa6eQl\xbf\x8aF\x10\xdb\xd7\x17{\x9a\xcev[\xce\xf0\xbc\xce\nͲ\x19\xf9\x0e$\x92\x11\xae9F\xfc\xf8\xf0\x1b\xf1H\xb9\xdeǡh,\xedwϭ\x85\xf77\xa1\xfd\xf0\xb6\xb5\xd8\xfe\x06\x17\xdbɨ\xf3̕\x9e)7\xea2Q\x1d\x987\xd6>|\x0ec\xd3=\xadI\x9bQ\xa4\xdaB\xfd\xf8ϰr\xdc\u07bf\x89\xea߮\xb5#-\xee\x9d.\xd4\x12\xe1t<\xd9k\x96p\xe0\xacM\x93\x10\x8e|\xbd\xd8\xean\xa7S\x11鑷\xff[ݽ\xf6,]7\xbb֤\xbc\x93\xe4\x94\xf3 U\xba\x19\xb5*\x914&#\x11q\x93\xe8G\v\x1bٚ\u05edq\xe8\xaa\x1c\"\xcbC\xe8G\xac\x9d/\xb1k<\x1c\x1av\xb2H\x98\x7f\xd6;-?\xebmF\x9f\x1d-\xfa\xdcKݮz\xffq\v\xdd6EfF\xcbP\xa3\x84389m\xcd&h\xa1\xadX\xd2\xc4\xc5\xf5*\x8f\xb7\a\x90p`\xef5\xc3\x1c\xc1\xf1\x131\xa3\xf4\x1d\xa5CY\xe7\x9fS\xf7\x9enӽ\xbc\x90Ӷ\x7f&\xad\xabXr\xfc\x04\x17\x18\xeb\b\xfa\xfc\xd7\xc6QEn\x1b>\xf7\xc8p\xea\xdbJ\xcb?n\xb4l\xa3\xb2\x1b\xc4\x1c#\vV%\xb1\xb8\xf5\xde98$ЃGE@*>/\xaf\x03\xa5\xeb\xf51\xea\t\x1c\xee\\\x89\x1d\xc1\xd8cB\t\x1f<\xbe\x94'd\x1fMa\xca%\f?\xf09\xd9O8\xf8$\xef\xfe\xb9G\x9d}\x1cF\xbf\xd8z\x19\xa8\xc1ؚ\x92\u06dd\x01}x>tF\xadj\xfc\xe7\x1e\xae\xb2\"\x7f\xed\xff\xb8\x01\xea\xa5˓g\x0e\xc9e\xdc\xccMB=\x01ܚ\xb9\x13\x8e\xc3xј\xe2\r\xfc\x14\xa7٪n=kLy\xaf\xb2*\xc9E\xc4AD\xf56\xa1\x84\xc0(\xd8\xf1$\xa1\xde9\xc6\xcd\xfc\xe7͒\x19\x81>\xb9k}\xbd\xca\x1b\x98\xc0t5\x9b\xb1J0\x12.\xe4\xf7\xbb2+\x82^/\x14n\x00\xb2(\xcfu\x822\n\xe3V\xad\xde\xd1q\xbd\x8e\xab\xf2\x1dK\x1a\xff \x12\xc5\x05\x8c;\xd6\x04\x1dQ/\xf4c.\xf3\xcduY|Y\xb1-V\xb1z\xb5\x80\t\xc4\x0e\f\x8eL\xee5>\x14תt\xde\x0e\xe7qD\xd8\xf7`\xccZ;\xeez\xb5\xd8F\xf7+V4U\x99\xa5ݴ\v@eqZ\fD\x8a\xd3\x14\n\xa9\xaey\x18\x86\x91\xbc\xe8%\xe0\xc9A\x96\xeeH\x88:\x8d,\x99\x14\xf0\x1b\\t\x81\x8b\xff>\xe0N\xe0#\x14\x83>\x9e\xc5\xd0\xe4!\xc0i,m\xcc\x04<\xc1y/x\xfd@\xde\xfe\xf6H\xdfkا\xe9Y\xbb\xe9\xe7\xee]7\x9b\x05:\xfbcXa\xee\xeb\xfa\x16O\xcc\xfde\xdfb\x8e\xcey/\xe5\xa7{\x13\x18\xc1&\x82'p\x00\xef;\x8e\xeb\xe2N\xa1\x17H\xf8$1\xad\x83-\xfck1O0\xa9e\xb9\xb5T\x15\x9c\xe0컯\x86³\x1cU\x83k;E{v\x18\xb5\xf5\n\xd5)T\x97>\xe2C\xbd\x19\xc8f~\xcb\xe2fU\xb1We\x9es\xdc\xc6\xc3\x12\xa5k\x1d\x98q\xe0\x9a^\x9e\x97\x1f\xf6\xad\x11\x99\xeaHh[F\x85\x8a\xa4K=J\xbd\xca\xd2(\x91\xc8m\xd6T\x1b\xe3\xad\xe4\xc8&\xba\x8bT\x85\xae\xd4HZ8~D\xdeva0\x89\xeb\xfd\xd0S\xbc\x0fʁ),V\xf7\xb5\xab\xf8a\x957\xd9\xd6z\xc8\xe4\xa2\x11\x12_\x8do\x9b\xb5\xdd\xe3\x7f;w\xad\x068\xaavG\v\xbe\xcf\n\xf6\xa6\xa9\xb2\xe2\xfa\xb3\xb7\xc0\xa18\xdcSr\xba\x9a\xd5\xfb\xdeD!\x8b\x987&\xedn\xfa\xec-\x8d\xa0^M-x|zl\xbf\xeb!vIg\xeba\xdf\x19y\u05ca\xdbE\xcfR\xae\xa6m\x8aL\xa0n\xee\x1b\x94\xbd\xe3\xcfs\xf8\xf8oWp\xf9\xae\xdd\x05\xfeN0\"\a\xe9\x81I\n\xf6\x7f\xb5n\xa0\xab\xbe\x9d}\x81\xe7\x17\xa1/\xb6Ͷ\x8e\xf9t]#빴\x17\xb2x\xa3\x19&\xd0\xfb\x8fގ\xa8O\x8e\xc6\xfb\xfbw \xa5\xba\x19\xf8\x80\xeb?\xcb\x00s{\xca\xee\xe5\x16\xf1-\x94\xce\vy<\x8e\xb0M\x87\x90\xb1%/{?1\xdb%hF\x030\xaa\xc1\xb2S\xd4\x1c\xaao\xfc\xd2v/y3%n\xab\xf4\xfd\x9b\x98\xec\xf7[̈\xa5A&DP\x7f\xda\x12\xa8\xd3\x1f\xb6\xa4\xd1\xe5qbr/\xf1\x9ag\xf6\x15\x8b\xc5BmI\x8bN\xbe\xee\xfe\xe8E\x9b\xc03\x8c>\xe9\xf2M\u07b8\x96T\x9bK\xb7\xf6\x8d$k\x95\xbe\xe7*\xce(\xba\xc7bn\xabʑ\xdbcc\xa3k/\xe4\xce\x1f\xaa\xc8\xf8\xb6\x18=\xc7?\xc7*\x0e\xb1\x1fN\xba6\xe8^N\xab\xcd\xf2\x83ǅjҧ\x1f \xa2=\xaaK\xed\xb1\xb1\xb5-\xe2%6u-\x95f\x11\xb1+4G\x8d\xb1\x1d\xdd2rv\b\x8a\x89\xf8c\xe4\xce$\x12&-\x83\x85_\x06\x8d\xfd\xab\xb1!\x86#\xfd5\xbe\x8a\xcc\x1d\xb1\x9d\x05W-*\xf7\x15f\xad)\xb6J\xb3\xacL\x05\n\xdf\xf0?\xef\xf9\x9f\x87\n\xbb\xa6\x05\xb61\xads\x00\x88\xdb\xe5^#\x82\xb8\x11\xed5#\x00\xbc\xb7\xf2\xc6\xdeG \xad\x83\xe5\xf7\xfc,\xf9\xbd\xc1kK\x9d\x93$Z\xdbu\x9f\x15\xd6\x02hG\xb5\x97\xbc\xfa`\xe2\xb4\f\b]\xa6ݎ\xe7\xb0C\xc7\xfe\xb0>w\x8f\xdb\xed|mt\x86\xfd\xcd\x14\x1d\xb6\x98Vs\x9ct\xdb)fU\xa4V\xfb\xc4\xdc\"[i\x04oӡ+ԣ\xc8:IFi\xeb\xeb$\xfe\x86rߎ\xef \x14\x05\xafWVf\xc46C3\x92\xcd\xe15\xbc\x10\x01\xd4\x04\x1d\xebs+\xf7%\xaf;T$X\xf9\x1b\xe3YeE\xf6Ɔx\x89\xa4\x86\x82\xde\u0379%^J\x16/\xa9z\xd5\xf6\xab\b.y\x8d\xbc\xa1B\x17\xb8W\xfbx;Q\xb7\xd5*8\xa0ҋ\x16\x8cH\x8d\x1eu,:|\xc0:?z\xd45\xf9X\xe5\xda\x00\xbc\xa0\xb9#\xb7\n\xe8\x8c\xe8\x91Ro\x9d\xd0Nn\xf4ȵ\xcc\xecƭTg\x1b\xb1\xc8\xe2\xc0>\x84\x94&\xb3}hD\xaa3\x06\x129Զ<\x01\xc1\a_\b\xbf96\xcd۬ΦrX\xb6\f\x9aq\x95\f\xf85\xaeD\x81\xbc\x00\xcbM\xc3\xc1\x97\xe4ٲ۸\xbek\xb7\x86\xa5\x97,\x15q\u0096\xc3\b\x96\xa3\b\x96\xe3\bR|\\x\xb4ۆ\x9d\x8e`\xe2\x90=nmќ\xe7\xd3ґnT\xd8\x0e8=\n%]\xe2\xfd6\xde#\xd7\x15\x8b\x9b/\xab仢aղ$\xff@Nm\x18\x04\xe9\x10\xfa`\x1c\xc4\xf2\x84t\x14\x9a\xc6r\xb0\xf1.\xc7V\x1e\xdd\x7fX\x8e\x9c\v\xcb\xde\xf0\\\x04\xb5\x1c\xbb\x16\x84\xc7\xcb!\x85|\x12\x95\xf8\xa2\\\xc1\xdeM\x13p\x9766څa/\x85A\xa0\x8f:S\x1e\xbc1\x1d\xf1ߡ\x1bn\x9d\xb7lWp\xa3\x14\xc1Җ\x93\xder\xe8\xf4\xa7\x9e\xbb\x89\x0f\x92С\x15hc9BV,\xc7\xf4V\xf6\x04\xefB\x887U\x97\xe3ˑJ\x1aQ\xd2\xe3\xc0\x80\x1b\xb5\xe1F\xfc\xf6\x90\xe6\xa3ݍ\xa3\xaey\xadbu\xbcX抗aǥ \t\xb5m\x14\r\xbb\a\x117qH48\x98\n\x8a\xc3`\xf3\x8cb1XK5Y\xa2\x8e\xf4\xfd\b\x1cIu\xb9\xaa\x12\xc3˷{\xf0)\fvIk\xf0\xf1\xc8w\xee\xca\xcc\\4:/\x8c\xbf\xe3\xad\xc5iO\xe1\x97\xebC\x10&\x92s\bu\x83y7(X4b\xb9\x1db\x10Ǜ\x1a\x9e\xfb\xbb*\xddr\x85\x88{YE\x90\xb2\xeb\x8aQ\x9b\x9f\xe3 \x1f\fG\x11\xe8\x1b\xf12\xb7}\xf3<\xae\x12}6#\x87\\\x10:\x84\r\x9a\xb8\xbaf\xf4\xa2R\x96\xb2\xa2\xc9\x1a\xb9\xb5\xe0:}\x80\xc2d\xea\xfeԻ\xbe\x93t;\x8b\xbc\x16#v\x1dn\xab\a7\xb2\xa58\xe3\xc6(:ZC}0v\\\x02\xe6\xb3\x1d&\xd1Q\x9a\xac%\x1c̲\x9c^9j1ʠ[\xe1\xc1x\xf9%L\xe0\xcfS\\V\x0f\x12d?\v\xca02\xf0\x1b&\x8e\b\xca{\x9f,\x19v\xa3\x8d\xd0K]{DE\x9f\x82\xdfF\x9f\x81T\xfe\xf4ҷ\xf54IT(\xe7\x7f{{\x89\u0557\x9f\xfe\x88Jv\x91\xa8\xb4\xdd\xfc\xb6:CJ4~\xa0X)gP\x0e\xf0\xaf\x15iZ\x97\xb4Ԛ'4\xf4ǜW\xd1\xf2f\xc7V\xdc߈\xce\xde\xec\xb4%{\xfb\xf4Ӝ?\xa9\x91\xa3FM\xd7\x03u\xa2MikG\xfe\xe1\xef\xdc\xf0\x8f;(\xd0\r\xdeF5\xbau|\x9e\x1e\xfb\x04\xd4\xef\xec!O\x13?\xecݿ\xba\xe9\xff\xb8.\xfeD&\xf9\aL\v-\xb3\xe4>\xfa\x97\u05ec?\xac6Y{p1S\x7f\xc6h\v\xed\x99\xfe\U0006e67e5\xa5\x13\x91\xf6\xd3]\x9c\uee38~\xa0\x7f\xa2X\xfc\xa8\x9aԪ(Ћ\xa6\xc3u\xb8\xeb\x06\x80\x874Y\xc1\xf1\xa0\x12\xafy\x11\f\xad\xaa\"\xe8-+\x96d5\xb5ܵ\x18ɕ\xd6\xd6\xfd\xb2^\x8eY+\xef\x14&*K\xed0;\x96L\xfc\x9e5n[\x1b\xfe\xc4\f'\b\x8e um\xa6\x97\xb0\x1c\u0095\xb3\xbe\x0e\x1az\xaf\x94vܣ\xd0,\xc1\x17\xb8\x99\xb19k\xf4\x02\xad\x05\xb7l\x05\xd3\xd1\xe3\x88Ϧ==\x9f\xf4\xf4\x9c\xbacF\xfd\xd0~\xf2\x19\x85K/\xf6$\x9f\xdeP:\xdf\xcd\xf3\xb5l\x1b\xe6gJ\x17\xbb}ũ\tyc\xb9\xcbf\xa3\xddmDz\xc7ZVr\xc7\xd2\xea0_\xcf[CH6\xc5\x19BF\xcdr(\xd1f\x94\xff\u07b5r6\xf0K6l\xc1\xcfA\x02\xda\xd1\xf2\xdf{\xae\xccM$\xca\x06b\xdf\x04U\fQ]v\xffgOyQ\xfdb\xb6@\xf4\xf6\xbc\x93\x9b\x8f\xef\xc5M\xb7Y\x92f\x7fC\x94\\ݿ!\xbc\xa8\xacM!z\xdb\xddm\x8f\xef\xd5m\xf7k\x88)\xfc\xf7o\x8b\xa5d\xbc\n\xd5\xc4\xffv\x97\xda\xf5\x92\xea\xc9kiW\xc3\xf6o\x18(5\xd4B\x05\x91\x13߃\xa4,\x9are]\xb4\xb8\xae\xf0Ԩn\xe2\xaa1m\xf9<\x80k\\5\xb8\xef\xe4\xe4/ފ\xe2o0\x9d\n\x8a &\x97W\xfcܩV\x9e\xb3<.J*\x0e\xa3Rq\x1a\xb5\xa4\x84\xd7\xf1k\xfc\xb9\x91?3\x1d\xdf5-u\x17\xe8T\xde\x1fX_@\x97\x8a\xb1\x8a>\xbd\x15\x9a\xa1\xee\x1e\xb5\xa1\x1c\x88q'\x1e\tq\xe2\xc5!s\x9f\x99\xb9\x19)\x94'\x965\x14\x9bE-\x9a\xa0\xe1\x15.\xf0\x9f3M\x18\b\x0e8w*5\xb6\xe7-lC\xa7,\xb1n2\x816\xf2\x96\xa12]\xc3\xc4\xed\xb2\xafז\xcbL\xba\xf1\x80l\f\x103\xb4g\xba\x86ǜ\x80/\xbe\xc0\x92\xf4\xb11)N\xf8\x14ȝ{\xc1\xb4\xfb\xf2\x1e7C.\xf3\x8eO7nMtP\xa8\x01\xe9lPB}\x90\x935\n\x18ֿF\xa9D\x19Ï\x8d}\x04\x93x\xceW\f>\xc8\b\xb8\x18̀\xfe\xaf\xcf?\xfa\xfckh\xfc\x9f\x91\xf3:~M6(\x97e\xd2B\xd5\x1f\xb5\xcb\r\x15z\x85Z\xe4\x106s\\\xceW\xb9u\xb5\xf5\x96UM\x96h\x83$\xbd\xc7%\xd2\xe4\xd2\xf9\x05\x1c뫣\x86sq\xce\n\x98\x80\x03\x1d\xc1\x92\xa7\xe3\xbf$\xfat\xccP\x8b\xc1[7\xfc\xc1~\xfc\x9d\x91is\xceG,\x05\x12\xc4\xf8\x82\xf8$ј\"\xab\xdfF\x10GP\v/.\xb2\x1df\x80\xa3\x102:\x81+\xd0\\\xe8\\\v\x91\xd4\\fW\xd8i/\x14y\x97\xf3+\xba\x1c$\x81\x81*\xce\xfe\xff\xec\xfd{\x7f\xdb6\xb2\x00\f\xf7\xef|\n\x84\xddƢMӔ|\x8d\x14\xa6O\x9a\xf4\x92=\xb9\xf4\xad\xd3\xdd\xf6q}\xf2\xa3D\xc8fB\x91:$\xe5H\x95u>\xfb\xfb\x9b\x19\x00\x04x\x91d\xf7\xb2\xbb\xe7i\xf6\x9cZ\x04\x06\x83\xc1`0\x18\x00\x83A\xc3@\xa9\xa0\xf0\xfd\r8tx\xafZ\xa5wɾd\x11\xeb\xb3\xeb\xc6\x00ײM\u07ba6\xd1Ƚ\xae\a\xd4\xc7\x1b\xc4\x15r\xf7+\xd4J\xd8y\xb7N\xea~\x85T5\xa9`\x8f\xd1P+ۊ\xab\x86\xbe~\x99\x1b\xfb\xabk\x97&f\x94\x84|\xdegQ\xe5-\xa6\xd5\x03\r+>\xac\xa4\xe4/pذ~J%V(\xfblH\xbf\xcc\x13P\xba\x90\x00\xc8`\x91\xab\x01\xdc\x18\x19H\xcc\xe0\x81|\x99\xc4k\x16\xa3i\x9d\xe7\x1fJ4\x91\x81\x86\xba#`\xbe\x9e\x8f\xf5\xdbz\x94\x02\x83\xd37k9]\xedÛ\xb5}\xc8ؼ\xa7C\x7f\u0604\xbb\n\xbd\x167\x1e^w\xd9.\x9b\x8b\v\xfd\xbb\xf0\x9f\xa7>T\xba\v\xff\xd9\x03\x84\xbblѫ>\xacb\xb0\xaa\xe2\x99\xd8t\xbaE\x05f\xcd\x05\x8c\xfe\x8d\x8c\xfeeLvf\xa4'@\xb7\x7fh\xf0)\xaeּ\x16o\x05\xab\x81S\x1f\xb2\xa8\xc0hh\\\xdb\x15\x91\xf2\x1c\xf6\xa1\x1c\xcc=<\xcd0\x87\xb2\xa8\xfe\x83l\xfaC\x9c\xc6m\x8dH\r\x7f\x05X?\xe4\xdc\xdbk\xa4mJ\xb6\xd4裱\xf5\x83\xf4\r\xd8\aM\xda7\x90\xe5\v\xb2\xaa\nGL\x8e\x0f\xe5\xec\x04\xb3\xc9\xf3\xe7\xff\xec`\x9d\x17\xf9\x14B\xbaI%O\x9fx\x98hbw\x94\x04\x1agn\xfb\xfb\xf9\xb4\xf6҇@\xb4\xb7w\xc9\xfc*\x9e\xfa\xfd\x9ax\xa1]\x7f\xa9\xea\xd6|Z\x19\xe6\x00O\x8cV#\x82\xea\x8b./\rť9\x10V\xaccu!h\xc3EW-Izv6,X\xb78\n\f\xcct\xf1\xee\x14Ee0\xf7=\xd1,\x1e6\x83w\x1b\xc0\xbd\xcb-\x1e>CW\xa9\xda\xe1_\xbd\xfa\xc8\xd4,Ör݆r\xdee\xcbakg\xc8\xf6Y`\xb3]\xe6\x1e\x9b\x9bZ\x1a\x9f4\xd71\xc5\xe0\x8fwsY0\xddڂ6&:l4X\xbf\x86#\x1fm87?a\xbb5\x11\xe8\xd8\xf66\f\xf7\xd9P\xe7c\xbbo\xfa\x88\xf9,\xa0\x9e\x18\x92\x9e\x1f\xd2W`N \xd0\x15\x1d\x04\xdcC\b`\xe8H\x9b2D~\x97\xf2\xbbf~\xfd\x8e\x1d\xdbe\x1f\x1d\xbc2\xfd\xb1r\x1b\xda\xe8\x96ʑf>\xc3]\xd8J\xc7$\xd3Y\xb1\xf9\x02\x00\x9d\x86\a-\x9d2\xc4h\xdb\xe1f\xd6bm\xe4,\x8f\x1b\xc2\xe2\x05\xac\x92\x132C\xbd\xe3\xe4m\xdf\x11\x88\xfc\xa23\x91?\x95L\x00\x91%h\xf56\xc1\xbak\x02\xa1Bk\xde\x05\xc0u\x93P\xc7B\x19\xbdL\xf2(䝐\xa4\xb7\xf6~.\x8aks\x91QK\x91\x92\x1b\x9aW\x89V\xb8\xe0Y\x8e\x17\xb1\xf0\x89[B\xb1\xf6Ed\x03\x9b\xdd\xf0X]{\xb3\xdah\xfcm\x14\xea\xf4\xe1)~\x93\x1b\xa81\x1cW\xd5{\xcaX}\xd3\xd6J\xc5\x15\u0098?n\xd2,M\xd2hݒL\xbbĜ\xeb\xeb-\xe3\f\xa9fC_\\\x9aFsx\xf8^\xd4\xf5\xbe\xe0y\xcec\xdcg\x96\xc84\x7f\xc7\xca{ty\xd7ay\x8f\xd6g\xb86\x83u\x9a\xb1\xcd\xc1\xdd@l\x18<zĸ;\x04\x93Ѹb\x92\x83\x89\xcb]>u\xb5\xa7\xbe\xf2\x9eL\x8c[\xf7\x17\xf2n\rF/\x985\xed!(jt\n\xd0\xc8\xceaG#\xef\xba\v\xbc~\xccO\x06\xa6\xc5\xce\xdd\x11\xdbG\xfa\xc1\xee\xad\x18\xd1y\x0f\xca\xf6\xb0\xacY\xb4W-\xdak\x0f_\xa6S1\xafQ\xb1Щ\b\xd0\x0e\xaf\xd4#\xa9\x98W\xa9X\xf4\xaaE{MoS\xdft\xe9\r\x12\\j\xc3.\xc3M\x8f\x12p\xcdʹ\xf5\x1fI\xdb\x05w3~\x15\xa5\x89\x1b\x93\xc9u)\xec\xa5.\x14\xb5\xdb\xc1\xb3v\xf0\x8a\x1b\xab,kJ\xb3H5\x82>\xe0\x91꼶\x88\x85\xb0\xfd\xb5Up\x852\xf5\x9a^9ƌ\xc3Gu \xa6\xadmohi5\x02s\x95\xe6ӑv\x05\xa0\xba\t,+Z\xb7\xb8ݴ\xbcm>\xdd5x\xa0P<\x8cp\xb7T!\x12\xf5\v\xc3J$?e]\xbe\xdf\xf5\xaaD\xaf\xec꾕T\f\xe9\x14_\xb7+_\r\x8f\xfb\xcc\xca\xc4\x11R\xd6gVlU\xf5W\xc8\xe3`\x96\x04\x8bM\n\x8c\x87W\xfc^\xda\xcbaE\x16a{r\u0378\xbf\x8f6C\x12ZEZ\xcaOM\x86+r\x8bX\xea\x02\x05\xc95q\xbd1\x05\xd3\x11\xf2\xabI\xad&\xab\x80\xe1\x0f\x14TD\xff[\xc5S\xfeµ\x15\xb4P\xf3\xe5\xc3\nJ'RZoN\x06\xec\xc3ޞ^\x89\xeaM\xb9\x93{\xe3`ы\x0f\x97\xf2\aD\xfe\xbcd\x97-g\xef*N\x8d\xc0S\x9bR\xffg\x16\x84\xe2\xed{M\xa1\xc0ұ\xba\xcbX;%\x8e\x92\x9cgE'\xc1\u05ed\x9aaŖ\x1c\xbe\xdb<u\xe7\xe8-'\xbf`\x1b\x9f\xc8\xd3\xe7Gx\x9a5\x18W\xbd\x1d@6\x12\xba\xd2an\xc1\xdc\xd4=\x96U\x18\x8a\x1b|J\x1d\xab\xddcZ\xea\x02S\x17p\xb2\xeczݚ\xc1\x87\xadz\x0eO\x8b67m\xe3c\xc1\x82К\xebr\x03\xf6\x9b\xb5\xd8\xefJ̺m\xa4\x92\xa8\xe9\x16\x9b?[\xd7\xdb\xf8\x00\xe1\xc6Ҧ̈́!\xadp\x1bmޣ\x15+<,\x03\x89\xf8N\xd0B%\xca\x1b\"Sw\x0efS>\u05ee\xb2L\xdd\x05\xa6\x89\x97Y;\"\xe3\tz\x0f\xecQQI4\xc9X\xf5qF|Dإ\xc7\x0f\xa3K\x90Ԏ\xf6Y\x9e\xcb\xc8\x11\xf3&\ry\xc7<\xe0\x167Y\xc8t\x91w\x86\xc8\x1816E\xe5\x8d\x16\xb2\xb4\x16\x02\x90l\xa7\xf2r˚\xe1U\xdb\xcd1W\x82b\xf3X\xdf\xd6±\x056\xa7\x18~j\xe3wn\xdb塃(\x88\xafGV\x9a\x8b\x9e\x8fv\x89\xac\xba\x84gOر9\xeck\x10`i\x1e\x1a\x96&\xb4\x18\xf9cؐ\x18곻\xde2D\xa0\xfaKׄ\xacW\xbf\xb8\xc46\\\xa3\x9b\xea\u06dd\xd5%#\b\xdc\x13ؼ\xa7\xba\xa7\xee\xbc\x0e\x017\x95 H\xec\x82 \x16M8\x9e\xa2\x84\xcf{\xed8\x9e\xa2\xb8/zU\x1c+C\x1dR$\xe9\x1e\x84+\xee\x8ac\xdc\x05|\xe9\\\x14G\x84OY\xb8\x10\bq4\x85\x86X⨫\x1e\xfa\x95b%\x1e\x04m\x96|*\x04 n\x10\x1a{HSM\x0eH\x88\x01j\x8d\x1ck\xa8\xc09\xd6pe\xd0\xe6\x82*\x1d\xff\x00\xd8Θ\x9e\x03]\x8f[\b\xb6\xb4\x18$\xd5\xe6,\t\xa9\xfa\x04YD\x13^}\xf0M\xa4\xbd\b\n\xee\xc8\xef\xf7a\xb08_L\x86\xa9x\xbe\xc0:\x9f%a\xb0\x80G\xe5^\xa7\xf2\u05fb\x19\xcf\xc5\xcf\x7f\xf20Q\x1f\xef\xaeg\x99\xfc\xfdM\x16\x89_\xe7\xe0[\r\xbf\xe5\x81$U4+\xc0I\"-R\xf1\xc8\"q\xe6\x8a\x17@O\x9f\xb5\x9b\x88\xe07\xe3\xbew\xafx\xf1\xe3\xbb\xe7/\xb4\xb0\xe5+\xa7D\xb1\xb8\x03\x86E\x1d\xc17\xb38\xfe\x99\a\xd9\xd6Xd\x81:\xaa\xef\xd2Y\x96o\x8d\a\xa1\xebH^Gq\x1c\xe5|\x94&\xe1\xf6\xb8\xf4BM(\x93Y\xc1\xef\x82\r\xe1\x1b\x10\xa5Iq\xbd=\x1a\x80\xae#9\xbfc\xdb\xceۚ\xf5.\x9al)=\x00\xd9\\\xfe\xd74\xe1o\xc7\xe3\x9c\x17\xeb0yFQ|_\xf1\xedx\x8b\xaa\x05d\xa5\xea|\x8d\xe0\xcb!\xa3\x86\x8b\x9b+\xe1ל\xc9\xdc\xf7\xba;Y\x1d\xfb\xe2\x8e\xc8\x17[\xe3^;\\\xda*\x90\x85\xb6\xae\xa5}$\xb5U\x81%\xb6ƿq\x90\xb5U\xa3\x17\xbcCm\xed㯽\",\xb3}\x1d\xadC\xb3\xb5\x06(\xb15\xfe\xf3{0\xeb\xfc\x8e|j\x1dЍ\x15\x00\xf4&ԕM\x10\x13\x89\x98\x10˩\xa9\n9N\xb3I\x80c\xf5\x1d͟\xd6\x17\x01\xfbbȾ\xe0\xec\x8b\xef\xfa_\xbc\xee\x7fqξ\xf8\xd9r\x1a\n \xf0\xe4\xe0\x8b\xf0\xe0\x8bE\r@a\x13H\xacj\xc5a\xb0ș\xaf\x7f\x8a\x89ژ\xbc\x9f\r\x87\x19\xbf\x89\x02`V\x05\\\x19\xc3Z\xad\n\x9c\xdb%\x9a\t\b\x81\x98\xff\xff\x1e$\xb3 \xa3)\x9d\x0f3\xf9\xfb5\xbcU\v?\x9eM\xb3(\xa6\x14\xcc\xf8\xfb,\xe1\xf47\xc6\xefg\xb3\xabY^\xa0\x1d\xc0\xa7\x05\xc7W\x91\x1df\xbd\x1d\x15\xa9\xf8\xf9&\xbdQ\xc9/\xf8\x88~\xb3\xcb\n9m\xed\"Z\u05f7L\xb3\x81\\\xcaԭ\xb2\x82O\xa6\xb0\x8bT\xdb\x14 \xd0N\xa8\xe5\x89e\x1ezXKg&\xb1X\x11;\"#\x87\x8d\xb78\x84\x1a3U\xaf;\xba\x0e\xb2\xe7iȟ\x15\x9d\b_\xa6><\xadĩ\xc2\xfah\xdfD\x95\x82\xa8K\x98\xde\xf9\x00\xfbO\x0e\xeb\x8c5\xb6\x10\xed\xf9\x05\x1ci\x18\x15=+\x80\x1e\xfb\xd2f_\xb2\xb1h[\xdfxځ\x8e\xa7\"،iX^?؞\xa4ZDW*#\xc3\xe3V\x17~\x89Njōa\x12\x14\x10\x03?7\xf6v\b_ݯ_[\x94\xf5\xe1}\x18\xaf\xf4S\x9a\xf4\x99\xf6\x15\xc2ö\xea\xeb;#\xef\xb5\xf1un|\xbd\xea3O]ya\x91\xc6v\xa4\x11\xf6neC\x1c\xd1\xea2\x84\x8dt\xedz\xe8K\x86T|\x7f\xf5\x9d\x16\x00\xb5\xa6\x16\x8b\x12\x16\xda,t\xbf\x83\xba\xdc\xef\xd8\x17\xac\vn9\xa1\v\xaflt{Ƴ=\xa4h\x12\xfeIR%sC\xec\xa3r\xb6\xee\x84\xee\u0081\xebF\xf0\x9fЮ\x82\x91)\x1a\xba\xdfA\xf6k\xf8\xcf9\xfc\xe7U\xb5[C\x153ze\xf4W\x91\x9e\xcbq\xb2\xc6&\x12|j:>#<\x83&u\xfd\xeb8\x8acz6Q\x8c\xe8\x8e\xe5\xf5B\xcbvL\x90\xc3\n\xc8a\x1d\xe4\xa8\x02rd\x80\xe4\r\x15A=\r\xca\xf9\a^\x1b\x7f?\xf0\x8e\x06\x90\xdb\r\x9azc)C\xf1U\x95\xf4\xfa\xd2\bR+\xf3*M?Φ\xb5r\x94\xbc\xa1\xec64\xd7\xd5u3\x96;\xd0abk\x9e\x91˨&A\xbf\xf1\x0eu\xf9\x16{#k/B\x97V\x8e\x1d\xfbҰA\x9em\x8b\xae\x1d\xc5p+\x14\xf5\xa6\x12B\xb1d2Q~\xb5=\xcauhF\xfd\xca\xcc\xd8i\xb6s\x84\xafg\xb8U\xad4:;\x82\x1b\xfa\x03f\x84\x85o\x85%߀\xe5\xbb;\xd3\"\x16\xd7&\x9a\x97\xf7ECJ\xf8\xf6\x96u{&\xc6\x0f\xdbc<\xc4G\xd6e\x0f\x84\xc1\xe2\xed\x984s\x85\xc8WwAI\xbdm,\xfeMl\x93;7Y\xc8\x0e\xd8\x04&\xaa\xd7wG%\xf7\x11LD\xd3\xf5\x88t\xbe?\xf5\x81\xf1_2\xeb\xfb\xd7\x16\xeb3\xeb\xd9k\xcb@u~g\x9aΛ\xf9\xf4\xe3\x9d\x10\x89N\xccgI{?~\xda\xdc\xca\xfa~\xd4?\xefC\xc6$]C\xc6|\x8bQ/F\xfcO\x1b@5尸3\xdf\xcb]3\x18L\x9egR\xf9\xf3\xf6\xf8\x8e\x9a\xf0\xf1#\x13\xdf\xff\xdb/\v\xa5\x89\b:`}a\xad\xad\xc6\xfa\xc2Z\xb7l\x04{/3\xa6\x1e#럜\x7fT\x13\x8d\x9aN\x9a@\xd4Dad\xbe.\xa7\x04\xa5\xf6\xeb\x00\x9a*ײ^\xa5\xf0\x8c\b\xb0D\xe9n#\xff\x85\xac\x94\xb7e|Wɀ\x01\xd8;Rj\xb35\xefU\x95HM\x17)\rToƛ\x19,\xfd\x94^\xa9\xa0@\xad\xa1t\x85\x91\xf9l\xf2\xfdD\r}#\xe7\\\xaft\xde\xc8 \xdc\xfe.E\xbd\x96\x0f\x02\xae\xe4\xdb\xc8\aIS\x92j\xe4H9l\x12\x99\x04[\x89&\xd4\xc1\x7f\xff\x92\xef\xfe\x12\xee\x1dTa\x82\xc9\xf7\x13\xcd<\xc2խ\x10\xb0r\x053\x9d\xf4Y\xf7\x81<\x1f7\a\xa9;+F\xf7_\xe3\x16\xd9B\xfdf\xdaI\x81\xb6u?0\x0eq\xa8\xb6\x86%\a\x83,\xf7=\x94\xd5^\x96a\xe5\v\xa4\xa3 \xee̊r\xf9\xb9b\xe3(\t⸙\x80\x17\x1a\x92U\xed}\xdcQ\x10\x13\xbbtm\xa5Z~\xb7\xb5\xe4\x9dY \x96]H\x05\xa1\x978k\xadF\xd0G\x8fh\xa1\xf5\xfe\xde-o[l\x11\t2a\xdb\x15\x15e\xbd\xccS\xe67\xc8R\xc7\xfa\xe2\xe7\xfd/&\xfb_\x84\xef\xe4\xfe\x94\xfbū\xff\xd7j\x12\xbd(O\x05\xc1\xe5&\x9a[\xa4/\xcf\xdf\n\x12\xbf\xacW\xfa&(\xa2\x1b\xce\xfa\xf5\x1c\xfdȨ\x02\xbe\xa9\x17+\xaba\xb4!\x8d>\x11\\\xa1cd\x1a\x02_\xe2\xf2\x1b\b\x11\f_\xad#@\xe3z\r\xc4\xe8\x01e\x1c\xa0>\xd2\xc0\xf1\xca\xf1M\x10k\x8e_\xdaP\x14\x04j\x03\x8b\\B\xc6q\x9af\bI\x0f\xf2\x82W\x01\xfcA\x825'\xa8\x10w R<ʐ8\xe5\xf2\x1e\x8f?\xf0C\x9d\x85H\xdf\x12B/\x8b\xb5\xa3\xae\x90)\x91\x19g3+\xbb\xde\xfe\x9c\xf9\x95\x147\v\x92+\xde\x00)\x14Y\x05\x1a\x94J\xad\xc4\x04\xa7\x88ߗ\xb7'\xfc\b\x18p\u008f\x1a\x19\xf0;\xf0\xb6\ru\vo\x8dS\xb8\x95]o\xbf\xce[Ji\xe3T\x95\xb7\x02\xba\x91\xb7\xd7t\xa5{;\xce¸+\xc41\x1a\xf3\x15\xe9\xe6\xc9\x1a\xbe%-\x1f\xb5k苆\xce8<\xe1\xc7l_\xa1\x06\x96\x96\xbfw1\xfb\x8f\xea\xa5V\xdc-ݤ\x1d\xe3\xae\xec*#\xf5.\x82\xeffvW\xbb\a!\x1b;'\f\x16w\xea\x1b\x82י\xdd}|\xea\x95;\x94a\xb00\xb7\tE\xa3T\x02\xec\xe7Hy\xa4\x85a\x99`\xac\xd4\x15W\x16w\xe9\x17Da\xe2c{\x12\xf6\x0e\x9d \x8a\xee\xb3n\xbd\x1f\xd4a\x8a\xfal\xe4k\xb5\x13\x00\xae\xad\x0fh\xad\xc5\xfcfڀ\xf1\vʗe\x16\x92\xb9&\xb74\x01\xc4\\\xb6O\x05\xf7Y\xa7u(\x11HS\x96\xd41쀝\x9d\x1cq\xe3\xf9\xd8\xeaAR\xdd\v\x15\x16&\xa5s\xab\x10\xb4`\xe1\x16\xe9+x\xe3\xfcy\x90\xab#\xf4\x88\xf9\xec\x94\xed˻\x94t\xb1\x87D\xb1l\xf3E\x18,.\xb7\x16VF-6\xf9O\x10v\x9b\xa0h\\\u00954\xdb\x03\xfa\xbf`\xa7k\xb6\xad7\xc8\xe5&\xc9l\xd4\x18\xa7v3\xf2\xcaqE\xb0\xd0ZW\nDe#\xa0Y6\xea\xbbF\x88\x7f\x0f8\xb0(\xdb\xcd\x0eة\xe0\xcb\x02\xef\x9dFv\xf5\x86\x88\xea\x1b\xb6Ǭܺ\xc4+G\xd43\xa5\xa87\x01\x8aᡀ\x8d\xb1Q+@d\"\xfa\x7f\a\x9ehg\x0e\xa6r\xf8\xc4\xf9G\xdd\xe8\xc0\x1d\x9d*@^\x83\xa8k\x05\x84\xab\x9a0\x04ۨE\x00^\xa9\x91\xa6\r%c\x16\aջ\xf5Xj\x1bH\x83\xba\xea\xedV\xb5\xb7\xb0\x84\xb7T\xdf4%T&\x88{)p\xcdW\xa8b\xea\xc8\x03j#\xa1\xc1\xd0A\xb8\xaa\x9d\x83\xb0\x8d\xfcW\n\xfa\xf7\xe3(\xb5\x01\x02u\xfc6\xae\xae\x99\x8d\xef\xc5[\xd3ameWِW\xc6`3\xb3\xaa\xbcEH\x83\xb5\xfa\x1a\x13ߵ=/\xf8\x94|\v\xba\xfc\xd0a\xc7\xf0\x9f.\xfe\xf7\x90\x1f90W\xc1\xafc\x87=\x86\xfft\xcf\xe0\xbf`~9\xac\xeb\xe1G\xaf\x8b_G\x87=\xf8\x83\x93\x9aú\xa7=\xcc<\xf1\x8e\b\xe8\xf8q\x8f\x9f8\xec\xf4\xf4\xf4\x04\xfe\x1ev\x8f\x0fO\xf8\t\xbbl\xa4\twz^\xf3\xe2:\r\x89\xb4\x8bʊ\xc3a]\x8a\xb3_M>nN\ueda4\x1fzf:\xd9\xdc5\xec2\xf9\xb8\x05\xba%\xbd\x8a\x1dL\xc6\x1anJ<lJ<i,\xde3S\xd1$\xe86\xa4U\xe0@\x97\xd5\x1b\x86ە-\xa9\x15\x9a@\x96\x10t]\xa7}\xa3\xce\xfe\xf4N\x93'\xb5_\xfcl\xd9\xfap\xa8\x8c\x85\"\xa3\b\a+\xb3bU\xfa\xab\xb5\xa5ê\x8aj\xc12d_\x84\x9b\x11\tk\xe2\xa1\b6Ն,\xd8\x12\x19X>\x8f\x1eɯ\xadP\xbfd_L7\xa3\xd6W6m\x88\xfa_\xbcނs\xc6J\xb6\x19\x15\xb8AmDdn74#r\xbfx\xb5\rEU\x7f\xd9U\xbb\xf0E\x89\x9a\xa51\xc1\x8d1\xa5\xa3\xfb\x0eT\xa4\x94\xf9f\xd67\xe6yJM\xa8m\xddJ\xaf\xe9)9Y\xa96\xf1y\xc1\x93\xc2a\x93j\xdb\xeat\xbba:\t\"Y\xc4p\x9eB\xa8oy\x01s\x83m\xbbE4\xfa\x98w&v\x1d\xe6\\\xc0T\xbd\xcc돠\xaf\xa3\xa7\xb3\x15\xffD\x93\xdbYk7\xeei\"\x1485*\xcd\xde\xceM\xe3Zb\x95\x85\x17l\x02\xf7Kf\xc5\xc8a\x13\xf5\x00\xfa\xcan\xabp\x9dV\x92۩\xf7\xd7L\x02\xc3\x16\xda\xc9\xf4\xb7^\x87l;%\xa5\x1c\xee\xd7+\x13\x813\xd8\x1e\xa7\xae\xae\xeeV\xc9vZ\xcb\xf4\xac_\x8bo+\xe5U\xf5\x87_\x83q+\x1dV\xf5h_\x83o+U\xd6\xe8\xfd͔߮\xc8n\xa3\xa2J\xf9\xae+\xa8r\xb0\xfd\x91\xea\xe9\xc7wϷ\xd1P\xc8\xd4uJ\xaazJ\xf6\x9b\x14U\xd9t\xa7\x85\xb9\x92\x8a\x95ݱ\a\x9f\xfd\xf5\xef\xff\xc0?\x9eei\x96\xbb\xd7\xc5$\xfe\xc3\xea\xf0\xba\x9ewrr\xf4\x99G\xff\xaa\x7f\xbd\xd3n\xf7\xb3n\xef\xf0\xe4\xb8w\xd8\xeb\x1ev?\xf3\xbaݣ\xa3\x93Ϙ\xf7g0`\x96\x17A\xf6\x99\xf7\x9b\xeb\xaa6\xee?\xe4\xdfry\xb0\xfb\x801TTp\x0fb\x18\xe4\x1c\xa5\xe1\x01cQ2\x8ag!ﳌ\xc7i\x10R\xf2\xee\xc1j\xf5`\xb9d!\x1fG\tgV\x11\x151\xb7\xd8j\xf55J\x12\x1b\u03a28\x84\x13\xc5咹\xdf\xd3S\x81\xee\x9b`\xc2\xd9j\xb5\\2\x9e\x84l\xb5z\xf0$\x8cn\xd8(\x0e\xf2ܷ\xaey\x10\xf2\x8c\xa1$ZO\x1f0\xf6\xe4\xba\xfb\x14J?OgI\xc1V+\xc67\xa3~rp\xdd}\xfa\xe0\xc9A\x18\xdd<\x05\xf2p\xf9\xce\\A\xd4j\x05h\xebu\x8eҐ\xb3\t\xbc\x8a\x80\x15CՇP\xf5\xa7\xa8\xb8f.\x98W\xa0V\xd9j\xf5\xac\xc0J\xd9j\xd5g\xaa\x15˥\xa8\x80\xea?$\x14\xaa8x\xc4S͈y\x9a\xf1\xa7O\xa0§\x02ӓ\x03\xfczr\x009\xb2\xa8\xe0\x0fceSD\xd2r\xa9|\x8d\x99\xa5\xf5\x88\x85\xc8\x1e\xdcw\xfcϣ\x82\x87\xff\xda\xf1\x7f|tX\x1b\xff\xddӿ\xc6\xff\x7f\xd8\xf8GI\xba\xc3\x10\xa7\x024Xp \xe2x\x9aG\x85\x1884\xa4\u0558\x14C\xed\x96\x15\xe9{\xa0D\r\xbar\xa4и{;+\xa6\xb3b\xed\x98\xd7F;A\x97\xc3w\xf38\xfd\x93F\xe6\x9f\xf3o\x92&Q\x91f\x7f\xa8\x02\xd80\xfe\x8f<\x18\xffǽ\x93\xe3\xdeѡwx\x04\xe3\xff\xe4\xb0\xfb\xd7\xf8\xff\u05ce\xffց~^\x04\xc5,\xd7\x06z\x1c%\x1fAG\xf8V^,b\x9e_s^X\xf8\xa0\x87o\x15|^\x1c\x8c\xf2\xdcb\xd7\x19\x1f\xfb\xd6rɂ<\xe7\x05\xb32X\xf6\\\a\x9f`\xf3\xd7E\x88\xd5\xcaz\xfa\xe0\t⨖\x86q\x87\xb7\xbf\n\xb1\u0099\x04\xd9U\x94\xf4YϛΙG\x7fN\xbf\x10\x1eeq\x1a\x14}|cV\x06\x1c\xc8#z\xc3'\xe31:>Q\xfa\xa7(\x84ۥG\x9e(x\xcd!fH\x9f\x1dz\xdeTD\x84\x18\xa7I\xb1?\x0e&Q\xbc\xe83\xf6,\x8b\x82\xd8a\xdf\xf1\xf8\x86\x17\xd1(pX\x1e$\xf9~γh\xac\xc1\xe7ѯ\xbcϺ=B\xb2*i\xbf>\xac\x90\xbf/\xe8\xf7X\xf7x:\xdf\x06\x85\x1ḅ\x9c-+\xed\n\x86y\x1a\xcf\xe4y\xf0\xaf\xfb\"\x9cu\xd7\xf3\x84\x83J\x91N\xfb\xf2\xc27E<Q\x9f\x8a\vz]1\x0f2Q\v\xfe\xeeC\xa9\xeb*-\xa3 \xb9\t6Q\xa3W\r}\">V\x0f\x9e\x1c`_?m\x9a*>\x05Y\x02\x8f\xc0\xc8\xc9\xe2\xdb4\t\xd38`9\xcfnx\xc6r\x94A\xc3\xe8\xdbl\xde=\xb9\xee=}\xcd'i\xb6xrp\xdd{Z\x99\x1e\xb0I\x16\v\x83\"؟\xc6i\xe1[\x13>q\x9f\xc5q:Ҧ\x8b\xe7\xb3,\xe3I\x11/X\x009A\xc1C6\\\x14<\x973\x88\xa0f\x1b\xdc\xdf\xf1`J\x8f:\xe5Z\r\xaf\xa2\x1b\xceRJ\xbe\a\xd2\xf3\x85\x8e\xec+ \x8d\x8d\x14\xd1E\xf0\x91'l\x9c\xa5\x13\x96/\xf2\x82O\xeeQ\xc3kly\xee\xc0\xefo2\xce\xf5\xfaD\x1e\v\x92\x90a\xdez\xfc Y\xd6Sفw\xe9\xc7\xe7\xc1\xe8\x9ao\u05cd#\x00u\xbf\x8b\x8aܡ\x9f\xaf\xa3<7\xa8\x86<$\x99r\xee\xc8\x13B\x8a\x9c~\x998\xda\xd7\xdbYQ늌\x8fxt\xc3C\xac.\xe7Iq\xaf\xca\xdeNyF\x17\xac`\xaf\x8c\x16JN-+\x97Y\x1a\x15\xdfr\xd1\xd4s~g\xf1\x8a\x83\x05\xcf\xdcwi\x11\xc4\xee\x0f\xfc\x7ff</rGO<\x87\xc1\x19\xea\xa3\x05Hb\b\xc22Q\x02k\xcfx>M\x93\x9c\xe74\xa0C\x12ɑ\xe8ԻJL>\t\xe2\xf8雴\xe0}\xf6\xee:\xca\xd94\xb8\xe2,\xcaY\x9a\xc0@\xbd\t\xa28\x18\xc6\x1c/\xa1\xf2\xe1\xec\x8aMҐ\xbbO\x0e\xa8܃'\xf9(\x8b\xa6\x85>\xe7|\bn\x02J\xb5X\x9e\x8d\xf4yk2\x9b\xbb\x1f\xc4l\xf5䀀\xee\x8a#<toz\xbf\x19\x8b1\x83\xfeVd\xd2\x10\xac\xe3\xf9\xec\xaf\x7f\xffw\xff\x95\xdd\xfe\xd9g\xff*\xfb\xff\xe4\xb4[\xb5\xff\x0f\xbb\xde_\xf6\xff\x9f\xf1\x0fNR\x9e\xbf\xfd\xf1\xcd;\xe63\xb0{\a\xec\xe0\x80u\x8f=&\x1d\xdd\xd31N\x01\x0f\x00\xf0\xe5\x9bw_\xff\xf0\x8fg\xaf\x98/,\xcb\a\xda+\x85\xc1\xf4\xfa\x9cg\x11\xcf;<\xe6\x13\x9e\x14\x95\xc3\b\x91\n\x13ֳ\xa2Ȣ\xe1\xac\xe0\x9d\x1d5\xbd\xec\xd8n>\x8d\xa3\xa2\xb3\xe3\xec\u0603\a+\rw\x94DE\x14\xc4ѯ\xfc[\xa8%\xef\x18\xe1Y\t-\x9e\x8d\xa6\xa3\x99\xac\xe2k\x91\xfc\xd5\xe29\xcc\x1c\xb0K\xd8\xd9\xc1)m\xc7Ve\x91h=8+$N\x83\x98\x17\xea\xce\xc5\x0fR\xc7>O\xe34s\xbf\xa7̎\xfe~\x06\x14\x8a\xe4s\r\x11{\xa2HRO\x83G\x91\x19I\x94\xe8f\xbe\x82\xbc\x88\xf48w\x90\x8dV\xbe\xd6$z\xf5S\xb4\xaa\xb3\x13F7;ڽ\x1c\x80vs\x83\xb18a\xee8l\a\xf2tX\x1eCx\x19\x9e\x84\x14\x13\x12\xb2m\xb3na\xd5o];\xc1\xb7\xd5O\xb9k( \x80\n\r9\x17\x8f\x9a^T\x18\x933\xbf\"lv\xe5\x95v\x80\xfa ^3\xf9\x00\xa1\\ˎ\xf8P\t\xe9\n\xff\xa8&\xf1dP\x12@\xac\x9e\xfc\xe2ÇK\xfd\xc9Y3\xe2\xdfUU6P,;&Zѵ}\xc1\x1d\xc7\xc8\x14K.\x1e\xbb\xe4\xf7\xf6O\xf86A\xe4ZT\xc1|\x87\t&PƓ\x90g<볝 \xe3\xc1\x8e\x99\x9b\x17Y\xfa\x91\xf7\xf1,\xdeihs\xdfl\x051\xd4\xfd&\x9a\xf3\xf0Ō\x8c\xc8\x0eA:rT8\x15\xe6\xc1?8(|)\x1c\r\xfbJG85\xb8I0\x7f\x11\x14\x01Ʈ\xcc\xfb\xa4u\x9cFl_\x059\xef\x97W\x9el\xedz\xc3\x01j\x1e\xa3\xd4\xcan|&XP\xec\x8e`\xe0\xea\xcf#\\\xb9\xb2\xcb\xd5H\x17\xbfLI[\x881X\xefj\xf7\x19\x8c\xb7\x9f+=\x8eR\xd9gWf\x9b\xe0t\x97NO\xfb%\x96o\xa29=\x1bO7F\xc5\xf1\xf8\x7f\xbd\xfe\xea\x9d\xd3,D@Jc+\x91H\x97\xe4\xc0h$\xaa6\xd0+0^\xf4H59/^V\xddBYG\x1f\x159OBa\xdfwv\x0e\xde_\xd1\xd2\xfb\xbd0\x15\xde\a\xd3h\xc7\xc1[f\xda!~\xc6\xff\xa7:\xb0\xc4\xed5|\xbb(\xc8r\xfe\xf7\x9c\xe0\\i\xfc\xbf\xe3\xf3\xa2\x12c\xb7Y\xa1RcZթ16\x01\x94\xf9Z\xfb\a\xedp/\x88\xba\xe5\xaa\x19F順\xc9\rQ\xdb\xf5r-\x1a\x88k\x0f:7\xab!\xbdb\xf0ݦ\"\xa0\x88\x06\x8dp\xaa\x01\x17\x1f\xb1\x8by\xf1\"-\n\x1e\xfe\x17G/\xde\xc0a\x1f\x1b\xc8[\xd5RFi\x92\xa7\xe0\x14\x90^u\x14Ά\x92\x98'GN\x10\x86\x00\xb6E\x81\xbad\x9ad\xac\x1c\xb6\xf3!O\x93\x9d\xf2\xb6\x81\xd4 \x15+\xa0\xb1\x85\x9a!\xf0\x91ㅘ\x8fҌpw\xd6N\xd2\x00\xde*Q\xf4\xd0\b\xb4\xab\xd2K\a\a\x8c\xbbW.KR6*\u05f6yE)\xeb\xd1\x15\xcd\xe6\x8a\xf1\x10b\xbf\xf1\x05J\xd1eËM\bw{\vHV\x0f\x1e\xd4\r\xa0\xc1\xef\xb5*\xa3\xd5\xec\x1fkcn\xb2\xff{=\xafr\xfe\xe7\x1du{\x7f\xd9\xff\x7f\x96\xfd\xff\xed\xdb7/\u07bez\xf6\xfe\xc5\xd7\xffx\x7f\xfe\xf5\x0f\xff\xf8\xfa\x87\xf7\xe7\uf7bd\xfb\xf1\x9c\xf9L\xd3\xff!\xbfyOۯ\xefi\xfbug\xf0\x00\x97\x05?\xbd~\xf5]QL\xbf\tFE*\xad6\xf3\x92>L/\xda\x15IQ@\xce1\xf6\xcaY\v\xfel\x04[\xf6?\xd1Vi\xc7z\x9d\xcf'q\xcf\x05$\xef\xde}oݧ\xf4\xe1\xbdKG\xa3,\xcd\xd3q\xa1!xp\xa9/\x84\xc8T\x16\xb9\xa2\x98\xae\xa6\xe6\x93\xf8\xba(\xa6F@\xfaf\rUek\xab\xb62\xaf\xfaÿ\xb2\x92*\x12P8\x9dF\xcbv\x14\x14\xa3k\xd6\xe1U\x95W}IO/3\xccx\xf0\xb1A}\x89\xfaM\x05\xae\x9b\x15\xb3,\xc6\x1b\x97\x81\xc3FA\x1c\x0f\x83\x91\xa1\xcb3\xfe?\xccofe\x19\xf4\xfca\xc5\xe6\xd0\xdf/(\xa3*LХM\xe8\\\x8c\xb9\xf3\xf6\xfc\x1dF\xdd\xf9\xf6\xebw\x96\xbc\xd1\xf2?n:\xe5I\x87\x80\x1d\x86\xe4\x81٬UW\x9d\x0e\xa0P\xce\vѢ\xefp\xa7\xba\xb3\xf3<M\n\x9e\x14\xfb\xb0\xe9\xb6\xe3\xec@ ψ\xbcH\x0e\xe6\xfb\x9f>}\xda\aSo\x7f\x96\xc5<\x81]\xedp\xc7\xd6\xe8E:\x92\x8c\a\xe1\x02F\x18\x1f]\xa3\x17\x8bϚ\xed4\xa0\x8a\xec\xa9 \\\xc0\xa9\x1c\xbc\x13ώj\x1d(\x18\f\xb0\xb5\x9e_=h\xc1\xf4\xb0\x82\x89\x9a\x9b\x84\xc4\a\xc9e\xbd\x7fK#\x0f\xf6\x1a\xf5gy\xff~\xfe\xf6\rx\xc7\xc2_\x8ayP\xef7-\x93\xca7\x88\x15\a\x9bu\xa7\xb3\xc3\xf6\x18\x80\xb0=\xb6cW7\v\x82\xe9\x94\x0e(;U\xc1\xd2\xe5\xafU\xe5U\r[V\xb5lI<ጿ\xfafA\xc9FR\x8f\xd8\x19^{w@E\xf6\xda\xe7Fem\xf8:\xe6\xd66\xb4\xd6\xdf\xf9\xb4\xde\xe1\x15~M\xd38\xfe\x01\xdbc\xa8)\xf0\xb5*ě\xe7\\<)V\xb2V3\xfb\xf3i\xcdp\xaa&\n\xc3\xe9Y\x9e\xcf&\x9c\x15\xd7\x1c0\xb1\xeb \x97\xe8\xe90$JF\x94+N\n\xaa壜\r9\xb8\x7f\x8d\xd3\xecS\x90\x85<dE*\xd19\xf8\xe3:H\u0098g\x00\x81\xc1\xaa\xab(\xa6Aq\xcd\u0094\xe7,I\v\xc6\xe7Q^\xb8\x06L,\\\xbe\\\xea⎦\x03Z\xfb\bY\xc5|\xec'\x17?\x06\x95e85R@\x18,e\xb5Gb\x9a\x96gƨߢ\x17\x8c\x9e\xc0\xc73\x88\xc6G\x8f4\"a\x84\xe3\x0f|\b\xa6#\xa9\x940m\xdf\x0f}\xd9 \xbbi\t\xb3\x81\x81\x15\xe3\xbf4\xfa\x8f1v\xd6\xea\xafÆ?\xf9\xdf4H\xa2\xd1\x1f\xeb\xfe\xb7\xc9\xfe\xef\x1e\x1d\x1fU\xfd\xffz\x7f\xf9\xff\xfc\xab\xfd\x7f\xee\xea\xff\xf7=H\xd2\x1d\xdc\xff$<9\xed\x9dφ\x88\t\xdc}\xc5y\xaer\xbfS\x9f\x84\xfa\xee~\x81\xd1\xd8p\xe6\xdd\xca/Px\xfcj\xc5\xfe\x14\x17_\xc1\rx\u0379\x95R\xd37\xe6\xf0)B\xabc\xf3{\xf9/R\xb5\xc2:j\xad8JƩ\xaaU\x00\xff\x86z7\x9c\xdb_\xf1\x84gA\xc6\xc3ʹ=\xfb\xe75OX6K\x80\t\x907\xcd\xd2pF\xd6\f\x008\xd2_<\xc88\xcb\xf84\xcd\n\xcdT\b'Q\x12\xe5E\x16\x14i\xc6\xf8$\x88\xe2\xd2\x11\xe0?ّ\xf3\x9e\xff\xa6Y:\x8eb\x0e\x0ex\xff2\xfd\x7fzR\xbf\xff\xd1;\xfaK\xff\xff\x19\xff>\x17\xbb;\xfbB\x10\xf6\x87\xb3\xa2H\x13\x19\xbb!ʧ1<\xbb2\x8c\xd3\xd1G\xcd=\xf1\x938\x9d\x1a\xa6q(\x9d\n\xb3\x90g\xfbY\x10F\xb3\xbc\xcfzқ\x11V\"WY:K\xc2>ˮ\x86\x01D\x92\x10\xff\xe7\x1e\xdb\xf2\xc1\xed8\xcd\xfa\xecs\xcey\xd5as\f\xa7Q\x9a\x1f\xa1\xf2\x92̈\x80u^\x93\x8cM\x83\x10.\x8ch`\xb0n\xdc\x0f\xe2\xe8*\xe9\xb3\x11\aC\xbb\xc1eңmWɛ\xf7\x827\xefA\xffa|\xbc*{\x924\xe1k\x8aD\xe3,\x98\xf0\xf7\x9f28\xf6\x94n\x95\xdb0Ƥ\xaa\xbb\x899u'˚\xb3\xa7`\x9b\xe9\xfa\xd9\xf5\xa4\alzóq\x9c~\xea\xb3`V\xa4f\xda\xfe\xa2\xcf\xf2Q\x96\xc6\xf1\xfa\xf4\xfdO|\xf81*\xf6\x8b,H$\xa5\xe94\x18Ełyn/g<\xc8\xf9~$vj\xf6'\xf9\x96\x90\x9b\xa16t\x00[\xaeg\x95\xe0\xc6Y\xe9\xfd;\t\xe6\xfb\"\xf5\xb1d\x91\xe1y\\r\xa9\"\xffJ\xe0\x9a\x86\xd0z\x0e\x1do͡\xe3\xad8tlp\xa8E\xff\xff\v\xfd\xff\xbd\xd3Ӛ\xfe?\xf1\xfe\xf2\xff\xf9\xd3\xec\x7f\x16\xa6\xc9N\xc1f9/\xcd\x1f\xf4\n\xcc\x1d\x96\xa7\xec\x13g\xa3 \x81}\xe0\x10e\vC\xbc\xa6\x93\xa8\x00\x83j\xc2v\x0f\xeeq\a@\x1d*`\x84\xa2$\x88\xdf\v7D\xcd\x1eQ\x17\x02\xb6rc܈\xf1\xae\x0e\x92`\xb2.\x97\xeco\xa0@X\xdf/+\xd05\v\xfb\xdb? \x86\xd0\xf3bNF3l\x9d\xbd\x7f\xdf\f\xea\xb3\x1d\x85\xef\x96\xc1\xa1'\\L\xdc\x19<\xd0(\n\x04\x8bJ:\xfa%\xba\xf3\xeb\xf4\xd3\xf7\x84\xf1e2N;\xb6Ţз\x9agp멬\xcc\xfd:\x0e\xa69\a\x93\x9b\xedA\u0093\x83@yu\x96\xdc\xf9\xec_e\xff\xf5\x8e\x0fOj\xeb\xff\xe3\xbf\xd6\xff\x7f\xca?\xb5\x0f\xbcF̴\x9d\xfc\x12\xea\xbb(\xe4\x06\xd4\xe6\xa3\x18i\xffl\xe5X&\x80]T'\xae\x9c\xcd|\xe6\x99\xd9\x11\xec\xab\xeelau\xedhQ\x1d1c\r\x19\x04\xb0\xa3\x0e\x7f્\x10\x91K\xd3\x7f-\x19\xff\xfbUs\xdeF\xd2w\f\xf0\x84hV\x85\xcb\xf6\xa0E\xda\xde\x1a\xc8\xd6|!&\xeb*FX\r4 \xe1\x80s`\\\x81\x1f\bh\x1dF\x9d\xb0\xed\xc0њ\x9eS\x04\xd9\x15/\x98\xaf7A\xeb\x86\x04\xaei\xae\xe9\x05\xc8W\x9d\x00\x1f\xaex\xdfn\at\xf5\x8e\x9e!\x98\x83\xee\xa4F\x06\xbeU\xc9\xfc6\xa5\xac7UsIĲ\x15A4\xf2\xb1=tt\xa7\xc8\x1f\xa6\xe1\u0080\x02\xb4\xf6`\x03\x90@o\xcaZ\x9a\xc8\x03\xa6\xa6\x13\x00C \xc9O\xb0\xe4\xf1\x88\x0e \xff\x19%a\xfa\xc95\xeb%+\x9d\x1c\t\xe1\xf0l:\xdf\x194c-ż\xab\x8fbdT>\x1bN\xa2\xa2\xa3K\xd44\xc8xR\xc0S\xc4n\xc6'\xe9\r\xaf1\xa0m8w\xcdӨ5\xfa\xc5\xf0\xf9m\xf6\xf6\xfdj\xf12\xecl\xa5\f\xb4s]\x1e\xeb\xbc\xe5q[c\fG\xd3J\x94\xa3\xca9\xa58\x16\xfcO\xd81ҙ\xf4G-\x026\xcc\xff\xc7\xc7^\xd5\xfe\xef\x9dx\x7f\xdd\xff\xffS\xfe=y\xf8\xe2\xed\xf3w?\x7f\xff5\x83\xce\x7f\xfa\xe0\t\xfcaq\x90\\\xf9\x16O\xc4f/\x0fB\xb11>\xe1E\xc0F\xd7A\x96\xf3·f\xc5x\xff\xccҳ\xc0\xebc\x9f\xff\xcf,\xba\xf1\xad\x9f\xf6\x7f|\xb6\xff<\x9dL\x83\"\x1a\xc2\x19\x81PM\xbe\xf5\xf2k\x9f\x87W\xdc(\t\x1aܷn\"\xfe\t6l5`\\\x7f\xfb!\xbf\x89F\x9c\x16㎼\x11\xb0\x8f\xe1\x89\xfc\xae\xeb5\xa0\n9\x19\xd0Q\x9ah\xd8\x1a\x00\x83Yq\x9df\r0\xcdk\x1a2\xd0\x0f\x0e\x12^\x84I\xe0\x0eӴȋ,\x98\x8e\xc2\xc4\x1d\xa5\x93\x03\x95pp\xe8v\xdd.,|\xca4u\xd9YT\x81g\x1eOI\xcf\xc1n\xf6˄\\\x81\xa34yr@\x99\x04\xb8q\xa9b81\x15\xe9\xd5U\xcc\xdf\xc1\xa5\xb7\x8a~\x03\xf5Y@:\xf3+\xbaN\xfb\x99\xf0yq\x1e\r\x81\"\xfd\xb7\xe9\xe8\x80Xܑ\xbc]\xe1\xe2N\xd5\xdbqgg\x94ƴ\xe0\xd8\xc1\x97\xae<\xf3\x98\xb8R\x8c\xf9\xd5\x147\xe3\xd38\x18\xf1\xce\x0e+19lg\xc78F\xe6\xb1\x1b%\tϾ{\xf7\x1a\xae\xa3\xec<\xfa\xfc\xf1ɱ7\xd8Y{V_\xad{\xcfgz%\x9b\xf0\x9f\x98\xf8\x8d\xc7L\xca5\x1c~\xb5^b\x87\x7f\xae\\\xb1\xe1R\xb0$\xf0S\x9a\x85\xfb0C\xf5ɱj\x1f\x12\xca\n\xb5ͨrî\xa4\xa3\xc8Z\xf1\xb6\x17\x9af\xbc\xb5\xd4uT\xf0\xfd|\x1a\x8cx\x1f\xe0\x90\xb2jyU\x98߀\xd7S\x1c兆B\xdf\xe2\xa4-\xd2Ji*E\a\x7fzG\xb5\xec1ӿ\x1b\x9e\x15\xd1(\x88\xe56n\x91N\xf5l\xb1\xe7\xbb/v:\xbbj3\xcf\xcc\xc6]\xc0\xc7\xee\xf1t^\xa7J\b\x04˧AbХ6\xf3\xa2$\x8e\x12\xbe\xaf\xed\xe9m\x8b}\xfd\x86{mW\x99=\x8c&\xa0\x10\x83\xa4\xa8ѩ$W+,\xaf\x8dx\x83:\xbe\n\xb5\xe5^\xefu\x14\x86<\xa9\t\x14\xaa\x89\x12\xb5\xbe\xbb\x18\xc41\xee,V\xcb\x04\xee(N\x8dQ\xa7\xed\xcb\x1f\xf6\xf4\xae\xa0}ԦnR#\x8a\xa2\x03\xc0O9\x05=\x01;V\x8c\xb1@\x9eNNgqLx\x18\xd6n\xd57QH\xb9\xb9k\xccK\xeb\xe9\xa3\"\x9a\xf0|\x80\xbb#\x8c\x99\a\xa007\x04Q\xc235\x88\xf5\\8\xb6ܧ3R\x95/\x0e\xb9\x9b4\xbb<\xe0.\xd2\"\x88)\xea\x8dڡ\x91g\x91\xf2\x90\x9b1upJ\x1fel\xabw\xd1$J\xae\xf2\xf2\xfc\x99n\xc6\x03>\x11\x14K;I\x9ffQR\x8c\x99\xf5E\xc8p\xd0\xe5\x16\xeb\xc4<a\xee\xd7\xf8e\x03\xf4>҂W\xb9\xb1\xb0܌\xfa\xdcbi2\x8a\xa3\xd1G\xdf\xd2g\x16\xf0\xb0\xb2\a\xa6\xd1k=\x15\x9a\x18ب5\xa6\xa7\xf1\x85\x04K\xf0\x8e>\xf0\xbf\xfby\x91ESu\x81\xbc\xda^\xa2To.a˞\x1a\t\x90\x14J\xecr([O\x9f\xc0`~Zc6$>9(\xc258t\xfdhU\xe1\xaaͩ\xebÆ\"z\xab\xe0\xf4\xbb֨5\x8dkjd\xa9Eq\xd7\xcf}'<)\x9a\x1a\xa6\x95\x7f\ng\xf6ͭ\\.\x19\xbcr\xcd:x\x99\x82\xb9\xe0f\xc8,ǲ\x19<r\x8e\xa8\U0007cff5\x82'\aͤ\xeb\xfe\x0f\xb5\x12\xc0\xc8ZGԪ\xa8\xa2\xae\xa3\xac\xa0\xaa\x02\xc0(I\x8bk\x9e庫\xc9\x0f|\x12D\xe8R\xb0Z\xd5\x05\xb7\x8cFp@*\xe8\xc9\x01\x19̟\xfdg\xffӼ\x1c\xfe\xb0:6\x9d\xfft{\xb5\xfdߣ\xbf\xce\x7f\xfe\x9c\x7f[\x9d\x81\x94N12\xf8\x85K\xa3Iw\x1e\xd6\xcf1>\xfb\xeb\xdf\x7fȿj\x14\xae?\x7f\xfc\xc3fO\xaf\xba\xffsx|\xfc\xd7\xf8\xff3\xfe\xb9R\x00\xde\xd3\xcdY7\xe4E\x10\xc5\xcbi\x8a\xe7\xa8d\xc7\xe4}\\\xbd)\xc7\x13\x15\xdf\v\x969\xde@z\xa9\xf4\x06ڂO\xb8\xb4\xc0\xffܮ=\x10n(ހ\x16\xa3\xdd\xe9|\xa0\xad'\xe4~\xb0\xdb;\xce\x19\x85\xa7\x1e\xecO\xd2_\xf77\xc1\xa4\x1b!\xea\xae\x16\r`\xab\x166\xb8Q\x12\xe0\x05\xb0\xa5(\xd4\xf7\xda@\x99\x1b\x15|\xe2V\xc0\xbb\xed\xe0\xf3\xf7q0\xe4\xf1R\x8f0G\xf1崘r\xa6W\xc9\xe1t>\x90.M'\xd3\xf9@\xd6\xe2\x1e\v@\xe0+\xcb\xd38\n\xd9\xe7܃\xff\r\xca\xf5\x17\xbaE\xd5\xfbP\xeb\xb3\xcf\xc7\xe3\xf1@_\xf7'),\xfa\xd77yYG\xa9\tD+\xfdn\xef\x98O\xaaԭ\xe5\x85\xea\x8261;\xb2\a\xe4I\x86\xed\xa8q\xa4\x02*֟\xe8\x01\xd4\xe5\x13\xf9\r\"\xbd\x0f\xdfw\xe5C\xad\xeb\xdb\xc8<\xb3\xd7#\xea\x0f\xf98\xcd\xf8R\xecG\xf6\xad_zǣ\x9e\xd5\xd0uH\xfb\xbe\v\x8c\xa4\x86\xeb՜\xdab\xac\xad\x11\xd90-\x96\x04t4\x9d\x0f\xc4\xde\x01\xfcԙ\xb3\xaf% w\xa0\x1b͎=n\x96\xact\xbe\x9f_\aa\xfa\xa9\xefA\x8c\xc6\xe9\xdc\xe8\x83\x13\xbb&{\x02+\x91\xd4+\xab\xc1\x9d\x80>v\xe3@ng\xa0J*\x11\xec\x8f\xe2hڗ\xfb/\xc3t\xbe\xb6ղ\xb3$2\xdc\x1a\xa9\x96(%[\x86\x8d\xac\xe1\xcco\xaeL\x1c\x03\xb5\xabB\x9b*u*\xe6\xef!$ĲM\x99V\x14\xa5'Y@r:\x9d\xb3\x10/\xc1\x1b\x9c\xecك\x06}\xddV5sq\xa9\xd8@\xc1}F\xa3{\xdc0R\f\xf9!q\xc1Vu\xa7z\xb7\x04I\x92\x16\xb8#\x82\x8f\r\x80*^\n\x19\xec\x96}\x0fl\xa9\xab5M\x1eq\xebh]W5\xd4\xc3\xdc2\xb1\x81\x11\x82\nб\xd4\r'\xd51\x01\xfcY3\x18t\xb9\xac\x8d\xcc\xdeq\x83\x06ЈDFl\x90\x8f\xfd\x926SDz\x8d\xfa\xee\xd06\xc6\xcd\xc6\xea\xb7\x1a\x1fF)\xdc\xceX\xb6\x1b\x00ưݢq\x9b+\xbb'\x8d\xa2\x18F\xd5\x19e\x9c'\xcb\x16\xcel\x10\x1b&\x8fڗU-&&!p#V\xa3䱚\xf8P<\xd6k\xc63\xbbi\xe2\xacJx9\xcd\xd6g\xf9\xd2H`g\xf4\xff\xc8\xe1.\xfc \x01\xeev\x95\x00uO`\x04\x19\x1d4\x9aey\x9a\xf5\x85V\xb9\aS\x9a&\xb1a\xe3$F\x13.P\xa3M\xde覭8\xb4\x0f\xe3\xbf[c\xd2\xf6d\x89>w\xb6.п\x06=\xbe\\;\x8c\xcflɦ{\n\x8e\xa8Dv\xe3\xb1w\xe7\x06\x952\xd86\b\xb6jg\v\x9aA#ebX-\xdeC\xe4!\xa7a\x8e\x81\xf4\xf7\xe1\xe1r\x1c\xc5q\x8b\xb6Y\xe0D\x943|\xfb\xc6i\x99\xa7\x00\tA,E$+C\xa5\x9c\xd8\x03J\xd6̅\xfc:\x98\xf2}\x8a9\x03\xf2?ʢ|\xba\x0fg\xcd\xf9v3\xe4\xe2\xfdU\x16\x85\xedtAnI\x96\xe4\xcf~w\xd0@a\xafB \x88\xb8H\b\x83\xfc:Ȳ`\xd1\xef\xb2n+\x15p[y\x1d\x11\x90_rY\x92\xb0\x9e\xe3m8\x15Û\x91^e\xc1\xa2\x1d)\x8cֵH\x01`\xa9Y\f\xb5E\xc9\xd6Ƌ{\x15\xa7\x9f\x84\x05\xe3\xb4\x11D@X)\xb6\x04U\xb1\xa6\x95u\xed\xa2t\x8bGڥw|\xec\xc8\xffw\xbb\xb6\x03Y\xfb\x1b\xf2\xd7d{\xeb37a\xf6\xda2\xf77\xe7\xae\xc1\xdd\xca\xdd(\xb9\xe1Y\xce72X\xc2i<\xd6f\xbe\xaa\x06\xaf\xf2X)\xd0\x1aw͜\xc6\f\xaf-\xb9\x1d\x8fWO\xde_\x97ވI\xe3Y̯x\x12\xd6W\xeeU\xb9\xd6W\xa3\x9a\x8dp\x04O\x1exj\xc25Β\xe5\xcc\r\xe5\xd9qͶ\xecm0u\x89\xb2\xca\xc4\xd2\xf5j\x10\xcc\xcd?\x05\xc5\xe8Z\xac\xfeЄ\x96\xa6\xb7\xa7jmY<\xf7\xec\x06|h\xb4R\xa8x\x8c\x14\x8fm\x92(!¾y.\x0f\xe1\xee[\xb0(\xda\x1a\x19d\x1c\x1b\x1f61\xa8\t-\xee\xb4PѾ״`1\xeb\xd2:2J\xaey\x16\x15u\x8b\x1ew\x94\xe8\\Yt\xb4\x04ս\x17\x8e<\xcf`E\x02\x87\xc0\xb1\xeafS\x17U\x14\x9f\xa4\x9e\x9cpE\xa7*-z\xd2\n\xb94x\xe4\xf6\xcc}\x16O\xdf8\xeaU,=\x1d𨵇\xdc0\xca\xe1\x80-,\xa99\xaa\x83\xce\xe2%\x9c\x7f\xd2\xe2\x1d\x03\xe2Д\xa2\xba\xa1d\x82H\xc21\xd3fy\n\xacq\xb4T\xe5\x980\x9e\a\x93(\x11\x93\xec\x194o\xed֍\xc2S\xb3\xf1\xfauU\xe95\x99\xe2\x8d\xc8\xc4bd-\xb6^\x13\xb2\x7f\xa3\xfd\xff?\xe8\x12\xc8\xda\xfd\xff\xee\xc9i\xef\xb8\x1a\xff\xad\xd7\xeb\x1e\xfe\xb5\xff\xffg\xfc\xbb\t2\x15\x96\xd4\xc7\xf0\xb74l\x94\xe3\xb9Jr\xd2\xe1\a{I\x11\x92\xb3\"\xf7U\x86\b\xb8h\xb9\x96=\x10\xd9<)|\x89u0N3\nn\xe6w\x1d\na\xe6#\x06\x19\xcf,z\"\x7f\xec\xedQ\x05⽊\uf0ec Ћ\xe8r@h/\xb4\xbcK\xbf!\xed\xf6v\xb9\x12\xb0M\xd9+\xe1\xadBY+\a\x820\x96\x8dUM\x84d\xff\xe2R\xd1\xfe\x91/X\x94\xc0\xb3\x1c6dQ\xb4\xe0\x8f|a\x0f\x04>H]9\x14F\xa3\xc4\x17\xf2\xbc\x88\x12\\i9y:\xcbF\xdc^J\x94\xd3,\x9d\xf2\xac@\xbc2O\x83\xbf\x90\xf9\x97>\xe5\x96\t\xb2\r\x1a\xf4\xca\x19\xc5i\xc2+-\xa9G\xd6\u009fy\x01\x8b\xa3h\xbc@({\xb5\x1aD\xe3\x0e\xe8\xe7t\xcc&i8\x8b\xf9C߷f\t\x85\xf8\b\xadG\x8f(\xd5\xe5\xf3i\x9a\x159\xb1(<\xf4!PS\x94\xf1\x8e\x15\x1eZ\xf6\xc0\x04R\xfd\xbf*\xe3\x14]\xc5\xe90\x881Dڼ ,\xef\x8b\xf4\x1c\xc9\xf1)\xc0\x9b;\xcd\xd2\"\x05b\\\x99\xe3\xbc\xf9\xf1ի\xf7\xe0\xa3\xec[ofql9?\xbey\xf1\xf57/\xdf|\xfdB\xa4\xfe\xa8Hu\xbez\xfb\xf6\xd5\xd7\xcfވ\x8c\xaf\xd24\xe6Ab9o~|\xfd\xd5\xd7?($\x10t\xd7r\xce\xdf\xfd\xf0\xf2ͷ\"\x91겜\xb7_\xfd\xfd\xeb\xe7\xefD\"\xd1d9\xdf\xfc\xf8\xe6\xf9\xbb\x97o\u07fc\x7f\xfe\xea\xd9\xf9\xb9o]\xd0\xfb,\xec\x1bѲKkPF+Ͽ\xd1:\x81\x8f\n\xd5\x0f\xaa\xad.\x84蒹\xbe\xef\x9b\xe8W\n\x15\x89\xd3]\xa5(\x1aw\xe8\x97{\x1d\xe4o?%\xdf\v\x90\x8e\x84\xb5\xed\xed\xe4l\xd0 g\x8a6\x10x\xd5\xc0h\xdcy\xb7\x98r\xf9\xfd\xd0\xf756\xda\xcb\xe2:K?a8E\x80\xc2x-+\x11\xd9l\x16\x17\xc6@\xd3ۣa\xa7\x9f\xed\rZ\nT40U\xfaJ\x0e\x15\x91]\x92O\xe4\xda\xcb\xfcST\x8c\xae\xe1\xd7(\xc89\x06\x7f\xeb\x8b2J\xec\x06\x98u\x93F!\xf3d\xa6)\x82\xd8\x1at\xa1\x10\xc3(\x1d\b\xc4\xf0M\xb8\xad\xa1\x10F\x89B\x17U\xac\xc2JH0K\x02\x94\xd0R>\r\\\x95\xafɯl\xa7\xc6\xf6\x95&\x91j\x80TER\x92\x8b\xa9\xbe1\xea\xb1My\x1c\x8d\xb8\xff\f\xb6*\xb4\x91\x89\xa9\xa5\xc4\a\xd9\x15\xde\xc4\x01\xafü3Nhd\xe3\xfc\xe0\x8f\x135\x8e;\xf0\xc21\xf0\xe4\xe0\xbf/~\xc9\x7f\xe9\\\xeeJ\f\x17\xffݹ\xdc\xfd\xa5ӹ\xf8o\xfbr\xd7\xfe\xc5>\xb0/\xba\x97\xca\x0f\xfc\xe0\x97\x83_\x0e\xdc\xdd//~\xc9~I.o\x7f9\xf8e\xb7\xf3e߽\xa5o{\xf7\xcb_v\x7f98\xb8r,\xcb\xd6\xca\xe4{\"I\xccN\x8e\xa5t5R&f\x1f\xdf\xef>z\xf4\x10S.\xbc\xcb//.\xfb\xf8\xbbd\x1eX\x8f\x9dq\xe2\xc8{Z\xa4\xb5\xdeӥ7\x7f\x9cH\xa4\xe5M-\x84\b\xfc\xd94\f\n\u07b9\x18FIؑ\x05\x1c\xf4\x99\xbct$\xcbrE\x94v\xcd,^\xa0k\xa5\x13ث\x92\x0e\x81\x0ew\x8d\xa0\xb8\xd0\xc2\xf8\xfd\x8a\x9a\x82\xbfE\xbb\xe4L\v\x90\"\t\xcc\xe2\x98w\xe8c\x7f\xdfF\xf0\v\r\xc1\x1ee]b\xa1\v\xf1!\xe9C\xb8\x92\x9c\tϮLj\U000373e2A\xfa\x8d2=\xd5\xc2z\vJtȤq\xe2\x8c\xe4\xdc\x10\x8d;\x8aG\x82\xfe'\xbdG\x8ftQV\xf9\x17ޥmK\x81\xbe\x8e\xf2A\xa5\x8b\xb02\x932Q\xd2\xe9٭\xfd'[x\x957\xf4\x96\xc4.\xbaK\x90\x8d=\x06\xa5\xf9dZ,\xe44\xe0k\xa8WH\x1b>I\xe1Wj|y\xfe\xfe\xc5\xdb7\xef\xbe~\xf3\xe3\xeb\xf7_\xfd\xf8\xed\xb7?\xeb\x00J=\xb2(Y\xca\x01\xd5ﮐQS\x18\xb82Ѳu\x7fܕvQmձ\xcb1\x9bφ\xe8\xf8\t4Ub\xd7v\x94\x95\a6\x14\x86\xc3\x14\n5⠫i\xf8\x8b\x96k\xac\x89\xc6\x1dm\xee+\x8b`\xf7H\x8bL\xa5\xba\xf9u4.t\x92>\nz\xa0\v\xdd2\xe4\xb51\"Tm+17b!\a\x19\xea\x8a\xd7\xcb\xed\x01&\xba\xf9l\xca3l\xa40\x04U:5\x1d\x1b\x03TS\xae\xbd\x94\x19\xa5\xa6\x13\x05\xcb\x04\x81\xa2\x04\x80IM\x96\x1b\x14\xd9b)J\x94\x95\b[\x11>\xec\x15\x06\xd9\xedp{\xb9Z\x95f\xb1\xa7\xcc\xe2\x92;M\xb61\xd5\x1d\x84\xa1h\xa8\xcec\bF\x1f\x8d;\x0f+\xf4i|\xb4۳|C^\xabm\x84Á\xbc\xc8f\xa3\"\xcd|\xccSF/|\x94\xe2\xa3Q&\xcd\x14\x1cJɈ\xe7P\x16;\xb6\xec\x95G\x8f*\te\x95\xba\xc0\xa1\xa1!\xf0A\x13k\xe3\x04\xc7\x00\x01\xa8\xb9\xe6a\xbb9ik\\ƮцΠĄ\x17\x87ߎ\x1b\x10\x89\x9c:\x1e\x91a\xd9\xf7\xe8ۥn\xfc\xf8F\xc7:\x88\xb7n\x9d\x81\x8a\x14\xac\x05ŨF\x1e\x82ۏ\x1e\x99\xd32\xa5^x\x97\xbeo\xfd\rynQ\xa5BMb\xfe\x80\xaa\xa29O\xe2\x9b(kASH\"E\x12p1\xb9l\x19\xa7\xab\xd2\x1as\xa8*\x9bj\x91|\xf4Q\xf7S\x96L\xab@\xaa5\x82\x0e*\x13%\xec\n\xa5Iu\x93f\xd7\"\x92\x956A\x88\xdfK\xd2w}\xfa\xe3\b\xd9\xed/K9\xee\x97?W+П\xd1\xd8\\ĔK\xa2\xc6d\x974=\xfew\xc5\xe3\x9cW\xc0\xf4\xec\x95ݑK&{ \x7f\xb9j\x95ݱ\xb4\xa7\x88\xe0N%\x15~\x057\f\xecAk\x9e>\x89\x94\xab<yw\xba\xba\xce{رF\nk\x94\xb4]ͷ\x02˶\xede)\"pm\xd3^Z\xb3\x9c3\xb0QG\x85\x85\xf3\x9c\xc2\x05\x16\xbb\xaf\xa1v\xb0\x9b(U\xf5\x98\xe5\xf0\x98O\x9e\x17\xd9\xf7\x90\xe2#R\x17\xee߉joo1E|\xd9\x17\n\xc7%lM</21T\x9d\xbc\xc8\xdee\xd1\xc4'\xf9\xd0\xe0\xdc\"\x8b&\xb7\xb7u1F\xd1Q\xb6\xe3\x7f\xff\x92\xef\xdd\xfe\x92\xef\xfd\x8d,ȕ\x13d\xd9K\xba\xddH\xa6\xb0\x8eR\\{\u0530F\x05\x9f\xd8Km\xf8\x93\xe2\x13\x83\x1dT\x03\r}\x1a\xf70k\xc2b\a`H#^D\x97\xbe\xef\x13\x16A_$\x172\xfbݕ\xf3\xe2\xed\xeb\xaf\xe7e\xbf\xa2\xbe\x9c\xf0<\x0f\xae\xb8\x98<\xf1^+\xa4\x0f\xf0s\x94\x86\xdc\xc7R#\x8e\xf7a/ \xef\x922EI_\xfc]9\xa3k>\xfa\xf8.\xfdȓgI\xf8-D#\x0e\xb9V\x9d\xeaD\xa7\x00\x18\x92*\xf8\x05F\x88\xa5\xaf\xf5\xb0Ǝu\xfe\xf3\x9bw\xcf~z\xff\xf5\x0f?X\x8e\xf5,aQr\x13\xc0\x89B\x9a\xb1(\x8e\xf9U\x103ZװOA\xce\xf2)\x1fE㈇\x96\xbd\x8a\xc6`\xc7\x1f\xb8\x05\xcf\v\xaa\xc3n\xc0\xff\xf2\xcd?\x9e\xbdz\xf9\xe2\xfd\xf3\xef\x9e\xfd\xf0\xec\xf9\xbb\xaf\x7f\x10UQ\xef3qs+gAY\xf5\xe8:ȂQ\x01*pU\x9a\xb7\xa2\x8b\xc9D\xac6s\xe54\f)\x1e˞\x06\xc1\x9a\xf0\xf0\xb9\xb0+\x84\x04\x12*\x80*/\x9dڎ4>\xcc2_\x9a\x9fbق\xeb\x18\xbb\x7fq\xe9HY\x12\xa5\xdbĉ\x94 LG\x02\x10,\x03R\x8d\xef\xc9\x00W\xef\xa1\xe9\xaa\xc1\xa4\x91\x04\xb6\\\xb7\xadV\x8e>\x94\x8b\xd4W\xccІ\x82\x7fqY\x82}ˋ\x82g~}\xa8Aϩ\xd2t\x85l5\xc0\xae\xd4Q\xe1\x1e\x81\x9600\xebG?\xc9\x12yd\f\xe4\x8b\xe8\xf2\xf6\x16\xcc\xd6U\xb5\x94\x94\x85\xb2\xa4\x90a\xfc\xb3\xe7[\x96\xb4l\x1a\a\x01\xcdmT\xe4\xa1\xef\xefwk\x15\x04a\xb8\x06w4\xeel\xc4\xeb\x03^\xbd\x1b)y\xd0\u0603\xd03U\x12(d\xc5\x1a*(\xf4\n\x8c\xe9\x8dĠI\x0f\x89\x0f5\xaa@0G\x9cҝ\xee\x1d(\xa3\xab\x83\xbf#\x7f\x820\x14Hhn\x15Z\x1c\xda/\xd3\x1b\x88\x10\xa6D\xcb$\x00\xb7\xde:\x16\xb3l\xdc\x14\xa5yť\tRn;\x89=j}n{\xc1\U000d1ffc\xe2E\xbf2\x00\x1c\x9e\xcc&<\x83ò>>\xc36J\x93qt5+SV\xb8jh\xac\xa8\xa3χ\xc6\b\x9c:\xb5\xea\xd5\xdab\x8e*\x99\xcf]\xdaM\x02\x86\xf5\xbaG'g\xbd\xc3\xdeq\xcf^\xd6\n\xba%\x89>\xdd\xe1\xfc=\x88Ya\x970\xc5C}\xca|\xff\x9eP\x13\x8b\u07bf\xb7\x97:\xeeZv\xa7\xa52ʷ\xc1p\xfa\x84\xd1n̝\xec\x1f^>\xff\xaf\xf3\xef\x9e\xfd\xf3\xfd\x9b\xb7\uf7ff}\xfd\xfd\xb3w5k\xa7\x0er{\xdbZ\xda\xdc\x1d\xb3\x97\x95\xd7!M\xb3k\xb5ր\xc3W\x9ct\xab\r\x13J\x91\xa4\r\x15X\xce\xc1/W\xbe\xa7\x89S_\x05\vK8\x0fs\x16\xb0\x8c\x8fy\xc6\xf1\t\x85\x14\xe6:QȢ\xf1)\xbe|\x1d\x1f\xe5Ћ^\x94A\xbf)=\xe4\xe3\x007g\x97x\x10;Mcr\x18\xb3FA\x16FI\x10[\x0e\xbdKط~\xe5Yj9\x93(\xe9+\xfe8\x93`\xae}M3\x8e/\a\xf5iS\xa2l8.\xee\x8c\xfalw\x9cf_\a\xa3\xebҺ\xfcH\xe3\xfd\xe2\xa3ؙ\xfaxy{k\x94\xb9\xf8x\xb9\xa2\x9d5\xa2\x9d\xc4\xc1_\xae\xe8S\xe8&\xf1,\x06\xae\xfa\xe9y\xb7x\x8c3ݠ\xb2\xe3P\xed\b\xccF\xcb!(\xb8x\x06N㗭3R8\xe65\xa8\x17\xa8N\x02\x8d\xa3\xb8\xe0Y\xd9\xc2\\B=\xcc\xd5\xd9\xfa\xca^I\xc4\xc5y\xf4+\xef\b_\r\xac\x19\x7f:§\x00S\xe8\xf7\xca6\xfa\xdbU\x83\x05\x95\xa5e\xba\xf3X\x02\xf8&\xca\xfd\xf0\xd0\xcdy\xccGEG/o\x8bPU\x1d+\xbf\xb9\xea\xe77W\x96\xed\x06E\x91u(*\x8b\xe5\bv\x87ŵ\xcc :D\x0e}\xd8jw\x1f\x06\x03\x18\xbb\xa6\f\xbb?\x88g,I\xe6\x01\xe8\xf6\xf6a\vL\xf5\f\x00\xa0m[=R\x04\xb5d~K\xd9\v\x00ƅ\xf3\xc3\xec\xf6\xf6aV\xae\x16\xcd/\xf1j]\x89\x15\xfb.\xe3WQ^\xf0Lb\xeb\x80\x06\xc8:Kz\xf7\x11@V\xb60\xb5\xf0] \x01\x85\xbd\x93\x89\xaf\xdb[+/\x82\xd1G\x8b6@\xc5P\x8b\xf2\x11\xb8\"\xfc\x10$W0s\x0e\x1a\x04\xae\x14(!t\xc8*ژ\x8fr\xfc+s`\x19G?Y\x94\xe4\x05,\xd1A\xa1\x99\xcf|\xda4\x8b\x11\xdcyt\x95\x04\xc5,\xe3\xed\xbb&bu_J<\xa8\"Y\v=\xa7\x12\x88M\xe2>\xb3\xf6*xqS\x14]:r|\x90~ \xc7Au\xa8\x8bvu\f҉(i\xfb\xcbZ\x85\x88j\xb5\xd3)\x06V\x0fk\a\x18KA\x11T\x8a]\a\x00\x8f\xafA\x01d\xe5@4\xb7W\r|%<\x15D\x90\xd6\xd4\xf4*F*\x8c\x1c\x98\xfb\xf4u\xe1]\xbas\x14Յ\x96\xb2\xd0&\xaf\xf9C_\x9e\x06\xa9)iQ\xa6=z\xb4x\xe8\xe3֬$j\x8eo\xe8,X\xb9s\xc4ұ`9˯\xd3Y\x1c\xb2!gT\x9e\xb8˃\x10`\xac=Y瞅HT\xc2\x02xA\xf4\x89\xe5\xc6S\xff\xd0^\xaaċޥ;\x7f\"~w/\xdd\xf9\xed\xad\xf6\xf1Dk\xab\xca0\xb0\xedW\xc1\x9a8L\xf3[\x91\x02\xf59\x85\xf2O\x136g\xb8\xa9\x93\xe3K?\x02\x9c\xde\xee\xb5\xf6h\xfd\xbbZ\x89)A\f'@\xf6\"\x85+\xfeՍw\xc8\xf1u\x15>\t\xa6\r\xaa\x99\x11\x8e\x1599L\xa2\x04t\xe6$J\xf0A,\xb3L\xa8ʄخ\x95-\n\x05s,\x14\xcc7\x14\nu\x0eAiʹ\x98D\t̬\x97\xab\x06\xc5Qk\x165V\x18\xc5ByRbG(\x1e\xa4\x06ch\xb9t\x0f\xb2cK\b\xfa\xe3\xcem\x17/Mt.<M\xd7_\x8a\xf2\x8b\x8d\xe5\x17\xaa\xbc6\x1f8\x9eB\xe0N\x82\xab$*f!oGu!q]x\x97\xfb\xdaoG\xfd\xee\x1a\xe9\x97U\x9a\xa9\xd6K)\tČ*\xbbP-s|\xd6T\b\x03$\xc0g\xa7YM\x0fLΪ\x87N\x9bL\x8e\xba\xa2S\x8f\x93-寎\xadf|*\xadw\xdd\xc0$i\xa3\b\x93\x15ұ[EL\xc8r{~\xd5D\t\xcdu\xd2{<\x04ꄶ\x1ce\xf4\x87\x1c\x80\x84\xb5\xe7\x8b\x1dY\xfa\xc2iW~\xd8K\x9d\xda\xfa4P\xceq\x02\x04E\xc3^\x96\xa8a鴲W\xd8r\x99\xfa\xa5\x9a\xe4\xd0\x1b\x86\x9e\xaa\xeb\xc3\x7f\a\x8d\xcci\xa9\xd7\xc1\xb5mC\xed匉\x9d\x80z\vaѦ(s\xece\xf9\xbb^Ih/Cw\xe1\xeb\xa8;\xa1\xbb\x80\xee_\xad\xecJO\xbb\xd7i\xfaQh\xcd\x1a&\x9e\x14\xd9\xc2^\"\x0f\xf0\xb7;V\xf3t<v. \xe3\xd2\x16\x1aK\x13p\xec\nS|g\t\xe6\x8b~y\xaf\x8c\x0fH\xc4`?\x84$\x0e\x16鬀\xb1J\xbf\x88Ў=\x10\x9f\xb4$\xc0\xea\xc5o{\xa0U\xec\x13\x18u\xccJ\xcf\xd0~\xdf\xdej=V\xe1C0.x\xd6\xca\b\x1d\xe1F~D\xbe7\xb8\xa3\x10J\xd3\\\x1c\xb1J+\x06\xab\xd5[p\x11\xed\xed]\x1a]Yo\xa5\xdciҒV\x83\x16\xe6\xd7t\x15\x99\x84\x1a\xf5\x83;\x18X\x1a\x98\xaf\xfd\x96s\xb4:\x9e\x1aw\xb4\xdcG\x8f\x8c܇zI5mSS\xe4|<\xc2kF\xec:\xb8\xe1,\x8c\xc6c\xbc\x92\xa3,\x10e\x9d\xc0\x94\xad!۳\xd8M\x8e\xb3\xb8Vݞ5`9絷\xea\xe1\xe6AǶ*S}Eh|\x1c \xb0\x87\x8a\xd2ӿ\x90\xd3'\xe90_\x1f\x98`\x81\x95+Hw\xfe:Jno͔`.6\xf7\xf3\x97\tM\xbc\x18\x05\xb6\xa1\xe4\xa3G\xa1;\x7fRM\xb5˂\xb4\xddR+\x19̱\xe4\xd3Z\xbdՒ\xf2t@&\x1b\xa7\xfd\xd4\xc64\xf9\x91&\x93\x86\x99\xa7q\xa6\xa2\xbdc\t\xa3\xa6Ls\xe9Sb\xcb\xd4ҍX*\xbfs\xbf\xf2\x8d~\x99\x95\xb4\v\xf9\v-\xb6K_~\xae\xe4\xb9\x05\xed\x95\U00046751r\x15|{\xab\xad\x7f\xed\xa5\xb1dF\xf0սw\x1b>\xc2Z\x15p|)\xf6\x1d\xfa\x1f\xe5Q͗\x02\xa6\xbfv#b\xcd:\xd0T\xbf\xd0~\xe1\x13S+\xa91\xdbQ\xed\x17&z\xe6\xfb\x96̶쥁\xd4oX\x9f6wg\xc7('vS\xd5\x1c\xa1\xf7ץ\x1c\xeb#XQ\xc0\xab\x11\xe3(\t\x99\x84`\xd6^\xb62\x89\xa8\xa3X\x95\xe4Cs|ߢ\x85[\x95\xfe\xb2\xffE?\x9a\xfb!U\xa1@\\\xf0\x1f\x94\xb4\xb2\x0e\x1a@\x0f}_mGI\x83o\x11s\x9fr\xdd+^\xc0\x16ެ\xe0\xe19\xa4\x1b\xbb \x0e\xbd\xd4J\x91\xa71\xe5\x9f\x18\x93\x16]j_&E\aQ\x01\x0e\xb9+\xf1\x0fX\x9c\xc8M\x12\xdb\xe9zFi\n\xc0\xbd\xb9\xb8\xd8J\xc1\xf2\xab\xd2\xfa\xf6u\xd9\xd7\t\xba\xbd\x85\xdb&\x9a\xc9\xebk#\xe3\xf6֨\xfd\xf6\xb6w\xec\xa9\xed\x1f\xe1\x8aq\x13\xe5b\x17\xe7N\xdb;\xab\xea\x06\x9a\xe8\xaf\xf5\xa7\xd8\xdfD\xf3b\x96\xf1\xdc}\x0e\x97g\xf4\xddP3G\x9f\xfc\xa8\xffG\xd7\x1c\\\v\xa5>\x11\xdf.\x1c\x1d\x16\xd9l\xd2=\xf2/\xac\xcf\xf9hx\xfa\xf8\xc4r\xac\xcf\xc3\xd1\xd9\xf8ԃ_\xc3^pD\xbf\x1e\xf7\xceN\x8f\x03\xf8u\xda=\x19\x1d=F\xb8\x1e\x0f\xcfz\b7\xe4G'g\xf0+\xe8\x86\xdeq\b\xbf\xf8\xe9h\xc8\t\xdfY\x10\x84\xf8+8;;\x1ba\x89\xc7\xe1\xa8\x17\x1e¯\x93\xa3\xc7|\x88\xf8\x0e\xcfN\x83\xe0\xd0\x02GF\xbc,ױ\x9b\xe9\xedy\x9e\a\x14\x1f\x9f\x1ez'c(y\xdc=\x1a\x9d\x9e\x10\xb6\x93\xe33\xc4{zxv\xf8\xf8\bӆ\x8fG\xa7H\xd3\xd9\xd1\xf0\xe4\xe4\x18~\x05\xa7\xa3\xe0\x98\xda8\xe6\xa7GX\x96\xf7\xc6\xc7=l\xc5x<>\xedQ\xda(\f=\x84\v\x8f\x86\xdd.b\t\xf9ٙH\xe3G\xe2\xd7\xe8q\xf7\xb8\x8b\x98\x1f\ap\x9d\x01)\x18zG=l\xd9\xf1\x99wvHm\xeczg\xbd\xa1u\xd9ڲ.\xb4\xac7\xee\x1d\x1db\xcb\x0eG\xbd\xd11\xe2=\n\x0eO\x89\xc7\xc7'\xc7=ꕓ\xe1\xc9\xf0t\x84u\xf5\x1e\x1f\x9fb\x89\xb3\x93 <\xe1\xd4\x17\xc3\xd11\xfe\x1a\x9e\x85\x8f\x8f\x91\x17\xe1!\xf7\x8e0m4\n\xc2^@\xbfΎ\xba\xd8+\xa3\xeeq\x8f\xda\x18\x84\x87g\xbd.\xe2\v\xba^\x97j;\xeb\x9evO\x91\x82\xc3.\xef\"\x96ð{\xd6E\xaa\x0e{^Э\xb5\f\xf7S\xa3\xd1ch\xd5Q\xef0<\x1aS[N\xceN<ꑳ1q&\xe8\rO\x0f\xb1-a8\x1a\x1ec\x1f\x8e\x8e\x83\xc3\x1e\x968\r\x8f\xcf\x0e\xb1G\x1e\x9f\x1c\x0e{\xc4\xdfQ\xef\x84z\xe9\xe8q7<D\xcaz\xe3\xde\xf1Q\xd0.C\xd7E!^\x03\xf6\x97\xc7\xdea\xdf\xfa\x9c\a\xc7\x1e\xf4ұ\xd7\xeb\x83L\x1f\x8e\xbbG\xf0\xe5\xf5A6\x0eO\xba\x87\x96sԅ/>\x0eF<\xb0\x9c#\xef1|\xf5\x1ew\xc3\x11|\x01\x96\xf1\xd1\xf1)?\x83\xaf3\xc8\xeb\xf6\xbaa\x0f\xbe\xba\x80\xe5q/\f8|\x1d#\xe4\xe9\x88\x0f\xe1\xeb\xa8\x0f#\xa2\x17<\x1e\xc3\x17\xd6\xd7;9\x1c\x9dX\xce!\x96;\x19\a^\xef\b\xbe\x80\xb2\xb3\xd3\xd1ao\b_\xa7P\xce\vώF\xf0\x05Xzg\xc3\xe3\xe3\x91\xe5\xf4\x10K78\x1a\x9f\x1e\xc1\xd7\t䝞\x1dB\r=\xc4y\xdc\v\xc2\xd1c\xf8\x02\x9c\xa7\xa3ǧ\x94\am\b\x8e\x87g\xc3\x10\xbe\x00\xe7\xa8;\nîU\xd1\x19xq\xef\xd35\xe71\xf4\xe8\xf0xx\x12`\xef\x9d\x1d\x9f\x9d\x9e\xa2\f\x9d\x9e\x1d\x8f\x8f\x0e\xa9\xa7\x8e\x8fOQJ\xe04mHc\xf1x\xf88 \x89=\x1cy\x87$\x7f\xc3\xe3\xee\xe1\x9a>\x1b\xa5)\xd6v\xcc\x1f\x87\xbd\xb1Y\xb6\xc4|:\x1c\x1d\x0eI\a=>;\x132\xde\x1d\xf6NH\xf3\x1c\x9d\x1e=&\x99\xf2\x1e\x8f\x87\xc7U9\x9d̒(\x81J<o4\xa2\xe1\vw\xb0\x86\x87\xa4\x0e\xceĐ\x1e\x8fe\xee\xe1\xa1\xe7=Ʀ?~,\x7f\x8dF㱄\x93J\xe0̃\xff\x11\xbe\xb3\xb1\xc4|tF\nixx\x1cP\xda\xf0P\xe6\x9e\f=\xefd\x88e\xc7\xc3C\x99+\xf1\r9\xfc\x8f0\x03]\xf4k\xf4x<\x16\xf4\x89\xda\xc6c~B\xbf\x82\x00 \xe1\x17\xe7\xd0:\xd9\"\xca=999\x914\x0f\xc7\x04\xe7y\xd0Nj\xd1ɉl%\xb4Ӻ\xdcv\x92\xfa!H\xc2t\x02\x8b\x8aƙ\xaa\xcc\xd6η\xa3\t\x97Oy\vOȐ\x96~z\x8e\xaf\x7f\xdc\xdev\xc52;/\b%\x9a\x02~\xcf\xf30\x1d`\xbf\nr\xee\xbf\x0e\x8akw\x1c\xa7i\xd6\xc1c\x88\x17A\xc1m0!\xdeEp\xf8~\xd0\xe5\x87B\xeaD\x9d%Y\xb4'\x0e\xd82\xad\x06DH\t\x1d{\xb7\xeby{\xdd\xe3\xbd\n\x19\x9a\xeb\x80ܲ\x15kE\xc8\x18\xc1\xea\x8dg~wмY!\x97\xd3z\xcdY\x14$\xa3j\xe5=j덯\xd1w\xd0;\xde\x13\x15\xec\xed\xb1\xbd\x0e\x96\x18\xa59\xb9!슬\xddn\xf7\xe0\xf1\x89g\xef\xf5\xec\xdd\xeeq\x15\xea\xe0\x143Nk\xe9]\xca\xe8\xcaE3.\x81\x96\xf3>\xe1\xd6\xfbgOv\x80\xb3\xe8\xdf왭X\xc1\xc6B\xb5\xe3\xb4&\xec\xbagǫ\x81\xe6\xa3\xd0\xd4-\xebY'\xe8\x13\x9e\xd3`\xcd\vr\xf6\f)Zm+\xd4 ,\x8d\xe2\f\x19\xd5M\x87\xe2\u05f7\xb8\xa1\xe3\xd7\x05\xee\xd74\xe1\x94ٱwO\xbc\xa6\xd3\xd5I\x9a\x14\u05f9\x7fa\xfd\x1d\xaecY\xdf\xc0\x94a\xbd\x0e2\xf0\t\x9bf\xf8{a9\xd6\xdfg\t\xfe7\x86\xf4\xd9\x15\xb8q\xf1\xa9\xe5Xo\xe1\x16\x96\xf5&\xbd\xb1\x1c\xeb\x05\x1fIu7K\"\xb8>\x84\xf7\x16\xfbV\xc8GA\xc8-'\xe7\xa3\x14\x1e\xb3>;9\xf2\xbc\xddÓc\xb7w\xbc\xdb\xf5\x1c\n\xc0\r[\x00\r\xbb\x9a\xca\x1a\x0f\xa1U?\xbe{\xfe\xcd,\x8e\x7fƍ胮\a&\xf8n\xd7[\xad\x1cQׂ\aYsM뫩c/q\"\x8fjH=w\x03J\xdcl#\xfe^H\xf4\xaf\xe1\xb3c_\x96\xc8?q\xfe\xb1\x8a\xfbt\v\xc4\x04\x01\xdd\r\x9b\xbd\n]\x18,*ضk7\"\xd2\xf0\x9c\xb0\xebt\xa6q\xf2\xf0\xc4\xf3vO\xb6\xa6\v՝NW\x1d\xdb\xfdqu\x8f\xe1\x98gVh\"u\xe2\xedv\x8f\uf3f1\x8en;\xae\xbd\xc6ry\xc7$\x8e\xb0\x94غ[\xca\xde9\xc1w\xec=+\xb7J\x8c5t\xf7¦\x8d\xccR\x83\xc0\x17:?\x1ag\b\x90Z\xf7\x85\x80T\x05'\v\xc2\xea=\xa2g\xbeV\xb6R\x80TU)\xa1~\x13\x99\x87.\xe8F\x01ձ\xbe\x18\xb2/\xb8ewB\xb3\xb8\xa9\xf1\xf4V\x16)4\xb2r\x83\xad\xf3K\xb8\xd7\xff%ܳ\xfbxWM\xa0\x1a\xf1(6\xe7\x7f\x87Z\x83\xbe\x0f<Ⱦ\x99\x81~%'\x05\x88\x11\xc0CبPM\xf3}\xa1\x03\xece\t\xedKu\xdbA\x8c{\b-\xfah\xbfk\xef\xe2T/\xb0\x95\xb0\x9eJssSᔘk\xaa\xa8Z\x86\xb4H\xad\x00%נ\xb1\xden5\xf5\xbbt\x96\xe5ur\xa4D\xd72\xa485\x94\x88\xe3(\xd7r\xa9\x83$\x90a\xfb\xac*\\Em\xfdo\xc5T\xefߙ{\"\x93\xcc$\x1e\xc5ȣ\x03\x9dG\xf6\xae\xfeu'\x83\xc3}\x95\x8e\x82\xb8\xd5\xec\xa0\xec\xffO\x1b\x1f\x7f\xa0\xe5\xf1G\x9a\x1d\xff\x9e6\xc7_\x06ǝ\r\x8e\xdf\xd1\xda\xf8\x1dM\x8d\xff\xfbvƟfd\xc0@\xfa\xbdg\xc3\xda\x04Sf5OJ\xcdsXӄ\x87\xb5\x993,\x8dj\x13_\x83\xb1\xd2d\xa9\xb4\xcc\xd9Ƅ}\a\xd3\xe2\x8f1\xd8\xfehnv\xff\x1dX\xf7\x87Xe\xdb\xd0\xf8\xaf\xe5\xf3\xbf\xcc\xfa\x12qo\xecA[\x96\xd0Q\xff\xf5\xfa\xabw\xa5\x86\x11\xf7]\x82a\xfe~A;u\xc10\xef,\xf0r\x10&>\xf5\xbb\xbc\xdbS\x9alq\x00\x9f{\xd6;K]\x01Q`\x8f\r\xa8\xc7{\xd6W\r@'\x06\xd0ɞ\xf5\xba\x01\xe8\xd0\x00:ܳ\xfe\xab\x02\xf4\xa4\xfb\xe8\xd1\xe2\xa9W\x82\xb9E\xfaM4\xe7a\xa7g\x9b\x90\xbe\xef+0\x8b\xb0\xa8B\xab\xd5&f\xc1\xf6[\xd7\xeb\x1d\xfd\xd7\xebo\xdf}\x7f'\xaey\xf3#O\xfb\xa75\xa8\x92\xb3g}_\xe7\x80\xf7\xf8\xf1q\xb7{\xd2;==5\x18\xa6\xa77v\x82wzxz\xd4=\xeb\x1d\x19\xa5d\xe2\x9e\xf5mC\x91\xa3\xb3\xe3J-\x98\xd2\xd85^\x05s\xef\xe8\xcf\xe8\x9c\xe6{\xd2q\x9a\xb9\xdf\a1/\nn^\x92\xd62\xaa~\x04\xb4\x97\r\a\xcf\xc6\r\x1f\xf3Pz`z\x1b\xac9\x97\xf6\x11\x97̼\b\xf0\xde\b~\\\xde\xdej_\xb7\xb7\x06\xa0v2EزY\x92D\xc9\x15\xdd\xc6\x15'\xf7W<\xe1YP\xa4\x99L\x95\x8e1\xe5\x95\x1d\xf0gH\xa7\xc2M\v\x9aF\xf81\xc1\xd7\xc8T\x0eǴ\xc9\xef|p\x04\xf9\"6\x13x\xcbEO\xb4\xc2t\xddT\x14\xa6\x9b\x8a%6\fG\x01\x88\x14\x85\xe02\xa8Q\xf5]\x1eW\xe0\x1d\xe3s\xaf{I\xb7F>\xf8\xdd\xc1\x87'\xed\x8d\x1a|\xa8\x92\xa1\xaa\xect\x0f\xda\xcb\xedb\xe0\xb36\xf2\x9b\x18si\x1c\xe3\xf9\xf4G8\xbb\xa4\x05\xf8P\xb5\xf0T:3\x19\xbe\f\x10;\xce0RE\xcd\x1f\xf9B\xden\xd2i\xd1{\x7foOBh-C\xa9\xecط\xb7\xea\x88n5h\x84\xa9\x04\x04\xa8\\u\xd0*\x96!u\x06j@(O\xb5\x8a\xdc\xf9U&\xec\xf6\xe0\x8e&\xb5x}\xbf7\xa03%\xc1\xbb\xb4;\xee\xb1\xdd\"﵊\xfd\x1e\xa9\x88ߥ\xee\x06\x80\xbdn;={{+\xbd\xf3ɑ\x0e\xe8P\xf3=~m\xd2Zte\xe9ه`^\xbb!\x88\xa9\xe5\xe5&\x8az#\x82\xf6,K\xf7\x9b~\xd3\xfd\xb50(\x82\x1f\x7fxE.A\xe2C\xba\b\xe2I\x14f\xd0\xef\xdbۦ\x15\x8a\xf2(\x04O\xa9\x98\x17\\\x16\x91\xdfFd\x05\x05\x8dW\xb9%(~4\xc1)}J\x9f\x10Y\x90\xe7\xb0\xf6r\xc4ϾV\xe6on\xf0!\x98w\x96\xb3,\xee\xeb\x8ds\xe0/Dv\xeb[\x1f\xf24\xb1\x9c|6\x1a\xf1<' \xf1\xe1b\x10\x11H\xb1\x1d\x0e\xe4P.\xfe\xd4\xf2V\xf6Jdk\x15\x8f\xd2$Oc\xee\xc6\xe9U\xc7\xc2\\\x16\xa7A\b~\xad\x82\x04\xf0f\xd5I\xb2\r6\bԊ.\xe3\xf4\xcf\xc9ёC\xf8sk]\x83\xb9v\xc9'W\xf7\xff}/\xeecc\xa9>\xe1\xc1\xec~\x15^z\xff\xa1?\xa0_\xfe\xbc\xbd5&:\x94\xb4\x8e*\xac\x17\xaa\\r({^\xb6K\x10\xd3o\x98RC%c\xf0k\xa0\xb91k$\x0eԝW\xfa\x96\xb1\xac\xb0\xc8\x1a\x97\xe6\x12\xdb\x7fq\xb8R\xf5\x91/\xc0\x9d\x19\x86\x17\xa2Ty\xb6q\xc1Hޝ\x85\xe8\x9ei\xc6\xe8Ax\xab\xe5\xfc:,\xdb\x01\x95\x84TIXV\"rD\x15\xda\xe5\xa5Z\x05ʣ\x1c\x10\xf9\xb2\x9c\x1e\x02\b#mY\xa8/,\xc7\xc2R\x0e\xa2\xb4.\a%H\x9d\xc8)*\xf5\xf0bzi\xe7\x17\xd3K\x1f~\xc1\xbd\x028,V\xdbE(\f+{+5D/E\xf0\x06U$r\x9a̧\xaa\x84Q\x17\xe3O\xe3zj\xee/\xe5\xa3\x14}\xfdf\xf2\xaai\x13\x17\x05H\xaa\v\x89\xc0\x95\xe5[o\xba6<\x80a\x95\xae\x11\x95\x8d\x14\xf1\f\x86Ó\x10\xa1\xe1\xc8=\x1e\xd3\x055\xf8\xbe\xf4+߷\xb7\xcba:\xe79:\x95W\xf2\\\xcc\x11\xde\x04\x02s_\xab\xa1/k\xa9\xde\xfb\xd1\x14\x8e麬*hp[&r)ޖlq\x95Zdk\xcc\xc7\x05eИ\x9eSQ\x10J\xc8z\xe2\xdd\xde\xc2ߧ:\x88\xb8P\x05\xbbP(`e\x15\xb2'쥖\xd6\xd4!\xea\xb9\x1d\xcb\x1eԋ\xbb\xe4\x03+\xa2\xb0\xfbV\x92&\xdcZip\xc4\xcbZ\xab\x87)\xdd\xd3\x19\xa6\x82F\x19\x1d\xa8\x9a\xb0\x86\x1c54\xf0\xeagS\xd34\xdf]\xbf\x9e\xef\xb7EI\n\xa3\x1b\xcb\x1e\xb4\\\xc5.\xf1Xv\x9bL\xd3\xe5\xeb\xe7\xd7Q\x1cv$1\n_\x10\x86\xf8\"6\xa0\xe4\t\xcf tS\x047\x8b\x15s\xb8\xbd\x94\xc0e\xe5\x14\x85\xa3c\xd1%(\xb33*\xfd\xb6\x0e\xf4w\xec\x8fj50\xf3\xe2\xfd\x06{\xd5*((\xc4\xf0\x9f=k:\xb7\xb6\x10(|<\xc0ڶ\x05z\x87\x03\xe5\xe27\xaay%\x15\r\x00\xf7\x13\x05\xa1\x134\x80(Ix\x06\xa1\xaf\x10\xb9\xc8oje\xa3\x84Tzt\x03Q\x1b\xc6m\xe5a0\xcb\x1eh\x8a\xa1\x89\x8c\n>T,Ȣ$\xb4\x97U1\xd8@ۦQ\\}\xdbk3u5\xc1\\\xadt\xfaL\xfd\x89\x97j\u038b #Y\x1b4\xe4~\x9d\x84\xb4\xbd3\x89\x92\x8e\xa1U%N\xa7M\x91\x0e\fEZ\xd6\xf5\xb4^\x85\xbd\xac\xa7\x11I\x8d\xc4\x12A\xc1\xfc.\x04y\x97tG\xbd\x82\x90n:\xd4k\xdfo\xaa\xb8\xde_\xdahm*@\xa3\xb7\xa5ԧ\xa6\xaa\x91\x9e\x96b\xa5p\x88 @\x86\x96o\x97\xf3\x06\xe8ڨhR;+ۼwF,\x957\xae:\xda4\x8e\x1c\x17\xf1o\xf1\x9a\xef6\xa6\xd7<\xaa{\xe8U\xb2\x9a\x8c\xaf\x8aٴ\xc1\x00\xbb\x10\xe7h\xf8\xac\xcf;\x18\x83e\x9c\x183\xed\xf6֚\xc6A\x94\x88\x882cخ\x03\x1a~L\"\x05O_\xcaU\xb5L\x16\xbbg\x95\xa5\x86q\xf0Oh\x83)\x98\xb7Y\x14\x14\\!\xaf\xf8\x02\xccd\x15t\x00\x0f\xe8\xe9\x88oP\xbd\xf6.e\\\xddz\x17\xbe\xa7W\\l\xee\xfb\x94S^ \xbf\xf0.\a⸰:1\xccpb\xd3\x1couD\a3\xb57\xff\xd4\xef\xd9K\xc0\x81G\x89\xb7\xb7\xb3Ui{SJI\xf1E\xf9\xb3\xdcgZ\x95=B~\r\xf9\xba\xab\xfd\x8dm\xc4\xea\xeb\xddt{\xdb\xc6cQ\x0e\xfdh\xfd\xf2\xe4\xa1\xd3\xc0\x1f\xdb<\x8a \x9e\xd2\xf6Իh\xf4\xd1/\x19\t9\xa9h\x81\x16❶\x12G\xe5&\"\x89\xcb\xe8#\xb9\xcc\"K\xb0v\r+\x1dq\x0e\xf4zT\t\xe3\x98\xe8\xa07\x10U\n\xc3\x1b\xe3D\xf4\x150\"\xea\xc3\x7fV*\xf6\x9f(\xd0\x1a\x1e\xc0\xb4\xcej\x82\x01f\x96Ka\x7fߤ!\x17\xeaD\xcc\xc9v%\"\x8f\x8a>$\x19S\xed뎭\x1aP\xab)\x15\x97\x915\xa5\x9eR\xc8T\xbb\xd5\\\xd76\xf1\xeef\xa4h\x1a\xaf\xb1FR\xc2-&\r='\xd6j\xf2 BS\xbd\xd8Bk\x14\xf1&\x83\x05a\xaa\x15b\xa2\xca,\xed\xa7\x14G\x97\xab\xdc\v\x94\xff\x90l\a\x1e*ڃ&[\x01qm\xb4(\x94مpJLP\xfad\xdej\xcb)B\xee\xaf\xdce\x8a\xf8\xa9e~\xf8i\xfd\xe4\x00_C\x9e\x15\xd7?\xc0\x92\xd3\xedn\x15\x04\xaby2I\xb3\x88'bŉyZ\xc2\xed\xadU\xa4S1gL\xa39\x8f\xf3\xefy\x86\x03\x18A\x8d\xa4\xdb\xdb\xd3cu\x86\x81\xe2a\xcb\v\xddE4\x02\x88\\\x9b\x99\fH\x1c\xdc\x02\xbc\xfc\xf6+\xf9\xa5f\xc5\x1b\xa4*\x17\xbeno\x8f\xee>\x15J\x12Ԣ`C\xb4\xb5\xf72\x88\x88\xba\x9e,\xf3\xf5\x80Pf@.\xa3\x82ր\\M\x1737]\xe6\xc41d9\x95\xb0`L=\xe1YY\x99\xfa\x92\xb8\v\xef\x12\xf4\xbb\x9e'ԅ|\x9bη\xe4\xe3t\xd6}\x82\x98i\xf1\x1c\x81\x17\xdaй\x89\xf2\xd5]Gҝn\r?\xd4[%\xb5gC\xdf\x19\x8d/\xb5\x7f\xa5\x1b\xef\xd19\xbb\x9d\xee\x9e\x1a\x98\"p\x10~\xeb\x97~4p\x05{г\x9b\xba\x045\xf8~\x97\xe0\xc8nm\x9b\xe8\xd4\xf9\x0e\xf2\x16\xd1?\xf4\x8d\x1b\xdch~\xdbf\x8f\x06\xb3\"\xa5P\x96Dl0\x17\xe2{s\xe5\xc2\xef\x8e-\x82\x9c\xe8\xf6\x8a-\x94D\xa7\xaaA\xec\x01\x94\xc1\x01\xf7\r\xb9R\xa9\x01H\xdf\xda\xe9\xc1\\\x1dN\xccW\xb6:\x9d\xd2\xd4A07\xbek\xf9ڀ\xf7\xab\x9a\xe6\xf6\xb6\xcao\x8d-\au}v\xb7\x9e\xba\xbd\x157\xba\xb2 \xc9avR\xe4\xeb\xda\xd4G\xe5I\xaa{!<`5\x19\xba\xbd\xd5袤\x81B\xe8[\xf83\x86\xd1a\xed\x89\xdew\xac=\x81gϲ\xc5q\xfa\x86\x12̳\xad\x95$\xce\xd4s7Q.\x94Գ8\xeeX\xbb\x96-\x8c\x9f\x8e\xbdR\x00\xbaº\xb2*\xaa\xe7\xc2*_\x89\x15\x83\xc0T\xb5\x97eDVYT\xd1k9\xea\xa7-\x1e\xf6\x90\xfd\xaduun\xe3\x9f\xf3\xd90\x8cn\xa2\x10\xfc\x85\x94\xce\xef\x18\xf3\x81\x18lWY\x14§\xdf\xd4\x1f\xf4B\xb9\xf5e\xb7\x0f\x0eI\r\xec7T\xd5\xfa\xc6[\xea)_\xeb\xbe\xf4KZmۈ\xad!\"\f\xd4\xc8[5h23\x9c\xb5\x9a\x89\x96\r\x11\x14\x8c\xf8\xa7\x9bc(\xb4\x86O\xb8O\x00\x84\xf2h\b\xf4\x8d\xb1Ew\xf7h\f+=\x9cB\xa7=\x9eBu\xd8ۦr\xde:\xd6\u0091w\xbf\x18\t\x9a9\xf7s\x8b\x99\xf7\xf3=σ\xefe\xcaዢ\xffg\x8d9U\x80&\x1a\xbf}\xe2)}7\x16\xa2c\rS\xfa\xaeV\xe1}\xec\xbd\x16ۍ\x9ee\xff75\xdcڶ\xa7\xb62\xe3\x1cAI\xff^\x16\xdco\xd7fm\x06\xdf\xef\x11\x1c\xa6]\xb7\xdd+V\xccv\xc1b\xaa\xcam\xd7\x14䭕[\xcb$x\xbf`2\xa0`M:\f\x13X\x87\xac\x90\xdbl?\x81\xf5k\xd9\xcb\x06\x89/\xd2i\xc5,^9$n\xfdV\x93\x98j\xae\xd8\xc4ć5F\xf1=\x8cL\xaa\xa8\xd5\xcaD\x13[L\xe5Y\xf0\t&\x02\xbd\xf4\xc2\x1e\x94\x99\xdffQ\x88\x86Ŗ&\x82\xa3P\x96|\xd0\xe2\x1f\xb6Z\xf7\x04\xb3\xbdY_Ѷ\xf7\xb3\xdf\xd7v\xfaVbc\x18\xe1\xa6\x05\\\n,\x98\xc1\xca\"\xfeC\xcda\xf1\xc0\xfe\xbf\x83-,f8\xc0\xb3r\x94(i\xea\x17dj\xb9\xd1b&\x9b\xa1n0#c\xeff//\xd0^\xfe\x1d\x8c\xe5m\xbd_\xd0\xc0r\xcfA\xb2\xc36\xfbKd\xb7\x98a\x8dE\x9cF\xe3\x8c^\xe5\xaaY\xdf\xc2G8\x88y\xf5\x05\x82\xf2)\x1cr.\xb3\x88\x12&\x9e\xe5\xcd\x19\x96\xb2l\xe9\x96\b(Jl\x83J\x15\xc0\x9dz\r\x91\xc8@UVN\ue626\x8a\xad\x88ta\xd26)\x10\xd16]\x8f\x84\x1ff9L\xaeHVI\xa1;J\xa7\x8b\x8e\f\xe1KI\xf4\xbb\x14IY\x9d\x8e\xc2n\x94Q\xc9S\x14\xd5R\x97G\xa1\xbd\x94H k\xb5\xa5@|ů\x83\x9b(\xcdd\xfc\xc9\uf8ab\xeb\x98&\xde\xc1\xb6\xa0w\xd8X\xa5\xd7\xe5)\x99~\x0f\ua6f8\xe8$v\x1e\x8c\xb9/^\x97$?\x8aWQ\xc21B8\xa6\xc9ȥ\xe4\x02\x8c\b\x8d$Ͷ%\xff0L\xd5/\xb7i\x8e\xb4?\\\ru('<t\xb3\xaba\a\"\xa3\xc1\xff,\xdb\xee\xb8g\xb6\xfe~\x90\xf2\xb7R\\@\xd7\x15\xed`+\xb6\x97\xb1\xdb\xee\xda2Ig9\x87\x15\xab\xe9\xde\x02\x86\xb6j\xad4\xf5\xf0\xfe\x80Ƅ\x98v扁x\x9a\xdbp\xb4\x02\xc9Z\xdc\xdf\xd8\xf7}H\xc2\x0fL~\xea=z\xa4\x9d\x04T\x03\xe7>zԡsb\xeam\x99\xfdeS\xa2,\x83\xf6\x81\x11\x88\x9f\xfc\x9a\xb5jDIq6\x88\x84\xecw\a\x0f\x10k\x9aEWQ\x12Ĳ\x90B\xa0;W\xd6q\t\x7fQ\r\xde\xe9\xc2\xf1\xff\xa0\x0e\x8ag\x18\xf4[\x1e\x96\xad\x94\xb8]\xe8M\xa3\xf8\x9dk\xf2no\xf5$\x04\x1c\xd4R|C&;\xb5|8LӨT\a\xea\xc2]h\xb0Y\x82fEM\x80\x1en\x96 \x1cF[\n\x91!>\x8f\x1e\xc1\x7f\xabOVXF\xcfY\x86\x044t\xd84\x9dv\xecAkG\xd6e\xc1\xf1\x84\v\xb0=\by\xcc\v\xce\xea0`¬\xe9.{Y\xef\x9c5\xe0\xab\xf5\x1d\xb3Rv\x1a\xf1\xcf^j\x1f\xad\xcc\x14K\xc0\xba\xd6\xe8\xc4\x18+\xfb>\n\xfbm\x16\x9a7\xe0ր\xfd\x9e\x8a\xba\xba\xfct\xff\xd64\x97W\xa2\xaa~\xf8\xff\xcdx\xb6`A\xa1\nY\xab:\"w\x16m\x8f\xebǗ::w\x16Y\xab\xbf\xe9\x8b\xee\xbfuL1\xcf\v\xdb\xcdӬ\x80QIN\xacA\x94\xc0\b\xeb[\xb4\x04\xb6\x9c\"\x8dy\x16$#\u07b70\x8c3\xe8h\x12\x80r\x1a\xe6\xd0o\xce,2$\xfd\xe2r\xd0T\x1d\x90۱\xe2Ȳ]n\b\x04\xbd8F\xef\"¸\x85_\xa6\x9f\xb8\x11\xc0K\xcf^ٚ\xf7A\xabz\xed\x0e\xa2\xa7\xe0\x9b\xb0\xbfo/k@\xf0*c%\xfeV\x93į\xec\xc6F\t\xc5v\x8e\xab\x03d\xf5\xca\u07b4\x01\x02\xd4^\xb7nG\xe8u\xa8\xcd\"\xb1\xfen\xc8\x13\x8b^\x02\xf0\xaf\xb7<`\xae\x8e\x8bw誹y\xfc\xbc\xab<\xf5\xf6\xdb\a\x904 \x9e%\xa3k\xfd\x12\x13\xa9\\\xf1\xcc0\xe4\xacy\xb2t@ \x9aw\x80\xf5\xe8\xf3\xae\xe7yG\x03K\xe6U\x1d\fEde\x9a\xabJ\xf7̜g\xc5W|\x9cf\xbcC\x05\x1d\x03`\x1cey\x81\xee\x01\xaa\xd24A\xf7\\\xbf2\xfb\xe8\xaaT\x85\x977\x94/OD\xfc}\xa3\x86\xba\xf7\x9a,m\x95\xf1\x9b5\x01\xbb\xd3\xc3S\xf2\xc1u\xbf+\aV\x03\x99\xed\x14!\xe74rVڵ\x1a\x11\xdep\xc8c\xdf(}\xc5\v\xd1U\xf9W\x8bw\xc1\x15>\"h\xe5\xd3 \xb1\xd08\xc1\"\x8dL\xd4\xcc\xdbgq\xfc\xb6\xb8\xe6\x19Lڹ\xdf\xc4Z\xba\xa8\xd1\x00m/M\xf7\xa4\xfal_}\xac9\xf6k0\x17\x11>\x04\xa1U\fv\x80+\xc3\xf7\xa9\x9b\xadq\xbdǩ\xcf\x1aہ\xe1\xe5\x87\x19\x0f>\xae\xd0Y\xb5\x99\xfc\xdf&2[Y7\xf6rS\xe3\x96q\x83\x90l#!Bf\xb7\xa3\"\xae\xb7s\xbbq\x01뼚)RΨ\x7f{h\xbe\x0f\xf8\xb7\x8ei\xa6蓡\xbd\\\x97\xdbY\xe6E\x90\x15M\x13\xe0,\xc2\xc7KiHXI*e\xdarL`{\x89\x7f\xdci\x86\x7f_P\x84y\xb1\x13^\xa4\xd3&\xdc9\xc5\x11Hg\x85>\x8f\xc8\x1agI\xadN{\xe5\xf4\x8e=\xe4\xff\xdd,2R\xc3d\x88Ѯb\x10\x86r\x16\xa8\xf9Ι*\xa8\xe1\xfe\x96\x92\x97\x8a7B\xdbT\r\x9aI\x989i\x12/X\x9ap\xf9\xea\x04\xee\xfd\xadʡEçi\xaa\x1eH\x01\xd2+\xd5\n\xd2\x1b\v͓\xfcjPk\xb5\xf9\xf4\x8fH\x9dՙa\x96\xd9Ό\xfd\x0e\x96\xbe/x\x11Dq}\xf2\xd52\xef~\x16\xb8\xd5u\xad\xf97\xd2}\x8e\xb2\xca\xefFg\f\xe5b7G\xe7:3\x82\x9e\xe0ܢ\x82rфR;f\xf3鹳/\x17}#V\x80\xe1\xd7h\x1cy\xdd\xe1&\x06\x06\xfe\xb1Bb\xaf:\xbc\x88@2Px\xb6\xf0\xfd\xa3\xd1\x13\xe4\xb4B\xa1\x05\xa3\xeam\xb9\b\xcdˋ\x93\xe7\xd7\xe9'y'\x16~\xcb\xf4\xef\xa2P]\xab\x85\xdf2\x9d^j\x909?hoB\x8dM>\x8eK6\x9a٫\xa6\x10K⽣\xb9\xb3P\xb9\xe1O\xe5ϟ\x1d=ʔZ\xee\xedY\xfdG\xc90\x9f\x0e\xac\xbd\x12tU7\xfa\xed%\xf7\xf9\xed\xad\xc9\x1b\xbaCc8V\x95|\xa3\x8b\x94\xdc-\x82\xec\x8a\x17n\x92\x86\x1c:G\x06D\xfa\xef\xce4(\xaeo\xf3\x9b\xabی\x8f\x8a\xdbQ\x94\x8dbn\xff\xed\xc0\xb8\xab^\x15gLD%\xf9\x93\xcfūD?\xdd\xder7\x0e\x16<\xfb\xa9\xcc\xfeYe\xff\xac\xb2\x7f\xc6\xec\x0f\xbe\xa7\xbd\xb0#]x\x13\x1ed</\xbe\x87\xc4A]\xd1mzf\xaa\xfat\x97(\xac=#\xf4ao\xefR]-\x15\xaaOo)\xb9Z\xff\xe4K\xb7\xdf(\xb9\xe1YA\x13\xc2O\xb6\x06\x82K~\xdai]\xf3̚|\x86\x0f\xafQ\xbb\xf4\xc0\xd7>\xee\r\xb9s\xfdQ5\xf3\t?\xaa\a}\xca\xe7\xd1$(8V&b:\xa7\xb3$\xecTi\x10\t?\xd9t\x05\xa6Z\xd27\xf0\xdb\xd5\xfc\xfd\xfd\x81\xe4\x9bV\xd3$Jj\x98no+\xb4\xea\xab\xc0*\xf0 zb\xc0\x0eh\x91\x89L\x81\a\xc5\xc5/\b\x00\x80\xb6\x18\x90.2\xdd\xf9\x13\xe1\xf5\xfeӣG\n̝?\x95\xed\\V\x88\x85P0\"o_ᰟ\xb4\xe4!.\xfb˨\x1f\xedu\x85\x1d\xa8\xea\xde\xeb\xea\xb5\xdb\xcbhoOX\xff\xfb\xfb+\t\x865?\xf1\xec\x92\n\x11a\x1b\xfd\xed\x11\x91ʺ\x94\xdb\xc5E\x19\x99;\xff\x9f\xac\xa0\x8b\x0f\xd3\xf4SGQ)\x1d\xc2\x11\x8d;\xb7\xf7\x85\xdc9={\xaf\rz!\xa0\x17{\xe2\xaf'\x8a\xfd\f\xc5H\x94\xb4\xd9F\x8c$}\xbe\xa9LHXbQ+\xb1\xa8\x96X\x98%p \xfb\xcbR\xe9\xe1\x81b\xbfīZ\xa5iC\x82)1\x19\xaf\xc3}\xa9\x7fȑ(\x1ai\xf7\xe5\x0fy7\x9f\xfe8\x98L\x99\x8e\xe4y_\xfep\xd2\f\x0e\xa3?8\x183Oӿ\xe4\xe5\xa0k\x9f\xdb[Y艞\xec\xcaT{\xa9'\xd3\xdb]+\xfc\xaf\xd8,\xc1\xdf\xea\x11\xbf*z\xa9s\f\xdc\xe2\xa1c\x9c!ued\x00\t.\"@\x85\xd9&`%\xd3tQ\xd1\x16\xecV\xab\x8b\xab\x14G9\x16\xe8~\x82>\x9b\x8bWn\x84O\x89x\a\xadO\x7f\x1c\x9a\xfe\xe5\x17\xee\x16\xff\xd4'\x89\xa6\xaf\x9f\xe9\xebg\xa7*4\x95o\xf1,\xe5O}\xf1\x17\f\xf6\xeb(\xe4\xfd\xaa!(m\f\xb23\xd7=\xdb\x1c%\xea\nn\xb9Z\xd1-\x86\xfa#P\x94\x81!\x1c\xf3\xeb\xf4Sk\xe5\xd8}-u\xcb\x15Ժ\xeaѐi\xaa\x1e2\xb0\xfa\xaaOE\xbbݩO\xb1\xe4O\x86\xbf\xcbd\x12\xdc\xfa.ƴ\f\xa4*Ĳ\fިޱ\x13\xb2(-I}\x1e\xadJ\xe6\xb4Q$\rȟ\x1b!\x7f\xfe\xad«S:\x17\"\x8c:\xf1\x15n\x99\xac7j\tH\xb7i\xe7\xefq\xdfĒY%-kǛn\xe2RI\xd2̰\x8c\xdc@\x03\x80\xe8\x14\xc0\xb7\xa5\x1fHQ\v\xb5W\v\x83Q1\v\xe2\x9f\xfd\x8d\xca\xd4\xe8E\xbbo|R\xbde\xf3L\xdbWڹ\x06w\x1dQqu<W\x15>\x95\x12-+]\x87tw\x1b\x934oϤTSDM\f\x06\xbc\xd2V۴l\tSsɒ\x16\x16\xa6\x95d\x99Tj\x99C\x9cM\xe8\xfc\xd78\x85k%,L\vm\xf4и\xb2\x97M\x1d,\xceʬ\x06\xfad\x96\xf0A@\xa5P\xd7\"byӤG(K\xbe\x00\xe7\x18\xeb\xa9~\xf3FÆC@Pj\xe6)`\x8bF\x14\xeb}n\xeb\x1b\x98\xf2\xacq\xc3潎\xc0(o\x0f\xb6\xa7\xb4鸒\xbb\xe8\xa0\xc9\xc3w\xb8F\x82\xc7\xde+I\xee(\x9dL\x83\x8c\xbf\x10\xa2\xf4\xbdp\xed\xec\xd4+\xb6\x1f\xe1\x8d\xc5\x17o\x9f\xff\xf8\xfa\xeb7\xef\xde\x7f\xff\xf6\xfc廗o\u07fc\x7f\xfe\xf6ͻg/ߜۢ%\xd7b2\xa93bK\xcf\tx\x15\xfd\xfb\xfa\xde\x05&o\xe9:\xf3!\x98;\xcb\xfbG\x83\x9a\xfe\xd6pP\xdb5\xf4\x15n\xa1\xd5[J\xe9Mw\xf2\x1a\xb7/\f'\xe1M\x9b4\xe6QI\x8b\x11\xa1\x1c\x85cI!\x14\x8b\xa3\\Ԍ\xbf\xda\xd4\xcf,\xb6\x9a\xefHB)[\xd7\xef\xc6\x12\xb8\xed%\xf5\x95\xe6\xf2\x9a\x04\xc5,\vb<r\x95O\xdc\xf8\xcacB\xbc\xa0%\xb6'q?\\^YGM\x90h\xbbw\xfa\xb2zm\xe0\v8\\\x1c\x94A\x00HY\xc1\xb7\x16\x9a\xa9z\x1a\xa3 \xf7|K\xb9\xd3X\xe4\xd2\xfc)(F\xd7\x1bT7\x01\xe9\x15R\x8a%s\x84\x9a\x0eF\x1f\xafp\xd9ܠ\xaakAa\xa8\xa8~\xa8\xd2F\x04\x9d\xa4\x88c\x14\xbd\xd5d\x1f\xc4\x15\xf3@[m\xd4k\x8d\xc9\"\x88Q\xb4\xf4t\f\xf7\xa1\x1f\x10\x94Q\xbd$\xc2\xf4\x95\xd8vע*T\xa2\xfe@c0ֈ/C\xac\xf4\xe1\xcb\\:\xad\x06r{8\xbf\xe6\xf1M\x94\\\xd9K\xe3Sۥ~O\x84\x99ٕmڎ\xbd\x92\b\xaf\xa5\xb7\x01ɣ\x99\xd2\xe4\x8e@\xf8\xe988ƭs\\S\x89\xe4uq\xcb\xe4v:\xc8q\a\x8f\xa9\xdbg\x94-\x8fl)\xb8F\x1c5\xfa9h\x99\xf7\xdb*\xfe\xed\xba\nS\x873\x90\x97u\xadm\x9f?W\x0e\x96\xeeWNʛ(k\xdf\x0e\x14\x11\x14\xa8~\x98'^\xc8\xf0\t\x15w\bu\xb4\x9e#\xd7:K\xdc\x05C\xcf-g\x12%}\x15\xed\xc0\x99\x04\xf3\xbe\n\x97@+\xfb\xbc\x7fQ櫼K\aq5\x1d\xe0@\x80\xec\x88\f\xc7\xfc\xa2{\xf9\xc4/\xbf<u\x95_\xbaϪ\x17\xa5\r\xa8jv0\xf7u\x94\x03\xf3(c\x033`\x03I6\xc07\x89Y6\x90!\xcf\xceVe\xb9\xaeQ\xae[/\x17̵r\x14\xa8N\xf0\x1c\xf6\x1d\xf5H0\x9a\xdb0\xf9\xeb\xd7v\x9c\xef#\tD\x99_\xefi+\x9d\xa2%\xeaX\x04b\xd9\x1b%\xa7\x1d\xc5$J,G\xb1r\x03l0\xb7Ji\xc1N\xa8\xf3\x9aְK\xd5\x1fe؍U\x1d>\x98W໗e\xe4\x93\xd5\x16M\x17⼭\r$ߕnP@\"\xe7w\xbd\xb4V\xf0$W\x17\xd6ć\xd8\xf6\x13_\xba\xcd-\x9c/\xe5\xd3ԕĦ`\x98\xee\x8f2W>\xa6]\x96\x94n<շ\xaa\xc5l\xf5}P\\\x7f\x13\x8c\x8a4[\xe8b*\xb3ϋ,\xfd\xc8\x1b\x01\xe4\x93\xe2z*)\x80\xa5hT\xdf=srD\x80\x17z\xfa=G\xf7+u\xa6A\x18F\xc9U\x7f\t\x87Ů\xd7u\xd0\x11\xbf\xef9t\x85\x15\x93`\xef\xa1\xef\xad\x04\x9a>Z\xf4\xce8\x8ac\xfa\xb9Z\t9\xec7\xbc\x1e\xa9\x1dT\xe0\x1e\xb2q\x11Hˬ\xa7C\xaaP=\xe8(C\x9b\x92\xfa\xc1\a]S\x8b\xc6\x1d-\xdfx\xc0[pb\u07bf\x00\xb9\xc6;X\x97\xceB\xffZ\xa1\x1d\x81c\xa5D\"v\"qD\xd4R\x17\x15\xd8Ş\xfe\xe1\t\x98`\xbe\x0eFk\xc4\xc6g\x1c\x9b\"\x8c\x82\xe2\x84-\xaa\xea\x0e\x15\xc4\x1b]\xec\x85PE4\xee,\x9e\x00\xad6\x12\xbc\xc0\x84\xa7@\x98\x8d\xd4-ȸ\x16^kpv\xf3\x04\xb8`\xc3\x7f|-U\x03\xaa\xb8\xc1\xc1\xd1\x05\xb0Ȇ\xff\xf8\xad0+{\x008\xf7\xfd\x0e\xc0\xedc%t\xc1C\x88\x1enm\r s\xaf\x1d\x06\xa5r\x80M\xd1\xe4d\x02*η\x82Y\x91Z_Bf\xdf̄\xeb\xf3\xd8Z=9\x98\xebB\xf2%\xe4\xf7\xcd\xfc\x81\xe9\x14\xa5\xd7r{\v\xd5<\xf1\xec\xe5\x82\x1a\x05\xc5\xf7\x17u\x82i\xf8\xac*\x98̺\x01\t\xb6\xba\rI\x91NW\xa5\f\x03[\x1c`\x10\xca0\x80;P\xf0\xb2u\xf7Լ\xf3\xd8:\xb7\xd5\x03\xec\xde\xde\xea\xeb3\x9a\x00#\x01q\x13\xa9\xec\x1b\xb8[\xd0~\x9bI\x1d\xc0\xf9ws+k[\x0e\x92Z\x10\xe1#\x924\xe4\xb9_\xa9\x1d\xce}-\x1b\xe7[RA.O\xa0F\xf3~\xac\x80\xa2KC\xa1\xb8CUS\xc4\x1dq\xce\x04\x8e^\xad\xb6\xb9\x18\xaa\r\xcb\xc1\x8a\xabiP\\\xfbH2(\xad\b\x0elEXf\xb0\\\xc8\x1dRb\x93\xd1\xe2\x1c=\xb3߸|\x05\x1d,\x03x\xc5\xf1\x97\xfa*\xb0O\xcb$\xea`\xd4\xdb\x04H\xbf\x1bA5Zݜ\x17ϊ\"\x8b\x86\xb3\x82w,\xc0n\xa1Ʒ\xd7@\x11jKL\x13\x9b!\xf7\xf5\x1b\x9f\xda\x14\xb5\xae\xa4\xb8\xe3%ɗ+T{娩u\xfd(0C\xe5b\xd5r\x0e\xed\xd8\r\x87\xf0\x18\x81_\xedBT\\\xf4!\x93l\x0e\x8c\xc8\xef\xab_\xfal\xa6'\x94Ua\xaa\x16QV\xdf\xe9\xac@\xf9\xbe\x95\x0e?\xf0\x11\\V\\K>B7\xb5A\xa3\xf1\xe2\xe3%2C~<\xd4u\xa1\x9e\xd1\u05cb\x18PzF\xbf\x89\x84\x8b\x8f\x97R\x88\xd5]0j\x89\xaa\xa0\xb9ڲ\xce\xe6\n\xfb\x15\xae֠\xb4\xbcF\xcaVjp\xc1\x15\\\xcd**\x87W\x99H\xe3\xba\xfc~h\xa8\xee\xaa\xd4\xfa\xda\xef\x15\xe2\x7f'\f0\x85[Xd\x88W\xfcn\xc0)r|\xf1wuGk\xda}E\xf1W\xd7eo\xb7\x95*\x8b\xc8\xe7\xd8p\xeb\xab\xc1\xe2\xa4+rJO+,|^\x80Υ\xec\x8e\xed,\ró\xb4\x1f\xa5]I\xf7\xa07\xd8ĭ\xb3ؘ`\xe5\xa5c \xb6c\xbb\xf3N\xc3\x1b\x05\xea\f؝\xdb+\xdb]\xb4\xc3,\xc0\xce\x02\x18\xed^\x9b>\xa1\x97\xc9Я\xb2\xeb:z?\xda\x03A\x99+\xba\xf9ѣJB\xa7\xf1\xa1\xb7\xc5C\xb2\xefʰ\x90\xa2\xd8]\xe5\xe1\x1cx\xbeF 0\xff~\x12\x81\xdd\xf9\x9bD\x02\xa5\x00\xe5\xc1X\\HI\xc1\xaf\xdfM&\x82\x8c\a\xdbʄ\xb7A(<\x84\xean\x80ړ\x90\xff\xc1\xf2\xf3U\xb0f\x85\x0e\xb9\xf7\x93\x9da\x90\xad\x93\x1c\xb4\x1dE\xae\xdf.?W\xc1\x14\xa3}\xb8\xdeqUh\xe4\xad6\x89E\xad\xf2%\x8bD\xfa\xca\xd9xͺ\xe1\x19'Q\xb1\xd8e\xa0\x0f9\xeb\xd3\xd7\xc0\xbc\xee\\]\"\x1b\r\xc5<_6\x8bd\x17ϰ\x92B>\xd7/\x029T\x93;\xcd\xcb\xe9\xd2/Oz8\xd8\x03\xaaŝ_t/\xf7|z\x9d\xacSE\xe7N\x82\xab$*f!WbC\xc5V\xce0Ȫs\xa5n\x8anI\xae<\xb2\aRE\xc8\x0e\x81\xd7\bTk\xc0\xe1Bt\xaf\x9d\xd4\xddNw_\xe7|y\x05\\\xe2\xfew^!\x99\xed\x97_\x9d\x8a{(\xee\a\n\xf0\x9fD\x8c7O\xbb\xc8}.nZϒ¿\xcf-\x1e\xad\x9d_\x19\x04\x89Q\xf5\xa5$\xec\xa0V__fU\xa2dT\xdfD\x99\x04E\x16\xcd\xfd\x8b\xae\xe39\x9e\x13\xba\x8b'ޗ\xfb\xdd~W}\b\xc5Yvl\xe9\x17\bӰ\xbd\xdb\xeb{\xd2f\xb6\b[\xc7ڣ\x1f\"\xe8\x85c\xd9\x18z\xe37,\xd96\xf6ɖ\vP]\x82k}Ѭ\xa9\x9b\u05eb\x19\x9a\xfeb\xbd:\xb7\x9c\x06$\xfa\xec\xb5WJ\xc8J\x96ZX\xce\xfa\x89\xca۫\xb0\xbaS\xf6\x8f\xbd\xaaD\xa14Ť\x1a\xa6\xa7\xbd\xa2֎]\xad\rT2\xa0\xc7ǦYZ\xa4\xb0L\x92\x9dJ\xf1=䪺\xac7\xb1\x97I\xe3\x02\xb6zI\\n\xce\b\x11\xb7K\xc6\xed\xf9f\x1b\xcb5yU\v5\xedyF\"\x0fG\a\xbe\xf4g\xde\x1a3=\x88\xd5U1Y\xccלvK\xc7߁\x89\xf5B~^\xfam\x19\xb0\xfbՖ\xb7\xb7\xb7j\xd4\xdaK\f\xb8\xdd\xf7\x1c\xd5U\xfdnu\xd1l\xe2lXo\xd2\x11U\x15\xb7\x8b\xa8\x9fT)\xba\xb4\x97mT\xd4@5\xaa\xa2\x95\x160\xbd\x8a\xe0\xae&γ\x8c\akl\x1cȾ\x9f\x91\x13\x00\xe2\xdfc\xc9T\xdds7\xcc\xe5\xffk\x06\xf2\xbf\xd06\xdet\xe2\xf2/X~\xfe۱\xa8\x1e\xfe\xac\x91+e\xb8\xa6u\xd6τ\x17\xd7ih\x9a\x1a\x16\xcd~Vߢ+Ԗ6\xe16bէ\xddf{Xͬ\x17T\xe1\xa5\f\x1e\xe5XW\x96=@\xdc\xf7\xdb$\xae\x04\x9f\nH\x95ȩE\xec\x85.\xefT\x81!}\xf5*聝\x95\x9cO6\\\x9b\xfc\x17nQ\x97o\xea!vYa\x19\xc3Rϔi\x96K,\x14\x8dn\x98\xb9k\xcc݄\x90\x18f \xa4-o\x99\xa6v\xae\xa5\xc9\x06\x9f\xb7\xb7m\xf1\x8c\x88\x0e\xc7\x1aƸ\xb3\xd2q\xbb\xbdc\xdb\xc4պ\xb7\xbd*;\xa2ܽ^\xdec\xc3\xfb\xce\xdb@#tr\xfe>N\x8bu\x9bA%\xd4=\xb7\x84\b\xc1\x14\xaa\xf9}\xf7\n\xeb\xbbD-g\xd7\xf0\xabzz\xedz]X\x82ShУ\x95\xbdŢ\xdf\\\xbe\xff\v\u05cf\xe4\xf3\x8d\xbb\r\x88W|\xac]X\xfe\xb6\xe5ϟ\xb7\xb6\xa1\xbb\x99j \x8e6/o\xd4Za\xb4qQS\xc2fM\xa0VfE\t\v\xbf\fݬ/x\xba\xfa\xc3\x16\x1bRe\xdeqԾ\x9e\xc5E\xb4f\xbcb\xfe\xfdF\xea\x04Q\xdfu\x1c\xfc\xf1\xdb\xffm\x1bek\xfcC\xa8\x88\xbaq\v\x9e\xb1S\x19\xf9\x94>\xe4\x9d\xea\xf7X\xb4C\x89\xf6\x80\xfeև\t\xa6k7o\xf1\xdb\xfd\x9d\x8f\xb17^ٕ\x1b\x82\xf4\xb0\xafh$y\x8d҇-\x0e\xc3\xd1i#<\xc4\xeb\xad\x12̨^\x1f\x92s\xf0\xfe\x92\x97(\xc19\x02\n\x06\xf3m\nvU\xc1\xc5]j\\h5.\xeeR\xe3BԸ\x95\x0f\x84\xe8魬u\xd2\xe6ߒ\x9c,W\xbfن\x82\xae\xd4q^Tb\xf9]\x12-I\xee[\xd7E1\xed\x1f\x1c|\xfa\xf4\xc9\xfdt\xe8\xa6\xd9\xd5A\xcf\xf3\xbc\x03\bڭ\xa6\x81f\xf7\xec7\xe7\x9d$'{U\xcd\x0e\xe4\x03e\xf8W\xdf\xc8\xf89\xb2ra3\xbf\x97\xdfu\xea\x06ki\xf7\x97\xf2g_\xfe\x90^\xd6\x17\x97\xceM\x94\xf7K\x03\xec\x06u\xdcZt\x8da\x03\x9d2\xec\x8f\x18\xbd\x17\x97\x83\xb7x\xeaM\x9b\x0e:ʶczU\xda7\b\xc0\x93v1ұR\xfc\xad\xbduLyh\xcd\xe2Z\xdb\xd0\x02\xb9\xbd\xa4\xbfk\xb5\x84\xbaְNO\b1\x92\xca\xc2\x14\xa5U\xdd_\xc6\xd4 Fi\xa6\xcf\xc0B\x97P\xe5\xd5\xf0\x91D_\x1c,\xd2Y\x01\x03\x8f~QA\xa1>u\x1f=\xa5\xbeGq\x9a\xf0\x0eA\x93\x06\xdadU\xc8\xc0\x98\x02\x8a\xb8YE\xa8\xfb\xef!\xfc%^\x9a\x11\xfd_\xe9\x90\xfaJs\x9b@1\xf5Շ\xe4IC0\\(\xcb*\x0f}Kp6N\x85\xfe`;8[\xee\xa8,\x8cFT\rK\xbcn\x9d\xdb<'\x19\xa9w\x9e\x9a~\x17\xa1\xabH\x8d\xbc\xf6\\\xb9\x81\x1e\xe5}\x02\x84\x00\xfd\xf6\xda\xcb\n$Z\xbel\x10\xbc\xb0\xae~\x80\x1c\xad\xb6\xbf%e8-lX\xb9H\x90\xfb\xfb6\xfc\x01k\x96\x9a\x81s\xef5ˡ\xe9\xc4{\x8f\x15\xcc_n\x15\x1b\xf6\xb5\xc4\x04\xf9\"-\xf2-7\xb7~\x8fUV\xa3\xfa\x98\xff\x9f]hU\xe2\"\xff\x96u\x17Ɵ\x90\f\xad.\u0080{\xfb\xb8\xf8\xaa\xee\x1e5/\u05ecO\xd7Q\xc1\xadzveo\xa8\x05ɦ]\x1f\xf3j\x92\xdc=#6`h\xbe\xad\x06\xe3\xef\xb3\x05\xfa\x1b\x9da\xff\x95\xfb\x8d\xbf\xdbֳ\xfe\xc6\x05\xf2\xbf\x92\x06J\xa0\xb3\xed4u>I\xd3\xe2\xba\xe9\"\x8b\xcc\xf9]/\xb2\xb4\xbf\x10\x14\\]e\xfc\n\xb5&\x85q\xea\x9a\x17隖\xd0\xeeu\x9a~\xa4\x99Y\xbc\x85+6\xf2d\xa3(\xc0\x8c\xbc\x1e\xde?\xf6\x9c1\xf9V\xaa\x13Z^\xb9\x02\xdd|\xf5\xce\fsl\xbc\x8b\xd1\x14\\\xb8v\xa5\x0e\xee\xd1u\xf1\xfe\\\xd7\xf3\xda/\xc7aa\xf0\xee\x04\x06\xa8{rv[\x84>\x9b|5\x11\xba\xf6\x92I4\xa6_OT\x1cA\xfcd\xe9\xac`\xe9\x98ވ\xee3k\x0fSW\xcd]\x80y\xc6\xcd\"Y\xbb\xa31\xb0z\x8c,YTC\xa7\x82\xad\xe2J\x81\x1c@\x04\x8c\xb0\xe0/.\aᚋ%/\xd41uY\xee\\\xe5@\xe9O\xd7Q\xcc5h\xb9?A\x85n\xae~\xf2='\xb8\xb9\xfaY\xf8\x9f\xa0\xa7\x91\xaf\x81\x8b\x88\xe3\x9e\xd3\xd8\x02{\x80\x05\x1a\xef\xb5\x00\xf2=?t\xe7\a\x04C\x15\x0f\xa02H^\x18\xc9+{\xd0\xd4\x02!\xc4\xf3> s\x16\xf0\xe7畽2\x99D@M\xc5+Q\x18\x04\xaf\xcd\xd2[\xaa\x05u\x1f\xac\xae\x17T\xd6v\xc1\x8f[_Ī\x8e\xe2`\\\xf0\xcc\x18\xc6\xeaʚ\xe5\x8c\x1b\x84\xec\xe1\x9a\xc7\n\fA\xdb,R\xe5\xefƾ\x85SL\xdf[i\xcb\xee\x90X\xb9\xe1\xbe2u\x8d\xceBJiQ\xa9hF4\xbf\x9c\x02\xf59\xd3 \xe6E\xc1\x1d\xba\xbb\x98\xdbK\xf1\xc3\x17\x7fK\x0f?\x01\xe9\x1b7\xfd\xf0\xba\xbd\xfb=eu\x04\x88z\xbbi¿\nr\xee\v_~\x81\xb1L7\x02\xb0\x7f\xa9=餞\x9b\xb6\xdd+\n\xceڱ\x0f (f\xbf\x8aC=\x9a_\xfa\xd9\xd5+SyF}]~\xd8o\x82R\x0f\xb8\xbd\xd3\x12;:\x84-\xa3\xd8=z$j\x83\x8f\xf2Z£Gd\xbcE9\xfe\x15\xbb\x05\xcbf\x91\xa1\xc8\xec24\xc3˂O(Im\x90;\"\xb9_)R^\x92\x80o\x94\x95Ƨn,\x89\xd6V\vz\x80\xb5VX\x8c\x1e)(\x7f\n\x9fMѓ\x94\xd6Q\x15\x88x>\xd8\\\xf5\xeb\xf6\xf6\x02o\x1d\xaa\x04\xa1\x90|\xdf\xf7D\xf00J\x10\x1fW\xbc\xc0\xa0y\x1d\xfb\xa9'nbx\x97n3w`\r*\xa2焺2\x83tw\xee,\xfa8\x82T\x88\xe8&\x12\x9a\x8a\x1b\xf2\xb9\xdfQ\x9f\xb2\x87oo=[ 'v\xc8\xd0\xf8\xf6\xda7\x19dL\x02L\x03\xf0\xaf\x16\x18\x91\xbb\xe4\x9f\xe8O\xd0\n\x95\x818\x97NO\x10i\xb0§\x8a\xc3\x11]\xe5\xaa\xf1\n+X\xaa\xa7\xfc\xb4\xfa\xa9jS\xc8H\x1f\xc2\x7f\xb4\xfd&\x19\x00\xaaID\xeb\\\x9c\xdf\xde\xd2\xf3\x02\xbb\r\f\xec\xda{\x06\x97\x9dE\x9f|\xb7$/\xc0\x1f\xab<\ardk\xeb7w\x99\x10\x91G\x8ftY1\xbfD\x7f\x7fِ\x067uK^\xf4+\xec2=\xd14a%?4\xd1\xdb\xe0qF\x03\fK\xe9tE\x97\xe2v\x8b\xd6\xfa\xb2\x92\xe8\xc6^*6(=\x14݀6Sŀ=e\x91B+A\xeas儳ɴj?\xe2 \\J(S\xa4\x1d\xbd\xba~\x8d\x00\xe4\a\xecV\xaf\xd6\xf68Ek\xfd\x04\xe2\xe2/\xe9j\\\xa9((\xbe\xa2\xeaM\f\x1b\x84\x18K9i\x19\xcd\x02eˀ\xc6\x1f\v\x9c\x17\x11\x00I%0Q\xb0:e:q\x1a\x84\rs9\x167\xf4vCWԀVzI\xe0e\xb5;\x8cL\x05\x8dd\xda\xcb\xf2\xf7ZM\xff\xbb\xea\x131O\xacj&\x81\xfb+\xcf\xd2o\xe0Ndm9Z\x85\x84E\xbf\xc8t<\xdd\x04\xd1\xf2\xabX\xe8\x02$J\xc9\\\xad\x86\xd7\xef\xd9k\xa7~\xb4\xf5)\x8c눢\xb8N\x829,\xc6\xe3E\ao\xa4#3[\x0fä\xd5k\xdb\xcb2\x9am\xad\xf8\x9a=\"\x18\xbav+~t2\x85ö\xb0͂\xc3\xf3J\x8crK\xc0\x0f\xfd9\x98u\xc2\u070f\x1c\xcf\x01\x1d\xe9,\xfa\xc0'\xda\xe3\x85@\xb3[\xd9w.\xc6\xea~1\xcb\x02\xf1\x92\xc6\xda\xfcM{\xbcT\xe6\xb7Z\x81\xa5\xc1\xd1l\\mxfϠX\x1e1\xa8G\xf7tT\x96\xbd\xaa\xd75\t\xe60k~O\x81.\x7f[e\x06.\xf9\xd2߿\xa7}\xdbh\x8c6\xf1_i\x92\xedfJ\xa1hF\xb3,\xe3I\xe9LS\x81\x1a\xe8@/\x95iR\x81\xd2^8\xd4\xf1y\r\xa5=\xe2\xb5ٙ\x8d]\xfc'\xda\u0603*\xe9{~\xd7HC\xda\xf7\xfc\xeeʴ\x1b\xc5Ý\x06\xd9\xfbU\\v\xdd8\xaaFr4\x10\x98O,?zT\xc5\xf7\xa4^D\xb7b\xb6\xa0\b_l\xea҃M۶|K\xcbp\x96\xd0\xed\xaa\xe5\xbc\xdf\xe9D\xfb݆\xc67Z\x86\x9e\x13\xf5#\xcd)\xa8\xc1H\x16'9\xd2V\xd6\\A\x9c\xf9\xf6=\xa8\xa6\xdb*ϵ[\xc74%U1>m\xe2;\xa6\x85Y:%\xe7\x1b \\~\xd5Ni72O\xed\x13u\xd53Wz\xaf\xf9ݍF\xb2\xd1Z\x98o>\xfb\xeb\xdf\x1f\xf2/\x9fF\x89{]L\xe2?\xb0\x0e\xaf\xeby''G\x9fy\xf4\xaf\xf2\xb7wxtr\xfcY\xb7wxr\xdc;\xecu\x0f\xbb\x9fy\xddn\xb7{\xf2\x19\xf3\xfe\f\x06\xcc\xf2\"\xc8>\xf3~s]\xd5\xc6\xfd\x87\xfc{\x92\x8f\xb2hZ<}p\xb0\xbb\xfb\x80\xed\xb2\xe7\xe9t\x81\a\u05ec3\xb2Y\xcf\xebv\xf7{^\xf7\x88}\xc3\xe3hξM\x82<\a\xb0Wш'9\x0f\x19h\x9b\x8c\x15ל\xbd~\xf9\x8eŔ\xfc\x80\xed\x1e<(\xd5C\x96\xa6\x85#Ocm\xb6|\xf0\x80\xb1\x03\xa8j2I\x93\xbf\x9f\x030cјɩ\x84ϧiV\xe4\xcc\xf7\xd9\x0eM\x92;6c\x934\x9c\xc5\xdcU\x99\x12a\xc7\x16\xf8\x9e\xbd~!\x80\b\xa3\xd8JQhI-\"VI\xda\x0e{\xf4H\xa4\xbb\xc1$\xb4\xc5\uf3a4U`\xfe*K?\xe5<cWq:\fb\r;\xb4\xcc=\x9fb\xdcL\x83\xa2\xd5\x03T\xbb\x0e+\xd5\x1b[>`̚\xe5\x9c\xe5E\x16\x8d\nk\x00\xd81\xb6y\xc6\xc7ќC\x9b.v>\xf1\xe1Ǩ\xd8q\xd8\xce\xeb\xf4W\xf83\xc9\xe1\xbfow.\x81\x92\x7f\xf0$L\xb5\x12H\nc\x0e\v\x92h\x82&\"`Y\xae\x90\x1f2\x89e\xb3\x98\xe7\xec#_\xf0\x90\r\x17\xd0[Q\x86[ge\xf9YΟ\xe7\xf9\xb3\x12\xcb\xc1.\xfb\xe75\x87s\"V\xa4\x90͞\x9f\x9f\xebդ\x19+ߛ\x02Dȫ]\xc0\xb7\xcb~,\xa28*\x16\xaa\xf9\x80\x83ly\x19\x9e/w\xd9\xcb1KRV\x04WDK\x94\xb3\xab\xe8\x86'\x0e\xa1\b؋\x97\xff\x80D*\x17\xba\xec-ZWA\x1c/ؔ\x02\xb5D<g\xa3 aCΦA\x9e\xf3\xd0Ų\xd0(U\xb3\xf4\xb4\xeb\x14\xc1\x95\x83\x05\xa9+\x88\xf7<f>k\t\x9a\n\x94\xdd\u07b2\x9d0\xbaٱ\xb1\x04p*y\x80?\xc1PIX\x94\b\x8c<\xbeH.\x99\x8f_\x17\xc9%\x82\x88\xf9\x8c\xc7\x0f\x18[\x19\xecy\x86\xe7\xb49\x1b\x81__\xc6\x13\x16$\xa1\x00\xcfq0\xd1\xe3\x9a\r\xad\x89\x92\xbcC\x99\xd0CX\xbe\xeb\xd0ߞ\xc3\\\xd7\xdd=\x90\xcd\x1b\xa7\x19\x13\xb6T\xd7a\x18\x1eoF\x9c\x17&1\x8b\x9e$\x03\x06\xbbC\xa2q\xa2V\xdd\xe7P\x15\x82\xfb|\x0f\xf4f\x11p\xadi/\xf1b\x0f\vp\r\x83'\xbe\xf95\xe7\x05+Rv\x9d\xc6!\xb6\xee\xff\xf9\xc8\x17\xe3\f\xfa<\xcd\xd8?^\xbf\"\x01-[\vDS)\x9fu*#H\xeb6ճ;XώÖ0\xd4Y\x9f\xed\x14|^\x1c\x8c\xf2|gEm\x03\xb6\xa9^n|kp\xe7\x9a\a\xe1\x0e\xdc\xc6v\x18\x8fm\xb3\x03]\xa2\xe6\xf6\x16\x7f\xe316$@\xe3;\xb6m\xb4\xff9\x12\x95\xb3 a\xe94\x18\xc1 P\xad\r\x8c\x11itzT\xe48\n\x88\t\xec<JF\x9cMҼ`\x93t\x18Ŝ\xfd\x13\xf5Bή\x83\x1bΊh\x12%W,\xca\xf3\x19\xcf٧\xa8\xb8.q\xef\x87<\x0e\x16b\x10}\xe2r\xd8\xe5|\x1ad\xf0\x83\x94\x01\b\a<\xaf\x8a\x81\x92\x0fr~5i\x967\x8c\xa2+0w\x82xz\x1d8\xacȂ(vX\xe4`\xe1\\\xef\x17\x1cȠ\xc6D\xdbw\x14\xf4\xff\xfe/\x15\xdf\xedz\x9e]\x16\xbe\xa4\xeb\xdd;\xfb\xda\b\x83\x19\x19\xba\xdes\xbd.\xdbc\xd1\x01B\xb2]\xd6\xf5<\x05\xf4+\xf3\x99\xdcj\xe9t\xd9>\xebt\xf7\xb1\x02\x9b\x1dP\x9dl\x97u\xba\x9e\xb7\x8f\xe8l\x87Q\xaeB@:\x94\xf95\xd5\xe7\xe6\xb3aN\xaf\x95yu\xc5\xe8\xe2>\xf1\xdbqgG\xa5\xedض[\xa4\xaf\xd2O<{\x1e@\xb4j\xbd\x0e\xe6˚\x1e=b;\xfb;lO~\xef\xe1'(\x98\x1d\x1aX0W=,5\xec\x05\xbd\xc4,\xb8\xcbhD\x88\xb7G\x7f\x98ż#\xd2\x19\xdb\xf9\x7f\x04Z\xc0)\xa5-g\x90\x88\x1d\xb2\xc7v\x96;l\xaf\x84\xf7\xbeX\x8a\x0e\xea\x03Я\x00\xb1\xd2!\xa8\v\xf6؎\t\x88,\xac\x01w\x10z\x0f\xba\xcb6\xcbt\x9b\xe0\xb0sl\xf6\x05t\xe7\xb6U\xect\xbd\x8d4\xef\xacv\x1c\xc1\xa4Q\x9e\xff\x80*El\x11<\x10@U\xe62\x9fu1ke\xa86ȫ)\xb6w\xb8\xf3r\x13dQ:\xcb\xd9Me\"\xae*p\f\v\xc9\xf2\xd9\x14\xac\x15\x1e\xca\tk\xd10\xc4\bU\x87\xc7\xf5\xd9\t&s\xa9qJ\xa1\x9a\xaa\x9f\x11\x91\r\xc5\xc4\xdc㎮\x83\xecY\xd1\xf1\xf0ٽ\xe9T\x8a$\xcaG:\x15\xd1C\xba\xb6\x9a\xc3`\xab\x93EOdCʹao\xaf\x14\xbd\xe9T\x891\xber\xba\a\xb8D\x1e\xb8R\\L\xa7\x97\xec\xa1\xef\xb3r\U00069989\xa9`p\t\x9b\xa5\xedЄ\xd7\xe4\xfc9/r\x86n\xbaӘӜ\xa2\x1b\x00A\xc1\xd2dě\xa6\xfe<\xaf\xb1U͊\xe5\xe4-\x1a\"\x19}\xa1uHb\xdf\xde\x1a\xf3\xfa\x86\x89\x1d\xf6\xa8s@,\x9cMEx\xe1\x06\xda&<\xbb\xe2\x9dt\xf8\xa1i\xba\x1e\xb0\x88=a\r\x13\xb6\xde)\"\x90\x0e\xf3\x99>I\x8bL\xb3\x99!\x1f\xdbj\x9c\x80\x9aI\x87\x1f\xd0^1;A\xa6\x02\xbc4bVz\x8b\xd3\xe1\x87Z\x93\x7fФ>\x18\xe6i<+\xc0~\xb9\xe2\xfb\xf4\x96\x1e\xc3\xcd!N\xb6\x9d\xb4\xfe\x1a\x182M\xa1\xb3t\xe9O\xc1\x94e\xf3>\x8fų|\xaf\xf8\xb8pآLx\x97N\x05\x81\xb4\xd5\xd1A\xbb@e\x7f\x8f&\x8a-\x9b\x9e\xba\xf3=\xbf\x82,u\x17Zڻtj\xf4p\xba\xb6\xb50-1<Bb\xe3,\x9dh\xad\xa4郥\x19\vpo\xaf\xdeZ|\xcf\x1bN\xa4\xb1\xbcâp.\x9b.\xea\x16\xab\x16\xc2\x0f\x8b\x16B\xbaþ\x14i}\xfa{\x11\x85s\xf6\x05\xfd\x16\x82r\xa9\xc8>`_͢\xb8\xd8/%2\x97\xab\x0e\xf9\xcd|Q/β}\xd6\xed9L\xfbwp\xc0\xde]s\x96`T!\xe8K\x04cE\xca\xc2,\xf8D\x05\xe9t\x92\x9d6\x15\xa4L(\xa8\xcc\r\xea0twf\xc7\x0ek*\x04\x9c-\xae\xa3\xd1Ǆ\xe79q%\b\xa3\x19\x90\xe79uxʔ\x92F\xcb1\U0008b972i\x11\x14\xbcϼ*\x81?@\x06t\au?\x02\x8f\xd2\fߖa]\xa7\n<KB\xa0\x87u<\xd7\x15*\x94\x0e\x13\xd9\xce\xe7\x9e\xe7\xed8\x1a\xf4\xe7\xd9\xd5\x10\x04\xe0\xf3,\xbb\xba\x1a\x0e\x118\x8c2z\xfe\xdd@~p\xc0\xba}6\x8a\xd3\xd1\xc7OQ\xce\x1d\xb6\x0f\x9f\xe9,)x\xa6R\xb1|>\xe5<\xac\x10V\x92\x96\xb3)\xcfX\xceGi\x12\"8N\xb4\xc0\xb1*˞\x8d\v\x9e]\xc5\xe9'(1\xe2I\x11\\Q\x05r\x82e݃#\x83\xc0\xb7\x94!9\x8c2@Zk\x9a\xf7Y\xcfd,\x96\xf8\x86l\x90\x92$\xf6\xe9\x9a'l\x96\xc3\xc0\xd0\x1e*&6\xfeJ\x9b\x81\xac\xc7\x1f;\x06\x9a\x1fs\xce\x02\x06\x0f0\xb0_\xf7\xd1\xf4\x82\x15\xac\x90]\xea\x01ys\xba\xcfvrZ\x8a\xef8P\x14֫\x98Ɋ\x94\x05y\x1e]\xe12\x14\xe8\x17Z\x88\xb8\x94N\xfbl\xe7\xd8\xfbb\xa7¥\x11zղ\x1b\x98f\xc0Wy!\x84}\\\xd4\xe1K\xf0\xeb4\x8b~M\x93B\x15\x98J\xffJ\xb6#\xd5\xe3\x0e\x16\x10D(\x00MנP\x8f\xd2$/\xb2\x19l)T\x94\x87\xd8q\xe8\xa4Re\xd0[IS\x1c\xccbb\x01\xc3r\xb9r$\xac\x8a\x97騁okZ\xe2[\xdaِY\xac\xb8\x86Y\xf5\x86gY\x14rd\xd9P葼\xff\x80Ր\xe2\x9e\x03\xa0\xa2\xcae\xb6\xf2\xf9vh\xd3G\xe9Q\\\x03\x87!\xa9Q\xd1i\xb2oH\x83\xd2\v\xb0j\xba\x80\xbd\x02h$\x8b\x12zѐE9\v\xe2\x8c\a\xe1B\"D<Qr尨\xc0\xecY\x91N\x02\xd1w\x8c\x1c\x84C\xd2\xd4Q\x91\x839s\x83\xa6\x9c\xa8j\xc8\x000J\xae\x14\xbe\"\x9dvl\nރ\xbb\x0e\xae\xc89\x10\x831J\xfa\xe5\xfe\x0ea)gg\xe1)\x0e(\x1eh\x136x\xe51\x1fs\x1fH\xe1qp\xa6\x83\x1c\xecC-\x9d\xc72\x03\x7f\x81=\xa3\x16\xbd\x9eÖ\x9a\xec\xa7e\b\x81\x95\xed\xb0e)v\xa9+\x7f;R\xebz\x8e\x1aq\xa9K\xbfV\xb6V\xef$\n\x99\xcfR\x97\xf4\xea^*敽\x94\x9e_\x90\r\x92\xf6\xd5R\x15\xa5ёb\xe4oG\xa5\xe2\x10K\xddBٌ+\xc5\x13ܟ\xab\xb0\x8e\x89.\x11k\x9d\xaf\xf88\xcd8V$\xd2Ѹ\xc6\xfd\x89\xdb[\x8c\x94.\xd1>\xd0L9\u074b\x7f'Kq\x7f`g\x9a\xa5W\x19\xcf\xf3a\x90\xa9\x05g\xf9n\v֡\xfa\xc1\xa0\xf1au\x1d\xa8\x93{p\xc0ޤ\xe6\xfe\x984\xfdq\x01i\xe8;\x14a\x1e\x84\xaa4\xda|\xb0\xdcU)\xfa\"\xb8\x93\x12ml\x9fum\\Ҳ}\x96\xbaj*\x81\xe5n\xcf(\x89+(#e<ͱ;\xc7\xd3\xdcL\x87=\xcbi~\x90\xba8\xb3\x18yi^p\xb0\xfa;\xdd\xfd\xd4\x15\xf3\x02\xd4\xd5\x19\xef\xa6.\xad\xaf\x0f`j\xb1ͺE\xa9\xf1\x81 \xfb\x81\xca\x1et\xcaM\x85$\x9att\x062\xb0l\aڧ2_?\x00c\x06\xec\x03{\xc2\x04\xc6\x01\xfb\xa0[\xc1\xf4\x0f\xdb\\\xdb\x11\x88؞ο\x0f\xc0?\xa4\x10V\x9fc\xb6K\x8dtX\xd9\xc0\a\x06V!\n\x98\x85\xb2\xf1\x81\xed\xea\xbcg{\xd4M\x82\xe7\x0eKun\xac\x1eT0\x15$\x02ڐ~\xf4H\x17\r`\vn\x94t\xe1\xde\xf0x\x9a\xdb%\xba\x95\xdd)\xa5\x9c1\xcdN\x04\\\x98\xb2rj:\xf6\xbcH\xa7rm\n\xea\x8f\x14\xaeTϦ:\xc3AZ\xdblӶۄg\xbf6(Js\x9d&b\x1ed\xb2-\xea\\4\x9d\x15\xe6҃\xc7.m\x1d\xc2\xd3s63>\xc55\x0e\xf9\xb8zYP\xd4ʹ\xe5J37\x94bm\xe0\xc6K\xa1\xc9Eh\"\x9a\xe4\xc0\x8aͅ\xd9\x18F7Q8\vb\xb2o\\\xf6\xcf(\x8eِ\xe3D\xf8)\x8b\x8a\x82'\x12W\x94\xe0\xc6\xe58\x88cx\xb2\v\x0e\x1e8\x1b\xf28\xfdd\xb2U\x18Պ\xaf E\xa9\xc9\\s\xec\xdfw\xe4\x83⺒\xe2+\xab\xc38\xf2r\x89\x91_\aa\xfaI\xef1\xc13cZ\xb1\x1dch5Y/\x8e\x96/&\x94Nu\x8a\xc0\x8d\xa0\xe9܀\xa58\x82}&@\x1a \xca\xd7\xcf\xc4\xe2\xc6\xc8L\xe7\xe7\u0604\xbeh\x8a\x9e\xa9n\\\xbc͢+\x98\x98w`\n\xdai\x04\xe9\xb3\x1dZ\x11t`#\xe9\x7f\xff\xb7sx\xe2I}\xb5\x1b\xed\xa5.\xe5b\x13B~eS\xc9X\x16P\xd3\"P\xcf\xf6v\x1c\xcf6\x1b\x81\xd7j~\x10\xeb\x95N\xea\x8a\x15\x05ە-\x7f\xfa\xb4+\xf9\xa3\r\xf0\xea$\x86:\x90v\x02\x94\xea\x8bL\u0557\xf3+\xe6\xff\xa6\xfe\xc3Q\xdf\xdd\xfbߎ \xed\xa0\xd7\xd4s\x1a\xebR\xf7\xfaS0\x1a\xf1\x98}\xc9v\x14c\x0e\xc3\x0e\x84\xfe\xf4\xec\x1d\xd8}7ʪ5\x85Ҳz\xae\x9a0\xfb\xf5#\xa8G\x8f\xcc\xed\xe7\x12\x01\x13S\x90\xa36*#S3;\x92e\xd8\x1a\xdc\t\xed\xcai\x0eRr\x1c\x98\xe8\xa5<\x8e\x92\xa8\xe0FG\x98[%\xae\x1c8p\x88\x90\xf3+\a\x19\x8e#K.\xfbv<汣\xe9\x1c+\xa2D\xe8\adn\x0f\xe5d\xa5\xe9r\xc0\x03z@\xe1C\\jG u垀-0w\xa7s\x96]\r\x03\xe2\xb1\xe3v\xed\x1d\xdbn\x99\x0ex|'\xf5\x17\x84\x1ffyA\n0-\x17y\x01\x83uZL+=\xa9њ\xf4\xe1֊P\x89\x81\xa1\n#\av\xc8J\x91\x06\x86\x83\xc0\xf3\xd8\xc53\xad7\x18]M\xec⚩\xe0\xa9HσI\xba}\xc0Un\xe7BG\x9aGgQ\xf1\x8fׯ:v\xb9\x18YwN\x19`\xbb\x8a\xe0J\xb6@\xc1\xdcL\xc4abP\x14YI\xbbԦ\xeaT\xea\tH\x03 \x00\x11\x9cOb\b\x122˒~>\xba\xe6\x93 ߟD\xa3,\xcd\xd31\xbe\xd8ڿ\x99\xc4\x16\xadW}\v\xd6\x16\xfb\x90\xf0tG\xd4\xf2@S\r\xa5\xb9\xa9\xc6eΆ\xb3\x02\tVvg\x10\x86,@(<l\x1a\x8b\xa36y\xfc\xda\x7fP\x9e)\x04a\x88\a\n;\xae\xac\x18dzHo#f\xfdY\x16w>\x17K\xbd\xcf\xff\xf1\xfa\x95\xbd#\x86Hm\x9d'&,\x7f\xed|\x97\xa1-ZYQh\xd3\x18\xf3Yo7\xabMeWٴ\xd32y\xa94\x86}\xb3\x83\x01\rv\x9c\x8a\x8d8J\xd3,\xcc\xc1e\x94\xe5J+\xe4N\x1d&\x15S\xc8~\xa6\xc0\xf63ݰ\xb3\xf5BK9\a掚\xe1r\xcd\xf8\xab\xe9u\x8a\x98\x9c]E\t\xf3پT\xbcj\x91e\xef\xf6\xaas\x83ä\x9aG&\x18\xab;M\xad\x932'ԎX\x8aї\xb1\xb8\x8bj\xac\xcd\xf9U'rX8GUT\xf0L\xe73h\xa9+\xbd\xc1\x90\xa0Ӓ\x89M\xb4>;<\xf1\u0601Լ`$\x89\x19tGR\xf3\xbf\xff\x1b\xceM\xee\x95\xe8\xb0\xe7p\xee\a\x1d\x0e\xbd\x17d#\xea.5\x85\xae\xecj\xa7j6H\xe6\xd4r*\x16G\x1d@\xaeWiF\xaf\xe7#G\xf7\xcbi\xbb\x0eA\f닿\x95\xecj[\x85|\x82\xbe\x87\x06\x8a\xcd\xc3f\xcd_\x9f7[\xb0\xd1ut\xc0\xa7\x8ax+\x1b\xb4\x04\xaa\a\xe1-@P\xacH\x19\x9c=b=l\x18s\x0e1+\xd8l\x9a\x96\xc7գk\xb8\xfdj\xd4d?h\xfa]\x93lc\xba|`\xac\xe5\"8jCc\xc67\xad\x19c\x91\x84b\xb8\xdf\x13\v\xf5(\xec\xbf\xf8\xe9\xe5$\xb8\xe2爛s_+\x9d\xf9U<\xcb:\xd3h\xcec\xea<\xbf\xe7L\x82\x8f\x9cj\xf7\xbb\x0e\xfd\x10\x8d\xf2\xddC\xa5\xb56QDtئV\x97s\xf6\x95\xa1\x89\xeb\n\xb0\x9c\x8b\x1a湚*\x1c\xd1iE\xb9\x9f!\xf2RT\x91\xd4\x020\x83䐺\xbde\x9e\xc6\xeb\x11\xe4E{){\xc2FM\xf3\xa5\xb66c\xbe\x01r\x11\xed\xa5\x97\x03J\x06$#\x8d\x86\x96d\xc3(\x1a\xd9l\xd40\xefJkd%\xb7\x16ɽ)\x1d\xf2\xaa\x99*t4\x8cg5ϰ\x9d\xfaLC\xd6\x18\xee\xc0\x88c9D\xe7\b\xdb\x13db\xc7\x06R1\xd9\r\xc2\x0fv9\xd1K7\xad\x9ae\xe9\xb3\n\xb2\xa0<\xcb\x7f\xf0@u\xba\xe8\xde\a\x0f\xe0\xf6ă'\a\xd2W\xee/\xb7ѿ\xfe\xfd\xf5\xef\xaf\x7f\xff\a\xfe\xfd\xff\a\x00K w\x97\x00P\x05\x00")
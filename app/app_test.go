@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"gnd.la/app"
 	"gnd.la/app/tester"
+	"gnd.la/metrics"
+	"gnd.la/trace"
 	"testing"
 	"time"
 )
@@ -48,3 +50,81 @@ func TestGoWait(t *testing.T) {
 	tt.Get("/wait", nil).Expect("43")
 	tt.Get("/nowait", nil).Expect("42")
 }
+
+func TestTrace(t *testing.T) {
+	exp := &trace.MemoryExporter{}
+	trace.SetExporter(exp)
+	defer trace.SetExporter(nil)
+	a := app.New()
+	a.HandleNamed("/", func(ctx *app.Context) {
+		work := ctx.StartSpan("work")
+		ctx.Cache().Set("k", 42, 0)
+		work.End()
+		ctx.Go(func(bg *app.Context) {
+			bg.StartSpan("bg work").End()
+		})
+		ctx.Wait()
+		ctx.WriteString(ctx.RequestID())
+	}, "index")
+	tt := tester.New(t, a)
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	tt.Get("/", nil).AddHeader(trace.RequestIDHeader, "abc").
+		AddHeader(trace.TraceparentHeader, "00-"+traceID+"-00f067aa0ba902b7-01").
+		Expect("abc").ExpectHeader(trace.RequestIDHeader, "abc")
+	spans := exp.Trace(traceID)
+	parents := make(map[string]string)
+	ids := make(map[string]string)
+	for _, v := range spans {
+		if v.RequestID != "abc" {
+			t.Errorf("expecting request id abc in span %s, got %q", v.Name, v.RequestID)
+		}
+		parents[v.Name] = v.ParentID
+		ids[v.Name] = v.SpanID
+	}
+	if len(spans) != 5 {
+		t.Fatalf("expecting 5 spans, got %v", spans)
+	}
+	expected := map[string]string{
+		"request":    "00f067aa0ba902b7",
+		"work":       ids["request"],
+		"cache.set":  ids["work"],
+		"background": ids["request"],
+		"bg work":    ids["background"],
+	}
+	for k, v := range expected {
+		if parents[k] != v {
+			t.Errorf("expecting %s parent to be %q, got %q", k, v, parents[k])
+		}
+	}
+	if h := spans[4].Attribute("handler"); h != "index" {
+		t.Errorf("expecting handler index, got %v", h)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	a := app.New()
+	a.HandleNamed("^/metrics-test/$", func(ctx *app.Context) {
+		if ctx.FormValue("fail") != "" {
+			ctx.NotFound("")
+			return
+		}
+		ctx.WriteString("ok")
+	}, "metrics-test")
+	requests := metrics.Default.Get("gondola_http_requests_total").(*metrics.Counter)
+	duration := metrics.Default.Get("gondola_http_request_duration_seconds").(*metrics.Histogram)
+	ok := requests.Value("metrics-test", "GET", "200")
+	notFound := requests.Value("metrics-test", "GET", "404")
+	count := duration.Count("metrics-test")
+	tt := tester.New(t, a)
+	tt.Get("/metrics-test/", nil).Expect("ok")
+	tt.Get("/metrics-test/", map[string]interface{}{"fail": 1}).Expect(404)
+	if v := requests.Value("metrics-test", "GET", "200"); v != ok+1 {
+		t.Errorf("expecting %v requests with status 200, got %v", ok+1, v)
+	}
+	if v := requests.Value("metrics-test", "GET", "404"); v != notFound+1 {
+		t.Errorf("expecting %v requests with status 404, got %v", notFound+1, v)
+	}
+	if v := duration.Count("metrics-test"); v != count+2 {
+		t.Errorf("expecting %v observed durations, got %v", count+2, v)
+	}
+}
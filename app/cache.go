@@ -2,6 +2,7 @@ package app
 
 import (
 	"gnd.la/cache"
+	"gnd.la/log"
 )
 
 // Cache is just a very thin wrapper around
@@ -15,3 +16,56 @@ type Cache struct {
 func (c *Cache) Close() error {
 	return nil
 }
+
+// fragmentCache implements template.FragmentCache using
+// the App cache. Keys are namespaced by the current language.
+type fragmentCache struct {
+	app *App
+}
+
+func (f *fragmentCache) key(context interface{}, key string) string {
+	if ctx, ok := context.(*Context); ok && ctx != nil {
+		if lang := ctx.Language(); lang != "" {
+			return key + ":" + lang
+		}
+	}
+	return key
+}
+
+// logger returns the logger for the given template context,
+// falling back to the App logger.
+func (f *fragmentCache) logger(context interface{}) log.Interface {
+	if ctx, ok := context.(*Context); ok && ctx != nil {
+		return ctx.Logger()
+	}
+	if f.app.Logger != nil {
+		return f.app.Logger
+	}
+	return nil
+}
+
+// Errors are logged rather than returned, since the fragment
+// is rendered anyway when it can't be retrieved or stored.
+func (f *fragmentCache) Get(context interface{}, key string) []byte {
+	c, err := f.app.Cache()
+	if err == nil {
+		var data []byte
+		if data, err = c.GetBytes(f.key(context, key)); err == nil {
+			return data
+		}
+	}
+	if l := f.logger(context); l != nil && err != cache.ErrNotFound {
+		l.Errorf("error getting template fragment %s: %s", key, err)
+	}
+	return nil
+}
+
+func (f *fragmentCache) Set(context interface{}, key string, data []byte, timeout int) {
+	c, err := f.app.Cache()
+	if err == nil {
+		err = c.SetBytes(f.key(context, key), data, timeout)
+	}
+	if l := f.logger(context); l != nil && err != nil {
+		l.Errorf("error storing template fragment %s: %s", key, err)
+	}
+}
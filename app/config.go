@@ -15,29 +15,59 @@ type Config struct {
 	// are not bundled and templates are recompiled each
 	// time they are loaded.
 	TemplateDebug bool `help:"Enable template debug mode. This disables asset bundling and template caching"`
+	// TemplateStreaming indicates if templates should stream
+	// their output, sending it to the client after the top
+	// assets and at every {{ flush }}, rather than when the
+	// whole page has been rendered. Streamed output is not
+	// minified.
+	TemplateStreaming bool `help:"Stream template output after the top assets and at every {{ flush }}"`
 	// Language indicates the language used for
 	// translating strings when there's no LanguageHandler
 	// or when it returns an empty string.
 	Language string `help:"Set the default language for translating strings"`
 	// Port indicates the port to listen on.
-	Port      int         `default:"8888" help:"Port to listen on"`
-	Database  *config.URL `help:"Default database to use, used by Context.Orm()"`
-	Cache     *config.URL `help:"Default cache, returned by Context.Cache()"`
+	Port     int         `default:"8888" max:"65535" help:"Port to listen on"`
+	Database *config.URL `help:"Default database to use, used by Context.Orm()"`
+	// Cache might be changed at runtime by reloading the configuration
+	// (see gnd.la/config.Reload). Apps open the new cache the next time
+	// it's requested, while the previous one is closed after a grace period.
+	Cache     *config.URL `reload:"true" help:"Default cache, returned by Context.Cache()"`
 	Blobstore *config.URL `help:"Default blobstore, returned by Context.Blobstore()"`
+	// MetricsPath indicates the path where the metrics (see
+	// gnd.la/metrics) are exposed using the Prometheus text format.
+	// Like the monitor pages, it's only available in debug mode or
+	// when profiling is enabled. Set it to an empty string to disable it.
+	MetricsPath string `default:"/_gondola_metrics" help:"Path for exposing the metrics in the Prometheus format, empty to disable it"`
 	// Secret indicates the secret associated with the app,
 	// which is used for signed cookies. It should be a
 	// random string with at least 32 characters.
 	// You can use gondola random-string to generate one.
-	Secret string `help:"Secret used for, among other things, hashing cookies"`
+	Secret string `secret:"true" help:"Secret used for, among other things, hashing cookies"`
+	// PreviousSecrets contains secrets which were previously
+	// used by the app. They're never used for signing, but
+	// values signed with them are still accepted, so the
+	// Secret can be rotated without logging out every user
+	// nor invalidating forms. Values signed with a previous
+	// secret are transparently re-signed when their cookie
+	// options are known (e.g. the signed in user cookie).
+	PreviousSecrets []string `secret:"true" help:"Previous secrets, still accepted when verifying signed values"`
 	// EncriptionKey is the encryption key for used by the
 	// app for, among other things, encrypted cookies. It should
 	// be a random string of 16 or 24 or 32 characters.
-	EncryptionKey string `help:"Key used for encryption (e.g. encrypted cookies)"`
+	EncryptionKey string `secret:"true" help:"Key used for encryption (e.g. encrypted cookies)"`
+	// PreviousEncryptionKeys contains encryption keys which
+	// were previously used by the app. Like PreviousSecrets, they're
+	// only used for decrypting values produced before rotating
+	// EncryptionKey. Note that values encrypted by versions of
+	// Gondola which didn't support key rotation are rejected once
+	// any previous encryption keys are configured.
+	PreviousEncryptionKeys []string `secret:"true" help:"Previous encryption keys, still accepted when decrypting values"`
 }
 
 var (
 	defaultConfig = Config{
-		Port: 8888,
+		Port:        8888,
+		MetricsPath: "/_gondola_metrics",
 	}
 )
 
@@ -19,9 +19,13 @@ import (
 	"gnd.la/internal"
 	"gnd.la/log"
 	"gnd.la/net/urlutil"
+	"gnd.la/trace"
+	"gnd.la/util/stringutil"
 	"gnd.la/util/types"
 )
 
+const requestIDLength = 20
+
 var (
 	// CookieSalt is the default salt used for signing
 	// cookies. For extra security, you might change this
@@ -49,6 +53,10 @@ type Context struct {
 	background      bool
 	wg              *sync.WaitGroup
 	values          map[string]interface{}
+	requestID       string
+	log             log.Interface
+	span            *trace.Span
+	spans           []*trace.Span
 }
 
 func (c *Context) reset() {
@@ -61,6 +69,10 @@ func (c *Context) reset() {
 	c.translations = nil
 	c.hasTranslations = false
 	c.values = nil
+	c.requestID = ""
+	c.log = nil
+	c.span = nil
+	c.spans = nil
 }
 
 // Count returns the number of elements captured
@@ -356,9 +368,15 @@ func (c *Context) Cookies() *cookies.Cookies {
 }
 
 // Cache is a shorthand for ctx.App().Cache(), but panics in case
-// of error, instead of returning it.
+// of error, instead of returning it. When tracing is enabled, the
+// returned Cache records its operations as children of the current
+// span for the Context (see Span).
 func (c *Context) Cache() *Cache {
-	return c.cache()
+	ca := c.cache()
+	if span := c.Span(); span != nil {
+		return &Cache{Cache: ca.Cache.WithSpan(span)}
+	}
+	return ca
 }
 
 // Blobstore is a shorthand for ctx.App().Blobstore(), but panics in
@@ -368,9 +386,15 @@ func (c *Context) Blobstore() *blobstore.Blobstore {
 }
 
 // Orm is a shorthand for ctx.App().Orm(), but panics in case
-// of error, rather than returning it.
+// of error, rather than returning it. When tracing is enabled, the
+// returned Orm records its operations as children of the current
+// span for the Context (see Span).
 func (c *Context) Orm() *Orm {
-	return c.orm()
+	o := c.orm()
+	if span := c.Span(); span != nil {
+		return &Orm{Orm: o.Orm.WithSpan(span)}
+	}
+	return o
 }
 
 // Execute loads the template with the given name using the
@@ -464,6 +488,8 @@ func (c *Context) Close() {
 func (c *Context) backgroundContext() *Context {
 	ctx := c.app.NewContext(nil)
 	ctx.R = c.R
+	ctx.requestID = c.RequestID()
+	ctx.handlerName = c.handlerName
 	ctx.background = true
 	ctx.provider = c.provider
 	ctx.reProvider = c.reProvider
@@ -519,6 +545,7 @@ func (c *Context) Go(f func(*Context)) {
 	}
 	c.wg.Add(1)
 	bg := c.backgroundContext()
+	bg.span = c.Span().Child("background")
 	var id int
 	if profile.On {
 		id = profile.ID()
@@ -529,6 +556,7 @@ func (c *Context) Go(f func(*Context)) {
 			defer profile.End(id)
 		}
 		defer bg.finalize(c.wg)
+		defer bg.span.End()
 		f(bg)
 	}()
 }
@@ -567,8 +595,80 @@ func (c *Context) Set(key string, value interface{}) {
 // is disabled, so it's safe to call any gnd.la/log.Interface methods
 // unconditionally (i.e. don't check if the returned value is nil, it'll
 // never be).
+//
+// Messages logged with the returned logger include the request id
+// (see RequestID), the handler name, the remote address and the
+// user id (if the user has been already loaded) as fields. The
+// returned logger also implements gnd.la/log.FieldLogger, so more
+// fields can be added with a type assertion. Since Contexts are
+// reused, the returned logger must not be used after the Context
+// has been closed.
 func (c *Context) Logger() log.Interface {
-	return c.logger()
+	if c.log == nil {
+		c.log = c.logger()
+	}
+	return c.log
+}
+
+// RequestID returns the identifier for the request being served by
+// this Context. If the request included a valid X-Request-Id header,
+// its value is used. Otherwise, a random identifier is generated the
+// first time it's requested. It's included in the messages logged
+// using Context.Logger, it's shared with the Contexts created by Go
+// and it's propagated in the requests sent by gnd.la/net/httpclient.
+func (c *Context) RequestID() string {
+	if c.requestID == "" {
+		if c.R != nil {
+			c.requestID = trace.ValidRequestID(c.R.Header.Get(trace.RequestIDHeader))
+		}
+		if c.requestID == "" {
+			c.requestID = stringutil.Random(requestIDLength)
+		}
+	}
+	return c.requestID
+}
+
+// Span returns the current trace span for this Context, which is
+// the innermost span started with StartSpan that hasn't ended yet or,
+// if there's none, the root span for the Context. For Contexts serving
+// a request, the root span is started by the App, while Contexts created
+// with Go receive a child span. If tracing is disabled, it returns nil.
+// See gnd.la/trace for more information.
+func (c *Context) Span() *trace.Span {
+	for len(c.spans) > 0 {
+		if span := c.spans[len(c.spans)-1]; !span.Ended() {
+			return span
+		}
+		c.spans = c.spans[:len(c.spans)-1]
+	}
+	return c.span
+}
+
+// StartSpan starts a new trace span with the given name as a child of
+// the current one (see Span), which it replaces as the current span until
+// it ends. This way, operations performed using this Context (e.g. ORM
+// queries or cache calls) are recorded as its children. If this Context
+// has no span yet (e.g. it was created with NewContext), the new span
+// becomes its root span. If tracing is disabled, it returns nil. In either
+// case, the returned span must be ended by calling End().
+//
+//  defer ctx.StartSpan("crunch").Set("items", len(items)).End()
+func (c *Context) StartSpan(name string) *trace.Span {
+	if c.Span() == nil {
+		c.span = trace.New(name, trace.SpanContext{RequestID: c.RequestID()})
+		return c.span
+	}
+	return c.startChildSpan(name)
+}
+
+// startChildSpan works like StartSpan, but it returns nil when
+// the Context has no span, rather than starting a new trace.
+func (c *Context) startChildSpan(name string) *trace.Span {
+	span := c.Span().Child(name)
+	if span != nil {
+		c.spans = append(c.spans, span)
+	}
+	return span
 }
 
 // Intercept http.ResponseWriter calls to find response
@@ -598,6 +698,18 @@ func (c *Context) Write(data []byte) (int, error) {
 	return c.ResponseWriter.Write(data)
 }
 
+// Flush implements http.Flusher, sending any buffered data to
+// the client. If the underlying http.ResponseWriter does not
+// support flushing, it does nothing.
+func (c *Context) Flush() {
+	if c.statusCode <= 0 {
+		c.WriteHeader(http.StatusOK)
+	}
+	if f, ok := c.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func urlHost(u string) string {
 	if u, _ := url.Parse(u); u != nil {
 		return u.Host
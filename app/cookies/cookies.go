@@ -6,7 +6,9 @@
 // in a cookie, using encoding/gob.Register.
 //
 // Signed cookies are signed using HMAC-SHA1. Encrypted cookies
-// are encrypted with AES and then signed with HMAC-SHA1.
+// are encrypted and authenticated with AES-GCM. Encrypted cookies
+// set by previous versions, encrypted with AES and then signed with
+// HMAC-SHA1, are still accepted and transparently upgraded.
 package cookies
 
 import (
@@ -33,6 +35,10 @@ var (
 
 	errNoRequest = errors.New("no request available")
 
+	// aeadSalt is used for deriving the keys for
+	// encrypted cookies from the Encrypter keys.
+	aeadSalt = []byte("gnd.la/app/cookies.aead")
+
 	// Maximum representable UNIX time with a signed 32 bit integer. This
 	// means that cookies won't be really permanent, but they will expire
 	// on January 19th 2038. I don't know about you, but I hope to be around
@@ -180,15 +186,67 @@ func (c *Cookies) setSigned(name string, data []byte, o *Options) error {
 }
 
 // getSigned returns the signed cookie data if the signature is valid.
-func (c *Cookies) getSigned(name string) ([]byte, error) {
+// If resign is true and the cookie was signed with an old key, it's
+// re-signed with the current one using the given options.
+func (c *Cookies) getSigned(name string, resign bool, o *Options) ([]byte, error) {
+	data, key, err := c.getSignedKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if resign && key != 0 {
+		c.setSigned(name, data, o)
+	}
+	return data, nil
+}
+
+// getSignedKey returns the signed cookie data as well as the
+// index of the key that was used to sign it.
+func (c *Cookies) getSignedKey(name string) ([]byte, int, error) {
 	if c.signer == nil {
-		return nil, ErrNoSigner
+		return nil, -1, ErrNoSigner
 	}
 	cookie, err := c.GetCookie(name)
+	if err != nil {
+		return nil, -1, err
+	}
+	return c.signer.UnsignKey(cookie.Value)
+}
+
+// aeadEncrypter returns a *cryptoutil.AEADEncrypter using keys
+// derived from the Cookies Encrypter keys. If there's a Signer,
+// values produced by previous versions (encrypted and then signed)
+// are still accepted and transparently migrated.
+func (c *Cookies) aeadEncrypter() (*cryptoutil.AEADEncrypter, error) {
+	if c.encrypter == nil {
+		return nil, ErrNoEncrypter
+	}
+	derived, err := c.encrypter.Derive(aeadSalt, "gnd.la/app/cookies.AEAD")
 	if err != nil {
 		return nil, err
 	}
-	return c.signer.Unsign(cookie.Value)
+	ae := &cryptoutil.AEADEncrypter{
+		Cipherer: derived.Cipherer,
+		Key:      derived.Key,
+		OldKeys:  derived.OldKeys,
+	}
+	if c.signer != nil {
+		ae.Legacy = &cryptoutil.EncryptSigner{Encrypter: c.encrypter, Signer: c.signer}
+	}
+	return ae, nil
+}
+
+// getEncryptedKeys returns the decrypted cookie data as well as the
+// keys used to produce it.
+func (c *Cookies) getEncryptedKeys(name string) ([]byte, *cryptoutil.KeyInfo, error) {
+	ae, err := c.aeadEncrypter()
+	if err != nil {
+		return nil, nil, err
+	}
+	cookie, err := c.GetCookie(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ae.DecryptString(cookie.Value, []byte(name))
 }
 
 // Has returns true if a cookie with the given name exists.
@@ -254,9 +312,26 @@ func (c *Cookies) SetOpts(name string, value interface{}, o *Options) error {
 
 // GetSecure works like Get, but for cookies set with SetSecure().
 // See SetSecure() for the guarantees made about the
-// cookie value.
+// cookie value. Cookies signed with a previous secret are
+// accepted but not re-signed, since their options are not sent
+// by the browser. Use SecureKey() to find which key signed the
+// cookie or GetSecureOpts() to re-sign it.
 func (c *Cookies) GetSecure(name string, out interface{}) error {
-	data, err := c.getSigned(name)
+	data, err := c.getSigned(name, false, nil)
+	if err != nil {
+		return err
+	}
+	return c.c.Decode(data, out)
+}
+
+// GetSecureOpts works like GetSecure(), but if the cookie was signed
+// with a previous secret, it's transparently signed again with the
+// current one. The given Options must be the same ones used
+// when setting the cookie (nil for cookies set with SetSecure()),
+// otherwise the browser might end up with a duplicate cookie or with
+// a different expiration.
+func (c *Cookies) GetSecureOpts(name string, out interface{}, o *Options) error {
+	data, err := c.getSigned(name, true, o)
 	if err != nil {
 		return err
 	}
@@ -292,28 +367,59 @@ func (c *Cookies) SetSecureOpts(name string, value interface{}, o *Options) erro
 
 // GetEncrypted works like Get, but for cookies set with SetEncrypted().
 // See SetEncrypted() for the guarantees made about the cookie value.
+// Cookies encrypted with an old key or using the previous format
+// (encrypted and then signed) are accepted but not encrypted again,
+// since their options are not sent by the browser. Use EncryptedKeys()
+// to find which keys were used or GetEncryptedOpts() to upgrade the
+// cookie.
 func (c *Cookies) GetEncrypted(name string, out interface{}) error {
-	if c.encrypter == nil {
-		return ErrNoEncrypter
-	}
-	data, err := c.getSigned(name)
+	return c.getEncrypted(name, out, false, nil)
+}
+
+// GetEncryptedOpts works like GetEncrypted(), but if the cookie was
+// encrypted with an old key or using the previous format, it's
+// transparently encrypted again using the current key. The given
+// Options must be the same ones used when setting the cookie (nil for
+// cookies set with SetEncrypted()).
+func (c *Cookies) GetEncryptedOpts(name string, out interface{}, o *Options) error {
+	return c.getEncrypted(name, out, true, o)
+}
+
+func (c *Cookies) getEncrypted(name string, out interface{}, reencrypt bool, o *Options) error {
+	decrypted, keys, err := c.getEncryptedKeys(name)
 	if err != nil {
 		return err
 	}
-	decrypted, err := c.encrypter.Decrypt(data)
-	if err != nil {
-		return err
+	if reencrypt && !keys.Current() {
+		c.setEncrypted(name, decrypted, o)
 	}
 	return c.c.Decode(decrypted, out)
 }
 
-// SetEncrypted sets a tamper-proof and encrypted cookie. The value is first
-// encrypted using *cryptoutil.Encrypter and the signed using *cryptoutil.Signer.
-// By default, these use AES and HMAC-SHA1 respectivelly. The user will not
-// be able to tamper with the cookie value nor reveal its contents.
+// SecureKey returns the index of the key used for signing the cookie
+// with the given name, set with SetSecure(). 0 indicates the current
+// key, while n > 0 indicates the n-th previous one (see
+// gnd.la/app.Config.PreviousSecrets). This is useful for monitoring
+// the progress of a key rotation.
+func (c *Cookies) SecureKey(name string) (int, error) {
+	_, key, err := c.getSignedKey(name)
+	return key, err
+}
+
+// EncryptedKeys returns the keys used for encrypting and signing the cookie
+// with the given name, set with SetEncrypted(). See SecureKey() and
+// gnd.la/crypto/cryptoutil.KeyInfo for more information.
+func (c *Cookies) EncryptedKeys(name string) (*cryptoutil.KeyInfo, error) {
+	_, keys, err := c.getEncryptedKeys(name)
+	return keys, err
+}
+
+// SetEncrypted sets a tamper-proof and encrypted cookie. The value is
+// encrypted using *cryptoutil.AEADEncrypter with the *cryptoutil.Encrypter
+// keys. By default, this uses AES-GCM. The user will not be able to tamper
+// with the cookie value nor reveal its contents.
 //
-// If you haven't set a Signer (usually set automatically for you, derived from
-// the gnd.la/app.App.Secret field) and an Encrypter (usually set automatically too,
+// If you haven't set an Encrypter (usually set automatically for you,
 // from gnd.la/app.App.EncryptionKey), this function will return an error.
 //
 // The options used for the cookie are the default ones provided
@@ -325,18 +431,25 @@ func (c *Cookies) SetEncrypted(name string, value interface{}) error {
 
 // SetEncryptedOpts works like SetEncrypted(), but accepts and Options parameter.
 func (c *Cookies) SetEncryptedOpts(name string, value interface{}, o *Options) error {
-	if c.encrypter == nil {
-		return ErrNoEncrypter
-	}
 	data, err := c.c.Encode(value)
 	if err != nil {
 		return err
 	}
-	encrypted, err := c.encrypter.Encrypt(data)
+	return c.setEncrypted(name, data, o)
+}
+
+// setEncrypted sets an encrypted cookie from its data. The cookie
+// name is authenticated too, so values can't be moved between cookies.
+func (c *Cookies) setEncrypted(name string, data []byte, o *Options) error {
+	ae, err := c.aeadEncrypter()
+	if err != nil {
+		return err
+	}
+	value, err := ae.EncryptString(data, []byte(name))
 	if err != nil {
 		return err
 	}
-	return c.setSigned(name, encrypted, o)
+	return c.set(name, value, o)
 }
 
 // Delete deletes with cookie with the given name.
@@ -0,0 +1,92 @@
+package cookies
+
+import (
+	"gnd.la/crypto/cryptoutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func rotatedCookie(t *testing.T, name string, value interface{}) (*http.Request, *cryptoutil.Signer) {
+	old := &cryptoutil.Signer{Key: []byte("old secret")}
+	w := httptest.NewRecorder()
+	if err := New(nil, w, nil, old, nil, nil).SetSecure(name, value); err != nil {
+		t.Fatal(err)
+	}
+	r, _ := http.NewRequest("GET", "/", nil)
+	for _, v := range w.Result().Cookies() {
+		r.AddCookie(v)
+	}
+	return r, &cryptoutil.Signer{Key: []byte("new secret"), OldKeys: [][]byte{old.Key}}
+}
+
+func TestGetSecureRotated(t *testing.T) {
+	r, signer := rotatedCookie(t, "foo", 42)
+	w := httptest.NewRecorder()
+	c := New(r, w, nil, signer, nil, nil)
+	var v int
+	if err := c.GetSecure("foo", &v); err != nil {
+		t.Fatal(err)
+	}
+	if v != 42 {
+		t.Errorf("expecting 42, got %d", v)
+	}
+	if key, err := c.SecureKey("foo"); err != nil || key != 1 {
+		t.Errorf("expecting key 1, got %d (%v)", key, err)
+	}
+	if h := w.Header().Get("Set-Cookie"); h != "" {
+		t.Errorf("GetSecure should not set cookies, got %q", h)
+	}
+}
+
+func TestGetSecureOptsRotated(t *testing.T) {
+	r, signer := rotatedCookie(t, "foo", 42)
+	w := httptest.NewRecorder()
+	c := New(r, w, nil, signer, nil, nil)
+	var v int
+	if err := c.GetSecureOpts("foo", &v, &Options{Path: "/foo/"}); err != nil {
+		t.Fatal(err)
+	}
+	if v != 42 {
+		t.Errorf("expecting 42, got %d", v)
+	}
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("expecting 1 cookie, got %d", len(cookies))
+	}
+	if cookies[0].Path != "/foo/" {
+		t.Errorf("expecting path /foo/, got %q", cookies[0].Path)
+	}
+	if !cookies[0].Expires.IsZero() {
+		t.Errorf("expecting session cookie, got expiration %v", cookies[0].Expires)
+	}
+	if _, key, err := signer.UnsignKey(cookies[0].Value); err != nil || key != 0 {
+		t.Errorf("expecting cookie signed with current key, got %d (%v)", key, err)
+	}
+}
+
+func TestEncryptedDerivedKey(t *testing.T) {
+	encrypter := &cryptoutil.Encrypter{Key: []byte("o1SWq95d8WGJUicB")}
+	w := httptest.NewRecorder()
+	if err := New(nil, w, nil, nil, encrypter, nil).SetEncrypted("foo", 42); err != nil {
+		t.Fatal(err)
+	}
+	r, _ := http.NewRequest("GET", "/", nil)
+	cookies := w.Result().Cookies()
+	for _, v := range cookies {
+		r.AddCookie(v)
+	}
+	var v int
+	if err := New(r, httptest.NewRecorder(), nil, nil, encrypter, nil).GetEncrypted("foo", &v); err != nil {
+		t.Fatal(err)
+	}
+	if v != 42 {
+		t.Errorf("expecting 42, got %d", v)
+	}
+	// The cookie must not be encrypted with the raw key, which
+	// is also used by the CTR Encrypter.
+	raw := &cryptoutil.AEADEncrypter{Key: encrypter.Key}
+	if _, _, err := raw.DecryptString(cookies[0].Value, []byte("foo")); err != cryptoutil.ErrCouldNotDecrypt {
+		t.Errorf("expecting ErrCouldNotDecrypt with the raw key, got %v", err)
+	}
+}
@@ -1,5 +1,9 @@
 package app
 
+import (
+	"gnd.la/log"
+)
+
 // nullLogger logs everything to /dev/null
 type nullLogger struct {
 }
@@ -15,3 +19,46 @@ func (n nullLogger) Warningf(format string, args ...interface{}) {}
 
 func (n nullLogger) Error(args ...interface{})                 {}
 func (n nullLogger) Errorf(format string, args ...interface{}) {}
+
+func (n nullLogger) With(keyvals ...interface{}) log.FieldLogger { return n }
+
+// logFields returns the fields added to every message logged
+// with Context.Logger. Their values are evaluated when each
+// message is logged, since some of them (e.g. the handler name
+// or the user) might not be available when the logger is created.
+func (c *Context) logFields() []interface{} {
+	return []interface{}{
+		"request_id", log.Valuer(func() interface{} {
+			if c.R == nil {
+				return nil
+			}
+			return c.RequestID()
+		}),
+		"trace_id", log.Valuer(func() interface{} {
+			if c.span == nil {
+				return nil
+			}
+			return c.span.TraceID
+		}),
+		"handler", log.Valuer(func() interface{} {
+			if c.handlerName == "" {
+				return nil
+			}
+			return c.handlerName
+		}),
+		"remote", log.Valuer(func() interface{} {
+			if addr := c.RemoteAddress(); addr != "" {
+				return addr
+			}
+			return nil
+		}),
+		"user", log.Valuer(func() interface{} {
+			// Don't call c.User(), since it might
+			// hit the database and log.
+			if c.user == nil {
+				return nil
+			}
+			return c.user.Id()
+		}),
+	}
+}
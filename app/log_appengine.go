@@ -11,22 +11,39 @@ import (
 	"appengine"
 )
 
-// gaeLoggger logs using the GAE logging APIs
+// gaeLoggger logs using the GAE logging APIs. Since GAE
+// doesn't support structured logging, fields are appended
+// to the messages.
 type gaeLogger struct {
-	c appengine.Context
+	c       appengine.Context
+	keyvals []interface{}
 }
 
-func (g *gaeLogger) Debug(args ...interface{})                 { g.c.Debugf("%s", fmt.Sprint(args...)) }
-func (g *gaeLogger) Debugf(format string, args ...interface{}) { g.c.Debugf(format, args...) }
+func (g *gaeLogger) msg(args ...interface{}) string {
+	return fmt.Sprint(args...) + log.FormatFields(g.keyvals...)
+}
+
+func (g *gaeLogger) msgf(format string, args ...interface{}) string {
+	return fmt.Sprintf(format, args...) + log.FormatFields(g.keyvals...)
+}
 
-func (g *gaeLogger) Info(args ...interface{})                 { g.c.Infof("%s", fmt.Sprint(args...)) }
-func (g *gaeLogger) Infof(format string, args ...interface{}) { g.c.Infof(format, args...) }
+func (g *gaeLogger) Debug(args ...interface{})                 { g.c.Debugf("%s", g.msg(args...)) }
+func (g *gaeLogger) Debugf(format string, args ...interface{}) { g.c.Debugf("%s", g.msgf(format, args...)) }
 
-func (g *gaeLogger) Warning(args ...interface{})                 { g.c.Warningf("%s", fmt.Sprint(args...)) }
-func (g *gaeLogger) Warningf(format string, args ...interface{}) { g.c.Warningf(format, args...) }
+func (g *gaeLogger) Info(args ...interface{})                 { g.c.Infof("%s", g.msg(args...)) }
+func (g *gaeLogger) Infof(format string, args ...interface{}) { g.c.Infof("%s", g.msgf(format, args...)) }
 
-func (g *gaeLogger) Error(args ...interface{})                 { g.c.Errorf("%s", fmt.Sprint(args...)) }
-func (g *gaeLogger) Errorf(format string, args ...interface{}) { g.c.Errorf(format, args...) }
+func (g *gaeLogger) Warning(args ...interface{})                 { g.c.Warningf("%s", g.msg(args...)) }
+func (g *gaeLogger) Warningf(format string, args ...interface{}) { g.c.Warningf("%s", g.msgf(format, args...)) }
+
+func (g *gaeLogger) Error(args ...interface{})                 { g.c.Errorf("%s", g.msg(args...)) }
+func (g *gaeLogger) Errorf(format string, args ...interface{}) { g.c.Errorf("%s", g.msgf(format, args...)) }
+
+func (g *gaeLogger) With(keyvals ...interface{}) log.FieldLogger {
+	kv := make([]interface{}, len(g.keyvals), len(g.keyvals)+len(keyvals))
+	copy(kv, g.keyvals)
+	return &gaeLogger{c: g.c, keyvals: append(kv, keyvals...)}
+}
 
 func (c *Context) logger() log.Interface {
 	if c.R == nil {
@@ -38,7 +55,7 @@ func (c *Context) logger() log.Interface {
 		if c.app.Logger == nil {
 			return nullLogger{}
 		}
-		return c.app.Logger
+		return c.app.Logger.With(c.logFields()...)
 	}
-	return &gaeLogger{c: appengine.NewContext(c.R)}
+	return &gaeLogger{c: appengine.NewContext(c.R), keyvals: c.logFields()}
 }
@@ -8,5 +8,5 @@ func (c *Context) logger() log.Interface {
 	if c.app.Logger == nil {
 		return nullLogger{}
 	}
-	return c.app.Logger
+	return c.app.Logger.With(c.logFields()...)
 }
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"net/http"
+	"runtime"
+	"strconv"
+
+	"gnd.la/metrics"
+)
+
+var (
+	requestsTotal = metrics.NewCounter("gondola_http_requests_total",
+		"Number of HTTP requests served, by handler name, method and status code.", "handler", "method", "status")
+	requestDuration = metrics.NewHistogram("gondola_http_request_duration_seconds",
+		"Time spent serving HTTP requests, by handler name.", nil, "handler")
+	requestsInFlight = metrics.NewGauge("gondola_http_requests_in_flight",
+		"Number of HTTP requests currently being served.")
+	templateDuration = metrics.NewHistogram("gondola_template_render_duration_seconds",
+		"Time spent executing templates, by template name.", nil, "template")
+)
+
+func init() {
+	metrics.NewGaugeFunc("go_goroutines", "Number of goroutines that currently exist.", func() float64 {
+		return float64(runtime.NumGoroutine())
+	})
+}
+
+// metricsMethod returns the method label for the request. Unknown
+// methods are grouped together to keep the number of series bounded.
+func metricsMethod(method string) string {
+	switch method {
+	case "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS":
+		return method
+	}
+	return "OTHER"
+}
+
+// endMetrics records the metrics for the request served by ctx. Requests
+// matched by unnamed handlers or not matched at all have an empty handler
+// label.
+func (app *App) endMetrics(ctx *Context) {
+	requestsInFlight.Dec()
+	status := ctx.statusCode
+	if status < 0 {
+		status = -status
+	} else if status == 0 {
+		// Nothing was written, net/http sends a 200
+		status = http.StatusOK
+	}
+	requestsTotal.Inc(ctx.handlerName, metricsMethod(ctx.R.Method), strconv.Itoa(status))
+	requestDuration.Observe(ctx.Elapsed().Seconds(), ctx.handlerName)
+}
+
+func metricsHandler(ctx *Context) {
+	ctx.Header().Set("Content-Type", metrics.ContentType)
+	if err := metrics.Default.WriteText(ctx); err != nil {
+		panic(err)
+	}
+}
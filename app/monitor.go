@@ -2,8 +2,29 @@ package app
 
 import (
 	"runtime"
+	"sync"
 )
 
+var monitorStats struct {
+	sync.RWMutex
+	funcs map[string]func(*App) interface{}
+}
+
+// RegisterMonitorStats registers a function which returns additional
+// statistics to be included in the monitor API response (available
+// in debug mode), using the given name as the key. It's intended to
+// be used by packages which can't be imported by this one (e.g.
+// gnd.la/cache/layer). Registering a name twice replaces the previous
+// function.
+func RegisterMonitorStats(name string, f func(app *App) interface{}) {
+	monitorStats.Lock()
+	defer monitorStats.Unlock()
+	if monitorStats.funcs == nil {
+		monitorStats.funcs = make(map[string]func(*App) interface{})
+	}
+	monitorStats.funcs[name] = f
+}
+
 func monitorHandler(ctx *Context) {
 	t := newInternalTemplate(ctx.app)
 	if err := t.parse("monitor.html", nil); err != nil {
@@ -23,6 +44,14 @@ func monitorAPIHandler(ctx *Context) {
 	data := map[string]interface{}{
 		"mem": &stats,
 	}
+	if c, err := ctx.app.Cache(); err == nil {
+		data["cache"] = c.Stats()
+	}
+	monitorStats.RLock()
+	for k, v := range monitorStats.funcs {
+		data[k] = v(ctx.app)
+	}
+	monitorStats.RUnlock()
 	if _, err := ctx.WriteJSON(data); err != nil {
 		panic(err)
 	}
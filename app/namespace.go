@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 )
 
@@ -113,6 +114,31 @@ func (ns *namespace) eval(ctx *Context) (map[string]interface{}, error) {
 	return m, nil
 }
 
+// types returns the types of the variables returned by eval,
+// for checking templates. Namespaces and variables which
+// can't be typed have a nil type.
+func (ns *namespace) types() map[string]reflect.Type {
+	m := map[string]reflect.Type{
+		"Ctx":     reflect.TypeOf((*Context)(nil)),
+		"Request": reflect.TypeOf((*http.Request)(nil)),
+		"App":     nil,
+		"Apps":    nil,
+	}
+	if ns == nil {
+		return m
+	}
+	for k, v := range ns.vars {
+		m[k] = reflect.TypeOf(v)
+	}
+	for k, v := range ns.funcs {
+		m[k] = v.Type().Out(0)
+	}
+	for k := range ns.namespaces {
+		m[k] = nil
+	}
+	return m
+}
+
 func isReservedVariable(va string) bool {
 	for _, v := range reservedVariables {
 		if v == va {
@@ -4,37 +4,96 @@ package app
 
 import (
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"gnd.la/blobstore"
 	"gnd.la/cache"
+	"gnd.la/config"
 	"gnd.la/net/mail"
+	"gnd.la/signal"
 )
 
+const (
+	// closeCacheDelay is the time to wait before closing a cache
+	// replaced after reloading the configuration, so requests
+	// which are still using it can finish.
+	closeCacheDelay = time.Minute
+)
+
+// cacheGeneration is incremented every time the default
+// cache changes after reloading the configuration.
+var cacheGeneration uint64
+
+func init() {
+	signal.Listen(config.CHANGED, func(_ string, obj interface{}) {
+		if obj.(*config.Change).Has("Cache") {
+			atomic.AddUint64(&cacheGeneration, 1)
+		}
+	})
+}
+
 // Methods that need to be redefined on appengine
 
 func (app *App) cache() (*Cache, error) {
+	gen := atomic.LoadUint64(&cacheGeneration)
+	app.mu.RLock()
+	c := app.c
+	current := app.cacheGeneration == gen
+	app.mu.RUnlock()
+	if c != nil && current {
+		return c, nil
+	}
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	if app.cacheGeneration != gen {
+		// Configuration was reloaded with a different cache
+		if app.parent != nil {
+			app.c = nil
+		} else if app.reloadCacheURL() && app.c != nil {
+			prev := app.c.Cache
+			time.AfterFunc(closeCacheDelay, func() { prev.Close() })
+			app.c = nil
+		}
+		app.cacheGeneration = gen
+	}
 	if app.c == nil {
-		app.mu.Lock()
-		defer app.mu.Unlock()
-		if app.c == nil {
-			if app.parent != nil {
-				var err error
-				app.c, err = app.parent.Cache()
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				c, err := cache.New(app.cfg.Cache)
-				if err != nil {
-					return nil, err
-				}
-				app.c = &Cache{Cache: c}
+		if app.parent != nil {
+			var err error
+			app.c, err = app.parent.Cache()
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			c, err := cache.New(app.cfg.Cache)
+			if err != nil {
+				return nil, err
 			}
+			app.c = &Cache{Cache: c}
 		}
 	}
 	return app.c, nil
 }
 
+// reloadCacheURL updates the cache URL for the App after the
+// Cache field in the global config has been reloaded, unless the
+// App has set its own cache URL. It returns true iff the cache
+// URL for the App changed. It must be called with app.mu held.
+func (app *App) reloadCacheURL() bool {
+	if app.cfg.Cache != app.defaultCache {
+		return false
+	}
+	config.RLock()
+	u := defaultConfig.Cache
+	config.RUnlock()
+	if u == app.defaultCache {
+		return false
+	}
+	app.cfg.Cache = u
+	app.defaultCache = u
+	return true
+}
+
 func (app *App) orm() (*Orm, error) {
 	if app.o == nil {
 		app.mu.Lock()
@@ -89,12 +148,11 @@ func (app *App) checkPort() error {
 }
 
 func (c *Context) cache() *Cache {
-	if c.app.c == nil {
-		if _, err := c.app.Cache(); err != nil {
-			panic(err)
-		}
+	cache, err := c.app.Cache()
+	if err != nil {
+		panic(err)
 	}
-	return c.app.c
+	return cache
 }
 
 func (c *Context) orm() *Orm {
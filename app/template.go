@@ -4,11 +4,14 @@ import (
 	"errors"
 	"io"
 	"os"
+	"reflect"
+	"time"
 
 	"gnd.la/app/profile"
 	"gnd.la/internal/templateutil"
 	"gnd.la/template"
 	"gnd.la/template/assets"
+	"gnd.la/trace"
 
 	"gopkgs.com/vfs.v1"
 )
@@ -88,7 +91,8 @@ func (t *Template) reverse(name string, args ...interface{}) (string, error) {
 
 // Execute executes the template, writing its result to the given
 // *Context. Note that Template uses an intermediate buffer, so
-// nothing will be written to the *Context in case of error.
+// nothing will be written to the *Context in case of error, unless
+// Config.TemplateStreaming is enabled (see gnd.la/template.StreamError).
 func (t *Template) Execute(ctx *Context, data interface{}) error {
 	return t.ExecuteTo(ctx, ctx, data)
 }
@@ -96,6 +100,12 @@ func (t *Template) Execute(ctx *Context, data interface{}) error {
 // ExecuteTo works like Execute, but allows writing the template result
 // to an arbitraty io.Writer rather than the current *Context.
 func (t *Template) ExecuteTo(w io.Writer, ctx *Context, data interface{}) error {
+	var span *trace.Span
+	if ctx != nil {
+		span = ctx.startChildSpan("template").Set("name", t.tmpl.Name())
+		defer span.End()
+	}
+	defer templateDuration.ObserveSince(time.Now(), t.tmpl.Name())
 	var tvars map[string]interface{}
 	var err error
 	if t.app.namespace != nil {
@@ -107,7 +117,34 @@ func (t *Template) ExecuteTo(w io.Writer, ctx *Context, data interface{}) error
 		tvars = make(map[string]interface{})
 	}
 	tvars["Ctx"] = ctx
-	return t.tmpl.ExecuteContext(w, data, ctx, tvars)
+	err = t.tmpl.ExecuteContext(w, data, ctx, tvars)
+	span.SetError(err)
+	return err
+}
+
+// Check verifies the template without executing it, using the type
+// of the data passed as the dot and the types of the variables defined
+// by the App. See gnd.la/template.Template.Check for the details.
+//
+//  func TestTemplates(t *testing.T) {
+//	tmpl, err := App.LoadTemplate("item.html")
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	if err := tmpl.Check(reflect.TypeOf(&Item{})); err != nil {
+//	    t.Error(err)
+//	}
+//  }
+func (t *Template) Check(dot reflect.Type) error {
+	return t.tmpl.Check(dot, t.app.namespace.types())
+}
+
+// Generate adds the code for rendering this template with the given type
+// of data to the given *template.Generator, checking it first like Check
+// does. See gnd.la/template.Generator for more information. Most users
+// should not call this function directly, but rather use gondola gen.
+func (t *Template) Generate(g *template.Generator, dot reflect.Type) error {
+	return g.Add(t.tmpl, dot, t.app.namespace.types())
 }
 
 func template_t(ctx *Context, str string) string {
@@ -130,6 +167,8 @@ func newTemplate(app *App, fs vfs.VFS, manager *assets.Manager) *Template {
 	t := &Template{tmpl: template.New(fs, manager), app: app}
 	if app.cfg != nil {
 		t.tmpl.Debug = app.cfg.TemplateDebug
+		t.tmpl.Streaming = app.cfg.TemplateStreaming
+		t.tmpl.FragmentCache = &fragmentCache{app: app}
 	}
 	t.tmpl.Funcs(templateFuncs).Funcs(template.FuncMap{"#reverse": t.reverse})
 	return t
@@ -35,7 +35,9 @@ type UserFunc func(ctx *Context, id int64) User
 func (c *Context) User() User {
 	if c.user == nil && c.app.userFunc != nil {
 		var id int64
-		err := c.Cookies().GetSecure(USER_COOKIE_NAME, &id)
+		// The cookie is set by SignIn with the default options,
+		// so it can be safely re-signed after a secret rotation.
+		err := c.Cookies().GetSecureOpts(USER_COOKIE_NAME, &id, nil)
 		if err == nil {
 			c.user = c.app.userFunc(c, id)
 		}
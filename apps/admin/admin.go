@@ -0,0 +1,160 @@
+package admin
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sync"
+
+	"gnd.la/app"
+)
+
+// Options customize how a model is shown and edited in the
+// admin. Use Customize to set the Options for a model.
+type Options struct {
+	// Label is the name shown for the model. If empty, it's
+	// derived from the model type name.
+	Label string
+	// Exclude removes the model from the admin.
+	Exclude bool
+	// Hidden lists the fields, identified by their qualified
+	// names (e.g. Foo.Bar for embedded fields), which are
+	// never shown nor edited. Fields which look like secrets
+	// (those of type gnd.la/crypto/password.Password and those
+	// with password, secret, token or hash in their names) are
+	// always hidden, unless they're listed in Visible.
+	Hidden []string
+	// Visible lists the fields which look like secrets, but
+	// should be shown and edited anyway.
+	Visible []string
+	// ReadOnly lists the fields which are shown, but can't
+	// be edited.
+	ReadOnly []string
+	// Columns lists the columns shown in the model list. If
+	// empty, there's a column for every non hidden field.
+	Columns []*Column
+	// Filters lists the fields which might be used for filtering
+	// the model list. If empty, boolean fields, indexed fields and
+	// fields referencing other models are used.
+	Filters []string
+	// Search lists the string fields matched against the text
+	// entered in the search box. If empty, all the non hidden
+	// string fields are searched.
+	Search []string
+	// Sort is the field used for sorting the list by default,
+	// prefixed by a '-' for descending order. If empty, the
+	// list is sorted by the primary key in descending order.
+	Sort string
+	// PerPage is the number of objects in each page of the
+	// list. If zero, the PerPage variable is used.
+	PerPage int
+	// Actions lists custom actions which might be applied to the
+	// objects selected in the list, besides deleting them.
+	Actions []*Action
+}
+
+// Column represents a column in the model list.
+type Column struct {
+	// Label is the column header. If empty, it's derived
+	// from Field.
+	Label string
+	// Field is the qualified name of the field shown in the
+	// column. The list might be sorted by field columns.
+	Field string
+	// Func, if non-nil, returns the value shown in the column
+	// for the given object, which is always a pointer to the
+	// model type. Columns with a Func are not sortable.
+	Func func(ctx *app.Context, obj interface{}) interface{}
+}
+
+// Action represents an action which might be applied to
+// the objects selected in the model list.
+type Action struct {
+	// Name identifies the action, it must be unique
+	// for each model.
+	Name string
+	// Label is the text shown to the user. If empty,
+	// Name is used.
+	Label string
+	// Func is called with the selected objects, which are
+	// always pointers to the model type. If it returns an
+	// error, its message is shown to the user.
+	Func func(ctx *app.Context, objs []interface{}) error
+}
+
+var customized struct {
+	sync.RWMutex
+	options map[reflect.Type]*Options
+}
+
+// Customize sets the Options used for the given model, which
+// might be either a value, a pointer or a reflect.Type, like
+// in gnd.la/orm.Register. Models without Options are shown
+// with all their fields.
+func Customize(model interface{}, opts *Options) {
+	typ, ok := model.(reflect.Type)
+	if !ok {
+		typ = reflect.TypeOf(model)
+	}
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	customized.Lock()
+	defer customized.Unlock()
+	if customized.options == nil {
+		customized.options = make(map[reflect.Type]*Options)
+	}
+	customized.options[typ] = opts
+}
+
+func modelOptions(typ reflect.Type) *Options {
+	customized.RLock()
+	defer customized.RUnlock()
+	if opts := customized.options[typ]; opts != nil {
+		return opts
+	}
+	return &Options{}
+}
+
+// adminOnly returns a Handler which only calls handler if the
+// user is signed in and an administrator. Anonymous users are
+// redirected to sign in, while other users get a 403.
+func adminOnly(handler app.Handler) app.Handler {
+	return func(ctx *app.Context) {
+		h := ctx.Header()
+		h.Add("Vary", "Cookie")
+		h.Add("Cache-Control", "private")
+		user := ctx.User()
+		if user == nil {
+			redirectToSignIn(ctx)
+			return
+		}
+		if !user.IsAdmin() {
+			ctx.Forbidden("")
+			return
+		}
+		handler(ctx)
+	}
+}
+
+// redirectToSignIn works like the redirect in app.SignedIn, but
+// the sign in handler is reversed from the root App, since it's
+// usually found in another included app (e.g. gnd.la/apps/users)
+// rather than in the admin.
+func redirectToSignIn(ctx *app.Context) {
+	root := ctx.App()
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	signIn, err := root.Reverse(app.SignInHandlerName)
+	if err != nil {
+		panic(err)
+	}
+	u, err := url.Parse(signIn)
+	if err != nil {
+		panic(err)
+	}
+	from := ctx.URL().String()
+	u.RawQuery += fmt.Sprintf("%s=%s", app.SignInFromParameterName, url.QueryEscape(from))
+	ctx.Redirect(u.String(), false)
+}
@@ -0,0 +1,502 @@
+package admin_test
+
+import (
+	"fmt"
+	"html"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+	"gnd.la/apps/admin"
+	"gnd.la/config"
+	"gnd.la/crypto/password"
+	"gnd.la/orm"
+	_ "gnd.la/orm/driver/sqlite"
+	"gnd.la/tasks"
+	"gnd.la/util/stringutil"
+
+	"gopkgs.com/vfs.v1"
+)
+
+const (
+	adminId = 1
+	userId  = 2
+
+	articleCount = 30
+	articlesURL  = "/admin/Article/"
+	accountsURL  = "/admin/Account/"
+)
+
+var (
+	inputRe = regexp.MustCompile(`<input[^>]*>`)
+	nameRe  = regexp.MustCompile(`name="([^"]*)"`)
+	valueRe = regexp.MustCompile(`value="([^"]*)"`)
+)
+
+type Article struct {
+	Id        int64 `orm:",primary_key,auto_increment"`
+	Title     string
+	Slug      string
+	Token     string
+	Published bool `orm:",index"`
+}
+
+type Account struct {
+	Id         int64 `orm:",primary_key,auto_increment"`
+	Name       string
+	Password   password.Password
+	Secret     string
+	APIToken   string
+	TokenCount int
+}
+
+type testUser struct {
+	id int64
+}
+
+func (u *testUser) Id() int64     { return u.id }
+func (u *testUser) IsAdmin() bool { return u.id == adminId }
+
+type adminTester struct {
+	*tester.Tester
+	// Cookies for the admin and the non admin user
+	admin string
+	user  string
+}
+
+func (a *adminTester) get(path string, cookie string) *tester.Request {
+	req := a.Get(path, nil)
+	if cookie != "" {
+		req.AddHeader("Cookie", cookie)
+	}
+	return req
+}
+
+func (a *adminTester) post(path string, cookie string, values url.Values) *tester.Request {
+	return a.Post(path, values.Encode()).
+		AddHeader("Content-Type", "application/x-www-form-urlencoded").
+		AddHeader("Cookie", cookie)
+}
+
+// serve performs a request using the given cookie without
+// the tester, for the cases where the response is required.
+func (a *adminTester) serve(t *testing.T, path string, cookie string) *httptest.ResponseRecorder {
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cookie != "" {
+		req.Header.Set("Cookie", cookie)
+	}
+	w := httptest.NewRecorder()
+	a.App.ServeHTTP(w, req)
+	return w
+}
+
+// signIn returns the cookie for the user with the given id.
+func (a *adminTester) signIn(t *testing.T, id int64) string {
+	w := a.serve(t, fmt.Sprintf("/sign-in-as/%d/", id), "")
+	for _, v := range w.HeaderMap["Set-Cookie"] {
+		if strings.HasPrefix(v, app.USER_COOKIE_NAME+"=") {
+			return strings.SplitN(v, ";", 2)[0]
+		}
+	}
+	t.Fatalf("no user cookie set when signing in as %d", id)
+	return ""
+}
+
+// hiddenValues returns the hidden inputs in the page at the given path,
+// which include the CSRF tokens for its form.
+func (a *adminTester) hiddenValues(t *testing.T, path string) url.Values {
+	w := a.serve(t, path, a.admin)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expecting code 200 from %s, got %d", path, w.Code)
+	}
+	values := make(url.Values)
+	for _, v := range inputRe.FindAllString(w.Body.String(), -1) {
+		if !strings.Contains(v, `type="hidden"`) {
+			continue
+		}
+		name := nameRe.FindStringSubmatch(v)
+		value := valueRe.FindStringSubmatch(v)
+		if name != nil && value != nil {
+			values.Add(name[1], html.UnescapeString(value[1]))
+		}
+	}
+	if len(values) == 0 {
+		t.Fatalf("no hidden values in %s", path)
+	}
+	return values
+}
+
+func (a *adminTester) orm(t *testing.T) *app.Orm {
+	o, err := a.App.Orm()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
+
+// resetArticles replaces all the articles with articleCount new ones,
+// half of them published.
+func (a *adminTester) resetArticles(t *testing.T) []*Article {
+	o := a.orm(t)
+	if _, err := o.DeleteFrom(o.TypeTable(reflect.TypeOf(Article{})), nil); err != nil {
+		t.Fatal(err)
+	}
+	var articles []*Article
+	for ii := 1; ii <= articleCount; ii++ {
+		article := &Article{
+			Title:     fmt.Sprintf("Article %02d", ii),
+			Slug:      fmt.Sprintf("article-%02d", ii),
+			Token:     fmt.Sprintf("token-%02d", ii),
+			Published: ii%2 == 0,
+		}
+		if _, err := o.Insert(article); err != nil {
+			t.Fatal(err)
+		}
+		articles = append(articles, article)
+	}
+	return articles
+}
+
+// article returns the article with the given id, or nil
+// if there's no such article.
+func (a *adminTester) article(t *testing.T, id int64) *Article {
+	var article Article
+	ok, err := a.orm(t).One(orm.Eq("Id", id), &article)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		return nil
+	}
+	return &article
+}
+
+func articleURL(action string, article *Article) string {
+	return articlesURL + action + "/" + strconv.FormatInt(article.Id, 10) + "/"
+}
+
+func testAdminOnly(t *testing.T, a *adminTester) {
+	for _, v := range []string{"/admin/", articlesURL, articlesURL + "new/"} {
+		a.get(v, "").Expect(302).MatchHeader("Location", `^/sign-in/\?from=`)
+		a.get(v, a.user).Expect(403)
+		a.get(v, a.admin).Expect(200)
+	}
+	a.get("/admin/", a.admin).Contains(articlesURL)
+}
+
+func testListPaging(t *testing.T, a *adminTester) {
+	a.resetArticles(t)
+	// Sorted by descending id by default
+	a.get(articlesURL, a.admin).Expect(200).Contains("Page 1 of 3 (30 objects)").
+		Match(`(?s)Article 30.*Article 21`).Contains("?p=2")
+	a.get(articlesURL+"?p=3", a.admin).Expect(200).Contains("Page 3 of 3 (30 objects)").
+		Match(`(?s)Article 10.*Article 01`).Contains("?p=2")
+	// Out of range pages show the last one
+	a.get(articlesURL+"?p=7", a.admin).Contains("Page 3 of 3 (30 objects)")
+}
+
+func testListSorting(t *testing.T, a *adminTester) {
+	a.resetArticles(t)
+	a.get(articlesURL+"?sort=Title", a.admin).Expect(200).Match(`(?s)Article 01.*Article 02.*Article 10`).
+		Contains(`class="admin-sorted-asc"`).Contains("?sort=-Title")
+	a.get(articlesURL+"?sort=-Title", a.admin).Expect(200).Match(`(?s)Article 30.*Article 29.*Article 21`).
+		Contains(`class="admin-sorted-desc"`)
+	// Unknown fields are ignored
+	a.get(articlesURL+"?sort=Nothing", a.admin).Expect(200).Match(`(?s)Article 30.*Article 29`)
+}
+
+func testListFiltering(t *testing.T, a *adminTester) {
+	a.resetArticles(t)
+	a.get(articlesURL+"?filter_Published=true", a.admin).Expect(200).Contains("(15 objects)")
+	a.get(articlesURL+"?filter_Published=false&sort=Title", a.admin).Expect(200).
+		Contains("(15 objects)").Match(`(?s)Article 01.*Article 03`)
+	a.get(articlesURL+"?q=Article+1", a.admin).Expect(200).Contains("Page 1 of 1 (10 objects)")
+	a.get(articlesURL+"?q=Article+1&filter_Published=true", a.admin).Expect(200).Contains("(5 objects)")
+	a.get(articlesURL+"?q=nothing", a.admin).Expect(200).Contains("(0 objects)")
+}
+
+func testEdit(t *testing.T, a *adminTester) {
+	article := a.resetArticles(t)[0]
+	u := articleURL("edit", article)
+	// Slug is shown, but it's read only, while Token is hidden
+	a.get(u, a.admin).Expect(200).Contains(`name="title"`).Contains(article.Slug)
+	body := a.serve(t, u, a.admin).Body.String()
+	if strings.Contains(body, `name="slug"`) {
+		t.Errorf("read only field is editable in %s", u)
+	}
+	if strings.Contains(body, article.Token) || strings.Contains(body, `name="token"`) {
+		t.Errorf("hidden field is shown in %s", u)
+	}
+	values := a.hiddenValues(t, u)
+	values.Set("title", "Edited")
+	values.Set("slug", "edited")
+	values.Set("token", "edited")
+	a.post(u, a.admin, values).Expect(302)
+	edited := a.article(t, article.Id)
+	if edited.Title != "Edited" {
+		t.Errorf("expecting title Edited, got %q", edited.Title)
+	}
+	if edited.Slug != article.Slug || edited.Token != article.Token {
+		t.Errorf("read only or hidden field was edited: %+v", edited)
+	}
+	// Without the CSRF tokens the object is not saved
+	a.post(u, a.admin, url.Values{"title": {"Forged"}}).Expect(200)
+	if title := a.article(t, article.Id).Title; title != "Edited" {
+		t.Errorf("object edited without a valid form, title is %q", title)
+	}
+	// Non admins can't edit either
+	values = a.hiddenValues(t, u)
+	values.Set("title", "Forged")
+	a.post(u, a.user, values).Expect(403)
+	if title := a.article(t, article.Id).Title; title != "Edited" {
+		t.Errorf("object edited by a non admin, title is %q", title)
+	}
+}
+
+func testDelete(t *testing.T, a *adminTester) {
+	articles := a.resetArticles(t)
+	u := articleURL("delete", articles[0])
+	a.get(u, a.admin).Expect(200)
+	a.post(u, a.admin, url.Values{"confirm": {"true"}}).Expect(200)
+	if a.article(t, articles[0].Id) == nil {
+		t.Fatal("object deleted without a valid form")
+	}
+	a.post(u, a.user, a.hiddenValues(t, u)).Expect(403)
+	a.post(u, a.admin, a.hiddenValues(t, u)).Expect(302)
+	if a.article(t, articles[0].Id) != nil {
+		t.Fatal("object not deleted")
+	}
+	a.get(u, a.admin).Expect(404)
+}
+
+func testBulkActions(t *testing.T, a *adminTester) {
+	articles := a.resetArticles(t)
+	selected := url.Values{
+		"action":   {"publish"},
+		"selected": {strconv.FormatInt(articles[0].Id, 10), strconv.FormatInt(articles[2].Id, 10)},
+	}
+	a.post(articlesURL, a.admin, selected).Expect(200)
+	if a.article(t, articles[0].Id).Published {
+		t.Fatal("action applied without a valid form")
+	}
+	values := a.hiddenValues(t, articlesURL)
+	for k, v := range selected {
+		values[k] = v
+	}
+	a.post(articlesURL, a.admin, values).Expect(200).Contains("Publish: 2 objects affected")
+	for _, v := range []*Article{articles[0], articles[2]} {
+		if !a.article(t, v.Id).Published {
+			t.Errorf("article %d not published", v.Id)
+		}
+	}
+	if a.article(t, articles[4].Id).Published {
+		t.Errorf("article %d published without being selected", articles[4].Id)
+	}
+	values = a.hiddenValues(t, articlesURL)
+	values.Set("action", "delete")
+	values["selected"] = selected["selected"]
+	a.post(articlesURL, a.admin, values).Expect(200).Contains("2 objects affected")
+	if a.article(t, articles[0].Id) != nil || a.article(t, articles[2].Id) != nil {
+		t.Error("selected articles not deleted")
+	}
+	a.get(articlesURL, a.admin).Contains("(28 objects)")
+	// Unknown actions are rejected
+	values = a.hiddenValues(t, articlesURL)
+	values.Set("action", "nothing")
+	values.Set("selected", strconv.FormatInt(articles[1].Id, 10))
+	a.post(articlesURL, a.admin, values).Expect(200)
+	if a.article(t, articles[1].Id) == nil {
+		t.Error("article deleted by an unknown action")
+	}
+}
+
+func testTasks(t *testing.T, a *adminTester) {
+	const tasksURL = "/admin/tasks/"
+	ran := make(chan bool, 1)
+	task := tasks.Register(a.App, func(ctx *app.Context) {
+		ran <- true
+	}, &tasks.Options{Name: "admin-test-task"})
+	defer task.Delete()
+	task.Interval = time.Hour
+	task.Resume(false)
+	a.get(tasksURL, "").Expect(302).MatchHeader("Location", `^/sign-in/\?from=`)
+	a.get(tasksURL, a.user).Expect(403)
+	a.get(tasksURL, a.admin).Expect(200).Contains("admin-test-task").Contains("1h0m0s")
+	a.get("/admin/", a.admin).Contains(tasksURL)
+	pause := url.Values{"task": {"admin-test-task"}, "action": {"pause"}}
+	a.post(tasksURL, a.admin, pause).Expect(200)
+	if !task.Scheduled() {
+		t.Fatal("task paused without a valid form")
+	}
+	values := a.hiddenValues(t, tasksURL)
+	for k, v := range pause {
+		values[k] = v
+	}
+	a.post(tasksURL, a.user, values).Expect(403)
+	if !task.Scheduled() {
+		t.Fatal("task paused by a non admin")
+	}
+	a.post(tasksURL, a.admin, values).Expect(302)
+	if task.Scheduled() {
+		t.Fatal("task not paused")
+	}
+	a.get(tasksURL, a.admin).Contains("(paused)")
+	values = a.hiddenValues(t, tasksURL)
+	values.Set("task", "admin-test-task")
+	values.Set("action", "resume")
+	a.post(tasksURL, a.admin, values).Expect(302)
+	if !task.Scheduled() {
+		t.Fatal("task not resumed")
+	}
+	values = a.hiddenValues(t, tasksURL)
+	values.Set("task", "admin-test-task")
+	values.Set("action", "run")
+	a.post(tasksURL, a.admin, values).Expect(302)
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		t.Fatal("task not run")
+	}
+	// Unknown tasks are rejected
+	values = a.hiddenValues(t, tasksURL)
+	values.Set("task", "nothing")
+	values.Set("action", "pause")
+	a.post(tasksURL, a.admin, values).Expect(200)
+}
+
+func testSecretFields(t *testing.T, a *adminTester) {
+	const secret = "account-secret"
+	const token = "account-token"
+	account := &Account{
+		Name:       "Account",
+		Password:   password.New("account-password"),
+		Secret:     secret,
+		APIToken:   token,
+		TokenCount: 42,
+	}
+	if _, err := a.orm(t).Insert(account); err != nil {
+		t.Fatal(err)
+	}
+	u := accountsURL + "edit/" + strconv.FormatInt(account.Id, 10) + "/"
+	for _, v := range []string{accountsURL, u, accountsURL + "new/"} {
+		body := a.serve(t, v, a.admin).Body.String()
+		for _, s := range []string{string(account.Password), secret, token, `name="password"`, `name="secret"`, `name="api_token"`} {
+			if strings.Contains(body, s) {
+				t.Errorf("secret %q is shown in %s", s, v)
+			}
+		}
+	}
+	// Fields listed in Visible are shown even if they look like secrets
+	a.get(u, a.admin).Expect(200).Contains(`name="name"`).Contains(`name="token_count"`)
+	// Secrets can't be searched, sorted or edited either
+	a.get(accountsURL+"?q="+token, a.admin).Expect(200).Contains("(0 objects)")
+	if body := a.serve(t, accountsURL+"?sort=Secret", a.admin).Body.String(); strings.Contains(body, "admin-sorted-asc") {
+		t.Error("list sorted by a secret field")
+	}
+	values := a.hiddenValues(t, u)
+	values.Set("name", "Edited")
+	values.Set("token_count", "7")
+	values.Set("secret", "edited")
+	values.Set("password", "edited")
+	a.post(u, a.admin, values).Expect(302)
+	var edited Account
+	if _, err := a.orm(t).One(orm.Eq("Id", account.Id), &edited); err != nil {
+		t.Fatal(err)
+	}
+	if edited.Name != "Edited" || edited.TokenCount != 7 {
+		t.Errorf("visible fields not edited: %+v", edited)
+	}
+	if edited.Secret != secret || edited.Password != account.Password {
+		t.Errorf("secret fields were edited: %+v", edited)
+	}
+}
+
+var adminTests = []func(*testing.T, *adminTester){
+	testAdminOnly,
+	testListPaging,
+	testListSorting,
+	testListFiltering,
+	testEdit,
+	testDelete,
+	testBulkActions,
+	testTasks,
+	testSecretFields,
+}
+
+func publishArticles(ctx *app.Context, objs []interface{}) error {
+	o := ctx.Orm()
+	for _, v := range objs {
+		article := v.(*Article)
+		article.Published = true
+		if _, err := o.Save(article); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestAdmin(t *testing.T) {
+	f, err := ioutil.TempFile("", "admin-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	a := app.New()
+	a.Config().Secret = stringutil.Random(32)
+	a.Config().Database = config.MustParseURL("sqlite://" + f.Name())
+	fs := vfs.Memory()
+	if err := vfs.WriteFile(fs, "base.html", []byte("<html><body>{{ app }}</body></html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	a.SetTemplatesFS(fs)
+	a.Include("/admin/", admin.App, "base.html")
+	a.HandleNamed("^/sign-in/$", func(ctx *app.Context) {
+		ctx.WriteString("sign in")
+	}, app.SignInHandlerName)
+	a.Handle(`^/sign-in-as/(\d+)/$`, func(ctx *app.Context) {
+		var id int64
+		ctx.MustParseIndexValue(0, &id)
+		ctx.MustSignIn(&testUser{id: id})
+	})
+	// UserFunc must be set after including the admin
+	a.SetUserFunc(func(ctx *app.Context, id int64) app.User {
+		return &testUser{id: id}
+	})
+	at := &adminTester{Tester: tester.New(t, a)}
+	at.admin = at.signIn(t, adminId)
+	at.user = at.signIn(t, userId)
+	for _, v := range adminTests {
+		v(t, at)
+	}
+}
+
+func init() {
+	orm.Register(Article{}, &orm.Options{Table: "articles", Name: "Article"})
+	orm.Register(Account{}, &orm.Options{Table: "accounts", Name: "Account"})
+	admin.Customize(&Account{}, &admin.Options{
+		Visible: []string{"TokenCount"},
+	})
+	admin.Customize(&Article{}, &admin.Options{
+		Hidden:   []string{"Token"},
+		ReadOnly: []string{"Slug"},
+		Search:   []string{"Title"},
+		PerPage:  10,
+		Actions: []*admin.Action{
+			{Name: "publish", Label: "Publish", Func: publishArticles},
+		},
+	})
+}
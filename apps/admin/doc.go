@@ -0,0 +1,54 @@
+// Package admin implements an app for managing the objects of the
+// models registered in the ORM.
+//
+// The admin app introspects the models registered in the ORM (their fields,
+// primary keys, references and indexes) and provides a page with a paginated
+// list for each one of them, which can be searched, filtered and sorted, as
+// well as pages for creating, editing and deleting objects. The forms for
+// creating and editing objects are generated using gnd.la/form, so the
+// same struct tags used for other forms (e.g. label, help or max_length)
+// might be used to customize them.
+//
+// The admin also includes a page listing the tasks registered with gnd.la/tasks,
+// with their schedule and their latest results, which allows running them
+// immediately as well as pausing and resuming them. It's served at tasks/,
+// so a model whose slug is "tasks" can't be managed in the admin.
+//
+// Models without a single primary key can only be listed and created. Fields
+// which are not supported by gnd.la/form or which are inside an embedded
+// pointer to a struct are shown in the pages, but they can't be edited.
+//
+// All the pages in the admin require a signed in user with administrator
+// privileges (see gnd.la/app.User). Anonymous users are redirected to sign
+// in, while other users receive a 403 response. Since the UserFunc is only
+// propagated to the apps which have already been included, remember to call
+// App.SetUserFunc after including the admin.
+//
+// The typical usage of this application is as follows:
+//
+//  myapp.Include("/admin/", admin.App, "admin-base.html")
+//
+// By default, all the models are shown with all their fields, except the ones
+// which look like secrets, like password hashes or tokens. Use Customize
+// to hide models or fields, to specify the columns and filters in the lists
+// or to add custom actions which might be applied to the selected objects
+// in a list. e.g.
+//
+//  admin.Customize(&Article{}, &admin.Options{
+//	Hidden:  []string{"Token"},
+//	Filters: []string{"Published"},
+//	Sort:    "-Created",
+//	Columns: []*admin.Column{
+//		{Field: "Title"},
+//		{Label: "Author", Func: func(ctx *app.Context, obj interface{}) interface{} {
+//			return authorName(ctx, obj.(*Article))
+//		}},
+//	},
+//	Actions: []*admin.Action{
+//		{Name: "publish", Label: "Publish", Func: publishArticles},
+//	},
+//  })
+//
+//  // Don't show the sessions in the admin
+//  admin.Customize(&Session{}, &admin.Options{Exclude: true})
+package admin
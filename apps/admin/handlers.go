@@ -0,0 +1,428 @@
+package admin
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gnd.la/app"
+	"gnd.la/form"
+	"gnd.la/orm"
+	"gnd.la/orm/query"
+	"gnd.la/util/types"
+)
+
+const (
+	ModelsHandlerName = "admin-models"
+	ListHandlerName   = "admin-list"
+	CreateHandlerName = "admin-create"
+	EditHandlerName   = "admin-edit"
+	DeleteHandlerName = "admin-delete"
+	TasksHandlerName  = "admin-tasks"
+)
+
+var (
+	// PerPage is the default number of objects shown in each page
+	// of a model list. See also Options.PerPage.
+	PerPage = 25
+
+	ModelsHandler = app.NamedHandler(ModelsHandlerName, adminOnly(modelsHandler))
+	ListHandler   = app.NamedHandler(ListHandlerName, adminOnly(listHandler))
+	CreateHandler = app.NamedHandler(CreateHandlerName, adminOnly(createHandler))
+	EditHandler   = app.NamedHandler(EditHandlerName, adminOnly(editHandler))
+	DeleteHandler = app.NamedHandler(DeleteHandlerName, adminOnly(deleteHandler))
+	TasksHandler  = app.NamedHandler(TasksHandlerName, adminOnly(tasksHandler))
+
+	deleteAction = &Action{Name: "delete", Label: "Delete selected"}
+)
+
+type actionForm struct {
+	Action  string `form:",select"`
+	actions []*Action
+}
+
+func (f *actionForm) FieldChoices(ctx *app.Context, field *form.Field) []*form.Choice {
+	choices := []*form.Choice{form.Choose}
+	for _, v := range f.actions {
+		label := v.Label
+		if label == "" {
+			label = v.Name
+		}
+		choices = append(choices, &form.Choice{Name: label, Value: v.Name})
+	}
+	return choices
+}
+
+type confirmForm struct {
+	Confirm bool `form:",hidden"`
+}
+
+type listFilter struct {
+	Param string
+	Label string
+	Value string
+	Bool  bool
+}
+
+type listColumn struct {
+	Label string
+	// URL sorts the list by this column, empty
+	// if the column is not sortable.
+	URL string
+	// Sorted is either "asc", "desc" or empty
+	Sorted string
+}
+
+type listCell struct {
+	Value interface{}
+	// URL points to the referenced object, if any
+	URL string
+}
+
+type listRow struct {
+	Id    string
+	URL   string
+	Cells []*listCell
+}
+
+type detail struct {
+	Label string
+	Value interface{}
+}
+
+func modelOr404(ctx *app.Context) *adminModel {
+	m := lookupModel(ctx, ctx.IndexValue(0))
+	if m == nil {
+		ctx.NotFound("model not found")
+	}
+	return m
+}
+
+// listURL returns the URL for the current list page, setting
+// the given parameters, which are specified as name, value
+// pairs. Parameters with an empty value are removed.
+func listURL(ctx *app.Context, m *adminModel, params ...string) string {
+	values := ctx.R.URL.Query()
+	for ii := 0; ii < len(params); ii += 2 {
+		if params[ii+1] == "" {
+			values.Del(params[ii])
+		} else {
+			values.Set(params[ii], params[ii+1])
+		}
+	}
+	u := ctx.MustReverse(ListHandlerName, m.Slug)
+	if enc := values.Encode(); enc != "" {
+		u += "?" + enc
+	}
+	return u
+}
+
+func (m *adminModel) sortField(s string) (string, orm.Sort) {
+	dir := orm.ASC
+	if strings.HasPrefix(s, "-") {
+		dir = orm.DESC
+		s = s[1:]
+	}
+	if _, ok := m.field(s); !ok {
+		return "", orm.ASC
+	}
+	return s, dir
+}
+
+// runAction runs the given action over the objects with the given primary
+// keys and returns the number of affected objects.
+func runAction(ctx *app.Context, m *adminModel, action *Action, ids []string) (int, error) {
+	pks := make([]interface{}, 0, len(ids))
+	for _, v := range ids {
+		val, err := m.parseValue(m.fields.PrimaryKey, v)
+		if err != nil {
+			return 0, err
+		}
+		pks = append(pks, val)
+	}
+	if len(pks) == 0 {
+		return 0, nil
+	}
+	o := ctx.Orm()
+	q := orm.In(m.pkName(), pks)
+	if action == deleteAction {
+		if _, err := o.DeleteFrom(m.table, q); err != nil {
+			return 0, err
+		}
+		return len(pks), nil
+	}
+	var objs []interface{}
+	iter := o.Table(m.table).Filter(q).Iter()
+	for obj := m.newObject(); iter.Next(obj.Interface()); obj = m.newObject() {
+		objs = append(objs, obj.Interface())
+	}
+	if err := iter.Err(); err != nil {
+		return 0, err
+	}
+	if err := action.Func(ctx, objs); err != nil {
+		return 0, err
+	}
+	return len(objs), nil
+}
+
+func modelsHandler(ctx *app.Context) {
+	data := map[string]interface{}{
+		"Models": adminModels(ctx),
+	}
+	ctx.MustExecute("models.html", data)
+}
+
+func listHandler(ctx *app.Context) {
+	m := modelOr404(ctx)
+	if m == nil {
+		return
+	}
+	data := map[string]interface{}{
+		"Model": m,
+	}
+	if actions := m.actions(); len(actions) > 0 && m.Editable() {
+		af := &actionForm{actions: actions}
+		f := form.New(ctx, af)
+		if f.Submitted() && f.IsValid() {
+			action := m.action(af.Action)
+			label := action.Label
+			if label == "" {
+				label = action.Name
+			}
+			data["Action"] = label
+			affected, err := runAction(ctx, m, action, ctx.R.Form["selected"])
+			if err != nil {
+				data["Error"] = err.Error()
+			}
+			data["Affected"] = affected
+		}
+		data["ActionForm"] = f
+	}
+	o := ctx.Orm()
+	var conds []query.Q
+	search := ctx.FormValue("q")
+	if search != "" {
+		var qs []query.Q
+		for _, v := range m.searchFields() {
+			qs = append(qs, orm.Contains(v, search))
+		}
+		if len(qs) > 0 {
+			conds = append(conds, orm.Or(qs...))
+		}
+	}
+	var filters []*listFilter
+	for _, ii := range m.filters() {
+		name := m.fields.QNames[ii]
+		f := &listFilter{
+			Param: "filter_" + name,
+			Label: fieldLabel(name),
+			Bool:  m.fields.Types[ii].Kind() == reflect.Bool,
+		}
+		f.Value = ctx.FormValue(f.Param)
+		if f.Value != "" {
+			if val, err := m.parseValue(ii, f.Value); err == nil {
+				conds = append(conds, orm.Eq(name, val))
+			}
+		}
+		filters = append(filters, f)
+	}
+	var q query.Q
+	switch len(conds) {
+	case 0:
+	case 1:
+		q = conds[0]
+	default:
+		q = orm.And(conds...)
+	}
+	sort := ctx.FormValue("sort")
+	sortField, sortDir := m.sortField(sort)
+	if sortField == "" {
+		sortField, sortDir = m.sortField(m.opts.Sort)
+	}
+	if sortField == "" && m.Editable() {
+		sortField, sortDir = m.pkName(), orm.DESC
+	}
+	perPage := m.opts.PerPage
+	if perPage <= 0 {
+		perPage = PerPage
+	}
+	total, err := o.Count(m.table, q)
+	if err != nil {
+		panic(err)
+	}
+	pages := int((total + uint64(perPage) - 1) / uint64(perPage))
+	if pages < 1 {
+		pages = 1
+	}
+	page, _ := strconv.Atoi(ctx.FormValue("p"))
+	if page < 1 {
+		page = 1
+	} else if page > pages {
+		page = pages
+	}
+	columns := m.columns()
+	var headers []*listColumn
+	for _, v := range columns {
+		header := &listColumn{Label: v.Label}
+		if header.Label == "" {
+			header.Label = fieldLabel(v.Field)
+		}
+		if _, ok := m.field(v.Field); ok && v.Func == nil {
+			next := v.Field
+			if v.Field == sortField {
+				if sortDir == orm.ASC {
+					header.Sorted = "asc"
+					next = "-" + next
+				} else {
+					header.Sorted = "desc"
+				}
+			}
+			header.URL = listURL(ctx, m, "sort", next, "p", "")
+		}
+		headers = append(headers, header)
+	}
+	models := adminModels(ctx)
+	var rows []*listRow
+	qs := o.Table(m.table).Filter(q).Offset((page - 1) * perPage).Limit(perPage)
+	if sortField != "" {
+		qs = qs.Sort(sortField, sortDir)
+	}
+	iter := qs.Iter()
+	for obj := m.newObject(); iter.Next(obj.Interface()); obj = m.newObject() {
+		row := &listRow{}
+		if m.Editable() {
+			pk := m.pkValue(obj)
+			row.Id = types.ToString(pk)
+			row.URL = ctx.MustReverse(EditHandlerName, m.Slug, pk)
+		}
+		for _, v := range columns {
+			cell := &listCell{}
+			if v.Func != nil {
+				cell.Value = v.Func(ctx, obj.Interface())
+			} else if idx, ok := m.field(v.Field); ok {
+				cell.Value = m.value(obj, idx)
+				cell.URL = m.referenceURL(ctx, models, v.Field, cell.Value)
+			}
+			row.Cells = append(row.Cells, cell)
+		}
+		rows = append(rows, row)
+	}
+	if err := iter.Err(); err != nil {
+		panic(err)
+	}
+	data["Search"] = search
+	data["Sort"] = sort
+	data["Filters"] = filters
+	data["Columns"] = headers
+	data["Rows"] = rows
+	data["Total"] = total
+	data["Page"] = page
+	data["Pages"] = pages
+	if page > 1 {
+		data["PrevURL"] = listURL(ctx, m, "p", strconv.Itoa(page-1))
+	}
+	if page < pages {
+		data["NextURL"] = listURL(ctx, m, "p", strconv.Itoa(page+1))
+	}
+	ctx.MustExecute("list.html", data)
+}
+
+func createHandler(ctx *app.Context) {
+	m := modelOr404(ctx)
+	if m == nil {
+		return
+	}
+	if !m.CanCreate() {
+		ctx.NotFound("model has no editable fields")
+		return
+	}
+	editObject(ctx, m, m.newObject(), true)
+}
+
+func editHandler(ctx *app.Context) {
+	m := modelOr404(ctx)
+	if m == nil {
+		return
+	}
+	obj, err := m.object(ctx, ctx.IndexValue(1))
+	if err != nil {
+		panic(err)
+	}
+	if !obj.IsValid() {
+		ctx.NotFound("object not found")
+		return
+	}
+	editObject(ctx, m, obj, false)
+}
+
+func editObject(ctx *app.Context, m *adminModel, obj reflect.Value, creating bool) {
+	fields := m.formFields(creating)
+	data := map[string]interface{}{
+		"Model":    m,
+		"Creating": creating,
+	}
+	if len(fields) > 0 {
+		f := form.NewOpts(ctx, &form.Options{Fields: fields}, obj.Interface())
+		if f.Submitted() && f.IsValid() {
+			o := ctx.Orm()
+			var err error
+			if creating {
+				_, err = o.Insert(obj.Interface())
+			} else {
+				_, err = o.Update(orm.Eq(m.pkName(), m.pkValue(obj)), obj.Interface())
+			}
+			if err == nil {
+				ctx.MustRedirectReverse(false, ListHandlerName, m.Slug)
+				return
+			}
+			data["Error"] = err.Error()
+		}
+		data["Form"] = f
+	}
+	if !creating {
+		pk := m.pkValue(obj)
+		data["Object"] = types.ToString(pk)
+		data["DeleteURL"] = ctx.MustReverse(DeleteHandlerName, m.Slug, pk)
+		// Show the fields which can't be edited
+		var details []*detail
+		for _, ii := range m.visibleFields() {
+			if !contains(fields, m.fields.QNames[ii]) {
+				details = append(details, &detail{Label: fieldLabel(m.fields.QNames[ii]), Value: m.value(obj, ii)})
+			}
+		}
+		data["Details"] = details
+	}
+	ctx.MustExecute("edit.html", data)
+}
+
+func deleteHandler(ctx *app.Context) {
+	m := modelOr404(ctx)
+	if m == nil {
+		return
+	}
+	obj, err := m.object(ctx, ctx.IndexValue(1))
+	if err != nil {
+		panic(err)
+	}
+	if !obj.IsValid() {
+		ctx.NotFound("object not found")
+		return
+	}
+	pk := m.pkValue(obj)
+	cf := &confirmForm{Confirm: true}
+	f := form.New(ctx, cf)
+	if f.Submitted() && f.IsValid() && cf.Confirm {
+		if _, err := ctx.Orm().DeleteFrom(m.table, orm.Eq(m.pkName(), pk)); err != nil {
+			panic(err)
+		}
+		ctx.MustRedirectReverse(false, ListHandlerName, m.Slug)
+		return
+	}
+	data := map[string]interface{}{
+		"Model":   m,
+		"Object":  types.ToString(pk),
+		"EditURL": ctx.MustReverse(EditHandlerName, m.Slug, pk),
+		"Form":    f,
+	}
+	ctx.MustExecute("delete.html", data)
+}
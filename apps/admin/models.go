@@ -0,0 +1,383 @@
+package admin
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/crypto/password"
+	"gnd.la/form"
+	"gnd.la/form/input"
+	"gnd.la/orm"
+	"gnd.la/orm/driver"
+	"gnd.la/util/stringutil"
+	"gnd.la/util/structs"
+)
+
+var (
+	formTags     = []string{"form", "gondola"}
+	fileType     = reflect.TypeOf(form.File(nil))
+	choicesType  = reflect.TypeOf((*form.ChoicesProvider)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	passwordType = reflect.TypeOf(password.Password(""))
+	// secretNames are matched against the lowercased field
+	// names to find the fields which look like secrets.
+	secretNames = []string{"password", "secret", "token", "hash"}
+)
+
+// adminModel wraps a Table registered in the ORM with
+// its Options and the information required for listing
+// and editing its objects.
+type adminModel struct {
+	table  *orm.Table
+	fields *driver.Fields
+	opts   *Options
+	// Slug identifies the model in the URLs, it's the name
+	// of the model in the ORM with every character which is
+	// not a letter, a digit or an underscore replaced by an
+	// underscore (e.g. example.com/pkg.Foo becomes
+	// example_com_pkg_Foo).
+	Slug  string
+	Label string
+	// fields which can be set by the form package
+	settable map[string]bool
+}
+
+func newAdminModel(table *orm.Table) *adminModel {
+	typ := table.Type()
+	opts := modelOptions(typ)
+	label := opts.Label
+	if label == "" {
+		label = stringutil.CamelCaseToWords(typ.Name(), " ")
+	}
+	m := &adminModel{
+		table:    table,
+		fields:   table.Model().Fields(),
+		opts:     opts,
+		Slug:     modelSlug(table.Name()),
+		Label:    label,
+		settable: make(map[string]bool),
+	}
+	// The form package uses different tags than the ORM, so
+	// a field might be known to the ORM but not to the form.
+	if s, err := structs.NewStruct(typ, formTags); err == nil {
+		providesChoices := reflect.PtrTo(typ).Implements(choicesType)
+		for ii, v := range s.QNames {
+			tag := s.Tags[ii]
+			if (tag.Has("select") || tag.Has("radio")) && !providesChoices {
+				continue
+			}
+			if isSettable(typ, s.Indexes[ii]) {
+				m.settable[v] = true
+			}
+		}
+	}
+	return m
+}
+
+// isSettable returns true iff the field at the given
+// index is of one of the types supported by the form
+// package and it's not inside an embedded pointer, which
+// might be nil.
+func isSettable(typ reflect.Type, index []int) bool {
+	for _, v := range index {
+		if typ.Kind() != reflect.Struct {
+			return false
+		}
+		typ = typ.Field(v).Type
+	}
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return typ == fileType
+}
+
+func modelSlug(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func adminModels(ctx *app.Context) []*adminModel {
+	var models []*adminModel
+	for _, v := range ctx.Orm().Tables() {
+		if m := newAdminModel(v); !m.opts.Exclude {
+			models = append(models, m)
+		}
+	}
+	return models
+}
+
+func lookupModel(ctx *app.Context, slug string) *adminModel {
+	for _, v := range adminModels(ctx) {
+		if v.Slug == slug {
+			return v
+		}
+	}
+	return nil
+}
+
+func contains(names []string, name string) bool {
+	for _, v := range names {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldLabel(qname string) string {
+	if p := strings.LastIndex(qname, "."); p >= 0 {
+		qname = qname[p+1:]
+	}
+	return stringutil.CamelCaseToWords(qname, " ")
+}
+
+func (m *adminModel) Type() reflect.Type {
+	return m.table.Type()
+}
+
+func (m *adminModel) newObject() reflect.Value {
+	return reflect.New(m.Type())
+}
+
+// isSecret returns true iff the given field looks like a
+// secret which shouldn't be shown in the admin.
+func isSecret(typ reflect.Type, qname string) bool {
+	if typ == passwordType {
+		return true
+	}
+	if p := strings.LastIndex(qname, "."); p >= 0 {
+		qname = qname[p+1:]
+	}
+	name := strings.ToLower(qname)
+	for _, v := range secretNames {
+		if strings.Contains(name, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// hidden returns true iff the field at the given index is
+// either listed in Options.Hidden or looks like a secret.
+func (m *adminModel) hidden(idx int) bool {
+	qname := m.fields.QNames[idx]
+	if contains(m.opts.Hidden, qname) {
+		return true
+	}
+	return !contains(m.opts.Visible, qname) && isSecret(m.fields.Types[idx], qname)
+}
+
+func (m *adminModel) field(qname string) (int, bool) {
+	idx, ok := m.fields.QNameMap[qname]
+	if ok && m.hidden(idx) {
+		return -1, false
+	}
+	return idx, ok
+}
+
+// visibleFields returns the indexes of the fields which
+// are not hidden.
+func (m *adminModel) visibleFields() []int {
+	var fields []int
+	for ii := range m.fields.QNames {
+		if !m.hidden(ii) {
+			fields = append(fields, ii)
+		}
+	}
+	return fields
+}
+
+// Editable returns true iff the objects of this model can be
+// edited and deleted, which requires a single primary key.
+func (m *adminModel) Editable() bool {
+	return m.fields.PrimaryKey >= 0
+}
+
+// CanCreate returns true iff new objects of this model
+// can be created from the admin.
+func (m *adminModel) CanCreate() bool {
+	return len(m.formFields(true)) > 0
+}
+
+func (m *adminModel) pkName() string {
+	return m.fields.QNames[m.fields.PrimaryKey]
+}
+
+// formFields returns the fields which are included in the
+// form for creating (if creating is true) or editing objects.
+// The primary key can only be set on creation, and only if
+// it's not automatically assigned by the database.
+func (m *adminModel) formFields(creating bool) []string {
+	var fields []string
+	for _, ii := range m.visibleFields() {
+		name := m.fields.QNames[ii]
+		if !m.settable[name] || contains(m.opts.ReadOnly, name) {
+			continue
+		}
+		if ii == m.fields.PrimaryKey && (!creating || m.fields.AutoincrementPk) {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+// value returns the value for the given field in the given object,
+// which must be a pointer to the model type. If the field is inside
+// a nil embedded pointer, it returns nil.
+func (m *adminModel) value(obj reflect.Value, idx int) interface{} {
+	v := obj
+	for _, ii := range m.fields.Indexes[idx] {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+		v = v.Field(ii)
+	}
+	return v.Interface()
+}
+
+func (m *adminModel) pkValue(obj reflect.Value) interface{} {
+	return m.value(obj, m.fields.PrimaryKey)
+}
+
+// parseValue parses the given string into a value suitable
+// for comparing with the given field.
+func (m *adminModel) parseValue(idx int, s string) (interface{}, error) {
+	val := reflect.New(m.fields.Types[idx])
+	if err := input.Input(s, val.Interface(), nil, true); err != nil {
+		return nil, err
+	}
+	return val.Elem().Interface(), nil
+}
+
+// object loads the object with the given primary key. If the
+// object does not exist, it returns an invalid reflect.Value.
+func (m *adminModel) object(ctx *app.Context, pk string) (reflect.Value, error) {
+	if !m.Editable() {
+		return reflect.Value{}, nil
+	}
+	val, err := m.parseValue(m.fields.PrimaryKey, pk)
+	if err != nil {
+		return reflect.Value{}, nil
+	}
+	obj := m.newObject()
+	found, err := ctx.Orm().Table(m.table).Filter(orm.Eq(m.pkName(), val)).One(obj.Interface())
+	if err != nil || !found {
+		return reflect.Value{}, err
+	}
+	return obj, nil
+}
+
+// columns returns the columns shown in the list, either the
+// ones provided in the Options or all the visible fields which
+// can be represented as text.
+func (m *adminModel) columns() []*Column {
+	if len(m.opts.Columns) > 0 {
+		return m.opts.Columns
+	}
+	var columns []*Column
+	for _, ii := range m.visibleFields() {
+		typ := m.fields.Types[ii]
+		if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Map ||
+			(typ.Kind() == reflect.Struct && typ != timeType && !reflect.PtrTo(typ).Implements(stringerType)) {
+			continue
+		}
+		columns = append(columns, &Column{Field: m.fields.QNames[ii]})
+	}
+	return columns
+}
+
+// filters returns the indexes of the fields which might
+// be used for filtering the list.
+func (m *adminModel) filters() []int {
+	var filters []int
+	if len(m.opts.Filters) > 0 {
+		for _, v := range m.opts.Filters {
+			if idx, ok := m.field(v); ok {
+				filters = append(filters, idx)
+			}
+		}
+		return filters
+	}
+	indexed := make(map[string]bool)
+	for _, v := range m.table.Model().Indexes() {
+		if len(v.Fields) == 1 {
+			indexed[v.Fields[0]] = true
+		}
+	}
+	for _, ii := range m.visibleFields() {
+		name := m.fields.QNames[ii]
+		if ii == m.fields.PrimaryKey || !m.settable[name] || m.fields.Types[ii] == fileType {
+			continue
+		}
+		if m.fields.Types[ii].Kind() == reflect.Bool || indexed[name] || m.fields.References[name] != nil {
+			filters = append(filters, ii)
+		}
+	}
+	return filters
+}
+
+// searchFields returns the names of the fields matched
+// against the text in the search box.
+func (m *adminModel) searchFields() []string {
+	if len(m.opts.Search) > 0 {
+		return m.opts.Search
+	}
+	var fields []string
+	for _, ii := range m.visibleFields() {
+		if m.fields.Types[ii].Kind() == reflect.String && m.settable[m.fields.QNames[ii]] {
+			fields = append(fields, m.fields.QNames[ii])
+		}
+	}
+	return fields
+}
+
+// referenceURL returns the URL for editing the object referenced
+// by the given field and value, or an empty string if the field
+// does not reference the primary key of a model in the admin.
+func (m *adminModel) referenceURL(ctx *app.Context, models []*adminModel, name string, val interface{}) string {
+	ref := m.fields.References[name]
+	if ref == nil || val == nil {
+		return ""
+	}
+	for _, v := range models {
+		if v.Type() == ref.Model.Type() && v.Editable() && v.pkName() == ref.Field {
+			return ctx.MustReverse(EditHandlerName, v.Slug, val)
+		}
+	}
+	return ""
+}
+
+// actions returns the actions available for the model,
+// including the builtin delete action.
+func (m *adminModel) actions() []*Action {
+	actions := m.opts.Actions
+	if m.Editable() {
+		actions = append([]*Action{deleteAction}, actions...)
+	}
+	return actions
+}
+
+func (m *adminModel) action(name string) *Action {
+	for _, v := range m.actions() {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
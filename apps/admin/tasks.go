@@ -0,0 +1,137 @@
+package admin
+
+import (
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/form"
+	"gnd.la/i18n"
+	"gnd.la/tasks"
+)
+
+const (
+	// taskResults is the number of results
+	// shown for each task.
+	taskResults = 5
+
+	runTask    = "run"
+	pauseTask  = "pause"
+	resumeTask = "resume"
+)
+
+var taskActions = []*form.Choice{
+	{Name: i18n.String("Run now"), Value: runTask},
+	{Name: i18n.String("Pause"), Value: pauseTask},
+	{Name: i18n.String("Resume"), Value: resumeTask},
+}
+
+type taskForm struct {
+	Task   string `form:",select"`
+	Action string `form:",select"`
+}
+
+func (f *taskForm) FieldChoices(ctx *app.Context, field *form.Field) []*form.Choice {
+	choices := []*form.Choice{form.Choose}
+	switch field.Name {
+	case "Task":
+		for _, v := range tasks.Tasks() {
+			choices = append(choices, &form.Choice{Name: v.Name(), Value: v.Name()})
+		}
+	case "Action":
+		choices = append(choices, taskActions...)
+	}
+	return choices
+}
+
+type taskRow struct {
+	Name     string
+	Schedule string
+	Paused   bool
+	NextRun  string
+	Running  int
+	Results  []*taskResult
+}
+
+type taskResult struct {
+	Started  string
+	Duration time.Duration
+	Error    string
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format("2006-01-02 15:04:05 MST")
+}
+
+func lookupTask(name string) *tasks.Task {
+	for _, v := range tasks.Tasks() {
+		if v.Name() == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// runTaskAction applies the given action to the task, which
+// was already validated by the form.
+func runTaskAction(ctx *app.Context, task *tasks.Task, action string) {
+	switch action {
+	case runTask:
+		name := task.Name()
+		ctx.Go(func(c *app.Context) {
+			if _, err := tasks.Run(c, name); err != nil {
+				c.Logger().Error(err)
+			}
+		})
+	case pauseTask:
+		task.Stop()
+	case resumeTask:
+		task.Resume(false)
+	}
+}
+
+func tasksHandler(ctx *app.Context) {
+	tf := &taskForm{}
+	f := form.New(ctx, tf)
+	if f.Submitted() && f.IsValid() {
+		if task := lookupTask(tf.Task); task != nil {
+			runTaskAction(ctx, task, tf.Action)
+		}
+		ctx.MustRedirectReverse(false, TasksHandlerName)
+		return
+	}
+	var rows []*taskRow
+	for _, v := range tasks.Tasks() {
+		row := &taskRow{
+			Name:     v.Name(),
+			Schedule: "-",
+			NextRun:  formatTime(v.NextRun()),
+			Running:  v.Running(),
+		}
+		if v.Cron != nil {
+			row.Schedule = v.Cron.String()
+		} else if v.Interval > 0 {
+			row.Schedule = v.Interval.String()
+		}
+		row.Paused = row.Schedule != "-" && !v.Scheduled()
+		results, err := v.Results(taskResults)
+		if err != nil {
+			panic(err)
+		}
+		for _, r := range results {
+			row.Results = append(row.Results, &taskResult{
+				Started:  formatTime(r.Started),
+				Duration: r.Duration(),
+				Error:    r.Error,
+			})
+		}
+		rows = append(rows, row)
+	}
+	data := map[string]interface{}{
+		"Tasks": rows,
+		"Form":  f,
+	}
+	ctx.MustExecute("tasks.html", data)
+}
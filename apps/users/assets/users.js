@@ -304,6 +304,11 @@
         return !ns._isMobile();
     }
     ns._onSignedIn = function(user) {
+        if (user && user.redirect) {
+            // Two-factor authentication is required
+            window.location.href = user.redirect;
+            return;
+        }
         var modal = $('#sign-in-modal');
         if (modal.length) {
             modal.on('hidden.bs.modal', function () {
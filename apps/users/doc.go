@@ -1,3 +1,18 @@
 // Package users implements an application for registering
 // and authenticating users, including social sign ins.
+//
+// Besides signing up and in, users might verify their email address
+// (see VerifyEmail and RequireVerifiedEmail), change it and delete their
+// account. Accounts might also be temporarily locked after a number of
+// failed sign in attempts (see MaxFailedSignIns). The signals declared in this package (e.g. VERIFIED
+// or DELETED) allow apps to react to these events.
+//
+// Users might enable two-factor authentication using TOTP codes
+// (RFC 6238), generated by any authenticator app, at the
+// TwoFactorEnrollHandler. Single use recovery codes are generated
+// when enabling it. Users are locked out after MaxTwoFactorAttempts
+// invalid codes. Use RequireAdminTwoFactor to force administrators
+// to enable two-factor authentication. Note that it requires setting
+// an encryption key in the App configuration, since TOTP secrets are
+// stored encrypted.
 package users
@@ -58,7 +58,10 @@ func signInFacebookTokenHandler(ctx *app.Context, client *oauth2.Client, token *
 	if err != nil {
 		panic(err)
 	}
-	ctx.MustSignIn(asGondolaUser(user))
+	if !signInOrVerify(ctx, user) {
+		ctx.Redirect(twoFactorURL(ctx, ctx.FormValue(app.SignInFromParameterName)), false)
+		return
+	}
 	redirectToFrom(ctx)
 }
 
@@ -77,7 +80,10 @@ func jsSignInFacebookHandler(ctx *app.Context) {
 	if err != nil {
 		panic(err)
 	}
-	ctx.MustSignIn(asGondolaUser(user))
+	if !signInOrVerify(ctx, user) {
+		writeTwoFactorRedirect(ctx, "")
+		return
+	}
 	writeJSONEncoded(ctx, user)
 }
 
@@ -81,13 +81,37 @@ func (s *SignIn) ValidateUsername(ctx *app.Context) error {
 
 func (s *SignIn) ValidatePassword(ctx *app.Context) error {
 	if s.User != nil {
-		pw := getUserValue(reflect.ValueOf(s.User), "Password").(password.Password)
+		user := reflect.ValueOf(s.User)
+		if isLocked(user) {
+			return ErrAccountLocked
+		}
+		pw := getUserValue(user, "Password").(password.Password)
 		if !pw.IsValid() {
 			return ErrNoPassword
 		}
 		if pw.Check(s.Password) != nil {
+			failedSignIn(ctx, user)
 			return ErrInvalidPassword
 		}
+		// Failed attempts are only reset once the user has
+		// completely signed in (see resetFailedSignIns).
+		if pw.NeedsRehash(PasswordOptions) {
+			// Upgrade the stored password to the current policy.
+			// Failing to rehash or to save the user must not
+			// prevent them from signing in.
+			if rehashed, err := password.Encode(s.Password, PasswordOptions); err != nil {
+				ctx.Logger().Errorf("error rehashing password: %s", err)
+			} else {
+				setUserValue(user, "Password", rehashed)
+				if _, err := ctx.Orm().Save(s.User); err != nil {
+					ctx.Logger().Errorf("error saving user after signing in: %s", err)
+				}
+			}
+		}
+		if RequireVerifiedEmail && !getUserValue(user, "EmailVerified").(bool) {
+			resendVerificationEmail(ctx, user)
+			return ErrEmailNotVerified
+		}
 	}
 	return nil
 }
@@ -28,7 +28,7 @@ func Current(ctx *app.Context) app.User {
 
 func Get(ctx *app.Context, id int64) (app.User, error) {
 	_, userVal := newEmptyUser()
-	key := "gnd:la:user:" + strconv.FormatInt(id, 10)
+	key := userCacheKey(id)
 	if ctx.Cache().Get(key, userVal) == nil {
 		return userVal.(app.User), nil
 	}
@@ -42,3 +42,13 @@ func Get(ctx *app.Context, id int64) (app.User, error) {
 	ctx.Cache().Set(key, userVal, 300)
 	return userVal.(app.User), nil
 }
+
+func userCacheKey(id int64) string {
+	return "gnd:la:user:" + strconv.FormatInt(id, 10)
+}
+
+// uncacheUser removes the user with the given id from the cache
+// used by Get. It must be called after modifying a user.
+func uncacheUser(ctx *app.Context, id int64) {
+	ctx.Cache().Delete(userCacheKey(id))
+}
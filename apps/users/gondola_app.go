@@ -19,7 +19,7 @@ var (
 func init() {
 	App.SetName("Users")
 	var manager *assets.Manager
-	assetsFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec;\u007fs۶\x92\xf9۟\x02I}\x115\x91(Ɏ\xd3F\xae_\xf3\xb3\xad;i\x9c\xab\x93\xe9\u0378~\x1a\x88\x84$\xda\x14ɐ\x90e7\xd6w\xbf]\x00\xa4@\x10\xa4d\xe7\uef79\xb9(\x19[\x06w\x17\x8b\xc5\xfe\x06\xb8\xc8X\x9a\xb9\x17ك\xff\xc5O\u007f\xd0\xef?{\xf6\xf4A\xbf\xdf\x1f|\u007f\xd0\xd7\u007f\xc3g\xff\xa0\xbf?x0\xd8\xdb\u007f\xba\xb7\xbf\xf7\xec\xfb\xa7\xfb\x00?\x00\x94\a\xa4\xff\xe0_\xf0Yd\x9c\xa6\xc0\xcaW/R~\x8a\xdf\xffG>\xced\x11y<\x88#g\xb7C\":gm\xf2e\x87\x90+\x9a\x92Q\x16L\xa3 \"G\xa4\xf5\xe5\vI\xd9\x15h\n#/Na\xf48\"\xabU\xebP\a\x8c\x17\xdc\x06y\x02\xc3\n\x14\x1e\x05\x13\xf2\xe2g\xea\xb1q\x1c_\xbeL\x12x\x02\xe3\x8a\xc8d\f#Ǿ\"\xa2\x83\xb90\xaah\x14\xb0\x1fX:\xcf\x00V\aű \xcb`1\xd9\xedE\x16#\x8f\x1a\xceE\x96é\x15\x18\xdc\xfev*\xc7s\xa8ʔ\xafg4\x8aX\xf8)\rM\xd4\x1cEAh\vf\x91/\x17\xa9\x16\xffK\x1cOCf.\xfd\"\x93\xe3͌I\x18\x1bqI&By\xec\x9e\xe1&\x9ek\xdfnoɮ\xf3eՖk\x892\xf7\xe7W\xa3?\x8f\u07fd\x1b\xbd;y\xf9\x06\xe7\x92.`2\xee.\x830\xec\x861\xf5[:,\x82\xbd-\x03\"\fӠ~99\xf9\xe5\xdd[\x1bթ\xe0\xd9FY\xe1\x98\xd4\x15\x829\xc3\xe9\xf1/\xef\u07fe\x19\x1d\xbf_C\xa2\xd21\xbf\x1bD\x15\xa8\x93O\x1f+`\xa0\x9e\x00\x97\x03\x8e|6\xa1\x8b\x90\v\r\x12\x83\xf8\xa1\v\x1eO\xc6C2\xa1a\xc6:\xa4\xd7\x13#\xc0\xcb\x14\xac@P\x83\x9fA4%\xf9~\x17\x98\x17ِ\xf0t!\x91\x00\x92\xfcvJxL2ܡI\f\x9aZ@&q\xb2HLh9\x88\x90$\x8b\xbd\x80\x86\x04\xb9\x86\xa5\xad\xf1\xe6\xf18\bY\x8e\xbd\xe6\xb0\x19\x9d\x80\x11HL\x8d\x90OC9?\xe2\xfbA\x96\x84\xf4\x86P\xf9@\x12\x11v?%A$\xb0V\x9a\xdc\xe4\xf6\x8c\xe4\xf6\x80\xf0\x04#\xeb\xe7A\x14\xa0\x17(|J\x9c௬\xad\t\x19\xac\xc0AJ\b\n\xac\x06\u007f3_\u007f\x8c\x9f\x94\xf1E\x1a\x1d\x16c\xab\xe2\x1b\"*\x9a\xa8\xe1.\xbb\xe6 b\xd0\xeeNiW;$\x9f\xf8\xb0\x84\xaa\xcd\t\xe8(\x82\xc3\x12_\xfc&a\xf1d\xed\x8a\x1e\x1e\xa1\x1aE@6\x00\x1dj\x91Ǐ\x8bg&\xcf\xcb \xf2\xe3%\x18\xc7\xcb\xec&\xf2\x8e\r9\x98\xd0\xf8\xf9\xf9\x95\x10\x97S}\"t\x11'\x19\x16\xd3u\xac@^ᑆe\ae\a\x87H\xc7\x17\xb9\xee\xd9\t\x82R\a\xac\t\xe2z2\x9e+\xf5\xa9<_i\xe2\xd6V\xf9\xf6\x8aE\xdc\xcd\x16\xe3\xccK\x831sZ`U3\x17\u007f\xfc\xc1\xb2\x04v\x89!\xe3S\xd6\xea\xac\x05\x96\xaa'6\xc1\xe9Z\xa4\xf6ٕ\xa6[\a]\xa8NT\x0e\x01\xebi\x0e\xadx+\xc2@\xbd7\x10\xf5f̻\xd4CPV\xc4T\xe24q\x94\x13\x98\x8cG9\x1f\xa03\xf9\xd7\xc3Z\xbcU\x1d\xb7[m\b\xcc\xc8\xd3`:e\xa9\xa3\xfbw\x03rU\xfe\xb3\x82T\xb8\xfav\x05p\x84ۜp\xa7\xd5\xeby1\xe8\xa3\xc7݉\x92\x8e\x1b1\xdec\xd1\xe8\xd3i\x8f\x86!d\x9d\xad\xb6\xcd\xcauS4B\xa3a\x915V8\x1a\to}\x12\xadq\x99/\x02kI\xc1l\x9b\x03.\xf1\xe3,\xc8\n@\x02\xdf=`\x16<\x06\xcd\xc0\xbd\xc2\b\xfc\xe63F\xc6\v\xce\xc5s\x1b\x8d\x14\xdc\x12K\x01\tւ\xc0\xc8\x0f\x92\xa2aʨ\u007fCdT\x02\x0f\xeb\x92\xdf \x03%\x98@\xa5\xccF\t\x1c\x89NcF\xb3\xa8ŉ\x17\x06\xde%\x10\xd0\x18q\x18\xda\xd9\x02x\xbd!K+\xa9y0\x9dqB}XJD\xd0\nc\xe9\xce[\xca]\xb6\xdd\x1d\x9b\x9d\xa9\xadX\xbb\xffS\x11\x1f^+\x16\x8e\f'\t\xf9\xc6\xc3\x1a\xd8:k0\x1d~\xbdF#?\xb8u\xae\x17\xfb\xb5\xcea\xd7M\xe2\x8c;\x86\xeetH\v\x91\x8eZ\xe4\t)Hh.ǧ\x9cv\b\x87\x90r*\xfcd\x87\\|\xfe\xaf_\xff\xd8\xecSr\xf5\x12\x14\xeal\xb3\xbdiu\x86\xc5\x15\x9a,UY.\xe4$z\a\x81wS`\xb1\xc5\xe9r\xac\xb3\x9bu)#3\xfd\xc1\xceF\xbcm\\\u008csH_z\xbd$\\\xe4\xa9\x1elüw\x91\xf5@\xa3A}\x87\xf8$\x8e\x18\xf8\x86\x9f\xe2\b\xd9?\xb2\x89\xc0\xee7p&\xca9\xf5f\"\xe4d\x8e\x82Z\xed\x94\xf9\xd0\x05\x98\xa5\x9e.C̣1\xb5\xf0co1ǰ偽r\xf66d\xf8\x97Ӓ\x04\xf4\xe9A\x14`\x1f\x98o\xa2\xea\xf4.\xe8\x15U@:\fŬ\xa0\xb2\x0f\x90\x9d\xa68\n?\x0fK,$:\vS\xc6\xd5\xfc٫\x9b\x8ft\xfa\x1e\xd2\xfa5'g\xfd\xf35n\xe2&\x14\\\x0f\u007f\x0f\xaa\r\xd9\x05\x88\x8d\xbfb\x90\xd21\a\xd49\xa9\x88\x03\xe4\xfaA\xe6\x8e5*%\rSO\xbb\\\x95lB*$Ӹ\xec\r\xcb.y\x9c\x00\x1eX\xbe\x0e\xa9\xa7\xac\x95\xa9\xf5}\xd2g\x87U\xeb\f\xc0\x9fR\x14\xb2\x8c)d2\x8e\xfd\x9bv%\xa7,\xd6\xe3\xb4M\xc3\xf8\xca\xf4N\xf1\xe2\x02\xa4\xef\xb4\\\x99fwU\x9aMh\x11\xe1ZmW\xb8f-\x05\xa8uS\xccMR\xe1\xb3\xdfȴթ\xf1\x1d\xb0\xb2I9m\xb19\x93\x8a\xc1\xeel˻\n\x02m\x97\xc1\x8el\xc8]P9)\xea1\xc4\xc8C\xeb\xd3 \xe2,\xbd\xa2X#g\x8c\x1f\xab\xbf\xb6H\x89\xf2M,\xb9\xae&\uf2f3\xad\x8b\x81/\xab\xfa\x8ciס.(\x1cd\x9f\v\xce2c\xa1\x0e\x88\x85]w\b\x02lJאG\x84s\xb1\xb4\x16)-O\x9d~\x87\x1c\xb4\t\xea\x12\x06\x80nk\x13\x11\xfc(\xbe\xcf*\xc4\x0e\xdaX\xbb\x8ba\x10ۢ!\r\xb4\a\xc8MI\xa2\x12\xc7\x1d\xd4\xf4\xae\xea\xba\xc5욗\xaa2\xb2M\xcal\xcdCj\x82\xdc6<Mi\x12\xb8\xb2\xecue\xeaVb\xd2VOVJ\xa7\x90ѴP\xf7\xdc\n\xea\xd2\x01\x8b\x01\x83\x16\xf5M#n\x1fnm\xc6\xdb\xdb\xef.\x1a/\x96\xcf\x0e~\xb1p\x88Z.\x80\\Th\xa7%\\x\xab]\x9bn\t\xd0;\xe9\x13\xb2\xb1\x10}4\x89\x8c\xfa\x0e\xd9A\xca&\xad\x06\x11\x8b&\x17\xd8J\x81&\xd9áMh\xcb\xc0\xe73\x03O,\xab+\x9elB\x9f1\x91:\xdb\xf0\xe5\xa3&\x02\xa0\xb0q¢\x97P\xf0\x82>\x05\x1eE\x01\xfd)\xf2;\a\x85\xf0\x84\xb4~\x92\xe9\xdeѠ%\x9b\xb0\x1d\xc9pGM\xdc@|[\xab\xdc\"\x01\xad\xad\xc5\xf4\xa0~\x91\x99\xfb*ě\xd2$\x81\xea\xe4H\xd3\xd0\xef\x84j\x82\x84\xb0\x01\xd6U\x10\xa6\x9c\x90\xbcz\xe4\x86,\x9a\xf2Y\x9d\xd6fyoԜ!\x88\xc4\f\xb6\x1dX\x83b\x94\xeb*\x86\xee\x12\xa3\xe5\xac\x10\u007f}v\xb2\xe0%Id\tc~\xa79\x15/\xb2y\xb5D\xa4\x03\xf1\xbbB\xe6\x0e\x9b\xb6\xed\xa6\xaf\xb6\x94G\x10\xddE\x1e\xfaB\xbeF \x9a\\\xffM\xf2X\xd58V\xd4%⊊\xa3\v1y\x1e\xf0B>\xaa\x85\x04\x00\xa7\xe2\x81AQ\x93\xee\x19\xba\xb2\xa3GXj\x16\x87#`\xe6\x8fη\x95\xf56+\xca\xcb\\5\x01x\x8eE\x18\u07bf\xa0E#cW\xa2\xad*\xd2r\xa7\xd4P\xaf\x91|\xb9L\x05d\xe4\xa16\x8d͋FvUC\x0e\xdd\xc1Cv\xe5bU!\xd6\xfdAJ\x81\xf9N\xbbѾ$\x1ba,\x9d+\xc4pL\x1f\x9d{7ʶ\xf3\x85\xa2cn\xb2\u0560\x05\x10\xa6\xffǕ \xcaO8\xaaeAu\x11\xeb\xf2\xcb섚\xad1\xb3\xf7\x9a\xf7]pܕ}d\x91\xf1\xaa\x0e\x9f\xaa\x9e\xb0\xef\x13dE?\xa4m\v\x15\xa8\x95X-\xa7\xecsъ\x11T\xf5\x86\xb0Z\xd3\x1f\xec\xf3\x82e\xbc\xbc\xacuw\xa7\xbc\x84\x0e\x91\n\u007f\x1f\xfd\xdf\xdc\xc8i\x12f\xb5\x03\\\xea0,<\x8fe\x953\x90\xfc\f\xd3d\xe7\xe7W.$\xa3N\xab7g\xbdd}\xa0i6\xc7\xeb\xc2d\xa2\xa6\x17\x1d.\\G\xa9?\x90\u007f\xf0\x94\xc7\x11\xd5Z\x00\xb0\xfdC\xfc\xfdcq\xac\xaa\"1\x8e>y\xd2T\xae=\x14\xb3\x9d\xe5xgAp~\xded\xa6\xb2\x9d\xb0\xadMVF\x94$\x1b\xf5\xdcֱ\xef\xf5\xc8\xfb\x18&\xff\xbc\b\xb0%\xabIug\x03yc\xa7'\xb56c\xf6\x90\xc0E\x98EiӦ#\xf8Y+\xf3 Ola\xfdW\xec\xc4E\x1cDN\xa33\xd2O\x0f\xc0\x04\x8d!\xbb\xad\x9a\xd3[\xcfF\fJ\x96\xae^\xf9\xe0\xa2h\xa4l\xd8\rPpq\xa2\xea\xd8f\x15\x95VV\xbf\x03\xd3\xf29\xb9U\xfeuG\xed\xe6ޔ7\x1fe?\x14\xd7\tT\xd3\x18\a\x8a{\x04r#Z\xa4\xd5.\x1f\x8bɮe\xe0\x0f奅bNue\xa1c\x9ck\xfa\xa0\x80\x1e\xd8@\x00\xf0\xe8\xc5\xe6\xa0o\x14O\xbeJpT\xe8!v\xab\x00,\x9eLB\x90\xaa\x01\"\x0f\xea\x92\x18\xc2\xc8\r\x00\xe1\xa9t\xc8F3 9\x8a!\xc8BRg\xc0\xd30\x1cS\xef\x12`k\x0eG\f\x84`>\an)g\xea\xb0\x19\x8d(\x86\x02&]\x06\xf0\a\xfaj\x82\x87\xfa\xf00\x90\x87Ƌ\b\aar<\\\x153\xecX\xaa\xdd{u\x88\xb6\xee\f\x01\x8f\xa7\x8c\x81p\xe6\xd8$\x84\x89H\xcdbw\xbe\xb6\xa9 \x1a\x06\xe2\x1cSf\xb2\x8e\xa1\xb6U\a\xb6\xb1?`6\x00\xca\x17<\n\v\xa8+%uk\x80\xaa\xd2^G\x1a^j\x82\x81X\x89h\x84\xc1Xt\xa2x\xfc.^\xb2\xf45\xcd\x18l\x05d,#\x19\x8dG\xc5U\v%\xebO\xa0\t\xa2\x97\xc0cB\xaf\xe2\xc0\a\r\x9f\xb0\x94E\x1e\xde\x1e\xc0\xa3)\xa4\x97%`\xe1:\xda$\x8d\xe7\xe2\xa9Т\x19\x8d\xfc\x90\xa5;\xe5T\xeelr^Z\x10phwZE\xcc\x16 FB\xceB\xc6ٚ\xe2a\x9dF.\xc5-'%\xdcue^_\x85\x03\x82[\xf4\xa2y\xc0Cq\x00\xf0Z:X\xb1\xf8\x98\xe4\xf2D\t\xba\xae\xab\x89NuՁ\xc8auokv\xb3v\x1f\xf3\xce\xc6\xef\x14\x94q\x0eNJ\x0e\xdcޒg\xfd~\a\xfcZ\xcaX\xe4\xca\xc1.\x19\xf4u\x15+\xda\x1a\x05\xb2\x1a\x01\xec\x83\xc1\x1a[\x8d\x9a\xe8(\xba\x90M\x90\x80\xa3\xcf\xd3\xdbkw\x9d\xfc[\x19\x9cǉ\x06-\xe9\n\xf0\xe2\xabML\x98ۣl\xa4(Z\x90\v\xb5$\xbc\xc8 \x15w 掘T\f\xca\x05\xe3\x18\xec\xd1bLӣ~\a\x82U\xf07\x1d\x87\f\xbe\xf38\x0e\xe5(\xb0$0\x905\x84\xc7\x15\x89\x01\xfcb\x9e[d\x96\xa3\xe4ܷZn\xb94%\xc39\x1a¡\xf2:\xed\x8et\xb5\x9b\xd2\x19\x91r*d\xe0#\xffZ\x89\xcfx\xb1\x91Kwv\x1c5d5%\xb8\xe6\xa0\n\xb6\n\xeb\x8a9y\xf12\f\xe3%8\x80T\xc6\xe26\x940\x9f\x89\x03\x89#yq*:\x93\x1f!|A\x9e3h\xeb\xe1WQ\xda\xcd8\x19\x1e\x11\xd1x/\xc1\x93\xbe\x05\x1a\xe6\x05ڀ\xe3\xe2\xc9\x17y\x94g\f\x8fLح\x0eKЭ\xa2LM\xaa2@\xd4\xd1\xd4\xf3\x8e:\x8a\x16\xccu\x9fS\xbfч\x93\xfe*ݞ\x98\xbb\xb8h\xf8\x15]Ö\x14\xab\x9b\x13\xec(9\xbb\xe2@\xecOa\r\xabUy\xf4Wi8\xab\x95eA\xeb\xc8SwH_\x85\xc2\xd5\xca[d\xd8\\^\xb7\xe7Ęޟ\x13\xb5\x83\x18\xadi\xfa\xed⡧\xa3\x8afX\x9c\x80\x15\xeb\\g\tw(\xefDkCr\xb5\x8bM\x88\xb9\xac\xf0\xec\xe5t\xf9\b\x99\xd9Z\xf8,t=(\x14Z\xea\xf2\x1c\x8a?\x8a#k\x13\x1a@\xb1c\x16\xf9\x1fc\xe3\xf4\xd2\xd2Щ\xcaL\xf6\x18\x9cV6\x8b\x97&\xf9\x15\xbaA>\x0f\xcd\xf1\xfa\xebsR\xe4\x16\x92\x85/X;,\xcd\x11\x10ۙ\xf0Ç\x85\xdf2h\xa8N\x90\x1d[?\x87UM\xa3\rwz\xd4|\nڶ\xac\xfc\x94\xbah=\x15\xac\xb04\x8dS\b˜\x02\xe9tӒ@\xfe?B\xb6\x12A\xaeF\xb3\xec\xe8ь\x85Iw\x1c\xc6\xe0f\x05\xa1\xaeJ\xd7\xe5_\x8f\xfe\xf1c\x0f\xa1\xffQ\x11\xe2(\xc8~\x17gߛe\xd8{\x19\xf9)dN\xb7K6>9\xbd\r>\xcc@\x8f\xe0\x17\xf5\xe1G\xec\u07fe\n\xc1\xb3\xbf\x82\xf9nn\x8f\xdfJ\xa2\xb7'P\xc0R\xf2{\x10\x05\xbd\xc0\xe5,\xe3ND\xaf\x82)\xe5q*d\xffr\n:f\xe1I\x1d\xd4odJ_A\xf5\n\xc5:\xe5jJ\xd0\xee\xe4\n\x9a<\x81|\x063\xb4f\x81\xefC\xce0V}\xb7\x92/\xb0\x19\xbd\xc4L\xd9<\xbebM-\x83\x8aa\xc0D\xcc~\xc1\xa4\xd2\xed,kdC\a\xf5\xf8}\xf9*\xea\xba\x13\xda!gH\xe5ܐ\x89\x1e\xe4\xdb\r\t\x80\x90|GT)\x96\n]K\x13\xb6-\xcfE]\x80\x19\xbaH\x88G\x9f\xc1\xcd៦\x93\xc1{r'oN\x86\x90\"\xce\u061cuS\x16B\x84\xbab\x18\xee\xb2J\xf5&\b\xe2\x95\x11\xfcr\xd6?\x17\xfd\x88^+\xbf1\x82u\xabx$\x8eы\x11\xb3\xab\x8b\xedԶ\xb5\x0fl\x83D\xf5\x04\x92\xc6\xc6\x17\xc1\xffN}fX\xed\xf1D]\xb0\x86\xf8\x9a2\x8am\x81%k\xa5P\xc5pQ̠\x86\xe77\xa6\r\xd4\x04}\xc62\x80\xeax\xc1\t\x95\xd2Mh\n\x91\x9a\xe3\xde\xc9f5T\r5\xd7\xffD\x9d\xedQ\xbc\xe9M\x8b\x1e\x02V\x18=w\xe7~\x8d\xf0Um+\xf3sVr\x0f\x97\xa6Mc\xeb֜#\x83\xc2֛\xe5\x17\x18\x06m\x17\x82b\xc0\x9d\xd6c]g\xec\x1dGZn5V5\x11\xbd\x15žbN\xf4\xc8v\x82\x98(2\x10JȞm\xff<\b\x01Ad\x16\xf2\xab*!\xa5\x9b\x976\"\xca?\xfa\f/\x0e~\xfa\xe3\xf8u<O\xc0[\x83\x9d'g\x83s\x105\xe4\x02\x1esz\u007f=\xe9M;\xe4\x11y\xd4\xde \xf6\xe6\xd8%l\xc0\xa8\x03+.\x16\r;\x05\xcfE\xb97sz\xff\x14\xf7\xeb~\x1a\xfe\xd5\xfb\xabw\xf6\xcf\xde\xf9\x93^\xb5\x9c\x9a\x93\x9f\x880\xc1\xa1\x9a\xb4z2P\x1cp\x95t\x81UtA>8\x12o8\xa83\xf1\x8b\xac+\x87+\xe9\x9e\x1c\xde\xfe\xaa\u007f\xd3\xe1\aήn\xbdZ\xae1\b\x0f\x86\xa7xG\nH\xdd\xe4\xc8\xe4ឡ\x95\xf3\xfcB\x858\xa6\x9e\xa6\xb18 \x96a\xe35\xe6\x01\x10\x10h\xd6\x15!\xbf\x0e\xb5\x94\x1d\x00v~:Z\x1f\xa0\x14Ӗ𤇦\x9c\xfd4ND\xb2\x89\xb5\xabߪx{\xedXEp\x05\x11A\xbe\xe5\xa0\xd3\xddu\xe9\x05\xbd6\xde6P=\xc6\x0f'\xa7\x1f\x8d\xfe\xdf\x02\xdf)\x90{V~\x80\x13\r\xe5Q\x8b\xad{>\xbc\xd7\xf9\vj\b\x82KIfug\b\x85\x13\xb9\xc4\xde\xde\x16\b\xeb\x96c\"^O\xd36M\f\x89W\xa4D\xb1\u007f\x89\x05\xd5\xf9\xa6{ ~\x80\xe1]\xa0\xae\x95\xaa\xac9\x93 \xcd\x1a\xefF\x01\r\x97\xfa~\x93n٦\xf6\xb4,\xb6\x92\xd96\xcdW \xba\xb8\x15\xba\x98\xcf.Ϸ\xc2۶֩b\xaeK\x9f\xe0j+\x04u!`\xeb\x83\xdb&+1\x9b)[\xbdձݕn\xe3\xe6L\xd9\x12\x84p5;\x10Z_6\x04\x01\xf2q\x96\xc6\xcbȦ\xba4d)ĺ\xb7\x92\x90hN\x15\xc8\x16n\xee\"\x80U\xc5ѬvVm\xe7\xe2?\x17,\xbd騗\xe6ps\x1f|\xfb\xfc\u007f\xf8ȗ$C\xf0\xdc\xff\xae\xf7\xbf\xfb\xcf\xf6\xf7\x9f\xaa\xf7\xbf\x9f\x0e\x9e\x0e\xc4\xfb\xdf\xfb\xfb\a\xdf\xde\xff\xfeW|^L\xc6^\x1c\xa2\x9b\xf9n\u007f|\xf0\xfc\xf9\x0f\x87;/\xa6\xf9\x88\xef?\x1d\xef?\x87\x11\xbe,\x80\xf6=o2A\xa0Y1D\xf1\x1f\fI?4J\xa1\x98\xc1\xd7\f\x9f&א]\xba\x10\x17\xa1\xf4\xf4\xbb^\x9cF\xf8\n\xad\xf3\"\a8H\xae\xa5\xf7\xeb.\xd9\xf82\xe0\xddq\x9c\xfa,\xed\xe6\xcf\x15 \xba\xa9\xee<\xfe\xbb\xe1qݓ\x956?\xb6\U000db630M\xd2\xe5q\x82x\x02L\xbaK\x83W\x00\x90\x945z\x12p#\x80e\xbaq\f\x12\x9c7\xcf(a\x9a'\xad\x85A\x81\xa8\x9b\xc3\xf9kc/\xc4>\xe620\xb7\xcb)慠\x19\xd8S\x98\nH\x10\xb6\xc0\xaf\x8e\xe7l\xe3\xe95M!?\x02\x02X,Ix\xbc\xad\x93^\xb2\xa8\xf8s\xd0\xff\x8f\xb6\x85zw\x9e}%\x85{`\v!\x0e\xc9 \xb9\x86z?\f|\x13v\x0f`K\xb2\f\xbc\xaa$\xb1\xf0O\x19\xc8q\x0e\xd9\"\x13=\x02\xc8<)\x94\xf2\x92H\x16\x93%S\xe7\xa63z\x85\xa7\x864\"\xe9tL%DN\x042]\xc4}}z\xeaVֆ\xe9\x923\x0f\xae\v\xce\x10\xdd\xd9;8\x00\x1e\x8b\x1f}wp\xd0n\xe3\n\x05ۚ\x9a\xa4\xd8\xffoX\xe7\xc0\\\xa7j\xe3u\x881\x00\xffQ\xccj\xe5s\x9aNaL*!\x90/\xb4\xf3\xb1\xe8O\x16w\xd6\u05c9OImu\x86\xbec\a\xf8O/\x8d\x8b\xf3\x1d\r\xbf\xac\xd3N\xee\xd4\xf4\xeb\xf3\xaeأr\xae\xa5o\x9f\x8ed뎨#\xa0\x86I\xa7\xf7\x98s\xda8%_\x06\x9cC\xc6_?\xa7r\xccw\x9bt\x8dd_h\xc0g\x8bq\xd3Bg\xf7Y\xe9\xaciR\xaa\xbf\xf3%+\x8d!\x11\xadw\xed\xe56\xa8\x9a\xc0J@=\xfa\xa0\x1fk\xad\x12\x9a\xd5!\x8f\x87WA\x16p\xbc\x82\xfcx8\x83\xaa:5\x0f\\U\xbc\x9a`\xfc*\x97\x95\x11\ao%\x8da\x1c\x87\xbe\xe5q\x06\xe54\xcc\xfcL\x9fT0\x15Ü\x90\xe5\x0fI\xde\x015\x9eK\xa6\xbbʧ\xf7M|qN=${\xdf?7\x9fH+\x1a\x92>\xfc+/v\xb5A\ued88g\xf7\xe3\x15\x91K;\xee\x1a\x92//\x94\x8e\xc16\xc1\xa5\x95\x9f\xcb\x05\xf6˃\x10\xfc*cj\xc5O+\xa2\x98\xa9\x1d@?e\x90\x81\xea\xa7KCp\x1bC\xe2a\x9b65ދ\t,E\x94\xb6m\xfb\x95\xb9\xf4\xf9\xecO\xc1\xf3\xaa\x8b\x05s|A[t\xd8\xd64\xd1+\v\x9fe\xc3\xc36\x05\xbalPA<\xb8\r\x15\xcf\xea^\xc82N/ݺ\xabK\xd8\xc0\x1b\xe2\x8b\xf3\xa84n6\xa7\xd9\f\xbe\xcc\xe9\x94\xfe\r\xfb\"ޕ\xdd\xeb\x0f\xf6{\xfd\x1fz\xfd\xe7\xbd|'\xba\xe2\xde\x15\x16\xd1a7\x9f\xb4[Lڅ\xfa\xbd\xf7\xdd\xdbk\xd8\xe1Htl\xab\xef\x05l\xde~]#\xf1\xcd\xf1\xea\xd3M\nR\xa7\x0f\xb5\xca#\xfa\x83r\x8b,O\xf2X\xd1\xdf\xd4ڮ\x86\xafr\xb42\x16\xaf4\xcbꐪ\xe2Q\xe4U@\x83\x18\xa1\xd0\xea܂\x10@w\xafH\xc5\x14Cx\xd2h\xb2\xa3͒\v\x1e\xdd\x00x\x03\xf1S\x10(\x9f\xab\x01\x05\xe9\xc1\xf3Qy3ɲ\x10\xec\xe0\xe4\v\x90(\x82@\xd7\a)\xc5S\rcN\xaf\xbb\xca`\xf7\u007f(\xec$G2\xdfA3\xaf%\xe9\xb6,$\xf5\x9d|\xaf\x02\fd\x1a\x8b\xcc0\x0f⺏\xdd˧\xd1\xcd^(\xc2a\xad\x84,.|\xf5\xad\x85\xf1\xed\xf3\xed\xf3\xed\xf3\xed\xd3\xf0\xf9\xef\x00\x00\x00\xff\xff\x15g\xef\xd1\x00R\x00\x00")
+	assetsFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec;ks\xdb6\xb6\xf9\xec_\x81\xa4Y\x93\x9aH\x94d\xc7٭Tm\xf3hں\x93ƹu2\xbd3\xae\xd7\x03\x91\x90\x04\x9b\x02\x18\x00\xb4\xec\xda\xfa\xefw\x0e\x00R$\xf8\x90\x9c\u07bb;w&\xccL\xa2\x80煃\x83\x83\xf3\x00SI\x84\f.\xe5\xa3\xff\xc3g0\x1c\f^\xbcx\xfeh`\x1e\xe7\xdfã\xc3\xc1\xe1\xa3\xe1\xd1\xc1\x8b\xa3\x83\xe7\xc3\xe1`\b\xf0\x7f\x7f\xfe\xf7Gh\xf0\xe8\xdf\xf0\xa4Ra\xf1h\xf0\x97y\xb9\x93\xfb\x7f\xf2\xf8\xb3\x94\x85\x8ar\xe6?\xed\"\x86\x97\xa4\x83\xee\xf6\x10\xba\xc6\x02]H:g\x94\xa1\t\xf2\xee\xee\x90 \xd7DH\x82^\x9e\xd29;fh\xbd\xf6\xc6E@\x9e\xaa:ȓTe\xa0ww\x88\xce\xd0\xcb\x1fqH\xa6\x9c_\xbdJ\x12\xb4^\xef!d\x89̦\xaf\x92\xe48\xb2D\x8a`\xc1q\x94\xd1\xc8a?\x10\xb1\x94h\x82\x8a\xa00F\xa5\xa4\x9c\xc9\xfbK\xc9A\xc6\x02Υ\xcc\xe0\xec\f\x1ci\x7f95\xe3\x19T\x85\xe5\x9b\x05f\x8cğD\xec\xa2f(\x16\xa20a\xc2\"3I;\xf9\x9f8\x9f\xc7ĝ\xfa\xa54\xe3\xed\x82\x19\x98:\xe2\x86\f\x03}<=\x83E</\xfc\xba\xbfGO\xfd\xbbu\xc7̅\xc9\xe0\xc7\xd7\x17\xbf\x1f\xbf{w\xf1\xee\xe4\xd5\x0f\xc0˸\x80ٴ\xb7\xa2q܋9\x8e\xbc\",\x80\xbd-\x03\x02\f)@\xfdtr\xf2ӻ\xb7uT\xe7Z\xe6:\xca\x16ǥn\x11\\\x0e\xa7\xc7?\xbd\x7f\xfb\xc3\xc5\xf1\xfb\r$\x18\x1d\x89z\x94U\xa0N>}\xac\x80\xf1Ty\xe3\xbd\f\xf0\"\"3\x9c\xc6J[\x90\x1e\x84\a\xa7\x8aϦ#4ñ$]\xd4\xef\xeb\x91^\xcc\xe7\x94!M\r\xa5\x92\xb29\xca\xd6;Ǽ\x94#\xa4Dj\x90RI\xd0/\xa7Hq$a\x85f\\,e\x0e\x99\xf0$M\\h3\b\x90H\xf2\x90\xe2\x18\x81\xd4=\xca6xK>\xa51ɰ7\x12\xb6\xa3#\xce,f\x81P\x84c\xc3\x1f\xf0#*\x93\x18\xdf\"l^\x18\"z\xdf\xcf\x11e\x1ak]ЛY\x9e\v\xb3<hb\x04ټ\xa7\x8c*\x18\xcd|\nO\xe0\x1f\xd9)(\x99ΐ\x0f\x94\x00\x94\xe2\x98\xfeI\xa2\xe2kx\x04Q\xa9`\xe3|l\x9d\xff\x02DK\x13,< 7\x8a\xb0ȿ[wK\xab\xdaE\x19\xe3q\t\xb5\xc0\x13M\xb4\n\xc6%\xb9\xd4mB\xf8l\xe3\x8a\x1eO\xc0\x8cXDf\x94\x91\xc8C\xfb\xfb\xf9;W\xe6\x15e\x11_\x05\xb3\xe9+y\xcb\xc2cG\x0f.4<?\xbe\xd6\xea\xf2\xabo\xe0\xc1\xc0d\x94\xb3\xeb\xd6\x02\x85\xb9G\x1a\x95\x1dT=\xb8TX\xa5\x99\xed\xd5\x13\xe4\xfc\x8a\x926\x88\x9b\xd9tiͧ\xf2~]Pwa\x96o\xaf\tS\x81L\xa72\x14tJ|\x0f\xa7j\x11\xc0_\xbf\x11\x99p&\t\b>'^w\xa30a\xdf\xd4)\xaehEv\x9d\x03\xb3u\x9b\xa0s\xd3a\xe5#`\xc3f\\\x8b\xb7F$\x96d\v\xd1pA«\xe2\x11$\xf33\x15\xf9m\x12e\x04fӋL\x0e4A\xd9\xcfq#\u07baIڝ\x16\x84\xc9@\t:\x9f\x13\xe1\x17\xfd\xbb\x03\xb9\x1e\xef\xb5\"宾S\x01\xbc\x80eN\x94\xef\xf5\xfb!g\x8c\x84*\x98Y\xed\x04\x8c\xa8>a\x17\x9fN\xfb8\x8e\x83K\xe9u\xeavyq+:G\xa3\xb3#\x1bv\xe1Ņ\xf6\xd6'l\x83K\"}\xb0\x96\f\xacnq\xfa}\xf4qAe\x0e\x88\xa8D!\x8ec\x12!,\x91\xe4\x9c\xc1\xbfjA\xd04UJ\xbf\xaf\xa3!\b\x8b\x88 \x11\xcc\x05\x80A\x1e \x85cApt\x8b̩\x84(\v\xd0/\xa9T\b\x02(A\xea(QU\xa2\xb1\xc0\x92y\n\x851\r\xafHT\x14\xc4'\xb0\xcfR\x1cǷhUKjI\xe7\v\x85p\x14!\xcc\x10\xecBnܹg\xdde'ث\xdbgv)6\xee\xffT\x9f\x0fo\xac\b\x93\xf2\x92@\xbc\xf1\xb8\x01\xb6i7\xb8\x0e\xbf٢A\x1eX\xba \xe4Q\xa3sx\x1a$\\*߱\x9d.\xf2\x00i\xe2\xa1g('Qp9\x11V\xb8\x8b\x14\xb9Q\xa7\xdaOv\xd1\xe5\xe7\xff\xfe\xf9\xb7\xed>%3/M\xa1iov\xb6\xcdn=\xae\xb7dc\xcaf\"'\xec\x1d\xc7Ѷ\x83\xa5\xee\x9c.\x9fu\xf5ۺ\x14\x91\xb9\xfe`o+\xde..a\xa1T\"G\xfd~\x12\xa7Y\xa8\x17\x84|ٿ\x94\xfd0\xa6\x84\xa9\x11\xbc\xe1\x8c\x04\x97\xf2{\xce@\xfcI\x9d\n\xea\xfd\x06p\xc2J\xe1p\xa1\x8f\x1c\xe9[\xa8\xf5^Y\x8e\xa2\x02\xa5\b\x8b:\x848Z\xa2\t\x8ax\x98.\xe1\xd8\n\x05\xc1\x8a\xbc\x8d\t\xfc\xcf\xf7\f\x81\"{\x19\xc0\xfe@\x13\xe4\x81\xe9\xf4/\xf15\xb6@E\x18\fQAe\x1dd \x05\x8cJ\x11\x8eK\"$E\x11\xe6DY\xfe\xf2\xf5\xedG<\x7f\x8f\x97d#\xc9\xd9\xe0|\x83\x9b\x04\t\x16\x84\xa9\xf7<\"\x01e\x92\b\xf5\x9a̸ \xbe좤\xa2\x8eT\x92\x0f&vl0)\xb31\x8baW`\x83\xcd\xfd}\x1b\xc6\xc9\x1f\x88\xbcR<\xf1;\xb0\xf3\x8b\x90Ő\xb5º\xb8NE\xee\x11/\xadG\xc4C\xa3\n\x93\xc6\xe4:\x99\xf2\xe8\xb6S\x89)\xf3\xf9\xf8\x1dwc\xfc\xc5\xf0\xce\xca\x12\xcc(\x8b|/0avφ\xd9\b\xe7'\x9c\xd7\t\xb4k.\x84\x00\x8dn\x8a\x04\x89\xd0>\xfb\a\x13\xb6\xfa\r\xbe\x83ɜ\xbc\r[\xea\x9cIe\xc3\xee\xed*\xbb=\x04:\x01\xc1\xe1bK\xec\x02Ɖ\xc1\x8e\x17T\x8ek\xdfR\xa6\x88\xb8\xc61\x985Q\xc7\xf6\x7f;\x84D\xd9\"\x96\\W\x9b\xf7\x05n\x9bd\xe0n\xdd\x1c1=\xf5q\x80\x95\x12t\x9a*\"\x9d\x89\xfa\x94E䦋\x00`[\xb8\x062\x02\\\x00\xa9\xb5\x0ei\x95\xf0\a]t\xd4A`Kp\x00\xf4\xbcmD\xe0\xb1r\x9fU\x88\x1du w\xd7\xc3\xd78N[\xc2\xc0\xfa\x03r[\x90h\xd5\xf1\x003}\xa8\xb9\xee\xc0\xbd४\x82\xec\x122\xd7\xc6!\r\x87\xdc.2\xcdqB\x03\x93\xf6\x06&t+\tY\x97O\xbaO\x18\x13,rs\xcfvAS8P\xb3\x81\xbb\xe8h\xe0n\xe2\xcex\xe7m\xbc\xfb\xfe}\n\x9b\x17\xd2g\x1f~\xd4H\bV\xae\x81\x020h\xdf\xd3.\xdc\xeb4\x86[\x1a\xf4A\xf6\x04b\xa4\xba\x8ef\x90\xc1\xde}o!\xc8\xcc\xeb\x8c[\xb1`\xaf\xe4hF<\x18چ\xb6\xa2\x91Z8xzZ=\xfdf\x1b\xfa\x82\xe8й\x0e\u07fcj#\xc0d\xc0\x13\xc2^\xa5jA\x98\xa2!\x06\x05\xfd\xae\xe3;\x1f\x94\xf0\fyߛpo2\xf4L\x11\xb6k\x04\xeeZ\xc6-\xc4wݕ;\x04\xa0\x8d\xb9X\xf1P\xbf\x94\xee\xbaj\xf5\n\x9c$D\xa0I\xc1B\xbfѦ\x99&=(\x80\xf5,\x84\xab' o_\x051as\xb5h\xb2Z\x99\xd5F]\x0e\x94i\x0eu+\xb0\x01\x85S\xaeg\x05z\xc8\x19m\xb8\x063\x1c\x91\x93T\x954!\x13B\xa2n{(\x9e=\xd9\x14\x81\xce1\xab\x92y\xc0\xa2\xed\xba\xe8\xeb\x1d\xf5A\xd9C\xf4Q\x9c\xc8_QHA\xaf\xff!}\xac\x1b\x1c+\xd8\x12\nt\xc6ѓ\xe9tIU\xae\x1f[B\xe2by\xaa_t\xc6\r4\xf0\x19\xb8\xb2\xc9\x13H5\xf3\xe6\xc83\xe4=9\xdfU\u05fb\xcc(Ks-\x83.bi\x1c\x7fyB\v\x9b\x8c\\벪\x0e\xcb\xfdRA\xbdA\xf3\xe54\x15M\xb4\f\x8dal\x964\x92\xeb\x06r\xe0\x0e\x1e\x93\xeb\x80J;\xef\x0fF\v$\xf2;\xad\xfbˈ\x11s\xe3\\\x03A |\xf4\xbf\xb8P\xb6\x9b/\xd4\x15sW\xac\x16+\xa0\xec\x7f\xdf\bX\xd6ᨦ\x05\xd5I\xac\xf7\x9a*\xa1ni̭\xbdfu\x17\x18\x0fL\x1dYG\xbc\xb6\xc2g\xb3'\xa8\xfbP\x99\xd7C:uG\x05X%\x9a O\x90\xcfy)FS-\x16\x84\xed\x9c~#\x9fS\"\xd5x\xaf\xce\xec\xdd~^\x17\x19\x83\xff\x12\xfb\xdf^\xc8iSf\xb5\x02\\\xaa0\xa4aHd\xa5\a\x92\xf50]q~|\x1d\xe0\x84\xfa^\x7fI\xfaɦ\xa1\xe9\x16Ǜ\x8e\xc9Ĳ\a\x18\x1d\xa7\x94\xea\x03\xd93\xe3\x02\xf9\x00N)\x9a\xa0\xc1\x18\xfe\xfd.o\xabړ\x18F\x9f=kK\xd7\x1ekng\x19\xde\x19\xa5\xe7\xe7m\xdbԔ\x13vݓ\x95\x11\xab\xc9V;\xaf\xab\xd8\xf7\xfb\xe8=G\x82|N\xa9 \x11*huo\vyg\xa5g\x8d{ƭ!\xf1D\xb9Iiۢ\x03\xf8\x99'C\x9e\x10\x0f\xf2\xbf|%.9e~\xab3*v\x0f\xf6\xf7݆B\xfd^u\xd9\xd7\xf6F\x1cJ5U\xbdr\xe3\"/\xa4lY\x8d\x1f_\a\xba\xa3\xea\xd7qՙ\x96l^\x81y\xb9O^\xab\xff\xa6V\xbb\xbb6\xe5\xc5\aݏ\xf4u\x02[4\x86\x81\xfc\x1e\x81Y\b\x0fy\x9dr[\xccT-i4B\xde\x06use\xa1\xeb\xf45#*H\xa8RAG\xc8\x03/\xb6$R\xe29qశC\xa8V\x8d\x90\xc7g\xb3\x982\x17\xc44\xea\x12\x1e\xd3\xf0v\x84<\xe8J\xc7\xe4b\xc1\xa5\xba\xe0\x82\xce)s\xe1q\x1cOqx5B^Cs\xc4A\xa0\xcb%\x89(V\xc46\x9ba\x13q\xb5 bE%A\xe0\xab\x114\xf5\xd1\fS\xd34N\x19\fr\xa1\x9b\xab\x9a\xc3f\r\xcb\xc5\xcd\aW\x88v\xae\f\xf5\xfb\xe8\x94\x10\x14\xf2%\x14\t\x11e\xa8a\xb2-5\xf3݊\n\xba`\xa0\xfb\x98&\x92\xf5\x1d\xb3\xad:\xb0\xad\xf5\x01\xb7\x00P\xbe\xe0\xb1\xdeےJ\x16wC*\xe2\xfa<\xd2\xf1R38\x88\xad\x8a.\xe00\x06\x9c@\xf1w|E\xc4\x1b,\x89߁\x88\xe5\u009c\xc6\x17\xf9U\v\xab\xebO\x92\xe8B R\x1c\xe1kN#$Ȍ\b\xc2B\xca\xe6\xba5\x05\xf4d\x82CRD\x9b\t\xbe\xd4o\xb5\x15-0\x8bb\"\xf6ʡ\xdc\xd9\xec\xbc4!ID\xbd\xd3\xca\xcfl\r\xe2\x04\xe4$&\x8al(\x8e\x9b,r\xa5o9Y\xe5n2\xf3\xe6,|EY\x90ע\x15U\xb1n\x00\xbc1\x0eVO\x9e\xa3L\x9f\xa0\xc1 \b\n\xaa3\xc7 \x10\x19W\u05f6a5\x1b\xd71\xabl\xfc\x8a\xd5\"XR曁\xfb{\xf4b0\xe8\"\x19\nBX`\x06{h8(\x9aX^\xd6ȑ\xed\xc8\xfd=:\x1an\xb0\xed\xa8\x8b\x0e\xaa\x8b\xc9\f\b\xf8E>\xfd\x83N\xcf\xcf~\x95\xc1\x15O\nІ\xae\x06\xcf\x7f֩\tb{ЍQ\x85\xe7u\x91g\xe0u\x04i~\x82\x9a\xbb\x9a\xa9\x1eԿ\xf4ؒ\xb0t\x8a\xc5d\xd0\x15D\xd2?\xf14&\x93AWq\x1e\x9bQ\xc5\x13\x8d\x01\xa2\x01<\xccH\x0f\xc0\x0f\xb7o!kZəo\xad\xb9\xe5\xd2\x16\fgh\x00\a\xc6\xebw\xba\xc6\xd5n\vgt\xc8i\x91\xd1$\xa7S9\x9f\xe1b\xa32\xee옵D5%\xb8\xf6C\x15\xb3\b\xf9\x8c+\xf4\xf2U\x1c\xf3\xd5'I\x849\x8b;\xc8'\x9f\x91\x1f\x13\x86^\x9e\xea\xca\xe4\xc7ۄ\xc8\x0e\x1av\x8aǯ\xa5\xf4T*4\x9a ]x/\xc1\xa3A\r4\x9d!\xf2\x19p\x02\xe8|\xa1'Y\xc4\xf0ąE\xbb4K\xee\xee\x8cN]\xaa\xe6\x80h\xa2Y\x8c;\x9a(\xd6`n\xea\x9c\xc5\x1b}\xc0\xf4g\xe3\xf64\xef\xfc\xa2\xa1\xc3\xf4!UC\xef\xeen3\x19\x88<\xac\x9e\x03\xdd\x10\xfb]\xef\x86\xf5\xba<\xfa\xb3\xd98\xebǘ6'Oy;6\x9dO\xd9luN\xac\x8b˛\xf2\x9c\x1e+\xd6\xe7t\xee\xa0G\x1b\x8a~O\xa1\xe9\xe9ۤ\xb9\x8b<\r\xab繉\x12\x1e\x90ށ`\xc4H\xf5\x14\x8a\x10K\x8dܩO\xa7\xcb-dRW\xc2'q\x10J\xe9{\xf6\xf2\x1c\xa8\x9fqV[\x84&q\x00\x153\x16}\xe4N\xf7\xb2\xa6\xa0Sՙ\xa91\xf8\x9e\\\xf0\x95K~\rnP-cw\xbc\xf9\xfa\x9cQy\r\xc9\xdc\x17l\x1cV\xc1\x11\xa0\xba\x9e\xf0\xe3ǹ\xdfrh\xd8JP=v\xb1\x0fk\x8bF[\xee\xf4X~\x16\xbanZ\x16bSz\xcaE!Bp\xf1\x863\x85)k\x11\xca\x12x\xea{\xdf\xc9\x043\x14\xc6X\xcaɓ\x05\x89\x93\xde4\xe6\xe1\x15҄z6\\7\xff{\xf2\xcf\xef\xfa\x00\xfdϊ\x12/\xa8\xfcU\xf7\xbe\xb7\xeb\xb0\xff\x8aE\x82\xd3\xe8~E\xa6'\xa7\xf7\xf4Â3rO?\xe0\xe8\x9e~\xe0\xd1\xfd\xeb\x18\x87W\xaf\x89\x10\xb7\xf7\xc7o\r\xd1\xfb\x93\x84\b\x8c~\xa5\x8c\xf6i\xa0\x88T>\xc3\xd7t\x8e\x15\x17Z\xf7\xaf愩\x1a\x99l\xa3~\xabP\xc5\x19T\xafPlB\xae\xb6\x00\r\x96\x19\xc6 %\x85\x7f\x83,\xf7\xe9Ts\xf3\x8f+ޛ\xe1Pq\x81p\xc9\xdb!*\xf3\xac}\xaf\xad\xdc\au64)3\xdauO<\xc8i\xb5\xf9,\xf3\x8e3\xdf[\xd0(\",\x98\xda\na\xc9kչ'\x83)Ȓ_\x93\xb6\xe2Fe\v/hD\xea\xaf\xc2T\xea\xb2\xe5\xbd\xd3R\xeb=~_\xbe4\xbb\xa9\xd9v\xd1\x19P9wtR\fG:-\xa1\x8a\xb6\x87\xaeΧ:\xe3F\xa8\a\x14\x12t\x06\x03\xb9\x84\x0e\xdd/>K߃\xff\xba\xee\x10,\xec䇓\x11\x92\xe1\x82,IO\x90\x18+zM\xe0`\x96\x95<S\x13\xdc\xdf7?\xce\x06\xe7\xbar\xd2\xf7\xb2\xbb-\x90a\xebW\xba៏\xd4\x19d\xa7\xb6b\xdd`\xba@\xd2Y\xf8<LyPE\xbc\xdfG\xc73{\x15|I\x90 Xr\x86V\xc4\x13\x04a\xa5\xd3.\xb0\xf0\xecn\xb7\x83\x9a\x80w[Q\xb5\xe0\xa9B\xd8h7\xc1\x02/\x89\x82\xb53eu\xca\xe6\r\x17\x15uE ĩ$\b\xe7\xd5\x0e\xa48\xea\a[\x15Q_\xb2_7\x16]?˒#\xbbrs[\x8c&\x15\x1e\x92`\x11.\xb2\xab\x16\xc3N \x93\x98*\xdf\xdb/\xdaL}m\x14\x97\x8b\xa2UK\x04\xbf\x8a\xa1\x02\x9a\x11\x9d\xd4\xf5:\x13K\x06=\x9e\xa0\x83\xba\xf5\v9S\x94\xb9%\x87u\x95\x90\xb5ͫ:\"֓G\x04\xae8~\xfa\xed\xf8\r_&\x9c\xc1>OΆ\xe7\x81 I\x8cC\xe2\xf7\xffx֟w\xd1\x13\xf4\xa4\xb3E\xed\xed\xa7\xac\xde\x03N\xc6\xea.\a\xec\xd2T\xc4\xc1\x12\xabp\xe1\xf7\xff\xa5o\x02~?\xfa\xa3\xffG\xff\xec_\xfd\xf3g\xfdj\xe2\xb7D\xdf#\xbd\x05G\x96i\xb5\x87\x91\xb7\xe2J\xb6@*\xb6`^L\xf4\xb7\x18\xb6{\x7f){f\xb8\x12\x98\x9a\xe1\xdd?Jhk\xd3\x00w{?\xb7\xe6\xc2\x05\xbc\x05\x91\xd0\xc4\x02\xd9;'Ҵ!\x1d\xab\\fW?\xe0wo.\xb8ne\x9bc\xe3\rD,\xbe\xb7\xc0\xb2\xa7\x83\x93&\xd4R\x1c\xe3u\xf2>n\xf3\x01e\x85\xae9\x9e\x8aGS&\xbe\xe0\x89\x0e\x8b!ˎ\xbc\x8a\xb7/4\x80\xb4T\x92\b\xf3=F\x91\xee\xd3\x00_\xe2\x1b\xe7\xbb\b[\r\xfdpr\xfaѩT\xa6\xf0\xf5\x83Y\xb3\xf2\v`4\xd2\x7fw\xeb\xea\xfc\xa3/\xea\x14\x81\x85\x00\xb8Ѥl\xeav\xe4N\xe4\nQ\x86v@\xd8\x14G\x93Te\xea1\x8b\xa6\x87\xf4\xc7\\\xba,q\x05\xa9\xdf\xf9\xb6\x1b+\x11\x85\xe3]\xa3n\x8c\xaal93*d\xeb-\xae\x88^\a8\x8a\xdal\xab\x8euX\x88\xb7+1x\x1b\xbf\x1c1\x80\xa5(\xaa\xf9\xec\xea|'\xbc]\xb3\xb2*\xe6&I\xa3\xd7;!ث\v;\xb7\x98\xdbv\x89[\xf6i\r{\x8aQ\xd3\xf6\xcb\xe7\xce\x1d\x9f\xf2N\xd0\xca-\xec\x03m\xf5占A>.\x04_\xb1:\xd3\xc51\x11\xca\xf7\xde\x1aB\xba\x8c\x96#\xd7H\xf3\x10\x05\xac+\x8ef\xbd\xb7\xee\xf8\x97\xff\x95\x12q۵\x9f\xf7\xc1\xe2>\xfa\xfa|\xf9c>\x92\x8c\x89\x94\xff\xa9\xef\xbf\a/\x0e\x0f\x9f?\x1a\x1e\x1c>?8|>|><|4\x18\x0e\xe1\x93\xf0\xaf\xdf\x7f\xff\x1b\x9e\x97\xb3i\xc8cؼ\xdf\x1cN\x8f\xbe\xfd\xf6\x1f㽗\xf3l$\x8a\x9eO\x0f\xbf\x1d\xef\xbdT\xab\x1c\xe80\fg3\x00Z\xe4C\x18\xfe\x8c\xf7^\x9a\xdd}!pD\xe13\xc3\xe7\xc9\xcdxo/\x10\x1c\x12\xba\xa8\x17r\xc1\x88\x90\xc8\x7f\x99\x01\x1c%7Ƨ\xf4VdzEUo\xcaEDD/{o\x01\xc7\x00\xb1\xe4\x7f\xb6\xbcnz\xb3.\xf0\x87r~\x9b\x10uLz\x8a'\x80\xa7\xc1\xc6\x06\xa8,\xab≡\\\xa0g\x00\xb7\x02\u0530\x9br\xa5\xf8\xb2\x9d\xa3\x81ig\xda\b\x03\n\xb17\x87\xb3\xcf\xc6^\xeau\xcct\xe0.\x97_^U\xeb\x89!S\x9fk\xc8\x112\xf8\xd5\xf1Ll\xe8^cћ\x03\x01HA\f<\xdc\xd6\x11W\x84\xe5\xff\x1d\x0e\xfe֩\xa1\xde[ʿH\xe1\v\xb0\xb5\x12Gh\x98\xdc \xc9c\x1a\xb9\xb0\a\x83\xbfuJ\xba\xa4aU\x93\x90N\v\xd2\v\xf92I\x15љ\xf7\x8c2\x1c#KDr\xb4\"\xb6o\xba\xc0ה\xcd\x11fḨ\xd8@dD(ӸoNO\x83\xca\xdc \b\xf1\x97\xf4&\x97\f\xd0\xfd\x83\xa3\xa3.\xda\xfc5\b\x86G\x9d\x0e\xccP\x8b]0\x13\x01\xf5\xff\x96y\x0e\xddy\xda\xe2X\x179\x03=\xca@\xcdv\xe6K,\xe6\x94Y#\x1c\xa1an\x9d\xfb\xba>\x99!\x15\u0089\x92\xd9\x16\x05\xfa\x86\x1c\xc1\x9fb\u0099\xf7w\n\xf8e\x9b\xf63\xa7V\xbc>\x1f\xe85*G0\xc5\xe5+\"\xd5\xd5\x1cl\v\xa8\x85\xe9\xfc\vx\xce[Y\xaa\x15U\x8a\x88\x16\x9ej\xf5\x05L7H\xf5\x13\xa5j\x91N\xdb&\xba\xf8\x92\x99.ژ\xe2\x02\xae\x8d\xdfGH\x97\xde7\xf0\t\x8e\xa0\xf64B\xc3ArS\xb0*mY]\xb4?\xba\xa6\x92*\xb8\x82\xbc?Z\xf0\xeb\x92\xdeL\xecnΫ\x19\x9c_\xe5d\x8d\xa9ފ\x98\xcd0\xe5qT\xf3Z\xd2?\xc9\b\r_$7\xe5\x97\t\x97\x14b\xe7\x11\xca\xea\x8a\xce{#t\xcf\xfa\U001012ef\xfb\xd4#t\xf0\xf7o\xdd7f\x17\x8d\xd0\x00\r\x9cɮ\xb7\xe8\xbd\xeeī\xf7\xe3\x15\x95\x9b}\xdcs4_\x9e(\x9eJ\x1e\xa7ʙ\xa8\x99\xe0\xa0<\xa8xR\x19\xb33~^Q\xc5®\x00\xf8)\x87\f\xb9Q=\x1c\xd39\x1b\xa1\x10\x8a\x9f\xc2\xf9.\x86֤&\x85e;\xac\xf0*\xf2\xab\x7f\xdb\xef[\x00\xb4L\xa5B\xban\xb5\xa1\x89(C\xdag\xd5\xe1A\xf2\x0f.\xfb\x9a\bhe\xc4Vf{/d\xc5\xc5U\xd0tu\t\xcab#\xf8p\x1e\x8c&\x90K,\x17\x94͗x\x8e\xff\xa4\xcc|+{0\x18\x1e\xf6\a\xff\xe8\x0f\xbe\xedg+\xd1\xd3\xf7\xae 5\x8d{\x19\xd3^δ\x17J\xd9\xff\xe6\xedM\x12c\xa6\xeb\xa0{\xd5D\x7f\xeb\xf2\x17-\x12\xbe\x1c\xaf\xbe\xddf M\xf6\xd0h<\xf0\xd8\x13\xaa\xe6MvV\f\xb6\x15\x8c\xab\xc7W\xf9\xb4r&?\xdekvHU\xf5X\xf2\x86.\x9c\x11\x16\xad\xc9-h\x05\xf4\x0e\xf2P\xcc\n\x04\x9dFW\x9c\x02\x97L\xf1\xe0\x06\xd0\x00\xe9\xbf5\x81r\xb7\n\xdd\x19\xd9s\xb2\xe6fR\xcdD\xa0.\x92M\xc0\xa0h\x02\xbd\x88\xe2\x98\xcf\v\x18K|ӳ\x1b\xf6\xf0\x1f\xf9>ɐ\xdco\xd0\xdckIŽ\xac5\xf5\x8d\xf9\xaeb\xc6Ŝ\xeb\xc80;ċ>\xf6 cS\xdc\xf6\xda\x10ƍ\x1a\xaaq\xe1믅\x81\xaf\xcf\xd7\xe7\xeb\xf3\xf5iy\xfeg\x00\xf5?8#\x00R\x00\x00")
 	const prefix = "/assets/"
 	manager = assets.New(assetsFS, prefix)
 	App.SetAssetsManager(manager)
@@ -49,6 +49,11 @@ func init() {
 		"SignInTwitter":       SignInTwitterHandlerName,
 		"SiteName":            func() string { return SiteName },
 		"GoogleApp":           func() interface{} { return GoogleApp },
+		"TwoFactor":           TwoFactorHandlerName,
+		"TwoFactorEnroll":     TwoFactorEnrollHandlerName,
+		"VerifyEmail":         VerifyEmailHandlerName,
+		"ChangeEmail":         ChangeEmailHandlerName,
+		"DeleteAccount":       DeleteAccountHandlerName,
 	})
 	App.HandleOptions("^/sign-in/$", SignInHandler.Handler, SignInHandler.Options)
 	App.HandleOptions("^/sign-in/facebook/$", SignInFacebookHandler.Handler, SignInFacebookHandler.Options)
@@ -65,11 +70,16 @@ func init() {
 	App.HandleOptions("^/sign-out/$", SignOutHandler.Handler, SignOutHandler.Options)
 	App.HandleOptions("^/forgot/$", ForgotHandler.Handler, ForgotHandler.Options)
 	App.HandleOptions("^/reset/$", ResetHandler.Handler, ResetHandler.Options)
+	App.HandleOptions("^/sign-in/verify/$", TwoFactorHandler.Handler, TwoFactorHandler.Options)
+	App.HandleOptions("^/two-factor/$", TwoFactorEnrollHandler.Handler, TwoFactorEnrollHandler.Options)
+	App.HandleOptions("^/verify/$", VerifyEmailHandler.Handler, VerifyEmailHandler.Options)
+	App.HandleOptions("^/email/$", ChangeEmailHandler.Handler, ChangeEmailHandler.Options)
+	App.HandleOptions("^/delete/$", DeleteAccountHandler.Handler, DeleteAccountHandler.Options)
 	template.AddFuncs(template.FuncMap{
 		"__users_get_social": getSocial,
 		"user_image":         Image,
 	})
-	templatesFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec[ks\xdb6\xd6\xf6g\xfd\n\x84\x93\xf7\x8d\xdd1iݵ\xe3\xc8J\xb2i\xbaqgg\x9b\xb1\x9dv:;;\x1eH\x84$\xd8\x14\xc1\x12\x90\x15M\xeb\xff\xbe\xe7\x00 ś\xac\\j;ْm,\n\x00\xc1\x03\xe2\\\x9e\xe7\x1cj*\xe2\x99P\xde\\-\x82\xbd\xfb:\x9a\xadf\xb3\xdf\xef\xee5\x9b\xcd֠\xd7\xcc~\xe2\xd1\xea\x0f\xfa{\xadv\xa7\xdd\xeb\xb5:\x83V\x1fƷz\xcd\xfe\x1ei\xee=\xc0\xb1\x94\x8a\xc6 \xca\x17/\xd2\x1c\xe9\xe77r\xfc\xfe;\xf1ٔ\x87\x8c8\x17\\\x05\xcc!\xb7\xb7Ц\x88\xf3\x83\xd6\f\xb2\x16˘DTʕ\x88\xfd\x17\xb6\x9b\x85>\x9c4\x86>\xbf!\x93\x00:O\x9cX\xac\x9cQ\x83\x90l\xdbD\x04\xee\xc2w\xfbĞ\x88\xe9T2\xe5v\xf4w\xb9p\xff\x96\x9c؎6\x91|\x16\xba\xcb\xc8\x05\xb5\\\xe8\xe9\xec\x84\xdc?q\x8c\xaaf\xbb\b\x01Y\xf8\x94x\xe7,T(\x10!\xb9\xe6S\xf9fAy\x90\xed\x81\xf9\xa2\x11tG1\x0fՔ\xec\xc3B\u007fa\xcfn\x18\x918\x05\r\t\xd3W(A\xfe\xef\xc6#\xef\x02F%;$S\x11\x04bEԜ\x11\x1eJ\x15/'\x8a\x8bP\xc2\x17\xc2\x15\x0e\x8e\x19ȟ\u007fV\x9es@\xbc\xf7\x92\xc5^\"\xc4\xf0(\x1aeEd\x81d\x9f'\x1b\nB}\x1f\xee*\xe1\xd63.\x15\x8b\x99\xff\xe7J\x8d\u007fB\xba`U\x82\x9b\xedߺ\x90\xe1\xbc;ڡD\xc3#\x18\xd3ȯ\x1b\xc6\xff*\x96ς\x00\x04\x9b0\x0e\vO\u05fc\xe2jN(\txx\x8d\x82\xa3ؔ\x84l\x95N\xea\x14\xc5\x1c\xa2\x9e\x90\x05Ss\x01\xba\x13\t\xa9\x1cB\xf5\x038q\xe0^1\xbba1H\xfd\xd2Jx{\xeb\x8c2\x1b\x01#<\xd3\x03\u007f\x17\xde\x19\xac\x98Ņ\xbd\x1a/\x95\x12a\xa2\xebKx\\ҕ\xcb\xf1\x02\x9e\xedX\x85\xf8υ\x9d\\\xd0x\xed\xd8՝\xeb^#\xaa\xb9:#\xef\x11\n\xbc\xed1\x0f\x8f\xc0\n\xb4y\x99\x13\xfb\xf1\xe5\xf6\xcf'\xa0\x11\xf7\xeb\xfew\xf9\u007f\xec6\xfe\xbf\xdf\xef\xb7:=\xf4\xff\x9df\xbb\xf6\xff\x0f\xec\xff\xcfń\xd3\xe0\x14\x14Bk(O4{Jɔ\xbah\x11\xdaJ\x86G|\xb4Qν\xfa\xf8\xa6\x8f+\xe9Nh\x10\x8c\xe9\xe4\xfa\u07bc\xc0.\xfb\xef\xb6;h\xff\xdd6 \xc0n\xab\r\xe3\u06ddN\xb7\xb6\xff\x87\xb1\xff\xa3\xef \xac\xb0\x0f\n\fZ\x1e\x93P\x84\xac\xf1\xdd\x11\x82\xbb'\xdf\xff\xf4\xfa\xe2\xd7wo\b\xea\x05\x84\x1c\xf3\x81\x81O\xf8k}\"'1\x8f\x00I\xac#v\xe2(\x98\xe3\xe8\x8a\xdeP\xd3jéEb\xef\xe8:\x104\x83\x1aV<\xf4\xc5\xca\x13\x11\vY\xfcot.\xaf\xad\x1a\u0098\xff\xecc\xc3暃\xe7\x8drH\xf4Y\xc0\x14\xdb1\x91\xb9Ў\x99\x04B\xb2}=\xd9\xf0\xc8HiB\xaaY\x10@\x12\xbd¿\x94\xfdk\b\xf8\xb8\xf1\xbf\xdd췋\xfc\xafӪ\xf9\xdf#\xf3\xbf\xb329xL\xf6\xa7\x15\xb5\x92\xfc\xbd\xf9\x10qd?\x95\x04\xe4\x8c\xfd\xb6dR\x919\x95\xe0\xe4\xf4\xc0;\xe8\xc7Ŝ\xcbt\xb5\x96\x1e\xc5\xe5\x19\x0eI\xa4)V\xdag\xb8\b\xb8N\xaf\xc0C\x12r\x04r\x86\xc02\xbc\x9fi\xc0wH\n\x12\xe0\xd0\x1b\x1c\xf9\x99\xa2f\xa7\xf0\xc8\xe9\x14w\x11v\x85O\xae\x91$\x02\x1d\xd4<j\x1a\x8b\x85\xd9_ñ`\x00\xf0\xccC\xa2\xe25\xecL\xb4\xe6\xe1\f\b\x98\x8f7Qx\xce\xd5\x1d\xab\xf3\xbe\x87\xd5W/\f{\x9e\xdc\xcd\xf96*\xa6\x1f\U000d8c50,#\x9f*\x90\x17E\x00)\x91\a\xebvT\x12h\xe6\xe16i*\x858Ot\xb9L\x1asB}$k4\xc6Q \x8dC\x1eFKE\x904õ\x8e\x8d\x8bs\xee\xfb\f 5\xecƒ\xe9Y2\x91\xadD:\xdfY\xd1\xfeT\xdaɾ.\xceY\x1f_Y\xfc\xbfL\xb3?\xea\x83zx\xfc\xdfkv1\xfew\xfa\x83~\xb7\xd3F\xfc\xdfi\xb5zu\xfc\u007f@\xfc_\xc5\x00\xc0\x1b\x8cٌ\x87*\xa6\xa1D\xa7\xf0\x96\x1f6\x1a\xaf\xb6\x05\x1dp%\xc6\xc3\xd2\xc9D,1a\xa9С\xbc<\xe7\x8a\xfd\xcb\xe4\x11ɊJ\x9d\xdb\vU\xb0N\x92|\xbeװ\x11\xca\xe7~\xf8\f\xa2W\xc8\x15W\xe0\xfb!Tq\x99̟\xc6\\p\xff\"\xb6}:py\x8d\xc6EUJ\xf0\x90 )\xd0\xf1\xcedB1\x88\xe9\xc8\xc7C#\xe9\x18`\v\xf8\xcf\xe3F\x03]\xef\xfb\xb3\u007f\xe2*\x1bglFc_\x1e6.\xe0\xca\xe2\n.\x18]4\x8c\x9fL\x9f˷l\xff\x1ar\xf1P\x03\xab\xfb\xa2\x01;\xec\xbf\xdb6\xf6߅\xff[\xf0\x1f\xf2\xff\xee\xa0\xce\xff=\xa4\xfd\xf3p\x12,}v\x9c\x83\xe0Z\x1f\x0e\xc9&E|H\xa4\xce\x11\xba\x06H\xe86\x9b,\xc8s\x01b\xd5j\x1b'h\xb5\x13x?\xef&]\x89&*MC\nu\x90s\xe8$\xa7\xa1\xaepH\xe7 g\x93\x1b\x04\a\xd7<\xfd\x01Q\xed\xf1\t\xf1\xf4\x89\x852\xd8\xf1\n]\x00\x165p.\x98\n\xc7\x14\xdb6\xc3u\xc1\xc13)\xd1\v\xc0qr\x83²\xeb\xb1\xcf\xc3\xcan\b\xc9\xd3\xf2\xac\x04aZf\x94AYN\x0ey\x817\x991\x9dc\xcdCB\x98\x91\xfdF<\xbdX\xe7\x1fB\xcc\fI\xcb\f2\"\x8d\x86O\\\x97\xcch\xc4=\xbc\x11\xe0\xe3\xd8\xc0G\xf0\x85\xd7\x12\xc8\t\xb8\\\xc0\x8d\xe3@L\xae\x01(\xb3\x05xaI\\w\x94\x9b\t\xe6\xa2\xc9\xe2f\xf6f\x80é+\x81\x12\x18\xf8z%\xc0\u007f\xbe4\x92\x9cc\xab$\x0eA\x91\x9c\xc2L\xc4\\ix\x05r8t\xa6\xe6\xbaWQ\xe4\x9d\xeag`\xc6\x00\xa9\x02b5Q˘\x1bؽ`R\xd2\x19\xdb2%\x84\x18\xe87\xf8\x1a\xd8#8\xf5D̉\x10לE\x02\xc8\xce\x1au*\x84\x9b]\xcea\xc2K\x11s\bf\xdbd\xb49\xa3\x13\xe7\xf2RC\xea\x9fB\xbb@\xcd6N+\xae\x9b\xc7l\x9a'\x05f\xbb\xcdu\x86)k-zj5\xf1\x05\x12\xae\x13\x9bG\xcf*Aiꡌh\x8a\xf0\xd1\xfc\f\x92g\x8b(\xc0\xb0\x98\xcb\xd4\xdb]r\xa3`)\r\xcaǋG\xa59\xab\xedI\v\xa8-j\x9bj!\xfc\xa7\xf9\xe9Rbpg-S7\x17e\xfe\xbbv\x1cN\x85\x9a\xe7j\x8a\xe5\x96\xcc=\xf3]6\t\xb0Œ\v֚\tv\x19\xe3Ks\f[\xfa\xabi\xa1֛+\xe9V\xd1\xc3\x1f\xcfS1\xaa\xf9\xe3\xa6{T|d\x9e\xe9\xdbJ\x00?\x8f\x02\x9a\xfd\xae\xa6\x81\xc5\xc7x\xa6+\xca1E\xb1+\xd4!\xab\x9c\xc9\x13\x9b\xb3 r6|\xff\x19\xa6KL\xfd֢\xc1\x17\x15\x8a\x95\xf75d&\\\x1b}\xd2U\xf0p*\xf4\xc9\a\xd0\xedj\x83{\x1feL\xcd\xdbajF\xc0\xd71C\x95\x14!3σ\x8e\x88\xe9\x00\xbf&\x19X\x95/\x9fT\xdaAٲʊ\xbbyD\xa8Qfo\xec\x9b\v\x88=\x9d\x11\xac\xb9\xbc\x92L\x15zgᜎʒ\x14\x99|\xc1D\xf3\x1anû\xbb\x8ai\x14\xb1\xd8!\xe0I\x03\xf0\xa4>\x97`\xac\xebcd\x00\xc5\xc4\xc4ƐK\b\xa1`\u0379[o1\xdb:\xa3\xf0\xb5\xe1\xff\x85\xf0ipO\x04`\xd7\xfb_\xcd\xfe\xa0\x90\xffo\xf7;u\xfe\xff\xf1\xea\u007f)\x1f\x90ǤD\x103\x80?\x1b3\xb5\x029\x89;\xd7\xdfȔ\xfa\xacD\x01t\x97\xeb\x03\x18\x10\xb3l\x92?\xd7\rN\x18\x04R\xa9\x17*\x8f\x983\x98<\xceFq\x1b\x15\r*\x1c[\xa0\x91\xf0\x0e,\xfd٘\rnn\xc1Ӊ >ǜ\xba&K{\xe2\xa8x\t\"\xff\xbf\xe2\x00@\x9fWdL7|ň\xf1\x05l\xa5\xe8*KKĲd\x9e#\x14\xbcpv[r^83\xb19\xd5\xdc\xe0\xc8\xcb=\\\x8b\xfd+\x06\x98\xcd\xd1\xfd\xe5^\xdd\\;\xce\xff-\xff\u007f\xaf\x15\xe0]\xf9\xdfN\xbfUz\xff\xb7۬\xfd\xff\xc3\xe7\u007f\xb6\xfb\xfb\xedu\xe2\f\xb5\xf8\xe4\xdap\xb7P\x1b\xee&%\xe1~\xa16\xdc!\x15\xdc\xec#\x1db\r6w\xd9\u007f\x16\xcd?\x82\xfd\x0fz\xbd\"\xfe\xeb\xb5k\xfc\xf7 G%34\xe6jj\xe7\x19X\xf3#&\xfe\xb6\x02\x9a\xcfˑh\xfa\xbe=Gb\xbaSs\xf7L[En\xe43r\"\xb8\x9crBdgz\xe3U\x10\x03\xf4\\W&8\xaa\x13\x1a<\xfc\x94\x84\x86N\v}ZB#\x97\xddɦ\a\x92\xb4\xc0v\xdfg\xb7\xfdq\xe3\u007f\xc9\xfe[=\x80\x04\xb5\xfd\u007f\r\xf5\x9f]\xe1?\xb1\xa2{\x8f\xfd\xf9\xf7\xc2>2%U\xc7\xfe]\xf1\xbfT\xd0{\x84\xfcOk`\xeb\xbf\xe0\tz\x1d\x8c\xff\x83^\x1d\xff\x1f\xc5\xfe7\xc5^c\xf8\x8d\xd2\x0fD\x92\x12\x8e\xb6\xaf\xad%\x9e\x9f9[9d\xdfV\xd3.gL]\x1aU#\xde\x011\x0eź\x8bmw03\xa0?Ic\xaa~\xbb\x1bO-\xf4\xb0\x00#\x12\xd12\xb2o\xd6\xe1\xa9-En\xfa\xdc\x15\xf7\xd5<3\xe2\x17\xfc^\x98\x00\x82<\x9f\xcdUf\xd4[ݐ\x9dͼڇ\x03\x12\t\x1a\x15\x85@\xef-\r\xfd\x80\xc5vL\x12\xcb\xf7ohL\x1c\f\xe8\xce\xc1]\xe5\xc0O\xab\xffy\xf8\x91B\xb1$\xf0\xef\xaa\xf6%\xf27tq\xaf\xfe9\xcf_\xf60@y.\xc4\xfd\xfd\xfcg'\xfekw\x8b\xef\xff\xb7ۃ\x1a\xff=\xa4\xff\xd7\x15@\xf9\xc7x\x19\xfat\x1c@\x1c\xd0z\xe1A\x9b\xc4^\xfd[\x99l\xf7\xbe\u9fd2\a)>\xd4\xce\xe5%\x96\xe0\xd1\x13\xdd\xfd\xd3 \xf4\x84\x97&6\x90\x13pV\xde\x1fWR\x84\xb7\xb7\xcf\xd3\xdf\xe5\xd4.\xa9>\xea\xa3>\xea\xe3~\x8f\xff\x06\x00\x00\xff\xff\x14\x92\x82\x9d\x00F\x00\x00")
+	templatesFS := vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec=\xfbo\xdb6\xb7\xf9\xd9\x7fũ\xb0ݶC,\xdb\xf2#\x17\x99\xe3\xb5wK\xb7\f\xc3]\xbf$\xddP|\xf8\x100\x12m\xb3\x91I\x8d\xa4\xed\x1a^\xfe\xf7\x0f|H\xd63N\xd2\xc6^7\x11hc\x8b\x14uH\x9d\xf79<\xf6\xa7\x88Np\x13\xcf\x10\tݩ\x9c\x85\a\x9f\xbf\xb5;\xed\xf6`\xd0;h\x9b\x96\xff\xdb\xe9\xf6\x8f\x0e:}o\xd0\xf7z\x9d^{p\xd0\xeex\xbd\x81w\x00\xed\x83\x1d\xb4\xb9\x90\x88\x1f\xb4?\xf9Y\xf9\xc5}!m\xbd\x86\x00\x8f\t\xc5\xe0\\\x12\x19b\ano\xd7k\x90\xe0|\xaf1\x03Vl\xceA\xa3\x87\xed\xc24\x80\xdb\xdb\xc60 \v\xf0C$ĉ\xc3\xd9\xd2\x195\x00\xd2\xd7|\x166gAs\x00\xf6\x03\x1b\x8f\x05\x96ͮ\xfe.f\xcd\xff\x8d?\xd8\x0e\x0f\x04\x99\xd0\xe6<j\x8e\x19\x9f\xe9\xe9\xec\x84$8q\xd2h\x9a\x1e\x00\xb0^\x03\x19\x83{\x81\xa9T`\x81m\xc3h\xb4^C\xc4\t\x95cx!\xc1\xf9\x1d?_`\x10j\x18\xa2fA \x19|\xbdp\xe1m\x88\x91\xc0\x870faȖ \xa7\x18\b\x15\x92\xcf}I\x18\x15@(\x10\xa9\x06\xfb\x8c\x8e\t\x9f\x99=\xa1xi\xa7AA\xc0\xb1\x10\xae\xf3\x12\xdcS}\xe5\xf6v؊R\x10\xe2P\xe0\ft\xd3\xde\xe8\x8e]\x1e\xb6\xa6\xbdQn)\x12\x9c\xf7l\xfe<\f\x81c\x1f\x93\x05\xde,\x03\xc9\rD\x16\x16X\x129\x05\x04!\xa17iȉt\xb2\xc0\x01\f\xd5v\xc2\f\xcb)\vN\x9c\x88\t\xe9\x00\xd2+?q\xd6k\xe0x\x81\xb9\xc0\xf0\xca@\x1a\xaf\xcf\xd9L\xa0W覺\xdf0>s\xcf1\r0O/\x1a`x=\x97\x92\xd1\x18E\xe6\x02s\xd1\x14\xf3\xeb\x19\x91p-\xa9\xfa\u05cc8\x99!\xber\xec\x9a/t\xaf\x01\xdaܝ\x82\xbc\xa5@Oo\xb3AM\xd3\x17\x90Ũ\x91|\xb0\x7f\x0e\xea\x96m\x01\x0e\xb1\xc4O\xc5\xf9\xef\xc9\xff\a\xdd\x1c\xff\xef\xf4\xdaG5\xff\xdf/\xff\xffAc\x86\xe1,\xc8\xf7ٜ\xca}J\x00\x83\xa8M\vI\xa9\f0\x10\a\xe5\x8c\xf6}j\x1d0E\x02\xae1\xa6`f\r\xaa\x98nJ~\\N\x11\xbd\x110f\x1c\xe6\x82\xd0\t|\xad\x19\xfe\xab\v\"\xf1\xff\xa3\x19~\x00ϯ\xd8\xd9j\xae\xbf\x01\x1c\xd1\x00P\x18\x02\x91\x02\x02$\x11,I\x18\xc25\x86\b\xf3\x19\xa2\x98\xcap\x15/ʅ\xcb)\x11\xe0#\xfa\\\xaa!s\x1a0\x8a\xddG\v\x00\x03\xf6k\vI\x89\b\xc8\f\xf8$!\x10(Y\u009d\xcc~\xd52\xe0)ژ\xf1\t\x93\xfb\xe5\xff\x83\xa3\xc1A\xc7\xebz\xfd~\xa7{\xd41\xfc\xbf߫\xf9\xff~\xf9\xff\x1b\x8d\x19\x86KEH\x88%\xe3\xc1w\xfb\x94\x00\x06U\xef\xa5\xfd\xdb\xcbg\"VW\xd3\xfc\xe7),\x03\x8e\x05\xce\xed\x956\a\xde\t\xcc\xcbl\x82R\tq\x7f\xd8\x14 \xb1\xa6\xcf\xf1\x84\b\x899\x0e>/\xd4\xea?Z\x10ly\x1e\xbbE\xd4U\"\xd1\x03M\x9c\x9c9\xa3\xc0F\xdaމ'}\xb4T\xb3\x10\x96\x883ӳ\x1fcfg\xa2\x8c\xf8\x8c\x8a\xa7e\xff\xdb\xf8\xbf\xea6\xfc\x7f0\x18t\xba}\xc5\xff\xbd~\xbb\xe6\xff;\xe6\xff\x17\xcc'(<\xf3\x19\xd5\x18Jb\xcc\x1e#\x18\xa3\xa6\xa2\bM%\xc3\x16\x19m\x90\xf3\xa0n_t\xfb \x9a>\n\xc3k\xe4\xdf<\x19\x17\xd8F\xff=\xaf\xab\xe8\xbf\xe7y\xfd~\xaf\xe3)\xffo\xb7]\xfb\x7fwD\xff\xado\x1a\x00\xf8\xa3\xc44\x10\xc7@\x19ōoZJ\xb9{\xf6ï\xdf_\xbe\x7f{\n\n/F\x8d\xa1\xf9\xa3\x04\x1f\vV\xfa\x83\xf09\x89$\xc8U\x84O\x1c\x89?\xca\xd6\a\xb4@\xe6\xaa\x15\xa7V\x13{\x8bV!C)\xadaIh\xc0\x96.\x8b0\xc5\xfc\xdfځh\xd1\x10no\xff\xf3b\xbdN\xdf\xf3\xf2\xdbFQ$\x1a+w\xcbD\xe6F;\xc6\x0f\x99\xc0/\xf4dÖ\x81҈T\xb3\xa0aˬ\xf0\x9fD\xfe\aZ\x05ܯ\xfc\xf7\xda\x03/o\xffy\x83\xda\xfe۵\xfc\xcf\xd9\x7f\xe7E\xe3`\x9f֟F\xd4R\xe3\xef\xf4cDx\x95\xdb\xef\x1c\xff1\xc7\xc2x\xfc\xb0\x19x\x87\xf9\xa1\x1df\xf1j\xadyċ3\x1cB\xa4M\xac\xa4\xcf\xd8\"%\u07b5\xd88\"c\xa0L\x82\xfb\x1b\n\xc9\x16H\x89\xd0C\x17j\xe4#AMO\xe1\xc2\xd9X\xbdE\xf0C\xe2\xdf(#q\x8a\x8d\x1d5\xe6l\x96\n<\xa9\x01\x98\xcaC\x90|\x05>\x8bV\xcaɩ\xfc\x8d\x11\x12R}&\xf2\x8eչ?0Z\xe5\xeed\x14?\xdb\xe6\xe0LV\x92\xb8f\xe7Q\x80\x947W\x81\xb0bse\a\xeb\xeb\nIp\x00\x84VAS\n\xc4\x05N\x05ɲFc\x06\xa8{Z\x8d\x868rF\xe3\x90\xd0h.A\x19\xcd'N\xe4X\xb98%A\x80\xa9\xa3\xde\xc6\x1c\x9f8Y\xc9V0:\xdfZ\xd0>\xabى\xffZ6g\xdd\xfeb\xf2\xff*\xf1\xfeȏr\xe7\xf2\xbf\xddo\xf7\x94\xfc\xef\x0e\x8e\x06\xbd\xae\xa7\xf4\xffn{Э\xe5\xff\x0e\xf5\xff2\v`\xbd\x86k<!TrD\x85b\n?\x91\xc3F\xe3u\x95\xd0Q\x91\xb1U&V%\x15CI\a\xc8`\x89\x84\xf6\xed\xe90\x95u\xf2\x05n\xc3J\xa8\x80\x04*TE(\x91D\"\x89A*\x19g\xe7Od.\x99P\xc6m\x9fI[j4.\xcb\\\x82\x87\xa0\x8c\x02-\xef\x8c'T\t1-\xf9\b5\x90^s\xb6\x14\x98\x1f7\x1a\x8a\xf5\xbe;\xffE\xad\xb2q\x8e'\x88\a\xe2\xb0q9Ņ\x15\\b4k\x18>\x99\xec\xcb\x17L\xfe\aZ\xe5\"T+V{\xca\xff\xeay\x86\xfe{^\xb7\xd7\xe9uz:\xff\xabW\xfb\xffvI\xff\x84\xfa\xe1<\xc0\xc7\x19\x15\\\xe3\xc3!l\\ć \xb4\x8f\xb0i\x14\t}\xcd:\v\xb2\xb6\x00X\xb4\xaa\xb2\t:^\xac\xdeO{qW\x8c\x89R\x9b!\xb98\xc8\x05\x99P8\xa3:\xc2!\na\xf7X\x83[\xaf\xe1\xab7J\xab=>\x01W\x7f\xb8\xbdM:^+\x16\xa0\x82\x1aj\xae3\xaa\xc7\xe4\xafm\x86뀃k\\\xa2\x97\xab\b\x8b\xb8/\xbb\x1e\xbb\x1f\x16vc\x90|U\x9c\x15\x94\x9a\x96\x1ae\xb4,'\xa3yq\x9d\b\xe6f\xb5=3#\xfe\x03\\\xbdX\xe7G\xc6&\xc6HK\r2 \x8d\x86ϚM\x98\xa0\x88\xb8\xeaA\x84\xbaܨ\x8fK\xc6o\x040\x1a\xae\x80Q\xb8\x0e\x99\x7f\x038\xc43L\xa5\x80fs\x94\x99\t`\x88\xe2\xc5M\xec\xc3T\xa2AS\xf8,2\xea\xeb\aF(\xbc2\x90\\\xa8\xab\x02\x1cP 9\xb9\x99\xc0\xdci\xec\neí\xd7\xf1}\xaf\xa3\xc8=\xd3{`\xc6p\x1c\x10\x8e}9\xe7Ĩ\xdd3,\x04\x9a\xe0\x8a)\x91\xefc!\x8c~\xcd\xc6\xe3\x90\xd0\x18L\x9f\xb1\x1b\x82#\x16\x12\x7f\xa5p\x8aNB|5eB^1N&\x84V\xc1h}F'\xceՕV\xa9\x7f\xa5v\x81\xda\xda8+\xb9o\xca\xf18k\x14\x98\xd7m\xee3\x96\xb2Ƣ\xaf,&~\xa7\f\xae\x13\xebGO#AaꡈP\xa2\xe1+\xf23\x9a<\x9eE!\x929O\xbd}K\xcd(\x9c\v\xa3嫛G\x859\xcb\xe9I\x03\xa8)\xaa\n\xb5\x94\xfa\x8f\xb2\xd3%\x86\xc1\x9d\xb1L\x80\x12\x98\xffO3\x0e\xa7\x04\xcd31\xc5\xe2\x95\xd43\xb3]\xd6\tPA\xc99jM\t\xbb\x14\xf1%>\x86\x8a\xfer\xb3P\xe3\xcd\a\xd1,3\x0f\x7f\xbeH\xc0(\xb7\x1f7ݣ\xfc\x96\xb9\xa6\xaf\xd2\x00|\x9c\th\xdew\xb9\x19\x98\xdf\xc6s\x1dQ\xe6H\x81]\x82\x0ei\xe4\x8cwl\x8a\xc3\xc8\xd9\xd8\xfbϕ\xbb\xc4\xc4o\xad6\xf8]\tbey\rLX\xd3J\x9fd\x15\x84\x8e\x99\xfe\xf0Q8\x15\x04\xf7.J\x91\x9a\xbb\x85\xd4l\xce-\xc7\n%\x19\xb59Eh\x04\xa6\x83P\x10\xd8g4\x10\xcfJ\xe9\xa0HYE\xc4\xddl\x11\t\xe2wc3\x17\x94\xee錆\xa8d%\xa9(\xf4\xd6\xc09\x1a\x15!\xc9[\xf29\x12\xcdb\xb8\x15\xef\xcd%GQ\x84\xb9\x03B\xaeB|\xe2\x04DD!Z\x1d+\v \xef\x98\xd8\x10rAC\xc8Qs\xe6\xd1\x15d[{\x14\xfej\xfa\xff\x8c\x05\xe8\xa9\x0e\x80l\xcb\xffj\x0f\x8er\xfe\x7foЮ\xfd\xff\xbb\xd4\xff\xb3\xd6\x7fb\x0f\x88c(\x18\x88)\x85?-35\x0291;\xd7\xdf`\x8c\x02\\0\x01tW3 (d\x93\xb4\x93?\xd3\xed3*1\x95\t\x17*\x8e\x98b\x14`\x9e\f\xd8HE\xa3\x15^[E#\xb6;T\xe8\xcf\xca쀈\x19I&r\x00q\x82\x9a\xc6K{\xe2H>\xc7\xce\xe8\x7f$\x99a\xf1m\x89\xc7tc\xaf\x180>\xc1Zɳ\xca\xc2\x12UX2k#\xe4\xb80\xa1\x15\\85\xf1\xb0\x95\xd8\x06-7\xb3\xb9V\xf7/\x19`^\x8e\xee/\xf6\xea\xcb5\xe3\xfc{\xf1\xff'\x8d\x00o\xf3\xffv\a\x9d|\xfc\xb7\xdf\x1e\xd4\xfc\x7f\x87\xfc\x7f+\xbf\xaf\x8e\x13\xa7L\x8b\aǆ{\xb9\xd8p/\x0e\t\x0fr\xb1\xe1.\x94\xd8f\xf7d\x88\xb5\xb2\xb9\x8d\xfe\xd3\xda\xfc\x1e\xe8\xff\xa8\xdf\xcf\xeb\x7f\xbd\xa3Z\xff\xdbI+\xb5\f\r\xb9N{9\xb5\xe6g\xe5\xf8\xabTh\x1e\xe7#y\x17\xdd\xe9#1ݣF\xda7\xf2.*\xf1\x8d<\xc2'\xa2\x96St\x88luo\xbc\x0e9F\xc1\xaa\xd4\xc1Q\xee\xd0 \xf4!\x0e\x8d3\xfa`\x87Fƻ\x93v\x0f\xc4n\x81j\xdeg_\xfb~\xe5\x7f\x81\xfe;}\xaf>\xff\xb9\a\xf9_\x12\xff\xd9&\xfec*zrٟ\xcd\v\xbb\xa7K\xaa\x96\xfd[Z1\xa0\xb7s\xfa\xef\xb4;G6\xfe\xdb\xed\xf7\xfb]%\xff\x8f\xbcZ\xfe\xef\x85\xfe7\xc1^C\xf8\x8d\xc2\x01\x918\x84\xa3\xe9\xab2\xc4\xf3\x1b\xc1K\a^\xd8h\xda\xd5\x04\xcb+\x83j\xe0\xbe\x04\xc3P,\xbb\xa8z\x82\x99A\xf1\x93D\xa6\xea\xecn\xf5Ѫ\x1eV\xc1\x88X4\x8flf\x9d\xfahC\x91\x9b\xbe\xe6\x92\x04r\x9a\x1a\xf1\xbb\xfa\x9e\x9b\xa09\xc5d2\x95\xa9Q?\xe9\v\xe9\xd9Lj\x9f\x1a\x10C\xd0(\t\x04\xba?!\x1a\x84\x98\xdb1\xb1,\x7f\xb1@\x1c\x1c%Н\x97w\x85\x03\x1f\x16\xffs՟D\x15\x8b\x05\xff\xb6h_\f\x7fC\a\xf7\xea\xe3<\xff\xd8&\x97\xac9F\xbed\xbc\x89)g\xe1S\xc4\x00\xb6\xf0\xffn\xbf\xd7O\xea\x7ft\xda\x1d\x95\xff\xd7\xef\xd4\xfaߎ\xf8\x7f\x95bw\x99`\x06\xa0\xb9\x9c\xaa\x14\x0e_\x87\x84\xf7y\n\xa0\x80\xae\xe9Q\xe9|\xef;\xa1O\xfb\xe0m\xe0\xfb\x1c\xfbl\x81\xf9\xea{\x16\xa4s}Ri\xea\x17Ҥ\x1db\x9d\xf7oF\x83\xaf\x87\x13\n\b\x04\x1ac\x88B\xe4c\x17N\x91?U\xf1eU|C\x97\xde\x108\x00F}\xac\x0f/+n\xac\xce`\x9b\xacG\x15\x99\x00\x93ТzM\n\xe5\x06d\xb5\x80(R\xb5<\xf0\n\x96\xcc\xd6\xf2\xb0\xa1Z\x95\x1e?A\xc5Tx\x80\xe1<Lފ\x05\xb6\xa9\x81\xcd\avm\xdaQ\xe5\xfa\xf5d!\x19\r\xd5\xdd#+\xae\x86-\xfdm\xd8\n\xc9\xe8\xce4\x8eak\x1ef\"\x18\x19\xabv#\xd9\xf3&\xfaF\xa8\xba\xe9\xc0=\xa3\x92\xd0y\x12\xb6\xaf:\x8a\xae\xcf#\x10\x81\xaeC\x1c\x94\xbe\xcbJ\xecHU\x86\xb1\xf7\xdfu\xe6\xe1\x94>\xe6\x11*o\xd5\xdc\xe8\xc2)\x95\x98\x03\xd2x\xa4\xde\xfeD\x9dbS2^%\xb2氌q5\xc2\xc2Ur\x1a\xe3\xfe\xa7\x17.\x97\xec\x8d\x06\xedT\xd3P\xd99\x84d\xc8\xe7<\x88\xf0c\xf5\xea\xaa2SrO\xc9\x15\x88\xb1g-\xec\x9e$g,:I*J\xdcq\xbf\xd21\xf9\xd3#\xf1\xbb|\x1d\x04&\xdf8Nm\xae\xa4S[\x1b(\x9bs|\x83W\x87\xea\x12\x05\xac_\xb7\xea\xd5/\x9cȘ\x90E\xc9ˌR4w\x81}\x8ee\x9a\xf22\x15\x19Z\xdf(\xfe\x00\x11g\v\"\b\xa3\xea\xa9\xef\xce\xcfb\xf6c\x12\x01\x15\xb3\x10\x80\xe0_\xe7\xe6\xe9\xfa\xfc\xcf\xcf\x17\xa0\xf5\xfcL\xa6\x88\xdd\xec\x14\xb3\xfd\x83[UY'\xe8\x99l\xe93{\x1c<\x9bi\xf2h\x14\xbc\xaf\xcbk\x17\x88j\b\xfb\x9fPqh\xf3\x8e\x9f\xce\x05\xb8\xcd\xfe\xefx\xbd\x9c\xfe\xe7y\x9d~\xad\xff\xfd\x9d\xf4\xbfO\x8b\xf4\x95\xe9\x7f\x9f\xaa\xf8%\xdc\xfd4˔7\xc7\"\x8bܝq\xadձ\xb1\x19\x90\x15ay\x16\xfe`V\xf8\x18&x\x1f\x16\xf8\b\x06\xf8\x1b\xe6d\xbc*c\x80i\xf6W\x9f\x10\xfc\xe2\x9bA\x86)c7{\xe3\xffm\xaf\x97?\xff\xefy\xdd\xda\xfeߥ\xffWg\x00\x8b?\xaf\xe74P\x9aϱ>\xb0\"\xdc\x10\v\xa1zu\xad\x8ct\xf7\v\xd3\xffA\xbcL\xe2C\x9ao\xbdR)\xf8\x8ak\xdc]\x1aDyB\xaf\x8co\x18N`\xbdv\xff\xfc \x18\xbd\xbd\xfd6\xa9\xcbQ\xbb$w\xd3\x16\x9a\xd1\xef\xb5\xfe\xa3\xe7\xf5\xbdB\xfd\xdf^M\xff{\xd6\xff\x8c\n\x90.\x10a+\x0e\xee\xd3\xffg\xd0\xd5ԁ\x7fP\x1d\x90_Թ\xdf\a\x14\x01\xd1\x0f\x8a=6a\xeef\x17.\xac\x0fO\xb2\xf8\x18\xf3\xbdj\x80\xb8gtQ]\x01D\xc3\xf8\x90\xf2\x1fE \xf7Q\xf9\xe3\x94s\xc6+\xaa۳y\x18h\x88\xfc\xfbֹO\xa6\xfb\\uF,v\x98\xfa\xf4\xc1\x96z\x9b\xef\vؾ\xf1\b\xfav\x06Se\xb3\xa2\xd8\xff}\v{\x96<\xe8\xeb\xc5\xe6Y\xe6\xcd\xe2\xe0\xee\xc7T\xf9>\v?\x86\x90\xfa\xb9\x01\xec\xdf\xdc\xef\xd7\x06R\xa5G\x15\x12ť8\x1fP^tQ\xc5Aʰ\xa9\xae;\xb2G\xf9\x7fe^\xed\x93T\xffx\x98\xff'\xf9\xfd\x97:\xff{\x97\xfa\x7fE\xfd\x8f\r\xe3\x84\xeaz q\x01\x10\xf53#f\xacb\tY\xde\x16{L\xee\xa8\r\"\x19h\xf6o\x99\xddS\xd6\n\xb9\xfbw\\vU7d#(\xcaw6\xf5\x8b\x03\xe6@\xff\x04\xe6Q\xc9Ι5U2\xdb].\xa7\xb6\xd8\xeaV\xb7\xba\xd5\xedKi\xff\x1d\x00\x9f\xf2\x1cS\x00p\x00\x00")
 	App.SetTemplatesFS(templatesFS)
 	tmpl_users_hook_html := template.New(templatesFS, manager)
 	tmpl_users_hook_html.Funcs(map[string]interface{}{
@@ -52,7 +52,10 @@ func signInGoogleTokenHandler(ctx *app.Context, client *oauth2.Client, token *oa
 	if err != nil {
 		panic(err)
 	}
-	ctx.MustSignIn(asGondolaUser(user))
+	if !signInOrVerify(ctx, user) {
+		ctx.Redirect(twoFactorURL(ctx, ctx.FormValue(app.SignInFromParameterName)), false)
+		return
+	}
 	redirectToFrom(ctx)
 }
 
@@ -67,7 +70,10 @@ func jsSignInGoogleHandler(ctx *app.Context) {
 	if err != nil {
 		panic(err)
 	}
-	ctx.MustSignIn(asGondolaUser(user))
+	if !signInOrVerify(ctx, user) {
+		writeTwoFactorRedirect(ctx, "")
+		return
+	}
 	writeJSONEncoded(ctx, user)
 }
 
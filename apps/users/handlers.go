@@ -1,11 +1,9 @@
 package users
 
 import (
-	"errors"
+	"encoding/json"
 	"fmt"
-	"net/url"
 	"reflect"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -14,13 +12,7 @@ import (
 	"gnd.la/crypto/password"
 	"gnd.la/form"
 	"gnd.la/i18n"
-	"gnd.la/net/mail"
 	"gnd.la/orm"
-	"gnd.la/util/stringutil"
-)
-
-var (
-	errResetExpired = errors.New("password reset expired")
 )
 
 const (
@@ -37,6 +29,12 @@ const (
 	SignOutHandlerName        = "users-sign-out"
 	ForgotHandlerName         = "users-forgot"
 	ResetHandlerName          = "users-reset"
+	TwoFactorHandlerName      = "users-two-factor"
+
+	TwoFactorEnrollHandlerName = "users-two-factor-enroll"
+	VerifyEmailHandlerName     = "users-verify-email"
+	ChangeEmailHandlerName     = "users-change-email"
+	DeleteAccountHandlerName   = "users-delete-account"
 
 	FacebookChannelHandlerName = "users-facebook-channel"
 	ImageHandlerName           = "users-image-handler"
@@ -53,6 +51,12 @@ var (
 	ForgotTemplateName      = "forgot.html"
 	ResetTemplateName       = "reset.html"
 
+	TwoFactorTemplateName       = "two-factor.html"
+	TwoFactorEnrollTemplateName = "two-factor-enroll.html"
+	VerifyEmailTemplateName     = "verify.html"
+	ChangeEmailTemplateName     = "change-email.html"
+	DeleteAccountTemplateName   = "delete.html"
+
 	SignInHandler           = app.NamedHandler(app.SignInHandlerName, app.Anonymous(signInHandler))
 	SignInFacebookHandler   = app.NamedHandler(SignInFacebookHandlerName, app.Anonymous(signInFacebookHandler))
 	SignInGoogleHandler     = app.NamedHandler(SignInGoogleHandlerName, app.Anonymous(signInGoogleHandler))
@@ -68,6 +72,11 @@ var (
 	JSSignUpHandler         = app.NamedHandler(JSSignUpHandlerName, app.Anonymous(jsSignUpHandler))
 	FacebookChannelHandler  = app.NamedHandler(FacebookChannelHandlerName, facebookChannelHandler)
 	UserImageHandler        = app.NamedHandler(ImageHandlerName, imageHandler)
+	TwoFactorHandler        = app.NamedHandler(TwoFactorHandlerName, app.Anonymous(twoFactorHandler))
+	TwoFactorEnrollHandler  = app.NamedHandler(TwoFactorEnrollHandlerName, app.SignedIn(twoFactorEnrollHandler))
+	VerifyEmailHandler      = app.NamedHandler(VerifyEmailHandlerName, verifyEmailHandler)
+	ChangeEmailHandler      = app.NamedHandler(ChangeEmailHandlerName, app.SignedIn(changeEmailHandler))
+	DeleteAccountHandler    = app.NamedHandler(DeleteAccountHandlerName, app.SignedIn(deleteAccountHandler))
 )
 
 func signInHandler(ctx *app.Context) {
@@ -82,7 +91,10 @@ func signInHandler(ctx *app.Context) {
 	signIn := SignIn{From: from}
 	form := form.New(ctx, &signIn)
 	if AllowUserSignIn && form.Submitted() && form.IsValid() {
-		ctx.MustSignIn(asGondolaUser(reflect.ValueOf(signIn.User)))
+		if !signInOrVerify(ctx, reflect.ValueOf(signIn.User)) {
+			ctx.Redirect(twoFactorURL(ctx, signIn.From), false)
+			return
+		}
 		ctx.RedirectBack()
 		return
 	}
@@ -111,7 +123,10 @@ func jsSignInHandler(ctx *app.Context) {
 	form := form.New(ctx, &signIn)
 	if form.Submitted() && form.IsValid() {
 		user := reflect.ValueOf(signIn.User)
-		ctx.MustSignIn(asGondolaUser(user))
+		if !signInOrVerify(ctx, user) {
+			writeTwoFactorRedirect(ctx, signIn.From)
+			return
+		}
 		writeJSONEncoded(ctx, user)
 		return
 	}
@@ -127,7 +142,10 @@ func signUpHandler(ctx *app.Context) {
 	user, _ := newEmptyUser()
 	form := SignUpForm(ctx, user)
 	if form.Submitted() && form.IsValid() {
-		saveNewUser(ctx, user)
+		if !saveNewUser(ctx, user) {
+			ctx.Redirect(verificationSentURL(ctx), false)
+			return
+		}
 		ctx.RedirectBack()
 		return
 	}
@@ -146,7 +164,12 @@ func jsSignUpHandler(ctx *app.Context) {
 	user, _ := newEmptyUser()
 	form := SignUpForm(ctx, user)
 	if form.Submitted() && form.IsValid() {
-		saveNewUser(ctx, user)
+		if !saveNewUser(ctx, user) {
+			ctx.WriteJSON(map[string]interface{}{
+				"redirect": verificationSentURL(ctx),
+			})
+			return
+		}
 		writeJSONEncoded(ctx, user)
 		return
 	}
@@ -188,34 +211,11 @@ func forgotHandler(ctx *app.Context) {
 	}
 	f := form.New(ctx, &fields)
 	if f.Submitted() && f.IsValid() {
-		se, err := ctx.App().EncryptSigner(Salt)
-		if err != nil {
-			panic(err)
-		}
-		values := make(url.Values)
-		values.Add("u", strconv.FormatInt(user.Id(), 36))
-		values.Add("t", strconv.FormatInt(time.Now().Unix(), 36))
-		values.Add("n", stringutil.Random(64))
-		payload := values.Encode()
-		p, err := se.EncryptSign([]byte(payload))
-		if err != nil {
-			panic(err)
-		}
-		abs := ctx.URL()
-		reset := fmt.Sprintf("%s://%s%s?p=%s", abs.Scheme, abs.Host, ctx.MustReverse(ResetHandlerName), p)
+		payload := encodePayload(ctx, payloadReset, user.Id(), nil)
 		data := map[string]interface{}{
-			"URL": reset,
+			"URL": payloadURL(ctx, ResetHandlerName, payload),
 		}
-		from := mail.DefaultFrom()
-		if from == "" {
-			from = fmt.Sprintf("no-reply@%s", abs.Host)
-		}
-		msg := &mail.Message{
-			To:      user.Email,
-			From:    from,
-			Subject: fmt.Sprintf(ctx.T("Reset your %s password"), SiteName),
-		}
-		ctx.MustSendMail("reset_password.txt", data, msg)
+		sendMail(ctx, "reset_password.txt", user.Email, fmt.Sprintf(ctx.T("Reset your %s password"), SiteName), data)
 		sent = true
 	}
 	data := map[string]interface{}{
@@ -228,35 +228,8 @@ func forgotHandler(ctx *app.Context) {
 }
 
 func decodeResetPayload(ctx *app.Context, payload string) (reflect.Value, error) {
-	se, err := ctx.App().EncryptSigner(Salt)
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	value, err := se.UnsignDecrypt(payload)
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	qs, err := url.ParseQuery(string(value))
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	userId, err := strconv.ParseInt(qs.Get("u"), 36, 64)
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	ts, err := strconv.ParseInt(qs.Get("t"), 36, 64)
-	if err != nil {
-		return reflect.Value{}, err
-	}
-	if time.Since(time.Unix(ts, 0)) > PasswordResetExpiry {
-		return reflect.Value{}, errResetExpired
-	}
-	user, userVal := newEmptyUser()
-	ok := ctx.Orm().MustOne(orm.Eq("User.UserId", userId), userVal)
-	if !ok {
-		return reflect.Value{}, errNoSuchUser
-	}
-	return user, nil
+	user, _, err := decodePayload(ctx, payloadReset, payload, PasswordResetExpiry)
+	return user, err
 }
 
 func resetHandler(ctx *app.Context) {
@@ -276,7 +249,7 @@ func resetHandler(ctx *app.Context) {
 		if err == nil && user.IsValid() {
 			valid = true
 		} else {
-			if err == errResetExpired {
+			if err == errPayloadExpired {
 				expired = true
 			}
 		}
@@ -285,8 +258,12 @@ func resetHandler(ctx *app.Context) {
 		passwordForm := &PasswordForm{User: user}
 		f = form.New(ctx, passwordForm)
 		if f.Submitted() && f.IsValid() {
+			setUserValue(user, "Password", password.NewOptions(string(passwordForm.Password), PasswordOptions))
 			ctx.Orm().MustSave(user.Interface())
-			ctx.MustSignIn(asGondolaUser(user))
+			if !signInOrVerify(ctx, user) {
+				ctx.Redirect(twoFactorURL(ctx, ""), false)
+				return
+			}
 			done = true
 		}
 	}
@@ -314,11 +291,31 @@ func FormErrors(ctx *app.Context, frm *form.Form) {
 	ctx.WriteJSON(data)
 }
 
-func saveNewUser(ctx *app.Context, user reflect.Value) {
-	setUserValue(user, "Password", password.New(string(getUserValue(user, "Password").(password.Password))))
+// saveNewUser saves a new user and signs them in, unless they
+// need to verify their email first. In that case, it returns false.
+func saveNewUser(ctx *app.Context, user reflect.Value) bool {
+	setUserValue(user, "Password", password.NewOptions(string(getUserValue(user, "Password").(password.Password)), PasswordOptions))
 	setUserValue(user, "Created", time.Now().UTC())
+	email := getUserValue(user, "Email").(string)
+	verify := email != "" && shouldVerifyEmail()
+	if verify {
+		setUserValue(user, "VerificationSent", time.Now().UTC())
+	}
 	ctx.Orm().MustInsert(user.Interface())
+	if verify {
+		sendVerificationEmail(ctx, user, email)
+	}
+	if RequireVerifiedEmail {
+		return false
+	}
 	ctx.MustSignIn(asGondolaUser(user))
+	return true
+}
+
+// verificationSentURL returns the URL for the page which tells
+// the user to check their email.
+func verificationSentURL(ctx *app.Context) string {
+	return ctx.MustReverse(VerifyEmailHandlerName) + "?sent=1"
 }
 
 func delayedHandler(f func() app.Handler) app.Handler {
@@ -342,8 +339,22 @@ func delayedHandler(f func() app.Handler) app.Handler {
 
 func windowCallbackHandler(ctx *app.Context, user reflect.Value, callback string) {
 	inWindow := ctx.FormValue("window") != ""
-	if user.IsValid() {
-		ctx.MustSignIn(asGondolaUser(user))
+	if user.IsValid() && !signInOrVerify(ctx, user) {
+		verify := twoFactorURL(ctx, ctx.FormValue(app.SignInFromParameterName))
+		if !inWindow {
+			ctx.Redirect(verify, false)
+			return
+		}
+		// Let the opener window continue to TwoFactorHandler
+		payload, err := json.Marshal(map[string]interface{}{"redirect": verify})
+		if err != nil {
+			panic(err)
+		}
+		ctx.MustExecute("js-callback.html", map[string]interface{}{
+			"Callback": callback,
+			"Payload":  payload,
+		})
+		return
 	}
 	if inWindow {
 		var payload []byte
@@ -0,0 +1,380 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/crypto/password"
+	"gnd.la/form"
+	"gnd.la/i18n"
+	"gnd.la/net/mail"
+	"gnd.la/orm"
+	"gnd.la/signal"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// VERIFIED is emitted when a user verifies their email
+	// address. The object is a *Event.
+	VERIFIED = "gnd.la/apps/users.verified"
+	// EMAIL_CHANGED is emitted when a user confirms a new email
+	// address. The object is a *Event, with PreviousEmail set.
+	EMAIL_CHANGED = "gnd.la/apps/users.email-changed"
+	// LOCKED is emitted when a user is locked out after too many
+	// failed sign in attempts. The object is a *Event.
+	LOCKED = "gnd.la/apps/users.locked"
+	// DELETED is emitted after a user deletes their account. The
+	// object is a *Event.
+	DELETED = "gnd.la/apps/users.deleted"
+
+	// Kinds of payloads sent by email, to prevent
+	// a link from being used for a different purpose.
+	payloadReset  = "r"
+	payloadVerify = "v"
+)
+
+var (
+	ErrEmailNotVerified = i18n.NewError("please, verify your email address first - we've sent you an email with the instructions")
+	ErrAccountLocked    = i18n.NewError("too many failed sign in attempts, please try again later")
+
+	errPayloadExpired = errors.New("payload expired")
+	errInvalidPayload = errors.New("invalid payload")
+)
+
+// Event is the object emitted with the signals
+// declared in this package.
+type Event struct {
+	// Context is the context which caused the event.
+	Context *app.Context
+	// User is the user which originated the event, as a pointer to
+	// the type registered with SetType.
+	User interface{}
+	// PreviousEmail is the user email before the change, only
+	// set for EMAIL_CHANGED.
+	PreviousEmail string
+}
+
+func emit(ctx *app.Context, name string, user reflect.Value, previousEmail string) {
+	signal.Emit(name, &Event{Context: ctx, User: user.Interface(), PreviousEmail: previousEmail})
+}
+
+// encodePayload returns an encrypted and authenticated payload which
+// includes the given kind, user id and values, as well as the current
+// time, suitable for including in the links sent by email.
+func encodePayload(ctx *app.Context, kind string, userId int64, values url.Values) string {
+	ae, err := ctx.App().AEADEncrypter(Salt)
+	if err != nil {
+		panic(err)
+	}
+	if values == nil {
+		values = make(url.Values)
+	}
+	values.Set("k", kind)
+	values.Set("u", strconv.FormatInt(userId, 36))
+	values.Set("t", strconv.FormatInt(time.Now().Unix(), 36))
+	values.Set("n", stringutil.Random(64))
+	p, err := ae.EncryptString([]byte(values.Encode()), []byte(kind))
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// decodePayload decodes a payload previously returned from encodePayload,
+// returning the user it references and its values. Payloads older than
+// expiry return errPayloadExpired.
+//
+// Payloads produced by previous versions (encrypted and then signed)
+// are still accepted, so links sent before upgrading keep working.
+func decodePayload(ctx *app.Context, kind string, payload string, expiry time.Duration) (reflect.Value, url.Values, error) {
+	ae, err := ctx.App().AEADEncrypter(Salt)
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	value, _, err := ae.DecryptString(payload, []byte(kind))
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	qs, err := url.ParseQuery(string(value))
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	// Password reset links sent by previous versions
+	// don't include the kind.
+	if k := qs.Get("k"); k != kind && (kind != payloadReset || k != "") {
+		return reflect.Value{}, nil, errInvalidPayload
+	}
+	userId, err := strconv.ParseInt(qs.Get("u"), 36, 64)
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	ts, err := strconv.ParseInt(qs.Get("t"), 36, 64)
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	if time.Since(time.Unix(ts, 0)) > expiry {
+		return reflect.Value{}, nil, errPayloadExpired
+	}
+	user, userVal := newEmptyUser()
+	ok := ctx.Orm().MustOne(ById(userId), userVal)
+	if !ok {
+		return reflect.Value{}, nil, errNoSuchUser
+	}
+	return user, qs, nil
+}
+
+// payloadURL returns the absolute URL for the given handler
+// with the given payload.
+func payloadURL(ctx *app.Context, handlerName string, payload string) string {
+	abs := ctx.URL()
+	return fmt.Sprintf("%s://%s%s?p=%s", abs.Scheme, abs.Host, ctx.MustReverse(handlerName), payload)
+}
+
+// sendMail sends an email to the given address using the given
+// template, which receives data as its argument.
+func sendMail(ctx *app.Context, template string, to string, subject string, data map[string]interface{}) {
+	from := mail.DefaultFrom()
+	if from == "" {
+		from = fmt.Sprintf("no-reply@%s", ctx.URL().Host)
+	}
+	msg := &mail.Message{
+		To:      to,
+		From:    from,
+		Subject: subject,
+	}
+	ctx.MustSendMail(template, data, msg)
+}
+
+// sendVerificationEmail sends an email to the given address with
+// a link which verifies it and, if it's not the user's current email,
+// changes the user email to it.
+func sendVerificationEmail(ctx *app.Context, user reflect.Value, email string) {
+	current := getUserValue(user, "Email").(string)
+	values := make(url.Values)
+	values.Set("c", Normalize(current))
+	values.Set("e", email)
+	payload := encodePayload(ctx, payloadVerify, asGondolaUser(user).Id(), values)
+	data := map[string]interface{}{
+		"URL":    payloadURL(ctx, VerifyEmailHandlerName, payload),
+		"Change": Normalize(email) != Normalize(current),
+		"Email":  email,
+	}
+	sendMail(ctx, "verify_email.txt", email, fmt.Sprintf(ctx.T("Verify your %s email address"), SiteName), data)
+}
+
+// resendVerificationEmail sends a new verification email to the
+// user's current address, unless another one was sent less than
+// VerificationEmailInterval ago. It's used when the user tries to
+// sign in, so repeated sign in attempts can't flood their inbox.
+func resendVerificationEmail(ctx *app.Context, user reflect.Value) {
+	email := getUserValue(user, "Email").(string)
+	if email == "" {
+		return
+	}
+	sent, _ := getUserValue(user, "VerificationSent").(time.Time)
+	if time.Since(sent) < VerificationEmailInterval {
+		return
+	}
+	setUserValue(user, "VerificationSent", time.Now().UTC())
+	if _, err := ctx.Orm().Save(user.Interface()); err != nil {
+		ctx.Logger().Errorf("error saving user verification time: %s", err)
+		return
+	}
+	uncacheUser(ctx, asGondolaUser(user).Id())
+	sendVerificationEmail(ctx, user, email)
+}
+
+// shouldVerifyEmail returns true iff verification emails
+// should be sent to new users.
+func shouldVerifyEmail() bool {
+	return VerifyEmail || RequireVerifiedEmail
+}
+
+// failedSignIn increments the failed sign in attempts for the given
+// user, locking them out if they reach MaxFailedSignIns. A
+// MaxFailedSignIns <= 0 disables locking.
+func failedSignIn(ctx *app.Context, user reflect.Value) {
+	if MaxFailedSignIns <= 0 {
+		return
+	}
+	failed := getUserValue(user, "FailedSignIns").(int) + 1
+	if failed >= MaxFailedSignIns {
+		lockOut(ctx, user)
+		return
+	}
+	setUserValue(user, "FailedSignIns", failed)
+	if _, err := ctx.Orm().Save(user.Interface()); err != nil {
+		ctx.Logger().Errorf("error saving failed sign in: %s", err)
+		return
+	}
+	uncacheUser(ctx, asGondolaUser(user).Id())
+}
+
+// lockOut locks the given user out for SignInLockout, clearing
+// their failed sign in attempts.
+func lockOut(ctx *app.Context, user reflect.Value) {
+	setUserValue(user, "LockedUntil", time.Now().UTC().Add(SignInLockout))
+	setUserValue(user, "FailedSignIns", 0)
+	if _, err := ctx.Orm().Save(user.Interface()); err != nil {
+		ctx.Logger().Errorf("error locking user out: %s", err)
+		return
+	}
+	uncacheUser(ctx, asGondolaUser(user).Id())
+	emit(ctx, LOCKED, user, "")
+}
+
+// resetFailedSignIns clears the failed sign in attempts for the given
+// user. It's called once the user has completely signed in, so users
+// with two-factor authentication must also enter a valid code.
+func resetFailedSignIns(ctx *app.Context, user reflect.Value) {
+	if getUserValue(user, "FailedSignIns").(int) == 0 {
+		return
+	}
+	setUserValue(user, "FailedSignIns", 0)
+	if _, err := ctx.Orm().Save(user.Interface()); err != nil {
+		ctx.Logger().Errorf("error resetting failed sign ins: %s", err)
+		return
+	}
+	uncacheUser(ctx, asGondolaUser(user).Id())
+}
+
+func isLocked(user reflect.Value) bool {
+	locked, _ := getUserValue(user, "LockedUntil").(time.Time)
+	return locked.After(time.Now())
+}
+
+// checkCurrentPassword returns an error if the user has a password
+// and it doesn't match the given one.
+func checkCurrentPassword(user reflect.Value, plain string) error {
+	pw := getUserValue(user, "Password").(password.Password)
+	if pw.IsValid() && pw.Check(plain) != nil {
+		return ErrInvalidPassword
+	}
+	return nil
+}
+
+func verifyEmailHandler(ctx *app.Context) {
+	payload := ctx.FormValue("p")
+	data := map[string]interface{}{
+		"Sent": ctx.FormValue("sent") != "",
+	}
+	if payload != "" {
+		user, values, err := decodePayload(ctx, payloadVerify, payload, EmailVerificationExpiry)
+		current := ""
+		if err == nil {
+			current = getUserValue(user, "Email").(string)
+		}
+		email := values.Get("e")
+		switch {
+		case err == errPayloadExpired:
+			data["Expired"] = true
+		case err != nil || values.Get("c") != Normalize(current):
+			// Links for changing the email become invalid
+			// once the email has been changed.
+			data["Invalid"] = true
+		default:
+			changed := Normalize(email) != Normalize(current)
+			if changed {
+				if _, err := validateNewEmail(ctx, email); err != nil {
+					data["Error"] = err
+					break
+				}
+				setUserValue(user, "Email", email)
+			}
+			setUserValue(user, "EmailVerified", true)
+			ctx.Orm().MustSave(user.Interface())
+			uncacheUser(ctx, asGondolaUser(user).Id())
+			emit(ctx, VERIFIED, user, "")
+			if changed {
+				emit(ctx, EMAIL_CHANGED, user, current)
+			}
+			data["Done"] = true
+			data["Changed"] = changed
+			data["Email"] = email
+		}
+	}
+	ctx.MustExecute(VerifyEmailTemplateName, data)
+}
+
+func changeEmailHandler(ctx *app.Context) {
+	user := reflect.ValueOf(ctx.User())
+	var fields struct {
+		Email            string `form:",singleline,max_length=50,label=New Email"`
+		Password         string `form:",password,optional,label=Current Password"`
+		ValidateEmail    func(*app.Context) error
+		ValidatePassword func(*app.Context) error
+	}
+	fields.ValidateEmail = func(c *app.Context) error {
+		addr, err := validateNewEmail(c, fields.Email)
+		if err != nil {
+			return err
+		}
+		fields.Email = addr
+		return nil
+	}
+	fields.ValidatePassword = func(c *app.Context) error {
+		return checkCurrentPassword(user, fields.Password)
+	}
+	f := form.New(ctx, &fields)
+	var sent bool
+	if f.Submitted() && f.IsValid() {
+		sendVerificationEmail(ctx, user, fields.Email)
+		sent = true
+	}
+	data := map[string]interface{}{
+		"ChangeEmailForm": f,
+		"HasPassword":     getUserValue(user, "Password").(password.Password).IsValid(),
+		"Sent":            sent,
+		"Email":           fields.Email,
+	}
+	ctx.MustExecute(ChangeEmailTemplateName, data)
+}
+
+func deleteAccountHandler(ctx *app.Context) {
+	user := reflect.ValueOf(ctx.User())
+	var fields struct {
+		Password         string `form:",password,optional,label=Password"`
+		Confirm          bool   `form:",label=I understand that my account will be permanently deleted"`
+		ValidatePassword func(*app.Context) error
+		ValidateConfirm  func(*app.Context) error
+	}
+	fields.ValidatePassword = func(c *app.Context) error {
+		return checkCurrentPassword(user, fields.Password)
+	}
+	fields.ValidateConfirm = func(c *app.Context) error {
+		if !fields.Confirm {
+			return i18n.Errorf("please, confirm that you want to delete your account")
+		}
+		return nil
+	}
+	f := form.New(ctx, &fields)
+	var deleted bool
+	if f.Submitted() && f.IsValid() {
+		id := asGondolaUser(user).Id()
+		o := ctx.Orm()
+		if err := o.Delete(user.Interface()); err != nil {
+			panic(err)
+		}
+		if _, err := o.DeleteFrom(o.TypeTable(twoFactorType), orm.Eq("UserId", id)); err != nil {
+			panic(err)
+		}
+		if err := deleteRecoveryCodes(ctx, id); err != nil {
+			panic(err)
+		}
+		uncacheUser(ctx, id)
+		ctx.SignOut()
+		emit(ctx, DELETED, user, "")
+		deleted = true
+	}
+	data := map[string]interface{}{
+		"DeleteAccountForm": f,
+		"HasPassword":       getUserValue(user, "Password").(password.Password).IsValid(),
+		"Deleted":           deleted,
+	}
+	ctx.MustExecute(DeleteAccountTemplateName, data)
+}
@@ -0,0 +1,247 @@
+package users
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	signUpURL      = "/users/sign-up/"
+	changeEmailURL = "/users/email/"
+	deleteURL      = "/users/delete/"
+)
+
+var verifyLinkRe = regexp.MustCompile(`(/users/verify/\?p=\S+)`)
+
+// verifyLink returns the verification link in the last
+// email sent to the given address.
+func (u *usersTester) verifyLink(t *testing.T, to string) string {
+	sent := u.mail.sent(to)
+	if len(sent) == 0 {
+		t.Fatalf("no email sent to %s", to)
+	}
+	m := verifyLinkRe.FindStringSubmatch(sent[len(sent)-1])
+	if m == nil {
+		t.Fatalf("no verification link in email to %s", to)
+	}
+	return m[1]
+}
+
+// signUp submits the sign up form, returning the response.
+func (s *session) signUp(t *testing.T, username string, email string) *httptest.ResponseRecorder {
+	return s.submit(t, signUpURL, url.Values{
+		"user._username":   {username},
+		"user._email":      {email},
+		"password":         {testPassword},
+		"confirm_password": {testPassword},
+		"accept":           {"true"},
+	})
+}
+
+func testEmailVerification(t *testing.T, u *usersTester) {
+	defer func(verify bool, expiry time.Duration) {
+		VerifyEmail = verify
+		EmailVerificationExpiry = expiry
+	}(VerifyEmail, EmailVerificationExpiry)
+	VerifyEmail = true
+	u.resetUsers(t)
+	const username = "verify"
+	const email = "verify@localhost"
+	s := u.session()
+	if w := s.signUp(t, username, email); w.Code != http.StatusFound || !s.signedIn() {
+		t.Fatalf("expecting a signed in redirect after signing up, got code %d", w.Code)
+	}
+	user := u.userNamed(t, username)
+	if user == nil || user.EmailVerified {
+		t.Fatalf("expecting an unverified user, got %+v", user)
+	}
+	link := u.verifyLink(t, email)
+	// Links don't require signing in, but they must not be altered
+	u.Get(strings.Replace(link, "p=", "p=a", 1), nil).Expect(200).Contains("Link is not valid")
+	if u.user(t, user.UserId).EmailVerified {
+		t.Fatal("email verified with an altered link")
+	}
+	u.Get(link, nil).Expect(200).Contains("has been verified")
+	if !u.user(t, user.UserId).EmailVerified {
+		t.Fatal("email not verified")
+	}
+	EmailVerificationExpiry = 0
+	u.Get(link, nil).Expect(200).Contains("Link has expired")
+}
+
+func testVerificationResend(t *testing.T, u *usersTester) {
+	defer func(require bool) {
+		RequireVerifiedEmail = require
+	}(RequireVerifiedEmail)
+	RequireVerifiedEmail = true
+	u.resetUsers(t)
+	const username = "resend"
+	const email = "resend@localhost"
+	s := u.session()
+	w := s.signUp(t, username, email)
+	if loc := w.Header().Get("Location"); w.Code != http.StatusFound || !strings.HasPrefix(loc, "/users/verify/?sent=") {
+		t.Fatalf("expecting redirect to the verification page, got code %d and location %q", w.Code, loc)
+	}
+	if s.signedIn() {
+		t.Fatal("signed in without verifying the email")
+	}
+	if c := len(u.mail.sent(email)); c != 1 {
+		t.Fatalf("expecting 1 verification email, got %d", c)
+	}
+	// Signing in again doesn't send another email until
+	// VerificationEmailInterval has passed
+	for ii := 0; ii < 3; ii++ {
+		if w := s.signInAs(t, username, testPassword); w.Code != http.StatusOK || s.signedIn() {
+			t.Fatalf("signed in without verifying the email, got code %d", w.Code)
+		}
+	}
+	if c := len(u.mail.sent(email)); c != 1 {
+		t.Fatalf("expecting 1 verification email before VerificationEmailInterval, got %d", c)
+	}
+	user := u.userNamed(t, username)
+	user.VerificationSent = time.Now().UTC().Add(-VerificationEmailInterval - time.Minute)
+	if _, err := u.orm(t).Save(user); err != nil {
+		t.Fatal(err)
+	}
+	s.signInAs(t, username, testPassword)
+	s.signInAs(t, username, testPassword)
+	if c := len(u.mail.sent(email)); c != 2 {
+		t.Fatalf("expecting 2 verification emails after VerificationEmailInterval, got %d", c)
+	}
+	u.Get(u.verifyLink(t, email), nil).Expect(200).Contains("has been verified")
+	if w := s.signInAs(t, username, testPassword); w.Code != http.StatusFound || !s.signedIn() {
+		t.Fatalf("not signed in after verifying the email, got code %d", w.Code)
+	}
+}
+
+func testChangeEmail(t *testing.T, u *usersTester) {
+	const changed = "changed@localhost"
+	const other = "other@localhost"
+	user := u.resetUsers(t)
+	u.Get(changeEmailURL, nil).Expect(302)
+	s := u.signedInSession(t)
+	sent := len(u.mail.sent(changed))
+	s.submit(t, changeEmailURL, url.Values{"email": {changed}, "password": {"wrong-password"}})
+	if len(u.mail.sent(changed)) != sent {
+		t.Fatal("email change requested with a wrong password")
+	}
+	// The address of another user can't be used
+	s.submit(t, changeEmailURL, url.Values{"email": {testEmail}, "password": {testPassword}})
+	if c := len(u.mail.sent(testEmail)); c != 0 {
+		t.Fatalf("email change requested for an address in use, got %d emails", c)
+	}
+	body := s.submit(t, changeEmailURL, url.Values{"email": {changed}, "password": {testPassword}}).Body.String()
+	if !strings.Contains(body, "sent an email to "+changed) {
+		t.Error("no confirmation after requesting an email change")
+	}
+	link := u.verifyLink(t, changed)
+	s.submit(t, changeEmailURL, url.Values{"email": {other}, "password": {testPassword}})
+	otherLink := u.verifyLink(t, other)
+	if email := u.user(t, user.UserId).Email; email != testEmail {
+		t.Fatalf("email changed to %q before confirming it", email)
+	}
+	u.Get(link, nil).Expect(200).Contains("has been changed to " + changed)
+	changedUser := u.user(t, user.UserId)
+	if changedUser.Email != changed || changedUser.NormalizedEmail != Normalize(changed) || !changedUser.EmailVerified {
+		t.Fatalf("email not changed, got %+v", changedUser)
+	}
+	// Links sent before the change are no longer valid
+	u.Get(otherLink, nil).Expect(200).Contains("Link is not valid")
+	if email := u.user(t, user.UserId).Email; email != changed {
+		t.Fatalf("email changed with an outdated link to %q", email)
+	}
+}
+
+func testDeleteAccount(t *testing.T, u *usersTester) {
+	user := u.resetUsers(t)
+	u.Get(deleteURL, nil).Expect(302)
+	s := u.signedInSession(t)
+	s.enroll(t)
+	s.submit(t, deleteURL, url.Values{"password": {testPassword}})
+	if u.user(t, user.UserId) == nil {
+		t.Fatal("account deleted without confirmation")
+	}
+	s.submit(t, deleteURL, url.Values{"password": {"wrong-password"}, "confirm": {"true"}})
+	if u.user(t, user.UserId) == nil {
+		t.Fatal("account deleted with a wrong password")
+	}
+	body := s.submit(t, deleteURL, url.Values{"password": {testPassword}, "confirm": {"true"}}).Body.String()
+	if !strings.Contains(body, "Your account has been deleted") {
+		t.Error("no confirmation after deleting the account")
+	}
+	if u.user(t, user.UserId) != nil {
+		t.Fatal("account not deleted")
+	}
+	if u.twoFactor(t, user.UserId) != nil {
+		t.Error("two-factor authentication not deleted")
+	}
+	if c := u.recoveryCodeCount(t, user.UserId); c != 0 {
+		t.Errorf("expecting no recovery codes, got %d", c)
+	}
+	if s.signedIn() {
+		t.Error("user still signed in after deleting their account")
+	}
+	if w := s.signIn(t, testPassword); w.Code != http.StatusOK || s.signedIn() {
+		t.Errorf("signed in as a deleted user, got code %d", w.Code)
+	}
+}
+
+func testSignInLockout(t *testing.T, u *usersTester) {
+	defer func(max int) {
+		MaxFailedSignIns = max
+	}(MaxFailedSignIns)
+	user := u.resetUsers(t)
+	s := u.session()
+	// Disabled by default
+	for ii := 0; ii < 10; ii++ {
+		s.signIn(t, "wrong-password")
+	}
+	if locked := u.user(t, user.UserId); !locked.LockedUntil.IsZero() || locked.FailedSignIns != 0 {
+		t.Fatalf("user locked with MaxFailedSignIns = 0, got %+v", locked)
+	}
+	if w := s.signIn(t, testPassword); w.Code != http.StatusFound || !s.signedIn() {
+		t.Fatalf("not signed in with lockout disabled, got code %d", w.Code)
+	}
+	MaxFailedSignIns = 3
+	s = u.session()
+	for ii := 0; ii < MaxFailedSignIns-1; ii++ {
+		s.signIn(t, "wrong-password")
+	}
+	if c := u.user(t, user.UserId).FailedSignIns; c != MaxFailedSignIns-1 {
+		t.Fatalf("expecting %d failed sign ins, got %d", MaxFailedSignIns-1, c)
+	}
+	// Signing in resets the failed attempts
+	if w := s.signIn(t, testPassword); w.Code != http.StatusFound || !s.signedIn() {
+		t.Fatalf("not signed in before reaching MaxFailedSignIns, got code %d", w.Code)
+	}
+	if c := u.user(t, user.UserId).FailedSignIns; c != 0 {
+		t.Fatalf("expecting failed sign ins to be reset, got %d", c)
+	}
+	s = u.session()
+	for ii := 0; ii < MaxFailedSignIns; ii++ {
+		s.signIn(t, "wrong-password")
+	}
+	locked := u.user(t, user.UserId)
+	if !locked.LockedUntil.After(time.Now()) {
+		t.Fatalf("expecting LockedUntil in the future, got %v", locked.LockedUntil)
+	}
+	if locked.FailedSignIns != 0 {
+		t.Errorf("expecting failed sign ins to be reset after locking, got %d", locked.FailedSignIns)
+	}
+	if w := s.signIn(t, testPassword); w.Code != http.StatusOK || s.signedIn() {
+		t.Fatalf("locked user signed in, got code %d", w.Code)
+	}
+	// Once LockedUntil has passed, the user can sign in again
+	locked.LockedUntil = time.Now().UTC().Add(-time.Minute)
+	if _, err := u.orm(t).Save(locked); err != nil {
+		t.Fatal(err)
+	}
+	if w := s.signIn(t, testPassword); w.Code != http.StatusFound || !s.signedIn() {
+		t.Fatalf("not signed in after the lockout expired, got code %d", w.Code)
+	}
+}
@@ -0,0 +1,476 @@
+package users
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/crypto/totp"
+	"gnd.la/form"
+	"gnd.la/i18n"
+	"gnd.la/orm"
+
+	"github.com/dchest/uniuri"
+)
+
+const (
+	twoFactorCookieName = "users-two-factor"
+	// recoveryCodeLength is the number of random characters in a
+	// recovery code, excluding the separator.
+	recoveryCodeLength = 10
+)
+
+var (
+	ErrInvalidCode = i18n.NewError("invalid code")
+
+	errTwoFactorExpired = errors.New("two-factor authentication expired")
+	recoveryCodeChars   = []byte("abcdefghjkmnpqrstuvwxyz23456789")
+	twoFactorType       = reflect.TypeOf(TwoFactor{})
+	recoveryCodeType    = reflect.TypeOf(RecoveryCode{})
+)
+
+// TwoFactor holds the two-factor authentication settings for
+// a user. Its secret is stored encrypted with the App encryption
+// key, so two-factor authentication requires setting one.
+type TwoFactor struct {
+	UserId int64 `orm:",primary_key"`
+	Secret string
+	// Enabled is false until the user confirms the secret by
+	// entering a valid code.
+	Enabled bool `orm:",default=false"`
+	// LastCounter is the TOTP counter of the last accepted code,
+	// codes with a lower or equal counter are rejected.
+	LastCounter int64
+	// FailedAttempts is the number of consecutive invalid codes,
+	// see MaxTwoFactorAttempts.
+	FailedAttempts int `orm:",default=0,notomitempty"`
+	Created        time.Time
+}
+
+// RecoveryCode is a single use code which can be entered instead of
+// a TOTP code (e.g. when the user has lost their device). Only an
+// HMAC-SHA256 of the code, keyed with the user's TOTP secret, is
+// stored and it's deleted once used.
+type RecoveryCode struct {
+	RecoveryCodeId int64  `orm:"id,primary_key,auto_increment"`
+	UserId         int64  `orm:",index"`
+	Hash           string `orm:",index"`
+}
+
+// RequireAdminTwoFactor is an app.Transformer which redirects signed in
+// administrators (see gnd.la/app.User.IsAdmin) without two-factor
+// authentication to TwoFactorEnrollHandler. It might be used either with
+// gnd.la/app.App.Transform or for wrapping individual handlers.
+func RequireAdminTwoFactor(handler app.Handler) app.Handler {
+	return func(ctx *app.Context) {
+		if user := ctx.User(); user != nil && user.IsAdmin() {
+			switch ctx.HandlerName() {
+			case TwoFactorEnrollHandlerName, SignOutHandlerName:
+			default:
+				tf, err := twoFactorFor(ctx, user.Id())
+				if err != nil {
+					panic(err)
+				}
+				if tf == nil || !tf.Enabled {
+					enroll := ctx.MustReverse(TwoFactorEnrollHandlerName)
+					from := ctx.URL().String()
+					ctx.Redirect(fmt.Sprintf("%s?%s=%s", enroll, app.SignInFromParameterName, url.QueryEscape(from)), false)
+					return
+				}
+			}
+		}
+		handler(ctx)
+	}
+}
+
+func twoFactorFor(ctx *app.Context, userId int64) (*TwoFactor, error) {
+	var tf *TwoFactor
+	ok, err := ctx.Orm().One(orm.Eq("UserId", userId), &tf)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return tf, nil
+}
+
+func (t *TwoFactor) additionalData() []byte {
+	return []byte(strconv.FormatInt(t.UserId, 36))
+}
+
+// key decrypts the TOTP secret. If it was encrypted with an old key,
+// it's encrypted again with the current one.
+func (t *TwoFactor) key(ctx *app.Context) (*totp.Key, error) {
+	ae, err := ctx.App().AEADEncrypter(Salt)
+	if err != nil {
+		return nil, err
+	}
+	secret, keys, err := ae.DecryptString(t.Secret, t.additionalData())
+	if err != nil {
+		return nil, err
+	}
+	if !keys.Current() {
+		if err := t.setKey(ctx, secret); err != nil {
+			return nil, err
+		}
+		if _, err := ctx.Orm().Save(t); err != nil {
+			return nil, err
+		}
+	}
+	return &totp.Key{Secret: secret, Skew: totp.DefaultSkew}, nil
+}
+
+func (t *TwoFactor) setKey(ctx *app.Context, secret []byte) error {
+	ae, err := ctx.App().AEADEncrypter(Salt)
+	if err != nil {
+		return err
+	}
+	enc, err := ae.EncryptString(secret, t.additionalData())
+	if err != nil {
+		return err
+	}
+	t.Secret = enc
+	return nil
+}
+
+// verify checks the given TOTP or recovery code, updating the
+// stored state so the code can't be used again.
+func (t *TwoFactor) verify(ctx *app.Context, code string) (bool, error) {
+	key, err := t.key(ctx)
+	if err != nil {
+		return false, err
+	}
+	if counter, ok := key.Validate(code, time.Now()); ok {
+		if int64(counter) <= t.LastCounter {
+			return false, nil
+		}
+		t.LastCounter = int64(counter)
+		if _, err := ctx.Orm().Save(t); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return useRecoveryCode(ctx, t.UserId, key, code)
+}
+
+// recoveryCodeHash returns the hash stored for the given recovery
+// code. It's keyed with the TOTP secret, so codes can't be recovered
+// from the hashes without also decrypting the secret.
+func recoveryCodeHash(key *totp.Key, code string) string {
+	code = strings.Replace(strings.Replace(code, "-", "", -1), " ", "", -1)
+	h := hmac.New(sha256.New, key.Secret)
+	h.Write([]byte(strings.ToLower(code)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// failedAttempt increments the failed attempts for the given user,
+// locking them out once they reach MaxTwoFactorAttempts. Failed
+// codes are counted separately from failed passwords, so neither
+// limit is reached earlier because of the other one.
+func (t *TwoFactor) failedAttempt(ctx *app.Context, user reflect.Value) {
+	if MaxTwoFactorAttempts <= 0 {
+		return
+	}
+	t.FailedAttempts++
+	locked := t.FailedAttempts >= MaxTwoFactorAttempts
+	if locked {
+		t.FailedAttempts = 0
+	}
+	if _, err := ctx.Orm().Save(t); err != nil {
+		ctx.Logger().Errorf("error saving failed two-factor attempt: %s", err)
+		return
+	}
+	if locked {
+		lockOut(ctx, user)
+	}
+}
+
+// resetFailedAttempts clears the failed attempts once
+// the user has entered a valid code.
+func (t *TwoFactor) resetFailedAttempts(ctx *app.Context) {
+	if t.FailedAttempts == 0 {
+		return
+	}
+	t.FailedAttempts = 0
+	if _, err := ctx.Orm().Save(t); err != nil {
+		ctx.Logger().Errorf("error resetting failed two-factor attempts: %s", err)
+	}
+}
+
+func useRecoveryCode(ctx *app.Context, userId int64, key *totp.Key, code string) (bool, error) {
+	var rc *RecoveryCode
+	o := ctx.Orm()
+	ok, err := o.One(orm.And(orm.Eq("UserId", userId), orm.Eq("Hash", recoveryCodeHash(key, code))), &rc)
+	if err != nil || !ok {
+		return false, err
+	}
+	if err := o.Delete(rc); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// newRecoveryCodes replaces the recovery codes for the given user
+// with RecoveryCodes new ones, returning them. This is the only time
+// the codes are available in plaintext.
+func newRecoveryCodes(ctx *app.Context, t *TwoFactor) ([]string, error) {
+	key, err := t.key(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteRecoveryCodes(ctx, t.UserId); err != nil {
+		return nil, err
+	}
+	o := ctx.Orm()
+	codes := make([]string, RecoveryCodes)
+	for ii := range codes {
+		code := uniuri.NewLenChars(recoveryCodeLength, recoveryCodeChars)
+		codes[ii] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+		if _, err := o.Insert(&RecoveryCode{UserId: t.UserId, Hash: recoveryCodeHash(key, code)}); err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
+
+func deleteRecoveryCodes(ctx *app.Context, userId int64) error {
+	o := ctx.Orm()
+	_, err := o.DeleteFrom(o.TypeTable(recoveryCodeType), orm.Eq("UserId", userId))
+	return err
+}
+
+// signInOrVerify signs in the given user, unless they have
+// two-factor authentication enabled. In that case, the user is
+// stored as pending verification and false is returned. Callers
+// should then redirect to TwoFactorHandler (see twoFactorURL).
+func signInOrVerify(ctx *app.Context, user reflect.Value) bool {
+	u := asGondolaUser(user)
+	tf, err := twoFactorFor(ctx, u.Id())
+	if err != nil {
+		panic(err)
+	}
+	if tf == nil || !tf.Enabled {
+		ctx.MustSignIn(u)
+		resetFailedSignIns(ctx, user)
+		return true
+	}
+	values := make(url.Values)
+	values.Add("u", strconv.FormatInt(u.Id(), 36))
+	values.Add("t", strconv.FormatInt(time.Now().Unix(), 36))
+	if err := ctx.Cookies().SetSecure(twoFactorCookieName, values.Encode()); err != nil {
+		panic(err)
+	}
+	return false
+}
+
+// pendingTwoFactorUser returns the user which signed in with
+// a password and still needs to enter the second factor. Users
+// locked out after too many failed attempts are rejected, which
+// invalidates any pending verification for them.
+func pendingTwoFactorUser(ctx *app.Context) (reflect.Value, error) {
+	var payload string
+	if err := ctx.Cookies().GetSecure(twoFactorCookieName, &payload); err != nil {
+		return reflect.Value{}, err
+	}
+	qs, err := url.ParseQuery(payload)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	userId, err := strconv.ParseInt(qs.Get("u"), 36, 64)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	ts, err := strconv.ParseInt(qs.Get("t"), 36, 64)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if time.Since(time.Unix(ts, 0)) > TwoFactorTimeout {
+		return reflect.Value{}, errTwoFactorExpired
+	}
+	user, userVal := newEmptyUser()
+	if !ctx.Orm().MustOne(ById(userId), userVal) {
+		return reflect.Value{}, errNoSuchUser
+	}
+	if isLocked(user) {
+		return reflect.Value{}, ErrAccountLocked
+	}
+	return user, nil
+}
+
+// twoFactorURL returns the URL for TwoFactorHandler, preserving
+// the page the user should be redirected to after signing in.
+func twoFactorURL(ctx *app.Context, from string) string {
+	u := ctx.MustReverse(TwoFactorHandlerName)
+	if from != "" {
+		u += fmt.Sprintf("?%s=%s", app.SignInFromParameterName, url.QueryEscape(from))
+	}
+	return u
+}
+
+// writeTwoFactorRedirect tells the JS side to continue the sign in
+// process at TwoFactorHandler.
+func writeTwoFactorRedirect(ctx *app.Context, from string) {
+	ctx.WriteJSON(map[string]interface{}{
+		"redirect": twoFactorURL(ctx, from),
+	})
+}
+
+func twoFactorHandler(ctx *app.Context) {
+	user, err := pendingTwoFactorUser(ctx)
+	if err != nil {
+		ctx.Cookies().Delete(twoFactorCookieName)
+		ctx.MustRedirectReverse(false, SignInHandlerName)
+		return
+	}
+	uid := asGondolaUser(user).Id()
+	tf, err := twoFactorFor(ctx, uid)
+	if err != nil {
+		panic(err)
+	}
+	if tf == nil || !tf.Enabled {
+		// Disabled since the password was entered
+		ctx.Cookies().Delete(twoFactorCookieName)
+		ctx.MustRedirectReverse(false, SignInHandlerName)
+		return
+	}
+	var fields struct {
+		Code         string `form:",singleline,label=Authentication or recovery code"`
+		ValidateCode func(*app.Context) error
+	}
+	fields.ValidateCode = func(c *app.Context) error {
+		ok, err := tf.verify(c, fields.Code)
+		if err != nil {
+			panic(err)
+		}
+		if !ok {
+			tf.failedAttempt(c, user)
+			if isLocked(user) {
+				c.Cookies().Delete(twoFactorCookieName)
+				return ErrAccountLocked
+			}
+			return ErrInvalidCode
+		}
+		return nil
+	}
+	f := form.New(ctx, &fields)
+	if f.Submitted() && f.IsValid() {
+		ctx.Cookies().Delete(twoFactorCookieName)
+		ctx.MustSignIn(asGondolaUser(user))
+		tf.resetFailedAttempts(ctx)
+		resetFailedSignIns(ctx, user)
+		ctx.RedirectBack()
+		return
+	}
+	data := map[string]interface{}{
+		"TwoFactorForm": f,
+		"From":          ctx.FormValue(app.SignInFromParameterName),
+	}
+	ctx.MustExecute(TwoFactorTemplateName, data)
+}
+
+func twoFactorEnrollHandler(ctx *app.Context) {
+	user := ctx.User()
+	tf, err := twoFactorFor(ctx, user.Id())
+	if err != nil {
+		panic(err)
+	}
+	if tf == nil {
+		tf = &TwoFactor{UserId: user.Id(), Created: time.Now().UTC()}
+	}
+	enabled := tf.Enabled
+	var key *totp.Key
+	if tf.Secret != "" {
+		if key, err = tf.key(ctx); err != nil {
+			panic(err)
+		}
+	} else {
+		key = totp.NewKey()
+		if err := tf.setKey(ctx, key.Secret); err != nil {
+			panic(err)
+		}
+		ctx.Orm().MustSave(tf)
+	}
+	var fields struct {
+		Code         string `form:",singleline,label=Authentication code"`
+		ValidateCode func(*app.Context) error
+	}
+	fields.ValidateCode = func(c *app.Context) error {
+		var ok bool
+		if enabled {
+			ok, err = tf.verify(c, fields.Code)
+			if err != nil {
+				panic(err)
+			}
+		} else {
+			var counter uint64
+			if counter, ok = key.Validate(fields.Code, time.Now()); ok {
+				tf.LastCounter = int64(counter)
+			}
+		}
+		if !ok {
+			return ErrInvalidCode
+		}
+		return nil
+	}
+	f := form.New(ctx, &fields)
+	var codes []string
+	var disabled bool
+	if f.Submitted() && f.IsValid() {
+		o := ctx.Orm()
+		switch {
+		case !enabled:
+			tf.Enabled = true
+			o.MustSave(tf)
+			enabled = true
+			codes, err = newRecoveryCodes(ctx, tf)
+		case ctx.FormValue("disable") != "":
+			if err = o.Delete(tf); err == nil {
+				err = deleteRecoveryCodes(ctx, tf.UserId)
+			}
+			enabled = false
+			disabled = true
+		default:
+			codes, err = newRecoveryCodes(ctx, tf)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+	// otpauth URIs are not considered safe by html/template,
+	// which would replace them with #ZgotmplZ.
+	var uri template.URL
+	if !enabled && !disabled {
+		issuer := TwoFactorIssuer
+		if issuer == "" {
+			issuer = SiteName
+		}
+		account := getUserValue(reflect.ValueOf(user), "Username").(string)
+		uri = template.URL(key.URI(issuer, account))
+	}
+	data := map[string]interface{}{
+		"TwoFactorForm": f,
+		"Enabled":       enabled,
+		"Disabled":      disabled,
+		"URI":           uri,
+		"Secret":        key.EncodedSecret(),
+		"RecoveryCodes": codes,
+		"From":          ctx.FormValue(app.SignInFromParameterName),
+	}
+	ctx.MustExecute(TwoFactorEnrollTemplateName, data)
+}
+
+func init() {
+	orm.Register(twoFactorType, &orm.Options{
+		Table: "gondola_users_two_factor",
+	})
+	orm.Register(recoveryCodeType, &orm.Options{
+		Table: "gondola_users_recovery_codes",
+	})
+}
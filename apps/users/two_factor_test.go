@@ -0,0 +1,276 @@
+package users
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/crypto/totp"
+	"gnd.la/orm"
+)
+
+const (
+	enrollURL = "/users/two-factor/"
+	verifyURL = "/users/sign-in/verify/"
+)
+
+var (
+	secretRe       = regexp.MustCompile(`<p><code>([A-Z2-7=]+)</code></p>`)
+	recoveryCodeRe = regexp.MustCompile(`<li><code>([^<]+)</code></li>`)
+)
+
+// invalidCode returns a code which is not accepted by the given key
+// at the current time.
+func invalidCode(key *totp.Key) string {
+	return key.Code(time.Now().Add(-time.Hour))
+}
+
+func (u *usersTester) twoFactor(t *testing.T, userId int64) *TwoFactor {
+	var tf TwoFactor
+	ok, err := u.orm(t).One(orm.Eq("UserId", userId), &tf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		return nil
+	}
+	return &tf
+}
+
+func (u *usersTester) recoveryCodeCount(t *testing.T, userId int64) int {
+	var codes []*RecoveryCode
+	if err := u.orm(t).Query(orm.Eq("UserId", userId)).All(&codes); err != nil {
+		t.Fatal(err)
+	}
+	return len(codes)
+}
+
+// allowCodeReuse clears the last accepted TOTP counter, so the
+// code for the current time can be entered again.
+func (u *usersTester) allowCodeReuse(t *testing.T, userId int64) {
+	tf := u.twoFactor(t, userId)
+	tf.LastCounter = 0
+	if _, err := u.orm(t).Save(tf); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// signedInSession returns a session signed in with the password,
+// which must not require a second factor.
+func (u *usersTester) signedInSession(t *testing.T) *session {
+	s := u.session()
+	if w := s.signIn(t, testPassword); w.Code != http.StatusFound || !s.signedIn() {
+		t.Fatalf("expecting a signed in redirect, got code %d and cookies %v", w.Code, s.cookies)
+	}
+	return s
+}
+
+// enroll enables two-factor authentication for the signed in user,
+// returning the key and the recovery codes.
+func (s *session) enroll(t *testing.T) (*totp.Key, []string) {
+	m := secretRe.FindStringSubmatch(s.serve(t, enrollURL, nil).Body.String())
+	if m == nil {
+		t.Fatal("no secret in enrollment page")
+	}
+	secret, err := totp.DecodeSecret(m[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &totp.Key{Secret: secret, Skew: totp.DefaultSkew}
+	body := s.submit(t, enrollURL, url.Values{"code": {key.Code(time.Now())}}).Body.String()
+	var codes []string
+	for _, v := range recoveryCodeRe.FindAllStringSubmatch(body, -1) {
+		codes = append(codes, v[1])
+	}
+	return key, codes
+}
+
+// enrolledUser resets the users and enables two-factor
+// authentication for the test user.
+func (u *usersTester) enrolledUser(t *testing.T) (*testUser, *totp.Key, []string) {
+	user := u.resetUsers(t)
+	key, codes := u.signedInSession(t).enroll(t)
+	if len(codes) != RecoveryCodes {
+		t.Fatalf("expecting %d recovery codes, got %d", RecoveryCodes, len(codes))
+	}
+	u.allowCodeReuse(t, user.UserId)
+	return user, key, codes
+}
+
+// signInWithPassword enters the password, which must redirect to
+// the two-factor verification.
+func (s *session) signInWithPassword(t *testing.T) {
+	w := s.signIn(t, testPassword)
+	if loc := w.Header().Get("Location"); w.Code != http.StatusFound || !strings.HasPrefix(loc, verifyURL) {
+		t.Fatalf("expecting redirect to %s, got code %d and location %q", verifyURL, w.Code, loc)
+	}
+	if s.signedIn() {
+		t.Fatal("user signed in without the second factor")
+	}
+}
+
+func testTwoFactorEnroll(t *testing.T, u *usersTester) {
+	user := u.resetUsers(t)
+	u.Get(enrollURL, nil).Expect(302)
+	s := u.signedInSession(t)
+	m := secretRe.FindStringSubmatch(s.serve(t, enrollURL, nil).Body.String())
+	if m == nil {
+		t.Fatal("no secret in enrollment page")
+	}
+	tf := u.twoFactor(t, user.UserId)
+	if tf == nil || tf.Enabled {
+		t.Fatalf("expecting disabled two-factor authentication, got %+v", tf)
+	}
+	if strings.Contains(tf.Secret, m[1]) {
+		t.Error("secret stored in plaintext")
+	}
+	secret, err := totp.DecodeSecret(m[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &totp.Key{Secret: secret, Skew: totp.DefaultSkew}
+	// Reloading the page keeps the same secret
+	s.get(enrollURL).Expect(200).Contains(m[1]).Contains(`data-uri="otpauth://totp/`)
+	body := s.submit(t, enrollURL, url.Values{"code": {invalidCode(key)}}).Body.String()
+	if recoveryCodeRe.MatchString(body) || u.twoFactor(t, user.UserId).Enabled {
+		t.Fatal("two-factor authentication enabled with an invalid code")
+	}
+	body = s.submit(t, enrollURL, url.Values{"code": {key.Code(time.Now())}}).Body.String()
+	if codes := recoveryCodeRe.FindAllString(body, -1); len(codes) != RecoveryCodes {
+		t.Errorf("expecting %d recovery codes, got %d", RecoveryCodes, len(codes))
+	}
+	if !u.twoFactor(t, user.UserId).Enabled {
+		t.Fatal("two-factor authentication not enabled")
+	}
+	if c := u.recoveryCodeCount(t, user.UserId); c != RecoveryCodes {
+		t.Errorf("expecting %d stored recovery codes, got %d", RecoveryCodes, c)
+	}
+	s.get(enrollURL).Expect(200).Contains("Two-factor authentication is enabled")
+}
+
+func testTwoFactorSignIn(t *testing.T, u *usersTester) {
+	_, key, _ := u.enrolledUser(t)
+	// The verification requires entering the password first
+	u.Get(verifyURL, nil).Expect(302).MatchHeader("Location", "^/users/sign-in/")
+	s := u.session()
+	s.signInWithPassword(t)
+	s.get(verifyURL).Expect(200).Contains(`name="code"`)
+	code := key.Code(time.Now())
+	w := s.submit(t, verifyURL, url.Values{"code": {code}})
+	if w.Code != http.StatusFound || !s.signedIn() {
+		t.Fatalf("expecting a signed in redirect, got code %d", w.Code)
+	}
+	if _, ok := s.cookies[twoFactorCookieName]; ok {
+		t.Error("pending verification not cleared after signing in")
+	}
+	// Codes can't be replayed
+	s = u.session()
+	s.signInWithPassword(t)
+	s.submit(t, verifyURL, url.Values{"code": {code}})
+	if s.signedIn() {
+		t.Error("signed in by replaying a code")
+	}
+	s.submit(t, verifyURL, url.Values{"code": {invalidCode(key)}})
+	if s.signedIn() {
+		t.Error("signed in with an invalid code")
+	}
+}
+
+func testRecoveryCodes(t *testing.T, u *usersTester) {
+	user, key, codes := u.enrolledUser(t)
+	s := u.session()
+	s.signInWithPassword(t)
+	s.submit(t, verifyURL, url.Values{"code": {codes[0]}})
+	if !s.signedIn() {
+		t.Fatal("not signed in with a recovery code")
+	}
+	if c := u.recoveryCodeCount(t, user.UserId); c != RecoveryCodes-1 {
+		t.Errorf("expecting %d recovery codes after using one, got %d", RecoveryCodes-1, c)
+	}
+	s = u.session()
+	s.signInWithPassword(t)
+	s.submit(t, verifyURL, url.Values{"code": {codes[0]}})
+	if s.signedIn() {
+		t.Fatal("recovery code used twice")
+	}
+	// Codes are accepted regardless of case and separators
+	s.submit(t, verifyURL, url.Values{"code": {strings.ToUpper(strings.Replace(codes[1], "-", "", -1))}})
+	if !s.signedIn() {
+		t.Fatal("not signed in with a recovery code without separator")
+	}
+	// Generating new codes invalidates the previous ones
+	s.submit(t, enrollURL, url.Values{"code": {key.Code(time.Now())}})
+	if c := u.recoveryCodeCount(t, user.UserId); c != RecoveryCodes {
+		t.Errorf("expecting %d new recovery codes, got %d", RecoveryCodes, c)
+	}
+	s = u.session()
+	s.signInWithPassword(t)
+	s.submit(t, verifyURL, url.Values{"code": {codes[2]}})
+	if s.signedIn() {
+		t.Error("signed in with a replaced recovery code")
+	}
+}
+
+func testTwoFactorLockout(t *testing.T, u *usersTester) {
+	defer func(max int) {
+		MaxFailedSignIns = max
+	}(MaxFailedSignIns)
+	MaxFailedSignIns = 3
+	user, key, _ := u.enrolledUser(t)
+	s := u.session()
+	for ii := 0; ii < MaxFailedSignIns-1; ii++ {
+		if w := s.signIn(t, "wrong-password"); w.Code != http.StatusOK || s.signedIn() {
+			t.Fatal("wrong password accepted")
+		}
+	}
+	s.signInWithPassword(t)
+	// Failed codes are counted separately from failed passwords
+	for ii := 0; ii < MaxTwoFactorAttempts-1; ii++ {
+		s.submit(t, verifyURL, url.Values{"code": {invalidCode(key)}})
+		if s.signedIn() {
+			t.Fatal("signed in with an invalid code")
+		}
+	}
+	locked := u.user(t, user.UserId)
+	if !locked.LockedUntil.IsZero() {
+		t.Fatal("user locked before reaching MaxTwoFactorAttempts")
+	}
+	if locked.FailedSignIns != MaxFailedSignIns-1 {
+		t.Errorf("expecting %d failed sign ins, got %d", MaxFailedSignIns-1, locked.FailedSignIns)
+	}
+	if tf := u.twoFactor(t, user.UserId); tf.FailedAttempts != MaxTwoFactorAttempts-1 {
+		t.Errorf("expecting %d failed two-factor attempts, got %d", MaxTwoFactorAttempts-1, tf.FailedAttempts)
+	}
+	s.submit(t, verifyURL, url.Values{"code": {invalidCode(key)}})
+	if locked = u.user(t, user.UserId); !locked.LockedUntil.After(time.Now()) {
+		t.Fatalf("expecting LockedUntil in the future, got %v", locked.LockedUntil)
+	}
+	if tf := u.twoFactor(t, user.UserId); tf.FailedAttempts != 0 {
+		t.Errorf("expecting failed two-factor attempts to be reset after locking, got %d", tf.FailedAttempts)
+	}
+	// The pending verification is invalidated and even
+	// the right password is rejected
+	if _, ok := s.cookies[twoFactorCookieName]; ok {
+		t.Error("pending verification not cleared after locking the user")
+	}
+	s.get(verifyURL).Expect(302)
+	if w := s.signIn(t, testPassword); w.Code != http.StatusOK || s.signedIn() {
+		t.Fatalf("locked user accepted, got code %d", w.Code)
+	}
+	// Once the lockout expires, the user can sign in again
+	locked.LockedUntil = time.Now().UTC().Add(-time.Minute)
+	if _, err := u.orm(t).Save(locked); err != nil {
+		t.Fatal(err)
+	}
+	s.signInWithPassword(t)
+	s.submit(t, verifyURL, url.Values{"code": {key.Code(time.Now())}})
+	if !s.signedIn() {
+		t.Fatal("user not signed in after the lockout expired")
+	}
+	if c := u.user(t, user.UserId).FailedSignIns; c != 0 {
+		t.Errorf("expecting failed sign ins to be reset, got %d", c)
+	}
+}
@@ -89,6 +89,10 @@ type User struct {
 	Admin              bool              `form:"-" orm:",default=false" json:"admin"`
 	Image              string            `form:"-" orm:",omitempty,nullempty" json:"-"`
 	ImageFormat        string            `form:"-" orm:",omitempty,nullempty" json:"-"`
+	EmailVerified      bool              `form:"-" orm:",default=false" json:"-"`
+	VerificationSent   time.Time         `form:"-" orm:",omitempty,nullempty" json:"-"`
+	FailedSignIns      int               `form:"-" orm:",default=0,notomitempty" json:"-"`
+	LockedUntil        time.Time         `form:"-" orm:",omitempty,nullempty" json:"-"`
 }
 
 func (u *User) Id() int64 {
@@ -118,21 +122,30 @@ func (u *User) ValidateUsername(ctx *app.Context) error {
 }
 
 func (u *User) ValidateEmail(ctx *app.Context) error {
-	addr, err := mail.Validate(u.Email, true)
+	addr, err := validateNewEmail(ctx, u.Email)
 	if err != nil {
-		return i18n.Errorf("this does not look like a valid email address")
+		return err
 	}
 	u.Email = addr
-	norm := Normalize(u.Email)
+	return nil
+}
+
+// validateNewEmail checks that the given email address is valid
+// and not in use by any user, returning it cleaned up.
+func validateNewEmail(ctx *app.Context, email string) (string, error) {
+	addr, err := mail.Validate(email, true)
+	if err != nil {
+		return "", i18n.Errorf("this does not look like a valid email address")
+	}
 	_, userVal := newEmptyUser()
-	found, err := ctx.Orm().One(orm.Eq("User.NormalizedEmail", norm), userVal)
+	found, err := ctx.Orm().One(ByEmail(addr), userVal)
 	if err != nil {
 		panic(err)
 	}
 	if found {
-		return i18n.Errorf("email %q is already in use", u.Email)
+		return "", i18n.Errorf("email %q is already in use", addr)
 	}
-	return nil
+	return addr, nil
 }
 
 func setUserValue(v reflect.Value, key string, value interface{}) {
@@ -0,0 +1,349 @@
+package users
+
+import (
+	"html"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+	"gnd.la/config"
+	"gnd.la/crypto/password"
+	"gnd.la/net/mail"
+	"gnd.la/orm"
+	_ "gnd.la/orm/driver/sqlite"
+	"gnd.la/template/assets"
+	"gnd.la/util/stringutil"
+
+	"gopkgs.com/vfs.v1"
+)
+
+const (
+	testUsername = "gondola"
+	testEmail    = "gondola@localhost"
+	testPassword = "gondola-password"
+)
+
+var (
+	inputRe = regexp.MustCompile(`<input[^>]*>`)
+	nameRe  = regexp.MustCompile(`name="([^"]*)"`)
+	valueRe = regexp.MustCompile(`value="([^"]*)"`)
+)
+
+type testUser struct {
+	User
+}
+
+type usersTester struct {
+	*tester.Tester
+	mail *mailServer
+}
+
+// mailServer is a minimal SMTP server which
+// stores the messages it receives.
+type mailServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	messages map[string][]string
+}
+
+func newMailServer(t *testing.T) *mailServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mailServer{listener: l, messages: make(map[string][]string)}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go m.serve(conn)
+		}
+	}()
+	return m
+}
+
+func (m *mailServer) serve(conn net.Conn) {
+	c := textproto.NewConn(conn)
+	defer c.Close()
+	c.PrintfLine("220 localhost")
+	var to []string
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "RCPT":
+			addr := line[strings.Index(line, "<")+1 : strings.LastIndex(line, ">")]
+			to = append(to, addr)
+		case "DATA":
+			c.PrintfLine("354 go ahead")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			m.mu.Lock()
+			for _, v := range to {
+				m.messages[v] = append(m.messages[v], string(data))
+			}
+			m.mu.Unlock()
+			to = nil
+		case "QUIT":
+			c.PrintfLine("221 bye")
+			return
+		}
+		c.PrintfLine("250 localhost")
+	}
+}
+
+// sent returns the messages received for the given address.
+func (m *mailServer) sent(to string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.messages[to]
+}
+
+func (m *mailServer) Close() error {
+	return m.listener.Close()
+}
+
+// session performs requests on behalf of a browser, keeping
+// the cookies set by the responses.
+type session struct {
+	u       *usersTester
+	cookies map[string]string
+}
+
+func (u *usersTester) session() *session {
+	return &session{u: u, cookies: make(map[string]string)}
+}
+
+func (s *session) cookie() string {
+	var cookies []string
+	for k, v := range s.cookies {
+		cookies = append(cookies, k+"="+v)
+	}
+	return strings.Join(cookies, "; ")
+}
+
+func (s *session) signedIn() bool {
+	_, ok := s.cookies[app.USER_COOKIE_NAME]
+	return ok
+}
+
+// serve performs a request with the session cookies, without
+// the tester, and stores the cookies set by the response. If
+// values is non nil, the request is a form POST.
+func (s *session) serve(t *testing.T, path string, values url.Values) *httptest.ResponseRecorder {
+	method := "GET"
+	var body string
+	if values != nil {
+		method = "POST"
+		body = values.Encode()
+	}
+	req, err := http.NewRequest(method, path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if c := s.cookie(); c != "" {
+		req.Header.Set("Cookie", c)
+	}
+	w := httptest.NewRecorder()
+	s.u.App.ServeHTTP(w, req)
+	for _, v := range (&http.Response{Header: w.Header()}).Cookies() {
+		if v.MaxAge < 0 || (!v.Expires.IsZero() && v.Expires.Before(time.Now())) {
+			delete(s.cookies, v.Name)
+		} else {
+			s.cookies[v.Name] = v.Value
+		}
+	}
+	return w
+}
+
+func (s *session) get(path string) *tester.Request {
+	req := s.u.Get(path, nil)
+	if c := s.cookie(); c != "" {
+		req.AddHeader("Cookie", c)
+	}
+	return req
+}
+
+// hiddenValues returns the hidden inputs in the page at the given path,
+// which include the CSRF tokens for its form.
+func (s *session) hiddenValues(t *testing.T, path string) url.Values {
+	w := s.serve(t, path, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expecting code 200 from %s, got %d", path, w.Code)
+	}
+	values := make(url.Values)
+	for _, v := range inputRe.FindAllString(w.Body.String(), -1) {
+		if !strings.Contains(v, `type="hidden"`) {
+			continue
+		}
+		name := nameRe.FindStringSubmatch(v)
+		value := valueRe.FindStringSubmatch(v)
+		if name != nil && value != nil {
+			values.Add(name[1], html.UnescapeString(value[1]))
+		}
+	}
+	if len(values) == 0 {
+		t.Fatalf("no hidden values in %s", path)
+	}
+	return values
+}
+
+// submit posts the form at the given path with the given
+// values, in addition to its hidden ones.
+func (s *session) submit(t *testing.T, path string, values url.Values) *httptest.ResponseRecorder {
+	form := s.hiddenValues(t, path)
+	for k, v := range values {
+		form[k] = v
+	}
+	return s.serve(t, path, form)
+}
+
+// signIn submits the sign in form for the test user with the
+// given password, returning the response.
+func (s *session) signIn(t *testing.T, pw string) *httptest.ResponseRecorder {
+	return s.signInAs(t, testUsername, pw)
+}
+
+func (s *session) signInAs(t *testing.T, username string, pw string) *httptest.ResponseRecorder {
+	return s.submit(t, "/users/sign-in/", url.Values{
+		"username": {username},
+		"password": {pw},
+	})
+}
+
+func (u *usersTester) orm(t *testing.T) *app.Orm {
+	o, err := u.App.Orm()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
+
+// resetUsers removes all the users and their two-factor data,
+// then creates a new user with testUsername and testPassword.
+func (u *usersTester) resetUsers(t *testing.T) *testUser {
+	o := u.orm(t)
+	for _, v := range []interface{}{testUser{}, TwoFactor{}, RecoveryCode{}} {
+		if _, err := o.DeleteFrom(o.TypeTable(reflect.TypeOf(v)), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	user := &testUser{}
+	user.Username = testUsername
+	user.NormalizedUsername = Normalize(testUsername)
+	user.Email = testEmail
+	user.NormalizedEmail = Normalize(testEmail)
+	user.Password = password.NewOptions(testPassword, PasswordOptions)
+	user.Created = time.Now().UTC()
+	if _, err := o.Insert(user); err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+// user returns the user with the given id, or nil if
+// there's no such user.
+func (u *usersTester) user(t *testing.T, id int64) *testUser {
+	var user testUser
+	ok, err := u.orm(t).One(ById(id), &user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		return nil
+	}
+	return &user
+}
+
+// userNamed returns the user with the given username, or nil
+// if there's no such user.
+func (u *usersTester) userNamed(t *testing.T, username string) *testUser {
+	var user testUser
+	ok, err := u.orm(t).One(ByUsername(username), &user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		return nil
+	}
+	return &user
+}
+
+var usersTests = []func(*testing.T, *usersTester){
+	testTwoFactorEnroll,
+	testTwoFactorSignIn,
+	testRecoveryCodes,
+	testTwoFactorLockout,
+	testEmailVerification,
+	testVerificationResend,
+	testChangeEmail,
+	testDeleteAccount,
+	testSignInLockout,
+}
+
+func TestUsers(t *testing.T) {
+	f, err := ioutil.TempFile("", "users-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	// Keep password hashing fast
+	defer func(opts *password.Options) {
+		PasswordOptions = opts
+	}(PasswordOptions)
+	PasswordOptions = &password.Options{Algorithm: password.PBKDF2, Hash: password.SHA256, Rounds: 1000}
+	ms := newMailServer(t)
+	defer ms.Close()
+	defer func(server string, from string) {
+		mail.Config.MailServer = server
+		mail.Config.DefaultFrom = from
+	}(mail.Config.MailServer, mail.Config.DefaultFrom)
+	mail.Config.MailServer = ms.listener.Addr().String()
+	mail.Config.DefaultFrom = "no-reply@localhost"
+	a := app.New()
+	a.Config().Secret = stringutil.Random(32)
+	a.Config().EncryptionKey = stringutil.Random(32)
+	a.Config().Database = config.MustParseURL("sqlite://" + f.Name())
+	fs := vfs.Memory()
+	if err := vfs.WriteFile(fs, "base.html", []byte("<html><body>{{ app }}</body></html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	a.SetTemplatesFS(fs)
+	// Assets from the users app are imported into the parent
+	a.SetAssetsManager(assets.New(vfs.Memory(), "/assets/"))
+	a.Include("/users/", App, "base.html")
+	// UserFunc must be set after including the users app
+	a.SetUserFunc(Func)
+	ut := &usersTester{Tester: tester.New(t, a), mail: ms}
+	for _, v := range usersTests {
+		v(t, ut)
+	}
+}
+
+func init() {
+	orm.Register(testUser{}, &orm.Options{Table: "users"})
+	SetType(&testUser{})
+}
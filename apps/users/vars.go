@@ -1,6 +1,9 @@
 package users
 
 import (
+	"time"
+
+	"gnd.la/crypto/password"
 	"gnd.la/social/facebook"
 	"gnd.la/social/github"
 	"gnd.la/social/google"
@@ -23,6 +26,51 @@ var (
 	// AllowRegistration can be used to disable user registration. Only existing users
 	// and social accounts will be able to log in.
 	AllowRegistration = true
+	// PasswordOptions are the options used for encoding new passwords. Stored
+	// passwords which were encoded with different options are transparently
+	// encoded again when the user signs in. If nil, the defaults from
+	// gnd.la/crypto/password are used.
+	PasswordOptions *password.Options
+	// TwoFactorIssuer is the issuer included in the two-factor
+	// authentication provisioning URIs, displayed by authenticator
+	// apps. If empty, SiteName is used.
+	TwoFactorIssuer = ""
+	// TwoFactorTimeout is the maximum time users have to enter their
+	// second factor after entering their password.
+	TwoFactorTimeout = 5 * time.Minute
+	// RecoveryCodes is the number of recovery codes generated when
+	// enabling two-factor authentication. Each one can be used once
+	// instead of a TOTP code.
+	RecoveryCodes = 10
+	// VerifyEmail makes new users receive an email with a link for
+	// verifying their address. Email changes are always verified.
+	VerifyEmail = false
+	// RequireVerifiedEmail prevents users from signing in with their
+	// password until they've verified their email address. Setting it
+	// also enables VerifyEmail.
+	RequireVerifiedEmail = false
+	// EmailVerificationExpiry is the time verification links remain valid.
+	EmailVerificationExpiry = 72 * time.Hour
+	// VerificationEmailInterval is the minimum time between the verification
+	// emails sent when a user with an unverified email address tries to sign
+	// in while RequireVerifiedEmail is set.
+	VerificationEmailInterval = time.Hour
+	// MaxFailedSignIns is the number of consecutive failed sign in attempts
+	// after which a user is locked out for SignInLockout. Note that anyone
+	// who knows a username or email address is able to lock that account.
+	// The default value of zero disables locking.
+	MaxFailedSignIns = 0
+	// MaxTwoFactorAttempts is the number of consecutive failed
+	// two-factor authentication codes after which a user is locked out
+	// for SignInLockout, which also invalidates their pending sign in.
+	// Failed codes are counted separately from the failed passwords
+	// limited by MaxFailedSignIns (see TwoFactor.FailedAttempts) and
+	// entering the right password doesn't reset them. Setting it to
+	// zero disables locking, allowing TOTP codes to be brute forced.
+	MaxTwoFactorAttempts = 5
+	// SignInLockout is the time a user stays locked out after reaching
+	// MaxFailedSignIns or MaxTwoFactorAttempts.
+	SignInLockout = 15 * time.Minute
 
 	SocialOrder = []string{SocialTypeFacebook, SocialTypeTwitter, SocialTypeGoogle, SocialTypeGithub}
 )
@@ -4,7 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"gnd.la/app/profile"
 	"gnd.la/cache/driver"
@@ -12,6 +15,7 @@ import (
 	"gnd.la/encoding/codec"
 	"gnd.la/encoding/pipe"
 	"gnd.la/log"
+	"gnd.la/trace"
 )
 
 var (
@@ -27,12 +31,24 @@ const cache = "cache"
 type Cache struct {
 	// The Logger to log debug messages and, more importantly, errors.
 	// New() initialies the log.Logger to log.Std.
-	Logger    *log.Logger
+	Logger *log.Logger
+	// Metrics, if non-nil, receives the measurements taken by the
+	// Cache. Note that they're also available from Stats, regardless
+	// of this field.
+	Metrics   Metrics
 	prefix    string
 	prefixLen int
 	driver    driver.Driver
 	codec     *codec.Codec
 	pipe      *pipe.Pipe
+	stats     *cacheStats
+	// Used by GetOrCompute
+	flight       *flight
+	earlyBeta    float64
+	staleTimeout int
+	lockTimeout  int
+	// set by WithSpan
+	span *trace.Span
 }
 
 func (c *Cache) backendKey(key string) string {
@@ -51,7 +67,9 @@ func (c *Cache) frontendKey(key string) string {
 // expires. If the timeout is 0, the item never expires, but
 // might be only purged from cache when running out of space.
 func (c *Cache) Set(key string, object interface{}, timeout int) error {
+	start := time.Now()
 	b, err := c.codec.Encode(object)
+	c.transform(TransformCodec, start)
 	if err != nil {
 		eerr := &cacheError{
 			op:    "encoding object",
@@ -75,13 +93,19 @@ func (c *Cache) Get(key string, obj interface{}) error {
 	if err != nil {
 		return err
 	}
-	cerr := c.codec.Decode(b, obj)
-	if cerr != nil {
+	return c.decode(key, b, obj)
+}
+
+func (c *Cache) decode(key string, data []byte, obj interface{}) error {
+	start := time.Now()
+	err := c.codec.Decode(data, obj)
+	c.transform(TransformCodec, start)
+	if err != nil {
 		derr := &cacheError{
 			op:    "decoding object",
 			key:   key,
 			codec: true,
-			err:   cerr,
+			err:   err,
 		}
 		c.error(derr)
 		return derr
@@ -119,6 +143,9 @@ func (c *Cache) GetMulti(out map[string]interface{}, typer Typer) error {
 	if profile.On && profile.Profiling() {
 		defer profile.Startf(cache, "GET MULTI", "%v", keys).End()
 	}
+	if c.span != nil {
+		defer c.span.Child("cache.get_multi").Set("keys", len(keys)).End()
+	}
 	qkeys := keys
 	if c.prefixLen > 0 {
 		qkeys = make([]string, len(keys))
@@ -126,7 +153,13 @@ func (c *Cache) GetMulti(out map[string]interface{}, typer Typer) error {
 			qkeys[ii] = c.backendKey(v)
 		}
 	}
+	start := time.Now()
 	data, err := c.driver.GetMulti(qkeys)
+	var size int
+	for _, v := range data {
+		size += len(v)
+	}
+	c.operation(opGetMulti, start, size, err)
 	if err != nil {
 		gerr := &cacheError{
 			op:  "getting multiple keys",
@@ -136,6 +169,7 @@ func (c *Cache) GetMulti(out map[string]interface{}, typer Typer) error {
 		c.error(gerr)
 		return gerr
 	}
+	c.lookups(len(data), len(keys)-len(data))
 	if typer == nil {
 		typer = mapTyper(out)
 	}
@@ -155,8 +189,14 @@ func (c *Cache) GetMulti(out map[string]interface{}, typer Typer) error {
 			c.error(derr)
 			return derr
 		}
+		value, err := c.decodePipe(k, value)
+		if err != nil {
+			return err
+		}
 		val := reflect.New(typ)
-		err := c.codec.Decode(value, val.Interface())
+		start := time.Now()
+		err = c.codec.Decode(value, val.Interface())
+		c.transform(TransformCodec, start)
 		if err != nil {
 			derr := &cacheError{
 				op:  "decoding object",
@@ -178,9 +218,14 @@ func (c *Cache) SetBytes(key string, b []byte, timeout int) error {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(cache).Note("SET", key).End()
 	}
+	if c.span != nil {
+		defer c.span.Child("cache.set").Set("key", key).Set("bytes", len(b)).End()
+	}
 	if c.pipe != nil {
 		var err error
+		start := time.Now()
 		b, err = c.pipe.Encode(b)
+		c.transform(TransformPipe, start)
 		if err != nil {
 			perr := &cacheError{
 				op:  "encoding data with pipe",
@@ -192,7 +237,9 @@ func (c *Cache) SetBytes(key string, b []byte, timeout int) error {
 		}
 	}
 	k := c.backendKey(key)
+	start := time.Now()
 	err := c.driver.Set(k, b, timeout)
+	c.operation(opSet, start, len(b), err)
 	if err != nil {
 		serr := &cacheError{
 			op:  "setting key",
@@ -211,7 +258,12 @@ func (c *Cache) GetBytes(key string) ([]byte, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(cache).Note("GET", key).End()
 	}
+	if c.span != nil {
+		defer c.span.Child("cache.get").Set("key", key).End()
+	}
+	start := time.Now()
 	b, err := c.driver.Get(c.backendKey(key))
+	c.operation(opGet, start, len(b), err)
 	if err != nil {
 		gerr := &cacheError{
 			op:  "getting key",
@@ -222,10 +274,19 @@ func (c *Cache) GetBytes(key string) ([]byte, error) {
 		return nil, gerr
 	}
 	if b == nil {
+		c.lookups(0, 1)
 		return nil, ErrNotFound
 	}
+	c.lookups(1, 0)
+	return c.decodePipe(key, b)
+}
+
+func (c *Cache) decodePipe(key string, b []byte) ([]byte, error) {
 	if c.pipe != nil {
+		start := time.Now()
+		var err error
 		b, err = c.pipe.Decode(b)
+		c.transform(TransformPipe, start)
 		if err != nil {
 			perr := &cacheError{
 				op:  "decoding data with pipe",
@@ -246,7 +307,12 @@ func (c *Cache) Delete(key string) error {
 	if profile.On {
 		defer profile.Startf(cache, "DELETE %s", key).End()
 	}
+	if c.span != nil {
+		defer c.span.Child("cache.delete").Set("key", key).End()
+	}
+	start := time.Now()
 	err := c.driver.Delete(c.backendKey(key))
+	c.operation(opDelete, start, 0, err)
 	if err != nil {
 		derr := &cacheError{
 			op:  "deleting",
@@ -261,7 +327,13 @@ func (c *Cache) Delete(key string) error {
 
 // Flush removes all items from the cache.
 func (c *Cache) Flush() error {
-	return c.driver.Flush()
+	start := time.Now()
+	err := c.driver.Flush()
+	c.operation(opFlush, start, 0, err)
+	if err != nil {
+		atomic.AddUint64(&c.stats.errors, 1)
+	}
+	return err
 }
 
 // Close closes the cache connection. If you're using a cache
@@ -279,6 +351,16 @@ func (c *Cache) Connection() interface{} {
 	return c.driver.Connection()
 }
 
+// WithSpan returns a copy of the Cache which records its operations
+// as children of the given trace span. The copy shares its connection
+// and statistics with c. Note that gnd.la/app.Context already returns
+// a Cache bound to its current span.
+func (c *Cache) WithSpan(span *trace.Span) *Cache {
+	cpy := *c
+	cpy.span = span
+	return &cpy
+}
+
 func (c *Cache) debugf(format string, arg ...interface{}) {
 	if c.Logger != nil {
 		c.Logger.Debugf(format, arg...)
@@ -292,6 +374,7 @@ func (c *Cache) warningf(format string, arg ...interface{}) {
 }
 
 func (c *Cache) error(err *cacheError) {
+	atomic.AddUint64(&c.stats.errors, 1)
 	if c.Logger != nil {
 		c.Logger.Error(err)
 	}
@@ -300,6 +383,8 @@ func (c *Cache) error(err *cacheError) {
 func newConfig(conf *config.URL) (*Cache, error) {
 	cache := &Cache{
 		Logger: log.Std,
+		stats:  newCacheStats(),
+		flight: &flight{},
 	}
 
 	if codecName := conf.Fragment.Get("codec"); codecName != "" {
@@ -325,6 +410,29 @@ func newConfig(conf *config.URL) (*Cache, error) {
 			return nil, fmt.Errorf("unknown pipe %q, maybe you forgot an import?", pipeName)
 		}
 	}
+	cache.earlyBeta = 1
+	if early := conf.Fragment.Get("early"); early != "" {
+		beta, err := strconv.ParseFloat(early, 64)
+		if err != nil || beta < 0 {
+			return nil, fmt.Errorf("invalid early %q, must be a non-negative number", early)
+		}
+		cache.earlyBeta = beta
+	}
+	for _, v := range []struct {
+		name string
+		val  *int
+	}{
+		{"stale", &cache.staleTimeout},
+		{"lock", &cache.lockTimeout},
+	} {
+		if s := conf.Fragment.Get(v.name); s != "" {
+			val, ok := conf.Fragment.Int(v.name)
+			if !ok || val < 0 {
+				return nil, fmt.Errorf("invalid %s %q, must be a non-negative number of seconds", v.name, s)
+			}
+			*v.val = val
+		}
+	}
 	var opener driver.Opener
 	if conf.Scheme != "" {
 		opener = driver.Get(conf.Scheme)
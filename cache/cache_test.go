@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -303,13 +304,48 @@ func TestCompress(t *testing.T) {
 	testCache(t, "memory://#min_compress=0&compress_level=9")
 }
 
+func TestPipe(t *testing.T) {
+	testCache(t, "memory://#pipe=zlib")
+}
+
+func TestGetMultiPipe(t *testing.T) {
+	c, err := newCache("memory://#pipe=zlib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// zlib only compresses values bigger than 100 bytes
+	values := map[string]string{
+		"p1": strings.Repeat("a", 1000),
+		"p2": strings.Repeat("b", 2000),
+	}
+	out := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if err := c.Set(k, v, 0); err != nil {
+			t.Fatal(err)
+		}
+		out[k] = ""
+	}
+	if err := c.GetMulti(out, nil); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range values {
+		if out[k] != v {
+			t.Errorf("bad value for %s - want %d bytes, got %v", k, len(v), out[k])
+		}
+	}
+}
+
+func TestTiered(t *testing.T) {
+	testCache(t, "tiered://?l2=memory://")
+}
+
 func TestPrefix(t *testing.T) {
 	prefix := "foo"
-	c1, err := newCache("memory://#prefix=" + prefix)
+	c1, err := newCache("memory://prefix-test#prefix=" + prefix)
 	if err != nil {
 		t.Fatal(err)
 	}
-	c2, err := newCache("memory://")
+	c2, err := newCache("memory://prefix-test")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -353,11 +389,11 @@ func TestMemoryCacheMaxSize(t *testing.T) {
 	data2 := make([]byte, 512)
 	c.SetBytes("k1", data1, 0)
 	c.SetBytes("k2", data2, 0)
+	// Use k1, so k2 becomes the least recently used
+	if _, err := c.GetBytes("k1"); err != nil {
+		t.Fatal(err)
+	}
 	c.SetBytes("k3", data2, 0)
-	// Sleep for 0.1s so the goroutine has time
-	// to remove items.
-	time.Sleep(100 * time.Millisecond)
-	// Should have evicted k2 or k3
 	k1d, err := c.GetBytes("k1")
 	if err != nil {
 		t.Error(err)
@@ -365,10 +401,38 @@ func TestMemoryCacheMaxSize(t *testing.T) {
 	if len(k1d) != len(data1) {
 		t.Errorf("bad data for key k1")
 	}
-	_, err2 := c.GetBytes("k2")
-	_, err3 := c.GetBytes("k3")
-	if err2 == nil && err3 == nil {
-		t.Errorf("should have evicted k2 or k3")
+	if _, err := c.GetBytes("k2"); err != ErrNotFound {
+		t.Errorf("should have evicted k2, got error %v", err)
+	}
+	if _, err := c.GetBytes("k3"); err != nil {
+		t.Errorf("should have kept k3, got error %v", err)
+	}
+}
+
+func TestMemoryCacheNamespaces(t *testing.T) {
+	c1, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1.SetBytes("k", []byte("v"), 0)
+	if _, err := c2.GetBytes("k"); err != ErrNotFound {
+		t.Errorf("unnamed memory caches should not share items, got error %v", err)
+	}
+	c3, err := newCache("memory://ns-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c4, err := newCache("memory://ns-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c3.SetBytes("k", []byte("v"), 0)
+	if b, err := c4.GetBytes("k"); err != nil || string(b) != "v" {
+		t.Errorf("named memory caches should share items, got %q, %v", string(b), err)
 	}
 }
 
@@ -0,0 +1,308 @@
+package cache
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"gnd.la/cache/driver"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// computedMagic is prepended to the values stored
+	// by GetOrCompute, followed by the soft expiration
+	// and the time it took to compute the value.
+	computedMagic      = "\xffGC1"
+	computedHeaderSize = len(computedMagic) + 16
+	// lockPollInterval is the interval between the checks
+	// for a value which is being computed by another process.
+	lockPollInterval = 25 * time.Millisecond
+)
+
+// Computed might be returned from the function passed to
+// GetOrCompute to control how the value is cached.
+type Computed struct {
+	// Value is the computed value.
+	Value interface{}
+	// Timeout replaces the timeout passed to GetOrCompute.
+	Timeout int
+	// NoStore indicates that the value should not be stored
+	// in the cache. Callers waiting for this value will
+	// compute their own instead.
+	NoStore bool
+}
+
+// computed represents a value stored by GetOrCompute.
+type computed struct {
+	data []byte
+	// soft expiration, in Unix nanoseconds. Zero means
+	// no expiration.
+	expires int64
+	// time taken to compute the value, in nanoseconds.
+	delta int64
+}
+
+func decodeComputed(b []byte) (*computed, bool) {
+	if len(b) < computedHeaderSize || string(b[:len(computedMagic)]) != computedMagic {
+		return nil, false
+	}
+	h := b[len(computedMagic):]
+	return &computed{
+		data:    b[computedHeaderSize:],
+		expires: int64(binary.BigEndian.Uint64(h)),
+		delta:   int64(binary.BigEndian.Uint64(h[8:])),
+	}, true
+}
+
+func (c *computed) encode() []byte {
+	b := make([]byte, computedHeaderSize+len(c.data))
+	copy(b, computedMagic)
+	binary.BigEndian.PutUint64(b[len(computedMagic):], uint64(c.expires))
+	binary.BigEndian.PutUint64(b[len(computedMagic)+8:], uint64(c.delta))
+	copy(b[computedHeaderSize:], c.data)
+	return b
+}
+
+func (c *computed) expired(now int64) bool {
+	return c.expires != 0 && c.expires <= now
+}
+
+// expiredEarly implements probabilistic early expiration, as
+// described in "Optimal Probabilistic Cache Stampede Prevention"
+// (Vattani et al.). Values which take longer to compute are
+// more likely to expire early.
+func (c *computed) expiredEarly(now int64, beta float64) bool {
+	if c.expires == 0 || beta <= 0 || c.delta <= 0 {
+		return false
+	}
+	return now-int64(float64(c.delta)*beta*math.Log(rand.Float64())) >= c.expires
+}
+
+// flight deduplicates concurrent computations of
+// the same key within a process.
+type flight struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	wg     sync.WaitGroup
+	data   []byte
+	stored bool
+	err    error
+}
+
+// inFlight returns true iff there's a computation
+// in progress for the given key.
+func (f *flight) inFlight(key string) bool {
+	f.mu.Lock()
+	_, found := f.calls[key]
+	f.mu.Unlock()
+	return found
+}
+
+// do calls fn unless there's already a computation in progress
+// for the given key, in which case it waits for it and returns
+// its results. The leader return value indicates if fn was called.
+func (f *flight) do(key string, fn func() ([]byte, bool, error)) (data []byte, stored bool, leader bool, err error) {
+	f.mu.Lock()
+	if f.calls == nil {
+		f.calls = make(map[string]*flightCall)
+	}
+	if call := f.calls[key]; call != nil {
+		f.mu.Unlock()
+		call.wg.Wait()
+		return call.data, call.stored, false, call.err
+	}
+	call := &flightCall{}
+	call.wg.Add(1)
+	f.calls[key] = call
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		delete(f.calls, key)
+		f.mu.Unlock()
+		call.wg.Done()
+	}()
+	call.data, call.stored, call.err = fn()
+	return call.data, call.stored, true, call.err
+}
+
+// GetOrCompute retrieves the item for the given key into obj, which
+// must be a pointer, like Get does. If the item is not found, fn is
+// called to compute it and its result is stored in the cache with
+// the given timeout (see Set) and then decoded into obj. Errors returned
+// from fn are returned unmodified, while errors communicating with the
+// cache are logged and the value is computed as if it was not found.
+//
+// The function passed to GetOrCompute might also return a *Computed,
+// to change the timeout or to avoid storing the value.
+//
+// When several goroutines request the same missing key at the same
+// time, fn is called only once and its result is shared among all of
+// them. Additionally, the following options (specified in the cache
+// configuration URL) help to avoid several processes computing the
+// same value when a popular item expires:
+//
+//   - stale={seconds}: Items are kept in the cache for the given number
+//     of seconds after they expire. While an item is stale, only one caller
+//     computes its new value, while concurrent callers receive the stale one.
+//   - early={beta}: Items expire early with a probability which increases
+//     as the expiration approaches and with the time it took to compute them.
+//     Higher values make early expiration more likely. The default is 1, while
+//     0 disables it.
+//   - lock={seconds}: When the driver supports atomically adding a key
+//     (memory, memcache and redis drivers do), only one process computes
+//     the value, holding a lock for at most the given number of seconds,
+//     while the rest wait for it (or use the stale value, if available).
+//     The lock is released once the value is stored, except with drivers
+//     which can't check atomically that it's still held by the same
+//     process (e.g. memcache), which let it expire instead.
+//
+// Items stored by GetOrCompute include some additional data, so they
+// should only be retrieved using GetOrCompute. Items which can't be
+// decoded into obj (e.g. stored by a previous version of the app, using
+// a different codec or type) are handled as missing, so they're computed
+// again and replaced.
+func (c *Cache) GetOrCompute(key string, obj interface{}, timeout int, fn func() (interface{}, error)) error {
+	var stale *computed
+	if b, err := c.GetBytes(key); err == nil {
+		if item, ok := decodeComputed(b); ok {
+			now := time.Now().UnixNano()
+			// If the item expired (maybe early), it's still served when
+			// another caller is already computing the new value.
+			if (!item.expired(now) && !item.expiredEarly(now, c.earlyBeta)) || c.flight.inFlight(key) {
+				if c.decode(key, item.data, obj) == nil {
+					return nil
+				}
+			} else {
+				stale = item
+			}
+		}
+	}
+	data, stored, leader, err := c.flight.do(key, func() ([]byte, bool, error) {
+		return c.compute(key, timeout, stale, fn)
+	})
+	if err != nil {
+		return err
+	}
+	if data == nil && stale != nil {
+		// Another process is computing the value
+		if c.decode(key, stale.data, obj) == nil {
+			return nil
+		}
+	}
+	if data == nil || (!stored && !leader) {
+		// Value was not shared by the leader, compute our own
+		if data, _, err = c.compute(key, timeout, nil, fn); err != nil {
+			return err
+		}
+	}
+	return c.decode(key, data, obj)
+}
+
+// compute calls fn and stores its result, returning the encoded value
+// and whether it was stored. If another process is computing the same
+// value and a stale value is available, it returns nil data and no error.
+func (c *Cache) compute(key string, timeout int, stale *computed, fn func() (interface{}, error)) ([]byte, bool, error) {
+	if c.lockTimeout > 0 {
+		if adder, ok := c.driver.(driver.Adder); ok {
+			lockKey := c.backendKey(key) + ".lock"
+			// The token identifies this process as the lock owner
+			token := stringutil.RandomBytes(16)
+			locked, err := adder.Add(lockKey, token, c.lockTimeout)
+			if err != nil && err != driver.ErrNotImplemented {
+				c.warningf("error locking %s: %s", key, err)
+			}
+			if err == nil && !locked {
+				if stale != nil {
+					return nil, false, nil
+				}
+				if item := c.waitComputed(key, lockKey); item != nil {
+					return item.data, true, nil
+				}
+			}
+			if locked {
+				defer c.unlock(key, lockKey, token)
+			}
+		}
+	}
+	start := time.Now()
+	value, err := fn()
+	if err != nil {
+		return nil, false, err
+	}
+	end := time.Now()
+	store := true
+	if cv, ok := value.(*Computed); ok {
+		value = cv.Value
+		timeout = cv.Timeout
+		store = !cv.NoStore
+	}
+	encodeStart := time.Now()
+	data, err := c.codec.Encode(value)
+	c.transform(TransformCodec, encodeStart)
+	if err != nil {
+		eerr := &cacheError{
+			op:    "encoding object",
+			key:   key,
+			codec: true,
+			err:   err,
+		}
+		c.error(eerr)
+		return nil, false, eerr
+	}
+	if store {
+		item := &computed{data: data, delta: int64(end.Sub(start))}
+		backendTimeout := timeout
+		if timeout != 0 {
+			item.expires = end.Add(time.Duration(timeout) * time.Second).UnixNano()
+			backendTimeout += c.staleTimeout
+		}
+		// Errors are already logged by SetBytes and
+		// the value can still be returned.
+		if err := c.SetBytes(key, item.encode(), backendTimeout); err != nil {
+			store = false
+		}
+	}
+	return data, store, nil
+}
+
+// unlock releases the lock acquired by compute, as long as it's still
+// held with the given token. If fn took longer than the lock timeout,
+// the lock might have been acquired by another process, which must
+// keep it. Drivers which can't check the lock owner atomically let
+// the lock expire, since the processes waiting for it also stop
+// when the value is stored.
+func (c *Cache) unlock(key string, lockKey string, token []byte) {
+	cd, ok := c.driver.(driver.CompareDeleter)
+	if !ok {
+		return
+	}
+	if _, err := cd.CompareAndDelete(lockKey, token); err != nil && err != driver.ErrNotImplemented {
+		c.warningf("error unlocking %s: %s", key, err)
+	}
+}
+
+// waitComputed waits until the value for the given key is computed
+// by another process or until the lock expires, returning nil in the
+// latter case.
+func (c *Cache) waitComputed(key string, lockKey string) *computed {
+	deadline := time.Now().Add(time.Duration(c.lockTimeout) * time.Second)
+	for time.Now().Before(deadline) {
+		time.Sleep(lockPollInterval)
+		if b, _ := c.GetBytes(key); b != nil {
+			if item, ok := decodeComputed(b); ok && !item.expired(time.Now().UnixNano()) {
+				return item
+			}
+		}
+		if b, _ := c.driver.Get(lockKey); b == nil {
+			// Lock was released without storing a value
+			break
+		}
+	}
+	return nil
+}
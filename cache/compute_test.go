@@ -0,0 +1,258 @@
+package cache
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetOrCompute(t *testing.T) {
+	c, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls int32
+	compute := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(50 * time.Millisecond)
+		return 42, nil
+	}
+	var wg sync.WaitGroup
+	for ii := 0; ii < 20; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var v int
+			if err := c.GetOrCompute("k", &v, 0, compute); err != nil {
+				t.Error(err)
+			}
+			if v != 42 {
+				t.Errorf("expecting 42, got %d", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expecting 1 call, got %d", calls)
+	}
+	var v int
+	if err := c.GetOrCompute("k", &v, 0, compute); err != nil || v != 42 {
+		t.Errorf("expecting cached 42, got %d (%v)", v, err)
+	}
+	if calls != 1 {
+		t.Errorf("value was not cached, got %d calls", calls)
+	}
+}
+
+func TestGetOrComputeError(t *testing.T) {
+	c, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	errCompute := errors.New("compute error")
+	var v int
+	err = c.GetOrCompute("k", &v, 0, func() (interface{}, error) {
+		return nil, errCompute
+	})
+	if err != errCompute {
+		t.Errorf("expecting errCompute, got %v", err)
+	}
+	if _, err := c.GetBytes("k"); err != ErrNotFound {
+		t.Errorf("expecting ErrNotFound after error, got %v", err)
+	}
+}
+
+func TestGetOrComputeNoStore(t *testing.T) {
+	c, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls int32
+	compute := func() (interface{}, error) {
+		n := atomic.AddInt32(&calls, 1)
+		time.Sleep(50 * time.Millisecond)
+		return &Computed{Value: int(n), NoStore: true}, nil
+	}
+	var wg sync.WaitGroup
+	for ii := 0; ii < 5; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var v int
+			if err := c.GetOrCompute("k", &v, 0, compute); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	// Every caller must compute its own value
+	if calls != 5 {
+		t.Errorf("expecting 5 calls, got %d", calls)
+	}
+	if _, err := c.GetBytes("k"); err != ErrNotFound {
+		t.Errorf("expecting ErrNotFound with NoStore, got %v", err)
+	}
+}
+
+func TestGetOrComputeUndecodable(t *testing.T) {
+	c, err := newCache("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Value stored with a different type, e.g. by a previous
+	// version of the app.
+	var s string
+	if err := c.GetOrCompute("k", &s, 0, func() (interface{}, error) { return "foo", nil }); err != nil {
+		t.Fatal(err)
+	}
+	var calls int
+	var v struct{ A int }
+	err = c.GetOrCompute("k", &v, 0, func() (interface{}, error) {
+		calls++
+		return struct{ A int }{42}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || v.A != 42 {
+		t.Errorf("expecting 1 call and 42, got %d calls and %d", calls, v.A)
+	}
+	v.A = 0
+	err = c.GetOrCompute("k", &v, 0, func() (interface{}, error) {
+		calls++
+		return nil, errors.New("value should have been replaced")
+	})
+	if err != nil || v.A != 42 {
+		t.Errorf("expecting replaced value 42, got %d (%v)", v.A, err)
+	}
+}
+
+func TestGetOrComputeStale(t *testing.T) {
+	c, err := newCache("memory://#stale=60&early=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Store an already expired value
+	item := &computed{data: mustEncode(t, c, 1), expires: time.Now().Add(-time.Second).UnixNano()}
+	if err := c.SetBytes("k", item.encode(), 60); err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		var v int
+		c.GetOrCompute("k", &v, 60, func() (interface{}, error) {
+			close(started)
+			<-release
+			return 2, nil
+		})
+		if v != 2 {
+			t.Errorf("refreshing caller expecting 2, got %d", v)
+		}
+		close(done)
+	}()
+	<-started
+	var v int
+	if err := c.GetOrCompute("k", &v, 60, func() (interface{}, error) {
+		t.Error("stale value was not served")
+		return 3, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if v != 1 {
+		t.Errorf("expecting stale 1, got %d", v)
+	}
+	close(release)
+	<-done
+	if err := c.GetOrCompute("k", &v, 60, nil); err != nil || v != 2 {
+		t.Errorf("expecting refreshed 2, got %d (%v)", v, err)
+	}
+}
+
+func TestGetOrComputeEarly(t *testing.T) {
+	item := &computed{expires: time.Now().Add(time.Second).UnixNano(), delta: int64(time.Hour)}
+	if !item.expiredEarly(time.Now().UnixNano(), 1) {
+		t.Error("value taking 1h to compute should expire 1s early")
+	}
+	if item.expiredEarly(time.Now().UnixNano(), 0) {
+		t.Error("value expired early with early=0")
+	}
+	item.delta = int64(time.Nanosecond)
+	if item.expiredEarly(time.Now().UnixNano(), 1) {
+		t.Error("value taking 1ns to compute should not expire 1s early")
+	}
+}
+
+func TestGetOrComputeLock(t *testing.T) {
+	// Two caches sharing the same storage, simulating
+	// two processes.
+	c1, err := newCache("memory://lock-test#lock=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := newCache("memory://lock-test#lock=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		var v int
+		c1.GetOrCompute("k", &v, 0, func() (interface{}, error) {
+			close(started)
+			time.Sleep(100 * time.Millisecond)
+			return 1, nil
+		})
+		close(done)
+	}()
+	<-started
+	var v int
+	if err := c2.GetOrCompute("k", &v, 0, func() (interface{}, error) {
+		t.Error("value computed while locked by another cache")
+		return 2, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if v != 1 {
+		t.Errorf("expecting 1, got %d", v)
+	}
+	<-done
+}
+
+func TestGetOrComputeUnlock(t *testing.T) {
+	c, err := newCache("memory://#lock=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v int
+	if err := c.GetOrCompute("k1", &v, 0, func() (interface{}, error) { return 1, nil }); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := c.driver.Get("k1.lock"); b != nil {
+		t.Error("lock not released after computing the value")
+	}
+	// Simulate the lock expiring while computing the value
+	// and being acquired by another process.
+	other := []byte("other")
+	if err := c.GetOrCompute("k2", &v, 0, func() (interface{}, error) {
+		c.driver.Set("k2.lock", other, 0)
+		return 2, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := c.driver.Get("k2.lock"); !bytes.Equal(b, other) {
+		t.Errorf("lock held by another process was released, got %q", b)
+	}
+}
+
+func mustEncode(t *testing.T, c *Cache, v interface{}) []byte {
+	b, err := c.codec.Encode(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
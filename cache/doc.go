@@ -11,6 +11,9 @@
 //  - pipe: A pipe to pass the data trough, usually for compressing it. See gnd.la/encoding/pipe for the available ones.
 //  - prefix: A prefix to be prepended to all keys stored.
 //
+// Additionally, the stale, early and lock options control how Cache.GetOrCompute avoids
+// computing the same value several times when it expires. See its documentation for details.
+//
 // Note that these options are not mandatory. For the available drivers, see gnd.la/cache/driver for the ones without
 // dependencies and its subpackages for the ones with external dependencies.
 //
@@ -19,4 +22,8 @@
 //  memcache://localhost#codec=json&pipe=zlib
 //  memory://#max_size=1.5G
 //  file://cache#max_size=512M
+//
+// Each Cache collects statistics about its usage (hits, misses, bytes transferred, latency
+// of the driver operations, etc...) which can be retrieved with Cache.Stats. Users which
+// want to export them to a monitoring system can also set Cache.Metrics.
 package cache
@@ -8,13 +8,36 @@
 // The provided drivers are:
 //
 //  - dummy:// - a dummy driver which does not cache data, useful for development
-//  - memory://[#max_size={size} - a memory driver with an optional maximum size
+//  - memory://[name][#max_size={size}&shards={n}&sweep={interval}] - a memory driver with an optional maximum size
 //  - file://path[#max_size={size} a file based driver with an optional maximum size
+//  - tiered://?l1={driver}&l2={driver}[#ttl={seconds}&channel={name}] - a two level driver
 //
 // Sizes admit the K, M, G and T suffixes to represent Kilobytes, Megabytes, Gigabytes and
 // Terabytes, respectivelly. When there's no prefix, the value is assumed to be in bytes. Note
 // that real numbers can be used, like e.g. 1.5G
 //
+// The memory driver evicts the least recently used items when max_size is exceeded. Items are
+// distributed among several shards (16 by default, fewer for caches with a small max_size) to reduce
+// lock contention. The shards option can be used to override this number, which must be a power of 2
+// and, since max_size is divided equally among the shards, not greater than max_size.
+// Expired items are removed from each shard at most every minute, when writing to it. This interval
+// can be changed with the sweep option (e.g. sweep=30s), while sweep=0 disables it (expired items are
+// still removed when they're read or evicted). Memory caches don't start any goroutines, so they
+// don't leak resources when they're not closed. Each memory cache has its own storage,
+// unless a name is provided, in which case all the memory caches opened with the same name
+// (e.g. memory://sessions) share it.
+//
+// The tiered driver keeps the items retrieved from l2 (usually a remote cache, like memcache
+// or redis) in l1 (memory by default) for up to ttl seconds (5 by default), avoiding roundtrips
+// to l2 for frequently used items. When l2 supports publishing messages (like redis does), every
+// change is broadcast using the given channel name, so other processes remove the modified items
+// from their l1. Otherwise, l1 might return stale items for up to ttl seconds. Items are never kept
+// in l1 longer than their remaining lifetime in l2 when l2 can report it (memory and redis drivers
+// do, memcache doesn't). Note that l1 and l2
+// are config URLs too, so any # or & in them must be escaped. e.g.
+//
+//  tiered://?l1=memory%3A%2F%2F%23max_size%3D64M&l2=redis://10.0.0.1#ttl=2
+//
 // Paths which don't start with a / are interepreted as relative to the application binary
 // (using gnd.la/util/pathutil.Relative), while paths starting by / are interpreted as absolute.
 // Note that paths should aways use forward slashes, even in platforms which use the backslash
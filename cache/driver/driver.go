@@ -52,6 +52,40 @@ type Driver interface {
 	Flush() error
 }
 
+// Adder is implemented by drivers which support storing an item
+// only if its key is not already present, atomically. Caches use
+// it for coordinating several processes which compute the same
+// value (see gnd.la/cache.Cache.GetOrCompute).
+type Adder interface {
+	// Add stores the given value only if the key is not present,
+	// returning true iff it was stored. The timeout parameter is
+	// interpreted as in Driver.Set.
+	Add(key string, b []byte, timeout int) (bool, error)
+}
+
+// CompareDeleter is implemented by drivers which support removing an
+// item only if it holds a given value, atomically. Caches use it for
+// releasing the locks acquired with Adder, without removing a lock
+// acquired by another process after the previous one expired.
+type CompareDeleter interface {
+	// CompareAndDelete removes the item with the given key only
+	// if its value is b, returning true iff it was removed.
+	CompareAndDelete(key string, b []byte) (bool, error)
+}
+
+// TTLGetter is implemented by drivers which can report the remaining
+// lifetime of their items. Tiered drivers use it to avoid keeping items
+// in L1 after they've expired in L2.
+type TTLGetter interface {
+	// GetTTL works like Driver.Get, but also returns the number of
+	// seconds until the item expires, rounded down, or -1 if the
+	// item doesn't expire.
+	GetTTL(key string) ([]byte, int, error)
+	// GetMultiTTL works like Driver.GetMulti, but also returns the
+	// remaining lifetime of each found item, as in GetTTL.
+	GetMultiTTL(keys []string) (map[string][]byte, map[string]int, error)
+}
+
 // Register registers a new cache driver with the
 // given protocol and opener function. This function
 // is not thread safe, as it's only intended to be
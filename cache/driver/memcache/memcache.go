@@ -22,6 +22,15 @@ func (c *memcacheDriver) Set(key string, b []byte, timeout int) error {
 	return c.error(c.Client.Set(&item))
 }
 
+func (c *memcacheDriver) Add(key string, b []byte, timeout int) (bool, error) {
+	item := memcache.Item{Key: key, Value: b, Expiration: int32(timeout)}
+	err := c.Client.Add(&item)
+	if err == memcache.ErrNotStored {
+		return false, nil
+	}
+	return err == nil, c.error(err)
+}
+
 func (c *memcacheDriver) Get(key string) ([]byte, error) {
 	item, err := c.Client.Get(key)
 	if err != nil {
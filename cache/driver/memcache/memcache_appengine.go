@@ -21,6 +21,15 @@ func (c *memcacheDriver) Set(key string, b []byte, timeout int) error {
 	return memcache.Set(c.c, item)
 }
 
+func (c *memcacheDriver) Add(key string, b []byte, timeout int) (bool, error) {
+	item := &memcache.Item{Key: key, Value: b, Expiration: time.Duration(timeout) * time.Second}
+	err := memcache.Add(c.c, item)
+	if err == memcache.ErrNotStored {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 func (c *memcacheDriver) Get(key string) ([]byte, error) {
 	item, err := memcache.Get(c.c, key)
 	if err != nil && err != memcache.ErrCacheMiss {
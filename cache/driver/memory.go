@@ -1,9 +1,10 @@
 package driver
 
 import (
+	"bytes"
+	"container/list"
 	"fmt"
-	"runtime"
-	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -11,144 +12,374 @@ import (
 	"gnd.la/util/parseutil"
 )
 
-type item struct {
+const (
+	// defaultMemoryShards is the default number of shards
+	// used by MemoryDriver. Must be a power of 2.
+	defaultMemoryShards = 16
+	// minMemoryShardSize is the minimum size for each shard when
+	// the cache has a maximum size. Caches with a small maximum size
+	// use fewer shards, so eviction remains close to a global LRU.
+	minMemoryShardSize = 1 << 20
+	// defaultSweepInterval is the default minimum interval
+	// between removals of the expired items in each shard.
+	defaultSweepInterval = time.Minute
+)
+
+var memoryStores struct {
+	sync.Mutex
+	stores map[string]*memoryStore
+}
+
+type memoryItem struct {
+	key     string
 	data    []byte
 	expires int64
 }
 
-type keyedItem struct {
-	key  string
-	item *item
+func (i *memoryItem) expired(now int64) bool {
+	return i.expires != 0 && i.expires < now
+}
+
+// memoryShard holds a subset of the items, with its own
+// lock, LRU list and counters. Items at the front of the list
+// are the most recently used ones.
+type memoryShard struct {
+	mu          sync.Mutex
+	items       map[string]*list.Element
+	lru         *list.List
+	size        uint64
+	maxSize     uint64
+	hits        uint64
+	misses      uint64
+	evictions   uint64
+	expirations uint64
+	// nextSweep is the time, in nanoseconds, when
+	// expired items will be removed from the shard.
+	nextSweep int64
+	// avoid false sharing between shards
+	_ [64]byte
 }
 
-type byExpirationAndSize []*keyedItem
+func newMemoryShard(maxSize uint64) *memoryShard {
+	return &memoryShard{
+		items:   make(map[string]*list.Element),
+		lru:     list.New(),
+		maxSize: maxSize,
+	}
+}
 
-func (e byExpirationAndSize) Len() int {
-	return len(e)
+// removeElement must be called with the shard lock held.
+func (s *memoryShard) removeElement(e *list.Element) {
+	item := s.lru.Remove(e).(*memoryItem)
+	delete(s.items, item.key)
+	s.size -= uint64(len(item.data))
 }
 
-func (e byExpirationAndSize) Less(i, j int) bool {
-	ei := e[i].item.expires
-	ej := e[j].item.expires
-	if ei != 0 && ej != 0 && ei != ej {
-		return ei < ej
+// sweep removes the expired items. It must be called with the
+// shard lock held.
+func (s *memoryShard) sweep(now int64) {
+	for _, e := range s.items {
+		if e.Value.(*memoryItem).expired(now) {
+			s.removeElement(e)
+			s.expirations++
+		}
 	}
-	if ei != 0 && ej == 0 {
-		return true
+}
+
+type memoryStore struct {
+	name   string
+	refs   int
+	shards []*memoryShard
+	mask   uint32
+	// sweep is the minimum interval between sweeps
+	// of a shard. Zero disables sweeping.
+	sweep time.Duration
+}
+
+func newMemoryStore(name string, maxSize uint64, shards int, sweep time.Duration) *memoryStore {
+	if shards <= 0 {
+		shards = defaultMemoryShards
+		if maxSize > 0 {
+			for shards > 1 && maxSize/uint64(shards) < minMemoryShardSize {
+				shards /= 2
+			}
+		}
+	}
+	s := &memoryStore{
+		name:   name,
+		shards: make([]*memoryShard, shards),
+		mask:   uint32(shards - 1),
+		sweep:  sweep,
+	}
+	first := time.Now().Add(sweep).UnixNano()
+	for ii := range s.shards {
+		sh := newMemoryShard(maxSize / uint64(shards))
+		sh.nextSweep = first
+		s.shards[ii] = sh
+	}
+	return s
+}
+
+func (s *memoryStore) shard(key string) *memoryShard {
+	// FNV-1a, inlined to avoid allocations
+	h := uint32(2166136261)
+	for ii := 0; ii < len(key); ii++ {
+		h ^= uint32(key[ii])
+		h *= 16777619
+	}
+	return s.shards[h&s.mask]
+}
+
+// set stores the given item. If add is true, the item is only
+// stored if the key is not present. It returns whether the item
+// was stored.
+func (s *memoryStore) set(key string, b []byte, expires int64, add bool) bool {
+	sh := s.shard(key)
+	size := uint64(len(b))
+	sh.mu.Lock()
+	if s.sweep > 0 {
+		// Expired items are removed lazily while writing,
+		// so a store doesn't need its own goroutine and
+		// shards which are not written to can't grow.
+		if now := time.Now(); now.UnixNano() >= sh.nextSweep {
+			sh.sweep(now.Unix())
+			sh.nextSweep = now.Add(s.sweep).UnixNano()
+		}
+	}
+	e := sh.items[key]
+	if add && e != nil && !e.Value.(*memoryItem).expired(time.Now().Unix()) {
+		sh.mu.Unlock()
+		return false
 	}
-	if ei == 0 && ej != 0 {
+	if sh.maxSize > 0 && size > sh.maxSize {
+		// Item would evict everything else and
+		// still not fit.
+		if e != nil {
+			sh.removeElement(e)
+		}
+		sh.mu.Unlock()
 		return false
 	}
-	return len(e[i].item.data) > len(e[j].item.data)
+	if e != nil {
+		// Reuse the existing item
+		item := e.Value.(*memoryItem)
+		sh.size -= uint64(len(item.data))
+		item.data = b
+		item.expires = expires
+		sh.lru.MoveToFront(e)
+	} else {
+		sh.items[key] = sh.lru.PushFront(&memoryItem{key: key, data: b, expires: expires})
+	}
+	sh.size += size
+	for sh.maxSize > 0 && sh.size > sh.maxSize {
+		sh.removeElement(sh.lru.Back())
+		sh.evictions++
+	}
+	sh.mu.Unlock()
+	return true
+}
+
+// get must be called with the shard lock held.
+func (s *memoryShard) get(key string) []byte {
+	if item := s.lookup(key); item != nil {
+		return item.data
+	}
+	return nil
+}
+
+// lookup returns the item for the given key, or nil if it's not
+// present or expired. It must be called with the shard lock held.
+func (s *memoryShard) lookup(key string) *memoryItem {
+	e := s.items[key]
+	if e == nil {
+		s.misses++
+		return nil
+	}
+	item := e.Value.(*memoryItem)
+	if item.expires != 0 && item.expired(time.Now().Unix()) {
+		s.removeElement(e)
+		s.expirations++
+		s.misses++
+		return nil
+	}
+	s.lru.MoveToFront(e)
+	s.hits++
+	return item
+}
+
+// ttl returns the remaining lifetime of the item in seconds,
+// as required by TTLGetter.
+func (i *memoryItem) ttl(now int64) int {
+	if i.expires == 0 {
+		return -1
+	}
+	return int(i.expires - now)
+}
+
+func (s *memoryStore) delete(key string) {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	if e := sh.items[key]; e != nil {
+		sh.removeElement(e)
+	}
+	sh.mu.Unlock()
 }
 
-func (e byExpirationAndSize) Swap(i, j int) {
-	e[i], e[j] = e[j], e[i]
+func (s *memoryStore) compareAndDelete(key string, b []byte) bool {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if item := sh.lookup(key); item != nil && bytes.Equal(item.data, b) {
+		sh.removeElement(sh.items[key])
+		return true
+	}
+	return false
+}
+
+func (s *memoryStore) flush() {
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+		sh.items = make(map[string]*list.Element)
+		sh.lru.Init()
+		sh.size = 0
+		sh.mu.Unlock()
+	}
+}
+
+// sweepAll removes all the expired items.
+func (s *memoryStore) sweepAll() {
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+		sh.sweep(time.Now().Unix())
+		sh.mu.Unlock()
+	}
+}
+
+func (s *memoryStore) stats() *MemoryStats {
+	st := &MemoryStats{}
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+		st.Items += len(sh.items)
+		st.Size += sh.size
+		st.Hits += sh.hits
+		st.Misses += sh.misses
+		st.Evictions += sh.evictions
+		st.Expirations += sh.expirations
+		sh.mu.Unlock()
+	}
+	return st
 }
 
-var cache struct {
-	sync.RWMutex
-	items map[string]*item
-	size  uint64
+// MemoryStats contains the statistics for a MemoryDriver.
+type MemoryStats struct {
+	// Number of items currently stored.
+	Items int
+	// Total size of the stored items, in bytes.
+	Size uint64
+	// Number of successful lookups.
+	Hits uint64
+	// Number of lookups for items which were not
+	// found or had expired.
+	Misses uint64
+	// Number of items removed to make room for new ones.
+	Evictions uint64
+	// Number of items removed because they expired.
+	Expirations uint64
 }
 
+// MemoryDriver implements an in-process cache. Items are distributed
+// among several shards, each one with its own lock, and evicted in
+// LRU order when the cache exceeds its maximum size. Expired items are
+// periodically removed from each shard when writing to it, so drivers
+// don't start any goroutines and it's safe to not close them.
+//
+// Each MemoryDriver has its own storage, unless it's opened with a
+// name (e.g. memory://mycache), in which case all the drivers opened
+// with the same name share their items.
 type MemoryDriver struct {
-	maxSize uint64
-	prune   chan struct{}
-	mu      sync.Mutex
+	store *memoryStore
 }
 
 func (d *MemoryDriver) Set(key string, b []byte, timeout int) error {
-	var expires int64
-	if timeout != 0 {
-		expires = time.Now().Unix() + int64(timeout)
-	}
-	prevSize := uint64(0)
-	cache.Lock()
-	if prev := cache.items[key]; prev != nil {
-		prevSize = uint64(len(prev.data))
-	}
-	cache.items[key] = &item{
-		data:    b,
-		expires: expires,
-	}
-	cache.size += uint64(len(b)) - prevSize
-	if d.maxSize > 0 && cache.size > d.maxSize {
-		d.mu.Lock()
-		// Unlock before sending over the channel,
-		// otherwise we might cause a deadlock since
-		// the pruneWorker might be waiting for the
-		// cache lock to be released while the send
-		// might be blocking waiting for the pruneWorker.
-		cache.Unlock()
-		d.prune <- struct{}{}
-		d.mu.Unlock()
-		return nil
-	}
-	cache.Unlock()
+	d.store.set(key, b, memoryExpiration(timeout), false)
 	return nil
 }
 
+func (d *MemoryDriver) Add(key string, b []byte, timeout int) (bool, error) {
+	return d.store.set(key, b, memoryExpiration(timeout), true), nil
+}
+
 func (d *MemoryDriver) Get(key string) ([]byte, error) {
-	cache.RLock()
-	item := cache.items[key]
-	cache.RUnlock()
-	if item == nil {
-		return nil, nil
-	}
-	if item.expires != 0 && item.expires < time.Now().Unix() {
-		d.deleteItem(key, item)
-		return nil, nil
-	}
-	return item.data, nil
+	sh := d.store.shard(key)
+	sh.mu.Lock()
+	data := sh.get(key)
+	sh.mu.Unlock()
+	return data, nil
 }
 
 func (d *MemoryDriver) GetMulti(keys []string) (map[string][]byte, error) {
-	items := make(map[string]*item, len(keys))
-	cache.RLock()
-	for _, v := range keys {
-		items[v] = cache.items[v]
+	results := make(map[string][]byte, len(keys))
+	for _, k := range keys {
+		sh := d.store.shard(k)
+		sh.mu.Lock()
+		if data := sh.get(k); data != nil {
+			results[k] = data
+		}
+		sh.mu.Unlock()
+	}
+	return results, nil
+}
+
+func (d *MemoryDriver) GetTTL(key string) ([]byte, int, error) {
+	sh := d.store.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if item := sh.lookup(key); item != nil {
+		return item.data, item.ttl(time.Now().Unix()), nil
 	}
-	cache.RUnlock()
+	return nil, 0, nil
+}
+
+func (d *MemoryDriver) GetMultiTTL(keys []string) (map[string][]byte, map[string]int, error) {
 	results := make(map[string][]byte, len(keys))
+	ttls := make(map[string]int, len(keys))
 	now := time.Now().Unix()
-	for k, v := range items {
-		if v != nil {
-			if v.expires != 0 && v.expires < now {
-				d.deleteItem(k, v)
-				continue
-			}
-			results[k] = v.data
+	for _, k := range keys {
+		sh := d.store.shard(k)
+		sh.mu.Lock()
+		if item := sh.lookup(k); item != nil {
+			results[k] = item.data
+			ttls[k] = item.ttl(now)
 		}
+		sh.mu.Unlock()
 	}
-	return results, nil
+	return results, ttls, nil
 }
 
 func (d *MemoryDriver) Delete(key string) error {
-	cache.RLock()
-	item := cache.items[key]
-	cache.RUnlock()
-	if item == nil {
-		return nil
-	}
-	d.deleteItem(key, item)
+	d.store.delete(key)
 	return nil
 }
 
-func (d *MemoryDriver) deleteItem(key string, i *item) {
-	cache.Lock()
-	delete(cache.items, key)
-	cache.size -= uint64(len(i.data))
-	cache.Unlock()
+func (d *MemoryDriver) CompareAndDelete(key string, b []byte) (bool, error) {
+	return d.store.compareAndDelete(key, b), nil
 }
 
+// Close releases the driver storage. For named drivers, the storage
+// is released when all the drivers sharing it have been closed.
 func (d *MemoryDriver) Close() error {
-	if d.prune != nil {
-		d.mu.Lock()
-		defer d.mu.Unlock()
-		close(d.prune)
-		d.prune = nil
+	if d.store == nil {
+		return nil
+	}
+	if d.store.name != "" {
+		memoryStores.Lock()
+		d.store.refs--
+		if d.store.refs == 0 {
+			delete(memoryStores.stores, d.store.name)
+		}
+		memoryStores.Unlock()
 	}
+	d.store = nil
 	return nil
 }
 
@@ -157,58 +388,69 @@ func (d *MemoryDriver) Connection() interface{} {
 }
 
 func (d *MemoryDriver) Flush() error {
-	cache.Lock()
-	defer cache.Unlock()
-	cache.size = 0
-	cache.items = make(map[string]*item)
+	d.store.flush()
 	return nil
 }
 
-func (d *MemoryDriver) pruneWorker(ch <-chan struct{}) {
-	for _ = range ch {
-		d.pruneCache()
-	}
+// Stats returns the current statistics for the driver. Note that
+// named drivers sharing their storage also share their statistics.
+func (d *MemoryDriver) Stats() *MemoryStats {
+	return d.store.stats()
 }
 
-func (d *MemoryDriver) pruneCache() {
-	cache.Lock()
-	defer cache.Unlock()
-	if cache.size < d.maxSize {
-		return
-	}
-	items := make([]*keyedItem, 0, len(cache.items))
-	for k, v := range cache.items {
-		items = append(items, &keyedItem{
-			key:  k,
-			item: v,
-		})
-	}
-	sort.Sort(byExpirationAndSize(items))
-	threshold := uint64(float64(d.maxSize) * 0.9)
-	for _, v := range items {
-		delete(cache.items, v.key)
-		cache.size -= uint64(len(v.item.data))
-		if cache.size < threshold {
-			break
-		}
+func memoryExpiration(timeout int) int64 {
+	if timeout != 0 {
+		return time.Now().Unix() + int64(timeout)
 	}
+	return 0
 }
 
 func openMemoryDriver(url *config.URL) (Driver, error) {
-	mdrv := &MemoryDriver{}
+	var maxSize uint64
 	if ms := url.Fragment.Get("max_size"); ms != "" {
-		maxSize, err := parseutil.Size(ms)
+		var err error
+		maxSize, err = parseutil.Size(ms)
 		if err != nil {
 			return nil, fmt.Errorf("invalid max_size %q", ms)
 		}
-		mdrv.maxSize = maxSize
-		mdrv.prune = make(chan struct{}, runtime.GOMAXPROCS(0))
-		go mdrv.pruneWorker(mdrv.prune)
 	}
-	return mdrv, nil
+	var shards int
+	if s := url.Fragment.Get("shards"); s != "" {
+		var err error
+		shards, err = strconv.Atoi(s)
+		if err != nil || shards <= 0 || shards&(shards-1) != 0 {
+			return nil, fmt.Errorf("invalid shards %q, must be a power of 2", s)
+		}
+		// Each shard gets an equal part of max_size, so
+		// shards with no room at all would be unbounded.
+		if maxSize > 0 && maxSize/uint64(shards) == 0 {
+			return nil, fmt.Errorf("invalid shards %d, can't be greater than max_size (%d)", shards, maxSize)
+		}
+	}
+	sweep := defaultSweepInterval
+	if s := url.Fragment.Get("sweep"); s != "" {
+		var err error
+		sweep, err = time.ParseDuration(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sweep interval %q: %s", s, err)
+		}
+	}
+	name := url.Value
+	if name == "" {
+		return &MemoryDriver{store: newMemoryStore("", maxSize, shards, sweep)}, nil
+	}
+	memoryStores.Lock()
+	defer memoryStores.Unlock()
+	store := memoryStores.stores[name]
+	if store == nil {
+		store = newMemoryStore(name, maxSize, shards, sweep)
+		memoryStores.stores[name] = store
+	}
+	store.refs++
+	return &MemoryDriver{store: store}, nil
 }
 
 func init() {
-	cache.items = make(map[string]*item)
+	memoryStores.stores = make(map[string]*memoryStore)
 	Register("memory", openMemoryDriver)
 }
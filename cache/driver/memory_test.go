@@ -0,0 +1,350 @@
+package driver
+
+import (
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gnd.la/config"
+)
+
+func newTestMemoryDriver(t testing.TB, url string) *MemoryDriver {
+	drv, err := openMemoryDriver(config.MustParseURL(url))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return drv.(*MemoryDriver)
+}
+
+func TestMemoryLRU(t *testing.T) {
+	drv := newTestMemoryDriver(t, "memory://#max_size=100")
+	defer drv.Close()
+	for ii := 0; ii < 10; ii++ {
+		drv.Set(strconv.Itoa(ii), make([]byte, 10), 0)
+	}
+	// Touch 0, so 1 is evicted first
+	if b, _ := drv.Get("0"); b == nil {
+		t.Fatal("0 not found")
+	}
+	drv.Set("10", make([]byte, 10), 0)
+	if b, _ := drv.Get("1"); b != nil {
+		t.Error("1 was not evicted")
+	}
+	if b, _ := drv.Get("0"); b == nil {
+		t.Error("0 was evicted")
+	}
+	// Items bigger than the cache are not stored
+	drv.Set("big", make([]byte, 101), 0)
+	if b, _ := drv.Get("big"); b != nil {
+		t.Error("item bigger than max_size was stored")
+	}
+	st := drv.Stats()
+	if st.Items != 10 || st.Size != 100 {
+		t.Errorf("expecting 10 items with size 100, got %d items with size %d", st.Items, st.Size)
+	}
+	if st.Evictions != 1 {
+		t.Errorf("expecting 1 eviction, got %d", st.Evictions)
+	}
+	if st.Hits != 2 || st.Misses != 2 {
+		t.Errorf("expecting 2 hits and 2 misses, got %d hits and %d misses", st.Hits, st.Misses)
+	}
+}
+
+func TestMemorySweep(t *testing.T) {
+	drv := newTestMemoryDriver(t, "memory://#sweep=0")
+	defer drv.Close()
+	past := time.Now().Unix() - 10
+	for ii := 0; ii < 10; ii++ {
+		drv.store.set(strconv.Itoa(ii), []byte{1}, past, false)
+	}
+	drv.Set("keep", []byte{1}, 0)
+	drv.store.sweepAll()
+	st := drv.Stats()
+	if st.Items != 1 {
+		t.Errorf("expecting 1 item after sweeping, got %d", st.Items)
+	}
+	if st.Expirations != 10 {
+		t.Errorf("expecting 10 expirations, got %d", st.Expirations)
+	}
+}
+
+func TestMemoryLazySweep(t *testing.T) {
+	drv := newTestMemoryDriver(t, "memory://#shards=1&sweep=1ms")
+	past := time.Now().Unix() - 10
+	for ii := 0; ii < 10; ii++ {
+		drv.store.set(strconv.Itoa(ii), []byte{1}, past, false)
+	}
+	time.Sleep(2 * time.Millisecond)
+	drv.Set("keep", []byte{1}, 0)
+	st := drv.Stats()
+	if st.Items != 1 {
+		t.Errorf("expecting 1 item after writing, got %d", st.Items)
+	}
+	if st.Expirations != 10 {
+		t.Errorf("expecting 10 expirations, got %d", st.Expirations)
+	}
+}
+
+func TestMemoryNoGoroutines(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for ii := 0; ii < 100; ii++ {
+		// Not closed on purpose, like caches opened
+		// for each request.
+		newTestMemoryDriver(t, "memory://")
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("opening memory drivers started %d goroutines", after-before)
+	}
+}
+
+func TestMemoryShards(t *testing.T) {
+	drv := newTestMemoryDriver(t, "memory://")
+	if n := len(drv.store.shards); n != defaultMemoryShards {
+		t.Errorf("expecting %d shards, got %d", defaultMemoryShards, n)
+	}
+	drv.Close()
+	drv = newTestMemoryDriver(t, "memory://#max_size=1K")
+	if n := len(drv.store.shards); n != 1 {
+		t.Errorf("expecting 1 shard for small cache, got %d", n)
+	}
+	drv.Close()
+	if _, err := openMemoryDriver(config.MustParseURL("memory://#shards=3")); err == nil {
+		t.Error("expecting an error with 3 shards")
+	}
+	if _, err := openMemoryDriver(config.MustParseURL("memory://#max_size=8&shards=16")); err == nil {
+		t.Error("expecting an error with more shards than max_size")
+	}
+	drv = newTestMemoryDriver(t, "memory://#max_size=64&shards=16")
+	defer drv.Close()
+	for ii := 0; ii < 100; ii++ {
+		drv.Set(strconv.Itoa(ii), make([]byte, 4), 0)
+	}
+	if st := drv.Stats(); st.Size > 64 {
+		t.Errorf("expecting size <= 64 with explicit shards, got %d", st.Size)
+	}
+}
+
+func TestMemoryNamespaces(t *testing.T) {
+	d1 := newTestMemoryDriver(t, "memory://shared")
+	d2 := newTestMemoryDriver(t, "memory://shared")
+	d1.Set("k", []byte{1}, 0)
+	if b, _ := d2.Get("k"); b == nil {
+		t.Error("drivers with the same name should share items")
+	}
+	d1.Close()
+	if b, _ := d2.Get("k"); b == nil {
+		t.Error("closing a named driver released its shared storage")
+	}
+	d2.Close()
+	d3 := newTestMemoryDriver(t, "memory://shared")
+	defer d3.Close()
+	if b, _ := d3.Get("k"); b != nil {
+		t.Error("storage was not released after closing all drivers")
+	}
+}
+
+// legacyMemoryDriver is a copy of the previous memory driver, which
+// used a single map protected by a sync.RWMutex and, when max_size
+// was exceeded, a goroutine which sorted all the items by expiration
+// and size to prune them. It's kept for comparing its performance
+// with MemoryDriver.
+type legacyMemoryDriver struct {
+	sync.RWMutex
+	items   map[string]*legacyItem
+	size    uint64
+	maxSize uint64
+	prune   chan struct{}
+	mu      sync.Mutex
+}
+
+type legacyItem struct {
+	data    []byte
+	expires int64
+}
+
+type legacyKeyedItem struct {
+	key  string
+	item *legacyItem
+}
+
+type legacyByExpirationAndSize []*legacyKeyedItem
+
+func (e legacyByExpirationAndSize) Len() int {
+	return len(e)
+}
+
+func (e legacyByExpirationAndSize) Less(i, j int) bool {
+	ei := e[i].item.expires
+	ej := e[j].item.expires
+	if ei != 0 && ej != 0 && ei != ej {
+		return ei < ej
+	}
+	if ei != 0 && ej == 0 {
+		return true
+	}
+	if ei == 0 && ej != 0 {
+		return false
+	}
+	return len(e[i].item.data) > len(e[j].item.data)
+}
+
+func (e legacyByExpirationAndSize) Swap(i, j int) {
+	e[i], e[j] = e[j], e[i]
+}
+
+func newLegacyMemoryDriver(maxSize uint64) *legacyMemoryDriver {
+	d := &legacyMemoryDriver{items: make(map[string]*legacyItem), maxSize: maxSize}
+	if maxSize > 0 {
+		d.prune = make(chan struct{}, runtime.GOMAXPROCS(0))
+		go d.pruneWorker(d.prune)
+	}
+	return d
+}
+
+func (d *legacyMemoryDriver) Set(key string, b []byte, timeout int) error {
+	var expires int64
+	if timeout != 0 {
+		expires = time.Now().Unix() + int64(timeout)
+	}
+	d.Lock()
+	if prev := d.items[key]; prev != nil {
+		d.size -= uint64(len(prev.data))
+	}
+	d.items[key] = &legacyItem{data: b, expires: expires}
+	d.size += uint64(len(b))
+	if d.maxSize > 0 && d.size > d.maxSize {
+		d.mu.Lock()
+		d.Unlock()
+		d.prune <- struct{}{}
+		d.mu.Unlock()
+		return nil
+	}
+	d.Unlock()
+	return nil
+}
+
+func (d *legacyMemoryDriver) Close() error {
+	if d.prune != nil {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		close(d.prune)
+		d.prune = nil
+	}
+	return nil
+}
+
+func (d *legacyMemoryDriver) pruneWorker(ch <-chan struct{}) {
+	for range ch {
+		d.pruneCache()
+	}
+}
+
+func (d *legacyMemoryDriver) pruneCache() {
+	d.Lock()
+	defer d.Unlock()
+	if d.size < d.maxSize {
+		return
+	}
+	items := make([]*legacyKeyedItem, 0, len(d.items))
+	for k, v := range d.items {
+		items = append(items, &legacyKeyedItem{key: k, item: v})
+	}
+	sort.Sort(legacyByExpirationAndSize(items))
+	threshold := uint64(float64(d.maxSize) * 0.9)
+	for _, v := range items {
+		delete(d.items, v.key)
+		d.size -= uint64(len(v.item.data))
+		if d.size < threshold {
+			break
+		}
+	}
+}
+
+func (d *legacyMemoryDriver) Get(key string) ([]byte, error) {
+	d.RLock()
+	item := d.items[key]
+	d.RUnlock()
+	if item == nil {
+		return nil, nil
+	}
+	if item.expires != 0 && item.expires < time.Now().Unix() {
+		d.Lock()
+		delete(d.items, key)
+		d.size -= uint64(len(item.data))
+		d.Unlock()
+		return nil, nil
+	}
+	return item.data, nil
+}
+
+type getSetter interface {
+	Set(key string, b []byte, timeout int) error
+	Get(key string) ([]byte, error)
+}
+
+const benchmarkKeys = 1 << 12
+
+var benchmarkData = make([]byte, 128)
+
+func benchmarkMemory(b *testing.B, drv getSetter, setEvery int) {
+	keys := make([]string, benchmarkKeys)
+	for ii := range keys {
+		keys[ii] = "key-" + strconv.Itoa(ii)
+		drv.Set(keys[ii], benchmarkData, 0)
+	}
+	var seed uint32
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		n := int(atomic.AddUint32(&seed, 7919))
+		for pb.Next() {
+			n++
+			key := keys[n&(benchmarkKeys-1)]
+			if n%setEvery == 0 {
+				drv.Set(key, benchmarkData, 0)
+			} else {
+				drv.Get(key)
+			}
+		}
+	})
+}
+
+// benchmarkMaxSize is the max_size used by the benchmarks with
+// a size limit, half of the size of all the benchmark items, so
+// the drivers need to evict items while running them.
+var benchmarkMaxSize = benchmarkKeys * uint64(len(benchmarkData)) / 2
+
+func benchmarkMemoryDriver(b *testing.B, setEvery int, maxSize uint64) {
+	url := "memory://"
+	if maxSize > 0 {
+		url += "#max_size=" + strconv.FormatUint(maxSize, 10)
+	}
+	drv := newTestMemoryDriver(b, url)
+	defer drv.Close()
+	benchmarkMemory(b, drv, setEvery)
+}
+
+func benchmarkLegacyMemoryDriver(b *testing.B, setEvery int, maxSize uint64) {
+	drv := newLegacyMemoryDriver(maxSize)
+	defer drv.Close()
+	benchmarkMemory(b, drv, setEvery)
+}
+
+func BenchmarkMemoryRead(b *testing.B)        { benchmarkMemoryDriver(b, 100, 0) }
+func BenchmarkMemoryMixed(b *testing.B)       { benchmarkMemoryDriver(b, 4, 0) }
+func BenchmarkMemoryWrite(b *testing.B)       { benchmarkMemoryDriver(b, 1, 0) }
+func BenchmarkLegacyMemoryRead(b *testing.B)  { benchmarkLegacyMemoryDriver(b, 100, 0) }
+func BenchmarkLegacyMemoryMixed(b *testing.B) { benchmarkLegacyMemoryDriver(b, 4, 0) }
+func BenchmarkLegacyMemoryWrite(b *testing.B) { benchmarkLegacyMemoryDriver(b, 1, 0) }
+
+func BenchmarkMemoryMixedMaxSize(b *testing.B) { benchmarkMemoryDriver(b, 4, benchmarkMaxSize) }
+func BenchmarkMemoryWriteMaxSize(b *testing.B) { benchmarkMemoryDriver(b, 1, benchmarkMaxSize) }
+func BenchmarkLegacyMemoryMixedMaxSize(b *testing.B) {
+	benchmarkLegacyMemoryDriver(b, 4, benchmarkMaxSize)
+}
+func BenchmarkLegacyMemoryWriteMaxSize(b *testing.B) {
+	benchmarkLegacyMemoryDriver(b, 1, benchmarkMaxSize)
+}
@@ -7,10 +7,19 @@
 // If no db is provided, it defaults to -1.
 // For the defaults and the explanation for the rest of the parameters,
 // see DefaultMaxIdle, DefaultMaxActive and DefaultIdleTimeout.
+//
+// This driver implements gnd.la/cache/driver.Adder,
+// gnd.la/cache/driver.CompareDeleter, gnd.la/cache/driver.Notifier
+// and gnd.la/cache/driver.TTLGetter,
+// so it can be used as the L2 of a tiered driver with invalidations
+// (see gnd.la/cache/driver).
 package redis
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"sync"
 	"time"
 
 	"gnd.la/cache/driver"
@@ -30,6 +39,22 @@ const (
 	// DefaultIdleTimeout is the amount of seconds after an idle
 	// connection will be dropped from the pool.
 	DefaultIdleTimeout = 300
+
+	// reconnectInterval is the time waited between reconnection
+	// attempts by subscriptions.
+	reconnectInterval = time.Second
+)
+
+var (
+	errClosed = errors.New("subscription closed")
+
+	// compareAndDeleteScript removes KEYS[1] if its value is ARGV[1].
+	compareAndDeleteScript = redis.NewScript(1, `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
 )
 
 type redisDriver struct {
@@ -48,6 +73,21 @@ func (r *redisDriver) Set(key string, b []byte, timeout int) error {
 	return err
 }
 
+func (r *redisDriver) Add(key string, b []byte, timeout int) (bool, error) {
+	conn := r.pool.Get()
+	var reply interface{}
+	var err error
+	if timeout == 0 {
+		reply, err = conn.Do("SET", key, b, "NX")
+	} else {
+		reply, err = conn.Do("SET", key, b, "EX", int32(timeout), "NX")
+	}
+	conn.Close()
+	// SET with NX returns a nil reply when the
+	// key was already present.
+	return err == nil && reply != nil, err
+}
+
 func (r *redisDriver) Get(key string) ([]byte, error) {
 	conn := r.pool.Get()
 	reply, err := conn.Do("GET", key)
@@ -93,6 +133,49 @@ func (r *redisDriver) GetMulti(keys []string) (map[string][]byte, error) {
 	return ret, nil
 }
 
+func (r *redisDriver) GetTTL(key string) ([]byte, int, error) {
+	data, ttls, err := r.GetMultiTTL([]string{key})
+	if err != nil {
+		return nil, 0, err
+	}
+	return data[key], ttls[key], nil
+}
+
+func (r *redisDriver) GetMultiTTL(keys []string) (map[string][]byte, map[string]int, error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+	// Use a transaction, so values and TTLs are consistent
+	conn.Send("MULTI")
+	for _, k := range keys {
+		conn.Send("GET", k)
+		conn.Send("TTL", k)
+	}
+	values, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return nil, nil, err
+	}
+	data := make(map[string][]byte, len(keys))
+	ttls := make(map[string]int, len(keys))
+	for ii, k := range keys {
+		b, ok := values[ii*2].([]byte)
+		if !ok {
+			// Not present
+			continue
+		}
+		ttl, err := redis.Int(values[ii*2+1], nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ttl == -2 {
+			// Expired between GET and TTL
+			continue
+		}
+		data[k] = b
+		ttls[k] = ttl
+	}
+	return data, ttls, nil
+}
+
 func (r *redisDriver) Delete(key string) error {
 	conn := r.pool.Get()
 	_, err := conn.Do("DEL", key)
@@ -100,6 +183,30 @@ func (r *redisDriver) Delete(key string) error {
 	return err
 }
 
+func (r *redisDriver) CompareAndDelete(key string, b []byte) (bool, error) {
+	conn := r.pool.Get()
+	deleted, err := redis.Bool(compareAndDeleteScript.Do(conn, key, b))
+	conn.Close()
+	return deleted, err
+}
+
+func (r *redisDriver) Publish(channel string, message []byte) error {
+	conn := r.pool.Get()
+	_, err := conn.Do("PUBLISH", channel, message)
+	conn.Close()
+	return err
+}
+
+func (r *redisDriver) Subscribe(channel string, fn func(message []byte)) (io.Closer, error) {
+	s := &subscription{pool: r.pool, channel: channel, fn: fn}
+	conn, err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	go s.receive(conn)
+	return s, nil
+}
+
 func (r *redisDriver) Connection() interface{} {
 	return r.pool
 }
@@ -115,6 +222,69 @@ func (r *redisDriver) Flush() error {
 	return err
 }
 
+// subscription receives the messages for a channel using
+// its own connection, reconnecting when it's lost.
+type subscription struct {
+	pool    *redis.Pool
+	channel string
+	fn      func(message []byte)
+	mu      sync.Mutex
+	conn    *redis.PubSubConn
+	closed  bool
+}
+
+func (s *subscription) connect() (*redis.PubSubConn, error) {
+	conn := &redis.PubSubConn{Conn: s.pool.Get()}
+	if err := conn.Subscribe(s.channel); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		conn.Close()
+		return nil, errClosed
+	}
+	s.conn = conn
+	return conn, nil
+}
+
+func (s *subscription) receive(conn *redis.PubSubConn) {
+	for {
+		switch v := conn.Receive().(type) {
+		case redis.Message:
+			s.fn(v.Data)
+		case error:
+			conn.Close()
+			for {
+				s.mu.Lock()
+				closed := s.closed
+				s.mu.Unlock()
+				if closed {
+					return
+				}
+				var err error
+				if conn, err = s.connect(); err == nil {
+					break
+				}
+				time.Sleep(reconnectInterval)
+			}
+		}
+	}
+}
+
+func (s *subscription) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	// Closing the connection makes Receive return
+	// an error, stopping the receive goroutine.
+	return s.conn.Close()
+}
+
 func redisOpener(url *config.URL) (driver.Driver, error) {
 	password := url.Fragment.Get("password")
 	db := -1
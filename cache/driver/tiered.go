@@ -0,0 +1,312 @@
+package driver
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gnd.la/config"
+	"gnd.la/log"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// DefaultTieredTTL is the default maximum number of seconds
+	// an item is kept in the L1 cache of a tiered driver.
+	DefaultTieredTTL = 5
+	// DefaultTieredChannel is the default channel used for
+	// broadcasting invalidations between tiered drivers.
+	DefaultTieredChannel = "gnd.la/cache/tiered"
+
+	tieredIdLen  = 16
+	tieredDelete = 'd'
+	tieredFlush  = 'f'
+)
+
+// Notifier is implemented by drivers which can broadcast messages
+// to all the processes connected to the same backend (e.g. redis
+// using its pub/sub support).
+type Notifier interface {
+	// Publish sends the message to all the subscribers of the
+	// given channel.
+	Publish(channel string, message []byte) error
+	// Subscribe calls fn with every message published to the given
+	// channel until the returned io.Closer is closed. Drivers should
+	// transparently reconnect when the connection to the backend
+	// is lost.
+	Subscribe(channel string, fn func(message []byte)) (io.Closer, error)
+}
+
+// TieredDriver combines a local cache (L1), usually an in-process
+// one, with a remote cache (L2). Values are retrieved from L1 when
+// present and from L2 otherwise, storing them in L1 for a short time
+// to avoid roundtrips to L2 for the most frequently requested items.
+//
+// When L2 implements Notifier, writes and deletions are broadcast to
+// the rest of the TieredDrivers using the same L2, so they remove the
+// affected items from their L1. Otherwise, items in L1 might be stale
+// for up to the L1 TTL.
+//
+// When L2 implements TTLGetter, items retrieved from it are kept in
+// L1 for at most their remaining lifetime in L2. Otherwise, items
+// might be served from L1 for up to the L1 TTL after they've expired
+// in L2.
+type TieredDriver struct {
+	l1      Driver
+	l2      Driver
+	ttl     int
+	id      string
+	channel string
+	sub     io.Closer
+}
+
+func (d *TieredDriver) l1Timeout(timeout int) int {
+	if timeout > 0 && timeout < d.ttl {
+		return timeout
+	}
+	return d.ttl
+}
+
+// setL1 stores an item retrieved from L2 in L1. ttl is its remaining
+// lifetime in L2, as returned by TTLGetter. Items about to expire
+// are not stored.
+func (d *TieredDriver) setL1(key string, b []byte, ttl int) {
+	if ttl < 0 {
+		d.l1.Set(key, b, d.ttl)
+	} else if ttl > 0 {
+		d.l1.Set(key, b, d.l1Timeout(ttl))
+	}
+}
+
+func (d *TieredDriver) Set(key string, b []byte, timeout int) error {
+	if err := d.l2.Set(key, b, timeout); err != nil {
+		return err
+	}
+	d.l1.Set(key, b, d.l1Timeout(timeout))
+	d.notify(tieredDelete, key)
+	return nil
+}
+
+// Add implements the Adder interface by calling Add on the
+// L2 driver. If the L2 driver doesn't implement Adder,
+// ErrNotImplemented is returned.
+func (d *TieredDriver) Add(key string, b []byte, timeout int) (bool, error) {
+	adder, ok := d.l2.(Adder)
+	if !ok {
+		return false, ErrNotImplemented
+	}
+	added, err := adder.Add(key, b, timeout)
+	if added {
+		d.l1.Delete(key)
+		d.notify(tieredDelete, key)
+	}
+	return added, err
+}
+
+// CompareAndDelete implements the CompareDeleter interface by calling
+// CompareAndDelete on the L2 driver. If the L2 driver doesn't implement
+// CompareDeleter, ErrNotImplemented is returned.
+func (d *TieredDriver) CompareAndDelete(key string, b []byte) (bool, error) {
+	cd, ok := d.l2.(CompareDeleter)
+	if !ok {
+		return false, ErrNotImplemented
+	}
+	deleted, err := cd.CompareAndDelete(key, b)
+	if deleted {
+		d.l1.Delete(key)
+		d.notify(tieredDelete, key)
+	}
+	return deleted, err
+}
+
+func (d *TieredDriver) Get(key string) ([]byte, error) {
+	if b, _ := d.l1.Get(key); b != nil {
+		return b, nil
+	}
+	var b []byte
+	var err error
+	ttl := -1
+	if g, ok := d.l2.(TTLGetter); ok {
+		b, ttl, err = g.GetTTL(key)
+	} else {
+		b, err = d.l2.Get(key)
+	}
+	if err != nil || b == nil {
+		return nil, err
+	}
+	d.setL1(key, b, ttl)
+	return b, nil
+}
+
+func (d *TieredDriver) GetMulti(keys []string) (map[string][]byte, error) {
+	results, _ := d.l1.GetMulti(keys)
+	if len(results) == len(keys) {
+		return results, nil
+	}
+	if results == nil {
+		results = make(map[string][]byte, len(keys))
+	}
+	missing := make([]string, 0, len(keys)-len(results))
+	for _, k := range keys {
+		if _, found := results[k]; !found {
+			missing = append(missing, k)
+		}
+	}
+	var remote map[string][]byte
+	var ttls map[string]int
+	var err error
+	if g, ok := d.l2.(TTLGetter); ok {
+		remote, ttls, err = g.GetMultiTTL(missing)
+	} else {
+		remote, err = d.l2.GetMulti(missing)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range remote {
+		ttl := -1
+		if ttls != nil {
+			ttl = ttls[k]
+		}
+		d.setL1(k, v, ttl)
+		results[k] = v
+	}
+	return results, nil
+}
+
+func (d *TieredDriver) Delete(key string) error {
+	d.l1.Delete(key)
+	err := d.l2.Delete(key)
+	d.notify(tieredDelete, key)
+	return err
+}
+
+func (d *TieredDriver) Close() error {
+	if d.sub != nil {
+		d.sub.Close()
+	}
+	err1 := d.l1.Close()
+	err2 := d.l2.Close()
+	if err2 != nil {
+		return err2
+	}
+	return err1
+}
+
+// Connection returns the connection of the L2 driver.
+func (d *TieredDriver) Connection() interface{} {
+	return d.l2.Connection()
+}
+
+func (d *TieredDriver) Flush() error {
+	d.l1.Flush()
+	err := d.l2.Flush()
+	d.notify(tieredFlush, "")
+	return err
+}
+
+// L1 returns the local driver.
+func (d *TieredDriver) L1() Driver {
+	return d.l1
+}
+
+// L2 returns the remote driver.
+func (d *TieredDriver) L2() Driver {
+	return d.l2
+}
+
+// notify broadcasts the given operation to the other drivers.
+// Messages contain the operation, the id of the sender and
+// the key.
+func (d *TieredDriver) notify(op byte, key string) {
+	if d.sub == nil {
+		return
+	}
+	msg := make([]byte, 0, 1+tieredIdLen+len(key))
+	msg = append(msg, op)
+	msg = append(msg, d.id...)
+	msg = append(msg, key...)
+	if err := d.l2.(Notifier).Publish(d.channel, msg); err != nil {
+		log.Errorf("error publishing cache invalidation: %s", err)
+	}
+}
+
+func (d *TieredDriver) received(msg []byte) {
+	if len(msg) < 1+tieredIdLen || string(msg[1:1+tieredIdLen]) == d.id {
+		// Invalid or sent by us
+		return
+	}
+	switch msg[0] {
+	case tieredDelete:
+		d.l1.Delete(string(msg[1+tieredIdLen:]))
+	case tieredFlush:
+		d.l1.Flush()
+	}
+}
+
+func openTieredDriverURL(name string, s string, def string) (Driver, error) {
+	if s == "" {
+		s = def
+	}
+	if s == "" {
+		return nil, fmt.Errorf("missing %s driver", name)
+	}
+	if !strings.Contains(s, "://") {
+		s += "://"
+	}
+	u, err := config.ParseURL(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s driver %q: %s", name, s, err)
+	}
+	if u.Scheme == "tiered" {
+		return nil, fmt.Errorf("%s driver can't be tiered", name)
+	}
+	opener := Get(u.Scheme)
+	if opener == nil {
+		return nil, fmt.Errorf("unknown %s driver %q, maybe you forgot an import?", name, u.Scheme)
+	}
+	return opener(u)
+}
+
+func openTieredDriver(url *config.URL) (Driver, error) {
+	ttl := DefaultTieredTTL
+	if s := url.Fragment.Get("ttl"); s != "" {
+		val, ok := url.Fragment.Int("ttl")
+		if !ok || val <= 0 {
+			return nil, fmt.Errorf("invalid ttl %q, must be a positive number of seconds", s)
+		}
+		ttl = val
+	}
+	l1, err := openTieredDriverURL("l1", url.Query.Get("l1"), "memory")
+	if err != nil {
+		return nil, err
+	}
+	l2, err := openTieredDriverURL("l2", url.Query.Get("l2"), "")
+	if err != nil {
+		l1.Close()
+		return nil, err
+	}
+	d := &TieredDriver{
+		l1:      l1,
+		l2:      l2,
+		ttl:     ttl,
+		id:      stringutil.Random(tieredIdLen),
+		channel: url.Fragment.Get("channel"),
+	}
+	if d.channel == "" {
+		d.channel = DefaultTieredChannel
+	}
+	if n, ok := l2.(Notifier); ok {
+		sub, err := n.Subscribe(d.channel, d.received)
+		if err != nil {
+			d.Close()
+			return nil, fmt.Errorf("error subscribing to invalidations: %s", err)
+		}
+		d.sub = sub
+	}
+	return d, nil
+}
+
+func init() {
+	Register("tiered", openTieredDriver)
+}
@@ -0,0 +1,177 @@
+package driver
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"gnd.la/config"
+)
+
+// notifierDriver wraps a MemoryDriver, adding an
+// in-process implementation of Notifier.
+type notifierDriver struct {
+	*MemoryDriver
+}
+
+var notifications struct {
+	sync.Mutex
+	subs map[*notifierSub]struct{}
+}
+
+type notifierSub struct {
+	channel string
+	fn      func([]byte)
+}
+
+func (s *notifierSub) Close() error {
+	notifications.Lock()
+	delete(notifications.subs, s)
+	notifications.Unlock()
+	return nil
+}
+
+func (d *notifierDriver) Publish(channel string, message []byte) error {
+	notifications.Lock()
+	defer notifications.Unlock()
+	for s := range notifications.subs {
+		if s.channel == channel {
+			s.fn(message)
+		}
+	}
+	return nil
+}
+
+func (d *notifierDriver) Subscribe(channel string, fn func([]byte)) (io.Closer, error) {
+	s := &notifierSub{channel: channel, fn: fn}
+	notifications.Lock()
+	notifications.subs[s] = struct{}{}
+	notifications.Unlock()
+	return s, nil
+}
+
+func openTestTiered(t *testing.T, url string) *TieredDriver {
+	drv, err := openTieredDriver(config.MustParseURL(url))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return drv.(*TieredDriver)
+}
+
+func TestTiered(t *testing.T) {
+	d := openTestTiered(t, "tiered://?l2=memory://tiered-test")
+	defer d.Close()
+	if _, ok := d.L1().(*MemoryDriver); !ok {
+		t.Fatalf("expecting memory L1, got %T", d.L1())
+	}
+	l2 := d.L2()
+	l2.Set("k1", []byte("v1"), 0)
+	if b, _ := d.Get("k1"); string(b) != "v1" {
+		t.Errorf("expecting v1 from L2, got %q", string(b))
+	}
+	if b, _ := d.L1().Get("k1"); string(b) != "v1" {
+		t.Errorf("value from L2 was not stored in L1, got %q", string(b))
+	}
+	// L1 should be used until it expires
+	l2.Set("k1", []byte("v2"), 0)
+	if b, _ := d.Get("k1"); string(b) != "v1" {
+		t.Errorf("expecting v1 from L1, got %q", string(b))
+	}
+	d.Set("k2", []byte("v2"), 0)
+	if b, _ := l2.Get("k2"); string(b) != "v2" {
+		t.Errorf("value was not stored in L2, got %q", string(b))
+	}
+	l2.Set("k3", []byte("v3"), 0)
+	res, err := d.GetMulti([]string{"k1", "k2", "k3", "k4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 3 || string(res["k1"]) != "v1" || string(res["k2"]) != "v2" || string(res["k3"]) != "v3" {
+		t.Errorf("unexpected GetMulti results %q", res)
+	}
+	d.Delete("k2")
+	if b, _ := d.Get("k2"); b != nil {
+		t.Errorf("k2 was not deleted")
+	}
+}
+
+func TestTieredInvalidation(t *testing.T) {
+	notifications.subs = make(map[*notifierSub]struct{})
+	Register("test-notifier", func(url *config.URL) (Driver, error) {
+		drv, err := openMemoryDriver(url)
+		if err != nil {
+			return nil, err
+		}
+		return &notifierDriver{drv.(*MemoryDriver)}, nil
+	})
+	d1 := openTestTiered(t, "tiered://?l2=test-notifier://invalidation-test")
+	defer d1.Close()
+	d2 := openTestTiered(t, "tiered://?l2=test-notifier://invalidation-test")
+	defer d2.Close()
+	d1.Set("k", []byte("v1"), 0)
+	if b, _ := d2.Get("k"); string(b) != "v1" {
+		t.Fatalf("expecting v1, got %q", string(b))
+	}
+	d1.Set("k", []byte("v2"), 0)
+	if b, _ := d2.Get("k"); string(b) != "v2" {
+		t.Errorf("L1 was not invalidated, got %q", string(b))
+	}
+	if b, _ := d1.L1().Get("k"); string(b) != "v2" {
+		t.Errorf("sender L1 should keep its own value, got %q", string(b))
+	}
+	d1.Flush()
+	if b, _ := d2.L1().Get("k"); b != nil {
+		t.Errorf("L1 was not flushed, got %q", string(b))
+	}
+}
+
+func TestTieredTTL(t *testing.T) {
+	d := openTestTiered(t, "tiered://?l2=memory://tiered-ttl-test#ttl=60")
+	defer d.Close()
+	l1 := d.L1().(*MemoryDriver)
+	l2 := d.L2()
+	now := time.Now().Unix()
+	l2.Set("expiring", []byte("v"), 2)
+	l2.Set("expired", []byte("v"), 0)
+	l2.(*MemoryDriver).store.set("expired", []byte("v"), now, false)
+	l2.Set("permanent", []byte("v"), 0)
+	// "expired" might have already expired in L2, so its
+	// value is not checked.
+	d.Get("expired")
+	for _, k := range []string{"expiring", "permanent"} {
+		if b, _ := d.Get(k); string(b) != "v" {
+			t.Errorf("expecting v for %s, got %q", k, string(b))
+		}
+	}
+	check := func() {
+		if _, ttl, _ := l1.GetTTL("expiring"); ttl < 0 || ttl > 2 {
+			t.Errorf("expecting L1 TTL <= 2 for item expiring in L2, got %d", ttl)
+		}
+		if b, _ := l1.Get("expired"); b != nil {
+			t.Error("item about to expire in L2 was stored in L1")
+		}
+		if _, ttl, _ := l1.GetTTL("permanent"); ttl < 59 || ttl > 60 {
+			t.Errorf("expecting L1 TTL 60 for permanent item, got %d", ttl)
+		}
+	}
+	check()
+	d.L1().Flush()
+	if _, err := d.GetMulti([]string{"expiring", "expired", "permanent"}); err != nil {
+		t.Fatal(err)
+	}
+	check()
+}
+
+func TestTieredErrors(t *testing.T) {
+	for _, v := range []string{
+		"tiered://",
+		"tiered://?l2=nonexistent://",
+		"tiered://?l2=tiered://",
+		"tiered://?l2=memory#ttl=0",
+	} {
+		if _, err := openTieredDriver(config.MustParseURL(v)); err == nil {
+			t.Errorf("expecting an error opening %s", v)
+		}
+	}
+}
@@ -5,17 +5,16 @@ import (
 	"errors"
 	"net/http"
 	"os"
+	"sync"
 
 	"gnd.la/app"
 	"gnd.la/cache"
-	"gnd.la/encoding/codec"
 	"gnd.la/internal"
 	"gnd.la/log"
 )
 
 var (
 	fromLayer     = []string{"true"}
-	layerCodec    = codec.Get("gob")
 	errNoCache    = errors.New("nil cache passed to cache layer")
 	errNoMediator = errors.New("nil mediator passed to cache layer")
 	noCacheLayer  = os.Getenv("GONDOLA_NO_CACHE_LAYER") != ""
@@ -32,6 +31,9 @@ type cachedResponse struct {
 type Layer struct {
 	cache    *cache.Cache
 	mediator Mediator
+	metrics  Metrics
+	mu       sync.Mutex
+	stats    map[string]*HandlerStats
 }
 
 // New returns a new layer, returning only errors if
@@ -43,7 +45,7 @@ func New(c *cache.Cache, m Mediator) (*Layer, error) {
 	if m == nil {
 		return nil, errNoMediator
 	}
-	return &Layer{cache: c, mediator: m}, nil
+	return &Layer{cache: c, mediator: m, stats: make(map[string]*HandlerStats)}, nil
 }
 
 // Cache returns the Layer's cache.
@@ -56,58 +58,113 @@ func (la *Layer) Mediator() Mediator {
 	return la.mediator
 }
 
+// Metrics returns the Layer's Metrics, if any.
+func (la *Layer) Metrics() Metrics {
+	return la.metrics
+}
+
+// SetMetrics sets a Metrics which will be notified about
+// every request handled by the Layer.
+func (la *Layer) SetMetrics(m Metrics) {
+	la.metrics = m
+}
+
+// Stats returns the statistics for the requests handled by
+// the Layer, keyed by handler name (see app.Context.HandlerName).
+func (la *Layer) Stats() map[string]*HandlerStats {
+	la.mu.Lock()
+	defer la.mu.Unlock()
+	stats := make(map[string]*HandlerStats, len(la.stats))
+	for k, v := range la.stats {
+		st := *v
+		st.Ratio = st.ratio()
+		stats[k] = &st
+	}
+	return stats
+}
+
+func (la *Layer) request(ctx *app.Context, skipped bool, served bool) {
+	name := ctx.HandlerName()
+	la.mu.Lock()
+	st := la.stats[name]
+	if st == nil {
+		st = &HandlerStats{}
+		la.stats[name] = st
+	}
+	st.add(skipped, served)
+	la.mu.Unlock()
+	if s := statsForApp(ctx.App()); s != nil {
+		s.add(name, skipped, served)
+	}
+	if la.metrics != nil {
+		la.metrics.Request(name, skipped, served)
+	}
+}
+
 // Wrap takes a app.Handler and returns a new app.Handler
 // wrapped by the Layer. Responses will be cached according
-// to what the Layer's Mediator indicates. Note that when
-// the environment variable GONDOLA_NO_CACHE_LAYER is non
-// empty, Wrap returns the same app.Handler that was
-// received (id est, it does nothing). This is done in
-// order to simplify profiling Gondola apps (gondola dev
-// -profile sets this environment variable).
+// to what the Layer's Mediator indicates. When several requests
+// for the same uncached response arrive at the same time, only
+// one of them calls the handler and the rest are served its
+// response, if it's cacheable (see gnd.la/cache.Cache.GetOrCompute
+// for the available options). If it's not, the waiting requests
+// call the handler once the first one finishes, which roughly
+// doubles their latency, so handlers which rarely produce cacheable
+// responses should be skipped by the Mediator. Cached responses which
+// can't be decoded (e.g. stored by a previous version of the app) are
+// handled like missing ones. Note that when the environment
+// variable GONDOLA_NO_CACHE_LAYER is non empty, Wrap returns the
+// same app.Handler that was received (id est, it does nothing).
+// This is done in order to simplify profiling Gondola apps
+// (gondola dev -profile sets this environment variable).
 func (la *Layer) Wrap(handler app.Handler) app.Handler {
 	if noCacheLayer {
 		return handler
 	}
 	return func(ctx *app.Context) {
 		if la.mediator.Skip(ctx) {
+			la.request(ctx, true, false)
 			handler(ctx)
 			return
 		}
 		key := la.mediator.Key(ctx)
-		data, _ := la.cache.GetBytes(key)
-		if data != nil {
-			// has cached data
-			var response *cachedResponse
-			err := layerCodec.Decode(data, &response)
-			if err == nil {
-				ctx.Set(internal.LayerServedFromCacheKey, true)
-				header := ctx.Header()
-				for k, v := range response.Header {
-					header[k] = v
-				}
-				header["X-Gondola-From-Layer"] = fromLayer
-				ctx.WriteHeader(response.StatusCode)
-				ctx.Write(response.Data)
-				return
-			}
-		}
-
-		rw := ctx.ResponseWriter
-		w := newWriter(rw)
-		ctx.ResponseWriter = w
-		handler(ctx)
-		ctx.ResponseWriter = rw
-		if la.mediator.Cache(ctx, w.statusCode, w.header) {
+		var handled bool
+		var response *cachedResponse
+		err := la.cache.GetOrCompute(key, &response, 0, func() (interface{}, error) {
+			handled = true
+			rw := ctx.ResponseWriter
+			w := newWriter(rw)
+			ctx.ResponseWriter = w
+			handler(ctx)
+			ctx.ResponseWriter = rw
 			response := &cachedResponse{w.header, w.statusCode, w.buf.Bytes()}
-			data, err := layerCodec.Encode(response)
-			if err == nil {
-				ctx.Set(internal.LayerCachedKey, true)
-				expiration := la.mediator.Expires(ctx, w.statusCode, w.header)
-				la.cache.SetBytes(key, data, expiration)
-			} else {
-				log.Errorf("Error encoding cached response: %v", err)
+			if !la.mediator.Cache(ctx, w.statusCode, w.header) {
+				return &cache.Computed{Value: response, NoStore: true}, nil
 			}
+			ctx.Set(internal.LayerCachedKey, true)
+			expiration := la.mediator.Expires(ctx, w.statusCode, w.header)
+			return &cache.Computed{Value: response, Timeout: expiration}, nil
+		})
+		if handled {
+			// Response was already written by the handler
+			la.request(ctx, false, false)
+			return
+		}
+		if err != nil {
+			log.Errorf("Error retrieving cached response: %v", err)
+			la.request(ctx, false, false)
+			handler(ctx)
+			return
+		}
+		la.request(ctx, false, true)
+		ctx.Set(internal.LayerServedFromCacheKey, true)
+		header := ctx.Header()
+		for k, v := range response.Header {
+			header[k] = v
 		}
+		header["X-Gondola-From-Layer"] = fromLayer
+		ctx.WriteHeader(response.StatusCode)
+		ctx.Write(response.Data)
 	}
 }
 
@@ -0,0 +1,120 @@
+package layer
+
+import (
+	"sync"
+
+	"gnd.la/app"
+	"gnd.la/signal"
+)
+
+// appStatsKey is the key used for storing the appStats in the
+// app. Since they're stored in the app, they're released with
+// it, rather than kept in a global registry.
+const appStatsKey = "__gondola_cache_layer_stats"
+
+// appStats aggregates the statistics for all the layers
+// used by an app and the apps included into it, by handler
+// name, for the app monitor.
+type appStats struct {
+	mu       sync.Mutex
+	handlers map[string]*HandlerStats
+}
+
+// statsForApp returns the appStats for the top level app
+// of the given one, or nil if it hasn't been prepared.
+func statsForApp(a *app.App) *appStats {
+	for a.Parent() != nil {
+		a = a.Parent()
+	}
+	st, _ := a.Get(appStatsKey).(*appStats)
+	return st
+}
+
+func (s *appStats) add(handler string, skipped bool, served bool) {
+	s.mu.Lock()
+	st := s.handlers[handler]
+	if st == nil {
+		st = &HandlerStats{}
+		s.handlers[handler] = st
+	}
+	st.add(skipped, served)
+	s.mu.Unlock()
+}
+
+// Metrics is the interface implemented by types which receive
+// the requests handled by a Layer, usually to export them to a
+// monitoring system. See Layer.SetMetrics.
+type Metrics interface {
+	// Request is called for every request handled by the Layer, with
+	// the name of the handler. Skipped indicates if the Mediator decided
+	// to skip the cache, while served indicates if the response was
+	// served from the cache.
+	Request(handler string, skipped bool, served bool)
+}
+
+// HandlerStats contains the Layer statistics for a handler.
+type HandlerStats struct {
+	// Requests is the total number of requests.
+	Requests uint64
+	// Skipped is the number of requests which skipped the
+	// cache, as indicated by the Mediator.
+	Skipped uint64
+	// Served is the number of requests served from the cache.
+	Served uint64
+	// Ratio is the ratio of requests served from the cache
+	// over the requests which did not skip it.
+	Ratio float64
+}
+
+func (s *HandlerStats) add(skipped bool, served bool) {
+	s.Requests++
+	if skipped {
+		s.Skipped++
+	}
+	if served {
+		s.Served++
+	}
+}
+
+func (s *HandlerStats) ratio() float64 {
+	if cacheable := s.Requests - s.Skipped; cacheable > 0 {
+		return float64(s.Served) / float64(cacheable)
+	}
+	return 0
+}
+
+// monitorStats returns the statistics from all the layers
+// used by the given app, for the app monitor.
+func monitorStats(a *app.App) interface{} {
+	total := &HandlerStats{}
+	handlers := make(map[string]*HandlerStats)
+	if s := statsForApp(a); s != nil {
+		s.mu.Lock()
+		for k, v := range s.handlers {
+			st := *v
+			st.Ratio = st.ratio()
+			handlers[k] = &st
+			total.Requests += v.Requests
+			total.Skipped += v.Skipped
+			total.Served += v.Served
+		}
+		s.mu.Unlock()
+	}
+	total.Ratio = total.ratio()
+	return map[string]interface{}{
+		"Total":    total,
+		"Handlers": handlers,
+	}
+}
+
+func init() {
+	app.RegisterMonitorStats("layer", monitorStats)
+	// Apps can't be modified concurrently while they're serving
+	// requests, so the stats must be set up before that.
+	signal.Listen(app.WILL_PREPARE, func(_ string, obj interface{}) {
+		a := obj.(*app.App)
+		if a.Get(appStatsKey) == nil {
+			a.Set(appStatsKey, &appStats{handlers: make(map[string]*HandlerStats)})
+		}
+	})
+}
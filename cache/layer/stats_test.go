@@ -0,0 +1,57 @@
+package layer
+
+import (
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+	"gnd.la/cache"
+	"gnd.la/config"
+)
+
+func requests(t *testing.T, a *app.App, handler string) uint64 {
+	stats := monitorStats(a).(map[string]interface{})
+	var handlerRequests uint64
+	if st := stats["Handlers"].(map[string]*HandlerStats)[handler]; st != nil {
+		handlerRequests = st.Requests
+	}
+	if total := stats["Total"].(*HandlerStats).Requests; total != handlerRequests {
+		t.Errorf("expecting %d total requests, got %d", handlerRequests, total)
+	}
+	return handlerRequests
+}
+
+func TestAppStats(t *testing.T) {
+	c, err := cache.New(config.MustParseURL("memory://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	la, err := New(c, &SimpleMediator{Expiration: 600})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Both apps share the same layer, but each one of
+	// them only reports its own requests.
+	a1 := app.New()
+	a1.HandleNamed("^/$", la.Wrap(func(ctx *app.Context) { ctx.WriteString("a1") }), "index")
+	a2 := app.New()
+	a2.HandleNamed("^/$", la.Wrap(func(ctx *app.Context) { ctx.WriteString("a2") }), "index")
+	if requests(t, a1, "index") != 0 {
+		t.Fatal("stats for an app which didn't serve any request")
+	}
+	t1 := tester.New(t, a1)
+	t2 := tester.New(t, a2)
+	for ii := 0; ii < 3; ii++ {
+		t1.Get("/", nil).Expect(200)
+	}
+	t2.Get("/", nil).Expect(200)
+	if r := requests(t, a1, "index"); r != 3 {
+		t.Errorf("expecting 3 requests for a1, got %d", r)
+	}
+	if r := requests(t, a2, "index"); r != 1 {
+		t.Errorf("expecting 1 request for a2, got %d", r)
+	}
+	if st := la.Stats()["index"]; st == nil || st.Requests != 4 {
+		t.Errorf("expecting 4 requests in the layer, got %+v", st)
+	}
+}
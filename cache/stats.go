@@ -0,0 +1,272 @@
+package cache
+
+import (
+	"sync/atomic"
+	"time"
+
+	"gnd.la/metrics"
+)
+
+// Names of the driver operations passed to Metrics.Operation
+// and used as keys in Stats.Operations.
+const (
+	OpGet      = "get"
+	OpGetMulti = "get_multi"
+	OpSet      = "set"
+	OpDelete   = "delete"
+	OpFlush    = "flush"
+)
+
+// Kinds of transformations passed to Metrics.Transform.
+const (
+	TransformCodec = "codec"
+	TransformPipe  = "pipe"
+)
+
+const (
+	opGet = iota
+	opGetMulti
+	opSet
+	opDelete
+	opFlush
+	numOps
+)
+
+var (
+	opNames = [numOps]string{OpGet, OpGetMulti, OpSet, OpDelete, OpFlush}
+
+	// LatencyBuckets are the upper bounds of the buckets in
+	// the latency histograms returned by Cache.Stats.
+	LatencyBuckets = []time.Duration{
+		100 * time.Microsecond,
+		250 * time.Microsecond,
+		500 * time.Microsecond,
+		time.Millisecond,
+		2500 * time.Microsecond,
+		5 * time.Millisecond,
+		10 * time.Millisecond,
+		25 * time.Millisecond,
+		50 * time.Millisecond,
+		100 * time.Millisecond,
+		250 * time.Millisecond,
+		500 * time.Millisecond,
+		time.Second,
+	}
+)
+
+var (
+	lookupsTotal = metrics.NewCounter("gondola_cache_lookups_total",
+		"Number of keys retrieved from all the caches, by result (hit or miss).", "result")
+	operationDuration = metrics.NewHistogram("gondola_cache_operation_duration_seconds",
+		"Time spent in cache driver operations, by operation.", latencyBucketsSeconds(), "operation")
+)
+
+func latencyBucketsSeconds() []float64 {
+	buckets := make([]float64, len(LatencyBuckets))
+	for ii, v := range LatencyBuckets {
+		buckets[ii] = v.Seconds()
+	}
+	return buckets
+}
+
+// Metrics is the interface implemented by types which receive the
+// measurements taken by a Cache, usually to export them to a monitoring
+// system. Set the Cache.Metrics field to enable it. Note that methods
+// in Metrics might be called concurrently.
+type Metrics interface {
+	// Lookup is called for every key retrieved from the cache, with
+	// found indicating if it was a hit.
+	Lookup(found bool)
+	// Operation is called after every call to the cache driver with
+	// its name (see the Op constants), its duration, the number of bytes
+	// sent or received and the error returned by the driver, if any.
+	Operation(op string, d time.Duration, bytes int, err error)
+	// Transform is called after encoding or decoding data using
+	// the codec or the pipe (see the Transform constants).
+	Transform(kind string, d time.Duration)
+}
+
+// Stats contains the statistics collected by a Cache since
+// it was created.
+type Stats struct {
+	// Hits is the number of keys found in the cache.
+	Hits uint64
+	// Misses is the number of keys not found in the cache.
+	Misses uint64
+	// Sets is the number of items stored.
+	Sets uint64
+	// Deletes is the number of items deleted.
+	Deletes uint64
+	// Errors is the number of errors, including encoding
+	// and decoding errors.
+	Errors uint64
+	// BytesIn is the number of bytes received from the driver.
+	BytesIn uint64
+	// BytesOut is the number of bytes sent to the driver.
+	BytesOut uint64
+	// CodecTime is the total time spent encoding and decoding
+	// objects with the codec.
+	CodecTime time.Duration
+	// PipeTime is the total time spent encoding and decoding
+	// data with the pipe.
+	PipeTime time.Duration
+	// Operations contains the latency statistics for each
+	// driver operation, keyed by the Op constants.
+	Operations map[string]*OperationStats
+}
+
+// HitRatio returns the ratio of hits over all lookups, or
+// zero if there have been no lookups.
+func (s *Stats) HitRatio() float64 {
+	if total := s.Hits + s.Misses; total > 0 {
+		return float64(s.Hits) / float64(total)
+	}
+	return 0
+}
+
+// OperationStats contains the latency statistics for
+// a driver operation.
+type OperationStats struct {
+	// Count is the number of times the operation was performed.
+	Count uint64
+	// Total is the total time spent in the operation.
+	Total time.Duration
+	// Buckets contains the latency histogram. Buckets[i] is the number
+	// of operations which took at most LatencyBuckets[i], while the
+	// last one counts the operations slower than any bucket.
+	Buckets []uint64
+}
+
+// Average returns the average duration of the operation.
+func (s *OperationStats) Average() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Count)
+}
+
+type histogram struct {
+	count   uint64
+	total   int64
+	buckets []uint64
+}
+
+func (h *histogram) observe(d time.Duration) {
+	atomic.AddUint64(&h.count, 1)
+	atomic.AddInt64(&h.total, int64(d))
+	ii := 0
+	for ii < len(LatencyBuckets) && d > LatencyBuckets[ii] {
+		ii++
+	}
+	atomic.AddUint64(&h.buckets[ii], 1)
+}
+
+func (h *histogram) stats() *OperationStats {
+	s := &OperationStats{
+		Count:   atomic.LoadUint64(&h.count),
+		Total:   time.Duration(atomic.LoadInt64(&h.total)),
+		Buckets: make([]uint64, len(h.buckets)),
+	}
+	for ii := range h.buckets {
+		s.Buckets[ii] = atomic.LoadUint64(&h.buckets[ii])
+	}
+	return s
+}
+
+type cacheStats struct {
+	hits      uint64
+	misses    uint64
+	sets      uint64
+	deletes   uint64
+	errors    uint64
+	bytesIn   uint64
+	bytesOut  uint64
+	codecTime int64
+	pipeTime  int64
+	ops       [numOps]histogram
+}
+
+func newCacheStats() *cacheStats {
+	s := &cacheStats{}
+	for ii := range s.ops {
+		s.ops[ii].buckets = make([]uint64, len(LatencyBuckets)+1)
+	}
+	return s
+}
+
+// Stats returns the statistics collected by the Cache
+// since it was created.
+func (c *Cache) Stats() *Stats {
+	s := c.stats
+	st := &Stats{
+		Hits:       atomic.LoadUint64(&s.hits),
+		Misses:     atomic.LoadUint64(&s.misses),
+		Sets:       atomic.LoadUint64(&s.sets),
+		Deletes:    atomic.LoadUint64(&s.deletes),
+		Errors:     atomic.LoadUint64(&s.errors),
+		BytesIn:    atomic.LoadUint64(&s.bytesIn),
+		BytesOut:   atomic.LoadUint64(&s.bytesOut),
+		CodecTime:  time.Duration(atomic.LoadInt64(&s.codecTime)),
+		PipeTime:   time.Duration(atomic.LoadInt64(&s.pipeTime)),
+		Operations: make(map[string]*OperationStats, numOps),
+	}
+	for ii := range s.ops {
+		st.Operations[opNames[ii]] = s.ops[ii].stats()
+	}
+	return st
+}
+
+func (c *Cache) lookups(hits int, misses int) {
+	atomic.AddUint64(&c.stats.hits, uint64(hits))
+	atomic.AddUint64(&c.stats.misses, uint64(misses))
+	if hits > 0 {
+		lookupsTotal.Add(float64(hits), "hit")
+	}
+	if misses > 0 {
+		lookupsTotal.Add(float64(misses), "miss")
+	}
+	if c.Metrics != nil {
+		for ii := 0; ii < hits; ii++ {
+			c.Metrics.Lookup(true)
+		}
+		for ii := 0; ii < misses; ii++ {
+			c.Metrics.Lookup(false)
+		}
+	}
+}
+
+// operation records a driver operation started at the given time.
+// bytes are the bytes sent or received, depending on the operation.
+func (c *Cache) operation(op int, start time.Time, bytes int, err error) {
+	d := time.Since(start)
+	c.stats.ops[op].observe(d)
+	operationDuration.Observe(d.Seconds(), opNames[op])
+	switch op {
+	case opGet, opGetMulti:
+		atomic.AddUint64(&c.stats.bytesIn, uint64(bytes))
+	case opSet:
+		atomic.AddUint64(&c.stats.bytesOut, uint64(bytes))
+		if err == nil {
+			atomic.AddUint64(&c.stats.sets, 1)
+		}
+	case opDelete:
+		if err == nil {
+			atomic.AddUint64(&c.stats.deletes, 1)
+		}
+	}
+	if c.Metrics != nil {
+		c.Metrics.Operation(opNames[op], d, bytes, err)
+	}
+}
+
+func (c *Cache) transform(kind string, start time.Time) {
+	d := time.Since(start)
+	if kind == TransformCodec {
+		atomic.AddInt64(&c.stats.codecTime, int64(d))
+	} else {
+		atomic.AddInt64(&c.stats.pipeTime, int64(d))
+	}
+	if c.Metrics != nil {
+		c.Metrics.Transform(kind, d)
+	}
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type testMetrics struct {
+	mu         sync.Mutex
+	lookups    map[bool]int
+	operations map[string]int
+	transforms map[string]int
+}
+
+func (m *testMetrics) Lookup(found bool) {
+	m.mu.Lock()
+	m.lookups[found]++
+	m.mu.Unlock()
+}
+
+func (m *testMetrics) Operation(op string, d time.Duration, bytes int, err error) {
+	m.mu.Lock()
+	m.operations[op]++
+	m.mu.Unlock()
+}
+
+func (m *testMetrics) Transform(kind string, d time.Duration) {
+	m.mu.Lock()
+	m.transforms[kind]++
+	m.mu.Unlock()
+}
+
+func TestStats(t *testing.T) {
+	c, err := newCache("memory://#pipe=zlib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &testMetrics{
+		lookups:    make(map[bool]int),
+		operations: make(map[string]int),
+		transforms: make(map[string]int),
+	}
+	c.Metrics = m
+	if err := c.Set("k1", "v1", 0); err != nil {
+		t.Fatal(err)
+	}
+	var s string
+	if err := c.Get("k1", &s); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get("k2", &s); err != ErrNotFound {
+		t.Fatalf("expecting ErrNotFound, got %v", err)
+	}
+	out := map[string]interface{}{"k1": "", "k3": ""}
+	if err := c.GetMulti(out, nil); err != nil {
+		t.Fatal(err)
+	}
+	c.Delete("k1")
+	st := c.Stats()
+	if st.Hits != 2 || st.Misses != 2 {
+		t.Errorf("expecting 2 hits and 2 misses, got %d and %d", st.Hits, st.Misses)
+	}
+	if r := st.HitRatio(); r != 0.5 {
+		t.Errorf("expecting hit ratio 0.5, got %v", r)
+	}
+	if st.Sets != 1 || st.Deletes != 1 {
+		t.Errorf("expecting 1 set and 1 delete, got %d and %d", st.Sets, st.Deletes)
+	}
+	if st.BytesOut == 0 || st.BytesIn != 2*st.BytesOut {
+		t.Errorf("expecting twice the bytes sent to be received, got %d out and %d in", st.BytesOut, st.BytesIn)
+	}
+	get := st.Operations[OpGet]
+	if get.Count != 2 {
+		t.Errorf("expecting 2 get operations, got %d", get.Count)
+	}
+	var buckets uint64
+	for _, v := range get.Buckets {
+		buckets += v
+	}
+	if buckets != get.Count {
+		t.Errorf("histogram has %d items, expecting %d", buckets, get.Count)
+	}
+	if m.lookups[true] != 2 || m.lookups[false] != 2 {
+		t.Errorf("unexpected lookups in Metrics %v", m.lookups)
+	}
+	if m.operations[OpGet] != 2 || m.operations[OpGetMulti] != 1 || m.operations[OpSet] != 1 || m.operations[OpDelete] != 1 {
+		t.Errorf("unexpected operations in Metrics %v", m.operations)
+	}
+	// 1 encode + 1 decode in Get + 1 decode in GetMulti
+	if m.transforms[TransformCodec] != 3 || m.transforms[TransformPipe] != 3 {
+		t.Errorf("unexpected transforms in Metrics %v", m.transforms)
+	}
+}
@@ -15,3 +15,15 @@ func genAppCommand(opts *genAppOptions) error {
 	}
 	return a.Gen(opts.Release)
 }
+
+func checkTemplatesCommand(args []string) error {
+	dir := "."
+	if len(args) > 0 {
+		dir = args[0]
+	}
+	a, err := app.Parse(dir)
+	if err != nil {
+		return err
+	}
+	return a.CheckTemplates()
+}
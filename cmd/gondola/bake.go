@@ -2,15 +2,22 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"go/build"
+	"io/ioutil"
+	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
 	"gnd.la/internal/gen/genutil"
 	"gnd.la/internal/vfsutil"
 	"gnd.la/log"
+	"gnd.la/template/assets"
+
+	"gopkgs.com/vfs.v1"
 )
 
 type bakeOptions struct {
@@ -18,6 +25,7 @@ type bakeOptions struct {
 	Name       string `help:"Variable name of the generated VFS"`
 	Out        string `name:"o" help:"Output filename. If empty, output is printed to standard output"`
 	Extensions string `name:"ext" help:"Additional extensions (besides html, css and js) to include, separated by commas"`
+	Manifest   string `help:"If non-empty, write a JSON manifest describing the baked assets to this file"`
 }
 
 func bakeCommand(opts *bakeOptions) error {
@@ -45,5 +53,69 @@ func bakeCommand(opts *bakeOptions) error {
 		return err
 	}
 	log.Debugf("Assets written to %s (%d bytes)", opts.Out, buf.Len())
+	if opts.Manifest != "" {
+		if err := writeBakeManifest(opts.Dir, extensions, opts.Manifest); err != nil {
+			return err
+		}
+		log.Debugf("Manifest written to %s", opts.Manifest)
+	}
 	return nil
 }
+
+// writeBakeManifest writes an assets.Manifest describing all the files
+// baked from dir. Generated assets (bundles, compiled assets) keep the
+// information recorded when they were generated, see assets.Manifest.
+func writeBakeManifest(dir string, extensions []string, out string) error {
+	fs, err := vfs.FS(dir)
+	if err != nil {
+		return err
+	}
+	exts := make(map[string]bool)
+	for _, v := range extensions {
+		if v != "" {
+			exts["."+strings.TrimPrefix(strings.ToLower(v), ".")] = true
+		}
+	}
+	m := assets.New(fs, "")
+	recorded, err := m.Manifest()
+	if err != nil {
+		return err
+	}
+	manifest := &assets.Manifest{Assets: make(map[string]*assets.ManifestAsset)}
+	err = vfs.Walk(fs, "/", func(_ vfs.VFS, p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !exts[strings.ToLower(path.Ext(p))] {
+			return err
+		}
+		name := strings.TrimPrefix(p, "/")
+		if name == assets.ManifestName {
+			return nil
+		}
+		if asset := recorded.Assets[name]; asset != nil {
+			manifest.Assets[name] = asset
+			return nil
+		}
+		integrity, err := m.Integrity(name)
+		if err != nil {
+			return err
+		}
+		manifest.Assets[name] = &assets.ManifestAsset{
+			Type:      strings.TrimPrefix(path.Ext(name), "."),
+			Integrity: integrity,
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Source maps which were not baked are not available
+	for _, v := range manifest.Assets {
+		if v.SourceMap != "" && manifest.Assets[v.SourceMap] == nil {
+			v.SourceMap = ""
+		}
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(out, data, 0644)
+}
@@ -0,0 +1,551 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/build"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"gnd.la/internal/gen/app"
+	"gnd.la/log"
+	"gnd.la/util/fileutil"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	appfileName = "appfile.yaml"
+	genfileName = "genfile.yaml"
+	// yamlIndent is the indentation used when
+	// adding new sections to YAML files.
+	yamlIndent = "    "
+)
+
+var (
+	errEntryExists = errors.New("entry already exists")
+	// patternPathRe matches the patterns which can be
+	// converted to a path for the generated tests.
+	patternPathRe = regexp.MustCompile(`^\^(/[\w\-/]*)\$?$`)
+)
+
+type generateOptions struct {
+	Dir     string `help:"Directory of the package where the files are generated"`
+	Pattern string `help:"URL pattern for generated handlers, defaults to ^/<name>/$"`
+	Force   bool   `help:"Overwrite existing files"`
+}
+
+const generateHelp = `Generates the code for a single piece of an app, where <kind> is one of:
+
+model:      An ORM model registered with orm.Register
+handler:    A named handler with its template and a gnd.la/app/tester test,
+            added to the handlers in appfile.yaml
+form:       A gnd.la/form struct with a validation method
+app:        A new app, in a subdirectory, with its appfile.yaml
+command:    A command registered with gnd.la/commands
+
+Names might be written either in camel case or with words separated by
+dashes or underscores (e.g. BlogPost or blog-post). Files are not overwritten
+unless -force is provided. After generating a handler or an app, run
+gondola gen-app to update the app code.
+`
+
+// genName contains a name in all the forms used by
+// the generated code.
+type genName struct {
+	words []string
+}
+
+func newGenName(s string) (*genName, error) {
+	var words []string
+	for _, v := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		for _, w := range stringutil.UnCamelCase(v) {
+			words = append(words, strings.ToLower(w))
+		}
+	}
+	if len(words) == 0 || !unicode.IsLetter(rune(words[0][0])) {
+		return nil, fmt.Errorf("invalid name %q, it must start with a letter", s)
+	}
+	return &genName{words: words}, nil
+}
+
+// Exported returns the name in camel case, starting with
+// an uppercase letter (e.g. BlogPost).
+func (n *genName) Exported() string {
+	var buf bytes.Buffer
+	for _, v := range n.words {
+		buf.WriteString(strings.ToUpper(v[:1]))
+		buf.WriteString(v[1:])
+	}
+	return buf.String()
+}
+
+// Unexported returns the name in camel case, starting with
+// a lowercase letter (e.g. blogPost).
+func (n *genName) Unexported() string {
+	e := n.Exported()
+	return strings.ToLower(e[:1]) + e[1:]
+}
+
+// Lower returns the name words separated by sep (e.g. blog-post).
+func (n *genName) Lower(sep string) string {
+	return strings.Join(n.words, sep)
+}
+
+// Title returns the name words separated by spaces, with the
+// first one capitalized (e.g. Blog post).
+func (n *genName) Title() string {
+	s := n.Lower(" ")
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+type generator struct {
+	Dir     string
+	Package string
+	Name    *genName
+	Pattern string
+	Path    string
+	Force   bool
+}
+
+// writeFile executes the given template with the generator as its
+// data and writes the result to name, relative to g.Dir. Go code is
+// formatted with gofmt.
+func (g *generator) writeFile(name string, text string) error {
+	p := filepath.Join(g.Dir, filepath.FromSlash(name))
+	if exists, _ := fileutil.Exists(p); exists && !g.Force {
+		return fmt.Errorf("%s already exists, use -force to overwrite it", p)
+	}
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, g); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	if filepath.Ext(p) == ".go" {
+		if data, err = format.Source(data); err != nil {
+			return fmt.Errorf("error formatting %s: %s", p, err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	log.Infof("writing %s", p)
+	return ioutil.WriteFile(p, data, 0644)
+}
+
+// addEntry adds the given key and value to the section at path in
+// the given YAML file in g.Dir. If the file doesn't exist, nothing
+// is done. If the key already exists, a warning is logged.
+func (g *generator) addEntry(filename string, path []string, key string, value string) error {
+	p := filepath.Join(g.Dir, filename)
+	if exists, _ := fileutil.Exists(p); !exists {
+		return nil
+	}
+	err := addYAMLEntry(p, path, key, value)
+	if err == errEntryExists {
+		log.Warningf("%s already exists in %s in %s, not modifying it", key, strings.Join(path, "."), p)
+		return nil
+	}
+	if err == nil {
+		log.Infof("added %s to %s in %s", key, strings.Join(path, "."), p)
+	}
+	return err
+}
+
+func (g *generator) model() error {
+	return g.writeFile(g.Name.Lower("_")+".go", modelTemplate)
+}
+
+func (g *generator) handler() error {
+	if exists, _ := fileutil.Exists(filepath.Join(g.Dir, appfileName)); !exists {
+		return fmt.Errorf("no %s found in %s, handlers can only be generated for apps (see gondola generate app)", appfileName, g.Dir)
+	}
+	a, err := app.Parse(g.Dir)
+	if err != nil {
+		return err
+	}
+	tmplDir := ""
+	if a.Templates != nil {
+		tmplDir = a.Templates.Path
+	}
+	if tmplDir == "" {
+		tmplDir = "tmpl"
+		if err := g.addEntry(appfileName, []string{"templates"}, "path", tmplDir); err != nil {
+			return err
+		}
+	}
+	if g.Pattern == "" {
+		g.Pattern = "^/" + g.Name.Lower("-") + "/$"
+	}
+	if _, err := regexp.Compile(g.Pattern); err != nil {
+		return fmt.Errorf("invalid pattern %q: %s", g.Pattern, err)
+	}
+	if m := patternPathRe.FindStringSubmatch(g.Pattern); m != nil {
+		g.Path = m[1]
+	}
+	base := g.Name.Lower("_") + "_handler"
+	tmplName := g.Name.Lower("-") + ".html"
+	if err := g.writeFile(base+".go", handlerTemplate); err != nil {
+		return err
+	}
+	if err := g.writeFile(base+"_test.go", handlerTestTemplate); err != nil {
+		return err
+	}
+	if err := g.writeFile(filepath.Join(tmplDir, tmplName), handlerHTMLTemplate); err != nil {
+		return err
+	}
+	handler := g.Name.Exported() + "Handler"
+	if err := g.addEntry(appfileName, []string{"handlers"}, handler, g.Pattern); err != nil {
+		return err
+	}
+	if err := g.addEntry(appfileName, []string{"vars"}, handler+"Name", g.Name.Exported()); err != nil {
+		return err
+	}
+	// Add the template to the compiled ones, if the
+	// package compiles its templates.
+	if hasYAMLSection(filepath.Join(g.Dir, genfileName), []string{"template", "templates"}) {
+		if err := g.addEntry(genfileName, []string{"template", "templates"}, tmplName, ""); err != nil {
+			return err
+		}
+	}
+	log.Infof("run gondola gen-app in %s to register the handler", g.Dir)
+	return nil
+}
+
+func (g *generator) form() error {
+	return g.writeFile(g.Name.Lower("_")+"_form.go", formTemplate)
+}
+
+func (g *generator) app() error {
+	g.Dir = filepath.Join(g.Dir, g.Name.Lower(""))
+	g.Package = g.Name.Lower("")
+	if err := g.writeFile(appfileName, appfileTemplate); err != nil {
+		return err
+	}
+	if err := g.writeFile("doc.go", appDocTemplate); err != nil {
+		return err
+	}
+	if err := g.writeFile(filepath.Join("tmpl", ".keep"), ""); err != nil {
+		return err
+	}
+	log.Infof("run gondola gen-app in %s to generate the app code", g.Dir)
+	return nil
+}
+
+func (g *generator) command() error {
+	return g.writeFile(g.Name.Lower("_")+"_command.go", commandTemplate)
+}
+
+// packageName returns the name of the package in dir, or a
+// name derived from the directory name if there's no package.
+func packageName(dir string) (string, error) {
+	if pkg, err := build.ImportDir(dir, 0); err == nil {
+		return pkg.Name, nil
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	name, err := newGenName(filepath.Base(abs))
+	if err != nil {
+		return "", fmt.Errorf("can't determine the package name for %s: %s", dir, err)
+	}
+	return name.Lower(""), nil
+}
+
+func generateCommand(args []string, opts *generateOptions) error {
+	if len(args) != 2 {
+		return errors.New("usage: gondola generate <kind> <name>")
+	}
+	name, err := newGenName(args[1])
+	if err != nil {
+		return err
+	}
+	g := &generator{
+		Dir:     opts.Dir,
+		Name:    name,
+		Pattern: opts.Pattern,
+		Force:   opts.Force,
+	}
+	generators := map[string]func() error{
+		"model":   g.model,
+		"handler": g.handler,
+		"form":    g.form,
+		"app":     g.app,
+		"command": g.command,
+	}
+	gen := generators[args[0]]
+	if gen == nil {
+		var kinds []string
+		for k := range generators {
+			kinds = append(kinds, k)
+		}
+		sort.Strings(kinds)
+		return fmt.Errorf("unknown kind %q, valid ones are %s", args[0], strings.Join(kinds, ", "))
+	}
+	if args[0] != "app" {
+		if g.Package, err = packageName(g.Dir); err != nil {
+			return err
+		}
+	}
+	return gen()
+}
+
+// yamlLine returns the indentation of the given line and whether
+// it has any content (comments and blank lines have no content).
+func yamlLine(line string) (int, bool) {
+	trimmed := strings.TrimLeft(line, " ")
+	return len(line) - len(trimmed), trimmed != "" && trimmed[0] != '#'
+}
+
+// yamlKey returns the key in the given line, if any.
+func yamlKey(line string) string {
+	s := strings.TrimSpace(line)
+	if p := strings.Index(s, ":"); p > 0 && (p == len(s)-1 || s[p+1] == ' ') {
+		return s[:p]
+	}
+	return ""
+}
+
+// yamlBlock returns the end of the block which starts at the given
+// line, for a section with the given indentation.
+func yamlBlock(lines []string, start int, indent int) int {
+	for ii := start; ii < len(lines); ii++ {
+		if ind, ok := yamlLine(lines[ii]); ok && ind <= indent {
+			return ii
+		}
+	}
+	return len(lines)
+}
+
+// findYAMLSection returns the position of the section at path in lines,
+// as the range of lines in its block and its indentation. If the section
+// is not found, it returns the deepest one found and the elements of path
+// which were not found.
+func findYAMLSection(lines []string, path []string) (start int, end int, indent int, missing []string) {
+	start, end, indent = 0, len(lines), -1
+	for ii, elem := range path {
+		found := false
+		// Only the keys at the indentation of the first
+		// line with content are children of the section.
+		childIndent := -1
+		for jj := start; jj < end; jj++ {
+			ind, ok := yamlLine(lines[jj])
+			if !ok {
+				continue
+			}
+			if childIndent < 0 {
+				childIndent = ind
+			}
+			if ind == childIndent && yamlKey(lines[jj]) == elem {
+				start, indent = jj+1, ind
+				end = yamlBlock(lines, start, indent)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return start, end, indent, path[ii:]
+		}
+	}
+	return start, end, indent, nil
+}
+
+func hasYAMLSection(filename string, path []string) bool {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return false
+	}
+	_, _, _, missing := findYAMLSection(strings.Split(string(data), "\n"), path)
+	return len(missing) == 0
+}
+
+func yamlValue(s string) string {
+	if s == "" || (strings.IndexAny(s[:1], "!&*[]{}|>'\"%@`,?:-#") < 0 &&
+		!strings.Contains(s, ": ") && !strings.Contains(s, " #")) {
+		return s
+	}
+	return strconv.Quote(s)
+}
+
+// addYAMLEntry adds the given key and value to the block at the given path
+// in the YAML file, creating any missing sections. The file is edited as
+// text, rather than decoded and encoded again, to preserve its formatting and
+// comments. If the key is already present, errEntryExists is returned.
+func addYAMLEntry(filename string, path []string, key string, value string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	var lines []string
+	if s := strings.TrimRight(string(data), "\n"); s != "" {
+		lines = strings.Split(s, "\n")
+	}
+	start, end, indent, missing := findYAMLSection(lines, path)
+	// Indentation of the block contents
+	childIndent := -1
+	pos := start
+	for ii := start; ii < end; ii++ {
+		if ind, ok := yamlLine(lines[ii]); ok {
+			if childIndent < 0 {
+				childIndent = ind
+			}
+			if ind == childIndent && len(missing) == 0 && yamlKey(lines[ii]) == key {
+				return errEntryExists
+			}
+			pos = ii + 1
+		}
+	}
+	if childIndent < 0 {
+		childIndent = 0
+		if indent >= 0 {
+			childIndent = indent + len(yamlIndent)
+		}
+	}
+	var added []string
+	for _, v := range missing {
+		added = append(added, strings.Repeat(" ", childIndent)+v+":")
+		childIndent += len(yamlIndent)
+	}
+	entry := strings.Repeat(" ", childIndent) + key + ":"
+	if value != "" {
+		entry += " " + yamlValue(value)
+	}
+	added = append(added, entry)
+	lines = append(lines[:pos], append(added, lines[pos:]...)...)
+	return ioutil.WriteFile(filename, []byte(strings.Join(lines, "\n")+"\n"), 0644)
+}
+
+const modelTemplate = `package {{ .Package }}
+
+import (
+	"time"
+
+	"gnd.la/orm"
+)
+
+type {{ .Name.Exported }} struct {
+	Id      int64 ` + "`" + `orm:",primary_key,auto_increment"` + "`" + `
+	Name    string
+	Created time.Time
+}
+
+func init() {
+	orm.Register(&{{ .Name.Exported }}{}, nil)
+}
+`
+
+const handlerTemplate = `package {{ .Package }}
+
+import (
+	"gnd.la/app"
+)
+
+const (
+	{{ .Name.Exported }}HandlerName = "{{ .Package }}-{{ .Name.Lower "-" }}"
+)
+
+var (
+	{{ .Name.Exported }}Handler = app.NamedHandler({{ .Name.Exported }}HandlerName, {{ .Name.Unexported }}Handler)
+)
+
+func {{ .Name.Unexported }}Handler(ctx *app.Context) {
+	ctx.MustExecute("{{ .Name.Lower "-" }}.html", nil)
+}
+`
+
+const handlerTestTemplate = `package {{ .Package }}
+
+import (
+	"testing"
+
+	"gnd.la/app/tester"
+)
+
+func Test{{ .Name.Exported }}Handler(t *testing.T) {
+	tt := tester.New(t, App)
+	{{ if .Path }}tt.Get("{{ .Path }}", nil).Expect(200){{ else }}// TODO: Use a path matching {{ .Pattern }}
+	tt.Get("/", nil).Expect(200){{ end }}
+}
+`
+
+const handlerHTMLTemplate = `{{ "{{" }} define "Title" {{ "}}" }}{{ .Name.Title }}{{ "{{" }} end {{ "}}" }}
+<h1>{{ .Name.Title }}</h1>
+`
+
+const formTemplate = `package {{ .Package }}
+
+import (
+	"gnd.la/app"
+	"gnd.la/i18n"
+)
+
+// {{ .Name.Exported }}Form is used with gnd.la/form, e.g.
+//
+//	var data {{ .Name.Exported }}Form
+//	f := form.New(ctx, &data)
+//	if f.Submitted() && f.IsValid() {
+//		...
+//	}
+type {{ .Name.Exported }}Form struct {
+	Name string ` + "`" + `form:",label=Name"` + "`" + `
+}
+
+// ValidateName is called when validating the Name field.
+// Returning an error marks the field as invalid.
+func (f *{{ .Name.Exported }}Form) ValidateName(ctx *app.Context) error {
+	if f.Name == "" {
+		return i18n.Errorf("name can't be empty")
+	}
+	return nil
+}
+`
+
+const appfileTemplate = `name: {{ .Name.Exported }}
+handlers:
+
+vars:
+
+templates:
+    path: tmpl
+`
+
+const appDocTemplate = `// Package {{ .Package }} implements the {{ .Name.Exported }} app.
+//
+// To use it, include it into your app e.g.
+//
+//	App.Include("/{{ .Name.Lower "-" }}/", {{ .Package }}.App, "base.html")
+package {{ .Package }}
+`
+
+const commandTemplate = `package {{ .Package }}
+
+import (
+	"gnd.la/app"
+	"gnd.la/commands"
+)
+
+func {{ .Name.Unexported }}Command(ctx *app.Context) {
+}
+
+func init() {
+	commands.MustRegister({{ .Name.Unexported }}Command, &commands.Options{
+		Name: "{{ .Name.Lower "-" }}",
+		Help: "{{ .Name.Title }}",
+	})
+}
+`
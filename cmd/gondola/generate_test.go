@@ -0,0 +1,267 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type yamlSectionCase struct {
+	yaml    string
+	path    []string
+	start   int
+	end     int
+	indent  int
+	missing []string
+}
+
+func TestFindYAMLSection(t *testing.T) {
+	const appfile = `# handlers: commented
+name: Blog
+handlers:
+    ListHandler: ^/$
+
+    # Comments don't end the block
+    PostHandler: ^/post/$
+vars:
+    ListHandlerName: List
+templates:
+  path: tmpl
+  hooks:
+    base.html: top
+# Last comment`
+	cases := []yamlSectionCase{
+		{appfile, []string{"handlers"}, 3, 7, 0, nil},
+		{appfile, []string{"vars"}, 8, 9, 0, nil},
+		{appfile, []string{"templates"}, 10, 14, 0, nil},
+		{appfile, []string{"templates", "path"}, 11, 11, 2, nil},
+		{appfile, []string{"templates", "hooks"}, 12, 14, 2, nil},
+		{appfile, []string{"templates", "functions"}, 10, 14, 0, []string{"functions"}},
+		{appfile, []string{"translations", "context"}, 0, 14, -1, []string{"translations", "context"}},
+		// Keys are only matched in the block of their parent
+		{appfile, []string{"handlers", "path"}, 3, 7, 0, []string{"path"}},
+		{appfile, []string{"path"}, 0, 14, -1, []string{"path"}},
+		{appfile, []string{"base.html"}, 0, 14, -1, []string{"base.html"}},
+		{"template:\n    hooks:\n        templates: value\n", []string{"template", "templates"}, 1, 4, 0, []string{"templates"}},
+		{"", []string{"handlers"}, 0, 1, -1, []string{"handlers"}},
+		{"handlers:\nvars:\n", []string{"handlers"}, 1, 1, 0, nil},
+		{"handlers_old:\n    A: b\n", []string{"handlers"}, 0, 3, -1, []string{"handlers"}},
+	}
+	for _, v := range cases {
+		lines := strings.Split(v.yaml, "\n")
+		start, end, indent, missing := findYAMLSection(lines, v.path)
+		if start != v.start || end != v.end || indent != v.indent || !reflect.DeepEqual(missing, v.missing) {
+			t.Errorf("expecting section %v at %d-%d with indent %d and missing %v, got %d-%d with indent %d and missing %v in\n%s",
+				v.path, v.start, v.end, v.indent, v.missing, start, end, indent, missing, v.yaml)
+		}
+	}
+}
+
+type yamlEntryCase struct {
+	yaml     string
+	path     []string
+	key      string
+	value    string
+	expected string
+}
+
+func TestAddYAMLEntry(t *testing.T) {
+	cases := []yamlEntryCase{
+		// Existing sections
+		{
+			"name: Blog\nhandlers:\n    ListHandler: ^/$\nvars:\n    ListHandlerName: List\n",
+			[]string{"handlers"}, "PostHandler", "^/post/$",
+			"name: Blog\nhandlers:\n    ListHandler: ^/$\n    PostHandler: ^/post/$\nvars:\n    ListHandlerName: List\n",
+		},
+		{
+			"name: Blog\nhandlers:\n    ListHandler: ^/$\nvars:\n    ListHandlerName: List\n",
+			[]string{"vars"}, "PostHandlerName", "Post",
+			"name: Blog\nhandlers:\n    ListHandler: ^/$\nvars:\n    ListHandlerName: List\n    PostHandlerName: Post\n",
+		},
+		// Empty sections, like the ones in a generated appfile
+		{
+			"name: Blog\nhandlers:\n\nvars:\n\ntemplates:\n    path: tmpl\n",
+			[]string{"handlers"}, "PostHandler", "^/post/$",
+			"name: Blog\nhandlers:\n    PostHandler: ^/post/$\n\nvars:\n\ntemplates:\n    path: tmpl\n",
+		},
+		// The indentation of the block is preserved
+		{
+			"templates:\n  path: tmpl\nname: Blog\n",
+			[]string{"templates"}, "assets", "assets",
+			"templates:\n  path: tmpl\n  assets: assets\nname: Blog\n",
+		},
+		// Nested sections
+		{
+			"app: true\ntemplate:\n    templates:\n        list.html:\n    other: value\nassets: true\n",
+			[]string{"template", "templates"}, "post.html", "",
+			"app: true\ntemplate:\n    templates:\n        list.html:\n        post.html:\n    other: value\nassets: true\n",
+		},
+		// Missing sections
+		{
+			"name: Blog\n",
+			[]string{"vars"}, "PostHandlerName", "Post",
+			"name: Blog\nvars:\n    PostHandlerName: Post\n",
+		},
+		{
+			"name: Blog\n",
+			[]string{"template", "templates"}, "post.html", "",
+			"name: Blog\ntemplate:\n    templates:\n        post.html:\n",
+		},
+		{
+			"template:\n    other: value\nname: Blog\n",
+			[]string{"template", "templates"}, "post.html", "",
+			"template:\n    other: value\n    templates:\n        post.html:\nname: Blog\n",
+		},
+		{
+			"",
+			[]string{"handlers"}, "PostHandler", "^/post/$",
+			"handlers:\n    PostHandler: ^/post/$\n",
+		},
+		// Comments
+		{
+			"# handlers: commented\nname: Blog\nhandlers:\n    # List\n    ListHandler: ^/$\n    # Trailing comment\n\n# vars\nvars:\n",
+			[]string{"handlers"}, "PostHandler", "^/post/$",
+			"# handlers: commented\nname: Blog\nhandlers:\n    # List\n    ListHandler: ^/$\n    PostHandler: ^/post/$\n    # Trailing comment\n\n# vars\nvars:\n",
+		},
+		{
+			"name: Blog # the name\nhandlers: # the handlers\n    ListHandler: ^/$ # list\n",
+			[]string{"handlers"}, "PostHandler", "^/post/$",
+			"name: Blog # the name\nhandlers: # the handlers\n    ListHandler: ^/$ # list\n    PostHandler: ^/post/$\n",
+		},
+		// Existing keys in other sections or deeper levels don't count
+		{
+			"handlers:\n    PostHandler: ^/post/$\nvars:\n    Other: value\n",
+			[]string{"vars"}, "PostHandler", "Post",
+			"handlers:\n    PostHandler: ^/post/$\nvars:\n    Other: value\n    PostHandler: Post\n",
+		},
+		{
+			"template:\n    hooks:\n        templates: value\n",
+			[]string{"template"}, "templates", "",
+			"template:\n    hooks:\n        templates: value\n    templates:\n",
+		},
+		// Values which need quoting
+		{
+			"vars:\n",
+			[]string{"vars"}, "Title", "Posts: latest",
+			"vars:\n    Title: \"Posts: latest\"\n",
+		},
+		{
+			"vars:\n",
+			[]string{"vars"}, "Selector", "*.html",
+			"vars:\n    Selector: \"*.html\"\n",
+		},
+	}
+	dir, err := ioutil.TempDir("", "gondola-yaml-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "test.yaml")
+	for _, v := range cases {
+		if err := ioutil.WriteFile(filename, []byte(v.yaml), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := addYAMLEntry(filename, v.path, v.key, v.value); err != nil {
+			t.Errorf("error adding %s to %v in\n%s\n%s", v.key, v.path, v.yaml, err)
+			continue
+		}
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := string(data); s != v.expected {
+			t.Errorf("adding %s to %v in\n%s\nexpecting\n%s\ngot\n%s", v.key, v.path, v.yaml, v.expected, s)
+		}
+	}
+}
+
+func TestAddExistingYAMLEntry(t *testing.T) {
+	cases := []yamlEntryCase{
+		{yaml: "handlers:\n    ListHandler: ^/$\n", path: []string{"handlers"}, key: "ListHandler"},
+		{yaml: "handlers:\n    ListHandler: ^/$\n    # Comment\n    PostHandler: ^/post/$\nvars:\n", path: []string{"handlers"}, key: "PostHandler"},
+		{yaml: "template:\n    templates:\n        list.html:\n", path: []string{"template", "templates"}, key: "list.html"},
+	}
+	dir, err := ioutil.TempDir("", "gondola-yaml-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "test.yaml")
+	for _, v := range cases {
+		if err := ioutil.WriteFile(filename, []byte(v.yaml), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := addYAMLEntry(filename, v.path, v.key, "value"); err != errEntryExists {
+			t.Errorf("expecting errEntryExists adding %s to %v in\n%s\ngot %v", v.key, v.path, v.yaml, err)
+		}
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := string(data); s != v.yaml {
+			t.Errorf("file modified when adding existing key %s to %v, got\n%s", v.key, v.path, s)
+		}
+	}
+}
+
+func generate(t *testing.T, dir string, kind string, name string) {
+	if err := generateCommand([]string{kind, name}, &generateOptions{Dir: dir}); err != nil {
+		t.Fatalf("error generating %s %s: %s", kind, name, err)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gondola-generate-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	generate(t, dir, "app", "blog")
+	appDir := filepath.Join(dir, "blog")
+	generate(t, appDir, "model", "blog-post")
+	generate(t, appDir, "handler", "BlogPost")
+	generate(t, appDir, "form", "comment")
+	generate(t, appDir, "command", "import_posts")
+	// Files are not overwritten
+	if err := generateCommand([]string{"model", "blog-post"}, &generateOptions{Dir: appDir}); err == nil {
+		t.Error("expecting an error when generating an existing model")
+	}
+	files := []string{
+		"doc.go",
+		"blog_post.go",
+		"blog_post_handler.go",
+		"blog_post_handler_test.go",
+		"comment_form.go",
+		"import_posts_command.go",
+	}
+	fset := token.NewFileSet()
+	for _, v := range files {
+		p := filepath.Join(appDir, v)
+		f, err := parser.ParseFile(fset, p, nil, parser.ParseComments|parser.AllErrors)
+		if err != nil {
+			t.Errorf("generated file %s is not valid Go: %s", v, err)
+			continue
+		}
+		if f.Name.Name != "blog" {
+			t.Errorf("expecting package blog in %s, got %s", v, f.Name.Name)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(appDir, "tmpl", "blog-post.html")); err != nil {
+		t.Error(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(appDir, appfileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	appfile := string(data)
+	for _, v := range []string{"name: Blog\n", "    BlogPostHandler: ^/blog-post/$\n", "    BlogPostHandlerName: BlogPost\n", "    path: tmpl\n"} {
+		if !strings.Contains(appfile, v) {
+			t.Errorf("expecting %q in %s, got\n%s", v, appfileName, appfile)
+		}
+	}
+}
@@ -54,6 +54,12 @@ var (
 			Func:    genAppCommand,
 			Options: &genAppOptions{},
 		},
+		{
+			Name:  "check-templates",
+			Help:  "Check the templates of a Gondola app declared in its appfile.yaml, reporting unknown fields, methods, functions and variables and wrong arguments",
+			Usage: "[dir]",
+			Func:  checkTemplatesCommand,
+		},
 		{
 			Name:    "bake",
 			Help:    "Converts all assets in <dir> into Go code and generates a VFS named with <name>",
@@ -91,6 +97,14 @@ var (
 			Func:    genCommand,
 			Options: &genOptions{Genfile: "genfile.yaml"},
 		},
+		{
+			Name:     "generate",
+			Help:     "Generate a model, handler, form, app or command",
+			Usage:    "<kind> <name>",
+			LongHelp: generateHelp,
+			Func:     generateCommand,
+			Options:  &generateOptions{Dir: "."},
+		},
 		{
 			Name:    "gae-dev",
 			Help:    "Start the Gondola App Engine development server",
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/util/stringutil"
+)
+
+var errInvalidOptions = errors.New("command options must be a pointer to a struct")
+
+// checkArgs checks that only the last arguments are optional
+// and only the last one is variadic.
+func checkArgs(args []*Arg) error {
+	optional := false
+	for ii, v := range args {
+		if v.optional {
+			optional = true
+		} else if optional {
+			return fmt.Errorf("argument %s is required but it comes after an optional argument", v.name)
+		}
+		if v.variadic && ii != len(args)-1 {
+			return fmt.Errorf("argument %s is variadic but it's not the last one", v.name)
+		}
+	}
+	return nil
+}
+
+// parseArgs checks the values received for the given arguments
+// and stores them by name in params.
+func parseArgs(args []*Arg, values []string, params map[string]string) error {
+	for ii, v := range args {
+		if ii >= len(values) {
+			if !v.optional {
+				return fmt.Errorf("missing argument %s", v.name)
+			}
+			continue
+		}
+		vals := values[ii : ii+1]
+		if v.variadic {
+			vals = values[ii:]
+		}
+		if v.typ == typInt {
+			for _, val := range vals {
+				if _, err := strconv.Atoi(val); err != nil {
+					return fmt.Errorf("argument %s must be an integer, not %q", v.name, val)
+				}
+			}
+		}
+		params[v.name] = strings.Join(vals, " ")
+	}
+	// Commands without declared arguments accept any number of them
+	if n := len(args); n > 0 && !args[n-1].variadic && len(values) > n {
+		return fmt.Errorf("too many arguments, %d unexpected", len(values)-n)
+	}
+	return nil
+}
+
+// bindOptions defines a flag in set for each exported field
+// in the struct val, which must be addressable. The default
+// value of each flag is the current field value.
+func bindOptions(set *flag.FlagSet, val reflect.Value) error {
+	typ := val.Type()
+	for ii := 0; ii < typ.NumField(); ii++ {
+		field := typ.Field(ii)
+		if field.PkgPath != "" {
+			// Unexported
+			continue
+		}
+		name := field.Tag.Get("name")
+		if name == "" {
+			name = stringutil.CamelCaseToLower(field.Name, "-")
+		}
+		if set.Lookup(name) != nil {
+			return fmt.Errorf("duplicate flag %s", name)
+		}
+		help := field.Tag.Get("help")
+		switch p := val.Field(ii).Addr().Interface().(type) {
+		case *bool:
+			set.BoolVar(p, name, *p, help)
+		case *int:
+			set.IntVar(p, name, *p, help)
+		case *int64:
+			set.Int64Var(p, name, *p, help)
+		case *uint:
+			set.UintVar(p, name, *p, help)
+		case *uint64:
+			set.Uint64Var(p, name, *p, help)
+		case *float64:
+			set.Float64Var(p, name, *p, help)
+		case *string:
+			set.StringVar(p, name, *p, help)
+		case *time.Duration:
+			set.DurationVar(p, name, *p, help)
+		default:
+			return fmt.Errorf("option field %s has unsupported type %s", field.Name, field.Type)
+		}
+	}
+	return nil
+}
+
+// optionsValue returns a copy of the struct pointed by
+// opts, which can be used with bindOptions.
+func optionsValue(opts interface{}) (reflect.Value, error) {
+	val := reflect.ValueOf(opts)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, errInvalidOptions
+	}
+	cpy := reflect.New(val.Elem().Type()).Elem()
+	cpy.Set(val.Elem())
+	return cpy, nil
+}
+
+// ParseOptions fills the struct pointed by out with the values of
+// the flags received by the command. It's intended to be used with
+// a struct of the same type as the one passed in Options.Options
+// when registering the command. e.g.
+//
+//  type importOptions struct {
+//	Dry     bool          `help:"Don't save anything"`
+//	Timeout time.Duration `help:"Maximum time per item"`
+//  }
+//
+//  commands.MustRegister(Import, &commands.Options{Options: &importOptions{Timeout: time.Minute}})
+//
+//  func Import(ctx *app.Context) {
+//	var opts importOptions
+//	if err := commands.ParseOptions(ctx, &opts); err != nil {
+//	    panic(err)
+//	}
+//	...
+//  }
+func ParseOptions(ctx *app.Context, out interface{}) error {
+	val := reflect.ValueOf(out)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return errInvalidOptions
+	}
+	set := flag.NewFlagSet("", flag.ContinueOnError)
+	set.SetOutput(ioutil.Discard)
+	if err := bindOptions(set, val.Elem()); err != nil {
+		return err
+	}
+	var err error
+	set.VisitAll(func(f *flag.Flag) {
+		if err != nil {
+			return
+		}
+		value := ctx.ParamValue(f.Name)
+		if value == "" && f.DefValue != "" {
+			// Not received, keep the current value
+			return
+		}
+		if serr := f.Value.Set(value); serr != nil {
+			err = fmt.Errorf("invalid value %q for option %s: %s", value, f.Name, serr)
+		}
+	})
+	return err
+}
@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"bytes"
 	"flag"
 	"fmt"
 	"io"
@@ -10,6 +9,7 @@ import (
 	"reflect"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 
 	"gnd.la/app"
@@ -24,25 +24,108 @@ var (
 	executed = false
 )
 
+// yesFlag is the name of the flag accepted by all commands
+// which makes the prompts assume their default answer.
+const yesFlag = "yes"
+
 type command struct {
 	handler app.Handler
 	help    string
 	usage   string
 	flags   []*Flag
+	args    []*Arg
+	options interface{}
+}
+
+// flagSet returns a new flag.FlagSet with all the flags accepted by
+// the command, the names of the flags declared by it, in order, and
+// the value bound to its options struct (which is invalid if the command
+// has no Options).
+func (c *command) flagSet(name string) (*flag.FlagSet, []string, reflect.Value, error) {
+	set := flag.NewFlagSet(name, flag.ContinueOnError)
+	// Print error/help messages ourselves
+	set.SetOutput(ioutil.Discard)
+	for _, f := range c.flags {
+		if set.Lookup(f.name) != nil {
+			return nil, nil, reflect.Value{}, fmt.Errorf("duplicate flag %s", f.name)
+		}
+		switch f.typ {
+		case typBool:
+			set.Bool(f.name, f.def.(bool), f.help)
+		case typInt:
+			set.Int(f.name, f.def.(int), f.help)
+		case typString:
+			set.String(f.name, f.def.(string), f.help)
+		default:
+			panic("invalid arg type")
+		}
+	}
+	var opts reflect.Value
+	if c.options != nil {
+		var err error
+		if opts, err = optionsValue(c.options); err != nil {
+			return nil, nil, reflect.Value{}, err
+		}
+		if err := bindOptions(set, opts); err != nil {
+			return nil, nil, reflect.Value{}, err
+		}
+	}
+	var names []string
+	set.VisitAll(func(f *flag.Flag) {
+		names = append(names, f.Name)
+	})
+	// VisitAll sorts the flags, keep the declaration order
+	// for the help.
+	sort.Sort(byPosition{names, c.flagPosition(opts)})
+	if set.Lookup(yesFlag) != nil {
+		return nil, nil, reflect.Value{}, fmt.Errorf("flag %s is reserved", yesFlag)
+	}
+	set.Bool(yesFlag, false, "Assume the default answer for all prompts")
+	return set, names, opts, nil
+}
+
+func (c *command) flagPosition(opts reflect.Value) map[string]int {
+	pos := make(map[string]int)
+	for _, f := range c.flags {
+		pos[f.name] = len(pos)
+	}
+	if opts.IsValid() {
+		typ := opts.Type()
+		for ii := 0; ii < typ.NumField(); ii++ {
+			field := typ.Field(ii)
+			name := field.Tag.Get("name")
+			if name == "" {
+				name = stringutil.CamelCaseToLower(field.Name, "-")
+			}
+			pos[name] = len(pos)
+		}
+	}
+	return pos
+}
+
+type byPosition struct {
+	names []string
+	pos   map[string]int
 }
 
+func (b byPosition) Len() int           { return len(b.names) }
+func (b byPosition) Swap(i, j int)      { b.names[i], b.names[j] = b.names[j], b.names[i] }
+func (b byPosition) Less(i, j int) bool { return b.pos[b.names[i]] < b.pos[b.names[j]] }
+
 // Register registers a new command with the
 // given function and options (which might be nil).
 func Register(f app.Handler, o *Options) error {
 	var name string
-	var help string
-	var usage string
-	var flags []*Flag
+	var parent string
+	cmd := &command{handler: f}
 	if o != nil {
 		name = o.Name
-		help = o.Help
-		usage = o.Usage
-		flags = o.Flags
+		parent = o.Parent
+		cmd.help = o.Help
+		cmd.usage = o.Usage
+		cmd.flags = o.Flags
+		cmd.args = o.Args
+		cmd.options = o.Options
 	}
 	if name == "" {
 		qname := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
@@ -53,15 +136,29 @@ func Register(f app.Handler, o *Options) error {
 		}
 	}
 	cmdName := stringutil.CamelCaseToLower(name, "-")
+	if parent != "" {
+		var parts []string
+		for _, v := range strings.Fields(parent) {
+			parts = append(parts, stringutil.CamelCaseToLower(v, "-"))
+		}
+		cmdName = strings.Join(append(parts, cmdName), " ")
+	}
 	if _, ok := commands[cmdName]; ok {
-		return fmt.Errorf("duplicate command name %q", name)
+		return fmt.Errorf("duplicate command name %q", cmdName)
 	}
-	commands[cmdName] = &command{
-		handler: f,
-		help:    help,
-		usage:   usage,
-		flags:   flags,
+	set, _, _, err := cmd.flagSet(cmdName)
+	if err != nil {
+		return fmt.Errorf("invalid flags for command %q: %s", cmdName, err)
+	}
+	if err := checkArgs(cmd.args); err != nil {
+		return fmt.Errorf("invalid arguments for command %q: %s", cmdName, err)
+	}
+	for _, v := range cmd.args {
+		if set.Lookup(v.name) != nil {
+			return fmt.Errorf("argument %s in command %q conflicts with a flag with the same name", v.name, cmdName)
+		}
 	}
+	commands[cmdName] = cmd
 	return nil
 }
 
@@ -119,31 +216,13 @@ func usageErrors(s string) {
 
 func executeCommand(name string, cmd *command, args []string, a *app.App) (err error) {
 	// Parse command flags
-	set := flag.NewFlagSet(name, flag.ContinueOnError)
+	set, _, _, err := cmd.flagSet(name)
+	if err != nil {
+		return err
+	}
 	set.Usage = func() {
 		commandHelp(name, -1, os.Stderr)
 	}
-	flags := map[string]interface{}{}
-	for _, arg := range cmd.flags {
-		switch arg.typ {
-		case typBool:
-			var b bool
-			set.BoolVar(&b, arg.name, arg.def.(bool), arg.help)
-			flags[arg.name] = &b
-		case typInt:
-			var i int
-			set.IntVar(&i, arg.name, arg.def.(int), arg.help)
-			flags[arg.name] = &i
-		case typString:
-			var s string
-			set.StringVar(&s, arg.name, arg.def.(string), arg.help)
-			flags[arg.name] = &s
-		default:
-			panic("invalid arg type")
-		}
-	}
-	// Print error/help messages ourselves
-	set.SetOutput(ioutil.Discard)
 	err = set.Parse(args)
 	if err != nil {
 		if err == flag.ErrHelp {
@@ -157,8 +236,11 @@ func executeCommand(name string, cmd *command, args []string, a *app.App) (err e
 		return err
 	}
 	params := map[string]string{}
-	for _, arg := range cmd.flags {
-		params[arg.name] = fmt.Sprintf("%v", reflect.ValueOf(flags[arg.name]).Elem().Interface())
+	set.VisitAll(func(f *flag.Flag) {
+		params[f.Name] = f.Value.String()
+	})
+	if err := parseArgs(cmd.args, set.Args(), params); err != nil {
+		return usageError(err.Error())
 	}
 	provider := &contextProvider{
 		args:   set.Args(),
@@ -194,24 +276,45 @@ func Execute(a *app.App) (bool, error) {
 	if !flag.Parsed() {
 		flag.Parse()
 	}
-	args := flag.Args()
-	if len(args) > 0 {
-		cmd := strings.ToLower(args[0])
-		for k, v := range commands {
-			if cmd == k {
-				if err := executeCommand(k, v, args[1:], a); err != nil {
-					fmt.Fprintf(os.Stderr, "error running command %s: %s\n", cmd, err)
-					if _, ok := err.(usageError); ok {
-						commandHelp(cmd, -1, os.Stderr)
-					}
+	return Run(a, flag.Args())
+}
+
+// Run works like Execute, but reads the command and its
+// parameters from args rather than from the command line.
+// Subcommands are matched by taking as many words from args
+// as possible e.g. "db migrate -dry" runs the migrate subcommand
+// of db, passing it the -dry flag. When args only names the parent
+// of some subcommands, their help is printed.
+func Run(a *app.App, args []string) (bool, error) {
+	if len(args) == 0 {
+		return false, nil
+	}
+	words := make([]string, len(args))
+	for ii, v := range args {
+		words[ii] = strings.ToLower(v)
+	}
+	for ii := len(words); ii > 0; ii-- {
+		name := strings.Join(words[:ii], " ")
+		if cmd := commands[name]; cmd != nil {
+			if err := executeCommand(name, cmd, args[ii:], a); err != nil {
+				fmt.Fprintf(os.Stderr, "error running command %s: %s\n", name, err)
+				if _, ok := err.(usageError); ok {
+					commandHelp(name, -1, os.Stderr)
 				}
-				return true, nil
 			}
+			return true, nil
+		}
+	}
+	for ii := len(words); ii > 0; ii-- {
+		name := strings.Join(words[:ii], " ")
+		if isGroup(name) {
+			fmt.Fprintf(os.Stderr, "Subcommands of %s:\n", name)
+			groupHelp(name, os.Stderr)
+			return true, nil
 		}
-		// Argument was given but it's not a command
-		return false, fmt.Errorf("%s is not a registered command", args[0])
 	}
-	return false, nil
+	// Argument was given but it's not a command
+	return false, fmt.Errorf("%s is not a registered command", args[0])
 }
 
 func execute(name string, obj interface{}) {
@@ -244,74 +347,134 @@ func commandHelp(name string, maxLen int, w io.Writer) {
 	if maxLen < 0 {
 		maxLen = len(name) + 1
 	}
-	fmt.Fprintf(w, "%s:%s%s\n", name, strings.Repeat(" ", maxLen-len(name)), commands[name].help)
+	cmd := commands[name]
+	fmt.Fprintf(w, "%s:%s%s\n", name, strings.Repeat(" ", maxLen-len(name)), cmd.help)
 	indent := strings.Repeat(" ", maxLen+1)
-	if usage := commands[name].usage; usage != "" {
+	usage := cmd.usage
+	if usage == "" && len(cmd.args) > 0 {
+		var args []string
+		for _, v := range cmd.args {
+			args = append(args, v.usage())
+		}
+		usage = strings.Join(args, " ")
+	}
+	if usage != "" {
 		fmt.Fprintf(w, "\n%sUsage: gondola %s %s\n", indent, name, usage)
 	}
-	if flags := commands[name].flags; len(flags) > 0 {
+	if len(cmd.args) > 0 {
+		fmt.Fprintf(w, "\n%sArguments for %v:\n", indent, name)
+		names := make([]string, len(cmd.args))
+		helps := make([]string, len(cmd.args))
+		for ii, v := range cmd.args {
+			names[ii] = v.name
+			helps[ii] = v.help
+		}
+		printColumns(w, indent, names, helps)
+	}
+	set, flags, _, err := cmd.flagSet(name)
+	if err != nil {
+		panic(err)
+	}
+	if len(flags) > 0 {
 		fmt.Fprintf(w, "\n%sAvailable flags for %v:\n", indent, name)
-		maxArgLen := -1
+		names := make([]string, len(flags))
 		helps := make([]string, len(flags))
-		for ii, f := range flags {
-			var buf bytes.Buffer
-			buf.WriteByte('-')
-			buf.WriteString(f.name)
-			buf.WriteByte('=')
-			if f.typ == typString {
-				buf.WriteString(fmt.Sprintf("%q", f.def))
-			} else {
-				buf.WriteString(fmt.Sprintf("%v", f.def))
-			}
-			s := buf.String()
-			if sl := len(s); sl > maxArgLen {
-				maxArgLen = sl
+		for ii, v := range flags {
+			f := set.Lookup(v)
+			def := f.DefValue
+			if _, ok := f.Value.(flag.Getter).Get().(string); ok {
+				def = strconv.Quote(def)
 			}
-			helps[ii] = s
+			names[ii] = "-" + f.Name + "=" + def
+			helps[ii] = f.Usage
 		}
-		maxArgLen++
-		format := fmt.Sprintf("%% -%ds", maxArgLen)
-		for ii, f := range flags {
-			fmt.Fprintf(w, indent)
-			fmt.Fprintf(w, format, helps[ii])
-			if f.help != "" {
-				fmt.Fprintf(w, f.help)
-			}
-			fmt.Fprintf(w, "\n")
+		printColumns(w, indent, names, helps)
+	}
+}
+
+// printColumns prints each name followed by its help,
+// aligning all the help strings.
+func printColumns(w io.Writer, indent string, names []string, helps []string) {
+	maxArgLen := -1
+	for _, v := range names {
+		if l := len(v); l > maxArgLen {
+			maxArgLen = l
 		}
 	}
+	maxArgLen++
+	format := fmt.Sprintf("%% -%ds", maxArgLen)
+	for ii, v := range names {
+		fmt.Fprint(w, indent)
+		fmt.Fprintf(w, format, v)
+		fmt.Fprint(w, helps[ii])
+		fmt.Fprint(w, "\n")
+	}
 }
 
 // commandsHelp prints the help for all commands to the given io.Writer
 func commandsHelp(w io.Writer) {
-	var cmds []string
+	printHelp(commandNames(""), w)
+}
+
+// groupHelp prints the help for all the subcommands of the
+// given one to the given io.Writer.
+func groupHelp(name string, w io.Writer) {
+	printHelp(commandNames(name), w)
+}
+
+func printHelp(cmds []string, w io.Writer) {
 	maxLen := 0
-	for k, _ := range commands {
-		if commandIsHidden(k) {
-			continue
-		}
-		if l := len(k); l > maxLen {
+	for _, v := range cmds {
+		if l := len(v); l > maxLen {
 			maxLen = l
 		}
-		cmds = append(cmds, k)
 	}
 	maxLen += 1
-	sort.Strings(cmds)
 	for _, v := range cmds {
 		commandHelp(v, maxLen, w)
 		fmt.Fprint(w, "\n\n")
 	}
 }
 
+// commandNames returns the sorted names of the non hidden commands
+// which are subcommands (at any level) of the given prefix. If prefix
+// is empty, all the non hidden commands are returned.
+func commandNames(prefix string) []string {
+	var cmds []string
+	for k := range commands {
+		if commandIsHidden(k) {
+			continue
+		}
+		if prefix != "" && !strings.HasPrefix(k, prefix+" ") {
+			continue
+		}
+		cmds = append(cmds, k)
+	}
+	sort.Strings(cmds)
+	return cmds
+}
+
+// isGroup returns true iff there are subcommands
+// of the given command.
+func isGroup(name string) bool {
+	for k := range commands {
+		if strings.HasPrefix(k, name+" ") {
+			return true
+		}
+	}
+	return false
+}
+
 // Implementation of the help command for Gondola apps
 func help(ctx *app.Context) {
-	var cmd string
-	ctx.ParseIndexValue(0, &cmd)
-	if cmd != "" {
+	if cmd := ctx.ParamValue("command"); cmd != "" {
 		c := strings.ToLower(cmd)
 		if _, ok := commands[c]; ok {
 			fmt.Fprintf(os.Stderr, "Help for command %s:\n", c)
 			commandHelp(c, -1, os.Stderr)
+		} else if isGroup(c) {
+			fmt.Fprintf(os.Stderr, "Subcommands of %s:\n", c)
+			groupHelp(c, os.Stderr)
 		} else {
 			fmt.Fprintf(os.Stderr, "No such command %q\n", cmd)
 		}
@@ -328,6 +491,7 @@ func commandIsHidden(name string) bool {
 func init() {
 	MustRegister(help, &Options{
 		Help: "Show available commands with their respective help.",
+		Args: Args(StringArg("command", "Show only the help for this command").Optional().Variadic()),
 	})
 	signal.Listen(app.WILL_PREPARE, execute)
 }
@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+)
+
+type testOptions struct {
+	Verbose bool
+	Retries int           `help:"Number of retries"`
+	Timeout time.Duration `name:"max-time"`
+	Prefix  string
+}
+
+func TestArgsAndOptions(t *testing.T) {
+	var name, files string
+	var opts testOptions
+	handler := func(ctx *app.Context) {
+		name = ctx.ParamValue("name")
+		files = ctx.ParamValue("files")
+		opts = testOptions{}
+		if err := ParseOptions(ctx, &opts); err != nil {
+			t.Error(err)
+		}
+	}
+	MustRegister(handler, &Options{
+		Name:    "run",
+		Parent:  "testGroup",
+		Args:    Args(StringArg("name", ""), IntArg("files", "").Optional().Variadic()),
+		Options: &testOptions{Retries: 3, Prefix: "foo"},
+	})
+	defer Remove("test-group run")
+	a := app.New()
+	if ok, err := Run(a, []string{"test-group", "run", "-verbose", "-max-time=5s", "bar", "1", "2"}); !ok || err != nil {
+		t.Fatalf("expecting command to run, got %v, %v", ok, err)
+	}
+	if name != "bar" || files != "1 2" {
+		t.Errorf("unexpected arguments %q and %q", name, files)
+	}
+	if expect := (testOptions{Verbose: true, Retries: 3, Timeout: 5 * time.Second, Prefix: "foo"}); opts != expect {
+		t.Errorf("expecting options %+v, got %+v", expect, opts)
+	}
+	name = ""
+	for _, v := range [][]string{
+		{"test-group", "run"},
+		{"test-group", "run", "bar", "baz"},
+	} {
+		Run(a, v)
+		if name != "" {
+			t.Errorf("command ran with invalid arguments %v", v)
+		}
+	}
+	if _, err := Run(a, []string{"test-group-nope"}); err == nil {
+		t.Error("expecting an error for a non-existing command")
+	}
+}
+
+func TestInvalidRegister(t *testing.T) {
+	handler := func(ctx *app.Context) {}
+	cases := []*Options{
+		{Name: "bad-args", Args: Args(StringArg("a", "").Optional(), StringArg("b", ""))},
+		{Name: "bad-variadic", Args: Args(StringArg("a", "").Variadic(), StringArg("b", ""))},
+		{Name: "bad-options", Options: testOptions{}},
+		{Name: "bad-yes", Flags: Flags(BoolFlag("yes", false, ""))},
+		{Name: "bad-duplicate", Flags: Flags(BoolFlag("verbose", false, "")), Options: &testOptions{}},
+		{Name: "bad-conflict", Flags: Flags(BoolFlag("a", false, "")), Args: Args(StringArg("a", ""))},
+	}
+	for _, v := range cases {
+		if err := Register(handler, v); err == nil {
+			Remove(v.Name)
+			t.Errorf("expecting an error registering %s", v.Name)
+		}
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	handler := func(ctx *app.Context) {}
+	MustRegister(handler, &Options{Name: "migrate", Parent: "test-db", Options: &testOptions{}})
+	defer Remove("test-db migrate")
+	var buf bytes.Buffer
+	if err := writeCompletion(&buf, "my-app", "bash"); err != nil {
+		t.Fatal(err)
+	}
+	script := buf.String()
+	for _, v := range []string{
+		"complete -F _my_app_complete my-app",
+		`"test-db migrate"|"test-db migrate "*) words="-verbose -retries -max-time -prefix -yes" ;;`,
+		`"test-db"|"test-db "*) words="migrate" ;;`,
+	} {
+		if !strings.Contains(script, v) {
+			t.Errorf("completion script does not contain %q:\n%s", v, script)
+		}
+	}
+	if strings.Index(script, `"test-db migrate"|`) > strings.Index(script, `"test-db"|`) {
+		t.Error("subcommand must be matched before its parent")
+	}
+	buf.Reset()
+	if err := writeCompletion(&buf, "my-app", "zsh"); err != nil || !strings.HasPrefix(buf.String(), "autoload") {
+		t.Errorf("invalid zsh completion script, error %v", err)
+	}
+	if err := writeCompletion(&buf, "my-app", "fish"); err == nil {
+		t.Error("expecting an error for an unsupported shell")
+	}
+}
+
+func TestPrompts(t *testing.T) {
+	defer func(r *bufio.Reader, w io.Writer) {
+		promptReader = r
+		promptOutput = w
+	}(promptReader, promptOutput)
+	promptOutput = ioutil.Discard
+	a := app.New()
+	ctx := a.NewContext(&contextProvider{params: map[string]string{}})
+	defer a.CloseContext(ctx)
+	promptReader = bufio.NewReader(strings.NewReader("maybe\nyes\n\nbar\n7\n2\n"))
+	if !Confirm(ctx, "Continue?", false) {
+		t.Error("expecting Confirm() = true")
+	}
+	if Prompt(ctx, "Name", "foo") != "foo" {
+		t.Error("expecting default value from Prompt()")
+	}
+	if v := Prompt(ctx, "Name", "foo"); v != "bar" {
+		t.Errorf("expecting Prompt() = bar, got %q", v)
+	}
+	if v := Choose(ctx, "Color", []string{"red", "green", "blue"}, 0); v != 1 {
+		t.Errorf("expecting Choose() = 1, got %d", v)
+	}
+	// Input is exhausted, defaults are returned
+	if Confirm(ctx, "Continue?", false) || Choose(ctx, "Color", []string{"red"}, 0) != 0 {
+		t.Error("expecting default values on EOF")
+	}
+	yes := a.NewContext(&contextProvider{params: map[string]string{yesFlag: "true"}})
+	defer a.CloseContext(yes)
+	if !Confirm(yes, "Continue?", false) || Prompt(yes, "Name", "foo") != "foo" || Choose(yes, "Color", []string{"red", "green"}, 1) != 1 {
+		t.Error("prompts do not return defaults with -yes")
+	}
+}
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gnd.la/app"
+)
+
+// writeCompletion writes a completion script for the given
+// shell (bash or zsh) and program name to w.
+func writeCompletion(w io.Writer, prog string, shell string) error {
+	var buf bytes.Buffer
+	switch shell {
+	case "bash":
+	case "zsh":
+		// zsh can use bash completion functions
+		buf.WriteString("autoload -U +X bashcompinit && bashcompinit\n\n")
+	default:
+		return fmt.Errorf("unsupported shell %q, supported shells are bash and zsh", shell)
+	}
+	fn := "_" + strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, prog) + "_complete"
+	// Words offered after each command or group, including
+	// the root, which is represented by the empty string.
+	nodes := map[string][]string{"": nil}
+	for _, name := range commandNames("") {
+		parts := strings.Split(name, " ")
+		for ii := range parts {
+			node := strings.Join(parts[:ii+1], " ")
+			if _, ok := nodes[node]; !ok {
+				nodes[node] = nil
+				parent := strings.Join(parts[:ii], " ")
+				nodes[parent] = append(nodes[parent], parts[ii])
+			}
+		}
+		_, flags, _, err := commands[name].flagSet(name)
+		if err != nil {
+			return err
+		}
+		for _, v := range append(flags, yesFlag) {
+			nodes[name] = append(nodes[name], "-"+v)
+		}
+	}
+	var keys []string
+	for k := range nodes {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	// Longest commands first, so subcommands are matched
+	// before their parents.
+	sort.Sort(byWords(keys))
+	fmt.Fprintf(&buf, "%s() {\n", fn)
+	buf.WriteString("    local cur word path words i\n")
+	buf.WriteString("    cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	buf.WriteString("    path=\"\"\n")
+	buf.WriteString("    for ((i=1; i<COMP_CWORD; i++)); do\n")
+	buf.WriteString("        word=\"${COMP_WORDS[i]}\"\n")
+	buf.WriteString("        case \"$word\" in\n")
+	buf.WriteString("            -*) ;;\n")
+	buf.WriteString("            *) path=\"${path:+$path }$word\" ;;\n")
+	buf.WriteString("        esac\n")
+	buf.WriteString("    done\n")
+	buf.WriteString("    case \"$path\" in\n")
+	for _, k := range keys {
+		fmt.Fprintf(&buf, "        %q|%q*) words=%q ;;\n", k, k+" ", strings.Join(nodes[k], " "))
+	}
+	fmt.Fprintf(&buf, "        \"\") words=%q ;;\n", strings.Join(nodes[""], " "))
+	buf.WriteString("        *) words=\"\" ;;\n")
+	buf.WriteString("    esac\n")
+	buf.WriteString("    COMPREPLY=( $(compgen -W \"$words\" -- \"$cur\") )\n")
+	buf.WriteString("}\n\n")
+	fmt.Fprintf(&buf, "complete -F %s %s\n", fn, prog)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+type byWords []string
+
+func (b byWords) Len() int      { return len(b) }
+func (b byWords) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byWords) Less(i, j int) bool {
+	wi := strings.Count(b[i], " ")
+	wj := strings.Count(b[j], " ")
+	if wi != wj {
+		return wi > wj
+	}
+	return b[i] < b[j]
+}
+
+func completion(ctx *app.Context) {
+	prog := filepath.Base(os.Args[0])
+	if err := writeCompletion(os.Stdout, prog, ctx.ParamValue("shell")); err != nil {
+		UsageError(err)
+	}
+}
+
+func init() {
+	MustRegister(completion, &Options{
+		Help: "Print a shell completion script for the app commands. e.g. eval \"$(./myapp completion bash)\"",
+		Args: Args(StringArg("shell", "Shell to generate the script for, either bash or zsh")),
+	})
+}
@@ -63,6 +63,45 @@
 //	// foo and bar now contain the parameters received in the command line
 //  }
 //
+// Commands might also declare their positional arguments, which are checked
+// before running the command and might be accessed by name too, as well as a
+// struct whose fields declare additional flags, with their default values,
+// which are then obtained using ParseOptions().
+//
+//  type greetOptions struct {
+//	Times    int           `help:"Number of greetings"`
+//	Interval time.Duration `help:"Time between greetings"`
+//  }
+//
+//  commands.MustRegister(Greet, &commands.Options{
+//	Help:    "Greet someone",
+//	Args:    commands.Args(commands.StringArg("name", "Name to greet"), commands.IntArg("age", "Age in years").Optional()),
+//	Options: &greetOptions{Times: 1, Interval: time.Second},
+//  })
+//
+//  func Greet(ctx *app.Context) {
+//	var opts greetOptions
+//	if err := commands.ParseOptions(ctx, &opts); err != nil {
+//	    panic(err)
+//	}
+//	name := ctx.ParamValue("name")
+//	...
+//  }
+//
+// Commands might be grouped by setting Options.Parent, which makes them
+// subcommands of their parent e.g. a command named migrate with Parent db
+// is invoked as "./myapp db migrate". Invoking "./myapp db" lists all the
+// subcommands of db.
+//
+// Command handlers might interact with the user using Confirm(), Prompt()
+// and Choose(). All commands accept the -yes flag, which makes these functions
+// return their default values (or true, for Confirm) without asking, so
+// commands can be run from scripts.
+//
+//  if !commands.Confirm(ctx, "Delete all the sessions?", false) {
+//	return
+//  }
+//
 // Finally, to invoke the command, pass it to your app binary e.g.
 //
 //  ./myapp my-command
@@ -77,5 +116,10 @@
 //
 //  ./myapp help
 //
+// Completion scripts for bash and zsh might be generated with the completion
+// command, e.g.:
+//
+//  eval "$(./myapp completion bash)"
+//
 // NOTE: These examples assume a UNIX environment. If you're using Windows type "myapp.exe" rather than "./myapp".
 package commands
@@ -23,6 +23,7 @@ func init() {
 			}
 			fmt.Fprintf(os.Stderr, "  %s\n", v)
 		}
+		fmt.Fprintf(os.Stderr, "\nAll commands accept -yes, which assumes the default answer for any prompts.\n")
 		fmt.Fprintf(os.Stderr, "\nType %s help for details.\n", os.Args[0])
 	}
 }
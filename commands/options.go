@@ -32,6 +32,25 @@ type Options struct {
 	// Any flags this command might accept. Use the convenience
 	// functions to define them.
 	Flags []*Flag
+	// Options might be a pointer to a struct which declares additional
+	// flags, one per exported field. Flags are named after the field
+	// name, transformed like the command name, unless the field has
+	// a name tag. The help tag indicates the flag help, while the
+	// default value is the field value. Supported field types are bool,
+	// int, int64, uint, uint64, float64, string and time.Duration. Use
+	// ParseOptions to obtain the values from the command handler.
+	Options interface{}
+	// Args declares the positional arguments accepted by the command,
+	// which are checked before running it. Besides using IndexValue(),
+	// their values might be accessed by name using ParamValue(). If
+	// Usage is empty, it's generated from Args. Use the convenience
+	// functions to define them.
+	Args []*Arg
+	// Parent is the name of the command this one is a subcommand of.
+	// e.g. a command named migrate with Parent db is run as "db migrate".
+	// The parent doesn't need to be registered, running it without a
+	// subcommand prints the help of its subcommands.
+	Parent string
 }
 
 // Flags is a convenience function which returns the received flags as a slice.
@@ -65,3 +84,61 @@ func IntFlag(name string, def int, help string) *Flag {
 func StringFlag(name string, def string, help string) *Flag {
 	return makeFlag(name, help, typString, def)
 }
+
+// Arg is an opaque type used to represent a positional argument
+// of a command. Use the StringArg() and IntArg() functions to create
+// an Arg. You can also use the convenience function Args() to create
+// a slice with several arguments.
+type Arg struct {
+	name     string
+	help     string
+	typ      int
+	optional bool
+	variadic bool
+}
+
+// Optional marks the argument as optional. Only the last
+// arguments of a command might be optional. It returns
+// the same *Arg, to allow chaining calls.
+func (a *Arg) Optional() *Arg {
+	a.optional = true
+	return a
+}
+
+// Variadic makes the argument accept all the remaining values
+// in the command line. Its value, as returned by ParamValue(),
+// contains all of them separated by spaces. Only the last argument
+// of a command might be variadic. It returns the same *Arg, to
+// allow chaining calls.
+func (a *Arg) Variadic() *Arg {
+	a.variadic = true
+	return a
+}
+
+func (a *Arg) usage() string {
+	s := "<" + a.name + ">"
+	if a.variadic {
+		s += "..."
+	}
+	if a.optional {
+		s = "[" + s + "]"
+	}
+	return s
+}
+
+// Args is a convenience function which returns the received arguments as a slice.
+func Args(args ...*Arg) []*Arg {
+	return args
+}
+
+// StringArg returns an argument of type string with
+// the given name and help.
+func StringArg(name string, help string) *Arg {
+	return &Arg{name: name, help: help, typ: typString}
+}
+
+// IntArg returns an argument of type int with the given
+// name and help. Non-integer values are rejected.
+func IntArg(name string, help string) *Arg {
+	return &Arg{name: name, help: help, typ: typInt}
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"gnd.la/app"
+)
+
+var (
+	// promptInput and promptOutput are variables
+	// so they can be replaced in tests.
+	promptInput  io.Reader = os.Stdin
+	promptOutput io.Writer = os.Stderr
+	promptReader *bufio.Reader
+)
+
+// readLine reads a line from the prompt input, without
+// the trailing newline nor surrounding whitespace.
+func readLine() (string, error) {
+	if promptReader == nil {
+		promptReader = bufio.NewReader(promptInput)
+	}
+	line, err := promptReader.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	return strings.TrimSpace(line), err
+}
+
+// Yes returns true iff the command was invoked with the
+// -yes flag, which makes all the prompts return their
+// default values (or true, in the case of Confirm) without
+// asking the user. Commands might use it to skip any other
+// interactive behavior.
+func Yes(ctx *app.Context) bool {
+	yes, _ := strconv.ParseBool(ctx.ParamValue(yesFlag))
+	return yes
+}
+
+// Confirm asks the user a yes or no question and returns the
+// answer. If the user just presses enter or the input is closed,
+// def is returned. If the command was invoked with -yes, it returns
+// true without asking.
+func Confirm(ctx *app.Context, question string, def bool) bool {
+	if Yes(ctx) {
+		return true
+	}
+	choices := "y/N"
+	if def {
+		choices = "Y/n"
+	}
+	for {
+		fmt.Fprintf(promptOutput, "%s [%s] ", question, choices)
+		answer, err := readLine()
+		if err != nil {
+			fmt.Fprintln(promptOutput)
+			return def
+		}
+		switch strings.ToLower(answer) {
+		case "":
+			return def
+		case "y", "yes":
+			return true
+		case "n", "no":
+			return false
+		}
+		fmt.Fprintln(promptOutput, "Please, answer yes or no.")
+	}
+}
+
+// Prompt asks the user for a value, returning def if the user
+// just presses enter, the input is closed or the command was
+// invoked with -yes.
+func Prompt(ctx *app.Context, question string, def string) string {
+	if Yes(ctx) {
+		return def
+	}
+	if def != "" {
+		fmt.Fprintf(promptOutput, "%s [%s]: ", question, def)
+	} else {
+		fmt.Fprintf(promptOutput, "%s: ", question)
+	}
+	answer, err := readLine()
+	if err != nil {
+		fmt.Fprintln(promptOutput)
+	}
+	if answer == "" {
+		return def
+	}
+	return answer
+}
+
+// Choose asks the user to choose one of the given options and
+// returns the index of the chosen one. If the user just presses
+// enter, the input is closed or the command was invoked with -yes,
+// it returns def.
+func Choose(ctx *app.Context, question string, options []string, def int) int {
+	if Yes(ctx) {
+		return def
+	}
+	for ii, v := range options {
+		fmt.Fprintf(promptOutput, "%d) %s\n", ii+1, v)
+	}
+	for {
+		fmt.Fprintf(promptOutput, "%s [%d]: ", question, def+1)
+		answer, err := readLine()
+		if err != nil {
+			fmt.Fprintln(promptOutput)
+			return def
+		}
+		if answer == "" {
+			return def
+		}
+		if n, err := strconv.Atoi(answer); err == nil && n > 0 && n <= len(options) {
+			return n - 1
+		}
+		fmt.Fprintf(promptOutput, "Please, enter a number between 1 and %d.\n", len(options))
+	}
+}
@@ -1,6 +1,10 @@
-// Package config implements a file/command line based configuration.
+// Package config implements a file/environment/command line based configuration.
 //
 // Configuration values are defined using a struct, which can be tagged
-// to include default values and help strings. Then, values can be read
-// from a config file or specified in the command line.
+// to include default values, help strings and validation constraints.
+// Then, values can be read from layered config files (in ini, YAML or
+// JSON format), environment variables or specified in the command line.
+// Fields tagged with reload:"true" might also be updated at runtime, by
+// reloading the configuration on SIGHUP or when the config files change
+// (see Reload and Watch).
 package config
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gnd.la/util/types"
+)
+
+const redacted = "<redacted>"
+
+var (
+	secretNameRe  = regexp.MustCompile("(^|-)(secret|secrets|password|passwords|token|tokens|key|keys)(-|$)")
+	urlPasswordRe = regexp.MustCompile(`(?i)((?:password|passwd|pass|pwd|secret)=)[^\s&#]*`)
+	urlUserinfoRe = regexp.MustCompile(`^([^/@:]*:)[^/@]*@`)
+)
+
+// Dump writes the current values of all the registered config fields
+// to w, sorted by key, using the same format as the config files. Fields
+// which contain secrets are redacted. A field is considered secret if it
+// has the struct tag secret:"true" or if its config key contains a word
+// like secret, password, token or key (use secret:"false" to override
+// it). Passwords in *URL fields are always redacted.
+//
+// Dump is intended to show the effective configuration after Parse
+// has been called. It's also available as the -config-dump command
+// line flag, which dumps the configuration to the standard output and
+// exits.
+func Dump(w io.Writer) error {
+	fields, err := registeredFields(false)
+	if err != nil {
+		return err
+	}
+	return dumpFields(w, fields)
+}
+
+func dumpFields(w io.Writer, fields fieldMap) error {
+	keys := make([]string, 0, len(fields))
+	names := make(map[string]string, len(fields))
+	for k := range fields {
+		name := parameterName(k)
+		keys = append(keys, name)
+		names[name] = k
+	}
+	sort.Strings(keys)
+	for _, name := range keys {
+		f := fields[names[name]]
+		value := dumpValue(f.Value)
+		if value != "" && isSecret(name, f.Tag) {
+			value = redacted
+		}
+		if _, err := fmt.Fprintf(w, "%s = %s\n", name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isSecret(name string, tag reflect.StructTag) bool {
+	switch tag.Get("secret") {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return secretNameRe.MatchString(name)
+}
+
+func dumpValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		s := make([]string, v.Len())
+		for ii := range s {
+			s[ii] = quote(v.Index(ii))
+		}
+		return strings.Join(s, ", ")
+	case reflect.Map:
+		keys := v.MapKeys()
+		s := make([]string, len(keys))
+		for ii, k := range keys {
+			s[ii] = quote(k) + "=" + quote(v.MapIndex(k))
+		}
+		sort.Strings(s)
+		return strings.Join(s, ", ")
+	case reflect.Ptr:
+		if v.IsNil() {
+			return ""
+		}
+		if u, ok := v.Interface().(*URL); ok {
+			return redactURL(u.String())
+		}
+	}
+	return types.ToString(v.Interface())
+}
+
+func redactURL(s string) string {
+	if p := strings.Index(s, "://"); p >= 0 {
+		s = s[:p+3] + urlUserinfoRe.ReplaceAllString(s[p+3:], "${1}"+redacted+"@")
+	}
+	return urlPasswordRe.ReplaceAllString(s, "${1}"+redacted)
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvPrefix is the prefix used for the names of the environment
+// variables read by Parse. Change it before calling Parse to
+// use a different prefix (e.g. the name of your app).
+var EnvPrefix = "GONDOLA_"
+
+// EnvName returns the name of the environment variable used
+// for the config field with the given struct field name. It's
+// derived from the config key, converted to uppercase, with
+// dashes replaced by underscores and prefixed by EnvPrefix.
+// e.g. a field named "FooBar" is read from GONDOLA_FOO_BAR.
+func EnvName(name string) string {
+	return EnvPrefix + strings.ToUpper(strings.Replace(parameterName(name), "-", "_", -1))
+}
+
+func parseEnv(fields fieldMap) error {
+	for k, v := range fields {
+		name := EnvName(k)
+		if raw := os.Getenv(name); raw != "" {
+			if err := parseValue(v.Value, raw); err != nil {
+				return fmt.Errorf("error parsing environment variable %s (struct field %q): %s", name, k, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gnd.la/form/input"
+	"gnd.la/util/yaml"
+)
+
+// Format represents a config file format.
+type Format int
+
+const (
+	// FormatIni is the default format, with one "key = value"
+	// pair per line. See gnd.la/util/stringutil.ParseIni for
+	// the details.
+	FormatIni Format = iota
+	// FormatYAML parses the config as a YAML mapping. Lists
+	// and mappings might be used for slice and map fields.
+	FormatYAML
+	// FormatJSON parses the config as a JSON object. Arrays
+	// and objects might be used for slice and map fields.
+	FormatJSON
+)
+
+// String returns the format name.
+func (f Format) String() string {
+	switch f {
+	case FormatIni:
+		return "ini"
+	case FormatYAML:
+		return "yaml"
+	case FormatJSON:
+		return "json"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// FileFormat returns the Format for the given filename, based on its
+// extension. Files ending with .yaml or .yml use FormatYAML, files
+// ending with .json use FormatJSON and any other file uses FormatIni.
+func FileFormat(filename string) Format {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".json":
+		return FormatJSON
+	}
+	return FormatIni
+}
+
+// ParseReaderFormat works like ParseReader, but allows specifying
+// the format of the data read from r.
+func ParseReaderFormat(r io.Reader, format Format, config interface{}) error {
+	fields, err := configFields(config)
+	if err != nil {
+		return err
+	}
+	return parseReaderFormat(r, format, fields)
+}
+
+func parseReaderFormat(r io.Reader, format Format, fields fieldMap) error {
+	var values map[string]interface{}
+	switch format {
+	case FormatIni:
+		return parseReader(r, fields)
+	case FormatYAML:
+		if err := yaml.UnmarshalReader(r, &values); err != nil {
+			return err
+		}
+	case FormatJSON:
+		dec := json.NewDecoder(r)
+		dec.UseNumber()
+		if err := dec.Decode(&values); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid config format %s", format)
+	}
+	for k, v := range fields {
+		name := parameterName(k)
+		if raw, ok := values[name]; ok && raw != nil {
+			if err := setValue(v.Value, raw); err != nil {
+				return fmt.Errorf("error parsing config %s field %q (struct field %q): %s", format, name, k, err)
+			}
+		}
+	}
+	return nil
+}
+
+// setValue sets v from a value decoded from YAML or JSON. Scalars are
+// parsed like the values in ini files, while lists and mappings are
+// assigned element by element to slices and maps.
+func setValue(v reflect.Value, raw interface{}) error {
+	switch x := raw.(type) {
+	case []interface{}:
+		if v.Kind() != reflect.Slice {
+			return fmt.Errorf("can't assign a list to type %s", v.Type())
+		}
+		s := reflect.MakeSlice(v.Type(), len(x), len(x))
+		for ii, e := range x {
+			if err := parseScalar(s.Index(ii).Addr().Interface(), e); err != nil {
+				return fmt.Errorf("error parsing element at index %d: %s", ii, err)
+			}
+		}
+		v.Set(s)
+		return nil
+	case map[string]interface{}:
+		m := make(map[interface{}]interface{}, len(x))
+		for k, e := range x {
+			m[k] = e
+		}
+		return setMap(v, m)
+	case map[interface{}]interface{}:
+		return setMap(v, x)
+	}
+	s, err := scalarString(raw)
+	if err != nil {
+		return err
+	}
+	return parseValue(v, s)
+}
+
+func setMap(v reflect.Value, m map[interface{}]interface{}) error {
+	if v.Kind() != reflect.Map {
+		return fmt.Errorf("can't assign a mapping to type %s", v.Type())
+	}
+	mv := reflect.MakeMap(v.Type())
+	for k, e := range m {
+		key := reflect.New(v.Type().Key())
+		if err := parseScalar(key.Interface(), k); err != nil {
+			return fmt.Errorf("error parsing key %v: %s", k, err)
+		}
+		elem := reflect.New(v.Type().Elem())
+		if err := parseScalar(elem.Interface(), e); err != nil {
+			return fmt.Errorf("error parsing value for key %v: %s", k, err)
+		}
+		mv.SetMapIndex(key.Elem(), elem.Elem())
+	}
+	v.Set(mv)
+	return nil
+}
+
+func parseScalar(out interface{}, raw interface{}) error {
+	s, err := scalarString(raw)
+	if err != nil {
+		return err
+	}
+	return input.Parse(s, out)
+}
+
+func scalarString(raw interface{}) (string, error) {
+	switch x := raw.(type) {
+	case string:
+		return x, nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case int:
+		return strconv.Itoa(x), nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	case uint64:
+		return strconv.FormatUint(x, 10), nil
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64), nil
+	case json.Number:
+		return x.String(), nil
+	case nil:
+		return "", nil
+	}
+	return "", fmt.Errorf("unsupported value %v of type %T", raw, raw)
+}
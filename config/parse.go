@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -19,6 +20,9 @@ import (
 var (
 	DefaultFilename = pathutil.Relative("app.conf")
 	configName      *string
+	configEnv       *string
+	configDump      *bool
+	configReload    *bool
 )
 
 type fieldValue struct {
@@ -39,13 +43,50 @@ func (f fieldMap) Append(name string, value reflect.Value, tag reflect.StructTag
 type varMap map[string]interface{}
 
 // Filename returns the filename used by Parse(). If the -config command
-// line flag was provided, it returns its value. Otherwise, it returns
-// DefaultFilename().
+// line flag was provided, it returns its value. Otherwise, if the
+// environment variable named by EnvName("Config") (GONDOLA_CONFIG by
+// default) is set, its value is returned. If none of them was provided,
+// it returns DefaultFilename.
 func Filename() string {
-	if configName == nil || *configName == "" {
-		return DefaultFilename
+	if configName != nil && *configName != "" && hasProvidedConfig() {
+		return *configName
 	}
-	return *configName
+	if fn := os.Getenv(EnvName("Config")); fn != "" {
+		return fn
+	}
+	return DefaultFilename
+}
+
+// Environment returns the name of the environment used for selecting
+// the config files read by Parse. It's taken from the -config-env command
+// line flag or, if it wasn't provided, from the environment variable named
+// by EnvName("ConfigEnv") (GONDOLA_CONFIG_ENV by default).
+func Environment() string {
+	if configEnv != nil && *configEnv != "" {
+		return *configEnv
+	}
+	return os.Getenv(EnvName("ConfigEnv"))
+}
+
+// Filenames returns the config files read by Parse, in the order they're
+// read. The first one is returned by Filename, then the one for the
+// current Environment (if any) and finally the local one. The last two
+// are derived from the first one by inserting the environment name or
+// "local" before its extension. e.g. if Filename() returns app.conf and
+// Environment() returns production, this function returns app.conf,
+// app.production.conf and app.local.conf.
+func Filenames() []string {
+	fn := Filename()
+	if fn == "" {
+		return nil
+	}
+	ext := filepath.Ext(fn)
+	base := fn[:len(fn)-len(ext)]
+	filenames := []string{fn}
+	if env := Environment(); env != "" {
+		filenames = append(filenames, base+"."+env+ext)
+	}
+	return append(filenames, base+".local"+ext)
 }
 
 func parameterName(name string) string {
@@ -62,6 +103,10 @@ func hasProvidedConfig() bool {
 	return ret
 }
 
+func hasProvidedConfigFile() bool {
+	return hasProvidedConfig() || os.Getenv(EnvName("Config")) != ""
+}
+
 func parseValue(v reflect.Value, raw string) error {
 	switch v.Type().Kind() {
 	case reflect.Bool:
@@ -146,7 +191,8 @@ func parseValue(v reflect.Value, raw string) error {
 // struct. No signal is emitted. Look at the documentation of
 // Parse() for information on the supported types as well as
 // the name mangling performed in the struct fields to convert
-// them to config file keys.
+// them to config file keys. The file format is determined by
+// its extension (see FileFormat).
 func ParseFile(filename string, config interface{}) error {
 	fields, err := configFields(config)
 	if err != nil {
@@ -174,7 +220,7 @@ func parseFile(filename string, fields fieldMap) error {
 		return err
 	}
 	defer f.Close()
-	return parseReader(f, fields)
+	return parseReaderFormat(f, FileFormat(filename), fields)
 }
 
 func parseReader(r io.Reader, fields fieldMap) error {
@@ -314,12 +360,18 @@ func configFields(config interface{}) (fieldMap, error) {
 }
 
 func configValueFields(value reflect.Value) (fieldMap, error) {
+	return valueFields(value, true)
+}
+
+// valueFields returns the fields in the given struct value. If defaults
+// is true, the values in the default struct tags are assigned to them.
+func valueFields(value reflect.Value, defaults bool) (fieldMap, error) {
 	fields := make(fieldMap)
 	valueType := value.Type()
 	for ii := 0; ii < value.NumField(); ii++ {
 		field := value.Field(ii)
 		if field.Type().Kind() == reflect.Struct {
-			subfields, err := configFields(field.Addr().Interface())
+			subfields, err := valueFields(field, defaults)
 			if err != nil {
 				return nil, err
 			}
@@ -331,7 +383,7 @@ func configValueFields(value reflect.Value) (fieldMap, error) {
 			}
 		} else {
 			sfield := valueType.Field(ii)
-			if def := sfield.Tag.Get("default"); def != "" {
+			if def := sfield.Tag.Get("default"); defaults && def != "" {
 				err := parseValue(field, def)
 				if err != nil {
 					return nil, fmt.Errorf("error parsing default value for field %q: %s", sfield.Name, err)
@@ -388,20 +440,33 @@ func mapToString(v reflect.Value) string {
 	return strings.Join(s, ", ")
 }
 
-// Parse parses all configurations previously registered using Register or RegisterFunc.
-// See those functions for information about adding your own configuration parameters.
-func Parse() error {
-	configName = flag.String("config", DefaultFilename, "Config file name")
+// registeredFields returns the fields of all the registered
+// structs. See valueFields for the defaults argument.
+func registeredFields(defaults bool) (fieldMap, error) {
 	fields := make(fieldMap)
 	for _, v := range registry {
-		valueFields, err := configValueFields(v.value)
+		valueFields, err := valueFields(v.value, defaults)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for k, v := range valueFields {
 			fields[k] = v
 		}
 	}
+	return fields, nil
+}
+
+// Parse parses all configurations previously registered using Register or RegisterFunc.
+// See those functions for information about adding your own configuration parameters.
+func Parse() error {
+	configName = flag.String("config", DefaultFilename, "Config file name")
+	configEnv = flag.String("config-env", "", "Environment used for selecting the additional config file to read (e.g. production)")
+	configDump = flag.Bool("config-dump", false, "Print the effective configuration, with secrets redacted, and exit")
+	configReload = flag.Bool("config-reload", false, "Reload the configuration on SIGHUP or when the config files change")
+	fields, err := registeredFields(true)
+	if err != nil {
+		return err
+	}
 	/* Setup flags before calling flag.Parse() */
 	flagValues, err := setupFlags(fields)
 	if err != nil {
@@ -409,27 +474,54 @@ func Parse() error {
 	}
 	/* Now parse the flags */
 	flag.Parse()
-	/* Read config file first */
-	if fn := Filename(); fn != "" {
-		if err := parseFile(fn, fields); err != nil {
-			if hasProvidedConfig() || !os.IsNotExist(err) {
-				return err
-			}
-		}
+	/* Keep a copy of the initial values, so Reload can start from them */
+	for _, v := range registry {
+		v.base = copyValue(v.value)
 	}
-	/* Command line overrides config file */
-	if err := copyFlagValues(fields, flagValues); err != nil {
+	if err := parseSources(fields, flagValues); err != nil {
 		return err
 	}
+	if err := validateFields(fields); err != nil {
+		return err
+	}
+	parsedFlags = flagValues
+	if *configDump {
+		if err := dumpFields(os.Stdout, fields); err != nil {
+			return err
+		}
+		os.Exit(0)
+	}
 	// Call registry functions
 	for _, v := range registry {
 		if v.f != nil {
 			v.f()
 		}
 	}
+	if *configReload {
+		Watch(DefaultWatchInterval)
+	}
 	return nil
 }
 
+// parseSources reads the config files, the environment and the
+// command line flags, in that order, into the given fields.
+func parseSources(fields fieldMap, flagValues varMap) error {
+	/* Read config files first, later ones override previous ones */
+	for ii, fn := range Filenames() {
+		if err := parseFile(fn, fields); err != nil {
+			if (ii == 0 && hasProvidedConfigFile()) || !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	/* Environment overrides config files */
+	if err := parseEnv(fields); err != nil {
+		return err
+	}
+	/* Command line overrides everything else */
+	return copyFlagValues(fields, flagValues)
+}
+
 // MustParse works like Parse, but panics if there's an error.
 func MustParse() {
 	if err := Parse(); err != nil {
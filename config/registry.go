@@ -11,6 +11,9 @@ var (
 type entry struct {
 	value reflect.Value
 	f     func()
+	// base is a copy of value before reading the config
+	// sources, used by Reload.
+	base reflect.Value
 }
 
 // Register is a shorthand for RegisterFunc(value, nil).
@@ -25,17 +28,23 @@ func Register(value interface{}) {
 // two struct tags are taken into account. The "help" tag is used when to provide
 // a help string to the user when defining command like flags, while the "default"
 // tag is used to provide a default value for the field in case it hasn't been
-// provided as a config key nor a command line flag.
+// provided as a config key nor a command line flag. The "required", "min" and "max"
+// tags declare constraints which are checked after parsing (see Validate), while
+// the "secret" tag controls if the field is redacted by Dump.
 //
-// The parsing process starts by reading the config file returned by Filename()
-// (which might be overriden by the -config command line flag), and then parses
-// any flags provided in the command line. This means any value in the config
-// file might be overriden by a command line flag.
+// The parsing process starts by reading the config files returned by Filenames()
+// (the one returned by Filename(), which might be overriden by the -config command
+// line flag, followed by the ones for the current Environment() and the local one),
+// then reads the environment variables (see EnvName) and finally parses any flags
+// provided in the command line. This means any value in a config file might be
+// overriden by a later config file, an environment variable or a command line flag.
+// Config files might use the ini, YAML or JSON formats (see FileFormat).
 //
 // Go's idiomatic camel-cased struct field names are mangled into lowercase words
 // to produce the flag names and config fields. e.g. a field named "FooBar" will
-// produce a "-foo-bar" flag and a "foo-bar" config key. Embedded struct are
-// flattened, as if their fields were part of the container struct.
+// produce a "-foo-bar" flag, a "foo-bar" config key and a GONDOLA_FOO_BAR
+// environment variable. Embedded struct are flattened, as if their fields were
+// part of the container struct.
 //
 //  var MyConfig struct {
 //	MyStringValue	string
@@ -0,0 +1,192 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"gnd.la/signal"
+)
+
+const (
+	// CHANGED is emitted after the configuration is reloaded and
+	// any field has changed. The object is a *Change.
+	CHANGED = "gnd.la/config.changed"
+	// RELOAD_FAILED is emitted when reloading the configuration
+	// from Watch fails. The object is the error.
+	RELOAD_FAILED = "gnd.la/config.reload-failed"
+)
+
+// DefaultWatchInterval is the interval used for checking
+// the config files for changes when the -config-reload
+// command line flag is provided.
+const DefaultWatchInterval = 2 * time.Second
+
+var (
+	errNotParsed = errors.New("can't reload the configuration before calling Parse")
+	reloadMutex  sync.Mutex
+	valuesMutex  sync.RWMutex
+	parsedFlags  varMap
+)
+
+// Change describes the changes made to the configuration
+// by Reload. Fields are identified by their struct field
+// names (e.g. LogDebug).
+type Change struct {
+	// Fields contains the fields which changed and
+	// have been updated with their new values.
+	Fields []string
+	// Rejected contains the fields which changed but can't
+	// be updated without restarting the process. They keep
+	// their previous values.
+	Rejected []string
+}
+
+// Has returns true iff the field with the given name
+// changed and has been updated.
+func (c *Change) Has(name string) bool {
+	for _, v := range c.Fields {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Reload parses again all the configurations registered with Register
+// or RegisterFunc, using the same sources as Parse (config files,
+// environment variables and command line flags, in that order). Since
+// the config files and the environment might have changed, this allows
+// updating the configuration without restarting the process.
+//
+// Only fields tagged with reload:"true" are updated, any other changed
+// field is reported in Change.Rejected and keeps its previous value.
+// Packages opt in to runtime changes by tagging their fields and listening
+// for the CHANGED signal, which is emitted if any field changed. Note that
+// the functions passed to RegisterFunc are not called again.
+//
+// The new values are parsed into copies of the registered structs and
+// validated (see Validate) before assigning any of them, so either all
+// reloadable fields are updated or, if there's an error, none of them is.
+// Since the new values are assigned while other goroutines might be
+// running, reloadable fields must only be read while holding RLock.
+func Reload() (*Change, error) {
+	reloadMutex.Lock()
+	defer reloadMutex.Unlock()
+	if parsedFlags == nil {
+		return nil, errNotParsed
+	}
+	current, err := registeredFields(false)
+	if err != nil {
+		return nil, err
+	}
+	fresh := make(fieldMap)
+	for _, v := range registry {
+		fields, err := valueFields(copyValue(v.base), false)
+		if err != nil {
+			return nil, err
+		}
+		for k, f := range fields {
+			fresh[k] = f
+		}
+	}
+	if err := parseSources(fresh, parsedFlags); err != nil {
+		return nil, err
+	}
+	if err := validateFields(fresh); err != nil {
+		return nil, err
+	}
+	change := &Change{}
+	for k, v := range current {
+		if reflect.DeepEqual(v.Value.Interface(), fresh[k].Value.Interface()) {
+			continue
+		}
+		if v.Tag.Get("reload") == "true" {
+			change.Fields = append(change.Fields, k)
+		} else {
+			change.Rejected = append(change.Rejected, k)
+		}
+	}
+	sort.Strings(change.Fields)
+	sort.Strings(change.Rejected)
+	valuesMutex.Lock()
+	for _, k := range change.Fields {
+		current[k].Value.Set(fresh[k].Value)
+	}
+	valuesMutex.Unlock()
+	if len(change.Fields) > 0 || len(change.Rejected) > 0 {
+		signal.Emit(CHANGED, change)
+	}
+	return change, nil
+}
+
+// RLock locks the registered configurations for reading. Fields
+// tagged with reload:"true" might be updated by Reload at any time,
+// so they must be read while holding this lock, usually from an
+// accessor function like e.g. gnd.la/net/mail.DefaultServer.
+// Readers see either all the values before a Reload or all the
+// values after it.
+func RLock() {
+	valuesMutex.RLock()
+}
+
+// RUnlock undoes a single RLock call.
+func RUnlock() {
+	valuesMutex.RUnlock()
+}
+
+// copyValue returns a copy of the given struct value. Pointers
+// are copied too, so parsing into the copy (e.g. into a *URL)
+// doesn't alter the original.
+func copyValue(v reflect.Value) reflect.Value {
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	for ii := 0; ii < c.NumField(); ii++ {
+		field := c.Field(ii)
+		if !field.CanSet() {
+			continue
+		}
+		switch field.Kind() {
+		case reflect.Struct:
+			field.Set(copyValue(field))
+		case reflect.Ptr:
+			if !field.IsNil() {
+				p := reflect.New(field.Type().Elem())
+				p.Elem().Set(field.Elem())
+				field.Set(p)
+			}
+		}
+	}
+	return c
+}
+
+func watchFiles(interval time.Duration) {
+	modTimes := fileModTimes()
+	for range time.Tick(interval) {
+		if m := fileModTimes(); !reflect.DeepEqual(m, modTimes) {
+			modTimes = m
+			reload()
+		}
+	}
+}
+
+// fileModTimes returns the modification times of
+// the config files which exist.
+func fileModTimes() map[string]time.Time {
+	m := make(map[string]time.Time)
+	for _, v := range Filenames() {
+		if st, err := os.Stat(v); err == nil {
+			m[v] = st.ModTime()
+		}
+	}
+	return m
+}
+
+func reload() {
+	if _, err := Reload(); err != nil {
+		signal.Emit(RELOAD_FAILED, err)
+	}
+}
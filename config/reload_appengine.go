@@ -0,0 +1,12 @@
+// +build appengine
+
+package config
+
+import (
+	"time"
+)
+
+// Watch does nothing on App Engine, since the configuration
+// can't change without deploying a new version.
+func Watch(interval time.Duration) {
+}
@@ -0,0 +1,42 @@
+// +build !appengine
+
+package config
+
+import (
+	"os"
+	ossignal "os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+var watching struct {
+	sync.Mutex
+	started bool
+}
+
+// Watch starts reloading the configuration (see Reload) when the
+// process receives a SIGHUP and, if interval is positive, when any of
+// the config files returned by Filenames is created, modified or removed,
+// checking them at the given interval. Errors while reloading are
+// reported by emitting the RELOAD_FAILED signal. Only the first call
+// to Watch has any effect. It's also started by Parse when the
+// -config-reload command line flag is provided.
+func Watch(interval time.Duration) {
+	watching.Lock()
+	defer watching.Unlock()
+	if watching.started {
+		return
+	}
+	watching.started = true
+	ch := make(chan os.Signal, 1)
+	ossignal.Notify(ch, syscall.SIGHUP)
+	go func() {
+		for range ch {
+			reload()
+		}
+	}()
+	if interval > 0 {
+		go watchFiles(interval)
+	}
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gnd.la/util/types"
+)
+
+// ValidationError is returned by Parse and Validate when
+// one or more config fields don't satisfy their constraints.
+type ValidationError struct {
+	// Errors contains one message per invalid field,
+	// sorted by config key.
+	Errors []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid config: " + strings.Join(e.Errors, "; ")
+}
+
+// Validate checks the constraints declared in the config struct tags.
+// The "required" tag, when set to "true", requires the field to have a
+// non-zero value. The "min" and "max" tags limit the value of numeric
+// fields and the length of string, slice and map fields, but they're
+// only checked when the field has a non-zero value. Parse calls this
+// function for every registered struct before calling the functions
+// passed to RegisterFunc. If any field is invalid, a *ValidationError is
+// returned.
+//
+//  var MyConfig struct {
+//	Port	int `required:"true" min:"1" max:"65535"`
+//	Hosts	[]string `min:"1"`
+//  }
+func Validate(config interface{}) error {
+	fields, err := configFields(config)
+	if err != nil {
+		return err
+	}
+	return validateFields(fields)
+}
+
+func validateFields(fields fieldMap) error {
+	var errs []string
+	for k, v := range fields {
+		if err := validateField(v); err != "" {
+			errs = append(errs, parameterName(k)+" "+err)
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func validateField(f *fieldValue) string {
+	val := f.Value
+	if isZero(val) {
+		if f.Tag.Get("required") == "true" {
+			return "is required"
+		}
+		// Limits only apply to fields which have been set
+		return ""
+	}
+	for _, tag := range []string{"min", "max"} {
+		limit := f.Tag.Get(tag)
+		if limit == "" {
+			continue
+		}
+		bound, err := strconv.ParseFloat(limit, 64)
+		if err != nil {
+			return fmt.Sprintf("has an invalid %s tag %q", tag, limit)
+		}
+		var v float64
+		var what string
+		switch {
+		case types.IsInt(val.Type()):
+			v = float64(val.Int())
+		case types.IsUint(val.Type()):
+			v = float64(val.Uint())
+		case types.IsFloat(val.Type()):
+			v = val.Float()
+		case val.Kind() == reflect.String || val.Kind() == reflect.Slice || val.Kind() == reflect.Map:
+			v = float64(val.Len())
+			what = "length "
+		default:
+			return fmt.Sprintf("can't have a %s tag (type %s)", tag, val.Type())
+		}
+		if tag == "min" && v < bound {
+			return fmt.Sprintf("%smust be at least %s", what, limit)
+		}
+		if tag == "max" && v > bound {
+			return fmt.Sprintf("%smust be at most %s", what, limit)
+		}
+	}
+	return ""
+}
+
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+	}
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
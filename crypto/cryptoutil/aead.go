@@ -0,0 +1,217 @@
+package cryptoutil
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gnd.la/encoding/base64"
+	"gnd.la/util/stringutil"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// aeadVersion is the first byte of every value
+	// produced by AEADEncrypter. It must be incremented
+	// if the format ever changes.
+	aeadVersion = 1
+	// aeadHeaderSize is the size of the header prepended
+	// to the ciphertext, containing the version and the
+	// algorithm.
+	aeadHeaderSize = 2
+)
+
+var (
+	// Tried to decrypt a value with an unsupported format version.
+	ErrUnknownVersion = errors.New("unknown encrypted value version")
+)
+
+// AEADAlgorithm represents an authenticated encryption
+// algorithm supported by AEADEncrypter.
+type AEADAlgorithm uint8
+
+const (
+	// AESGCM uses a block cipher (AES by default) in GCM mode.
+	AESGCM AEADAlgorithm = iota + 1
+	// ChaCha20Poly1305 uses the ChaCha20-Poly1305 construction. It
+	// requires 32 byte keys.
+	ChaCha20Poly1305
+)
+
+func (a AEADAlgorithm) String() string {
+	switch a {
+	case AESGCM:
+		return "AES-GCM"
+	case ChaCha20Poly1305:
+		return "ChaCha20-Poly1305"
+	}
+	return fmt.Sprintf("AEADAlgorithm(%d)", uint8(a))
+}
+
+// AEADEncrypter performs authenticated symmetrical encryption and
+// decryption, optionally authenticating additional data which is not
+// encrypted. Unlike Encrypter, its output does not need to be signed
+// in order to detect tampering.
+//
+// Every value produced by AEADEncrypter starts with a header which
+// includes the format version and the algorithm used, so values
+// can still be decrypted after changing Algorithm. Keys can be rotated
+// by moving the current Key to OldKeys, since decryption with the
+// wrong key is always detected.
+type AEADEncrypter struct {
+	// Algorithm is the algorithm used for encrypting new values.
+	// If zero, AESGCM is used.
+	Algorithm AEADAlgorithm
+	// Cipherer returns the cipher.Block used with AESGCM. If nil,
+	// it defaults to aes.NewCipher.
+	Cipherer Cipherer
+	// Key is the encryption key. If empty, all public methods
+	// will return ErrNoEncryptionKey.
+	Key []byte
+	// OldKeys are previous encryption keys, never used for encrypting
+	// but tried in order after Key when decrypting.
+	OldKeys [][]byte
+	// Legacy, if non-nil, is used by DecryptString to decrypt
+	// values produced by EncryptSigner.EncryptSign, allowing
+	// transparent migration from unauthenticated encryption.
+	Legacy *EncryptSigner
+}
+
+func (e *AEADEncrypter) aead(alg AEADAlgorithm, key []byte) (cipher.AEAD, error) {
+	switch alg {
+	case AESGCM:
+		cipherer := e.Cipherer
+		if cipherer == nil {
+			cipherer = aes.NewCipher
+		}
+		block, err := cipherer(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	case ChaCha20Poly1305:
+		return chacha20poly1305.New(key)
+	}
+	return nil, fmt.Errorf("unknown AEAD algorithm %s", alg)
+}
+
+// Encrypt encrypts and authenticates the given data as well as
+// authenticating the additional data, which might be nil. The same
+// additional data must be provided to Decrypt.
+func (e *AEADEncrypter) Encrypt(data []byte, additional []byte) ([]byte, error) {
+	if len(e.Key) == 0 {
+		return nil, ErrNoEncryptionKey
+	}
+	alg := e.Algorithm
+	if alg == 0 {
+		alg = AESGCM
+	}
+	aead, err := e.aead(alg, e.Key)
+	if err != nil {
+		return nil, err
+	}
+	ns := aead.NonceSize()
+	out := make([]byte, aeadHeaderSize+ns, aeadHeaderSize+ns+len(data)+aead.Overhead())
+	out[0] = aeadVersion
+	out[1] = byte(alg)
+	copy(out[aeadHeaderSize:], stringutil.RandomBytes(ns))
+	nonce := out[aeadHeaderSize:]
+	return aead.Seal(out, nonce, data, aeadAdditional(out[:aeadHeaderSize], additional)), nil
+}
+
+// Decrypt decrypts the given data, previously returned from Encrypt,
+// checking that neither the data nor the additional data were
+// tampered with.
+func (e *AEADEncrypter) Decrypt(data []byte, additional []byte) ([]byte, error) {
+	value, _, err := e.DecryptKey(data, additional)
+	return value, err
+}
+
+// DecryptKey works like Decrypt, but also returns the index of the key
+// used to decrypt the data. 0 indicates Key, while values > 0 indicate
+// OldKeys[key-1].
+func (e *AEADEncrypter) DecryptKey(data []byte, additional []byte) ([]byte, int, error) {
+	if len(e.Key) == 0 {
+		return nil, -1, ErrNoEncryptionKey
+	}
+	if len(data) < aeadHeaderSize {
+		return nil, -1, ErrCouldNotDecrypt
+	}
+	if data[0] != aeadVersion {
+		return nil, -1, ErrUnknownVersion
+	}
+	alg := AEADAlgorithm(data[1])
+	ad := aeadAdditional(data[:aeadHeaderSize], additional)
+	for ii := 0; ii <= len(e.OldKeys); ii++ {
+		key := e.Key
+		if ii > 0 {
+			key = e.OldKeys[ii-1]
+		}
+		aead, err := e.aead(alg, key)
+		if err != nil {
+			// Key might not be valid for this algorithm
+			// (e.g. ChaCha20Poly1305 requires 32 byte keys).
+			continue
+		}
+		ns := aead.NonceSize()
+		if len(data) < aeadHeaderSize+ns+aead.Overhead() {
+			return nil, -1, ErrCouldNotDecrypt
+		}
+		nonce, in := data[aeadHeaderSize:aeadHeaderSize+ns], data[aeadHeaderSize+ns:]
+		if out, err := aead.Open(nil, nonce, in, ad); err == nil {
+			return out, ii, nil
+		}
+	}
+	return nil, -1, ErrCouldNotDecrypt
+}
+
+// EncryptString works like Encrypt, but returns its result encoded
+// as a string which can be safely used in cookies, urls or headers.
+func (e *AEADEncrypter) EncryptString(data []byte, additional []byte) (string, error) {
+	enc, err := e.Encrypt(data, additional)
+	if err != nil {
+		return "", err
+	}
+	return base64.Encode(enc), nil
+}
+
+// DecryptString decrypts a value returned from EncryptString. If the
+// value was produced by EncryptSigner.EncryptSign and e.Legacy is
+// non-nil, it's decrypted using e.Legacy, ignoring the additional
+// data. The returned *KeyInfo indicates which keys were used to
+// produce the value, callers should encrypt it again when its
+// Current method returns false.
+func (e *AEADEncrypter) DecryptString(value string, additional []byte) ([]byte, *KeyInfo, error) {
+	// Values produced by Signer.Sign always include a ':',
+	// which is never produced by base64.Encode.
+	if strings.IndexByte(value, ':') >= 0 {
+		if e.Legacy == nil {
+			return nil, nil, ErrUnknownVersion
+		}
+		data, keys, err := e.Legacy.UnsignDecryptKeys(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys.Legacy = true
+		return data, keys, nil
+	}
+	enc, err := base64.Decode(value)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, key, err := e.DecryptKey(enc, additional)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, &KeyInfo{Encrypt: key}, nil
+}
+
+func aeadAdditional(header []byte, additional []byte) []byte {
+	ad := make([]byte, len(header)+len(additional))
+	copy(ad, header)
+	copy(ad[len(header):], additional)
+	return ad
+}
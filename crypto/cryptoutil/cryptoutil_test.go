@@ -0,0 +1,226 @@
+package cryptoutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+var (
+	testSalt    = []byte("gnd.la/crypto/cryptoutil.test")
+	testSecret1 = []byte("w3gS3C6oYVxgeZ8JeupQQ7iuVdKWnC1h")
+	testSecret2 = []byte("lJ4pTGZyYJ0T9yWsyaBq6Wn3nOvHkDcO")
+	testKey1    = []byte("o1SWq95d8WGJUicB")
+	testKey2    = []byte("K4OA6HXo2i9ZvB2n")
+	testValue   = []byte("gondola")
+)
+
+func TestSignerRotation(t *testing.T) {
+	old := &Signer{Key: testSecret1, Salt: testSalt}
+	signed, err := old.Sign(testValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cur := &Signer{Key: testSecret2, Salt: testSalt}
+	if _, err := cur.Unsign(signed); err != ErrTampered {
+		t.Errorf("expecting ErrTampered without old keys, got %v", err)
+	}
+	cur.OldKeys = [][]byte{testSecret1}
+	value, key, err := cur.UnsignKey(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(value, testValue) {
+		t.Errorf("expecting value %q, got %q", testValue, value)
+	}
+	if key != 1 {
+		t.Errorf("expecting key 1, got %d", key)
+	}
+	resigned, err := cur.Sign(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, key, _ := cur.UnsignKey(resigned); key != 0 {
+		t.Errorf("expecting key 0 after re-signing, got %d", key)
+	}
+}
+
+func TestEncryptSignerRotation(t *testing.T) {
+	old := &EncryptSigner{
+		Encrypter: &Encrypter{Key: testKey1},
+		Signer:    &Signer{Key: testSecret1, Salt: testSalt},
+	}
+	value, err := old.EncryptSign(testValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cur := &EncryptSigner{
+		Encrypter: &Encrypter{Key: testKey2, OldKeys: [][]byte{testKey1}},
+		Signer:    &Signer{Key: testSecret2, Salt: testSalt, OldKeys: [][]byte{testSecret1}},
+	}
+	dec, keys, err := cur.UnsignDecryptKeys(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dec, testValue) {
+		t.Errorf("expecting value %q, got %q", testValue, dec)
+	}
+	if keys.Sign != 1 || keys.Encrypt != 1 || keys.Current() {
+		t.Errorf("expecting old keys, got %+v", keys)
+	}
+	value, err = cur.EncryptSign(dec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, keys, err := cur.UnsignDecryptKeys(value); err != nil || !keys.Current() {
+		t.Errorf("expecting current keys, got %+v (error %v)", keys, err)
+	}
+}
+
+func TestEncryptSignerLegacy(t *testing.T) {
+	es := &EncryptSigner{
+		Encrypter: &Encrypter{Key: testKey1},
+		Signer:    &Signer{Key: testSecret1, Salt: testSalt},
+	}
+	// Values produced before encryption keys were
+	// bound to the signature.
+	enc, err := es.Encrypter.Encrypt(testValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := es.Signer.Sign(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, keys, err := es.UnsignDecryptKeys(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dec, testValue) {
+		t.Errorf("expecting value %q, got %q", testValue, dec)
+	}
+	if keys.Encrypt != -1 {
+		t.Errorf("expecting legacy encryption key -1, got %d", keys.Encrypt)
+	}
+	// After rotating the encryption key, legacy values can't
+	// be attributed to any key, so they must be rejected rather
+	// than decrypted to garbage.
+	for _, k := range [][][]byte{{testKey1, testKey2}, {testKey2, testKey1}} {
+		es.Encrypter = &Encrypter{Key: k[0], OldKeys: [][]byte{k[1]}}
+		if _, _, err := es.UnsignDecryptKeys(value); err != ErrCouldNotDecrypt {
+			t.Errorf("expecting ErrCouldNotDecrypt for legacy value after rotating encryption key, got %v", err)
+		}
+	}
+}
+
+func TestAEADEncrypter(t *testing.T) {
+	for _, alg := range []AEADAlgorithm{AESGCM, ChaCha20Poly1305} {
+		key := testKey1
+		if alg == ChaCha20Poly1305 {
+			key = testSecret1
+		}
+		ae := &AEADEncrypter{Algorithm: alg, Key: key}
+		enc, err := ae.Encrypt(testValue, []byte("name"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		dec, err := ae.Decrypt(enc, []byte("name"))
+		if err != nil {
+			t.Fatalf("error decrypting with %s: %s", alg, err)
+		}
+		if !bytes.Equal(dec, testValue) {
+			t.Errorf("expecting value %q with %s, got %q", testValue, alg, dec)
+		}
+		if _, err := ae.Decrypt(enc, []byte("other")); err != ErrCouldNotDecrypt {
+			t.Errorf("expecting ErrCouldNotDecrypt with other additional data and %s, got %v", alg, err)
+		}
+		enc[len(enc)-1]++
+		if _, err := ae.Decrypt(enc, []byte("name")); err != ErrCouldNotDecrypt {
+			t.Errorf("expecting ErrCouldNotDecrypt with tampered data and %s, got %v", alg, err)
+		}
+	}
+}
+
+func TestAEADEncrypterRotation(t *testing.T) {
+	old := &AEADEncrypter{Key: testKey1}
+	value, err := old.EncryptString(testValue, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Change both the key and the algorithm
+	cur := &AEADEncrypter{Algorithm: ChaCha20Poly1305, Key: testSecret2, OldKeys: [][]byte{testKey1}}
+	dec, keys, err := cur.DecryptString(value, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dec, testValue) {
+		t.Errorf("expecting value %q, got %q", testValue, dec)
+	}
+	if keys.Encrypt != 1 || keys.Current() {
+		t.Errorf("expecting old key, got %+v", keys)
+	}
+}
+
+func TestAEADEncrypterLegacy(t *testing.T) {
+	es := &EncryptSigner{
+		Encrypter: &Encrypter{Key: testKey1},
+		Signer:    &Signer{Key: testSecret1, Salt: testSalt},
+	}
+	value, err := es.EncryptSign(testValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ae := &AEADEncrypter{Key: testKey1}
+	if _, _, err := ae.DecryptString(value, nil); err != ErrUnknownVersion {
+		t.Errorf("expecting ErrUnknownVersion without Legacy, got %v", err)
+	}
+	ae.Legacy = es
+	dec, keys, err := ae.DecryptString(value, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dec, testValue) {
+		t.Errorf("expecting value %q, got %q", testValue, dec)
+	}
+	if !keys.Legacy || keys.Current() {
+		t.Errorf("expecting legacy value, got %+v", keys)
+	}
+}
+
+func TestEncrypterDerive(t *testing.T) {
+	e := &Encrypter{Key: testKey2, OldKeys: [][]byte{testKey1}}
+	d1, err := e.Derive(testSalt, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d1.Key) != len(e.Key) || len(d1.OldKeys) != 1 || len(d1.OldKeys[0]) != len(testKey1) {
+		t.Errorf("expecting derived keys with the same length, got %+v", d1)
+	}
+	if bytes.Equal(d1.Key, e.Key) || bytes.Equal(d1.OldKeys[0], testKey1) {
+		t.Error("derived keys can't be equal to the original ones")
+	}
+	d2, err := e.Derive(testSalt, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(d1.Key, d2.Key) {
+		t.Error("derived keys must be deterministic")
+	}
+	for _, v := range []struct {
+		salt []byte
+		info string
+	}{
+		{[]byte("other salt"), "test"},
+		{testSalt, "other"},
+	} {
+		d, err := e.Derive(v.salt, v.info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(d.Key, d1.Key) {
+			t.Errorf("expecting different keys with salt %q and info %q", v.salt, v.info)
+		}
+	}
+	if _, err := (&Encrypter{}).Derive(testSalt, "test"); err != ErrNoEncryptionKey {
+		t.Errorf("expecting ErrNoEncryptionKey, got %v", err)
+	}
+}
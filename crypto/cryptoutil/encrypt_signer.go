@@ -1,8 +1,43 @@
 package cryptoutil
 
+import (
+	"crypto/sha256"
+)
+
+// KeyInfo reports which keys were used to produce a value
+// returned from EncryptSigner.EncryptSign or AEADEncrypter.EncryptString.
+// Indices follow the same convention used by Signer.UnsignKey and
+// Encrypter.DecryptKey, 0 meaning the current key and n > 0 meaning
+// the n-th old key.
+type KeyInfo struct {
+	// Sign is the index of the key used for signing. It's always
+	// 0 for values produced by AEADEncrypter, since they're not signed.
+	Sign int
+	// Encrypt is the index of the key used for encryption or
+	// -1 if the value was produced before encryption keys were
+	// bound to the signature. Those values are only accepted
+	// while the Encrypter has no old keys (see
+	// EncryptSigner.UnsignDecryptKeys).
+	Encrypt int
+	// Legacy is true when AEADEncrypter.DecryptString decrypted a
+	// value produced by EncryptSigner.
+	Legacy bool
+}
+
+// Current returns true iff the value was produced using the
+// current signing and encryption keys and the current format.
+func (k *KeyInfo) Current() bool {
+	return !k.Legacy && k.Sign == 0 && k.Encrypt == 0
+}
+
 // EncryptSigner is a conveniency type
 // which performs encryption with and then
 // signs the encrypted data.
+//
+// The signature also covers a fingerprint of the encryption
+// key, which allows EncryptSigner to determine which of the
+// Encrypter keys should be used for decrypting a value, making
+// it possible to rotate both the signing and the encryption keys.
 type EncryptSigner struct {
 	// Encrypter used for encryption/decryption.
 	Encrypter *Encrypter
@@ -17,7 +52,11 @@ func (e *EncryptSigner) EncryptSign(data []byte) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return e.Signer.Sign(enc)
+	signer, err := e.keySigner(0)
+	if err != nil {
+		return "", err
+	}
+	return signer.Sign(enc)
 }
 
 // UnsignDecrypt takes an encrypted and signed string, previously returned
@@ -25,9 +64,68 @@ func (e *EncryptSigner) EncryptSign(data []byte) (string, error) {
 // If the signature does not match or the data can't be correctly decrypted
 // an error is returned.
 func (e *EncryptSigner) UnsignDecrypt(data string) ([]byte, error) {
-	enc, err := e.Signer.Unsign(data)
+	value, _, err := e.UnsignDecryptKeys(data)
+	return value, err
+}
+
+// UnsignDecryptKeys works like UnsignDecrypt, but also returns
+// which keys were used to produce the value. Callers might use
+// this information to re-encrypt and re-sign values which were
+// not produced with the current keys (see KeyInfo.Current).
+//
+// Values produced before encryption keys were bound to signatures
+// are only accepted while the Encrypter has no OldKeys. Since CTR
+// mode can't detect decryption with the wrong key, these values
+// can't be safely decrypted once the encryption key has been rotated,
+// so ErrCouldNotDecrypt is returned for them.
+func (e *EncryptSigner) UnsignDecryptKeys(data string) ([]byte, *KeyInfo, error) {
+	for ii := 0; ii <= len(e.Encrypter.OldKeys); ii++ {
+		signer, err := e.keySigner(ii)
+		if err != nil {
+			return nil, nil, err
+		}
+		enc, sk, err := signer.UnsignKey(data)
+		if err != nil {
+			if err == ErrTampered {
+				continue
+			}
+			return nil, nil, err
+		}
+		value, err := e.Encrypter.DecryptKey(enc, ii)
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, &KeyInfo{Sign: sk, Encrypt: ii}, nil
+	}
+	// Value produced before encryption keys were bound
+	// to signatures.
+	enc, sk, err := e.Signer.UnsignKey(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(e.Encrypter.OldKeys) > 0 {
+		// There's no way to tell which key
+		// was used to encrypt the value.
+		return nil, nil, ErrCouldNotDecrypt
+	}
+	value, err := e.Encrypter.Decrypt(enc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, &KeyInfo{Sign: sk, Encrypt: -1}, nil
+}
+
+// keySigner returns a copy of e.Signer with its salt bound
+// to the encryption key at the given index.
+func (e *EncryptSigner) keySigner(key int) (*Signer, error) {
+	k, err := e.Encrypter.key(key)
 	if err != nil {
 		return nil, err
 	}
-	return e.Encrypter.Decrypt(enc)
+	h := sha256.Sum256(k)
+	signer := *e.Signer
+	salt := make([]byte, 0, len(signer.Salt)+8)
+	salt = append(salt, signer.Salt...)
+	signer.Salt = append(salt, h[:8]...)
+	return &signer, nil
 }
@@ -3,8 +3,13 @@ package cryptoutil
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/sha256"
 	"errors"
+	"io"
+
 	"gnd.la/util/stringutil"
+
+	"golang.org/x/crypto/hkdf"
 )
 
 var (
@@ -22,7 +27,8 @@ type Cipherer func(key []byte) (cipher.Block, error)
 // using a block cipher in CTR mode and a random IV.
 // It's important to note that the output from Encrypt
 // must also be authenticated in other to be secure. One easy
-// way to the that is using Signer.
+// way to the that is using Signer. New code should prefer
+// AEADEncrypter, which provides authenticated encryption.
 type Encrypter struct {
 	// Cipherer returns a cipher.Block initialized with
 	// the given key. If nil, it defaults to aes.NewCipher
@@ -30,23 +36,83 @@ type Encrypter struct {
 	// Key is the encryption key. If empty, all public methods
 	// will return ErrNoEncryptionKey.
 	Key []byte
+	// OldKeys are previous encryption keys, which are never
+	// used for encrypting but might be used for decrypting
+	// using DecryptKey. Note that CTR mode can't detect
+	// decryption with the wrong key, so the caller must know
+	// which key was used (EncryptSigner takes care of this).
+	OldKeys [][]byte
 }
 
-func (e *Encrypter) getCipher() (cipher.Block, error) {
-	if len(e.Key) == 0 {
-		return nil, ErrNoEncryptionKey
+func (e *Encrypter) getCipher(key int) (cipher.Block, error) {
+	k, err := e.key(key)
+	if err != nil {
+		return nil, err
 	}
 	cipherer := e.Cipherer
 	if cipherer == nil {
 		cipherer = aes.NewCipher
 	}
-	return cipherer(e.Key)
+	return cipherer(k)
+}
+
+// key returns Key for 0 and OldKeys[idx-1] for idx > 0.
+func (e *Encrypter) key(idx int) ([]byte, error) {
+	var k []byte
+	if idx == 0 {
+		k = e.Key
+	} else if idx > 0 && idx <= len(e.OldKeys) {
+		k = e.OldKeys[idx-1]
+	}
+	if len(k) == 0 {
+		return nil, ErrNoEncryptionKey
+	}
+	return k, nil
+}
+
+// Derive returns a copy of the Encrypter with its Key and OldKeys
+// replaced by keys derived from them using HKDF with SHA-256 and
+// the given salt and info. Derived keys have the same length as
+// the original ones, so they're still valid for the Cipherer. This
+// allows using the same configured key for different purposes (e.g.
+// CTR and AEAD encryption) without sharing any key material between
+// them.
+func (e *Encrypter) Derive(salt []byte, info string) (*Encrypter, error) {
+	if len(e.Key) == 0 {
+		return nil, ErrNoEncryptionKey
+	}
+	key, err := deriveKey(e.Key, salt, info)
+	if err != nil {
+		return nil, err
+	}
+	var oldKeys [][]byte
+	for _, v := range e.OldKeys {
+		k, err := deriveKey(v, salt, info)
+		if err != nil {
+			return nil, err
+		}
+		oldKeys = append(oldKeys, k)
+	}
+	return &Encrypter{
+		Cipherer: e.Cipherer,
+		Key:      key,
+		OldKeys:  oldKeys,
+	}, nil
+}
+
+func deriveKey(key []byte, salt []byte, info string) ([]byte, error) {
+	r := hkdf.New(sha256.New, key, salt, []byte(info))
+	derived := make([]byte, len(key))
+	if _, err := io.ReadFull(r, derived); err != nil {
+		return nil, err
+	}
+	return derived, nil
 }
 
 // Encrypt encrypts the given data using the Encrypter's
 // Cipherer and Key, using a random initialization vector.
 func (e *Encrypter) Encrypt(data []byte) ([]byte, error) {
-	ci, err := e.getCipher()
+	ci, err := e.getCipher(0)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +128,13 @@ func (e *Encrypter) Encrypt(data []byte) ([]byte, error) {
 // Decrypt decrypts the given data using the Encrypter's
 // Cipherer and Key.
 func (e *Encrypter) Decrypt(data []byte) ([]byte, error) {
-	ci, err := e.getCipher()
+	return e.DecryptKey(data, 0)
+}
+
+// DecryptKey works like Decrypt, but uses the key at the given
+// index. 0 represents Key, while values > 0 represent OldKeys[key-1].
+func (e *Encrypter) DecryptKey(data []byte, key int) ([]byte, error) {
+	ci, err := e.getCipher(key)
 	if err != nil {
 		return nil, err
 	}
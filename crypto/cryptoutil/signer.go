@@ -39,24 +39,30 @@ type Signer struct {
 	Hasher Hasher
 	// Key is the key used for signing the data.
 	Key []byte
+	// OldKeys are previous keys which are no longer used
+	// for signing, but are still accepted when checking
+	// signatures. They're tried in order after Key, which
+	// allows rotating keys without invalidating every
+	// previously signed value.
+	OldKeys [][]byte
 	// Salt is prepended to the value to be signed. See the Signer
 	// documentation for security considerations about the salt.
 	Salt []byte
 }
 
-func (s *Signer) sign(data []byte) ([]byte, error) {
-	if len(s.Key) == 0 {
+func (s *Signer) sign(key []byte, data []byte) ([]byte, error) {
+	if len(key) == 0 {
 		return nil, ErrNoSigningKey
 	}
 	var h hash.Hash
 	var err error
 	if s.Hasher != nil {
-		h, err = s.Hasher(s.Key)
+		h, err = s.Hasher(key)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		h = hmac.New(sha1.New, s.Key)
+		h = hmac.New(sha1.New, key)
 	}
 	if len(s.Salt) > 0 {
 		if _, err := h.Write(s.Salt); err != nil {
@@ -73,7 +79,7 @@ func (s *Signer) sign(data []byte) ([]byte, error) {
 // as a string. See Signer documentation for the characteristics of
 // the returned string.
 func (s *Signer) Sign(data []byte) (string, error) {
-	signature, err := s.sign(data)
+	signature, err := s.sign(s.Key, data)
 	if err != nil {
 		return "", err
 	}
@@ -84,24 +90,45 @@ func (s *Signer) Sign(data []byte) (string, error) {
 // that its signature is valid and, in that case, returns the initial
 // data. If the signature is not valid, an error is returned.
 func (s *Signer) Unsign(signed string) ([]byte, error) {
+	data, _, err := s.UnsignKey(signed)
+	return data, err
+}
+
+// UnsignKey works like Unsign, but also returns the index of the key
+// which produced the signature. 0 indicates Key, while values > 0
+// indicate OldKeys[key-1]. Callers might use this information to
+// re-sign values produced with an old key.
+func (s *Signer) UnsignKey(signed string) ([]byte, int, error) {
 	parts := strings.Split(signed, ":")
 	if len(parts) != 2 {
-		return nil, ErrNotSigned
+		return nil, -1, ErrNotSigned
 	}
 	data, err := base64.Decode(parts[0])
 	if err != nil {
-		return nil, err
+		return nil, -1, err
 	}
 	signature, err := base64.Decode(parts[1])
 	if err != nil {
-		return nil, err
+		return nil, -1, err
 	}
-	sign, err := s.sign(data)
-	if err != nil {
-		return nil, err
+	for ii, key := range s.keys() {
+		sign, err := s.sign(key, data)
+		if err != nil {
+			return nil, -1, err
+		}
+		if len(sign) == len(signature) && subtle.ConstantTimeCompare(sign, signature) == 1 {
+			return data, ii, nil
+		}
 	}
-	if len(sign) != len(signature) || subtle.ConstantTimeCompare(sign, signature) != 1 {
-		return nil, ErrTampered
+	return nil, -1, ErrTampered
+}
+
+// keys returns Key followed by OldKeys.
+func (s *Signer) keys() [][]byte {
+	if len(s.OldKeys) == 0 {
+		return [][]byte{s.Key}
 	}
-	return data, nil
+	keys := make([][]byte, 0, len(s.OldKeys)+1)
+	keys = append(keys, s.Key)
+	return append(keys, s.OldKeys...)
 }
@@ -0,0 +1,246 @@
+package password
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gnd.la/util/stringutil"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Algorithm represents a password hashing algorithm. Its value
+// is used as the algorithm identifier in encoded passwords, with
+// the exception of PBKDF2, which uses the Hash name for compatibility
+// with passwords encoded by previous versions.
+type Algorithm string
+
+const (
+	// PBKDF2 uses PBKDF2 with the given Hash and number of rounds.
+	PBKDF2 Algorithm = "pbkdf2"
+	// Scrypt uses the scrypt key derivation function.
+	Scrypt Algorithm = "scrypt"
+	// Bcrypt uses bcrypt. Since bcrypt doesn't accept passwords
+	// longer than 72 bytes, longer ones are hashed with SHA-256
+	// before being passed to bcrypt.
+	Bcrypt Algorithm = "bcrypt"
+	// Argon2id uses the id variant of Argon2.
+	Argon2id Algorithm = "argon2id"
+	// DefaultAlgorithm is the algorithm used by default, currently
+	// Argon2id.
+	DefaultAlgorithm = Argon2id
+)
+
+const (
+	// DefaultScryptN is the default scrypt CPU/memory cost parameter.
+	DefaultScryptN = 32768
+	// DefaultScryptR is the default scrypt block size parameter.
+	DefaultScryptR = 8
+	// DefaultScryptP is the default scrypt parallelization parameter.
+	DefaultScryptP = 1
+	// DefaultBcryptCost is the default bcrypt cost.
+	DefaultBcryptCost = 12
+	// DefaultArgon2Time is the default number of Argon2 passes.
+	DefaultArgon2Time = 2
+	// DefaultArgon2Memory is the default Argon2 memory size, in KiB.
+	DefaultArgon2Memory = 19 * 1024
+	// DefaultArgon2Threads is the default Argon2 parallelism.
+	DefaultArgon2Threads = 1
+
+	// saltLength is the salt length used for scrypt and argon2id.
+	saltLength = 32
+	// keyLength is the output length used for scrypt and argon2id.
+	keyLength = 32
+	// bcryptSaltLength is the length of the salt encoded
+	// by bcrypt.
+	bcryptSaltLength = 22
+	// bcryptMaxLength is the maximum password length
+	// accepted by bcrypt.
+	bcryptMaxLength = 72
+)
+
+// parameters returns the algorithm parameters from the given
+// options, which might be nil. Returned parameters are always
+// in the order they're encoded.
+func (a Algorithm) parameters(opts *Options) []int {
+	if opts == nil {
+		opts = &Options{}
+	}
+	switch a {
+	case PBKDF2:
+		return []int{orDefault(opts.Rounds, DefaultRounds)}
+	case Scrypt:
+		return []int{
+			orDefault(opts.ScryptN, DefaultScryptN),
+			orDefault(opts.ScryptR, DefaultScryptR),
+			orDefault(opts.ScryptP, DefaultScryptP),
+		}
+	case Bcrypt:
+		return []int{orDefault(opts.BcryptCost, DefaultBcryptCost)}
+	case Argon2id:
+		return []int{
+			orDefault(opts.Argon2Time, DefaultArgon2Time),
+			orDefault(opts.Argon2Memory, DefaultArgon2Memory),
+			orDefault(opts.Argon2Threads, DefaultArgon2Threads),
+		}
+	}
+	panic(fmt.Errorf("invalid algorithm %q", string(a)))
+}
+
+// checkParameters returns ErrInvalidParameters if the given
+// parameters can't be used with the algorithm.
+func (a Algorithm) checkParameters(params []int) error {
+	switch a {
+	case Bcrypt:
+		if params[0] < bcrypt.MinCost || params[0] > bcrypt.MaxCost {
+			return ErrInvalidParameters
+		}
+	case Argon2id:
+		// argon2 takes uint32 time and memory and
+		// uint8 threads, which must be non-zero.
+		if params[0] <= 0 || uint64(params[0]) > math.MaxUint32 ||
+			params[1] <= 0 || uint64(params[1]) > math.MaxUint32 ||
+			params[2] <= 0 || params[2] > math.MaxUint8 {
+			return ErrInvalidParameters
+		}
+	}
+	return nil
+}
+
+// bcryptPlain returns the plaintext passed to bcrypt for the given
+// password. Passwords longer than bcryptMaxLength are hashed with
+// SHA-256 and encoded as base64, which yields 44 bytes.
+func bcryptPlain(plain string) []byte {
+	if len(plain) <= bcryptMaxLength {
+		return []byte(plain)
+	}
+	h := sha256.Sum256([]byte(plain))
+	return []byte(base64.StdEncoding.EncodeToString(h[:]))
+}
+
+// key returns the result of hashing the plaintext with the
+// given salt and parameters. For bcrypt, the salt is ignored
+// and the returned value includes the salt.
+func (a Algorithm) key(h Hash, params []int, salt string, plain string) ([]byte, error) {
+	switch a {
+	case PBKDF2:
+		return h.RawHash(salt, plain, params[0]), nil
+	case Scrypt:
+		return scrypt.Key([]byte(plain), []byte(salt), params[0], params[1], params[2], keyLength)
+	case Bcrypt:
+		return bcrypt.GenerateFromPassword(bcryptPlain(plain), params[0])
+	case Argon2id:
+		return argon2.IDKey([]byte(plain), []byte(salt), uint32(params[0]), uint32(params[1]), uint8(params[2]), keyLength), nil
+	}
+	return nil, fmt.Errorf("invalid algorithm %q", string(a))
+}
+
+// AlgorithmNamed returns the algorithm with the given name, as
+// encoded in the first field of a Password. Hash names (e.g. "sha1")
+// return PBKDF2. If no algorithm with that name is found, an error
+// is returned.
+func AlgorithmNamed(name string) (Algorithm, error) {
+	switch Algorithm(name) {
+	case Scrypt, Bcrypt, Argon2id:
+		return Algorithm(name), nil
+	}
+	if _, err := HashNamed(name); err == nil {
+		return PBKDF2, nil
+	}
+	return "", fmt.Errorf("no algorithm named %q", name)
+}
+
+// decoded represents a Password split into its fields.
+type decoded struct {
+	alg    Algorithm
+	hash   Hash
+	params []int
+	salt   string
+	hashed []byte
+}
+
+func (d *decoded) check(plain string) error {
+	if d.alg == Bcrypt {
+		encoded := fmt.Sprintf("$2a$%02d$%s%s", d.params[0], d.salt, d.hashed)
+		if bcrypt.CompareHashAndPassword([]byte(encoded), bcryptPlain(plain)) != nil {
+			return ErrNoMatch
+		}
+		return nil
+	}
+	key, err := d.alg.key(d.hash, d.params, d.salt, plain)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(d.hashed, key) != 1 {
+		return ErrNoMatch
+	}
+	return nil
+}
+
+func decodeParameters(s string, count int) ([]int, error) {
+	fields := strings.Split(s, ",")
+	if len(fields) != count {
+		return nil, ErrInvalidParameters
+	}
+	params := make([]int, count)
+	for ii, v := range fields {
+		p, err := strconv.Atoi(v)
+		if err != nil || p <= 0 {
+			return nil, ErrInvalidParameters
+		}
+		params[ii] = p
+	}
+	return params, nil
+}
+
+func encodeParameters(params []int) string {
+	s := make([]string, len(params))
+	for ii, v := range params {
+		s[ii] = strconv.Itoa(v)
+	}
+	return strings.Join(s, ",")
+}
+
+func newPassword(plain string, alg Algorithm, h Hash, params []int) (Password, error) {
+	if err := alg.checkParameters(params); err != nil {
+		return "", err
+	}
+	var salt string
+	switch alg {
+	case PBKDF2:
+		// Use the same number of bits for the salt and the hash, since
+		// it provides the maximum possible security.
+		salt = stringutil.Random(h.Size())
+		return Password(fmt.Sprintf("%s:%d:%s:%s", h.Name(), params[0], salt, h.Hash(salt, plain, params[0]))), nil
+	case Bcrypt:
+		encoded, err := alg.key(h, params, "", plain)
+		if err != nil {
+			return "", err
+		}
+		// bcrypt encodes the result as $2a$cost$salt+hash
+		p := strings.LastIndex(string(encoded), "$") + 1
+		salt, hashed := string(encoded[p:p+bcryptSaltLength]), string(encoded[p+bcryptSaltLength:])
+		return Password(fmt.Sprintf("%s:%d:%s:%s", alg, params[0], salt, hashed)), nil
+	}
+	salt = stringutil.Random(saltLength)
+	key, err := alg.key(h, params, salt, plain)
+	if err != nil {
+		return "", err
+	}
+	return Password(fmt.Sprintf("%s:%s:%s:%s", alg, encodeParameters(params), salt, hex.EncodeToString(key))), nil
+}
+
+func orDefault(val int, def int) int {
+	if val > 0 {
+		return val
+	}
+	return def
+}
@@ -2,7 +2,12 @@
 // and checking passwords.
 //
 // Passwords are encoded using a per-password salt and then
-// hashed using PBKDF2 with the chosen algorithm (sha256 by default).
+// hashed using the chosen Algorithm (Argon2id by default). Scrypt,
+// bcrypt and PBKDF2 (with SHA1 or SHA2) are also supported. The
+// encoded password includes the algorithm and its parameters, so
+// changing the defaults doesn't break already stored passwords.
+// Use Password.NeedsRehash after a successful Check() to determine
+// if the stored password should be encoded again.
 // Password provides the Check() method for verifying that
 // the given plaintext matches the encoded password. This
 // method is not vulnerable to timing attacks.
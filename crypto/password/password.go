@@ -1,11 +1,9 @@
 package password
 
 import (
-	"crypto/subtle"
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"gnd.la/util/stringutil"
 	"strconv"
 	"strings"
 )
@@ -28,6 +26,12 @@ var (
 	// ErrInvalidHex means the encoded password value is not properly
 	// encoded as hexadecimal.
 	ErrInvalidHex = errors.New("hashed password is not properly encoded")
+	// ErrInvalidParameters means the algorithm parameters stored in the
+	// password or specified in the Options are not valid.
+	ErrInvalidParameters = errors.New("invalid algorithm parameters")
+	// ErrTooLong means the plaintext password is longer than
+	// MaxPasswordLength.
+	ErrTooLong = errors.New("password is too long")
 )
 
 const (
@@ -35,10 +39,10 @@ const (
 	// or verify a password longer than this will cause an error. This
 	// is a measure against DoS attacks.
 	MaxPasswordLength = 8192
-	// DefaultRounds is the number of PBKDF2 rounds used when creating a new password.
-	// Altering this number won't break already generated and stored passwords,
+	// DefaultRounds is the number of PBKDF2 rounds used when creating a new password
+	// with PBKDF2. Altering this number won't break already generated and stored passwords,
 	// since they store the number of rounds they were created with.
-	DefaultRounds = 4096
+	DefaultRounds = 600000
 )
 
 // Password represents an encoded password, which can be stored
@@ -60,30 +64,67 @@ func (p Password) field(idx int) string {
 	return string(p)[s : s+c]
 }
 
-func (p Password) validate() ([]byte, Hash, string, int, error) {
+func (p Password) decode() (*decoded, error) {
 	if strings.Count(string(p), ":") != 3 {
-		return nil, 0, "", 0, ErrInvalidFieldCount
+		return nil, ErrInvalidFieldCount
 	}
-	h, err := p.Hash()
+	alg, err := p.Algorithm()
 	if err != nil {
-		return nil, 0, "", 0, err
+		return nil, err
 	}
-	rounds, err := p.Rounds()
-	if err != nil {
-		return nil, 0, "", 0, ErrInvalidRoundCount
+	d := &decoded{alg: alg, salt: p.Salt()}
+	switch alg {
+	case PBKDF2:
+		if d.hash, err = p.Hash(); err != nil {
+			return nil, err
+		}
+		rounds, err := p.Rounds()
+		if err != nil || rounds <= 0 {
+			return nil, ErrInvalidRoundCount
+		}
+		d.params = []int{rounds}
+		if len(d.salt) != d.hash.Size() {
+			return nil, ErrInvalidSaltLength
+		}
+	case Bcrypt:
+		if d.params, err = decodeParameters(p.field(1), 1); err != nil {
+			return nil, err
+		}
+		if err := alg.checkParameters(d.params); err != nil {
+			return nil, err
+		}
+		if len(d.salt) != bcryptSaltLength {
+			return nil, ErrInvalidSaltLength
+		}
+		d.hashed = []byte(p.field(3))
+		return d, nil
+	default:
+		if d.params, err = decodeParameters(p.field(1), 3); err != nil {
+			return nil, err
+		}
+		if err := alg.checkParameters(d.params); err != nil {
+			return nil, err
+		}
+		if len(d.salt) != saltLength {
+			return nil, ErrInvalidSaltLength
+		}
 	}
-	salt := p.Salt()
-	if len(salt) != h.Size() {
-		return nil, 0, "", 0, ErrInvalidSaltLength
+	if d.hashed, err = hex.DecodeString(p.field(3)); err != nil {
+		return nil, ErrInvalidHex
 	}
-	decoded, err := hex.DecodeString(p.field(3))
-	if err != nil {
-		return nil, 0, "", 0, ErrInvalidHex
+	size := keyLength
+	if alg == PBKDF2 {
+		size = d.hash.Size()
 	}
-	if len(decoded) != h.Size() {
-		return nil, 0, "", 0, ErrInvalidHashedLength
+	if len(d.hashed) != size {
+		return nil, ErrInvalidHashedLength
 	}
-	return decoded, h, salt, rounds, nil
+	return d, nil
+}
+
+// Algorithm returns the Algorithm used to encode the password.
+func (p Password) Algorithm() (Algorithm, error) {
+	return AlgorithmNamed(p.field(0))
 }
 
 // Salt returns the salt used to encode the password.
@@ -91,18 +132,25 @@ func (p Password) Salt() string {
 	return p.field(2)
 }
 
-// Hash returns the Hash used to encode the password.
+// Hash returns the Hash used to encode the password. Only
+// passwords encoded with PBKDF2 have a Hash.
 func (p Password) Hash() (Hash, error) {
 	return HashNamed(p.field(0))
 }
 
 // Rounds returns the number of PBKDF2 rounds used to encode this password.
+// For passwords not encoded with PBKDF2, an error is returned.
 func (p Password) Rounds() (int, error) {
+	if _, err := p.Hash(); err != nil {
+		return 0, err
+	}
 	r := p.field(1)
 	return strconv.Atoi(r)
 }
 
-// String returns the password string as hash:rounds:salt:hex(hash)
+// String returns the password string as algorithm:parameters:salt:hash.
+// For PBKDF2, algorithm is the Hash name and parameters is the number
+// of rounds.
 func (p Password) String() string {
 	return string(p)
 }
@@ -111,7 +159,7 @@ func (p Password) String() string {
 // This means it has a hash that is available and the salt and hashed
 // data have the same length as the hash output.
 func (p Password) IsValid() bool {
-	_, _, _, _, err := p.validate()
+	_, err := p.decode()
 	return err == nil
 }
 
@@ -123,17 +171,38 @@ func (p Password) Check(plain string) error {
 	if len(plain) > MaxPasswordLength {
 		return ErrNoMatch
 	}
-	decoded, hash, salt, rounds, err := p.validate()
+	d, err := p.decode()
 	if err != nil {
 		// This does not affect the time-constness of the function
 		// since an invalid Password string will always return at
 		// this point, regardless of the input.
 		return err
 	}
-	if subtle.ConstantTimeCompare(decoded, hash.RawHash(salt, plain, rounds)) != 1 {
-		return ErrNoMatch
+	return d.check(plain)
+}
+
+// NeedsRehash returns true if the password was not encoded using
+// the algorithm and parameters specified by the given Options (or
+// the defaults, if opts is nil), or if the password is not valid.
+// Callers should rehash the password by calling NewOptions with
+// the plaintext password after it has been successfully checked.
+// Note that passwords encoded with stronger parameters than the
+// specified ones don't need to be rehashed.
+func (p Password) NeedsRehash(opts *Options) bool {
+	d, err := p.decode()
+	if err != nil {
+		return true
+	}
+	alg, hash := opts.algorithm()
+	if d.alg != alg || (alg == PBKDF2 && d.hash != hash) {
+		return true
 	}
-	return nil
+	for ii, v := range alg.parameters(opts) {
+		if d.params[ii] < v {
+			return true
+		}
+	}
+	return false
 }
 
 // Matches is a shorthand for Check(plain) == nil. Id est,
@@ -144,8 +213,8 @@ func (p Password) Matches(plain string) bool {
 	return p.Check(plain) == nil
 }
 
-// New returns a new Password hashed using DefaultHash with
-// DefaultRounds. Most users would want to use this function
+// New returns a new Password hashed using DefaultAlgorithm with
+// its default parameters. Most users would want to use this function
 // to create a Password from a plaintext string. For advanced
 // uses, see NewOptions.
 func New(plain string) Password {
@@ -153,37 +222,82 @@ func New(plain string) Password {
 }
 
 // Options specify the Password options when creating one
-// from its plaintext.
+// from its plaintext. Zero fields are replaced by their
+// defaults.
 type Options struct {
-	// The Hash to use for hashing the Password. If this
+	// Algorithm is the algorithm used for hashing the Password.
+	// If empty, DefaultAlgorithm is used, unless Hash is
+	// non-zero. In that case, PBKDF2 is used.
+	Algorithm Algorithm
+	// The Hash to use for hashing the Password with PBKDF2. If this
 	// field is zero, DefaultHash is used.
 	Hash Hash
 	// Rounds is the number of PBKDF2 iterations used
 	// for the password. If this field is <= 0, DefaultRounds
 	// is used instead.
 	Rounds int
+	// ScryptN, ScryptR and ScryptP are the scrypt parameters. See
+	// DefaultScryptN, DefaultScryptR and DefaultScryptP.
+	ScryptN int
+	ScryptR int
+	ScryptP int
+	// BcryptCost is the bcrypt cost. See DefaultBcryptCost.
+	BcryptCost int
+	// Argon2Time, Argon2Memory (in KiB) and Argon2Threads are the
+	// Argon2id parameters. See DefaultArgon2Time, DefaultArgon2Memory
+	// and DefaultArgon2Threads.
+	Argon2Time    int
+	Argon2Memory  int
+	Argon2Threads int
 }
 
-// NewOptions returns a password hashed with the given hash
-// and rounds. If the hash is not available or not valid,
-// it will panic. If Hash or Rounds are not provided,
-// DefaultHash and DefaultRounds, respectivelly, are used.
+func (o *Options) algorithm() (Algorithm, Hash) {
+	alg := DefaultAlgorithm
+	hash := DefaultHash
+	if o != nil {
+		if o.Hash != Hash(0) {
+			alg = PBKDF2
+			hash = o.Hash
+		}
+		if o.Algorithm != "" {
+			alg = o.Algorithm
+		}
+	}
+	return alg, hash
+}
+
+// NewOptions returns a password hashed with the given options. If
+// the hash or the algorithm are not available or not valid, it will
+// panic. See Options for the default values used for its fields.
+// If plain is longer than MaxPasswordLength, an empty Password is
+// returned.
 func NewOptions(plain string, opts *Options) Password {
+	p, err := Encode(plain, opts)
+	if err != nil {
+		if err == ErrTooLong {
+			return Password("")
+		}
+		panic(err)
+	}
+	return p
+}
+
+// Encode works like NewOptions, but returns an error rather than
+// panicking when the password can't be hashed with the given options.
+// Passwords longer than MaxPasswordLength return ErrTooLong.
+func Encode(plain string, opts *Options) (Password, error) {
 	if len(plain) > MaxPasswordLength {
-		return Password("")
+		return Password(""), ErrTooLong
 	}
-	hash := DefaultHash
-	rounds := DefaultRounds
-	if opts != nil {
-		if opts.Hash != Hash(0) {
-			hash = opts.Hash
-		}
-		if opts.Rounds > 0 {
-			rounds = opts.Rounds
+	alg, hash := opts.algorithm()
+	switch alg {
+	case PBKDF2:
+		if !hash.Available() {
+			return Password(""), fmt.Errorf("hash %d is not available", int(hash))
 		}
+	case Scrypt, Bcrypt, Argon2id:
+	default:
+		return Password(""), fmt.Errorf("invalid algorithm %q", string(alg))
 	}
-	// Use the same number of bits for the salt and the hash, since
-	// it provides the maximum possible security.
-	salt := stringutil.Random(hash.Size())
-	return Password(fmt.Sprintf("%s:%d:%s:%s", hash.Name(), rounds, salt, hash.Hash(salt, plain, rounds)))
+	return newPassword(plain, alg, hash, alg.parameters(opts))
 }
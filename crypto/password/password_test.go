@@ -1,6 +1,7 @@
 package password
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -29,3 +30,111 @@ func TestInvalidPasswords(t *testing.T) {
 		}
 	}
 }
+
+func TestAlgorithms(t *testing.T) {
+	pw := "gondola"
+	opts := []*Options{
+		{Algorithm: Scrypt, ScryptN: 1024},
+		{Algorithm: Bcrypt, BcryptCost: 4},
+		{Algorithm: Argon2id, Argon2Memory: 1024},
+		{Algorithm: PBKDF2, Rounds: 1024},
+	}
+	for _, v := range opts {
+		p := NewOptions(pw, v)
+		t.Logf("Password %q was encoded using %s as %q", pw, v.Algorithm, p.String())
+		if err := p.Check(pw); err != nil {
+			t.Errorf("Error verifying password %q using %s: %s", pw, v.Algorithm, err)
+		}
+		if p.Matches(pw + "1") {
+			t.Errorf("Password %q using %s matches %q", pw, v.Algorithm, pw+"1")
+		}
+		if alg, err := p.Algorithm(); err != nil || alg != v.Algorithm {
+			t.Errorf("expecting algorithm %s, got %s (error %v)", v.Algorithm, alg, err)
+		}
+		if p.NeedsRehash(v) {
+			t.Errorf("password %q using %s needs rehash with the same options", p, v.Algorithm)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	// PBKDF2 password encoded with 4096 rounds.
+	p := Password("sha1:4096:JJf2f46fmbw06LwXJ308:9b4d23006b93e1d6bb052c1545d9532d1433736b")
+	if err := p.Check("gondola"); err != nil {
+		t.Fatal(err)
+	}
+	if !p.NeedsRehash(nil) {
+		t.Errorf("password %q does not need rehash with the default options", p)
+	}
+	if p.NeedsRehash(&Options{Hash: SHA1, Rounds: 4096}) {
+		t.Errorf("password %q needs rehash with its own options", p)
+	}
+	if !p.NeedsRehash(&Options{Hash: SHA1, Rounds: 8192}) {
+		t.Errorf("password %q does not need rehash with more rounds", p)
+	}
+	weak := NewOptions("gondola", &Options{Algorithm: Scrypt, ScryptN: 1024})
+	if !weak.NeedsRehash(&Options{Algorithm: Scrypt}) {
+		t.Errorf("password %q does not need rehash with default scrypt parameters", weak)
+	}
+	if !Password("").NeedsRehash(nil) {
+		t.Error("empty password does not need rehash")
+	}
+}
+
+func TestLongPasswords(t *testing.T) {
+	long := strings.Repeat("gondola", 20)
+	opts := []*Options{
+		{Algorithm: Scrypt, ScryptN: 1024},
+		{Algorithm: Bcrypt, BcryptCost: 4},
+		{Algorithm: Argon2id, Argon2Memory: 1024},
+		{Algorithm: PBKDF2, Rounds: 1024},
+	}
+	for _, v := range opts {
+		p, err := Encode(long, v)
+		if err != nil {
+			t.Errorf("error encoding long password using %s: %s", v.Algorithm, err)
+			continue
+		}
+		if err := p.Check(long); err != nil {
+			t.Errorf("error verifying long password using %s: %s", v.Algorithm, err)
+		}
+		// Passwords sharing a prefix longer than 72 bytes
+		// must not match with bcrypt either.
+		if p.Matches(long[:len(long)-1] + "b") {
+			t.Errorf("long password using %s matches a different password", v.Algorithm)
+		}
+		if _, err := Encode(strings.Repeat("a", MaxPasswordLength+1), v); err != ErrTooLong {
+			t.Errorf("expecting ErrTooLong using %s, got %v", v.Algorithm, err)
+		}
+	}
+}
+
+func TestInvalidParameters(t *testing.T) {
+	opts := []*Options{
+		{Algorithm: Bcrypt, BcryptCost: 32},
+		{Algorithm: Argon2id, Argon2Threads: 256},
+		{Algorithm: Algorithm("foo")},
+	}
+	for _, v := range opts {
+		if _, err := Encode("gondola", v); err == nil {
+			t.Errorf("expecting an error encoding with %+v", v)
+		}
+	}
+	salt := strings.Repeat("a", saltLength)
+	hashed := strings.Repeat("00", keyLength)
+	invalid := []Password{
+		// uint8(256) would be 0 threads, which panics in argon2
+		Password("argon2id:2,1024,256:" + salt + ":" + hashed),
+		Password("argon2id:2,1024,0:" + salt + ":" + hashed),
+		Password("argon2id:2,4294967296,1:" + salt + ":" + hashed),
+		Password("bcrypt:32:" + strings.Repeat("a", bcryptSaltLength) + ":foo"),
+	}
+	for _, v := range invalid {
+		if v.IsValid() {
+			t.Errorf("password %q with invalid parameters parsed as valid", v)
+		}
+		if err := v.Check("gondola"); err == nil {
+			t.Errorf("password %q with invalid parameters matches", v)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Package totp implements time-based one time passwords, as
+// defined by RFC 6238, and the HMAC-based one time passwords
+// they're built on, defined by RFC 4226.
+//
+// TOTP codes are compatible with most authenticator apps (e.g.
+// Google Authenticator or FreeOTP). Use Key.URI to generate the
+// provisioning URI, which is usually displayed as a QR code.
+//
+//	key := totp.NewKey()
+//	uri := key.URI("My Site", "alice@example.com")
+//	// Later, when the user enters a code
+//	if _, ok := key.Validate(code, time.Now()); ok {
+//		// code is valid
+//	}
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// DefaultDigits is the default number of digits in
+	// a code.
+	DefaultDigits = 6
+	// DefaultPeriod is the default time step.
+	DefaultPeriod = 30 * time.Second
+	// DefaultSkew is the default number of periods before and after
+	// the current one which are also accepted by Validate, to allow
+	// for clock drift and for the time the user takes to enter the code.
+	DefaultSkew = 1
+	// SecretSize is the size of the secrets generated by NewKey. It
+	// matches the HMAC-SHA1 output size, as recommended by RFC 4226.
+	SecretSize = 20
+)
+
+var (
+	encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+	powers   = [...]uint32{1, 10, 100, 1000, 10000, 100000, 1000000, 10000000, 100000000, 1000000000}
+)
+
+// Algorithm is the hash function used by the HMAC.
+type Algorithm int
+
+const (
+	// SHA1 is the default algorithm and the only one supported by
+	// some authenticator apps.
+	SHA1 Algorithm = iota
+	SHA256
+	SHA512
+)
+
+func (a Algorithm) hash() func() hash.Hash {
+	switch a {
+	case SHA1:
+		return sha1.New
+	case SHA256:
+		return sha256.New
+	case SHA512:
+		return sha512.New
+	}
+	panic(fmt.Errorf("invalid TOTP algorithm %d", int(a)))
+}
+
+func (a Algorithm) String() string {
+	switch a {
+	case SHA1:
+		return "SHA1"
+	case SHA256:
+		return "SHA256"
+	case SHA512:
+		return "SHA512"
+	}
+	return fmt.Sprintf("Algorithm(%d)", int(a))
+}
+
+// HOTP returns the HMAC-based one time password with the given
+// number of digits for the given secret and counter, as defined by
+// RFC 4226.
+func HOTP(alg Algorithm, secret []byte, counter uint64, digits int) string {
+	if digits <= 0 || digits >= len(powers) {
+		panic(fmt.Errorf("invalid number of digits %d", digits))
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(alg.hash(), secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	// Dynamic truncation, see section 5.3 in RFC 4226
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	code := strconv.FormatUint(uint64(value%powers[digits]), 10)
+	if len(code) < digits {
+		code = strings.Repeat("0", digits-len(code)) + code
+	}
+	return code
+}
+
+// Key represents a TOTP shared secret with its parameters.
+// The zero values of Algorithm, Digits and Period are replaced
+// with their defaults.
+type Key struct {
+	// Secret is the shared secret.
+	Secret []byte
+	// Algorithm is the hash function used for generating the codes.
+	Algorithm Algorithm
+	// Digits is the number of digits in each code. If zero,
+	// DefaultDigits is used.
+	Digits int
+	// Period is the time step. If zero, DefaultPeriod is used.
+	Period time.Duration
+	// Skew is the number of periods accepted before and after
+	// the current one. Note that, unlike the other fields, a zero
+	// value means no skew. NewKey initializes it to DefaultSkew.
+	Skew int
+}
+
+// NewKey returns a new Key with a random secret and the
+// default parameters.
+func NewKey() *Key {
+	return &Key{Secret: stringutil.RandomBytes(SecretSize), Skew: DefaultSkew}
+}
+
+// DecodeSecret decodes a secret encoded in base32, as returned
+// by Key.EncodedSecret. Padding and spaces are optional and the
+// decoding is case insensitive.
+func DecodeSecret(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.Replace(s, " ", "", -1))
+	return encoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// EncodedSecret returns the secret encoded in base32 without
+// padding, the format used by authenticator apps when manually
+// entering a key.
+func (k *Key) EncodedSecret() string {
+	return encoding.EncodeToString(k.Secret)
+}
+
+func (k *Key) digits() int {
+	if k.Digits > 0 {
+		return k.Digits
+	}
+	return DefaultDigits
+}
+
+func (k *Key) period() time.Duration {
+	if k.Period > 0 {
+		return k.Period
+	}
+	return DefaultPeriod
+}
+
+// Counter returns the counter (number of time steps since
+// the UNIX epoch) corresponding to the given time.
+func (k *Key) Counter(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(k.period()/time.Second)
+}
+
+// Code returns the code for the given time.
+func (k *Key) Code(t time.Time) string {
+	return HOTP(k.Algorithm, k.Secret, k.Counter(t), k.digits())
+}
+
+// Validate checks if the given code is valid at the given time,
+// allowing k.Skew periods of difference. If the code is valid, its
+// counter is also returned. Callers should store it and reject codes
+// with a counter lower or equal to the last one used, since each
+// code must be accepted only once.
+func (k *Key) Validate(code string, t time.Time) (uint64, bool) {
+	code = strings.Replace(code, " ", "", -1)
+	if len(code) != k.digits() {
+		return 0, false
+	}
+	current := k.Counter(t)
+	var counter uint64
+	var ok bool
+	// Check all the candidates, to avoid leaking which one matched
+	// via timing.
+	for ii := -k.Skew; ii <= k.Skew; ii++ {
+		c := current + uint64(ii)
+		if ii < 0 && current < uint64(-ii) {
+			continue
+		}
+		expected := HOTP(k.Algorithm, k.Secret, c, k.digits())
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 && !ok {
+			counter = c
+			ok = true
+		}
+	}
+	return counter, ok
+}
+
+// URI returns the otpauth:// URI used for provisioning this
+// Key in authenticator apps, usually encoded in a QR code. The
+// issuer identifies the service, while the account identifies the
+// user within it (e.g. its email or username).
+func (k *Key) URI(issuer string, account string) string {
+	label := account
+	if issuer != "" {
+		label = issuer + ":" + account
+	}
+	values := make(url.Values)
+	values.Set("secret", k.EncodedSecret())
+	if issuer != "" {
+		values.Set("issuer", issuer)
+	}
+	values.Set("algorithm", k.Algorithm.String())
+	values.Set("digits", strconv.Itoa(k.digits()))
+	values.Set("period", strconv.Itoa(int(k.period()/time.Second)))
+	u := &url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + label,
+		RawQuery: strings.Replace(values.Encode(), "+", "%20", -1),
+	}
+	return u.String()
+}
@@ -0,0 +1,108 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+var (
+	// Secrets from RFC 6238, appendix B
+	rfcSecrets = map[Algorithm][]byte{
+		SHA1:   []byte("12345678901234567890"),
+		SHA256: []byte("12345678901234567890123456789012"),
+		SHA512: []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+	}
+	rfcVectors = []struct {
+		t    int64
+		alg  Algorithm
+		code string
+	}{
+		{59, SHA1, "94287082"},
+		{59, SHA256, "46119246"},
+		{59, SHA512, "90693936"},
+		{1111111109, SHA1, "07081804"},
+		{1111111109, SHA256, "68084774"},
+		{1111111109, SHA512, "25091201"},
+		{1111111111, SHA1, "14050471"},
+		{1111111111, SHA256, "67062674"},
+		{1111111111, SHA512, "99943326"},
+		{1234567890, SHA1, "89005924"},
+		{1234567890, SHA256, "91819424"},
+		{1234567890, SHA512, "93441116"},
+		{2000000000, SHA1, "69279037"},
+		{2000000000, SHA256, "90698825"},
+		{2000000000, SHA512, "38618901"},
+		{20000000000, SHA1, "65353130"},
+		{20000000000, SHA256, "77737706"},
+		{20000000000, SHA512, "47863826"},
+	}
+)
+
+func TestHOTP(t *testing.T) {
+	// Test vectors from RFC 4226, appendix D
+	expected := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+	for ii, v := range expected {
+		if code := HOTP(SHA1, rfcSecrets[SHA1], uint64(ii), 6); code != v {
+			t.Errorf("expecting HOTP %s for counter %d, got %s", v, ii, code)
+		}
+	}
+}
+
+func TestTOTP(t *testing.T) {
+	for _, v := range rfcVectors {
+		key := &Key{Secret: rfcSecrets[v.alg], Algorithm: v.alg, Digits: 8}
+		ts := time.Unix(v.t, 0)
+		if code := key.Code(ts); code != v.code {
+			t.Errorf("expecting code %s at %d with %s, got %s", v.code, v.t, v.alg, code)
+		}
+		if _, ok := key.Validate(v.code, ts); !ok {
+			t.Errorf("code %s at %d with %s did not validate", v.code, v.t, v.alg)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	key := NewKey()
+	now := time.Unix(1400000000, 0)
+	code := key.Code(now)
+	counter, ok := key.Validate(code, now.Add(DefaultPeriod))
+	if !ok {
+		t.Fatal("code from previous period did not validate")
+	}
+	if expected := key.Counter(now); counter != expected {
+		t.Errorf("expecting counter %d, got %d", expected, counter)
+	}
+	if _, ok := key.Validate(code, now.Add(3*DefaultPeriod)); ok {
+		t.Error("code from 3 periods ago validated")
+	}
+	key.Skew = 0
+	if _, ok := key.Validate(code, now.Add(DefaultPeriod)); ok {
+		t.Error("code from previous period validated without skew")
+	}
+	if _, ok := key.Validate("12345", now); ok {
+		t.Error("code with wrong length validated")
+	}
+}
+
+func TestURI(t *testing.T) {
+	key := &Key{Secret: rfcSecrets[SHA1]}
+	u, err := url.Parse(key.URI("Gondola Site", "alice@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Gondola Site:alice@example.com" {
+		t.Errorf("invalid URI %s", u)
+	}
+	q := u.Query()
+	if q.Get("issuer") != "Gondola Site" {
+		t.Errorf("invalid issuer %q", q.Get("issuer"))
+	}
+	secret, err := DecodeSecret(q.Get("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(secret) != string(key.Secret) {
+		t.Errorf("expecting secret %q, got %q", key.Secret, secret)
+	}
+}
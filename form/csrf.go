@@ -147,23 +147,26 @@ func csrfEncryptSigner(ctx *app.Context, salt string) (*cryptoutil.EncryptSigner
 	}
 	encrypter, _ := a.Encrypter()
 	if encrypter == nil {
-		var key []byte
 		cfg := a.Config()
 		if cfg == nil || cfg.Secret == "" {
 			return nil, errors.New("can't generate CSRF tokens, App configuration has no Secret")
 		}
-		if len(cfg.EncryptionKey) > 0 {
-			key = []byte(cfg.EncryptionKey)
-		} else {
-			s := sha256.New()
-			s.Write([]byte(cfg.Secret))
-			key = s.Sum(nil)
+		encrypter = &cryptoutil.Encrypter{Cipherer: a.Cipherer, Key: csrfKey(cfg.Secret)}
+		for _, v := range cfg.PreviousSecrets {
+			encrypter.OldKeys = append(encrypter.OldKeys, csrfKey(v))
 		}
-		encrypter = &cryptoutil.Encrypter{Cipherer: a.Cipherer, Key: key}
 	}
 	return &cryptoutil.EncryptSigner{Encrypter: encrypter, Signer: signer}, nil
 }
 
+// csrfKey derives an encryption key from the given secret,
+// used when the App has no EncryptionKey.
+func csrfKey(secret string) []byte {
+	s := sha256.New()
+	s.Write([]byte(secret))
+	return s.Sum(nil)
+}
+
 func newCSRF(f *Form) (*csrf, error) {
 	c := &csrf{}
 	if !f.Submitted() {
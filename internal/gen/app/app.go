@@ -23,6 +23,9 @@ type Templates struct {
 	Path      string            `yaml:"path"`
 	Functions map[string]string `yaml:"functions"`
 	Hooks     map[string]string `yaml:"hooks"`
+	// Data maps template names to the Go types of
+	// the data passed to them, used by check-templates.
+	Data map[string]string `yaml:"data"`
 }
 
 type Translations struct {
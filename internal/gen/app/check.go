@@ -0,0 +1,111 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gnd.la/log"
+)
+
+const checkTemplatesFilename = "gondola_check_templates_test.go"
+
+// templateNames returns the names of the templates in the
+// app templates directory, relative to it.
+func (app *App) templateNames() ([]string, error) {
+	dir := filepath.Join(app.Dir, app.Templates.Path)
+	var names []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() && path != dir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.IsDir() {
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			names = append(names, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	sort.Strings(names)
+	return names, err
+}
+
+// CheckTemplates checks all the templates in the app templates
+// directory using gnd.la/app.Template.Check. Since the templates
+// must be loaded by the app, with its functions, variables and
+// hooks, this is done by temporarily writing a test to the app
+// package and running it with go test. The types of the data
+// passed to each template are declared in the templates.data
+// section of the appfile, mapping template names to Go types
+// in the app package (e.g. "item.html: *Item"). Templates which
+// are not declared there are checked without a type for the dot.
+// The app code must have been generated with gen-app.
+func (app *App) CheckTemplates() error {
+	if app.Templates == nil || app.Templates.Path == "" {
+		return errors.New("no templates path declared in " + appFilename)
+	}
+	names, err := app.templateNames()
+	if err != nil {
+		return err
+	}
+	exists := make(map[string]bool, len(names))
+	for _, v := range names {
+		exists[v] = true
+	}
+	for k := range app.Templates.Data {
+		if !exists[k] {
+			return fmt.Errorf("template %q declared in %s data does not exist", k, appFilename)
+		}
+	}
+	pkg, err := build.ImportDir(app.Dir, 0)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n\n", pkg.Name)
+	buf.WriteString("import (\n\"reflect\"\n\"testing\"\n)\n\n")
+	buf.WriteString("func TestGondolaCheckTemplates(t *testing.T) {\n")
+	buf.WriteString("templates := []struct {\nname string\ndata reflect.Type\n}{\n")
+	for _, v := range names {
+		typ := "nil"
+		if t := app.Templates.Data[v]; t != "" {
+			typ = fmt.Sprintf("reflect.TypeOf((*%s)(nil)).Elem()", t)
+		}
+		fmt.Fprintf(&buf, "{%q, %s},\n", v, typ)
+	}
+	buf.WriteString("}\n")
+	buf.WriteString("for _, v := range templates {\n")
+	buf.WriteString("tmpl, err := App.LoadTemplate(v.name)\n")
+	buf.WriteString("if err != nil {\nt.Errorf(\"error loading %s: %s\", v.name, err)\ncontinue\n}\n")
+	buf.WriteString("if err := tmpl.Check(v.data); err != nil {\nt.Errorf(\"%s\", err)\n}\n")
+	buf.WriteString("}\n}\n")
+	out := filepath.Join(app.Dir, checkTemplatesFilename)
+	if err := ioutil.WriteFile(out, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	defer os.Remove(out)
+	log.Debugf("checking %d templates", len(names))
+	cmd := exec.Command("go", "test", "-run", "^TestGondolaCheckTemplates$", ".")
+	cmd.Dir = app.Dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return errors.New("template check failed")
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"gnd.la/internal/gen/genutil"
 	"gnd.la/internal/gen/json"
 	"gnd.la/internal/gen/strings"
+	"gnd.la/internal/gen/template"
 	"gnd.la/util/types"
 	"gnd.la/util/yaml"
 )
@@ -60,6 +61,13 @@ func Gen(pkgName string, config string) error {
 				return err
 			}
 		case "template":
+			opts, err := templateOptions(v)
+			if err != nil {
+				return err
+			}
+			if err := template.Gen(pkgName, opts); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -206,6 +214,32 @@ func stringsOptions(val interface{}) (*strings.Options, error) {
 	return opts, nil
 }
 
+func templateOptions(val interface{}) (*template.Options, error) {
+	m, ok := toMap(val)
+	if !ok {
+		return nil, fmt.Errorf("template options must be a map, not %T", val)
+	}
+	opts := &template.Options{}
+	for k, v := range m {
+		switch k {
+		case "app":
+			opts.App = types.ToString(v)
+		case "out":
+			opts.Out = types.ToString(v)
+		case "templates":
+			templates, ok := toMap(v)
+			if !ok {
+				return nil, fmt.Errorf("templates inside template options must be a map")
+			}
+			opts.Templates = make(map[string]string, len(templates))
+			for name, typ := range templates {
+				opts.Templates[name] = types.ToString(typ)
+			}
+		}
+	}
+	return opts, nil
+}
+
 func toMap(val interface{}) (map[string]interface{}, bool) {
 	switch v := val.(type) {
 	case nil:
@@ -0,0 +1,117 @@
+// Package template generates Go code for rendering templates without
+// using the template interpreter. See gnd.la/template.Generator for
+// the details.
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	"gnd.la/internal/gen/genutil"
+	"gnd.la/log"
+	"gnd.la/template"
+)
+
+const (
+	genTemplatesFilename = "gondola_gen_templates_test.go"
+	defaultApp           = "App"
+	defaultOut           = "gen_templates.go"
+)
+
+// Options specify the options used when generating the code for
+// the templates.
+type Options struct {
+	// App is the name of the *app.App variable in the package
+	// used for loading the templates. If empty, App is used.
+	App string
+	// Templates maps the template names to the Go types of the
+	// data passed to them (e.g. "item.html: *Item"), interpreted
+	// in the package. Templates with an empty type are generated
+	// without a type for the dot.
+	Templates map[string]string
+	// Out is the name of the generated file. If empty,
+	// gen_templates.go is used.
+	Out string
+}
+
+// Gen generates the code for rendering the templates declared in opts,
+// using the *app.App in the given package. Since the templates must be
+// loaded by the app, with its functions, variables and hooks, this is
+// done by temporarily writing a test to the package and running it with
+// go test. The package is built without any previously generated code,
+// using the gnd.la/template.NoCompiledTag build tag.
+func Gen(pkgName string, opts *Options) error {
+	if opts == nil || len(opts.Templates) == 0 {
+		return errors.New("no templates to generate")
+	}
+	pkg, err := genutil.NewPackage(pkgName)
+	if err != nil {
+		return err
+	}
+	appName := opts.App
+	if appName == "" {
+		appName = defaultApp
+	}
+	out := opts.Out
+	if out == "" {
+		out = defaultOut
+	}
+	tmp, err := ioutil.TempFile("", "gondola-templates")
+	if err != nil {
+		return err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+	names := make([]string, 0, len(opts.Templates))
+	for k := range opts.Templates {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n\n", pkg.Name())
+	buf.WriteString("import (\n\"io/ioutil\"\n\"reflect\"\n\"testing\"\n\n\"gnd.la/template\"\n)\n\n")
+	buf.WriteString("func TestGondolaGenTemplates(t *testing.T) {\n")
+	fmt.Fprintf(&buf, "g := template.NewGenerator(%q, %q)\n", pkg.Name(), pkg.Path())
+	fmt.Fprintf(&buf, "g.Comment = %q\n", genutil.AutogenString())
+	buf.WriteString("templates := []struct {\nname string\ndata reflect.Type\n}{\n")
+	for _, v := range names {
+		typ := "nil"
+		if t := opts.Templates[v]; t != "" {
+			typ = fmt.Sprintf("reflect.TypeOf((*%s)(nil)).Elem()", t)
+		}
+		fmt.Fprintf(&buf, "{%q, %s},\n", v, typ)
+	}
+	buf.WriteString("}\n")
+	buf.WriteString("for _, v := range templates {\n")
+	fmt.Fprintf(&buf, "tmpl, err := %s.LoadTemplate(v.name)\n", appName)
+	buf.WriteString("if err != nil {\nt.Fatalf(\"error loading %s: %s\", v.name, err)\n}\n")
+	buf.WriteString("if err := tmpl.Generate(g, v.data); err != nil {\nt.Fatal(err)\n}\n")
+	buf.WriteString("}\n")
+	buf.WriteString("src, err := g.Source()\nif err != nil {\nt.Fatal(err)\n}\n")
+	fmt.Fprintf(&buf, "if err := ioutil.WriteFile(%q, src, 0644); err != nil {\nt.Fatal(err)\n}\n", tmp.Name())
+	buf.WriteString("}\n")
+	test := filepath.Join(pkg.Dir(), genTemplatesFilename)
+	if err := ioutil.WriteFile(test, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	defer os.Remove(test)
+	log.Debugf("generating code for %d templates", len(names))
+	cmd := exec.Command("go", "test", "-tags", template.NoCompiledTag, "-run", "^TestGondolaGenTemplates$", ".")
+	cmd.Dir = pkg.Dir()
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return errors.New("template code generation failed")
+	}
+	data, err := ioutil.ReadFile(tmp.Name())
+	if err != nil {
+		return err
+	}
+	return genutil.WriteAutogen(filepath.Join(pkg.Dir(), out), data)
+}
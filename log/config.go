@@ -3,25 +3,59 @@ package log
 import (
 	"gnd.la/config"
 	"gnd.la/net/mail"
+	"gnd.la/signal"
 )
 
 var logConfig struct {
-	LogDebug bool
+	LogDebug bool `reload:"true"`
+}
+
+var smtpWriter *SmtpWriter
+
+// updateSmtpWriter adds a writer which sends errors to the admin
+// email when not running in debug mode, replacing the previous one.
+func updateSmtpWriter() {
+	if smtpWriter != nil {
+		Std.RemoveWriter(smtpWriter)
+		smtpWriter = nil
+	}
+	if logConfig.LogDebug {
+		return
+	}
+	// Check if we should send errors to the admin email
+	admin := mail.AdminEmail()
+	from := mail.DefaultFrom()
+	server := mail.DefaultServer()
+	if admin != "" && server != "" {
+		smtpWriter = NewSmtpWriter(LError, server, from, admin)
+		Std.AddWriter(smtpWriter)
+	}
 }
 
 func init() {
 	config.RegisterFunc(&logConfig, func() {
 		if logConfig.LogDebug {
 			Std.SetLevel(LDebug)
-		} else {
-			// Check if we should send errors to the admin email
-			admin := mail.AdminEmail()
-			from := mail.DefaultFrom()
-			server := mail.DefaultServer()
-			if admin != "" && server != "" {
-				writer := NewSmtpWriter(LError, server, from, admin)
-				Std.AddWriter(writer)
+		}
+		updateSmtpWriter()
+	})
+	signal.Listen(config.CHANGED, func(_ string, obj interface{}) {
+		change := obj.(*config.Change)
+		if change.Has("LogDebug") {
+			if logConfig.LogDebug {
+				Std.SetLevel(LDebug)
+			} else {
+				Std.SetLevel(LDefault)
 			}
 		}
+		if change.Has("LogDebug") || change.Has("MailServer") || change.Has("DefaultFrom") || change.Has("AdminEmail") {
+			updateSmtpWriter()
+		}
+		if len(change.Rejected) > 0 {
+			Warningf("config fields %v changed, restart to apply them", change.Rejected)
+		}
+	})
+	signal.Listen(config.RELOAD_FAILED, func(_ string, obj interface{}) {
+		Errorf("error reloading config: %v", obj)
 	})
 }
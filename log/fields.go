@@ -0,0 +1,102 @@
+package log
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const badKey = "!BADKEY"
+
+// Field is a key/value pair attached to a log message.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Valuer is a function which returns the value for a Field. When
+// the value of a Field is a Valuer, the function is called every
+// time a message is logged, allowing fields whose values change
+// over time (e.g. the signed in user). Fields whose Valuer returns
+// nil are omitted.
+type Valuer func() interface{}
+
+// Entry represents a log message with its fields.
+type Entry struct {
+	Time    time.Time
+	Level   LLevel
+	File    string
+	Line    int
+	Message string
+	Fields  []Field
+}
+
+// EntryWriter is implemented by Writers which handle structured
+// messages. When a Writer implements EntryWriter, the Logger calls
+// WriteEntry rather than Write.
+type EntryWriter interface {
+	Writer
+	WriteEntry(e *Entry) error
+}
+
+// makeFields converts alternating keys and values into a
+// slice of Field. Keys which are not strings are formatted
+// with fmt.Sprint, while a missing value is logged with the
+// !BADKEY key.
+func makeFields(keyvals []interface{}) []Field {
+	fields := make([]Field, 0, (len(keyvals)+1)/2)
+	for ii := 0; ii < len(keyvals); ii += 2 {
+		if ii == len(keyvals)-1 {
+			fields = append(fields, Field{Key: badKey, Value: keyvals[ii]})
+			break
+		}
+		key, ok := keyvals[ii].(string)
+		if !ok {
+			key = fmt.Sprint(keyvals[ii])
+		}
+		fields = append(fields, Field{Key: key, Value: keyvals[ii+1]})
+	}
+	return fields
+}
+
+// evalFields returns the fields with their Valuers evaluated,
+// omitting the ones which returned nil.
+func evalFields(fields []Field) []Field {
+	if len(fields) == 0 {
+		return nil
+	}
+	values := make([]Field, 0, len(fields))
+	for _, v := range fields {
+		if valuer, ok := v.Value.(Valuer); ok {
+			if v.Value = valuer(); v.Value == nil {
+				continue
+			}
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func appendFields(buf []byte, fields []Field) []byte {
+	for _, v := range fields {
+		buf = append(buf, ' ')
+		buf = append(buf, v.Key...)
+		buf = append(buf, '=')
+		s := fmt.Sprint(v.Value)
+		if s == "" || strings.ContainsAny(s, " \t\r\n=\"") {
+			s = strconv.Quote(s)
+		}
+		buf = append(buf, s...)
+	}
+	return buf
+}
+
+// FormatFields returns the given alternating keys and values
+// formatted as text, in the same format used by the Logger when
+// writing to a Writer which doesn't implement EntryWriter
+// (" key1=value1 key2=value2"). Valuers are evaluated. This
+// function is intended for implementations of FieldLogger.
+func FormatFields(keyvals ...interface{}) string {
+	return string(appendFields(nil, evalFields(makeFields(keyvals))))
+}
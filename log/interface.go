@@ -30,3 +30,18 @@ type Interface interface {
 	// log message at the error level.
 	Errorf(format string, args ...interface{})
 }
+
+// FieldLogger is implemented by loggers which support adding fields
+// to their messages, like Logger. Since not every Interface implements
+// it, use a type assertion to check for it:
+//
+//	if fl, ok := logger.(log.FieldLogger); ok {
+//		logger = fl.With("user", userId)
+//	}
+type FieldLogger interface {
+	Interface
+	// With returns a FieldLogger which adds the given fields,
+	// specified as alternating keys and values, to every
+	// message. See Logger.With for more information.
+	With(keyvals ...interface{}) FieldLogger
+}
@@ -0,0 +1,86 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// JSONWriter is a Writer which writes each message as a JSON
+// object in its own line, with its fields as additional keys.
+// It's intended to be used with log aggregation services.
+//
+//	{"file":"app.go","level":"Info","line":120,"msg":"GET / 200","request_id":"...","time":"..."}
+type JSONWriter struct {
+	mutex sync.Mutex
+	out   io.Writer
+	level LLevel
+}
+
+// Write implements the Writer interface. It's only called
+// when the message is not logged by a Logger.
+func (w *JSONWriter) Write(level LLevel, flags int, b []byte) (int, error) {
+	err := w.WriteEntry(&Entry{
+		Time:    time.Now(),
+		Level:   level,
+		Message: string(b),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// WriteEntry implements the EntryWriter interface.
+func (w *JSONWriter) WriteEntry(e *Entry) error {
+	m := make(map[string]interface{}, len(e.Fields)+5)
+	for _, v := range e.Fields {
+		m[v.Key] = jsonValue(v.Value)
+	}
+	m["time"] = e.Time.Format(time.RFC3339Nano)
+	m["level"] = e.Level.String()
+	m["msg"] = e.Message
+	if e.File != "" {
+		m["file"] = e.File
+		m["line"] = e.Line
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	_, err = w.out.Write(data)
+	return err
+}
+
+func (w *JSONWriter) Level() LLevel {
+	return w.level
+}
+
+// jsonValue returns a value for v which can be always encoded
+// as JSON. Errors are converted to their message, while values
+// which can't be encoded are formatted using fmt.Sprint.
+func jsonValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case nil, string, bool, int, int64, float64:
+		return x
+	case error:
+		return x.Error()
+	case fmt.Stringer:
+		return x.String()
+	}
+	if _, err := json.Marshal(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return v
+}
+
+// NewJSONWriter returns a new *JSONWriter which writes
+// the messages with at least the given level to out.
+func NewJSONWriter(out io.Writer, level LLevel) *JSONWriter {
+	return &JSONWriter{out: out, level: level}
+}
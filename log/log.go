@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -34,10 +36,19 @@ var (
 // output to an io.Writer.  Each logging operation makes a single call to
 // the Writer's Write method.  A Logger can be used simultaneously from
 // multiple goroutines; it guarantees to serialize access to the Writer.
+//
+// Loggers returned by With share their flags, level and writers with the
+// Logger they were derived from, but add their fields to every message.
 type Logger struct {
 	flags   int // properties
 	level   LLevel
 	writers []Writer // destination for output
+	parent  *Logger  // non-nil for loggers returned by With
+	fields  []Field
+	// mutex protects level and writers, which might change
+	// while other goroutines are logging (e.g. when the
+	// configuration is reloaded).
+	mutex sync.RWMutex
 }
 
 // New creates a new Logger.   The out variable sets the
@@ -104,16 +115,6 @@ func (l *Logger) formatHeader(level LLevel, buf *[]byte, t time.Time, file strin
 		}
 	}
 	if l.flags&(Lshortfile|Llongfile) != 0 {
-		if l.flags&Lshortfile != 0 {
-			short := file
-			for i := len(file) - 1; i > 0; i-- {
-				if file[i] == '/' {
-					short = file[i+1:]
-					break
-				}
-			}
-			file = short
-		}
 		*buf = append(*buf, file...)
 		*buf = append(*buf, ':')
 		itoa(buf, line, -1)
@@ -121,19 +122,32 @@ func (l *Logger) formatHeader(level LLevel, buf *[]byte, t time.Time, file strin
 	}
 }
 
-func (l *Logger) FormatMessage(level LLevel, calldepth int, s string) []byte {
-	now := time.Now() // get this early.
-	var file string
-	var line int
-	if l.flags&(Lshortfile|Llongfile) != 0 {
-		// release lock while getting caller info - it's expensive.
-		var ok bool
-		_, file, line, ok = runtime.Caller(calldepth)
-		if !ok {
-			file = "???"
-			line = 0
+func (l *Logger) caller(calldepth int) (string, int) {
+	if l.flags&(Lshortfile|Llongfile) == 0 {
+		return "", 0
+	}
+	_, file, line, ok := runtime.Caller(calldepth)
+	if !ok {
+		return "???", 0
+	}
+	if l.flags&Lshortfile != 0 {
+		for i := len(file) - 1; i > 0; i-- {
+			if file[i] == '/' {
+				file = file[i+1:]
+				break
+			}
 		}
 	}
+	return file, line
+}
+
+func (l *Logger) FormatMessage(level LLevel, calldepth int, s string) []byte {
+	now := time.Now() // get this early.
+	file, line := l.caller(calldepth + 1)
+	return l.formatMessage(level, now, file, line, s, nil)
+}
+
+func (l *Logger) formatMessage(level LLevel, t time.Time, file string, line int, s string, fields []Field) []byte {
 	var buf []byte
 	select {
 	case buf = <-pool:
@@ -141,17 +155,83 @@ func (l *Logger) FormatMessage(level LLevel, calldepth int, s string) []byte {
 	default:
 		buf = make([]byte, 0, maxPoolCap)
 	}
-	l.formatHeader(level, &buf, now, file, line)
-	buf = append(buf, s...)
+	l.formatHeader(level, &buf, t, file, line)
+	if len(fields) > 0 {
+		nl := len(s) > 0 && s[len(s)-1] == '\n'
+		if nl {
+			s = s[:len(s)-1]
+		}
+		buf = append(buf, s...)
+		buf = appendFields(buf, fields)
+		if nl {
+			buf = append(buf, '\n')
+		}
+	} else {
+		buf = append(buf, s...)
+	}
 	return buf
 }
 
+// root returns the Logger which holds the flags,
+// level and writers for this Logger.
+func (l *Logger) root() *Logger {
+	if l.parent != nil {
+		return l.parent
+	}
+	return l
+}
+
+// With returns a Logger which adds the given fields to every
+// message, specified as alternating keys and values. Values might
+// be a Valuer, to evaluate them every time a message is logged.
+// Writers which implement EntryWriter (e.g. JSONWriter) receive the
+// fields as structured data, while the rest of them receive the
+// fields appended to the message as key=value pairs. The returned
+// Logger shares its flags, level and writers with l.
+//
+//	logger := log.Std.With("user", userId)
+//	logger.Infof("signed in from %s", addr)
+func (l *Logger) With(keyvals ...interface{}) FieldLogger {
+	fields := make([]Field, len(l.fields), len(l.fields)+(len(keyvals)+1)/2)
+	copy(fields, l.fields)
+	return &Logger{
+		parent: l.root(),
+		fields: append(fields, makeFields(keyvals)...),
+	}
+}
+
+// Fields returns the fields added to this Logger by With.
+func (l *Logger) Fields() []Field {
+	return l.fields
+}
+
 func (l *Logger) AddWriter(w Writer) {
-	l.writers = append(l.writers, w)
+	r := l.root()
+	r.mutex.Lock()
+	r.writers = append(r.writers, w)
+	r.mutex.Unlock()
+}
+
+// RemoveWriter removes the given Writer, previously
+// added with AddWriter.
+func (l *Logger) RemoveWriter(w Writer) {
+	r := l.root()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	writers := make([]Writer, 0, len(r.writers))
+	for _, v := range r.writers {
+		if v != w {
+			writers = append(writers, v)
+		}
+	}
+	r.writers = writers
 }
 
 func (l *Logger) RemoveWriters() {
-	l.writers = nil
+	r := l.root()
+	r.mutex.Lock()
+	r.writers = nil
+	r.mutex.Unlock()
 }
 
 // Write is a generic low-level interface to a Logger. By using the calldepth
@@ -172,15 +252,42 @@ func (l *Logger) Writeln(level LLevel, calldepth int, v ...interface{}) {
 }
 
 func (l *Logger) write(level LLevel, calldepth int, v ...interface{}) {
-	if level >= l.level {
+	r := l.root()
+	r.mutex.RLock()
+	minLevel := r.level
+	writers := r.writers
+	r.mutex.RUnlock()
+	if level >= minLevel {
+		now := time.Now() // get this early.
 		s := fmt.Sprint(v...)
-		msg := l.FormatMessage(level, calldepth, s)
-		for _, w := range l.writers {
-			if level >= w.Level() {
-				w.Write(level, l.flags, msg)
+		file, line := r.caller(calldepth)
+		fields := evalFields(l.fields)
+		var msg []byte
+		var entry *Entry
+		for _, w := range writers {
+			if level < w.Level() {
+				continue
+			}
+			if ew, ok := w.(EntryWriter); ok {
+				if entry == nil {
+					entry = &Entry{
+						Time:    now,
+						Level:   level,
+						File:    file,
+						Line:    line,
+						Message: strings.TrimSuffix(s, "\n"),
+						Fields:  fields,
+					}
+				}
+				ew.WriteEntry(entry)
+				continue
 			}
+			if msg == nil {
+				msg = r.formatMessage(level, now, file, line, s, fields)
+			}
+			w.Write(level, r.flags, msg)
 		}
-		if cap(msg) <= maxPoolCap {
+		if msg != nil && cap(msg) <= maxPoolCap {
 			select {
 			case pool <- msg:
 			default:
@@ -190,14 +297,14 @@ func (l *Logger) write(level LLevel, calldepth int, v ...interface{}) {
 }
 
 func (l *Logger) writef(level LLevel, calldepth int, format string, v ...interface{}) {
-	if level >= l.level {
+	if level >= l.Level() {
 		s := fmt.Sprintf(format, v...)
 		l.write(level, calldepth+1, s)
 	}
 }
 
 func (l *Logger) writeln(level LLevel, calldepth int, v ...interface{}) {
-	if level >= l.level {
+	if level >= l.Level() {
 		s := fmt.Sprintln(v...)
 		l.write(level, calldepth+1, s)
 	}
@@ -326,26 +433,32 @@ func (l *Logger) Nil(v interface{}) {
 
 // Flags returns the output flags for the logger.
 func (l *Logger) Flags() int {
-	return l.flags
+	return l.root().flags
 }
 
 // SetFlags sets the output flags for the logger.
 func (l *Logger) SetFlags(flags int) {
-	l.flags = flags
+	l.root().flags = flags
 }
 
 func (l *Logger) Level() LLevel {
-	return l.level
+	r := l.root()
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.level
 }
 
 func (l *Logger) SetLevel(level LLevel) {
-	l.level = level
+	r := l.root()
+	r.mutex.Lock()
+	r.level = level
+	r.mutex.Unlock()
 }
 
 // IsDebug returns true if the Logger is showing
 // debug messages.
 func (l *Logger) IsDebug() bool {
-	return l.level <= LDebug
+	return l.Level() <= LDebug
 }
 
 // AddWriter adds a writer to the standard logger for the standard logger.
@@ -371,6 +484,13 @@ func SetLevel(level LLevel) {
 	Std.SetLevel(level)
 }
 
+// With returns a Logger derived from the standard logger
+// which adds the given fields to every message. See
+// Logger.With for more information.
+func With(keyvals ...interface{}) FieldLogger {
+	return Std.With(keyvals...)
+}
+
 // These functions write to the standard logger.
 func Log(level LLevel, v ...interface{}) {
 	Std.write(level, 3, v...)
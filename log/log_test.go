@@ -0,0 +1,63 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(NewIOWriter(&buf, LDebug), Lshortlevel, LDebug)
+	user := 0
+	derived := logger.With("handler", "index", "user", Valuer(func() interface{} {
+		if user == 0 {
+			return nil
+		}
+		return user
+	}))
+	derived.Infof("hello %s", "world")
+	user = 42
+	derived.With("msg", "with spaces").Warning("bye")
+	logger.Info("plain")
+	expected := "[I] hello world handler=index\n" +
+		"[W] bye handler=index user=42 msg=\"with spaces\"\n" +
+		"[I] plain\n"
+	if s := buf.String(); s != expected {
+		t.Errorf("expecting %q, got %q", expected, s)
+	}
+}
+
+func TestJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(NewJSONWriter(&buf, LInfo), Lshortfile, LDebug)
+	derived := logger.With("err", errors.New("failed"), "n", 1, "odd")
+	derived.Debug("not logged")
+	derived.(*Logger).Errorln("something", "happened")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expecting 1 line, got %d: %q", len(lines), buf.String())
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &m); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"level":   "Error",
+		"msg":     "something happened",
+		"err":     "failed",
+		"n":       float64(1),
+		"!BADKEY": "odd",
+		"file":    "log_test.go",
+	}
+	for k, v := range expected {
+		if m[k] != v {
+			t.Errorf("expecting %s = %v, got %v", k, v, m[k])
+		}
+	}
+	if _, ok := m["time"]; !ok {
+		t.Error("no time in JSON message")
+	}
+}
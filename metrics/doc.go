@@ -0,0 +1,26 @@
+// Package metrics implements a registry of counters, gauges and
+// histograms which can be exported in the Prometheus text exposition
+// format.
+//
+// Metrics are usually declared as package level variables, using the
+// functions which register them in the Default Registry:
+//
+//  var jobsDone = metrics.NewCounter("myapp_jobs_done_total", "Number of jobs done, by kind.", "kind")
+//
+//  func doJob(kind string) {
+//      defer jobsDone.Inc(kind)
+//      ...
+//  }
+//
+// Several Gondola packages register their own metrics in the
+// Default Registry, all of them prefixed with "gondola_". gnd.la/app
+// records the served requests (by handler name, method and status), their
+// latency, the requests in flight and the template execution time, gnd.la/orm
+// records the query time by model and operation, gnd.la/cache records hits,
+// misses and driver operation time, while gnd.la/tasks and gnd.la/tasks/queue
+// record task and job executions and their duration.
+//
+// gnd.la/app exposes the Default Registry at the path indicated by
+// its MetricsPath configuration field, under the same conditions as
+// the monitor pages (i.e. in debug mode or when profiling is enabled).
+package metrics
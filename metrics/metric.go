@@ -0,0 +1,369 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// DefaultBuckets are the default upper bounds for the
+	// Histogram buckets, in seconds. They're intended to
+	// measure the latency of network services.
+	DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+)
+
+type series struct {
+	// Keep the fields accessed atomically first,
+	// so they're 64 bit aligned on 32 bit platforms.
+	bits    uint64
+	count   uint64
+	values  []string
+	buckets []uint64
+}
+
+func (s *series) value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&s.bits))
+}
+
+func (s *series) set(v float64) {
+	atomic.StoreUint64(&s.bits, math.Float64bits(v))
+}
+
+func (s *series) add(delta float64) {
+	for {
+		old := atomic.LoadUint64(&s.bits)
+		nv := math.Float64bits(math.Float64frombits(old) + delta)
+		if atomic.CompareAndSwapUint64(&s.bits, old, nv) {
+			return
+		}
+	}
+}
+
+// vec holds the series for a metric, one for
+// each combination of label values.
+type vec struct {
+	name    string
+	help    string
+	labels  []string
+	buckets int
+	mutex   sync.RWMutex
+	series  map[string]*series
+}
+
+func (v *vec) init(name string, help string, labels []string, buckets int) {
+	checkNames(name, labels)
+	v.name = name
+	v.help = help
+	v.labels = append([]string(nil), labels...)
+	v.buckets = buckets
+	v.series = make(map[string]*series)
+}
+
+// Name returns the metric name.
+func (v *vec) Name() string {
+	return v.name
+}
+
+// Help returns the metric description.
+func (v *vec) Help() string {
+	return v.help
+}
+
+// Labels returns the label names for the metric.
+func (v *vec) Labels() []string {
+	return append([]string(nil), v.labels...)
+}
+
+func (v *vec) key(values []string) string {
+	if len(values) != len(v.labels) {
+		panic(fmt.Errorf("metric %s has %d labels, %d values provided", v.name, len(v.labels), len(values)))
+	}
+	return strings.Join(values, "\x00")
+}
+
+// lookup returns the series for the given values,
+// or nil if it hasn't been created yet.
+func (v *vec) lookup(values []string) *series {
+	key := v.key(values)
+	v.mutex.RLock()
+	s := v.series[key]
+	v.mutex.RUnlock()
+	return s
+}
+
+// get returns the series for the given values,
+// creating it if needed.
+func (v *vec) get(values []string) *series {
+	key := v.key(values)
+	v.mutex.RLock()
+	s := v.series[key]
+	v.mutex.RUnlock()
+	if s == nil {
+		v.mutex.Lock()
+		if s = v.series[key]; s == nil {
+			s = &series{values: append([]string(nil), values...)}
+			if v.buckets > 0 {
+				s.buckets = make([]uint64, v.buckets)
+			}
+			v.series[key] = s
+		}
+		v.mutex.Unlock()
+	}
+	return s
+}
+
+// sorted returns the series for the metric,
+// sorted by their label values.
+func (v *vec) sorted() []*series {
+	v.mutex.RLock()
+	keys := make([]string, 0, len(v.series))
+	for k := range v.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	series := make([]*series, len(keys))
+	for ii, k := range keys {
+		series[ii] = v.series[k]
+	}
+	v.mutex.RUnlock()
+	return series
+}
+
+// Counter is a metric which can only increase. Counters might
+// have labels, in which case each combination of label values
+// is counted independently.
+type Counter struct {
+	vec
+}
+
+// NewCounter returns a new Counter, with the given name, description
+// and label names. Note that it must be added to a Registry in order
+// to be exported. Use the package level NewCounter to create a Counter
+// registered in the Default Registry.
+func (r *Registry) NewCounter(name string, help string, labels ...string) *Counter {
+	c := newCounter(name, help, labels)
+	r.MustRegister(c)
+	return c
+}
+
+func newCounter(name string, help string, labels []string) *Counter {
+	c := &Counter{}
+	c.init(name, help, labels, 0)
+	return c
+}
+
+// Inc increments the counter for the given label values by 1.
+func (c *Counter) Inc(values ...string) {
+	c.get(values).add(1)
+}
+
+// Add increments the counter for the given label values by
+// delta. It panics if delta is negative.
+func (c *Counter) Add(delta float64, values ...string) {
+	if delta < 0 {
+		panic(fmt.Errorf("counter %s can't decrease", c.name))
+	}
+	c.get(values).add(delta)
+}
+
+// Value returns the current value for the given label values.
+func (c *Counter) Value(values ...string) float64 {
+	if s := c.lookup(values); s != nil {
+		return s.value()
+	}
+	return 0
+}
+
+func (c *Counter) writeText(w *textWriter) {
+	w.header(c.name, c.help, "counter")
+	for _, s := range c.sorted() {
+		w.sample(c.name, c.labels, s.values, "", "", s.value())
+	}
+}
+
+// Gauge is a metric which can increase and decrease. Like
+// Counter, it might have labels.
+type Gauge struct {
+	vec
+}
+
+// NewGauge returns a new Gauge registered in r.
+// See Registry.NewCounter for the arguments.
+func (r *Registry) NewGauge(name string, help string, labels ...string) *Gauge {
+	g := newGauge(name, help, labels)
+	r.MustRegister(g)
+	return g
+}
+
+func newGauge(name string, help string, labels []string) *Gauge {
+	g := &Gauge{}
+	g.init(name, help, labels, 0)
+	return g
+}
+
+// Set sets the gauge for the given label values to v.
+func (g *Gauge) Set(v float64, values ...string) {
+	g.get(values).set(v)
+}
+
+// Add adds delta, which might be negative, to the gauge
+// for the given label values.
+func (g *Gauge) Add(delta float64, values ...string) {
+	g.get(values).add(delta)
+}
+
+// Inc increments the gauge for the given label values by 1.
+func (g *Gauge) Inc(values ...string) {
+	g.get(values).add(1)
+}
+
+// Dec decrements the gauge for the given label values by 1.
+func (g *Gauge) Dec(values ...string) {
+	g.get(values).add(-1)
+}
+
+// Value returns the current value for the given label values.
+func (g *Gauge) Value(values ...string) float64 {
+	if s := g.lookup(values); s != nil {
+		return s.value()
+	}
+	return 0
+}
+
+func (g *Gauge) writeText(w *textWriter) {
+	w.header(g.name, g.help, "gauge")
+	for _, s := range g.sorted() {
+		w.sample(g.name, g.labels, s.values, "", "", s.value())
+	}
+}
+
+// GaugeFunc is a gauge without labels whose value is
+// obtained by calling a function every time the metrics
+// are exported.
+type GaugeFunc struct {
+	name string
+	help string
+	f    func() float64
+}
+
+// NewGaugeFunc returns a new GaugeFunc registered in r.
+func (r *Registry) NewGaugeFunc(name string, help string, f func() float64) *GaugeFunc {
+	g := newGaugeFunc(name, help, f)
+	r.MustRegister(g)
+	return g
+}
+
+func newGaugeFunc(name string, help string, f func() float64) *GaugeFunc {
+	checkNames(name, nil)
+	return &GaugeFunc{name: name, help: help, f: f}
+}
+
+// Name returns the metric name.
+func (g *GaugeFunc) Name() string {
+	return g.name
+}
+
+// Help returns the metric description.
+func (g *GaugeFunc) Help() string {
+	return g.help
+}
+
+// Value calls the function and returns its result.
+func (g *GaugeFunc) Value() float64 {
+	return g.f()
+}
+
+func (g *GaugeFunc) writeText(w *textWriter) {
+	w.header(g.name, g.help, "gauge")
+	w.sample(g.name, nil, nil, "", "", g.f())
+}
+
+// Histogram counts observations (e.g. request durations)
+// into configurable buckets, while also keeping their count
+// and their sum. Like Counter, it might have labels.
+type Histogram struct {
+	vec
+	bounds []float64
+}
+
+// NewHistogram returns a new Histogram registered in r. The
+// buckets argument indicates the upper bounds of the buckets.
+// If it's empty, DefaultBuckets is used. See Registry.NewCounter
+// for the rest of the arguments.
+func (r *Registry) NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	h := newHistogram(name, help, buckets, labels)
+	r.MustRegister(h)
+	return h
+}
+
+func newHistogram(name string, help string, buckets []float64, labels []string) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	bounds := append([]float64(nil), buckets...)
+	sort.Float64s(bounds)
+	if math.IsInf(bounds[len(bounds)-1], 1) {
+		bounds = bounds[:len(bounds)-1]
+	}
+	h := &Histogram{bounds: bounds}
+	// Last bucket is +Inf
+	h.init(name, help, labels, len(bounds)+1)
+	return h
+}
+
+// Observe adds the observation v to the histogram for
+// the given label values.
+func (h *Histogram) Observe(v float64, values ...string) {
+	s := h.get(values)
+	atomic.AddUint64(&s.buckets[sort.SearchFloat64s(h.bounds, v)], 1)
+	atomic.AddUint64(&s.count, 1)
+	s.add(v)
+}
+
+// ObserveSince adds the time elapsed since start, in seconds, to the
+// histogram for the given label values. It's intended to be deferred:
+//
+//  defer h.ObserveSince(time.Now(), "value1", "value2")
+func (h *Histogram) ObserveSince(start time.Time, values ...string) {
+	h.Observe(time.Since(start).Seconds(), values...)
+}
+
+// Count returns the number of observations for
+// the given label values.
+func (h *Histogram) Count(values ...string) uint64 {
+	if s := h.lookup(values); s != nil {
+		return atomic.LoadUint64(&s.count)
+	}
+	return 0
+}
+
+// Sum returns the sum of the observations for
+// the given label values.
+func (h *Histogram) Sum(values ...string) float64 {
+	if s := h.lookup(values); s != nil {
+		return s.value()
+	}
+	return 0
+}
+
+func (h *Histogram) writeText(w *textWriter) {
+	w.header(h.name, h.help, "histogram")
+	for _, s := range h.sorted() {
+		var cumulative uint64
+		for ii := range s.buckets {
+			cumulative += atomic.LoadUint64(&s.buckets[ii])
+			le := math.Inf(1)
+			if ii < len(h.bounds) {
+				le = h.bounds[ii]
+			}
+			w.sample(h.name+"_bucket", h.labels, s.values, "le", formatFloat(le), float64(cumulative))
+		}
+		w.sample(h.name+"_sum", h.labels, s.values, "", "", s.value())
+		w.sample(h.name+"_count", h.labels, s.values, "", "", float64(atomic.LoadUint64(&s.count)))
+	}
+}
@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestWriteText(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_requests_total", "Requests.\nBy path.", "path", "code")
+	c.Inc("/", "200")
+	c.Add(2, "/", "200")
+	c.Inc("/a\"b\\", "404")
+	g := r.NewGauge("test_in_flight", "")
+	g.Inc()
+	g.Inc()
+	g.Dec()
+	r.NewGaugeFunc("test_answer", "The answer.", func() float64 { return 42 })
+	h := r.NewHistogram("test_duration_seconds", "Durations.", []float64{1, 0.1}, "kind")
+	h.Observe(0.05, "a")
+	h.Observe(0.1, "a")
+	h.Observe(0.5, "a")
+	h.Observe(3, "a")
+	var buf bytes.Buffer
+	if err := r.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP test_answer The answer.
+# TYPE test_answer gauge
+test_answer 42
+# HELP test_duration_seconds Durations.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{kind="a",le="0.1"} 2
+test_duration_seconds_bucket{kind="a",le="1"} 3
+test_duration_seconds_bucket{kind="a",le="+Inf"} 4
+test_duration_seconds_sum{kind="a"} 3.65
+test_duration_seconds_count{kind="a"} 4
+# TYPE test_in_flight gauge
+test_in_flight 1
+# HELP test_requests_total Requests.\nBy path.
+# TYPE test_requests_total counter
+test_requests_total{path="/",code="200"} 3
+test_requests_total{path="/a\"b\\",code="404"} 1
+`
+	if s := buf.String(); s != expected {
+		t.Errorf("expecting\n%s\ngot\n%s", expected, s)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_total", "", "kind")
+	h := r.NewHistogram("test_seconds", "", nil)
+	var wg sync.WaitGroup
+	for ii := 0; ii < 10; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for jj := 0; jj < 1000; jj++ {
+				c.Inc("a")
+				h.Observe(0.5)
+			}
+		}()
+	}
+	wg.Wait()
+	if v := c.Value("a"); v != 10000 {
+		t.Errorf("expecting counter = 10000, got %v", v)
+	}
+	if v := h.Count(); v != 10000 {
+		t.Errorf("expecting histogram count = 10000, got %v", v)
+	}
+	if v := h.Sum(); v != 5000 {
+		t.Errorf("expecting histogram sum = 5000, got %v", v)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("test_total", "")
+	if err := r.Register(newGauge("test_total", "", nil)); err == nil {
+		t.Error("expecting an error when registering a duplicate name")
+	}
+	if !r.Unregister("test_total") || r.Get("test_total") != nil {
+		t.Error("expecting test_total to be unregistered")
+	}
+	for _, v := range []func(){
+		func() { r.NewCounter("1invalid", "") },
+		func() { r.NewCounter("test_total", "", "le") },
+		func() { r.NewCounter("test_other_total", "", "a").Inc() },
+		func() { r.NewCounter("test_neg_total", "").Add(-1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expecting a panic")
+				}
+			}()
+			v()
+		}()
+	}
+}
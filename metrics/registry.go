@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+var (
+	// Default is the Registry used by the package level functions
+	// and by the metrics declared by other Gondola packages.
+	Default = NewRegistry()
+
+	nameRe  = regexp.MustCompile("^[a-zA-Z_:][a-zA-Z0-9_:]*$")
+	labelRe = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
+)
+
+// Metric is the interface implemented by all the metrics
+// which can be added to a Registry.
+type Metric interface {
+	// Name returns the metric name.
+	Name() string
+	// Help returns the metric description.
+	Help() string
+	writeText(w *textWriter)
+}
+
+// Registry holds a set of metrics, identified by their names.
+// It's safe to use a Registry from multiple goroutines.
+type Registry struct {
+	mutex   sync.RWMutex
+	metrics map[string]Metric
+}
+
+// NewRegistry returns a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]Metric)}
+}
+
+// Register adds the given Metric to the Registry. If there's already
+// a Metric with the same name, an error is returned.
+func (r *Registry) Register(m Metric) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	name := m.Name()
+	if _, ok := r.metrics[name]; ok {
+		return fmt.Errorf("there's already a metric named %s", name)
+	}
+	r.metrics[name] = m
+	return nil
+}
+
+// MustRegister works like Register, but panics if there's an error.
+func (r *Registry) MustRegister(m Metric) {
+	if err := r.Register(m); err != nil {
+		panic(err)
+	}
+}
+
+// Unregister removes the Metric with the given name from the Registry,
+// returning true if it was present.
+func (r *Registry) Unregister(name string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	_, ok := r.metrics[name]
+	delete(r.metrics, name)
+	return ok
+}
+
+// Get returns the Metric with the given name, or nil
+// if there's no such metric.
+func (r *Registry) Get(name string) Metric {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.metrics[name]
+}
+
+// WriteText writes all the metrics in the Registry to w, sorted by name,
+// using the Prometheus text exposition format (version 0.0.4). When
+// serving them over HTTP, set the Content-Type header to ContentType.
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mutex.RLock()
+	metrics := make([]Metric, 0, len(r.metrics))
+	for _, v := range r.metrics {
+		metrics = append(metrics, v)
+	}
+	r.mutex.RUnlock()
+	sort.Sort(metricsByName(metrics))
+	tw := newTextWriter(w)
+	for _, v := range metrics {
+		v.writeText(tw)
+	}
+	return tw.Flush()
+}
+
+// NewCounter creates a new Counter and registers it in the
+// Default Registry. It panics if the name is already taken.
+func NewCounter(name string, help string, labels ...string) *Counter {
+	c := newCounter(name, help, labels)
+	Default.MustRegister(c)
+	return c
+}
+
+// NewGauge creates a new Gauge and registers it in the
+// Default Registry. It panics if the name is already taken.
+func NewGauge(name string, help string, labels ...string) *Gauge {
+	g := newGauge(name, help, labels)
+	Default.MustRegister(g)
+	return g
+}
+
+// NewGaugeFunc creates a new GaugeFunc and registers it in the
+// Default Registry. It panics if the name is already taken.
+func NewGaugeFunc(name string, help string, f func() float64) *GaugeFunc {
+	g := newGaugeFunc(name, help, f)
+	Default.MustRegister(g)
+	return g
+}
+
+// NewHistogram creates a new Histogram and registers it in the
+// Default Registry. It panics if the name is already taken. If
+// buckets is empty, DefaultBuckets is used.
+func NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	h := newHistogram(name, help, buckets, labels)
+	Default.MustRegister(h)
+	return h
+}
+
+type metricsByName []Metric
+
+func (m metricsByName) Len() int           { return len(m) }
+func (m metricsByName) Less(i, j int) bool { return m[i].Name() < m[j].Name() }
+func (m metricsByName) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+
+func checkNames(name string, labels []string) {
+	if !nameRe.MatchString(name) {
+		panic(fmt.Errorf("invalid metric name %q", name))
+	}
+	for _, v := range labels {
+		if !labelRe.MatchString(v) || v == "le" {
+			panic(fmt.Errorf("invalid label name %q for metric %s", v, name))
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the value for the Content-Type header
+// when serving the output of Registry.WriteText.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	helpEscaper  = strings.NewReplacer("\\", "\\\\", "\n", "\\n")
+	labelEscaper = strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\"", "\\\"")
+)
+
+// textWriter writes metrics using the Prometheus text
+// exposition format. Write errors are recorded and
+// returned by Flush.
+type textWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func newTextWriter(w io.Writer) *textWriter {
+	return &textWriter{w: bufio.NewWriter(w)}
+}
+
+func (w *textWriter) writeString(s string) {
+	if w.err == nil {
+		_, w.err = w.w.WriteString(s)
+	}
+}
+
+func (w *textWriter) header(name string, help string, typ string) {
+	if help != "" {
+		w.writeString("# HELP " + name + " " + helpEscaper.Replace(help) + "\n")
+	}
+	w.writeString("# TYPE " + name + " " + typ + "\n")
+}
+
+// sample writes a sample for the given label names and values. If
+// extraName is not empty, an additional label is written (used for
+// the le label in histogram buckets).
+func (w *textWriter) sample(name string, labels []string, values []string, extraName string, extraValue string, v float64) {
+	w.writeString(name)
+	if len(labels) > 0 || extraName != "" {
+		w.writeString("{")
+		for ii, l := range labels {
+			if ii > 0 {
+				w.writeString(",")
+			}
+			w.writeString(l + "=\"" + labelEscaper.Replace(values[ii]) + "\"")
+		}
+		if extraName != "" {
+			if len(labels) > 0 {
+				w.writeString(",")
+			}
+			w.writeString(extraName + "=\"" + extraValue + "\"")
+		}
+		w.writeString("}")
+	}
+	w.writeString(" " + formatFloat(v) + "\n")
+}
+
+func (w *textWriter) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.w.Flush()
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"net/url"
 	"time"
+
+	"gnd.la/trace"
 )
 
 // Transport is the interface used as a transport by *Client.
@@ -39,6 +41,14 @@ type Transport interface {
 // request.
 type Proxy func(*http.Request) (*url.URL, error)
 
+// tracingContext is implemented by Contexts which carry a request
+// id and a trace span (e.g. *app.Context), which are propagated in
+// the outgoing requests.
+type tracingContext interface {
+	RequestID() string
+	Span() *trace.Span
+}
+
 type proxyRoundTripper interface {
 	http.RoundTripper
 	Proxy() Proxy
@@ -52,6 +62,7 @@ func NewTransport(ctx Context) Transport {
 
 func newTransport(ctx Context) *transport {
 	tr := &transport{}
+	tr.tracing, _ = ctx.(tracingContext)
 	rt := newRoundTripper(ctx, tr)
 	tr.transport = rt
 	return tr
@@ -61,10 +72,12 @@ type transport struct {
 	userAgent string
 	timeout   time.Duration
 	transport http.RoundTripper
+	tracing   tracingContext
 }
 
 func (t *transport) clone(ctx Context) *transport {
 	tc := *t
+	tc.tracing, _ = ctx.(tracingContext)
 	tc.transport = newRoundTripper(ctx, &tc)
 	return &tc
 }
@@ -91,5 +104,30 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 			req.Header.Add("User-Agent", t.userAgent)
 		}
 	}
-	return t.transport.RoundTrip(req)
+	if req.Header == nil {
+		return t.transport.RoundTrip(req)
+	}
+	span := t.startSpan(req)
+	sc := span.Context()
+	if sc.RequestID == "" && t.tracing != nil {
+		sc.RequestID = t.tracing.RequestID()
+	}
+	trace.Inject(req.Header, sc)
+	resp, err := t.transport.RoundTrip(req)
+	if resp != nil {
+		span.Set("status", resp.StatusCode)
+	}
+	span.SetError(err).End()
+	return resp, err
+}
+
+// startSpan starts the span for the given request, as a child of
+// the current span for the Context which created the transport.
+func (t *transport) startSpan(req *http.Request) *trace.Span {
+	if !trace.Enabled() || t.tracing == nil {
+		return nil
+	}
+	return t.tracing.Span().Child("http").
+		Set("method", req.Method).
+		Set("url", req.URL.String())
 }
@@ -7,10 +7,13 @@ import (
 // Config specifies the mail configuration. It's not recommended
 // to change this fields manually. Instead, use their respective
 // config keys or flags. See DefaultServer, DefaultFrom and AdminEmail.
+// All of them might be changed at runtime by reloading the
+// configuration (see gnd.la/config.Reload), so they must be
+// read using the accessor functions.
 var Config struct {
-	MailServer  string `default:"localhost:25" help:"Default mail server used by gnd.la/net/mail"`
-	DefaultFrom string `help:"Default From address when sending emails"`
-	AdminEmail  string `help:"When running in non-debug mode, any error messages will be emailed to this adddress"`
+	MailServer  string `default:"localhost:25" reload:"true" help:"Default mail server used by gnd.la/net/mail"`
+	DefaultFrom string `reload:"true" help:"Default From address when sending emails"`
+	AdminEmail  string `reload:"true" help:"When running in non-debug mode, any error messages will be emailed to this adddress"`
 }
 
 func init() {
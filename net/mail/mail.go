@@ -11,6 +11,7 @@ import (
 	"net/mail"
 	"path"
 
+	"gnd.la/config"
 	"gnd.la/util/generic"
 )
 
@@ -175,6 +176,8 @@ func Send(msg *Message) error {
 //
 // The default server value is localhost:25.
 func DefaultServer() string {
+	config.RLock()
+	defer config.RUnlock()
 	return Config.MailServer
 }
 
@@ -183,6 +186,8 @@ func DefaultServer() string {
 // Use the configuration file key default_from or the
 // command line flag -default-from to change it.
 func DefaultFrom() string {
+	config.RLock()
+	defer config.RUnlock()
 	return Config.DefaultFrom
 }
 
@@ -192,6 +197,8 @@ func DefaultFrom() string {
 // Use the configuration file key admin_email or the
 // command line flag -admin-email to change it.
 func AdminEmail() string {
+	config.RLock()
+	defer config.RUnlock()
 	return Config.AdminEmail
 }
 
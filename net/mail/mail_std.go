@@ -46,8 +46,8 @@ func makeBoundary() string {
 }
 
 func sendMail(to []string, cc []string, bcc []string, msg *Message) error {
-	from := Config.DefaultFrom
-	server := Config.MailServer
+	from := DefaultFrom()
+	server := DefaultServer()
 	if msg.Server != "" {
 		server = msg.Server
 	}
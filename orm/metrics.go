@@ -0,0 +1,10 @@
+package orm
+
+import (
+	"gnd.la/metrics"
+)
+
+var (
+	queryDuration = metrics.NewHistogram("gondola_orm_query_duration_seconds",
+		"Time spent executing ORM queries, by model and operation.", nil, "model", "operation")
+)
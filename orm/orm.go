@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"gnd.la/app/profile"
 	"gnd.la/config"
@@ -12,6 +13,7 @@ import (
 	"gnd.la/orm/driver"
 	"gnd.la/orm/driver/sql"
 	"gnd.la/orm/query"
+	"gnd.la/trace"
 	"gnd.la/util/types"
 )
 
@@ -45,6 +47,8 @@ type Orm struct {
 	typeRegistry typeRegistry
 	// these fields are non-nil iff the ORM driver uses database/sql
 	db *sql.DB
+	// set by WithSpan
+	span *trace.Span
 }
 
 // Table returns a Query object initialized with the given table.
@@ -136,6 +140,10 @@ func (o *Orm) insert(m *model, obj interface{}) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("insert", m.name).End()
 	}
+	if o.span != nil {
+		defer o.span.Child("orm.insert").Set("model", m.name).End()
+	}
+	defer queryDuration.ObserveSince(time.Now(), m.name, "insert")
 	var pkName string
 	var pkVal reflect.Value
 	f := m.fields
@@ -210,6 +218,10 @@ func (o *Orm) update(m *model, q query.Q, obj interface{}) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("update", m.name).End()
 	}
+	if o.span != nil {
+		defer o.span.Child("orm.update").Set("model", m.name).End()
+	}
+	defer queryDuration.ObserveSince(time.Now(), m.name, "update")
 	return o.conn.Update(m, q, obj)
 }
 
@@ -230,6 +242,10 @@ func (o *Orm) Upsert(q query.Q, obj interface{}) (Result, error) {
 		if profile.On && profile.Profiling() {
 			defer profile.Start(orm).Note("upsert", "").End()
 		}
+		if o.span != nil {
+			defer o.span.Child("orm.upsert").Set("model", m.name).End()
+		}
+		defer queryDuration.ObserveSince(time.Now(), m.name, "upsert")
 		return o.conn.Upsert(m, q, obj)
 	}
 	res, err := o.update(m, q, obj)
@@ -289,6 +305,9 @@ func (o *Orm) save(m *model, obj interface{}) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("save", m.name).End()
 	}
+	if o.span != nil {
+		defer o.span.Child("orm.save").Set("model", m.name).End()
+	}
 	var res Result
 	var err error
 	if m.fields.PrimaryKey >= 0 {
@@ -382,6 +401,10 @@ func (o *Orm) delete(m *model, q query.Q) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("delete", m.name).End()
 	}
+	if o.span != nil {
+		defer o.span.Child("orm.delete").Set("model", m.name).End()
+	}
+	defer queryDuration.ObserveSince(time.Now(), m.name, "delete")
 	return o.conn.Delete(m, q)
 }
 
@@ -506,6 +529,15 @@ func (o *Orm) SetLogger(logger *log.Logger) {
 	}
 }
 
+// WithSpan returns a copy of the Orm which records its operations
+// as children of the given trace span. Note that gnd.la/app.Context
+// already returns an Orm bound to its current span.
+func (o *Orm) WithSpan(span *trace.Span) *Orm {
+	cpy := *o
+	cpy.span = span
+	return &cpy
+}
+
 func (o *Orm) models(objs []interface{}, q query.Q, sort []driver.Sort, jt JoinType) (*joinModel, []*driver.Methods, error) {
 	jm := &joinModel{}
 	models := make(map[*model]struct{})
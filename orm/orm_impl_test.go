@@ -3,6 +3,7 @@ package orm
 import (
 	"bytes"
 	"flag"
+	"reflect"
 	"testing"
 	"time"
 
@@ -639,6 +640,26 @@ func testDefaults(t *testing.T, o *Orm) {
 	}
 }
 
+func testTables(t *testing.T, o *Orm) {
+	o.mustRegister((*AutoIncrement)(nil), &Options{Name: "B"})
+	o.mustRegister((*Defaulter)(nil), &Options{Name: "A"})
+	o.mustInitialize()
+	tables := o.Tables()
+	if len(tables) != 2 {
+		t.Fatalf("expecting 2 tables, got %d", len(tables))
+	}
+	if tables[0].Name() != "A" || tables[1].Name() != "B" {
+		t.Errorf("expecting tables A and B, got %s and %s", tables[0].Name(), tables[1].Name())
+	}
+	if typ := tables[1].Type(); typ != reflect.TypeOf(AutoIncrement{}) {
+		t.Errorf("expecting type AutoIncrement, got %v", typ)
+	}
+	fields := tables[1].Model().Fields()
+	if pk := fields.PrimaryKey; pk < 0 || fields.QNames[pk] != "Id" || !fields.AutoincrementPk {
+		t.Errorf("expecting autoincrement primary key Id, got %d", pk)
+	}
+}
+
 func testSaveUnchanged(t *testing.T, o *Orm) {
 	o.mustRegister((*AutoIncrement)(nil), nil)
 	o.mustInitialize()
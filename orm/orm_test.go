@@ -12,6 +12,10 @@ func TestBadAutoincrement(t *testing.T) {
 	runTest(t, testBadAutoincrement)
 }
 
+func TestTables(t *testing.T) {
+	runTest(t, testTables)
+}
+
 func TestTime(t *testing.T) {
 	runTest(t, testTime)
 }
@@ -6,6 +6,7 @@ import (
 	"gnd.la/orm/driver"
 	"gnd.la/orm/query"
 	"reflect"
+	"time"
 )
 
 type Query struct {
@@ -122,6 +123,10 @@ func (q *Query) Exists() (bool, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("exists", q.model.String()).End()
 	}
+	if q.orm.span != nil {
+		defer q.orm.span.Child("orm.exists").Set("model", q.model.String()).End()
+	}
+	defer queryDuration.ObserveSince(time.Now(), q.model.model.name, "exists")
 	return q.orm.driver.Exists(q.model, q.q)
 }
 
@@ -193,6 +198,10 @@ func (q *Query) Count() (uint64, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("count", q.model.String()).End()
 	}
+	if q.orm.span != nil {
+		defer q.orm.span.Child("orm.count").Set("model", q.model.String()).End()
+	}
+	defer queryDuration.ObserveSince(time.Now(), q.model.model.name, "count")
 	return q.orm.driver.Count(q.model, q.q, q.limit, q.offset)
 }
 
@@ -230,6 +239,10 @@ func (q *Query) exec(limit int) driver.Iter {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("query", q.model.String()).End()
 	}
+	if q.orm.span != nil {
+		defer q.orm.span.Child("orm.query").Set("model", q.model.String()).End()
+	}
+	defer queryDuration.ObserveSince(time.Now(), q.model.model.name, "query")
 	return q.orm.conn.Query(q.model, q.q, q.sort, limit, q.offset)
 }
 
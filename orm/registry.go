@@ -454,6 +454,27 @@ func (o *Orm) NameTable(name string) *Table {
 	return nil
 }
 
+// Tables returns the Tables for all the models registered in
+// the Orm, sorted by their names.
+func (o *Orm) Tables() []*Table {
+	models := make([]*model, 0, len(o.typeRegistry))
+	for _, v := range o.typeRegistry {
+		models = append(models, v)
+	}
+	sort.Sort(modelsByName(models))
+	tables := make([]*Table, len(models))
+	for ii, v := range models {
+		tables[ii] = tableWithModel(v)
+	}
+	return tables
+}
+
+type modelsByName []*model
+
+func (m modelsByName) Len() int           { return len(m) }
+func (m modelsByName) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+func (m modelsByName) Less(i, j int) bool { return m[i].name < m[j].name }
+
 // TypeTable returns the Table for the given type, or
 // nil if there's no such table.
 func (o *Orm) TypeTable(typ reflect.Type) *Table {
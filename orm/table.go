@@ -1,6 +1,9 @@
 package orm
 
 import (
+	"reflect"
+
+	"gnd.la/orm/driver"
 	"gnd.la/orm/query"
 )
 
@@ -37,6 +40,28 @@ func (t *Table) Skip() *Table {
 	return &Table{model: model}
 }
 
+// Name returns the name of the model in the Table, as provided in
+// Options.Name or assigned by the ORM (see Orm.NameTable). For joined
+// tables, it returns the name of the first model.
+func (t *Table) Name() string {
+	return t.model.name
+}
+
+// Type returns the type of the model in the Table. For
+// joined tables, it returns the type of the first model.
+func (t *Table) Type() reflect.Type {
+	return t.model.Type()
+}
+
+// Model returns the driver.Model for the Table, which provides
+// access to the table name, its fields, primary keys, references
+// and indexes. For joined tables, it returns the first model.
+// Note that references are only resolved after the ORM has been
+// initialized.
+func (t *Table) Model() driver.Model {
+	return t.model.model
+}
+
 func tableWithModel(m *model) *Table {
 	return &Table{model: &joinModel{model: m}}
 }
@@ -4,13 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"gnd.la/internal/runtimeutil"
-	"gnd.la/log"
 )
 
 var (
 	signals = map[string][]*reflect.Value{}
+	mu      sync.RWMutex
 )
 
 type Token struct {
@@ -47,7 +48,9 @@ func listen(name string, f interface{}) (*Token, error) {
 	if err := checkListener(val); err != nil {
 		return nil, err
 	}
+	mu.Lock()
 	signals[name] = append(signals[name], &val)
+	mu.Unlock()
 	return &Token{&val}, nil
 }
 
@@ -57,6 +60,8 @@ func listen(name string, f interface{}) (*Token, error) {
 // Listen(). If it's empty, all the listeners for the given signals will be
 // removed.
 func Stop(name string, t *Token) {
+	mu.Lock()
+	defer mu.Unlock()
 	if name == "" {
 		for k := range signals {
 			removeToken(signals, k, t)
@@ -66,10 +71,14 @@ func Stop(name string, t *Token) {
 	}
 }
 
-// Emit calls all the listeners for the given signal.
+// Emit calls all the listeners for the given signal. It might
+// be called from any goroutine, but note that listeners run in
+// the goroutine which emits the signal.
 func Emit(name string, object interface{}) {
-	log.Debugf("Emitting signal %s with %T object", name, object)
-	if rec := signals[name]; rec != nil {
+	mu.RLock()
+	rec := append([]*reflect.Value(nil), signals[name]...)
+	mu.RUnlock()
+	if len(rec) > 0 {
 		params := []reflect.Value{reflect.ValueOf(name), reflect.ValueOf(object)}
 		for _, v := range rec {
 			v.Call(params[:v.Type().NumIn()])
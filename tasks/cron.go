@@ -0,0 +1,231 @@
+package tasks
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit is the number of years searched by Cron.Next
+// before giving up (e.g. for an expression like "0 0 30 2 *").
+const cronSearchLimit = 5
+
+var (
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+	cronMonths = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	cronDays   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = [...]cronField{
+	{"minute", 0, 59, nil},
+	{"hour", 0, 23, nil},
+	{"day of month", 1, 31, nil},
+	{"month", 1, 12, cronMonths},
+	// 7 is also accepted as Sunday
+	{"day of week", 0, 7, cronDays},
+}
+
+// Cron represents a parsed cron expression, used for scheduling
+// tasks with ScheduleCron. Use ParseCron or MustParseCron to
+// obtain a Cron.
+type Cron struct {
+	expr     string
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	domStar  bool
+	dowStar  bool
+	location *time.Location
+}
+
+// ParseCron parses a cron expression with 5 fields separated by
+// spaces: minute (0-59), hour (0-23), day of month (1-31), month (1-12
+// or jan-dec) and day of week (0-7 or sun-sat, with both 0 and 7 meaning
+// Sunday). Each field might be an asterisk, a number, a range (1-5) or
+// a comma separated list of them, with an optional step (*/15, 1-9/2).
+// As in most cron implementations, if both day of month and day of week
+// are restricted, times matching any of them are accepted. The macros
+// @yearly (or @annually), @monthly, @weekly, @daily (or @midnight) and
+// @hourly are also supported.
+//
+// Times are interpreted in UTC unless the expression is prefixed by
+// TZ={location} (e.g. "TZ=Europe/Madrid 0 3 * * mon-fri").
+func ParseCron(expr string) (*Cron, error) {
+	c := &Cron{expr: expr, location: time.UTC}
+	spec := strings.TrimSpace(expr)
+	if strings.HasPrefix(spec, "TZ=") {
+		p := strings.IndexAny(spec, " \t")
+		if p < 0 {
+			return nil, fmt.Errorf("invalid cron expression %q: missing fields after time zone", expr)
+		}
+		loc, err := time.LoadLocation(spec[3:p])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %s", expr, err)
+		}
+		c.location = loc
+		spec = strings.TrimSpace(spec[p:])
+	}
+	if m, ok := cronMacros[spec]; ok {
+		spec = m
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expecting %d fields, got %d", expr, len(cronFields), len(fields))
+	}
+	values := [...]*uint64{&c.minute, &c.hour, &c.dom, &c.month, &c.dow}
+	for ii, v := range fields {
+		bits, err := parseCronField(v, &cronFields[ii])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %s", expr, err)
+		}
+		*values[ii] = bits
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow = (c.dow | 1) &^ (1 << 7)
+	}
+	c.domStar = fields[2] == "*" || fields[2] == "?"
+	c.dowStar = fields[4] == "*" || fields[4] == "?"
+	return c, nil
+}
+
+// MustParseCron works like ParseCron, but panics if
+// there's an error.
+func MustParseCron(expr string) *Cron {
+	c, err := ParseCron(expr)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func parseCronField(s string, f *cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(s, ",") {
+		rng := part
+		step := 1
+		if p := strings.IndexByte(part, '/'); p >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[p+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %s %q", f.name, part)
+			}
+			rng = part[:p]
+		}
+		var start, end int
+		switch {
+		case rng == "*" || rng == "?":
+			start, end = f.min, f.max
+		case strings.IndexByte(rng, '-') > 0:
+			p := strings.IndexByte(rng, '-')
+			var err error
+			if start, err = parseCronValue(rng[:p], f); err != nil {
+				return 0, err
+			}
+			if end, err = parseCronValue(rng[p+1:], f); err != nil {
+				return 0, err
+			}
+			if end < start {
+				return 0, fmt.Errorf("invalid range in %s %q", f.name, rng)
+			}
+		default:
+			var err error
+			if start, err = parseCronValue(rng, f); err != nil {
+				return 0, err
+			}
+			end = start
+			if step > 1 {
+				// a/n means from a to the max
+				end = f.max
+			}
+		}
+		for ii := start; ii <= end; ii += step {
+			bits |= 1 << uint(ii)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, f *cronField) (int, error) {
+	for ii, v := range f.names {
+		if strings.EqualFold(s, v) {
+			if f.min > 0 {
+				return ii + f.min, nil
+			}
+			return ii, nil
+		}
+	}
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", f.name, s)
+	}
+	if val < f.min || val > f.max {
+		return 0, fmt.Errorf("%s %d out of range [%d, %d]", f.name, val, f.min, f.max)
+	}
+	return val, nil
+}
+
+// Next returns the first time matching the expression which
+// is after t, or the zero time if there's no such time (e.g.
+// the expression "0 0 30 2 *" never matches).
+func (c *Cron) Next(t time.Time) time.Time {
+	t = t.In(c.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + cronSearchLimit
+	for t.Year() <= limit {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.location)
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.location)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, c.location)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c *Cron) matchesDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Location returns the location used for
+// interpreting the expression.
+func (c *Cron) Location() *time.Location {
+	return c.location
+}
+
+// String returns the expression the Cron was parsed from.
+func (c *Cron) String() string {
+	return c.expr
+}
@@ -0,0 +1,203 @@
+package tasks
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/orm"
+)
+
+// DefaultHistorySize is the number of runs per task
+// kept by the default History.
+const DefaultHistorySize = 20
+
+var history struct {
+	sync.RWMutex
+	h History
+}
+
+// Result represents the result of a task execution,
+// as stored in the History.
+type Result struct {
+	// Task is the task name.
+	Task    string
+	Started time.Time
+	Ended   time.Time
+	// Error is the error message if the task failed
+	// (e.g. because it panicked).
+	Error string
+}
+
+// Duration returns the time the execution took.
+func (r *Result) Duration() time.Duration {
+	return r.Ended.Sub(r.Started)
+}
+
+// History is the interface implemented by the types which store
+// the results of the task executions. By default, the results are
+// kept in memory (see NewMemoryHistory), use SetHistory to change it.
+type History interface {
+	// Add stores a new result.
+	Add(r *Result) error
+	// Results returns up to limit results for the task with
+	// the given name, most recent first. A limit <= 0 returns
+	// all of them.
+	Results(task string, limit int) ([]*Result, error)
+}
+
+// SetHistory sets the History used for storing the results of the
+// executions of registered tasks. If h is nil, results are not stored.
+func SetHistory(h History) {
+	history.Lock()
+	history.h = h
+	history.Unlock()
+}
+
+// GetHistory returns the History set with SetHistory, which
+// defaults to a memory history with DefaultHistorySize results
+// per task.
+func GetHistory() History {
+	history.RLock()
+	defer history.RUnlock()
+	return history.h
+}
+
+// Results returns up to limit results for the task, most
+// recent first. See History.Results.
+func (t *Task) Results(limit int) ([]*Result, error) {
+	if h := GetHistory(); h != nil {
+		return h.Results(t.Name(), limit)
+	}
+	return nil, nil
+}
+
+func addResult(ctx *app.Context, task *Task, started time.Time, ended time.Time, err error) {
+	h := GetHistory()
+	if h == nil || !task.isRegistered() {
+		return
+	}
+	r := &Result{Task: task.Name(), Started: started, Ended: ended}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	if err := h.Add(r); err != nil {
+		ctx.Logger().Errorf("error storing result for task %s: %s", r.Task, err)
+	}
+}
+
+type memoryHistory struct {
+	sync.RWMutex
+	size    int
+	results map[string][]*Result
+}
+
+// NewMemoryHistory returns a History which keeps up to size
+// results per task in memory.
+func NewMemoryHistory(size int) History {
+	return &memoryHistory{size: size, results: make(map[string][]*Result)}
+}
+
+func (h *memoryHistory) Add(r *Result) error {
+	h.Lock()
+	defer h.Unlock()
+	results := append([]*Result{r}, h.results[r.Task]...)
+	if len(results) > h.size {
+		results = results[:h.size]
+	}
+	h.results[r.Task] = results
+	return nil
+}
+
+func (h *memoryHistory) Results(task string, limit int) ([]*Result, error) {
+	h.RLock()
+	defer h.RUnlock()
+	results := h.results[task]
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	return append([]*Result(nil), results...), nil
+}
+
+var (
+	ormResultType  = reflect.TypeOf(ormResult{})
+	ormHistoryOnce sync.Once
+)
+
+// ormResult is the model used for storing results in the ORM.
+type ormResult struct {
+	Id      int64  `orm:",primary_key,auto_increment"`
+	Task    string `orm:",index"`
+	Started time.Time
+	Ended   time.Time
+	Error   string
+}
+
+type ormHistory struct {
+	app  *app.App
+	size int
+}
+
+// NewOrmHistory returns a History which stores up to size results per
+// task in the gondola_task_results table of the given App's ORM, removing
+// the oldest ones when new results are added (a size <= 0 keeps all of
+// them). Since the table
+// model must be registered before the ORM is initialized, this function
+// must be called before the App's ORM is used (e.g. from an init function
+// or at the start of main).
+func NewOrmHistory(a *app.App, size int) History {
+	ormHistoryOnce.Do(func() {
+		orm.Register(ormResultType, &orm.Options{
+			Table: "gondola_task_results",
+			Name:  "TaskResult",
+		})
+	})
+	return &ormHistory{app: a, size: size}
+}
+
+func (h *ormHistory) Add(r *Result) error {
+	o, err := h.app.Orm()
+	if err != nil {
+		return err
+	}
+	if _, err := o.Insert(&ormResult{Task: r.Task, Started: r.Started, Ended: r.Ended, Error: r.Error}); err != nil {
+		return err
+	}
+	if h.size <= 0 {
+		return nil
+	}
+	// Remove the results older than the size-th most recent one
+	var oldest ormResult
+	ok, err := o.Query(orm.Eq("Task", r.Task)).Sort("Id", orm.DESC).Offset(h.size - 1).One(&oldest)
+	if err != nil || !ok {
+		return err
+	}
+	_, err = o.DeleteFrom(o.TypeTable(ormResultType), orm.And(orm.Eq("Task", r.Task), orm.Lt("Id", oldest.Id)))
+	return err
+}
+
+func (h *ormHistory) Results(task string, limit int) ([]*Result, error) {
+	o, err := h.app.Orm()
+	if err != nil {
+		return nil, err
+	}
+	q := o.Query(orm.Eq("Task", task)).Sort("Id", orm.DESC)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	var results []*Result
+	iter := q.Iter()
+	var row ormResult
+	for iter.Next(&row) {
+		results = append(results, &Result{Task: row.Task, Started: row.Started, Ended: row.Ended, Error: row.Error})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func init() {
+	SetHistory(NewMemoryHistory(DefaultHistorySize))
+}
@@ -0,0 +1,25 @@
+package tasks
+
+import (
+	"time"
+
+	"gnd.la/metrics"
+)
+
+var (
+	executionsTotal = metrics.NewCounter("gondola_task_executions_total",
+		"Number of task executions, by task name and result (success or error).", "task", "result")
+	taskDuration = metrics.NewHistogram("gondola_task_duration_seconds",
+		"Time spent executing tasks, by task name.",
+		[]float64{.01, .1, 1, 10, 30, 60, 300, 600, 1800, 3600}, "task")
+)
+
+func recordTaskMetrics(task *Task, started time.Time, err error) {
+	name := task.Name()
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	executionsTotal.Inc(name, result)
+	taskDuration.ObserveSince(started, name)
+}
@@ -0,0 +1,39 @@
+// Package queue implements a persistent job queue, shared by all the
+// instances of an app.
+//
+// Jobs are identified by a name and carry an optional payload, which
+// is encoded as JSON. They're stored in a Storage, so they survive
+// restarts, and any instance with a handler for the job name might run
+// them. Gondola includes storages backed by the ORM (NewOrmStorage), by
+// redis (NewRedisStorage and NewCacheStorage) and an in-memory one for
+// tests and single process apps (NewMemoryStorage).
+//
+//  o, err := App.Orm()
+//  if err != nil {
+//      panic(err)
+//  }
+//  q := queue.New(App, queue.NewOrmStorage(o.Orm))
+//  q.Handle("send-welcome", func(ctx *app.Context, job *queue.Job) error {
+//      var userId int64
+//      if err := job.Decode(&userId); err != nil {
+//          return err
+//      }
+//      return sendWelcome(ctx, userId)
+//  }, &queue.Options{Concurrency: 4})
+//  q.Start(8)
+//
+//  // In some handler
+//  q.Enqueue("send-welcome", user.Id)
+//
+// Jobs are delivered at least once: if a handler returns an error (or
+// panics), the job is retried with an exponential backoff until it reaches
+// its maximum number of attempts, after which it's moved to the dead jobs
+// (see Queue.Dead and Queue.Requeue) and the JOB_DEAD signal is emitted. If
+// an instance stops while running a job, the job is run again once its lease
+// expires (see Queue.Lease).
+//
+// Queue also implements gnd.la/tasks.Locker, so it can be used for
+// running scheduled tasks in only one instance of the app:
+//
+//  tasks.Schedule(App, cleanup, &tasks.Options{Locker: q}, time.Hour, false)
+package queue
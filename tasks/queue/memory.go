@@ -0,0 +1,168 @@
+package queue
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+type memoryJob struct {
+	job Job
+	// available is the time the job might be claimed,
+	// either its RunAt or the end of its lease.
+	available time.Time
+}
+
+type memoryLock struct {
+	owner   string
+	expires time.Time
+}
+
+type memoryStorage struct {
+	mu    sync.Mutex
+	id    int64
+	jobs  map[string]*memoryJob
+	dead  []*Job
+	locks map[string]*memoryLock
+}
+
+// NewMemoryStorage returns a Storage which keeps the jobs in memory.
+// Jobs are lost when the process exits and they can't be shared with
+// other instances, so it's only intended for tests and apps running a
+// single instance.
+func NewMemoryStorage() Storage {
+	return &memoryStorage{
+		jobs:  make(map[string]*memoryJob),
+		locks: make(map[string]*memoryLock),
+	}
+}
+
+func (s *memoryStorage) Push(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.id++
+	job.Id = strconv.FormatInt(s.id, 10)
+	s.jobs[job.Id] = &memoryJob{job: *job, available: job.RunAt}
+	return nil
+}
+
+func (s *memoryStorage) Claim(names []string, now time.Time, lease time.Duration) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var next *memoryJob
+	for _, v := range s.jobs {
+		if v.available.After(now) || !containsName(names, v.job.Name) {
+			continue
+		}
+		if next == nil || v.available.Before(next.available) ||
+			(v.available.Equal(next.available) && v.job.Created.Before(next.job.Created)) {
+			next = v
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+	next.job.Attempts++
+	next.job.Version++
+	next.available = now.Add(lease)
+	job := next.job
+	return &job, nil
+}
+
+// claimed returns the stored job if it's still held by
+// the claim which returned job. s.mu must be held.
+func (s *memoryStorage) claimed(job *Job) (*memoryJob, error) {
+	m := s.jobs[job.Id]
+	if m == nil || m.job.Version != job.Version {
+		return nil, ErrLeaseExpired
+	}
+	return m, nil
+}
+
+func (s *memoryStorage) Complete(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.claimed(job); err != nil {
+		return err
+	}
+	delete(s.jobs, job.Id)
+	return nil
+}
+
+func (s *memoryStorage) Retry(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.claimed(job)
+	if err != nil {
+		return err
+	}
+	m.job.RunAt = job.RunAt
+	m.job.Error = job.Error
+	m.available = job.RunAt
+	return nil
+}
+
+func (s *memoryStorage) Bury(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.claimed(job)
+	if err != nil {
+		return err
+	}
+	delete(s.jobs, job.Id)
+	dead := m.job
+	dead.Error = job.Error
+	s.dead = append([]*Job{&dead}, s.dead...)
+	return nil
+}
+
+func (s *memoryStorage) Dead(limit int) ([]*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dead := s.dead
+	if limit > 0 && limit < len(dead) {
+		dead = dead[:limit]
+	}
+	jobs := make([]*Job, len(dead))
+	for ii, v := range dead {
+		job := *v
+		jobs[ii] = &job
+	}
+	return jobs, nil
+}
+
+func (s *memoryStorage) Requeue(id string, runAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ii, v := range s.dead {
+		if v.Id == id {
+			s.dead = append(s.dead[:ii], s.dead[ii+1:]...)
+			job := *v
+			job.Attempts = 0
+			job.RunAt = runAt
+			s.jobs[id] = &memoryJob{job: job, available: runAt}
+			return nil
+		}
+	}
+	return ErrJobNotFound
+}
+
+func (s *memoryStorage) Lock(name string, owner string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if l := s.locks[name]; l != nil && l.owner != owner && l.expires.After(now) {
+		return false, nil
+	}
+	s.locks[name] = &memoryLock{owner: owner, expires: now.Add(ttl)}
+	return true, nil
+}
+
+func containsName(names []string, name string) bool {
+	for _, v := range names {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package queue
+
+import (
+	"time"
+
+	"gnd.la/metrics"
+)
+
+var (
+	jobsTotal = metrics.NewCounter("gondola_queue_jobs_total",
+		"Number of queued job executions, by job name and result (success, retry, dead or expired).", "job", "result")
+	jobDuration = metrics.NewHistogram("gondola_queue_job_duration_seconds",
+		"Time spent running queued jobs, by job name.",
+		[]float64{.01, .1, 1, 10, 30, 60, 300, 600, 1800, 3600}, "job")
+)
+
+func recordJobMetrics(job *Job, result string, started time.Time) {
+	jobsTotal.Inc(job.Name, result)
+	jobDuration.ObserveSince(started, job.Name)
+}
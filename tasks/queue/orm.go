@@ -0,0 +1,268 @@
+package queue
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gnd.la/orm"
+	"gnd.la/orm/query"
+)
+
+var (
+	ormJobType  = reflect.TypeOf(ormJob{})
+	ormLockType = reflect.TypeOf(ormLock{})
+)
+
+// ormJob is the model used for storing jobs in the ORM.
+type ormJob struct {
+	Id       int64 `orm:",primary_key,auto_increment"`
+	Name     string
+	Payload  []byte
+	Attempts int
+	RunAt    time.Time
+	Created  time.Time
+	Error    string
+	// Available is the time the job might be claimed, either
+	// its RunAt or the end of its lease. It's stored as Unix
+	// milliseconds, because some backends can't compare
+	// time.Time values in queries.
+	Available int64 `orm:",index"`
+	Dead      bool  `orm:",index"`
+	// Buried is the time the job was moved to the dead jobs.
+	Buried time.Time
+	// Version is incremented on every claim, so only one
+	// instance can claim a job and only the latest claim
+	// can complete, retry or bury it.
+	Version int64
+}
+
+func (j *ormJob) job() *Job {
+	return &Job{
+		Id:       strconv.FormatInt(j.Id, 10),
+		Name:     j.Name,
+		Payload:  j.Payload,
+		Attempts: j.Attempts,
+		RunAt:    j.RunAt,
+		Created:  j.Created,
+		Error:    j.Error,
+		Version:  j.Version,
+	}
+}
+
+// ormLock is the model used for storing locks in the ORM.
+type ormLock struct {
+	Name  string `orm:",primary_key"`
+	Owner string
+	// Expires is stored as Unix milliseconds, like ormJob.Available.
+	Expires int64
+}
+
+type ormStorage struct {
+	o *orm.Orm
+}
+
+// NewOrmStorage returns a Storage which keeps the jobs in the given
+// ORM, in the gondola_queue_jobs and gondola_queue_locks tables. The
+// models for these tables are registered when this package is imported,
+// so the tables are created by gnd.la/orm.Orm.Initialize.
+func NewOrmStorage(o *orm.Orm) Storage {
+	return &ormStorage{o: o}
+}
+
+func (s *ormStorage) Push(job *Job) error {
+	row := &ormJob{
+		Name:      job.Name,
+		Payload:   job.Payload,
+		RunAt:     job.RunAt,
+		Created:   job.Created,
+		Available: millis(job.RunAt),
+	}
+	if _, err := s.o.Insert(row); err != nil {
+		return err
+	}
+	job.Id = strconv.FormatInt(row.Id, 10)
+	return nil
+}
+
+func (s *ormStorage) Claim(names []string, now time.Time, lease time.Duration) (*Job, error) {
+	q := orm.And(orm.In("Name", names), orm.Eq("Dead", false), orm.Lte("Available", millis(now)))
+	for {
+		var row ormJob
+		ok, err := s.o.Query(q).Sort("Available", orm.ASC).One(&row)
+		if err != nil || !ok {
+			return nil, err
+		}
+		version := row.Version
+		row.Version++
+		row.Attempts++
+		row.Available = millis(now.Add(lease))
+		res, err := s.o.Update(orm.And(orm.Eq("Id", row.Id), orm.Eq("Version", version)), &row)
+		if err != nil {
+			return nil, err
+		}
+		aff, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if aff > 0 {
+			return row.job(), nil
+		}
+		// Another instance claimed the job first, try with the next one
+	}
+}
+
+func parseId(id string) (int64, error) {
+	val, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid job id %q", id)
+	}
+	return val, nil
+}
+
+func (s *ormStorage) load(job *Job) (*ormJob, error) {
+	id, err := parseId(job.Id)
+	if err != nil {
+		return nil, err
+	}
+	var row ormJob
+	ok, err := s.o.One(orm.Eq("Id", id), &row)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	return &row, nil
+}
+
+// claimed returns the query which matches the given job
+// only while it's held by the claim which returned it.
+func claimed(id int64, job *Job) query.Q {
+	return orm.And(orm.Eq("Id", id), orm.Eq("Version", job.Version))
+}
+
+// checkClaimed returns ErrLeaseExpired if no rows were
+// affected by an operation on a claimed job.
+func checkClaimed(res orm.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return ErrLeaseExpired
+	}
+	return nil
+}
+
+func (s *ormStorage) Complete(job *Job) error {
+	id, err := parseId(job.Id)
+	if err != nil {
+		return err
+	}
+	return checkClaimed(s.o.DeleteFrom(s.o.TypeTable(ormJobType), claimed(id, job)))
+}
+
+// update loads the claimed job, calls f to modify it and
+// saves it, as long as it's still held by the given claim.
+func (s *ormStorage) update(job *Job, f func(row *ormJob)) error {
+	row, err := s.load(job)
+	if err != nil {
+		if err == ErrJobNotFound {
+			err = ErrLeaseExpired
+		}
+		return err
+	}
+	f(row)
+	return checkClaimed(s.o.Update(claimed(row.Id, job), row))
+}
+
+func (s *ormStorage) Retry(job *Job) error {
+	return s.update(job, func(row *ormJob) {
+		row.RunAt = job.RunAt
+		row.Available = millis(job.RunAt)
+		row.Error = job.Error
+	})
+}
+
+func (s *ormStorage) Bury(job *Job) error {
+	return s.update(job, func(row *ormJob) {
+		row.Error = job.Error
+		row.Dead = true
+		row.Buried = time.Now()
+	})
+}
+
+func (s *ormStorage) Dead(limit int) ([]*Job, error) {
+	q := s.o.Query(orm.Eq("Dead", true)).Sort("Buried", orm.DESC)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	var jobs []*Job
+	iter := q.Iter()
+	var row ormJob
+	for iter.Next(&row) {
+		jobs = append(jobs, row.job())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *ormStorage) Requeue(id string, runAt time.Time) error {
+	row, err := s.load(&Job{Id: id})
+	if err != nil {
+		return err
+	}
+	if !row.Dead {
+		return ErrJobNotFound
+	}
+	row.Dead = false
+	row.Attempts = 0
+	row.RunAt = runAt
+	row.Available = millis(runAt)
+	_, err = s.o.Update(orm.Eq("Id", row.Id), row)
+	return err
+}
+
+func (s *ormStorage) Lock(name string, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	lock := &ormLock{Name: name, Owner: owner, Expires: millis(now.Add(ttl))}
+	q := orm.And(orm.Eq("Name", name), orm.Or(orm.Eq("Owner", owner), orm.Lt("Expires", millis(now))))
+	res, err := s.o.Update(q, lock)
+	if err != nil {
+		return false, err
+	}
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if aff > 0 {
+		return true, nil
+	}
+	if _, err := s.o.Insert(lock); err != nil {
+		// Insert fails if another instance holds the lock
+		exists, eerr := s.o.Exists(s.o.TypeTable(ormLockType), orm.Eq("Name", name))
+		if eerr == nil && exists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func init() {
+	orm.Register(ormJobType, &orm.Options{
+		Table: "gondola_queue_jobs",
+		Name:  "QueueJob",
+	})
+	orm.Register(ormLockType, &orm.Options{
+		Table: "gondola_queue_locks",
+		Name:  "QueueLock",
+	})
+}
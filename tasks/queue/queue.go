@@ -0,0 +1,388 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/internal/runtimeutil"
+	"gnd.la/log"
+	"gnd.la/signal"
+	"gnd.la/tasks"
+)
+
+const (
+	// JOB_DEAD is emitted when a job reaches its maximum
+	// number of attempts and it's moved to the dead jobs.
+	// The object is the *Job.
+	JOB_DEAD = "gnd.la/tasks/queue.job-dead"
+)
+
+const (
+	// DefaultMaxAttempts is the maximum number of times a job is
+	// run when its Options don't specify MaxAttempts.
+	DefaultMaxAttempts = 5
+	// DefaultMinBackoff is the time waited before retrying a failed
+	// job for the first time when its Options don't specify MinBackoff.
+	// The time is doubled on every subsequent attempt.
+	DefaultMinBackoff = 10 * time.Second
+	// DefaultMaxBackoff is the maximum time waited before retrying a
+	// failed job when its Options don't specify MaxBackoff.
+	DefaultMaxBackoff = time.Hour
+	// DefaultLease is the default value for Queue.Lease.
+	DefaultLease = 5 * time.Minute
+	// DefaultPollInterval is the default value for Queue.PollInterval.
+	DefaultPollInterval = time.Second
+)
+
+// Handler is the function type used for running jobs. Returning
+// an error (or panicking) makes the job fail, so it's retried until
+// it reaches its maximum number of attempts.
+type Handler func(ctx *app.Context, job *Job) error
+
+// Options are used to specify how the jobs with a given
+// name are run when registering their Handler.
+type Options struct {
+	// Concurrency is the maximum number of jobs with this name run
+	// simultaneously by each instance. If zero, it's only limited by
+	// the number of workers.
+	Concurrency int
+	// MaxAttempts is the maximum number of times a job is run before
+	// moving it to the dead jobs. If zero, DefaultMaxAttempts is used.
+	MaxAttempts int
+	// MinBackoff is the time waited before retrying a failed job for
+	// the first time. It's doubled on every subsequent attempt, up to
+	// MaxBackoff. If zero, DefaultMinBackoff is used.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum time waited before retrying a failed
+	// job. If zero, DefaultMaxBackoff is used.
+	MaxBackoff time.Duration
+}
+
+func (o *Options) maxAttempts() int {
+	if o.MaxAttempts > 0 {
+		return o.MaxAttempts
+	}
+	return DefaultMaxAttempts
+}
+
+// backoff returns the time to wait before retrying a job
+// which has failed the given number of attempts. Up to 10%
+// of random jitter is added, so jobs which failed at the
+// same time are not retried at the same time.
+func (o *Options) backoff(attempts int) time.Duration {
+	min := o.MinBackoff
+	if min <= 0 {
+		min = DefaultMinBackoff
+	}
+	max := o.MaxBackoff
+	if max <= 0 {
+		max = DefaultMaxBackoff
+	}
+	d := min
+	for ii := 1; ii < attempts && d < max; ii++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d + time.Duration(rand.Int63n(int64(d)/10+1))
+}
+
+type handler struct {
+	fn      Handler
+	opts    Options
+	running int
+}
+
+// Queue runs the jobs stored in a Storage, using a pool of workers.
+// Jobs might be enqueued from any instance, but they're only run by
+// the instances which have called Start and registered a Handler for
+// the job name. Use New to initialize a Queue.
+type Queue struct {
+	// App is the app used for creating the contexts
+	// passed to the handlers.
+	App *app.App
+	// Storage is the backend where the jobs are stored.
+	Storage Storage
+	// Lease is the time a job is reserved for the worker which runs it.
+	// If it's not finished after Lease (e.g. because the instance was
+	// stopped), the job is run again, so it must be longer than the
+	// time needed for running any job. If zero, DefaultLease is used.
+	Lease time.Duration
+	// PollInterval is the time workers wait before checking the
+	// Storage again when there are no due jobs. If zero,
+	// DefaultPollInterval is used.
+	PollInterval time.Duration
+	owner        string
+	mu           sync.Mutex
+	handlers     map[string]*handler
+	stop         chan struct{}
+	wg           sync.WaitGroup
+}
+
+// New returns a new Queue for the given app, using the given Storage.
+func New(a *app.App, s Storage) *Queue {
+	host, _ := os.Hostname()
+	return &Queue{
+		App:      a,
+		Storage:  s,
+		owner:    fmt.Sprintf("%s-%d-%d", host, os.Getpid(), rand.Int63()),
+		handlers: make(map[string]*handler),
+	}
+}
+
+// Handle registers the handler for the jobs with the given name. If
+// opts is nil, the default options are used. If there was previously
+// another handler registered with the same name, it will panic.
+func (q *Queue) Handle(name string, h Handler, opts *Options) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.handlers[name] != nil {
+		panic(fmt.Errorf("there's already a handler registered for jobs named %s", name))
+	}
+	hd := &handler{fn: h}
+	if opts != nil {
+		hd.opts = *opts
+	}
+	q.handlers[name] = hd
+}
+
+// HandleTask registers a handler which runs the given task (see
+// gnd.la/tasks.Register) for the jobs named after the task. The job
+// fails if the task can't be started (e.g. because of its MaxInstances)
+// or if it panics.
+func (q *Queue) HandleTask(task *tasks.Task, opts *Options) {
+	q.Handle(task.Name(), func(ctx *app.Context, job *Job) error {
+		_, err := tasks.Run(ctx, task.Name())
+		return err
+	}, opts)
+}
+
+// Enqueue adds a job with the given name to the queue, to be run as
+// soon as possible. The payload is encoded as JSON and might be nil.
+func (q *Queue) Enqueue(name string, payload interface{}) (*Job, error) {
+	return q.EnqueueAt(name, payload, time.Now())
+}
+
+// EnqueueAt works like Enqueue, but the job won't be run before the
+// given time.
+func (q *Queue) EnqueueAt(name string, payload interface{}, at time.Time) (*Job, error) {
+	job := &Job{
+		Name:    name,
+		RunAt:   at,
+		Created: time.Now(),
+	}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding payload for job %s: %s", name, err)
+		}
+		job.Payload = data
+	}
+	if err := q.Storage.Push(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Dead returns up to limit jobs which have reached their maximum
+// number of attempts, most recently failed first. A limit <= 0
+// returns all of them.
+func (q *Queue) Dead(limit int) ([]*Job, error) {
+	return q.Storage.Dead(limit)
+}
+
+// Requeue moves the dead job with the given id back to the queue,
+// resetting its number of attempts, to be run as soon as possible.
+func (q *Queue) Requeue(id string) error {
+	return q.Storage.Requeue(id, time.Now())
+}
+
+// Lock acquires or renews the lock with the given name for the
+// current instance. It implements gnd.la/tasks.Locker.
+func (q *Queue) Lock(name string, ttl time.Duration) (bool, error) {
+	return q.Storage.Lock(name, q.owner, ttl)
+}
+
+// Start starts the given number of workers, which run the due jobs
+// with a registered Handler until Stop is called. If the queue has
+// already been started, it's stopped first.
+func (q *Queue) Start(workers int) {
+	q.Stop()
+	stop := make(chan struct{})
+	q.mu.Lock()
+	q.stop = stop
+	q.mu.Unlock()
+	for ii := 0; ii < workers; ii++ {
+		q.wg.Add(1)
+		go q.work(stop)
+	}
+}
+
+// Stop stops the workers started by Start, waiting for
+// the jobs which are currently running to finish.
+func (q *Queue) Stop() {
+	q.mu.Lock()
+	stop := q.stop
+	q.stop = nil
+	q.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		q.wg.Wait()
+	}
+}
+
+// RunOne claims a due job and runs it in the calling goroutine. It
+// returns true if a job was run. Note that the job might have failed,
+// which is not reported as an error. The returned error is non-nil only
+// when there was an error communicating with the Storage.
+func (q *Queue) RunOne() (bool, error) {
+	job, h, err := q.claim()
+	if err != nil || job == nil {
+		return false, err
+	}
+	return true, q.run(job, h)
+}
+
+func (q *Queue) work(stop chan struct{}) {
+	defer q.wg.Done()
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		ran, err := q.RunOne()
+		if err != nil {
+			log.Errorf("error running queued job: %s", err)
+		}
+		if !ran {
+			select {
+			case <-stop:
+				return
+			case <-time.After(q.pollInterval()):
+			}
+		}
+	}
+}
+
+func (q *Queue) lease() time.Duration {
+	if q.Lease > 0 {
+		return q.Lease
+	}
+	return DefaultLease
+}
+
+func (q *Queue) pollInterval() time.Duration {
+	if q.PollInterval > 0 {
+		return q.PollInterval
+	}
+	return DefaultPollInterval
+}
+
+// claim reserves a job for a handler which has not reached
+// its concurrency limit. To avoid holding the lock while talking
+// to the Storage, a slot is reserved in every handler with a limit
+// before claiming, so concurrent workers can't exceed it. Slots
+// which end up unused are released after claiming.
+func (q *Queue) claim() (*Job, *handler, error) {
+	var names []string
+	var reserved []*handler
+	q.mu.Lock()
+	for k, v := range q.handlers {
+		if v.opts.Concurrency <= 0 {
+			names = append(names, k)
+		} else if v.running < v.opts.Concurrency {
+			v.running++
+			reserved = append(reserved, v)
+			names = append(names, k)
+		}
+	}
+	q.mu.Unlock()
+	if len(names) == 0 {
+		return nil, nil, nil
+	}
+	sort.Strings(names)
+	job, err := q.Storage.Claim(names, time.Now(), q.lease())
+	var h *handler
+	q.mu.Lock()
+	if job != nil {
+		h = q.handlers[job.Name]
+	}
+	for _, v := range reserved {
+		if v != h {
+			v.running--
+		}
+	}
+	if h != nil && h.opts.Concurrency <= 0 {
+		h.running++
+	}
+	q.mu.Unlock()
+	if err != nil || job == nil {
+		return nil, nil, err
+	}
+	if h == nil {
+		// Should not happen with a correct Storage, but don't
+		// lose the job.
+		job.Error = fmt.Sprintf("no handler for job %s", job.Name)
+		return nil, nil, q.Storage.Retry(job)
+	}
+	return job, h, nil
+}
+
+func (q *Queue) run(job *Job, h *handler) error {
+	ctx := q.App.NewContext(nil)
+	defer q.App.CloseContext(ctx)
+	started := time.Now()
+	span := ctx.StartSpan("job").Set("job", job.Name).Set("id", job.Id)
+	jerr := runHandler(ctx, job, h)
+	span.SetError(jerr).End()
+	q.mu.Lock()
+	h.running--
+	q.mu.Unlock()
+	var result string
+	var err error
+	switch {
+	case jerr == nil:
+		result = "success"
+		err = q.Storage.Complete(job)
+	case job.Attempts < h.opts.maxAttempts():
+		result = "retry"
+		job.Error = jerr.Error()
+		job.RunAt = time.Now().Add(h.opts.backoff(job.Attempts))
+		ctx.Logger().Warningf("job %s (%s) failed on attempt %d, retrying at %v: %s", job.Name, job.Id, job.Attempts, job.RunAt, jerr)
+		err = q.Storage.Retry(job)
+	default:
+		result = "dead"
+		job.Error = jerr.Error()
+		ctx.Logger().Errorf("job %s (%s) failed after %d attempts: %s", job.Name, job.Id, job.Attempts, jerr)
+		if err = q.Storage.Bury(job); err == nil {
+			signal.Emit(JOB_DEAD, job)
+		}
+	}
+	if err == ErrLeaseExpired {
+		// The job took longer than its lease and it has been
+		// claimed again, the new claim decides what happens to it.
+		ctx.Logger().Warningf("job %s (%s) lease expired while running, result %s discarded", job.Name, job.Id, result)
+		result = "expired"
+		err = nil
+	}
+	recordJobMetrics(job, result, started)
+	return err
+}
+
+func runHandler(ctx *app.Context, job *Job, h *handler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			_, stackSkip, _, _ := runtimeutil.GetPanic()
+			err = fmt.Errorf("panic: %v\n%s", r, runtimeutil.FormatStack(stackSkip))
+		}
+	}()
+	return h.fn(ctx, job)
+}
@@ -0,0 +1,156 @@
+package queue_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/signal"
+	"gnd.la/tasks/queue"
+)
+
+func TestRunJob(t *testing.T) {
+	q := queue.New(app.New(), queue.NewMemoryStorage())
+	var got []int
+	q.Handle("add", func(ctx *app.Context, job *queue.Job) error {
+		var v int
+		if err := job.Decode(&v); err != nil {
+			return err
+		}
+		got = append(got, v)
+		return nil
+	}, nil)
+	for _, v := range []int{1, 2} {
+		if _, err := q.Enqueue("add", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := q.EnqueueAt("add", 3, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Enqueue("unhandled", nil); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		ran, err := q.RunOne()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ran {
+			break
+		}
+	}
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("expecting jobs [1 2] to run, got %v", got)
+	}
+}
+
+func TestRetryAndDead(t *testing.T) {
+	q := queue.New(app.New(), queue.NewMemoryStorage())
+	attempts := 0
+	q.Handle("fail", func(ctx *app.Context, job *queue.Job) error {
+		attempts++
+		if job.Attempts != attempts {
+			t.Errorf("expecting attempt %d, got %d", attempts, job.Attempts)
+		}
+		if attempts == 2 {
+			panic("second attempt")
+		}
+		return errors.New("failed")
+	}, &queue.Options{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	var dead *queue.Job
+	token := signal.Listen(queue.JOB_DEAD, func(_ string, obj interface{}) {
+		dead = obj.(*queue.Job)
+	})
+	defer signal.Stop(queue.JOB_DEAD, token)
+	job, err := q.Enqueue("fail", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ii := 0; ii < 3; ii++ {
+		time.Sleep(5 * time.Millisecond)
+		if ran, err := q.RunOne(); !ran || err != nil {
+			t.Fatalf("expecting attempt %d to run, got %v, %v", ii+1, ran, err)
+		}
+	}
+	if dead == nil || dead.Id != job.Id || dead.Error != "failed" {
+		t.Fatalf("expecting job %s to be dead, got %+v", job.Id, dead)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if ran, _ := q.RunOne(); ran {
+		t.Error("dead job was run")
+	}
+	jobs, err := q.Dead(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].Id != job.Id {
+		t.Fatalf("expecting dead job %s, got %v", job.Id, jobs)
+	}
+	if err := q.Requeue(job.Id); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Requeue(job.Id); err != queue.ErrJobNotFound {
+		t.Errorf("expecting ErrJobNotFound when requeueing twice, got %v", err)
+	}
+	attempts = 0
+	if ran, err := q.RunOne(); !ran || err != nil {
+		t.Fatalf("requeued job did not run: %v, %v", ran, err)
+	}
+}
+
+func TestWorkers(t *testing.T) {
+	q := queue.New(app.New(), queue.NewMemoryStorage())
+	q.PollInterval = time.Millisecond
+	const count = 20
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	running, maxRunning := 0, 0
+	q.Handle("slow", func(ctx *app.Context, job *queue.Job) error {
+		defer wg.Done()
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(2 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	}, &queue.Options{Concurrency: 2})
+	wg.Add(count)
+	for ii := 0; ii < count; ii++ {
+		if _, err := q.Enqueue("slow", ii); err != nil {
+			t.Fatal(err)
+		}
+	}
+	q.Start(4)
+	wg.Wait()
+	q.Stop()
+	if maxRunning != 2 {
+		t.Errorf("expecting 2 concurrent jobs, got %d", maxRunning)
+	}
+}
+
+func TestLock(t *testing.T) {
+	s := queue.NewMemoryStorage()
+	a := queue.New(app.New(), s)
+	b := queue.New(app.New(), s)
+	if ok, err := a.Lock("task", 10*time.Millisecond); !ok || err != nil {
+		t.Fatalf("a could not acquire the lock: %v, %v", ok, err)
+	}
+	if ok, _ := b.Lock("task", 10*time.Millisecond); ok {
+		t.Fatal("b acquired the lock held by a")
+	}
+	if ok, _ := a.Lock("task", 10*time.Millisecond); !ok {
+		t.Fatal("a could not renew the lock")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if ok, _ := b.Lock("task", 10*time.Millisecond); !ok {
+		t.Fatal("b could not acquire the expired lock")
+	}
+}
@@ -0,0 +1,306 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"gnd.la/cache"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// DefaultRedisPrefix is the prefix used for the keys
+// of the storages returned by NewCacheStorage.
+const DefaultRedisPrefix = "gondola:queue:"
+
+var (
+	errNoRedis = errors.New("the cache is not backed by redis")
+
+	// claimScript takes the first due job from the sorted set in KEYS[1]
+	// and moves it to the end of its lease, incrementing its version in
+	// the hash in KEYS[2]. It returns the job id and its new version.
+	claimScript = redis.NewScript(2, `
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, 1)
+if #ids == 0 then
+	return false
+end
+redis.call('ZADD', KEYS[1], ARGV[2], ids[1])
+return {ids[1], redis.call('HINCRBY', KEYS[2], ids[1], 1)}
+`)
+	// completeScript removes the job ARGV[1] from the sorted set in
+	// KEYS[1], its data in KEYS[2] and its version from the hash in
+	// KEYS[3], as long as its version is still ARGV[2].
+	completeScript = redis.NewScript(3, `
+if redis.call('HGET', KEYS[3], ARGV[1]) ~= ARGV[2] then
+	return 0
+end
+redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('DEL', KEYS[2])
+redis.call('HDEL', KEYS[3], ARGV[1])
+return 1
+`)
+	// retryScript stores ARGV[3] as the data of the job ARGV[1] in
+	// KEYS[2] and sets its score to ARGV[4] in the sorted set in
+	// KEYS[1], as long as its version in KEYS[3] is still ARGV[2].
+	retryScript = redis.NewScript(3, `
+if redis.call('HGET', KEYS[3], ARGV[1]) ~= ARGV[2] then
+	return 0
+end
+redis.call('SET', KEYS[2], ARGV[3])
+redis.call('ZADD', KEYS[1], ARGV[4], ARGV[1])
+return 1
+`)
+	// buryScript stores ARGV[3] as the data of the job ARGV[1] in
+	// KEYS[2] and moves it from the sorted set in KEYS[1] to the list
+	// in KEYS[4], as long as its version in KEYS[3] is still ARGV[2].
+	buryScript = redis.NewScript(4, `
+if redis.call('HGET', KEYS[3], ARGV[1]) ~= ARGV[2] then
+	return 0
+end
+redis.call('SET', KEYS[2], ARGV[3])
+redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('LPUSH', KEYS[4], ARGV[1])
+return 1
+`)
+	// lockScript sets the lock in KEYS[1] to ARGV[1] with a TTL of ARGV[2]
+	// milliseconds if it's not set or if it's already held by ARGV[1].
+	lockScript = redis.NewScript(1, `
+local owner = redis.call('GET', KEYS[1])
+if owner and owner ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`)
+)
+
+// redisStorage keeps a counter for assigning job ids in {prefix}id,
+// every job encoded as JSON in {prefix}job:{id}, the ids of the pending
+// jobs with a given name in the sorted set {prefix}jobs:{name} (scored
+// by the time in ms they might be claimed), the version of each claimed
+// job in the hash {prefix}versions, the ids of the dead jobs in the list
+// {prefix}dead and the owner of each lock in {prefix}lock:{name}.
+type redisStorage struct {
+	pool   *redis.Pool
+	prefix string
+}
+
+// NewRedisStorage returns a Storage which keeps the jobs in redis,
+// using the given connection pool. All the keys are prefixed with
+// the given prefix.
+func NewRedisStorage(pool *redis.Pool, prefix string) Storage {
+	return &redisStorage{pool: pool, prefix: prefix}
+}
+
+// NewCacheStorage returns a Storage which keeps the jobs in the redis
+// server used by the given cache, with the keys prefixed by
+// DefaultRedisPrefix. If the cache doesn't use the redis driver (see
+// gnd.la/cache/driver/redis), an error is returned.
+func NewCacheStorage(c *cache.Cache) (Storage, error) {
+	pool, ok := c.Connection().(*redis.Pool)
+	if !ok {
+		return nil, errNoRedis
+	}
+	return NewRedisStorage(pool, DefaultRedisPrefix), nil
+}
+
+func (s *redisStorage) jobKey(id string) string {
+	return s.prefix + "job:" + id
+}
+
+func (s *redisStorage) jobsKey(name string) string {
+	return s.prefix + "jobs:" + name
+}
+
+func (s *redisStorage) deadKey() string {
+	return s.prefix + "dead"
+}
+
+func (s *redisStorage) versionsKey() string {
+	return s.prefix + "versions"
+}
+
+func (s *redisStorage) load(conn redis.Conn, id string) (*Job, error) {
+	data, err := redis.Bytes(conn.Do("GET", s.jobKey(id)))
+	if err != nil {
+		if err == redis.ErrNil {
+			err = ErrJobNotFound
+		}
+		return nil, err
+	}
+	var job Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *redisStorage) store(conn redis.Conn, job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return conn.Send("SET", s.jobKey(job.Id), data)
+}
+
+func (s *redisStorage) Push(job *Job) error {
+	conn := s.pool.Get()
+	defer conn.Close()
+	id, err := redis.Int64(conn.Do("INCR", s.prefix+"id"))
+	if err != nil {
+		return err
+	}
+	job.Id = strconv.FormatInt(id, 10)
+	conn.Send("MULTI")
+	if err := s.store(conn, job); err != nil {
+		conn.Do("DISCARD")
+		return err
+	}
+	conn.Send("ZADD", s.jobsKey(job.Name), millis(job.RunAt), job.Id)
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+func (s *redisStorage) Claim(names []string, now time.Time, lease time.Duration) (*Job, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+	// Check the names in random order, so jobs with
+	// any name have the same chances of being run.
+	for _, ii := range rand.Perm(len(names)) {
+		claimed, err := redis.Values(claimScript.Do(conn, s.jobsKey(names[ii]), s.versionsKey(), millis(now), millis(now.Add(lease))))
+		if err != nil {
+			if err == redis.ErrNil {
+				continue
+			}
+			return nil, err
+		}
+		var id string
+		var version int64
+		if _, err := redis.Scan(claimed, &id, &version); err != nil {
+			return nil, err
+		}
+		job, err := s.load(conn, id)
+		if err != nil {
+			return nil, err
+		}
+		job.Attempts++
+		job.Version = version
+		if err := s.store(conn, job); err != nil {
+			return nil, err
+		}
+		if _, err := conn.Do(""); err != nil {
+			return nil, err
+		}
+		return job, nil
+	}
+	return nil, nil
+}
+
+// scriptClaimed returns ErrLeaseExpired if the given script reply
+// indicates that the job was not held by the claim anymore.
+func scriptClaimed(reply interface{}, err error) error {
+	ok, err := redis.Bool(reply, err)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrLeaseExpired
+	}
+	return nil
+}
+
+func (s *redisStorage) Complete(job *Job) error {
+	conn := s.pool.Get()
+	defer conn.Close()
+	return scriptClaimed(completeScript.Do(conn, s.jobsKey(job.Name), s.jobKey(job.Id), s.versionsKey(), job.Id, job.Version))
+}
+
+func (s *redisStorage) Retry(job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	conn := s.pool.Get()
+	defer conn.Close()
+	return scriptClaimed(retryScript.Do(conn, s.jobsKey(job.Name), s.jobKey(job.Id), s.versionsKey(), job.Id, job.Version, data, millis(job.RunAt)))
+}
+
+func (s *redisStorage) Bury(job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	conn := s.pool.Get()
+	defer conn.Close()
+	return scriptClaimed(buryScript.Do(conn, s.jobsKey(job.Name), s.jobKey(job.Id), s.versionsKey(), s.deadKey(), job.Id, job.Version, data))
+}
+
+func (s *redisStorage) Dead(limit int) ([]*Job, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+	stop := limit - 1
+	if limit <= 0 {
+		stop = -1
+	}
+	ids, err := redis.Values(conn.Do("LRANGE", s.deadKey(), 0, stop))
+	if err != nil {
+		return nil, err
+	}
+	var jobs []*Job
+	for _, v := range ids {
+		id, err := redis.String(v, nil)
+		if err != nil {
+			return nil, err
+		}
+		job, err := s.load(conn, id)
+		if err != nil {
+			if err == ErrJobNotFound {
+				continue
+			}
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (s *redisStorage) Requeue(id string, runAt time.Time) error {
+	conn := s.pool.Get()
+	defer conn.Close()
+	removed, err := redis.Int(conn.Do("LREM", s.deadKey(), 0, id))
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return ErrJobNotFound
+	}
+	job, err := s.load(conn, id)
+	if err != nil {
+		return err
+	}
+	job.Attempts = 0
+	job.RunAt = runAt
+	conn.Send("MULTI")
+	if err := s.store(conn, job); err != nil {
+		conn.Do("DISCARD")
+		return err
+	}
+	conn.Send("ZADD", s.jobsKey(job.Name), millis(job.RunAt), job.Id)
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+func (s *redisStorage) Lock(name string, owner string, ttl time.Duration) (bool, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+	return redis.Bool(lockScript.Do(conn, s.prefix+"lock:"+name, owner, int64(ttl/time.Millisecond)))
+}
+
+// millis returns the given time as milliseconds
+// since the Unix epoch.
+func millis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
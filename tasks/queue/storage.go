@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrJobNotFound is returned by Queue.Requeue and Storage.Requeue
+	// when there's no dead job with the given id.
+	ErrJobNotFound = errors.New("job not found")
+	// ErrLeaseExpired is returned by Storage.Complete, Storage.Retry
+	// and Storage.Bury when the job has been claimed again after its
+	// lease expired (or it's not stored anymore), so the Job passed
+	// to them doesn't hold it.
+	ErrLeaseExpired = errors.New("job lease expired")
+)
+
+// Job represents a job stored in a queue.
+type Job struct {
+	// Id is assigned by the Storage when the job is
+	// enqueued. Its format depends on the Storage.
+	Id string
+	// Name is used for selecting the Handler which
+	// runs the job.
+	Name string
+	// Payload is the JSON encoded payload passed
+	// to Queue.Enqueue. See also Decode.
+	Payload []byte
+	// Attempts is the number of times the job has
+	// been started, including the current one.
+	Attempts int
+	// RunAt is the earliest time the job will be run.
+	RunAt time.Time
+	// Created is the time the job was enqueued.
+	Created time.Time
+	// Error is the error returned by the last failed
+	// attempt, if any.
+	Error string
+	// Version is set by Storage.Claim and it's different for
+	// every claim of the job. It acts as a lease token: Complete,
+	// Retry and Bury only modify the job if it hasn't been claimed
+	// again since.
+	Version int64
+}
+
+// Decode decodes the job payload into out, which
+// must be a pointer.
+func (j *Job) Decode(out interface{}) error {
+	if len(j.Payload) == 0 {
+		return nil
+	}
+	return json.Unmarshal(j.Payload, out)
+}
+
+// Storage is the interface implemented by queue storage backends.
+// Storages must be safe for concurrent use by multiple goroutines
+// and, unless they're only intended for a single process, by
+// multiple instances of the app.
+type Storage interface {
+	// Push stores a new job, setting its Id.
+	Push(job *Job) error
+	// Claim reserves the job with the earliest RunAt among the due
+	// ones (RunAt <= now) with any of the given names, increments its
+	// Attempts and returns it. The reserved job won't be returned by
+	// Claim again until lease has elapsed, so it's run again if it's not
+	// completed, retried or buried before. If there are no due jobs, it
+	// must return nil with no error.
+	Claim(names []string, now time.Time, lease time.Duration) (*Job, error)
+	// Complete removes a claimed job.
+	Complete(job *Job) error
+	// Retry stores the RunAt and Error of a claimed job and
+	// releases it, so it can be claimed again after RunAt.
+	Retry(job *Job) error
+	// Bury stores the Error of a claimed job and moves it
+	// to the dead jobs, which are never claimed.
+	//
+	// Complete, Retry and Bury must return ErrLeaseExpired without
+	// modifying the job if its Version doesn't match the one
+	// returned by its last claim.
+	Bury(job *Job) error
+	// Dead returns up to limit dead jobs, most recently
+	// buried first. A limit <= 0 returns all of them.
+	Dead(limit int) ([]*Job, error)
+	// Requeue moves the dead job with the given id back to the
+	// queue, resetting its Attempts and setting its RunAt. If
+	// there's no such dead job, it returns ErrJobNotFound.
+	Requeue(id string, runAt time.Time) error
+	// Lock acquires the lock with the given name for owner, or renews
+	// it if owner already holds it, for the given time. It returns
+	// true iff the lock is held by owner.
+	Lock(name string, owner string, ttl time.Duration) (bool, error)
+}
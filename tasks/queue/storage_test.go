@@ -0,0 +1,336 @@
+package queue_test
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"gnd.la/config"
+	"gnd.la/orm"
+	_ "gnd.la/orm/driver/sqlite"
+	"gnd.la/tasks/queue"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+const testLease = time.Minute
+
+func push(t *testing.T, s queue.Storage, name string, runAt time.Time) *queue.Job {
+	job := &queue.Job{
+		Name:    name,
+		Payload: []byte(`"` + name + `"`),
+		RunAt:   runAt,
+		Created: time.Now(),
+	}
+	if err := s.Push(job); err != nil {
+		t.Fatal(err)
+	}
+	if job.Id == "" {
+		t.Fatal("Push did not set the job id")
+	}
+	return job
+}
+
+func claim(t *testing.T, s queue.Storage, name string, now time.Time) *queue.Job {
+	job, err := s.Claim([]string{name}, now, testLease)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return job
+}
+
+func expectJob(t *testing.T, job *queue.Job, id string, attempts int) {
+	if job == nil {
+		t.Fatalf("expecting job %s, got none", id)
+	}
+	if job.Id != id || job.Attempts != attempts {
+		t.Fatalf("expecting job %s with %d attempts, got job %s with %d attempts", id, attempts, job.Id, job.Attempts)
+	}
+}
+
+func expectNoJob(t *testing.T, job *queue.Job) {
+	if job != nil {
+		t.Fatalf("expecting no job, got %+v", job)
+	}
+}
+
+func testStorageClaim(t *testing.T, s queue.Storage) {
+	now := time.Now()
+	a1 := push(t, s, "a", now.Add(-time.Second))
+	a2 := push(t, s, "a", now.Add(-2*time.Second))
+	push(t, s, "a", now.Add(time.Hour))
+	expectNoJob(t, claim(t, s, "missing", now))
+	first := claim(t, s, "a", now)
+	expectJob(t, first, a2.Id, 1)
+	if first.Name != "a" || string(first.Payload) != `"a"` {
+		t.Errorf("bad claimed job %+v", first)
+	}
+	second := claim(t, s, "a", now)
+	expectJob(t, second, a1.Id, 1)
+	// Both due jobs are leased and the other one is not due yet
+	expectNoJob(t, claim(t, s, "a", now))
+	// Leases expired, jobs are claimed again
+	later := now.Add(testLease + time.Second)
+	again := claim(t, s, "a", later)
+	if again == nil || (again.Id != a1.Id && again.Id != a2.Id) || again.Attempts != 2 {
+		t.Fatalf("expecting job %s or %s with 2 attempts, got %+v", a1.Id, a2.Id, again)
+	}
+	// Only the latest claim of a job can complete it
+	stale, current := first, second
+	if again.Id == second.Id {
+		stale, current = second, first
+	}
+	if err := s.Complete(stale); err != queue.ErrLeaseExpired {
+		t.Errorf("expecting ErrLeaseExpired when completing a job claimed again, got %v", err)
+	}
+	if err := s.Complete(current); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Complete(again); err != nil {
+		t.Fatal(err)
+	}
+	expectNoJob(t, claim(t, s, "a", later.Add(testLease+time.Second)))
+}
+
+func testStorageRetry(t *testing.T, s queue.Storage) {
+	now := time.Now()
+	r := push(t, s, "r", now.Add(-time.Second))
+	job := claim(t, s, "r", now)
+	expectJob(t, job, r.Id, 1)
+	job.Error = "failed"
+	job.RunAt = now.Add(10 * time.Second)
+	if err := s.Retry(job); err != nil {
+		t.Fatal(err)
+	}
+	expectNoJob(t, claim(t, s, "r", now.Add(5*time.Second)))
+	job = claim(t, s, "r", now.Add(11*time.Second))
+	expectJob(t, job, r.Id, 2)
+	if job.Error != "failed" {
+		t.Errorf("expecting error failed, got %q", job.Error)
+	}
+	job.Error = "dead"
+	if err := s.Bury(job); err != nil {
+		t.Fatal(err)
+	}
+	expectNoJob(t, claim(t, s, "r", now.Add(time.Hour)))
+	dead, err := s.Dead(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dead) != 1 || dead[0].Id != r.Id || dead[0].Error != "dead" {
+		t.Fatalf("expecting dead job %s, got %v", r.Id, dead)
+	}
+	if err := s.Requeue(r.Id, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Requeue(r.Id, now); err != queue.ErrJobNotFound {
+		t.Errorf("expecting ErrJobNotFound when requeueing twice, got %v", err)
+	}
+	if err := s.Requeue("12345", now); err != queue.ErrJobNotFound {
+		t.Errorf("expecting ErrJobNotFound when requeueing an unknown job, got %v", err)
+	}
+	if dead, _ := s.Dead(0); len(dead) != 0 {
+		t.Errorf("expecting no dead jobs after requeueing, got %v", dead)
+	}
+	expectJob(t, claim(t, s, "r", now.Add(time.Second)), r.Id, 1)
+}
+
+// testStorageLeaseFencing checks that a worker whose lease expired
+// can't complete, retry or bury a job claimed by another one.
+func testStorageLeaseFencing(t *testing.T, s queue.Storage) {
+	now := time.Now()
+	f := push(t, s, "f", now.Add(-time.Second))
+	stale := claim(t, s, "f", now)
+	expectJob(t, stale, f.Id, 1)
+	later := now.Add(testLease + time.Second)
+	current := claim(t, s, "f", later)
+	expectJob(t, current, f.Id, 2)
+	if stale.Version == current.Version {
+		t.Fatalf("both claims returned version %d", current.Version)
+	}
+	if err := s.Complete(stale); err != queue.ErrLeaseExpired {
+		t.Errorf("expecting ErrLeaseExpired from Complete, got %v", err)
+	}
+	stale.Error = "stale"
+	stale.RunAt = now
+	if err := s.Retry(stale); err != queue.ErrLeaseExpired {
+		t.Errorf("expecting ErrLeaseExpired from Retry, got %v", err)
+	}
+	if err := s.Bury(stale); err != queue.ErrLeaseExpired {
+		t.Errorf("expecting ErrLeaseExpired from Bury, got %v", err)
+	}
+	if dead, _ := s.Dead(0); len(dead) != 0 {
+		t.Errorf("expecting no dead jobs, got %v", dead)
+	}
+	// The job is still leased by the current claim
+	expectNoJob(t, claim(t, s, "f", later))
+	current.Error = "failed"
+	current.RunAt = later.Add(time.Second)
+	if err := s.Retry(current); err != nil {
+		t.Fatal(err)
+	}
+	// Releasing the job doesn't make older claims valid
+	if err := s.Complete(stale); err != queue.ErrLeaseExpired {
+		t.Errorf("expecting ErrLeaseExpired from Complete after Retry, got %v", err)
+	}
+	job := claim(t, s, "f", later.Add(2*time.Second))
+	expectJob(t, job, f.Id, 3)
+	if job.Error != "failed" {
+		t.Errorf("expecting error failed, got %q", job.Error)
+	}
+	if err := s.Complete(job); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Complete(job); err != queue.ErrLeaseExpired {
+		t.Errorf("expecting ErrLeaseExpired when completing twice, got %v", err)
+	}
+	expectNoJob(t, claim(t, s, "f", later.Add(time.Hour)))
+}
+
+// testStorageConcurrentClaim checks that concurrent workers
+// never claim the same job.
+func testStorageConcurrentClaim(t *testing.T, s queue.Storage) {
+	const count = 20
+	now := time.Now()
+	for ii := 0; ii < count; ii++ {
+		push(t, s, "c", now.Add(-time.Second))
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	claimed := make(map[string]int)
+	for ii := 0; ii < 4; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				job, err := s.Claim([]string{"c"}, now, testLease)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if job == nil {
+					return
+				}
+				mu.Lock()
+				claimed[job.Id]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(claimed) != count {
+		t.Errorf("expecting %d claimed jobs, got %d", count, len(claimed))
+	}
+	for k, v := range claimed {
+		if v != 1 {
+			t.Errorf("job %s was claimed %d times", k, v)
+		}
+	}
+}
+
+func testStorageLock(t *testing.T, s queue.Storage) {
+	const ttl = 200 * time.Millisecond
+	if ok, err := s.Lock("task", "a", ttl); !ok || err != nil {
+		t.Fatalf("a could not acquire the lock: %v, %v", ok, err)
+	}
+	if ok, err := s.Lock("task", "b", ttl); ok || err != nil {
+		t.Fatalf("b acquired the lock held by a: %v, %v", ok, err)
+	}
+	if ok, err := s.Lock("task", "a", ttl); !ok || err != nil {
+		t.Fatalf("a could not renew the lock: %v, %v", ok, err)
+	}
+	if ok, err := s.Lock("other", "b", ttl); !ok || err != nil {
+		t.Fatalf("b could not acquire another lock: %v, %v", ok, err)
+	}
+	time.Sleep(2 * ttl)
+	if ok, err := s.Lock("task", "b", ttl); !ok || err != nil {
+		t.Fatalf("b could not acquire the expired lock: %v, %v", ok, err)
+	}
+	if ok, err := s.Lock("task", "a", ttl); ok || err != nil {
+		t.Fatalf("a acquired the lock held by b: %v, %v", ok, err)
+	}
+}
+
+var storageTests = []func(*testing.T, queue.Storage){
+	testStorageClaim,
+	testStorageRetry,
+	testStorageLeaseFencing,
+	testStorageConcurrentClaim,
+	testStorageLock,
+}
+
+// testStorage runs all the storage tests, each one of them with
+// a new Storage returned by open. The returned function is called
+// after each test to clean up the Storage.
+func testStorage(t *testing.T, open func() (queue.Storage, func())) {
+	for _, v := range storageTests {
+		s, done := open()
+		v(t, s)
+		done()
+	}
+}
+
+func TestMemoryStorage(t *testing.T) {
+	testStorage(t, func() (queue.Storage, func()) {
+		return queue.NewMemoryStorage(), func() {}
+	})
+}
+
+func TestOrmStorage(t *testing.T) {
+	f, err := ioutil.TempFile("", "queue-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	o, err := orm.New(config.MustParseURL("sqlite://" + f.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer o.Close()
+	o.SqlDB().Exec("PRAGMA journal_mode = WAL")
+	o.SqlDB().Exec("PRAGMA busy_timeout = 5000")
+	// Models can only be registered once per process, so all
+	// the tests share the same ORM and the tables are emptied
+	// after each one of them.
+	if err := o.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	testStorage(t, func() (queue.Storage, func()) {
+		return queue.NewOrmStorage(o), func() {
+			for _, v := range []string{"gondola_queue_jobs", "gondola_queue_locks"} {
+				if _, err := o.SqlDB().Exec("DELETE FROM " + v); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func TestRedisStorage(t *testing.T) {
+	conn, err := net.Dial("tcp", "127.0.0.1:6379")
+	if err != nil {
+		t.Skip("redis is not running. start redis on localhost to run this test")
+	}
+	conn.Close()
+	testStorage(t, func() (queue.Storage, func()) {
+		pool := &redis.Pool{
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", "127.0.0.1:6379")
+			},
+		}
+		prefix := "gondola:test:queue:" + strconv.FormatInt(time.Now().UnixNano(), 10) + ":"
+		return queue.NewRedisStorage(pool, prefix), func() {
+			c := pool.Get()
+			if keys, err := redis.Values(c.Do("KEYS", prefix+"*")); err == nil && len(keys) > 0 {
+				c.Do("DEL", keys...)
+			}
+			c.Close()
+			pool.Close()
+		}
+	})
+}
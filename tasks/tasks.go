@@ -6,7 +6,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math/rand"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
@@ -35,10 +37,14 @@ type Task struct {
 	App      *app.App
 	Handler  app.Handler
 	Interval time.Duration
-	Options  *Options
-	ticker   *time.Ticker
-	stop     chan struct{}
-	stopped  chan struct{}
+	// Cron is used instead of Interval for
+	// tasks scheduled with ScheduleCron.
+	Cron    *Cron
+	Options *Options
+	mu      sync.Mutex
+	next    time.Time
+	stop    chan struct{}
+	stopped chan struct{}
 }
 
 // Stop de-schedules the task. After stopping the task, it
@@ -53,14 +59,64 @@ func (t *Task) Stop() {
 	}
 }
 
+// Resume schedules the task again after stopping it with Stop. If now
+// is true, the task is also run immediately. Tasks which have neither
+// an Interval nor a Cron are not scheduled.
 func (t *Task) Resume(now bool) {
 	t.Stop()
-	t.ticker = time.NewTicker(t.Interval)
+	if t.Interval <= 0 && t.Cron == nil {
+		return
+	}
 	t.stop = make(chan struct{}, 1)
 	t.stopped = make(chan struct{}, 1)
+	t.setNext(t.nextRun(time.Now()))
 	go t.execute(now)
 }
 
+// Scheduled returns true iff the task is currently scheduled
+// (i.e. it has an Interval or a Cron and it's not stopped).
+func (t *Task) Scheduled() bool {
+	return !t.NextRun().IsZero()
+}
+
+// NextRun returns the time of the next scheduled run of the task,
+// or the zero time if the task is not scheduled.
+func (t *Task) NextRun() time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.next
+}
+
+// Running returns the number of instances of the
+// task currently running in this process.
+func (t *Task) Running() int {
+	running.Lock()
+	defer running.Unlock()
+	return running.tasks[t]
+}
+
+func (t *Task) setNext(next time.Time) {
+	t.mu.Lock()
+	t.next = next
+	t.mu.Unlock()
+}
+
+// nextRun returns the time of the first scheduled run after
+// now, including the jitter, or the zero time if the task
+// won't run again.
+func (t *Task) nextRun(now time.Time) time.Time {
+	var next time.Time
+	if t.Cron != nil {
+		next = t.Cron.Next(now)
+	} else {
+		next = now.Add(t.Interval)
+	}
+	if !next.IsZero() && t.Options != nil && t.Options.Jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(t.Options.Jitter))))
+	}
+	return next
+}
+
 // Name returns the task name.
 func (t *Task) Name() string {
 	if t.Options != nil && t.Options.Name != "" {
@@ -82,20 +138,36 @@ func (t *Task) deleteLocked() {
 	delete(registered.tasks, t.Name())
 }
 
+func (t *Task) isRegistered() bool {
+	registered.RLock()
+	defer registered.RUnlock()
+	return registered.tasks[t.Name()] == t
+}
+
 func (t *Task) execute(now bool) {
 	if now {
 		t.executeTask()
 	}
 	for {
-		c := t.ticker.C
+		// A nil channel blocks forever, used when
+		// there are no more runs scheduled.
+		var c <-chan time.Time
+		var timer *time.Timer
+		if next := t.NextRun(); !next.IsZero() {
+			timer = time.NewTimer(next.Sub(time.Now()))
+			c = timer.C
+		}
 		select {
 		case <-c:
+			t.setNext(t.nextRun(time.Now()))
 			go t.executeTask()
 		case <-t.stop:
+			if timer != nil {
+				timer.Stop()
+			}
+			t.setNext(time.Time{})
 			close(t.stop)
 			t.stop = nil
-			t.ticker.Stop()
-			t.ticker = nil
 			t.stopped <- struct{}{}
 			return
 		}
@@ -113,6 +185,61 @@ type Options struct {
 	// this function that can be simultaneously running. If zero,
 	// there is no limit.
 	MaxInstances int
+	// SkipIfRunning indicates that scheduled runs of the task should
+	// be skipped while another instance of it is running in the same
+	// process. As opposed to MaxInstances, it doesn't affect the
+	// executions started with Run or RunHandler.
+	SkipIfRunning bool
+	// Jitter is the maximum random delay added to every scheduled
+	// run of the task, which helps avoiding many tasks (or many
+	// instances of the app) starting at the same time.
+	Jitter time.Duration
+	// Locker, if non-nil, is used for running the scheduled executions
+	// of the task in just one instance of the app at a time. Before every
+	// scheduled run, the instance tries to acquire a lock named after the
+	// task and skips the run if another instance holds it. The lock is
+	// held for twice the task interval (or a minute for tasks scheduled
+	// with a Cron) plus the Jitter, so the instance which acquires it
+	// keeps running the task until it stops renewing it and, since every
+	// instance runs a given occurrence within Jitter, it's held until
+	// the other instances have skipped it. Executions started
+	// with Run or RunHandler don't use the lock. See gnd.la/tasks/queue
+	// for an implementation shared by all the instances of an app.
+	Locker Locker
+}
+
+// Locker is the interface implemented by types which provide named
+// locks shared by several instances of an app. See Options.Locker.
+type Locker interface {
+	// Lock acquires the lock with the given name for the current
+	// instance, or renews it if the instance already holds it. The
+	// lock is released after ttl unless it's renewed. It returns
+	// true iff the lock is held by the current instance.
+	Lock(name string, ttl time.Duration) (bool, error)
+}
+
+// defaultLockTTL is the time a lock is held, in addition to
+// the jitter, by tasks with a Locker but no interval (e.g. tasks
+// scheduled with a Cron or which only run when the app starts
+// listening). It's the margin for the differences between the
+// clocks and timers of the instances.
+const defaultLockTTL = time.Minute
+
+// isLeader returns true iff the current instance should
+// run the scheduled executions of the task.
+func (t *Task) isLeader() (bool, error) {
+	if t.Options == nil || t.Options.Locker == nil {
+		return true, nil
+	}
+	ttl := 2 * t.Interval
+	if ttl <= 0 {
+		ttl = defaultLockTTL
+	}
+	// Each instance adds its own random delay to every run, the
+	// lock must outlive the latest one or another instance could
+	// acquire it and run the same occurrence again.
+	ttl += t.Options.Jitter
+	return t.Options.Locker.Lock("gnd.la/tasks/"+t.Name(), ttl)
 }
 
 func afterTask(ctx *app.Context, task *Task, started time.Time, terr *error) {
@@ -139,6 +266,7 @@ func afterTask(ctx *app.Context, task *Task, started time.Time, terr *error) {
 		*terr = errors.New(buf.String())
 	}
 	end := time.Now()
+	addResult(ctx, task, started, end, *terr)
 	running.Lock()
 	defer running.Unlock()
 	c := running.tasks[task] - 1
@@ -150,10 +278,17 @@ func afterTask(ctx *app.Context, task *Task, started time.Time, terr *error) {
 	ctx.Logger().Infof("Finished task %s (%d instances now running) at %v (took %v)", name, c, end, end.Sub(started))
 }
 
-func numberOfInstances(task *Task) (int, error) {
+// numberOfInstances increments the number of running instances
+// of the task and returns it. If the task can't be started, it
+// returns an error or, for scheduled runs which must be skipped
+// because of SkipIfRunning, zero with no error.
+func numberOfInstances(task *Task, scheduled bool) (int, error) {
 	running.Lock()
 	defer running.Unlock()
 	c := running.tasks[task]
+	if scheduled && c > 0 && task.Options != nil && task.Options.SkipIfRunning {
+		return 0, nil
+	}
 	if task.Options != nil && task.Options.MaxInstances > 0 {
 		if c >= task.Options.MaxInstances {
 			return 0, fmt.Errorf("not starting task %s because it's already running %d instances", task.Name(), c)
@@ -167,14 +302,40 @@ func numberOfInstances(task *Task) (int, error) {
 	return c, nil
 }
 
-func executeTask(ctx *app.Context, task *Task) (ran bool, err error) {
+func executeTask(ctx *app.Context, task *Task) (bool, error) {
+	return runTask(ctx, task, false)
+}
+
+// executeScheduled runs a scheduled execution of the task, unless
+// another instance of the app holds its lock (see Options.Locker)
+// or it's skipped because of Options.SkipIfRunning.
+func executeScheduled(ctx *app.Context, task *Task) (bool, error) {
+	if leader, err := task.isLeader(); !leader {
+		if err != nil {
+			return false, fmt.Errorf("error acquiring lock for task %s: %s", task.Name(), err)
+		}
+		return false, nil
+	}
+	return runTask(ctx, task, true)
+}
+
+func runTask(ctx *app.Context, task *Task, scheduled bool) (ran bool, err error) {
 	var n int
-	if n, err = numberOfInstances(task); err != nil {
+	if n, err = numberOfInstances(task, scheduled); err != nil {
+		return
+	}
+	if n == 0 {
+		ctx.Logger().Infof("Skipping task %s because it's already running", task.Name())
 		return
 	}
 	started := time.Now()
 	ctx.Logger().Infof("Starting task %s (%d instances now running) at %v", task.Name(), n, started)
 	ran = true
+	span := ctx.StartSpan("task").Set("task", task.Name())
+	defer func() {
+		span.SetError(err).End()
+		recordTaskMetrics(task, started, err)
+	}()
 	defer afterTask(ctx, task, started, &err)
 	task.Handler(ctx)
 	return
@@ -220,6 +381,38 @@ func Schedule(m *app.App, task app.Handler, opts *Options, interval time.Duratio
 	return t
 }
 
+// ScheduleCron registers and schedules a task to be run at the times
+// matching the given cron expression (see ParseCron). Use MustParseCron
+// for declaring the expression inline:
+//
+//  tasks.ScheduleCron(App, sendReports, nil, tasks.MustParseCron("TZ=Europe/Madrid 0 9 * * mon-fri"))
+//
+// ScheduleCron returns a Task instance, which might be used to stop, resume or delete it.
+func ScheduleCron(m *app.App, task app.Handler, opts *Options, cron *Cron) *Task {
+	t := Register(m, task, opts)
+	t.Cron = cron
+	go t.Resume(false)
+	return t
+}
+
+// Tasks returns all the registered tasks, sorted by name.
+func Tasks() []*Task {
+	registered.RLock()
+	tasks := make([]*Task, 0, len(registered.tasks))
+	for _, v := range registered.tasks {
+		tasks = append(tasks, v)
+	}
+	registered.RUnlock()
+	sort.Sort(byName(tasks))
+	return tasks
+}
+
+type byName []*Task
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Less(i, j int) bool { return b[i].Name() < b[j].Name() }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
 // Run starts the given task identifier by it's name, unless
 // it has been previously registered with Options which
 // prevent from running it right now (e.g. it was registered
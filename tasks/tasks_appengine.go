@@ -29,7 +29,7 @@ func gondolaRunTasksHandler(ctx *app.Context) {
 	for _, v := range pendingTasks.tasks {
 		task := v
 		ctx.Go(func(c *app.Context) {
-			if _, err := executeTask(c, task); err != nil {
+			if _, err := executeScheduled(c, task); err != nil {
 				ctx.Logger().Error(err)
 			}
 		})
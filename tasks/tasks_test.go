@@ -0,0 +1,166 @@
+package tasks_test
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	_ "gnd.la/orm/driver/sqlite"
+	"gnd.la/tasks"
+)
+
+func TestCron(t *testing.T) {
+	from := time.Date(2015, time.March, 27, 10, 30, 15, 0, time.UTC) // Friday
+	cases := []struct {
+		expr string
+		next string
+	}{
+		{"* * * * *", "2015-03-27 10:31"},
+		{"*/15 * * * *", "2015-03-27 10:45"},
+		{"0 3 * * *", "2015-03-28 03:00"},
+		{"@hourly", "2015-03-27 11:00"},
+		{"@daily", "2015-03-28 00:00"},
+		{"0 9 * * mon-fri", "2015-03-30 09:00"},
+		{"30 10 * * 5", "2015-04-03 10:30"},
+		{"0 0 * * 7", "2015-03-29 00:00"},
+		{"0 0 1 jan *", "2016-01-01 00:00"},
+		{"0 12 1,15 * *", "2015-04-01 12:00"},
+		// Both restricted, any of them matches
+		{"0 0 13 * fri", "2015-04-03 00:00"},
+		{"5/20 10 * * *", "2015-03-27 10:45"},
+		{"TZ=America/New_York 0 7 * * *", "2015-03-27 11:00"},
+	}
+	for _, v := range cases {
+		c, err := tasks.ParseCron(v.expr)
+		if err != nil {
+			t.Errorf("error parsing %q: %s", v.expr, err)
+			continue
+		}
+		next := c.Next(from).UTC().Format("2006-01-02 15:04")
+		if next != v.next {
+			t.Errorf("expecting next time %s for %q, got %s", v.next, v.expr, next)
+		}
+	}
+	if next := tasks.MustParseCron("0 0 30 feb *").Next(from); !next.IsZero() {
+		t.Errorf("expecting no next time for Feb 30th, got %v", next)
+	}
+}
+
+func TestCronErrors(t *testing.T) {
+	for _, v := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * foo *", "*/0 * * * *", "5-1 * * * *", "TZ=Nowhere/Nope * * * * *"} {
+		if _, err := tasks.ParseCron(v); err == nil {
+			t.Errorf("expecting an error parsing %q", v)
+		}
+	}
+}
+
+func TestHistory(t *testing.T) {
+	a := app.New()
+	fail := false
+	task := tasks.Register(a, func(ctx *app.Context) {
+		if fail {
+			panic("failed")
+		}
+	}, &tasks.Options{Name: "history-test"})
+	defer task.Delete()
+	ctx := a.NewContext(nil)
+	defer a.CloseContext(ctx)
+	for _, v := range []bool{false, true} {
+		fail = v
+		tasks.Run(ctx, task.Name())
+	}
+	results, err := task.Results(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expecting 2 results, got %d", len(results))
+	}
+	if !strings.Contains(results[0].Error, "failed") {
+		t.Errorf("expecting error in most recent result, got %q", results[0].Error)
+	}
+	if results[1].Error != "" || results[1].Ended.Before(results[1].Started) {
+		t.Errorf("invalid first result %+v", results[1])
+	}
+	if task.Scheduled() || !task.NextRun().IsZero() {
+		t.Error("registered task is scheduled")
+	}
+}
+
+func TestOrmHistory(t *testing.T) {
+	f, err := ioutil.TempFile("", "tasks-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	a := app.New()
+	a.Config().Database = config.MustParseURL("sqlite://" + f.Name())
+	const size = 3
+	h := tasks.NewOrmHistory(a, size)
+	// Prepare creates the table for the results
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	started := time.Now().Truncate(time.Second)
+	for ii := 0; ii < 2*size; ii++ {
+		for _, v := range []string{"a", "b"} {
+			r := &tasks.Result{Task: v, Started: started.Add(time.Duration(ii) * time.Second)}
+			if err := h.Add(r); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, v := range []string{"a", "b"} {
+		results, err := h.Results(v, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != size {
+			t.Fatalf("expecting %d results for %s, got %d", size, v, len(results))
+		}
+		// Only the most recent ones are kept
+		for ii, r := range results {
+			if exp := started.Add(time.Duration(2*size-ii-1) * time.Second); !r.Started.Equal(exp) {
+				t.Errorf("expecting result %d for %s started at %v, got %v", ii, v, exp, r.Started)
+			}
+		}
+	}
+}
+
+type testLocker chan time.Duration
+
+func (l testLocker) Lock(name string, ttl time.Duration) (bool, error) {
+	select {
+	case l <- ttl:
+	default:
+	}
+	return true, nil
+}
+
+func TestLockTTL(t *testing.T) {
+	const (
+		interval = 10 * time.Millisecond
+		jitter   = time.Minute
+	)
+	l := make(testLocker, 1)
+	a := app.New()
+	task := tasks.Schedule(a, func(ctx *app.Context) {}, &tasks.Options{Name: "lock-test", Jitter: jitter, Locker: l}, interval, false)
+	defer task.Delete()
+	// Run a scheduled execution right away, without waiting for the jitter
+	task.Resume(true)
+	select {
+	case ttl := <-l:
+		// The lock must be held until every instance has run
+		// the same occurrence, including their jitter.
+		if exp := 2*interval + jitter; ttl != exp {
+			t.Errorf("expecting lock TTL %v, got %v", exp, ttl)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lock was not acquired")
+	}
+}
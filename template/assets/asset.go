@@ -3,7 +3,6 @@ package assets
 import (
 	"fmt"
 	"io/ioutil"
-	"strings"
 )
 
 type Position int
@@ -80,8 +79,7 @@ func (a *Asset) String() string {
 }
 
 func (a *Asset) IsRemote() bool {
-	name := strings.ToLower(a.Name)
-	return strings.HasPrefix(name, "//") || strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://")
+	return isRemote(a.Name)
 }
 
 func (a *Asset) IsHTML() bool {
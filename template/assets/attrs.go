@@ -2,6 +2,7 @@ package assets
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -12,5 +13,6 @@ func (a Attributes) String() string {
 	for k, v := range map[string]string(a) {
 		attrs = append(attrs, fmt.Sprintf("%s=\"%s\"", k, strings.Replace(v, "\"", "\\\"", -1)))
 	}
+	sort.Strings(attrs)
 	return strings.Join(attrs, " ")
 }
@@ -3,6 +3,7 @@ package assets
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"hash/fnv"
@@ -70,13 +71,18 @@ func Bundle(groups []*Group, opts Options) (*Asset, error) {
 	}
 	// The bundle is output to the first manager
 	m := groups[0].Manager
+	sourceMap := name + ".map"
 	// Check if the code has been already bundled
 	if m.Has(name) {
 		log.Debugf("%s already bundled into %s and up to date", names, name)
+		if !m.Has(sourceMap) {
+			sourceMap = ""
+		}
 	} else {
 		dir := path.Dir(name)
 		log.Debugf("bundling %v", names)
 		var code []string
+		var sources []string
 		for _, group := range groups {
 			for _, v := range group.Assets {
 				c, err := v.Code(group.Manager)
@@ -91,7 +97,11 @@ func Bundle(groups []*Group, opts Options) (*Asset, error) {
 						log.Warningf("asset %q will move from %v to %v, relative paths might not work", v.Name, vd, dir)
 					}
 				}
+				// Do this before bundling, so the source map
+				// mappings are not altered by it.
+				c = makeLinksCacheable(m, dir, c)
 				code = append(code, c)
+				sources = append(sources, sourceURL(group.Manager, v.Name))
 			}
 		}
 		// Bundle to a buf first. We don't want to create
@@ -99,21 +109,43 @@ func Bundle(groups []*Group, opts Options) (*Asset, error) {
 		var buf bytes.Buffer
 		allCode := strings.Join(code, "\n\n")
 		reader := strings.NewReader(allCode)
-		if err := bundler.Bundle(&buf, reader, opts); err != nil {
+		var mappings []offsetMapping
+		if mb, ok := bundler.(mappingBundler); ok && !opts.BoolOpt("nosourcemap") {
+			mappings, err = mb.bundleMappings(&buf, reader, opts)
+		} else {
+			err = bundler.Bundle(&buf, reader, opts)
+		}
+		if err != nil {
 			return nil, err
 		}
-		s := makeLinksCacheable(m, dir, buf.Bytes())
 		initial := len(allCode)
-		final := len(s)
+		final := buf.Len()
 		var percent float64
 		if initial != 0 {
 			percent = float64(final) / float64(initial) * 100
 		}
 		log.Debugf("reduced size from %s to %s (%.2f%%)", formatutil.Size(uint64(initial)),
 			formatutil.Size(uint64(final)), percent)
+		if len(mappings) > 0 {
+			offsets := make([]int, len(code))
+			for ii := 1; ii < len(code); ii++ {
+				offsets[ii] = offsets[ii-1] + len(code[ii-1]) + 2
+			}
+			sm := newSourceMap(path.Base(name), buf.Bytes(), []byte(allCode), mappings, sources, offsets)
+			if err := writeSourceMap(m, sourceMap, sm); err != nil {
+				return nil, err
+			}
+			if assetType == TypeCSS {
+				fmt.Fprintf(&buf, "\n/*# sourceMappingURL=%s */\n", path.Base(sourceMap))
+			} else {
+				fmt.Fprintf(&buf, "\n//# sourceMappingURL=%s\n", path.Base(sourceMap))
+			}
+		} else {
+			sourceMap = ""
+		}
 		w, err := m.Create(name, true)
 		if err == nil {
-			if _, err := io.Copy(w, strings.NewReader(s)); err != nil {
+			if _, err := io.Copy(w, &buf); err != nil {
 				w.Close()
 				return nil, err
 			}
@@ -127,6 +159,9 @@ func Bundle(groups []*Group, opts Options) (*Asset, error) {
 			}
 		}
 	}
+	if err := m.addToManifest(name, assetType, names, sourceMap); err != nil {
+		log.Debugf("error adding %s to the assets manifest: %s", name, err)
+	}
 	return &Asset{
 		Name:     name,
 		Type:     assetType,
@@ -134,9 +169,8 @@ func Bundle(groups []*Group, opts Options) (*Asset, error) {
 	}, nil
 }
 
-func makeLinksCacheable(m *Manager, dir string, b []byte) string {
-	css := string(b)
-	return replaceCssUrls(css, func(s string) string {
+func makeLinksCacheable(m *Manager, dir string, code string) string {
+	return replaceCssUrls(code, func(s string) string {
 		var suffix string
 		if sep := strings.IndexAny(s, "?#"); sep >= 0 {
 			suffix = s[sep:]
@@ -152,6 +186,32 @@ func makeLinksCacheable(m *Manager, dir string, b []byte) string {
 	})
 }
 
+// sourceURL returns the URL for the given asset name,
+// without the version, to be used in source maps.
+func sourceURL(m *Manager, name string) string {
+	prefix := m.Prefix()
+	if prefix == "" {
+		prefix = "/"
+	}
+	return prefix + strings.TrimPrefix(name, "/")
+}
+
+func writeSourceMap(m *Manager, name string, sm *SourceMap) error {
+	data, err := json.Marshal(sm)
+	if err != nil {
+		return err
+	}
+	w, err := m.Create(name, true)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
 func replaceRelativePaths(code string, dir string, final string) string {
 	count := strings.Count(final, "/") + 1
 	return replaceCssUrls(code, func(s string) string {
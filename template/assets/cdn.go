@@ -13,6 +13,11 @@ type CdnInfo struct {
 	Pattern  *regexp.Regexp
 	Repl     string
 	Fallback string
+	// Integrity maps the URLs generated by this CdnInfo to their
+	// Subresource Integrity hashes (see Integrity). Assets loaded
+	// from a URL with a pinned hash are rendered with an integrity
+	// attribute.
+	Integrity map[string]string
 }
 
 var CdnInfos = []*CdnInfo{
@@ -36,6 +41,7 @@ func CdnAssets(m *Manager, asset *Asset) ([]*Asset, error) {
 	}
 	acdn := *asset
 	acdn.Name = name
+	acdn.Attributes = cdnAttributes(asset.Attributes, name)
 	assets := []*Asset{&acdn}
 	if err := appendScriptFallback(m, asset, &assets, fallback); err != nil {
 		return nil, err
@@ -55,6 +61,29 @@ func Cdn(name string) (string, string, error) {
 	return "", "", fmt.Errorf("could not find CDN URL for %q", name)
 }
 
+// CdnIntegrity returns the pinned integrity hash for the given
+// CDN URL, or an empty string if there's none.
+func CdnIntegrity(url string) string {
+	for _, v := range CdnInfos {
+		if h := v.Integrity[url]; h != "" {
+			return h
+		}
+	}
+	return ""
+}
+
+func cdnAttributes(attrs Attributes, url string) Attributes {
+	integrity := CdnIntegrity(url)
+	if integrity == "" {
+		return attrs
+	}
+	cdnAttrs := Attributes{"integrity": integrity, "crossorigin": "anonymous"}
+	for k, v := range attrs {
+		cdnAttrs[k] = v
+	}
+	return cdnAttrs
+}
+
 func cdnScriptParser(k, orig string) SingleAssetParser {
 	return func(m *Manager, name string, options Options) ([]*Asset, error) {
 		asset := orig + name
@@ -71,6 +100,7 @@ func cdnScriptParser(k, orig string) SingleAssetParser {
 		if options.Async() {
 			script.Attributes = Attributes{"async": "async"}
 		}
+		script.Attributes = cdnAttributes(script.Attributes, src)
 		assets := []*Asset{script}
 		if err := appendScriptFallback(m, script, &assets, fallback); err != nil {
 			return nil, err
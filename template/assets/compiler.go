@@ -52,6 +52,7 @@ func Compile(m *Manager, name string, typ Type, opts Options) (string, error) {
 	if o, _ := m.Load(out); o != nil {
 		o.Close()
 		log.Debugf("%s already compiled to %s", name, out)
+		m.addCompiledToManifest(out, typ, name)
 		return out, nil
 	}
 	seeker.Seek(0, 0)
@@ -71,5 +72,12 @@ func Compile(m *Manager, name string, typ Type, opts Options) (string, error) {
 	if err := w.Close(); err != nil {
 		return "", err
 	}
+	m.addCompiledToManifest(out, typ, name)
 	return out, nil
 }
+
+func (m *Manager) addCompiledToManifest(out string, typ Type, name string) {
+	if err := m.addToManifest(out, typ, []string{name}, ""); err != nil {
+		log.Debugf("error adding %s to the assets manifest: %s", out, err)
+	}
+}
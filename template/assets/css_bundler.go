@@ -9,15 +9,26 @@ var (
 	cleanCSSPath, _ = exec.LookPath("cleancss")
 )
 
+// cssBundler minifies CSS code using cleancss when it's installed,
+// the assets service when it's been enabled (see Service) or
+// the in-process minifier otherwise.
 type cssBundler struct {
 }
 
 func (c *cssBundler) Bundle(w io.Writer, r io.Reader, opts Options) error {
+	_, err := c.bundleMappings(w, r, opts)
+	return err
+}
+
+func (c *cssBundler) bundleMappings(w io.Writer, r io.Reader, opts Options) ([]offsetMapping, error) {
 	if cleanCSSPath != "" {
-		return command(cleanCSSPath, []string{"--s0"}, w, r, opts)
+		return nil, command(cleanCSSPath, []string{"--s0"}, w, r, opts)
 	}
-	_, _, err := assetsService("css", w, r)
-	return err
+	if Service != "" {
+		_, _, err := assetsService("css", w, r)
+		return nil, err
+	}
+	return minifyCSS(w, r)
 }
 
 func (c *cssBundler) Type() Type {
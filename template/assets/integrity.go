@@ -0,0 +1,66 @@
+package assets
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"io"
+	"strings"
+	"time"
+)
+
+// Integrity returns the Subresource Integrity hash (using SHA-384)
+// of the data read from r, suitable for using it as the value
+// of the integrity attribute of <script> and <link> elements.
+func Integrity(r io.Reader) (string, error) {
+	h := sha512.New384()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return "sha384-" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+type integrityHash struct {
+	hash    string
+	modTime time.Time
+	size    int64
+}
+
+// Integrity returns the Subresource Integrity hash for the asset
+// with the given name. The hash is cached and only computed again
+// when the modification time or the size of the asset changes, so
+// assets edited while the app is running (e.g. in debug mode) don't
+// end up with a stale hash. For remote assets, it returns an empty
+// string.
+func (m *Manager) Integrity(name string) (string, error) {
+	if isRemote(name) {
+		return "", nil
+	}
+	st, err := m.fs.Stat(name)
+	if err != nil {
+		return "", err
+	}
+	m.mutex.RLock()
+	h, ok := m.integrity[name]
+	m.mutex.RUnlock()
+	if !ok || !h.modTime.Equal(st.ModTime()) || h.size != st.Size() {
+		f, err := m.Load(name)
+		if err != nil {
+			return "", err
+		}
+		hash, err := Integrity(f)
+		f.Close()
+		if err != nil {
+			return "", err
+		}
+		h = &integrityHash{hash: hash, modTime: st.ModTime(), size: st.Size()}
+		m.mutex.Lock()
+		m.integrity[name] = h
+		m.mutex.Unlock()
+	}
+	return h.hash, nil
+}
+
+func isRemote(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasPrefix(name, "//") || strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://")
+}
@@ -0,0 +1,56 @@
+package assets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkgs.com/vfs.v1"
+)
+
+func TestIntegrityModified(t *testing.T) {
+	dir, err := ioutil.TempDir("", "assets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := vfs.FS(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := filepath.Join(dir, "style.css")
+	if err := ioutil.WriteFile(p, []byte("body { color: red; }"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m := New(fs, "/assets/")
+	h1, err := m.Integrity("style.css")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(p, []byte("body { color: blue; }"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Make sure the modification time changes, even on
+	// filesystems with a coarse timestamp resolution.
+	mtime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(p, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	h2, err := m.Integrity("style.css")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1 == h2 {
+		t.Errorf("integrity hash didn't change after modifying the asset (%s)", h1)
+	}
+	expected, err := Integrity(strings.NewReader("body { color: blue; }"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h2 != expected {
+		t.Errorf("expecting integrity %s, got %s", expected, h2)
+	}
+}
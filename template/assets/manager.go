@@ -19,12 +19,15 @@ type Manager struct {
 	prefix       string
 	prefixLength int
 	cache        map[string]string
+	integrity    map[string]*integrityHash
+	manifest     *Manifest
 	mutex        sync.RWMutex
 }
 
 func New(fs vfs.VFS, prefix string) *Manager {
 	m := new(Manager)
 	m.cache = make(map[string]string)
+	m.integrity = make(map[string]*integrityHash)
 	m.fs = fs
 	m.SetPrefix(prefix)
 	runtime.SetFinalizer(m, func(manager *Manager) {
@@ -70,7 +73,9 @@ func (m *Manager) Has(name string) bool {
 
 func (m *Manager) Create(name string, overwrite bool) (io.WriteCloser, error) {
 	flags := os.O_WRONLY | os.O_CREATE
-	if !overwrite {
+	if overwrite {
+		flags |= os.O_TRUNC
+	} else {
 		flags |= os.O_EXCL
 	}
 	f, err := m.fs.OpenFile(name, flags, 0644)
@@ -0,0 +1,153 @@
+package assets
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+)
+
+// ManifestName is the name of the file, relative to the root of the
+// Manager VFS, where the Manifest is written.
+const ManifestName = "assets.gen.json"
+
+// ManifestAsset describes an asset in a Manifest.
+type ManifestAsset struct {
+	// Type is the type of the asset, as its extension (e.g. css).
+	Type string `json:"type"`
+	// Sources contains the names of the assets used to
+	// generate this asset, empty for non-generated ones.
+	Sources []string `json:"sources,omitempty"`
+	// Integrity is the Subresource Integrity hash of the asset.
+	Integrity string `json:"integrity,omitempty"`
+	// SourceMap is the name of the source map for the asset, if any.
+	SourceMap string `json:"source_map,omitempty"`
+}
+
+// Manifest describes the assets generated by a Manager, either by
+// bundling or by compiling other assets. Every time an asset is
+// generated, the Manager updates its Manifest and writes it as
+// JSON to ManifestName, so it can be used by deployment steps
+// (e.g. uploading the assets to a CDN). gondola bake can also
+// write a Manifest describing all the baked assets.
+type Manifest struct {
+	// Assets maps the asset names to their description.
+	Assets map[string]*ManifestAsset `json:"assets"`
+}
+
+// Names returns the sorted names of the assets in the Manifest.
+func (m *Manifest) Names() []string {
+	names := make([]string, 0, len(m.Assets))
+	for k := range m.Assets {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Generated returns the sorted names of the assets generated
+// from the asset with the given name.
+func (m *Manifest) Generated(name string) []string {
+	var names []string
+	for k, v := range m.Assets {
+		for _, s := range v.Sources {
+			if s == name {
+				names = append(names, k)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Manifest returns the Manifest for the assets generated by this
+// Manager, including the ones generated by previous runs.
+func (m *Manager) Manifest() (*Manifest, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if err := m.loadManifest(); err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{Assets: make(map[string]*ManifestAsset, len(m.manifest.Assets))}
+	for k, v := range m.manifest.Assets {
+		a := *v
+		manifest.Assets[k] = &a
+	}
+	return manifest, nil
+}
+
+// loadManifest must be called with the mutex held.
+func (m *Manager) loadManifest() error {
+	if m.manifest != nil {
+		return nil
+	}
+	manifest := &Manifest{Assets: make(map[string]*ManifestAsset)}
+	if m.Has(ManifestName) {
+		f, err := m.Load(ManifestName)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, manifest); err != nil {
+			return err
+		}
+		if manifest.Assets == nil {
+			manifest.Assets = make(map[string]*ManifestAsset)
+		}
+	}
+	m.manifest = manifest
+	return nil
+}
+
+// addToManifest adds the asset with the given name to the Manifest
+// and writes it, if the asset wasn't already in it.
+func (m *Manager) addToManifest(name string, typ Type, sources []string, sourceMap string) error {
+	integrity, err := m.Integrity(name)
+	if err != nil {
+		return err
+	}
+	asset := &ManifestAsset{
+		Type:      typ.Ext(),
+		Sources:   sources,
+		Integrity: integrity,
+		SourceMap: sourceMap,
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if err := m.loadManifest(); err != nil {
+		return err
+	}
+	if prev := m.manifest.Assets[name]; prev != nil && manifestAssetEqual(prev, asset) {
+		return nil
+	}
+	m.manifest.Assets[name] = asset
+	data, err := json.MarshalIndent(m.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	w, err := m.Create(ManifestName, true)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func manifestAssetEqual(a, b *ManifestAsset) bool {
+	if a.Type != b.Type || a.Integrity != b.Integrity || a.SourceMap != b.SourceMap || len(a.Sources) != len(b.Sources) {
+		return false
+	}
+	for ii, v := range a.Sources {
+		if b.Sources[ii] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,408 @@
+package assets
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// The minifiers in this file are used when no external tools are
+// available and the remote services have not been enabled. They
+// only remove comments and whitespace, so they never rename identifiers
+// nor rewrite any expressions. Comments starting with /*! (usually
+// licenses) are preserved.
+
+var (
+	errUnterminatedComment = errors.New("unterminated comment")
+	errUnterminatedString  = errors.New("unterminated string")
+
+	// keywords after which a / starts a regular expression
+	jsRegexpKeywords = map[string]bool{
+		"return":     true,
+		"typeof":     true,
+		"instanceof": true,
+		"in":         true,
+		"of":         true,
+		"new":        true,
+		"delete":     true,
+		"void":       true,
+		"throw":      true,
+		"case":       true,
+		"do":         true,
+		"else":       true,
+		"yield":      true,
+		"await":      true,
+	}
+)
+
+func isCSSSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// copyQuoted appends the quoted string starting at src[pos] to out,
+// returning the new output and the position right after the string.
+func copyQuoted(out []byte, src []byte, pos int) ([]byte, int, error) {
+	quote := src[pos]
+	for ii := pos + 1; ii < len(src); ii++ {
+		switch src[ii] {
+		case '\\':
+			ii++
+		case quote:
+			return append(out, src[pos:ii+1]...), ii + 1, nil
+		case '\n':
+			return nil, 0, errUnterminatedString
+		}
+	}
+	return nil, 0, errUnterminatedString
+}
+
+// copyTemplate works like copyQuoted, but for JS template literals,
+// which might contain nested strings and templates in their
+// ${} substitutions. Substitutions are copied verbatim.
+func copyTemplate(out []byte, src []byte, pos int) ([]byte, int, error) {
+	var err error
+	ii := pos + 1
+	for ii < len(src) {
+		switch src[ii] {
+		case '\\':
+			ii += 2
+			continue
+		case '`':
+			return append(out, src[pos:ii+1]...), ii + 1, nil
+		case '$':
+			if ii+1 < len(src) && src[ii+1] == '{' {
+				out = append(out, src[pos:ii+2]...)
+				if out, ii, err = copySubstitution(out, src, ii+2); err != nil {
+					return nil, 0, err
+				}
+				pos = ii
+				continue
+			}
+		}
+		ii++
+	}
+	return nil, 0, errUnterminatedString
+}
+
+func copySubstitution(out []byte, src []byte, pos int) ([]byte, int, error) {
+	var err error
+	depth := 1
+	for ii := pos; ii < len(src); {
+		switch src[ii] {
+		case '"', '\'':
+			out = append(out, src[pos:ii]...)
+			if out, ii, err = copyQuoted(out, src, ii); err != nil {
+				return nil, 0, err
+			}
+			pos = ii
+			continue
+		case '`':
+			out = append(out, src[pos:ii]...)
+			if out, ii, err = copyTemplate(out, src, ii); err != nil {
+				return nil, 0, err
+			}
+			pos = ii
+			continue
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return append(out, src[pos:ii+1]...), ii + 1, nil
+			}
+		}
+		ii++
+	}
+	return nil, 0, errUnterminatedString
+}
+
+// minifyCSS removes the comments and unnecessary whitespace
+// from the CSS code read from r and writes the result to w,
+// returning the mappings for generating a source map.
+func minifyCSS(w io.Writer, r io.Reader) ([]offsetMapping, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	out, mappings, err := minifyCSSCode(src)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(out); err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
+func minifyCSSCode(src []byte) ([]byte, []offsetMapping, error) {
+	var err error
+	var mappings []offsetMapping
+	out := make([]byte, 0, len(src))
+	// mark is true when the next token must be mapped
+	mark := true
+	space := false
+	// depth of parenthesis, whitespace inside them
+	// is left alone (e.g. calc()).
+	depth := 0
+	for ii := 0; ii < len(src); {
+		c := src[ii]
+		switch {
+		case c == '/' && ii+1 < len(src) && src[ii+1] == '*':
+			end := bytes.Index(src[ii+2:], []byte("*/"))
+			if end < 0 {
+				return nil, nil, errUnterminatedComment
+			}
+			end += ii + 4
+			mark = true
+			if ii+2 < len(src) && src[ii+2] == '!' {
+				if len(out) > 0 && out[len(out)-1] != '\n' {
+					out = append(out, '\n')
+				}
+				out = append(out, src[ii:end]...)
+				out = append(out, '\n')
+				space = false
+			} else {
+				space = true
+			}
+			ii = end
+			continue
+		case isCSSSpace(c):
+			space = true
+			mark = true
+			ii++
+			continue
+		}
+		if space && len(out) > 0 && cssNeedsSpace(out[len(out)-1], c, depth) {
+			out = append(out, ' ')
+		}
+		space = false
+		// Last semicolon in a block is not required
+		if c == '}' && len(out) > 0 && out[len(out)-1] == ';' {
+			out = out[:len(out)-1]
+			if n := len(mappings); n > 0 && mappings[n-1].out == len(out) {
+				mappings = mappings[:n-1]
+			}
+		}
+		if mark {
+			mappings = append(mappings, offsetMapping{out: len(out), in: ii})
+			mark = false
+		}
+		switch c {
+		case '"', '\'':
+			if out, ii, err = copyQuoted(out, src, ii); err != nil {
+				return nil, nil, err
+			}
+			continue
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		}
+		out = append(out, c)
+		ii++
+	}
+	return out, mappings, nil
+}
+
+func cssNeedsSpace(prev byte, next byte, depth int) bool {
+	if strings.IndexByte("{};,:(\n", prev) >= 0 || strings.IndexByte("{};,)!", next) >= 0 {
+		return false
+	}
+	if depth == 0 && (prev == '>' || prev == '~' || next == '>' || next == '~') {
+		return false
+	}
+	return true
+}
+
+func isJSIdent(c byte) bool {
+	return c == '_' || c == '$' || c == '\\' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func isJSSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\v' || c == '\f'
+}
+
+// jsRegexpAllowed returns true if a / after the given output
+// starts a regular expression rather than a division. Note that
+// treating a division as a regular expression is harmless, since
+// regular expressions are copied verbatim.
+func jsRegexpAllowed(out []byte) bool {
+	if len(out) == 0 {
+		return true
+	}
+	last := out[len(out)-1]
+	if last == ')' || last == ']' {
+		return false
+	}
+	if isJSIdent(last) {
+		start := len(out)
+		for start > 0 && isJSIdent(out[start-1]) {
+			start--
+		}
+		return jsRegexpKeywords[string(out[start:])]
+	}
+	return true
+}
+
+// jsRegexpEnd returns the position right after the regular
+// expression starting at src[pos], or -1 if there's no
+// valid regular expression there.
+func jsRegexpEnd(src []byte, pos int) int {
+	class := false
+	for ii := pos + 1; ii < len(src); ii++ {
+		switch src[ii] {
+		case '\\':
+			ii++
+		case '[':
+			class = true
+		case ']':
+			class = false
+		case '/':
+			if !class {
+				return ii + 1
+			}
+		case '\n', '\r':
+			return -1
+		}
+	}
+	return -1
+}
+
+// jsKeepNewline returns true if a newline between prev and next
+// might be required because of automatic semicolon insertion.
+func jsKeepNewline(prev byte, next byte) bool {
+	return (isJSIdent(prev) || strings.IndexByte(")]}'\"`+-/", prev) >= 0) &&
+		(isJSIdent(next) || strings.IndexByte("([{'\"`+-!~/#", next) >= 0)
+}
+
+// jsNeedsSpace returns true if removing the space between prev
+// and next would join two tokens.
+func jsNeedsSpace(prev byte, next byte) bool {
+	switch {
+	case isJSIdent(prev) && isJSIdent(next):
+		return true
+	case (prev == '+' || prev == '-') && next == prev:
+		return true
+	case prev == '/' && (next == '/' || next == '*'):
+		return true
+	case prev >= '0' && prev <= '9' && next == '.':
+		return true
+	case prev == '<' && next == '!', prev == '-' && next == '>':
+		return true
+	}
+	return false
+}
+
+// minifyJS removes the comments and unnecessary whitespace from
+// the JS code read from r and writes the result to w. Newlines
+// are kept wherever automatic semicolon insertion might need them.
+// The mappings for generating a source map are also returned.
+func minifyJS(w io.Writer, r io.Reader) ([]offsetMapping, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	out, mappings, err := minifyJSCode(src)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(out); err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
+func minifyJSCode(src []byte) ([]byte, []offsetMapping, error) {
+	var err error
+	var mappings []offsetMapping
+	out := make([]byte, 0, len(src))
+	// mark is true when the next token must be mapped
+	mark := true
+	space := false
+	newline := false
+	for ii := 0; ii < len(src); {
+		c := src[ii]
+		switch {
+		case c == '\n' || c == '\r':
+			newline = true
+			mark = true
+			ii++
+			continue
+		case isJSSpace(c):
+			space = true
+			mark = true
+			ii++
+			continue
+		case c == '/' && ii+1 < len(src) && src[ii+1] == '/':
+			mark = true
+			for ii < len(src) && src[ii] != '\n' && src[ii] != '\r' {
+				ii++
+			}
+			continue
+		case c == '/' && ii+1 < len(src) && src[ii+1] == '*':
+			end := bytes.Index(src[ii+2:], []byte("*/"))
+			if end < 0 {
+				return nil, nil, errUnterminatedComment
+			}
+			end += ii + 4
+			mark = true
+			comment := src[ii:end]
+			if len(comment) > 4 && comment[2] == '!' {
+				if len(out) > 0 {
+					out = append(out, '\n')
+				}
+				out = append(out, comment...)
+				space = false
+				newline = true
+			} else if bytes.IndexAny(comment, "\r\n") >= 0 {
+				newline = true
+			} else {
+				space = true
+			}
+			ii = end
+			continue
+		}
+		if (space || newline) && len(out) > 0 {
+			prev := out[len(out)-1]
+			if newline && jsKeepNewline(prev, c) {
+				out = append(out, '\n')
+			} else if jsNeedsSpace(prev, c) {
+				out = append(out, ' ')
+			}
+		}
+		space = false
+		newline = false
+		if mark {
+			mappings = append(mappings, offsetMapping{out: len(out), in: ii})
+			mark = false
+		}
+		switch c {
+		case '"', '\'':
+			if out, ii, err = copyQuoted(out, src, ii); err != nil {
+				return nil, nil, err
+			}
+			continue
+		case '`':
+			if out, ii, err = copyTemplate(out, src, ii); err != nil {
+				return nil, nil, err
+			}
+			continue
+		case '/':
+			if jsRegexpAllowed(out) {
+				if end := jsRegexpEnd(src, ii); end > 0 {
+					out = append(out, src[ii:end]...)
+					ii = end
+					continue
+				}
+			}
+		}
+		out = append(out, c)
+		ii++
+	}
+	return out, mappings, nil
+}
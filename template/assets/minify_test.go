@@ -0,0 +1,64 @@
+package assets
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+type minifyTest struct {
+	code     string
+	expected string
+}
+
+func testMinify(t *testing.T, f func(io.Writer, io.Reader) ([]offsetMapping, error), tests []minifyTest) {
+	for _, v := range tests {
+		var buf bytes.Buffer
+		if _, err := f(&buf, strings.NewReader(v.code)); err != nil {
+			t.Errorf("error minifying %q: %s", v.code, err)
+			continue
+		}
+		if s := buf.String(); s != v.expected {
+			t.Errorf("expecting %q when minifying %q, got %q", v.expected, v.code, s)
+		}
+	}
+}
+
+func TestMinifyCSS(t *testing.T) {
+	testMinify(t, minifyCSS, []minifyTest{
+		{"a {\n\tcolor: red;\n\tmargin: 0 auto;\n}\n", "a{color:red;margin:0 auto}"},
+		{"/* comment */ .a .b > .c , .d ~ .e {}", ".a .b>.c,.d~.e{}"},
+		{".a :hover { width: calc(100% - 2px) }", ".a :hover{width:calc(100% - 2px)}"},
+		{"@media screen and (max-width: 10px) { a { b: c !important; } }", "@media screen and (max-width:10px){a{b:c!important}}"},
+		{"a { content: \"  /* not a comment */  \"; }", "a{content:\"  /* not a comment */  \"}"},
+		{"/*! license */\na{}", "/*! license */\na{}"},
+		{"a { background: url(a.png) no-repeat }", "a{background:url(a.png) no-repeat}"},
+	})
+}
+
+func TestMinifyJS(t *testing.T) {
+	testMinify(t, minifyJS, []minifyTest{
+		{"var a = 1 ;\n// comment\nvar b = 2;", "var a=1;var b=2;"},
+		{"function f ( a , b ) {\n  return a + b;\n}", "function f(a,b){return a+b;}"},
+		{"a = b\n++c", "a=b\n++c"},
+		{"return\nx", "return\nx"},
+		{"a + +b; c - -d", "a+ +b;c- -d"},
+		{"var s = 'a  // b' + \"c /* d */\";", "var s='a  // b'+\"c /* d */\";"},
+		{"var t = `a  ${ b + `c  d` }  e`;", "var t=`a  ${ b + `c  d` }  e`;"},
+		{"var r = /[ /]  x/g.test(s);", "var r=/[ /]  x/g.test(s);"},
+		{"var d = a / b / c;", "var d=a/b/c;"},
+		{"return /a  b/;", "return/a  b/;"},
+		{"x = 1 .toString()", "x=1 .toString()"},
+		{"/*! license */\nvar a;", "/*! license */\nvar a;"},
+		{"/* multi\nline */a\n/* c */b", "a\nb"},
+	})
+}
+
+func TestMinifyErrors(t *testing.T) {
+	for _, v := range []string{"var a = 'b", "/* a", "var t = `a ${ b"} {
+		if _, err := minifyJS(&bytes.Buffer{}, strings.NewReader(v)); err == nil {
+			t.Errorf("expecting an error when minifying %q", v)
+		}
+	}
+}
@@ -10,17 +10,9 @@ func Render(m *Manager, a *Asset) (template.HTML, error) {
 	var html string
 	switch a.Type {
 	case TypeCSS:
-		if a.Attributes != nil {
-			html = fmt.Sprintf("<link %s rel=\"stylesheet\" type=\"text/css\" href=\"%s\">", a.Attributes.String(), m.URL(a.Name))
-		} else {
-			html = fmt.Sprintf("<link rel=\"stylesheet\" type=\"text/css\" href=\"%s\">", m.URL(a.Name))
-		}
+		html = fmt.Sprintf("<link %srel=\"stylesheet\" type=\"text/css\" href=\"%s\">", renderAttributes(m, a), m.URL(a.Name))
 	case TypeJavascript:
-		if a.Attributes != nil {
-			html = fmt.Sprintf("<script %s type=\"text/javascript\" src=\"%s\"></script>", a.Attributes.String(), m.URL(a.Name))
-		} else {
-			html = fmt.Sprintf("<script type=\"text/javascript\" src=\"%s\"></script>", m.URL(a.Name))
-		}
+		html = fmt.Sprintf("<script %stype=\"text/javascript\" src=\"%s\"></script>", renderAttributes(m, a), m.URL(a.Name))
 	default:
 		if a.HTML == "" {
 			return "", fmt.Errorf("asset %q of Other type must specify HTML", a.Name)
@@ -30,6 +22,32 @@ func Render(m *Manager, a *Asset) (template.HTML, error) {
 	return Conditional(a.Condition, html), nil
 }
 
+// renderAttributes returns the attributes for the given asset, followed
+// by a space, adding the integrity hash for local assets. Remote
+// assets only get an integrity attribute when it has been pinned
+// (e.g. in their CdnInfo). Assets with an integrity hash which are
+// served from another origin also get a crossorigin attribute.
+func renderAttributes(m *Manager, a *Asset) string {
+	attrs := make(Attributes, len(a.Attributes)+2)
+	for k, v := range a.Attributes {
+		attrs[k] = v
+	}
+	if _, ok := attrs["integrity"]; !ok && !a.IsRemote() {
+		if integrity, _ := m.Integrity(a.Name); integrity != "" {
+			attrs["integrity"] = integrity
+		}
+	}
+	if _, ok := attrs["integrity"]; ok && isRemote(m.URL(a.Name)) {
+		if _, ok := attrs["crossorigin"]; !ok {
+			attrs["crossorigin"] = "anonymous"
+		}
+	}
+	if len(attrs) == 0 {
+		return ""
+	}
+	return attrs.String() + " "
+}
+
 func RenderTo(w io.Writer, m *Manager, a *Asset) error {
 	h, err := Render(m, a)
 	if err != nil {
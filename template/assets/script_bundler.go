@@ -10,14 +10,27 @@ import (
 	"net/url"
 )
 
-// ScriptBundler uses Google's closure compiler to optimize JS code.
-// Accepted options are:
+// scriptBundler minifies JS code in-process, unless the Google Closure
+// Compiler service has been enabled (see ClosureCompiler).
+// When using the closure compiler, accepted options are:
 //  optimize: (simple|advanced) - defaults to simple
 //  compiler_warnings: boolean - defaults to false
 type scriptBundler struct {
 }
 
 func (c *scriptBundler) Bundle(w io.Writer, r io.Reader, opts Options) error {
+	_, err := c.bundleMappings(w, r, opts)
+	return err
+}
+
+func (c *scriptBundler) bundleMappings(w io.Writer, r io.Reader, opts Options) ([]offsetMapping, error) {
+	if ClosureCompiler == "" {
+		return minifyJS(w, r)
+	}
+	return nil, c.closureCompiler(w, r, opts)
+}
+
+func (c *scriptBundler) closureCompiler(w io.Writer, r io.Reader, opts Options) error {
 	code, err := ioutil.ReadAll(r)
 	if err != nil {
 		return err
@@ -36,7 +49,7 @@ func (c *scriptBundler) Bundle(w io.Writer, r io.Reader, opts Options) error {
 		"output_format":     []string{"json"},
 		"output_info":       outputInfo,
 	}
-	resp, err := http.PostForm("http://closure-compiler.appspot.com/compile", form)
+	resp, err := http.PostForm(ClosureCompiler, form)
 	if err != nil {
 		return err
 	}
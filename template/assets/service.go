@@ -1,6 +1,7 @@
 package assets
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -8,6 +9,15 @@ import (
 	"net/url"
 )
 
+const (
+	// DefaultService is the URL of the public Gondola
+	// assets service. See Service.
+	DefaultService = "http://assets.gondolaweb.com/"
+	// DefaultClosureCompiler is the URL of the public
+	// Google Closure Compiler service. See ClosureCompiler.
+	DefaultClosureCompiler = "http://closure-compiler.appspot.com/compile"
+)
+
 // Service indicates the base URL for the assets
 // service to use. POST calls will be made to:
 //
@@ -19,9 +29,24 @@ import (
 //
 // The code to reduce or compile will be sent in
 // the form parameter named "code".
-var Service = "http://assets.gondolaweb.com/"
+//
+// The service is only used when the required external
+// tools (e.g. cleancss or lessc) are not installed. If Service
+// is empty (the default), CSS is minified in-process
+// and compiling from other languages fails. Set it to
+// DefaultService to use the public assets service.
+var Service = ""
+
+// ClosureCompiler indicates the URL of the Google Closure Compiler
+// service used for bundling JS code. If empty (the default), JS
+// is minified in-process, removing only comments and whitespace.
+// Set it to DefaultClosureCompiler to use the public service.
+var ClosureCompiler = ""
 
 func assetsService(path string, w io.Writer, r io.Reader) (int, int, error) {
+	if Service == "" {
+		return 0, 0, errors.New("no assets service configured, see gnd.la/template/assets.Service")
+	}
 	code, err := ioutil.ReadAll(r)
 	if err != nil {
 		return 0, 0, err
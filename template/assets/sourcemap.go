@@ -0,0 +1,119 @@
+package assets
+
+import (
+	"bytes"
+	"io"
+	"sort"
+)
+
+const base64VLQChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// SourceMap represents a version 3 source map, which maps the
+// positions in a generated asset (e.g. a bundle) to the positions
+// in the assets it was generated from.
+type SourceMap struct {
+	Version  int      `json:"version"`
+	File     string   `json:"file,omitempty"`
+	Sources  []string `json:"sources"`
+	Names    []string `json:"names"`
+	Mappings string   `json:"mappings"`
+}
+
+// offsetMapping maps an offset in the output of a minifier
+// to an offset in its input.
+type offsetMapping struct {
+	out int
+	in  int
+}
+
+// mappingBundler is implemented by the bundlers which can
+// return the mappings required for generating a source map.
+// If they return no mappings, no source map is generated.
+type mappingBundler interface {
+	Bundler
+	bundleMappings(w io.Writer, r io.Reader, opts Options) ([]offsetMapping, error)
+}
+
+// lineStarts returns the offsets where each line in b starts.
+func lineStarts(b []byte) []int {
+	starts := []int{0}
+	for ii, c := range b {
+		if c == '\n' {
+			starts = append(starts, ii+1)
+		}
+	}
+	return starts
+}
+
+// position returns the line and column (both 0 based) of
+// the given offset, using the line starts from lineStarts.
+func position(starts []int, offset int) (int, int) {
+	line := sort.Search(len(starts), func(ii int) bool { return starts[ii] > offset }) - 1
+	return line, offset - starts[line]
+}
+
+func writeVLQ(buf *bytes.Buffer, value int) {
+	vlq := value << 1
+	if value < 0 {
+		vlq = (-value << 1) | 1
+	}
+	for {
+		digit := vlq & 31
+		vlq >>= 5
+		if vlq > 0 {
+			digit |= 32
+		}
+		buf.WriteByte(base64VLQChars[digit])
+		if vlq == 0 {
+			break
+		}
+	}
+}
+
+// newSourceMap returns a SourceMap from the given mappings between out
+// and in, where in is the concatenation of the given sources, which
+// start at the given offsets.
+func newSourceMap(file string, out []byte, in []byte, mappings []offsetMapping, sources []string, offsets []int) *SourceMap {
+	outStarts := lineStarts(out)
+	sourceStarts := make([][]int, len(sources))
+	for ii := range sources {
+		end := len(in)
+		if ii < len(offsets)-1 {
+			end = offsets[ii+1]
+		}
+		sourceStarts[ii] = lineStarts(in[offsets[ii]:end])
+	}
+	var buf bytes.Buffer
+	genLine, genCol, prevSource, prevLine, prevCol := 0, 0, 0, 0, 0
+	first := true
+	for _, v := range mappings {
+		line, col := position(outStarts, v.out)
+		source := sort.Search(len(offsets), func(ii int) bool { return offsets[ii] > v.in }) - 1
+		if source < 0 {
+			continue
+		}
+		srcLine, srcCol := position(sourceStarts[source], v.in-offsets[source])
+		for genLine < line {
+			buf.WriteByte(';')
+			genLine++
+			genCol = 0
+			first = true
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		writeVLQ(&buf, col-genCol)
+		writeVLQ(&buf, source-prevSource)
+		writeVLQ(&buf, srcLine-prevLine)
+		writeVLQ(&buf, srcCol-prevCol)
+		genCol, prevSource, prevLine, prevCol = col, source, srcLine, srcCol
+	}
+	return &SourceMap{
+		Version:  3,
+		File:     file,
+		Sources:  sources,
+		Names:    []string{},
+		Mappings: buf.String(),
+	}
+}
@@ -0,0 +1,46 @@
+package assets
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestVLQ(t *testing.T) {
+	cases := []struct {
+		value    int
+		expected string
+	}{
+		{0, "A"},
+		{1, "C"},
+		{-1, "D"},
+		{15, "e"},
+		{16, "gB"},
+		{123, "2H"},
+		{-2048, "hgE"},
+	}
+	for _, v := range cases {
+		var buf bytes.Buffer
+		writeVLQ(&buf, v.value)
+		if s := buf.String(); s != v.expected {
+			t.Errorf("expecting VLQ %q for %d, got %q", v.expected, v.value, s)
+		}
+	}
+}
+
+func TestSourceMap(t *testing.T) {
+	in := []byte("a {\n  b: c;\n}\n\nd {}")
+	out, mappings, err := minifyCSSCode(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "a{b:c}d{}" {
+		t.Fatalf("unexpected output %q", string(out))
+	}
+	sm := newSourceMap("out.css", out, in, mappings, []string{"a.css", "d.css"}, []int{0, 15})
+	// a -> a.css 0:0, { -> 0:2, b -> 1:2, c -> 1:5, } -> 2:0,
+	// d -> d.css 0:0, { -> 0:2
+	const expected = "AAAA,CAAE,CACA,EAAG,CACL,CCFA,CAAE"
+	if sm.Mappings != expected {
+		t.Errorf("expecting mappings %q, got %q", expected, sm.Mappings)
+	}
+}
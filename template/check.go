@@ -0,0 +1,404 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template/parse"
+
+	"gnd.la/util/types"
+)
+
+// CheckErrors is returned by Template.Check when it finds
+// any errors. Each error is prefixed with its location
+// in the template as file:line:column.
+type CheckErrors []error
+
+func (e CheckErrors) Error() string {
+	s := make([]string, len(e))
+	for ii, v := range e {
+		s[ii] = v.Error()
+	}
+	return strings.Join(s, "\n")
+}
+
+// Check verifies the template against the type of the data which will
+// be passed as the dot when it's executed, without executing it. It
+// follows every template invoked from the root one, including the ones
+// from {{ extend }}, {{ block }} and hooks, resolving fields, methods and
+// functions and checking their number of arguments and, when possible,
+// their types. Check is conservative: values with an unknown type (e.g.
+// interface{}) are not checked any further.
+//
+// vars contains the types of the variables passed to the template,
+// accessible as $Vars.Name or its shorthand @Name. If vars is non-nil,
+// references to variables not present in it are also reported. A nil
+// reflect.Type value declares a variable without checking its uses.
+//
+// The template must be compiled, so hooks have been already added. If
+// there are any errors, a CheckErrors is returned.
+func (t *Template) Check(dot reflect.Type, vars map[string]reflect.Type) error {
+	if t.prog == nil {
+		return errors.New("can't check template, it's not compiled yet")
+	}
+	c := &checker{
+		t:        t,
+		vars:     vars,
+		seen:     make(map[checkKey]bool),
+		reported: make(map[string]bool),
+	}
+	c.tree(t.root, knownType(dot))
+	if len(c.errs) > 0 {
+		return c.errs
+	}
+	return nil
+}
+
+type checkKey struct {
+	name string
+	dot  reflect.Type
+}
+
+type checkScope struct {
+	name string
+	dot  reflect.Type
+	vars map[string]reflect.Type
+}
+
+func (s *checkScope) copy() *checkScope {
+	vars := make(map[string]reflect.Type, len(s.vars))
+	for k, v := range s.vars {
+		vars[k] = v
+	}
+	return &checkScope{name: s.name, dot: s.dot, vars: vars}
+}
+
+type checker struct {
+	t        *Template
+	vars     map[string]reflect.Type
+	seen     map[checkKey]bool
+	reported map[string]bool
+	errs     CheckErrors
+}
+
+// knownType returns nil for types which can't be
+// checked at compile time.
+func knownType(typ reflect.Type) reflect.Type {
+	if typ == nil || (typ.Kind() == reflect.Interface && typ.NumMethod() == 0) {
+		return nil
+	}
+	return typ
+}
+
+func (c *checker) errorf(s *checkScope, node parse.Node, format string, args ...interface{}) {
+	err := fmt.Errorf(format, args...)
+	name := s.name
+	if p := strings.Index(name, "$htmltemplate"); p >= 0 {
+		// Mangled tree generated by html/template, which
+		// has no text. Use the unmangled version instead.
+		name = name[:p-1]
+	}
+	if tr := c.t.trees[name]; tr != nil {
+		err = c.t.formatTreeErr(tr, node, err)
+	}
+	if msg := err.Error(); !c.reported[msg] {
+		c.reported[msg] = true
+		c.errs = append(c.errs, err)
+	}
+}
+
+func (c *checker) tree(name string, dot reflect.Type) {
+	key := checkKey{name, dot}
+	if c.seen[key] {
+		return
+	}
+	c.seen[key] = true
+	tr := c.t.trees[name]
+	if tr == nil || tr.Root == nil {
+		return
+	}
+	s := &checkScope{
+		name: name,
+		dot:  dot,
+		vars: map[string]reflect.Type{"$": dot},
+	}
+	c.walk(s, tr.Root)
+}
+
+func (c *checker) walk(s *checkScope, n parse.Node) {
+	switch x := n.(type) {
+	case *parse.ListNode:
+		if x == nil {
+			return
+		}
+		for _, v := range x.Nodes {
+			c.walk(s, v)
+		}
+	case *parse.ActionNode:
+		c.pipe(s, x.Pipe)
+	case *parse.IfNode:
+		c.pipe(s, x.Pipe)
+		c.walk(s.copy(), x.List)
+		c.walk(s.copy(), x.ElseList)
+	case *parse.WithNode:
+		inner := s.copy()
+		inner.dot = c.pipe(inner, x.Pipe)
+		c.walk(inner, x.List)
+		c.walk(s.copy(), x.ElseList)
+	case *parse.RangeNode:
+		inner := s.copy()
+		typ := c.pipeType(inner, x.Pipe)
+		key, elem := c.rangeTypes(s, x, typ)
+		switch len(x.Pipe.Decl) {
+		case 1:
+			inner.vars[x.Pipe.Decl[0].Ident[0]] = elem
+		case 2:
+			inner.vars[x.Pipe.Decl[0].Ident[0]] = key
+			inner.vars[x.Pipe.Decl[1].Ident[0]] = elem
+		}
+		inner.dot = elem
+		c.walk(inner, x.List)
+		c.walk(s.copy(), x.ElseList)
+	case *parse.TemplateNode:
+		var dot reflect.Type
+		if x.Pipe != nil {
+			dot = c.pipe(s, x.Pipe)
+		}
+		c.tree(x.Name, dot)
+	}
+}
+
+func (c *checker) rangeTypes(s *checkScope, n parse.Node, typ reflect.Type) (reflect.Type, reflect.Type) {
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ = knownType(typ); typ == nil {
+		return nil, nil
+	}
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		return reflect.TypeOf(0), knownType(typ.Elem())
+	case reflect.Map:
+		return knownType(typ.Key()), knownType(typ.Elem())
+	case reflect.Chan:
+		return nil, knownType(typ.Elem())
+	case reflect.Interface:
+		return nil, nil
+	}
+	c.errorf(s, n, "can't range over %s", typ)
+	return nil, nil
+}
+
+// pipe returns the type of the given pipe, declaring
+// its variables in the scope.
+func (c *checker) pipe(s *checkScope, pipe *parse.PipeNode) reflect.Type {
+	typ := c.pipeType(s, pipe)
+	for _, v := range pipe.Decl {
+		s.vars[v.Ident[0]] = typ
+	}
+	return typ
+}
+
+func (c *checker) pipeType(s *checkScope, pipe *parse.PipeNode) reflect.Type {
+	if pipe == nil {
+		return nil
+	}
+	var typ reflect.Type
+	for ii, v := range pipe.Cmds {
+		typ = c.command(s, v, ii > 0)
+	}
+	return typ
+}
+
+// command returns the type of the given command. final
+// indicates if the command receives the result of the
+// previous one in the pipe as its last argument.
+func (c *checker) command(s *checkScope, cmd *parse.CommandNode, final bool) reflect.Type {
+	args := cmd.Args[1:]
+	switch x := cmd.Args[0].(type) {
+	case *parse.FieldNode:
+		return c.fields(s, x, s.dot, x.Ident, args, final)
+	case *parse.ChainNode:
+		return c.fields(s, x, c.arg(s, x.Node), x.Field, args, final)
+	case *parse.VariableNode:
+		if len(x.Ident) == 1 {
+			return s.vars[x.Ident[0]]
+		}
+		typ, rem := c.variable(s, x)
+		return c.fields(s, x, typ, rem, args, final)
+	case *parse.IdentifierNode:
+		return c.function(s, x, args, final)
+	}
+	return c.arg(s, cmd.Args[0])
+}
+
+// variable returns the type of the variable named by the first
+// identifiers of the node and the remaining field names.
+func (c *checker) variable(s *checkScope, n *parse.VariableNode) (reflect.Type, []string) {
+	name := n.Ident[0]
+	if name == "$"+varsKey && !strings.Contains(s.name, nsMark) {
+		if c.vars == nil {
+			return nil, nil
+		}
+		typ, ok := c.vars[n.Ident[1]]
+		if !ok {
+			c.errorf(s, n, "undefined variable @%s", n.Ident[1])
+		}
+		return knownType(typ), n.Ident[2:]
+	}
+	// Undefined variables might be inherited from the
+	// calling template, so they're not an error.
+	return s.vars[name], n.Ident[1:]
+}
+
+// arg returns the type of a node used as an argument.
+func (c *checker) arg(s *checkScope, n parse.Node) reflect.Type {
+	switch x := n.(type) {
+	case *parse.BoolNode:
+		return reflect.TypeOf(true)
+	case *parse.StringNode:
+		return stringType
+	case *parse.NumberNode:
+		if x.IsInt {
+			return reflect.TypeOf(0)
+		}
+		if x.IsFloat {
+			return reflect.TypeOf(0.0)
+		}
+	case *parse.DotNode:
+		return s.dot
+	case *parse.PipeNode:
+		return c.pipeType(s.copy(), x)
+	case *parse.FieldNode, *parse.ChainNode, *parse.VariableNode, *parse.IdentifierNode:
+		return c.command(s, &parse.CommandNode{NodeType: parse.NodeCommand, Pos: n.Position(), Args: []parse.Node{n}}, false)
+	}
+	return nil
+}
+
+func (c *checker) fields(s *checkScope, n parse.Node, typ reflect.Type, names []string, args []parse.Node, final bool) reflect.Type {
+	for ii, v := range names {
+		if ii == len(names)-1 {
+			return c.field(s, n, typ, v, args, final)
+		}
+		typ = c.field(s, n, typ, v, nil, false)
+	}
+	return typ
+}
+
+func (c *checker) field(s *checkScope, n parse.Node, typ reflect.Type, name string, args []parse.Node, final bool) reflect.Type {
+	if typ == nil {
+		c.args(s, args)
+		return nil
+	}
+	if typ.Kind() == reflect.Map && (typ.Key().Kind() == reflect.String || stringType.AssignableTo(typ.Key())) {
+		return knownType(typ.Elem())
+	}
+	if m, ok := typ.MethodByName(name); ok {
+		first := 1
+		if typ.Kind() == reflect.Interface {
+			// Interface methods have no receiver
+			first = 0
+		}
+		return c.call(s, n, "method "+name, m.Type, first, args, final)
+	}
+	base := typ
+	for base.Kind() == reflect.Ptr {
+		base = base.Elem()
+	}
+	if m, ok := reflect.PtrTo(base).MethodByName(name); ok {
+		return c.call(s, n, "method "+name, m.Type, 1, args, final)
+	}
+	switch base.Kind() {
+	case reflect.Struct:
+		if f, ok := base.FieldByName(name); ok && f.PkgPath == "" {
+			if len(args) > 0 || final {
+				c.errorf(s, n, "%s.%s is a field, not a method, and can't receive arguments", base, name)
+			}
+			return knownType(f.Type)
+		}
+	case reflect.Interface:
+		return nil
+	}
+	c.errorf(s, n, "type %s has no field or method %q", typ, name)
+	return nil
+}
+
+func (c *checker) function(s *checkScope, n *parse.IdentifierNode, args []parse.Node, final bool) reflect.Type {
+	switch {
+	case n.Ident == topAssetsFuncName || n.Ident == bottomAssetsFuncName || n.Ident == flushFuncName || n.Ident == varNop:
+		return nil
+	case strings.HasPrefix(n.Ident, "html_"):
+		// Escaping functions added by html/template
+		return nil
+	}
+	info := c.t.funcMap[n.Ident]
+	if info == nil {
+		c.errorf(s, n, "undefined function %q", n.Ident)
+		c.args(s, args)
+		return nil
+	}
+	typ := reflect.TypeOf(info.f)
+	if typ.Kind() != reflect.Func {
+		return nil
+	}
+	return c.call(s, n, "function "+n.Ident, typ, traitsArgumentCount(info.traits), args, final)
+}
+
+// args checks the given arguments, when their
+// parameter types are not known.
+func (c *checker) args(s *checkScope, args []parse.Node) {
+	for _, v := range args {
+		c.arg(s, v)
+	}
+}
+
+// call checks a call to a function or method with the given type. first
+// is the index of the first parameter provided by the template, since the
+// receiver, the context and the state are provided by the interpreter.
+func (c *checker) call(s *checkScope, n parse.Node, name string, typ reflect.Type, first int, args []parse.Node, final bool) reflect.Type {
+	numIn := typ.NumIn() - first
+	argc := len(args)
+	if final {
+		argc++
+	}
+	if typ.IsVariadic() {
+		if argc < numIn-1 {
+			c.errorf(s, n, "%s requires at least %d arguments, %d given", name, numIn-1, argc)
+		}
+	} else if argc != numIn {
+		c.errorf(s, n, "%s requires %d arguments, %d given", name, numIn, argc)
+	}
+	for ii, v := range args {
+		at := c.arg(s, v)
+		if in := paramType(typ, first, ii); in != nil && at != nil && !assignable(at, in) {
+			c.errorf(s, v, "%s expects argument %d of type %s, not %s", name, ii+1, in, at)
+		}
+	}
+	if typ.NumOut() == 0 {
+		return nil
+	}
+	return knownType(typ.Out(0))
+}
+
+// paramType returns the type of the ii-th parameter provided
+// by the template, or nil if there's no such parameter.
+func paramType(typ reflect.Type, first int, ii int) reflect.Type {
+	p := first + ii
+	numIn := typ.NumIn()
+	if typ.IsVariadic() && p >= numIn-1 {
+		return typ.In(numIn - 1).Elem()
+	}
+	if p >= numIn {
+		return nil
+	}
+	return typ.In(p)
+}
+
+func assignable(from reflect.Type, to reflect.Type) bool {
+	if from.AssignableTo(to) {
+		return true
+	}
+	// The interpreter converts between numeric types
+	return types.IsNumeric(from) && types.IsNumeric(to)
+}
@@ -0,0 +1,106 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkgs.com/vfs.v1"
+)
+
+type checkItem struct {
+	Name  string
+	Price float64
+}
+
+func (i *checkItem) Discount(pct int) float64 {
+	return i.Price * float64(100-pct) / 100
+}
+
+type checkData struct {
+	Title string
+	Items []*checkItem
+	Tags  map[string]int
+	Any   interface{}
+}
+
+type checkTest struct {
+	tmpl   string
+	errors []string
+}
+
+var checkTests = []*checkTest{
+	{"{{ .Title }}{{ range .Items }}{{ .Name }} {{ .Discount 10 }}{{ end }}", nil},
+	{"{{ range $k, $v := .Tags }}{{ $k }}={{ add $v 1 }}{{ end }}", nil},
+	{"{{ with .Items }}{{ range . }}{{ .Price }}{{ end }}{{ end }}", nil},
+	{"{{ .Any.Whatever.Goes }}{{ printf \"%d\" .Tags.foo }}", nil},
+	{"{{ $it := index .Items 0 }}{{ $it.Nope }}", nil},
+	{"{{ .Titel }}", []string{"check.html:1:3: type *template.checkData has no field or method \"Titel\""}},
+	{"{{ range .Items }}{{ .Nam }}{{ end }}", []string{"check.html:1:21: type *template.checkItem has no field or method \"Nam\""}},
+	{"{{ range .Items }}{{ .Discount }}{{ end }}", []string{"check.html:1:21: method Discount requires 1 arguments, 0 given"}},
+	{"{{ range .Items }}{{ .Discount \"10\" }}{{ end }}", []string{"check.html:1:31: method Discount expects argument 1 of type int, not string"}},
+	{"{{ range $it := .Items }}{{ $it.Name.Foo }}{{ end }}", []string{"check.html:1:31: type string has no field or method \"Foo\""}},
+	{"{{ .Title 1 }}", []string{"check.html:1:3: template.checkData.Title is a field, not a method, and can't receive arguments"}},
+	{"{{ range .Title }}{{ end }}", []string{"check.html:1:9: can't range over string"}},
+	{"{{ to_lower }}", []string{"check.html:1:3: function to_lower requires 1 arguments, 0 given"}},
+	{"{{ @Foo }} {{ @Bar }}", []string{"check.html:1:8: undefined variable @Foo"}},
+	{"{{ @Bar.Name }} {{ @Bar.Age }}", []string{"check.html:1:29: type *template.checkItem has no field or method \"Age\""}},
+	{"{{ define \"item\" }}{{ .Name }}{{ .Cost }}{{ end }}{{ range .Items }}{{ template \"item\" . }}{{ end }}", []string{"check.html:1:64: type *template.checkItem has no field or method \"Cost\""}},
+}
+
+func TestCheck(t *testing.T) {
+	dot := reflect.TypeOf(&checkData{})
+	vars := map[string]reflect.Type{"Bar": reflect.TypeOf(&checkItem{})}
+	for _, v := range checkTests {
+		tmpl := parseNamedText(t, "check.html", v.tmpl, nil, "text/html")
+		if tmpl == nil {
+			continue
+		}
+		err := tmpl.Check(dot, vars)
+		var errs []string
+		if err != nil {
+			cerrs, ok := err.(CheckErrors)
+			if !ok {
+				t.Errorf("expecting CheckErrors from %q, got %T", v.tmpl, err)
+				continue
+			}
+			for _, e := range cerrs {
+				errs = append(errs, e.Error())
+			}
+		}
+		if strings.Join(errs, "\n") != strings.Join(v.errors, "\n") {
+			t.Errorf("checking %q, expecting errors %q, got %q", v.tmpl, v.errors, errs)
+		}
+	}
+}
+
+func TestCheckExtend(t *testing.T) {
+	files := map[string]*vfs.File{
+		"base.html": &vfs.File{Data: []byte("<html><head></head><body>{{ block \"content\" }}{{ end }}{{ extend }}{{ .Title }}</body></html>")},
+		"page.html": &vfs.File{Data: []byte("{{/*\n  extends: base.html\n*/}}{{ define \"content\" }}{{ range .Items }}{{ .Prize }}{{ end }}{{ end }}{{ .Any }}{{ .Tittle }}")},
+	}
+	fs, err := vfs.Map(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := New(fs, nil)
+	if err := tmpl.Parse("page.html"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	err = tmpl.Check(reflect.TypeOf(&checkData{}), nil)
+	expected := []string{
+		"page.html:3:47: type *template.checkItem has no field or method \"Prize\"",
+		"page.html:3:118: type *template.checkData has no field or method \"Tittle\"",
+	}
+	if err == nil {
+		t.Fatal("expecting errors checking extended template")
+	}
+	for _, v := range expected {
+		if !strings.Contains(err.Error(), v) {
+			t.Errorf("expecting error %q, got %q", v, err)
+		}
+	}
+}
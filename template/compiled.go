@@ -0,0 +1,298 @@
+package template
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"text/template/parse"
+)
+
+// CompiledFunc is the type of the functions generated by Generator,
+// which render a template tree without using the interpreter. Users
+// should not write CompiledFunc functions by hand.
+type CompiledFunc func(s *State, w *bytes.Buffer, dot interface{}) error
+
+type compiledTree struct {
+	dot reflect.Type
+	fn  CompiledFunc
+}
+
+var compiled struct {
+	sync.RWMutex
+	trees map[string][]*compiledTree
+}
+
+// RegisterCompiled registers a function generated by Generator for the
+// tree with the given hash. When a template containing a tree with the same
+// hash is compiled, the function is used to render the tree whenever its dot
+// has the given type (or always, if dot is nil). Since the hash is derived
+// from the tree after all the transformations (including HTML escaping) have
+// been applied, a stale function is never used. This function is called from
+// the init() function in the generated code, users should not need to call it.
+func RegisterCompiled(hash string, dot reflect.Type, fn CompiledFunc) {
+	compiled.Lock()
+	if compiled.trees == nil {
+		compiled.trees = make(map[string][]*compiledTree)
+	}
+	compiled.trees[hash] = append(compiled.trees[hash], &compiledTree{dot: dot, fn: fn})
+	compiled.Unlock()
+}
+
+func compiledTrees(hash string) []*compiledTree {
+	compiled.RLock()
+	defer compiled.RUnlock()
+	return compiled.trees[hash]
+}
+
+func hasCompiledTrees() bool {
+	compiled.RLock()
+	defer compiled.RUnlock()
+	return len(compiled.trees) > 0
+}
+
+// treeHash returns the hash used to match a tree with its generated
+// function. Besides the tree itself, it includes any settings which
+// alter the code generated from it.
+func (t *Template) treeHash(name string, tr *parse.Tree) string {
+	h := sha1.New()
+	io.WriteString(h, name)
+	io.WriteString(h, "\x00"+t.contentType)
+	fmt.Fprintf(h, "\x00%v\x00", t.tmpl.DropComments)
+	io.WriteString(h, tr.Root.String())
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (p *program) loadCompiled() {
+	if !hasCompiledTrees() {
+		return
+	}
+	for k, v := range p.tmpl.trees {
+		if c := compiledTrees(p.tmpl.treeHash(k, v)); len(c) > 0 {
+			if p.compiled == nil {
+				p.compiled = make(map[string][]*compiledTree)
+				p.fns = make(map[string]*fn, len(p.tmpl.funcMap))
+				for name, info := range p.tmpl.funcMap {
+					p.fns[name] = newFn(info, name)
+				}
+			}
+			p.compiled[k] = c
+		}
+	}
+}
+
+// compiledFunc returns the generated function for the given tree
+// and dot, or nil if there's none. Functions generated for a pointer
+// type are not used with nil pointers, so they don't need to check
+// for nil every time they access the dot.
+func (p *program) compiledFunc(tmpl string, dot reflect.Value) CompiledFunc {
+	for _, v := range p.compiled[tmpl] {
+		if v.dot == nil {
+			return v.fn
+		}
+		if dot.IsValid() && dot.Type() == v.dot {
+			if dot.Kind() == reflect.Ptr && dot.IsNil() {
+				return nil
+			}
+			return v.fn
+		}
+	}
+	return nil
+}
+
+// The following methods are used by the code generated by Generator.
+// They implement the same semantics as the interpreter for the parts
+// of the template which can't be resolved when generating the code.
+
+// Variable returns the value of the variable with the given name.
+func (s *State) Variable(name string) (interface{}, error) {
+	v, err := s.varValue(name)
+	if err != nil {
+		return nil, err
+	}
+	return valueInterface(v), nil
+}
+
+// Field returns the result of evaluating the given field or method
+// in v, passing the given arguments if it's a method.
+func (s *State) Field(v interface{}, name string, args ...interface{}) (interface{}, error) {
+	top := reflect.ValueOf(v)
+	if !top.IsValid() {
+		return nil, nil
+	}
+	if top.Kind() == reflect.Map && (top.Type().Key().Kind() == reflect.String || stringType.AssignableTo(top.Type().Key())) {
+		if top.IsNil() {
+			return nil, nil
+		}
+		return valueInterface(top.MapIndex(reflect.ValueOf(name).Convert(top.Type().Key()))), nil
+	}
+	ptr := top
+	if kind := ptr.Kind(); (kind == reflect.Interface || kind == reflect.Ptr) && ptr.IsNil() {
+		return nil, nil
+	}
+	fn := ptr.MethodByName(name)
+	if !fn.IsValid() && ptr.Kind() == reflect.Ptr && ptr.Type().Elem().Kind() == reflect.Interface {
+		ptr = ptr.Elem()
+		fn = ptr.MethodByName(name)
+	}
+	if fn.IsValid() {
+		return s.callArgs(fn, name, 0, nil, nil, args)
+	}
+	for top.Kind() == reflect.Ptr || top.Kind() == reflect.Interface {
+		if top.IsNil() {
+			return nil, nil
+		}
+		top = top.Elem()
+	}
+	if top.Kind() != reflect.Struct {
+		if err := s.requiresPointerErr(top, name); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("can't evaluate field on type %T", top.Interface())
+	}
+	res := top.FieldByName(name)
+	if !res.IsValid() {
+		if err := s.requiresPointerErr(top, name); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%q is not a field of struct type %T", name, top.Interface())
+	}
+	return valueInterface(res), nil
+}
+
+// Call calls the template function with the given name.
+func (s *State) Call(name string, args ...interface{}) (interface{}, error) {
+	fn := s.p.fns[name]
+	if fn == nil {
+		return nil, fmt.Errorf("undefined function %q", name)
+	}
+	var prepend []reflect.Value
+	if fn.traits&funcTraitState != 0 {
+		prepend = append(prepend, reflect.ValueOf(s))
+	}
+	if fn.traits&funcTraitContext != 0 {
+		prepend = append(prepend, s.context)
+	}
+	return s.callArgs(fn.val, name, fn.traits, fn.fp, prepend, args)
+}
+
+// callArgs pushes the arguments to the stack in the order expected by
+// s.call (reversed), calls fn and pops its result.
+func (s *State) callArgs(fn reflect.Value, name string, traits funcTrait, fp fastPath, prepend []reflect.Value, args []interface{}) (interface{}, error) {
+	pos := len(s.stack)
+	for ii := len(args) - 1; ii >= 0; ii-- {
+		s.stack = append(s.stack, reflect.ValueOf(args[ii]))
+	}
+	for ii := len(prepend) - 1; ii >= 0; ii-- {
+		s.stack = append(s.stack, prepend[ii])
+	}
+	if err := s.call(fn, name, len(prepend)+len(args), traits, fp); err != nil {
+		s.stack = s.stack[:pos]
+		return nil, err
+	}
+	res := s.stack[pos]
+	s.stack = s.stack[:pos]
+	return valueInterface(res), nil
+}
+
+// Print writes v to the output, like {{ v }} would.
+func (s *State) Print(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.IsValid() && rv.Type() == stringType {
+		_, err := s.w.WriteString(rv.String())
+		return err
+	}
+	val, doPrint, ok := printableValue(rv)
+	if !ok {
+		return fmt.Errorf("can't print value of type %s", rv.Type())
+	}
+	if doPrint {
+		_, err := fmt.Fprint(s.w, val)
+		return err
+	}
+	return nil
+}
+
+// IsTrue returns wheter v evaluates to true in an {{ if }}.
+func (s *State) IsTrue(v interface{}) bool {
+	return isTrue(reflect.ValueOf(v))
+}
+
+// Iterator iterates over a value in a {{ range }}.
+type Iterator interface {
+	// Next returns false when the iteration has ended. Otherwise,
+	// it returns true, the key or index and the value.
+	Next() (bool, interface{}, interface{})
+}
+
+type valueIterator struct {
+	iterator
+}
+
+func (it valueIterator) Next() (bool, interface{}, interface{}) {
+	next, k, v := it.iterator.Next()
+	if !next {
+		return false, nil, nil
+	}
+	return true, valueInterface(k), valueInterface(v)
+}
+
+// Iter returns an Iterator for ranging over v.
+func (s *State) Iter(v interface{}) (Iterator, error) {
+	it, err := newIterator(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return valueIterator{it}, nil
+}
+
+// Template executes the template with the given name and namespace,
+// using dot as its data.
+func (s *State) Template(name string, ns string, dot interface{}) error {
+	mark := s.varMark()
+	err := s.execute(name, ns, reflect.ValueOf(dot))
+	s.vars = s.vars[:mark]
+	return err
+}
+
+// Flush flushes the output when streaming.
+func (s *State) Flush() error {
+	if s.flush != nil && len(s.fragments) == 0 {
+		return s.flush()
+	}
+	return nil
+}
+
+// TopAssets returns the HTML for the assets at the top of the page.
+func (s *State) TopAssets() []byte {
+	return s.p.tmpl.topAssets
+}
+
+// BottomAssets returns the HTML for the assets at the bottom of the page.
+func (s *State) BottomAssets() []byte {
+	return s.p.tmpl.bottomAssets
+}
+
+// Escaper returns the HTML escaping function with the given name, which
+// must be one of the functions added by the HTML contextual escaping.
+func Escaper(name string) func(...interface{}) string {
+	switch x := htmlEscapeFuncs[name].(type) {
+	case func(...interface{}) string:
+		return x
+	case func(string) string:
+		return func(args ...interface{}) string {
+			return x(fmt.Sprint(args...))
+		}
+	}
+	panic(fmt.Errorf("unknown escaper %q", name))
+}
+
+func valueInterface(v reflect.Value) interface{} {
+	if v.IsValid() && v.CanInterface() {
+		return v.Interface()
+	}
+	return nil
+}
@@ -59,6 +59,7 @@ const (
 	opVAL
 	opVAR
 	opWB
+	opFLUSH
 )
 
 type valType uint32
@@ -216,6 +217,8 @@ type State struct {
 	res       []reflect.Value // used for storing return values in fast paths
 	resPtr    *reflect.Value
 	context   reflect.Value
+	flush     func() error // non-nil only when streaming
+	fragments []*fragment  // {{ cache }} blocks being rendered
 }
 
 func newState(p *program, w *bytes.Buffer) *State {
@@ -260,20 +263,12 @@ func (s *State) reset() {
 	s.marks = s.marks[:0]
 	s.dot = s.dot[:0]
 	s.iterators = s.iterators[:0]
+	s.flush = nil
+	s.fragments = s.fragments[:0]
 }
 
 func (s *State) formatTreeErr(name string, tr *parse.Tree, node parse.Node, err error) error {
-	loc, _ := tr.ErrorContext(node)
-	if loc != "" {
-		// Might to adjust the column due to the prepend varNop nodes
-		file, line, col, ok := splitErrorContext(loc)
-		if ok {
-			col -= s.p.tmpl.offsets[tr][line]
-			loc = fmt.Sprintf("%s:%d:%d", file, line, col)
-		}
-		err = fmt.Errorf("%s: %s", loc, err.Error())
-	}
-	return err
+	return s.p.tmpl.formatTreeErr(tr, node, err)
 }
 
 func (s *State) formatErr(pc int, tmpl string, err error) error {
@@ -457,6 +452,11 @@ func (s *State) execute(tmpl string, ns string, dot reflect.Value) (err error) {
 	}
 	var pc int
 	defer s.recover(&pc, &tmpl, &err)
+	if s.p.compiled != nil {
+		if fn := s.p.compiledFunc(tmpl, dot); fn != nil {
+			return fn(s, s.w, valueInterface(dot))
+		}
+	}
 	for pc = 0; pc < len(code); pc++ {
 		v := code[pc]
 		switch v.op {
@@ -650,6 +650,14 @@ func (s *State) execute(tmpl string, ns string, dot reflect.Value) (err error) {
 			if _, err := s.w.Write(s.p.bs[int(v.val)]); err != nil {
 				return s.formatErr(pc, tmpl, err)
 			}
+		case opFLUSH:
+			// Fragments being cached are read from the
+			// buffer, so it can't be flushed until they end.
+			if s.flush != nil && len(s.fragments) == 0 {
+				if err := s.flush(); err != nil {
+					return s.formatErr(pc, tmpl, err)
+				}
+			}
 		default:
 			return s.errorf(pc, tmpl, "invalid opcode %d", v.op)
 		}
@@ -861,6 +869,9 @@ type program struct {
 	bs       [][]byte
 	code     map[string][]inst
 	context  map[string][]*context
+	// trees with generated code, see RegisterCompiled
+	compiled map[string][]*compiledTree
+	fns      map[string]*fn
 	// used only during compilation
 	s *scratch
 }
@@ -1126,6 +1137,13 @@ func (p *program) walk(n parse.Node) error {
 			p.s.noPrint = true
 			break
 		}
+		if x.Ident == flushFuncName {
+			// Flushing is handled by the interpreter, which
+			// ignores opFLUSH when not streaming.
+			p.inst(opFLUSH, 0)
+			p.s.noPrint = true
+			break
+		}
 		name := x.Ident
 		if strings.HasPrefix(name, "html_") {
 			if p.s.noPrint {
@@ -1364,8 +1382,9 @@ func (p *program) stitch() {
 	p.stitchTree(p.tmpl.root)
 }
 
-func (p *program) execute(w *bytes.Buffer, name string, data interface{}, context interface{}, vars VarMap) error {
+func (p *program) execute(w *bytes.Buffer, name string, data interface{}, context interface{}, vars VarMap, flush func() error) error {
 	s := newState(p, w)
+	s.flush = flush
 	s.context = reflect.ValueOf(context)
 	s.pushVar("Vars", reflect.ValueOf(vars))
 	err := s.execute(name, "", reflect.ValueOf(data))
@@ -1396,6 +1415,7 @@ func newProgram(tmpl *Template) (*program, error) {
 		return nil, err
 	}
 	p.stitch()
+	p.loadCompiled()
 	return p, nil
 }
 
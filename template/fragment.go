@@ -0,0 +1,246 @@
+package template
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"text/template/parse"
+
+	"gnd.la/internal/templateutil"
+)
+
+const (
+	cacheFuncName    = "_gondola_cache"
+	cacheEndFuncName = "_gondola_end_cache"
+	fragmentPrefix   = "gnd.la/template:"
+	// keys longer than this are hashed
+	maxFragmentKeyLength = 200
+)
+
+// FragmentCache is the interface implemented by types which store the
+// fragments rendered by {{ cache }} blocks. The context passed to
+// ExecuteContext is passed to its methods, letting implementations
+// further namespace the keys (e.g. by language). Set the
+// FragmentCache field in Template to enable fragment caching.
+//
+// A {{ cache }} block takes the key, the timeout in seconds (0 means
+// no expiration) and, optionally, any number of additional values
+// which are appended to the key. The key is automatically namespaced
+// by the name of the template file which contains the block.
+//
+//	{{ cache "sidebar" 300 @Ctx.User.Id }}
+//	    ... expensive markup ...
+//	{{ end }}
+//
+// When the template is in debug mode or has no FragmentCache,
+// {{ cache }} blocks are always rendered.
+type FragmentCache interface {
+	// Get returns the fragment stored with the given key, or
+	// nil if there's no such fragment.
+	Get(context interface{}, key string) []byte
+	// Set stores the fragment with the given key and timeout.
+	Set(context interface{}, key string, data []byte, timeout int)
+}
+
+type fragment struct {
+	key     string
+	timeout int
+	start   int
+}
+
+// replaceCacheBlocks replaces {{ cache ... }} with an {{ if }}
+// calling cacheFuncName with the template name as the first
+// argument. The end of the block is added by addCacheEnds.
+// Actions are scanned like text/template does, so comments
+// and string literals which contain {{ cache }} are left
+// untouched.
+func replaceCacheBlocks(name string, s string) string {
+	var buf bytes.Buffer
+	for {
+		start := strings.Index(s, leftDelim)
+		if start < 0 {
+			break
+		}
+		start += len(leftDelim)
+		end := actionEnd(s[start:])
+		if end < 0 {
+			// Unterminated, let the parser report it
+			break
+		}
+		end += start
+		buf.WriteString(s[:start])
+		buf.WriteString(replaceCacheAction(name, s[start:end]))
+		buf.WriteString(rightDelim)
+		s = s[end+len(rightDelim):]
+	}
+	buf.WriteString(s)
+	return buf.String()
+}
+
+func isTrimSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// actionEnd returns the index of the right delimiter which ends the
+// action starting at s (just after the left delimiter), skipping
+// comments and quoted strings, or -1 if there's none.
+func actionEnd(s string) int {
+	p := 0
+	if len(s) > 1 && s[0] == '-' && isTrimSpace(s[1]) {
+		p = 2
+	}
+	if strings.HasPrefix(s[p:], "/*") {
+		c := strings.Index(s[p:], "*/")
+		if c < 0 {
+			return -1
+		}
+		p += c + 2
+		if e := strings.Index(s[p:], rightDelim); e >= 0 {
+			return p + e
+		}
+		return -1
+	}
+	for ; p < len(s); p++ {
+		switch c := s[p]; c {
+		case '"', '\'', '`':
+			// Skip the quoted string. Raw strings
+			// don't have escape sequences.
+			for p++; p < len(s) && s[p] != c; p++ {
+				if c != '`' && s[p] == '\\' {
+					p++
+				}
+			}
+		default:
+			if strings.HasPrefix(s[p:], rightDelim) {
+				return p
+			}
+		}
+	}
+	return -1
+}
+
+// replaceCacheAction returns the action, without delimiters, with
+// cache replaced by an if calling cacheFuncName. Other actions are
+// returned unchanged.
+func replaceCacheAction(name string, action string) string {
+	var leftTrim, rightTrim string
+	body := action
+	if len(body) > 1 && body[0] == '-' && isTrimSpace(body[1]) {
+		leftTrim = "-"
+		body = body[1:]
+	}
+	if n := len(body); n > 1 && body[n-1] == '-' && isTrimSpace(body[n-2]) {
+		rightTrim = "-"
+		body = body[:n-1]
+	}
+	body = strings.TrimSpace(body)
+	const keyword = "cache"
+	if !strings.HasPrefix(body, keyword) || (len(body) > len(keyword) && !isTrimSpace(body[len(keyword)])) {
+		return action
+	}
+	args := strings.TrimSpace(body[len(keyword):])
+	return fmt.Sprintf("%s if %s %q %s %s", leftTrim, cacheFuncName, name, args, rightTrim)
+}
+
+func isCacheBlock(n parse.Node) bool {
+	if in, ok := n.(*parse.IfNode); ok && len(in.Pipe.Cmds) > 0 {
+		if id, ok := in.Pipe.Cmds[0].Args[0].(*parse.IdentifierNode); ok {
+			return id.Ident == cacheFuncName
+		}
+	}
+	return false
+}
+
+// addCacheEnds adds a call to cacheEndFuncName at the end
+// of every {{ cache }} block, to store the rendered fragment.
+func addCacheEnds(treeMap map[string]*parse.Tree) error {
+	var err error
+	for _, v := range treeMap {
+		tr := v
+		templateutil.WalkTree(tr, func(n, p parse.Node) {
+			if err != nil || !isCacheBlock(n) {
+				return
+			}
+			in := n.(*parse.IfNode)
+			if in.ElseList != nil {
+				loc, _ := tr.ErrorContext(n)
+				err = fmt.Errorf("%s: {{ cache }} blocks can't have an {{ else }}", loc)
+				return
+			}
+			cmd := &parse.CommandNode{
+				NodeType: parse.NodeCommand,
+				Pos:      n.Position(),
+				Args:     []parse.Node{parse.NewIdentifier(cacheEndFuncName).SetPos(n.Position())},
+			}
+			end := &parse.ActionNode{
+				NodeType: parse.NodeAction,
+				Pos:      n.Position(),
+				Pipe: &parse.PipeNode{
+					NodeType: parse.NodePipe,
+					Pos:      n.Position(),
+					Cmds:     []*parse.CommandNode{cmd},
+				},
+			}
+			in.List.Nodes = append(in.List.Nodes, end)
+		})
+	}
+	return err
+}
+
+func fragmentKey(file string, key interface{}, values []interface{}) string {
+	k := fmt.Sprint(key)
+	if len(values) > 0 {
+		s := make([]string, len(values)+1)
+		s[0] = k
+		for ii, v := range values {
+			s[ii+1] = fmt.Sprint(v)
+		}
+		k = strings.Join(s, ":")
+	}
+	k = fragmentPrefix + file + ":" + k
+	if len(k) > maxFragmentKeyLength || strings.ContainsAny(k, " \t\r\n") {
+		h := sha1.Sum([]byte(k))
+		k = fragmentPrefix + file + ":" + hex.EncodeToString(h[:])
+	}
+	return k
+}
+
+func (s *State) contextInterface() interface{} {
+	if s.context.IsValid() {
+		return s.context.Interface()
+	}
+	return nil
+}
+
+// beginCache is called at the start of a {{ cache }} block. If the
+// fragment is cached, it's written to the output and the block is
+// skipped. Otherwise, the block is rendered and stored by endCache.
+func beginCache(s *State, file string, key interface{}, timeout int, values ...interface{}) bool {
+	fc := s.p.tmpl.FragmentCache
+	if fc == nil || s.p.tmpl.Debug {
+		s.fragments = append(s.fragments, nil)
+		return true
+	}
+	k := fragmentKey(file, key, values)
+	if data := fc.Get(s.contextInterface(), k); data != nil {
+		s.w.Write(data)
+		return false
+	}
+	s.fragments = append(s.fragments, &fragment{key: k, timeout: timeout, start: s.w.Len()})
+	return true
+}
+
+func endCache(s *State) string {
+	p := len(s.fragments) - 1
+	f := s.fragments[p]
+	s.fragments = s.fragments[:p]
+	if f != nil {
+		b := s.w.Bytes()[f.start:]
+		data := make([]byte, len(b))
+		copy(data, b)
+		s.p.tmpl.FragmentCache.Set(s.contextInterface(), f.key, data, f.timeout)
+	}
+	return ""
+}
@@ -0,0 +1,141 @@
+package template
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkgs.com/vfs.v1"
+)
+
+type testFragmentCache struct {
+	items map[string][]byte
+	sets  int
+}
+
+func (c *testFragmentCache) Get(context interface{}, key string) []byte {
+	return c.items[key]
+}
+
+func (c *testFragmentCache) Set(context interface{}, key string, data []byte, timeout int) {
+	c.items[key] = data
+	c.sets++
+}
+
+func TestFragmentCache(t *testing.T) {
+	const text = "a{{ cache \"frag\" 60 .Id }}<i>{{ call .F }}</i>{{ cache \"inner\" 0 }}[{{ call .F }}]{{ end }}{{ end }}b"
+	tmpl := parseNamedText(t, "fragment.html", text, nil, "text/html")
+	fc := &testFragmentCache{items: make(map[string][]byte)}
+	tmpl.FragmentCache = fc
+	calls := 0
+	data := map[string]interface{}{
+		"Id": 1,
+		"F": func() int {
+			calls++
+			return calls
+		},
+	}
+	execute := func(expected string) {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != expected {
+			t.Errorf("expecting %q, got %q", expected, buf.String())
+		}
+	}
+	execute("a<i>1</i>[2]b")
+	if fc.sets != 2 {
+		t.Errorf("expecting 2 fragments stored, got %d", fc.sets)
+	}
+	if s := string(fc.items["gnd.la/template:fragment.html:frag:1"]); s != "<i>1</i>[2]" {
+		t.Errorf("unexpected cached fragment %q", s)
+	}
+	// Cached
+	execute("a<i>1</i>[2]b")
+	// Different key
+	data["Id"] = 2
+	execute("a<i>3</i>[2]b")
+	// Debug mode bypasses the cache
+	tmpl.Debug = true
+	execute("a<i>4</i>[5]b")
+}
+
+func TestFragmentCacheStreaming(t *testing.T) {
+	tmpl := parseNamedText(t, "fragment.html", "a{{ flush }}{{ cache \"frag\" 0 }}b{{ flush }}c{{ end }}", nil, "text/plain")
+	fc := &testFragmentCache{items: make(map[string][]byte)}
+	tmpl.FragmentCache = fc
+	tmpl.Streaming = true
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "abc" {
+		t.Errorf("expecting abc, got %q", buf.String())
+	}
+	if s := string(fc.items["gnd.la/template:fragment.html:frag"]); s != "bc" {
+		t.Errorf("expecting cached fragment bc, got %q", s)
+	}
+}
+
+func TestFragmentCacheElse(t *testing.T) {
+	fs, err := vfs.Map(map[string]*vfs.File{"else.html": &vfs.File{Data: []byte("{{ cache \"k\" 0 }}a{{ else }}b{{ end }}")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := New(fs, nil)
+	err = tmpl.Parse("else.html")
+	if err == nil || !strings.Contains(err.Error(), "can't have an {{ else }}") {
+		t.Errorf("expecting {{ else }} error, got %v", err)
+	}
+}
+
+func TestFragmentCacheSyntax(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected string
+		cached   string
+	}{
+		// Trim markers
+		{"a {{- cache \"k\" 0 -}} b {{- end }} c", "ab c", "b"},
+		{"{{cache \"k\" 0}}b{{end}}", "b", "b"},
+		// Not a cache block
+		{"{{/* {{ cache \"k\" 0 }} */}}b", "b", ""},
+		{"{{- /* {{ cache \"k\" 0 }} */ -}} b", "b", ""},
+		{"{{ \"{{ cache \\\"k\\\" 0 }}\" }}", "{{ cache \"k\" 0 }}", ""},
+		{"{{ `{{ cache \"k\" 0 }}` }}", "{{ cache \"k\" 0 }}", ""},
+		{"{{ \"}}\" }}{{ cache \"k\" 0 }}b{{ end }}", "}}b", "b"},
+	}
+	for _, v := range cases {
+		tmpl := parseNamedText(t, "syntax.html", v.text, nil, "text/plain")
+		if tmpl == nil {
+			continue
+		}
+		fc := &testFragmentCache{items: make(map[string][]byte)}
+		tmpl.FragmentCache = fc
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, nil); err != nil {
+			t.Errorf("error executing %q: %s", v.text, err)
+			continue
+		}
+		if buf.String() != v.expected {
+			t.Errorf("expecting %q executing %q, got %q", v.expected, v.text, buf.String())
+		}
+		if s := string(fc.items["gnd.la/template:syntax.html:k"]); s != v.cached {
+			t.Errorf("expecting cached fragment %q executing %q, got %q", v.cached, v.text, s)
+		}
+	}
+}
+
+func TestFragmentKey(t *testing.T) {
+	if k := fragmentKey("f.html", "k", []interface{}{1, "a"}); k != "gnd.la/template:f.html:k:1:a" {
+		t.Errorf("unexpected key %q", k)
+	}
+	long := fragmentKey("f.html", strings.Repeat("k", 300), nil)
+	if len(long) > maxFragmentKeyLength {
+		t.Errorf("long key was not hashed, got %q", long)
+	}
+	if k := fragmentKey("f.html", "with spaces", nil); strings.Contains(k, " ") {
+		t.Errorf("key with spaces was not hashed, got %q", k)
+	}
+}
@@ -403,6 +403,12 @@ var templateFuncs = makeFuncMap(FuncMap{
 
 	// !Pseudo-functions which act as custom tags
 	"extend": nop,
+	// Flush the output rendered so far when streaming. Otherwise,
+	// it does nothing.
+	flushFuncName: nop,
+	// Begin and end {{ cache }} blocks
+	"@" + cacheFuncName:    beginCache,
+	"@" + cacheEndFuncName: endCache,
 	// !Used to make the parser parse undefined
 	// variables, since we allow variable
 	// inheritance to subtemplates
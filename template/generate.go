@@ -0,0 +1,1236 @@
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template/parse"
+	"time"
+	"unicode"
+
+	"gnd.la/internal/templateutil"
+	"gnd.la/log"
+	"gnd.la/util/types"
+)
+
+// NoCompiledTag is the build tag which excludes the code
+// generated by Generator from the build, making all the
+// templates use the interpreter.
+const NoCompiledTag = "gondola_no_compiled_templates"
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	intType  = reflect.TypeOf(0)
+)
+
+// Generator generates Go code for rendering templates ahead of time,
+// avoiding the interpreter. The generated code accesses fields, calls
+// methods and ranges over slices and maps directly when their types
+// are known at generation time, while the HTML escaping functions are
+// chosen using the contextual analysis performed when the template
+// is compiled. Everything else (e.g. template functions, variables
+// or values of unknown types) falls back to the same runtime used by
+// the interpreter, and trees which can't be generated at all are
+// left to the interpreter.
+//
+// Generated functions are registered in an init() function and
+// picked up when a matching template is compiled (see RegisterCompiled),
+// so templates are still loaded and compiled as usual. Any change to a
+// template makes it use the interpreter until the code is generated
+// again.
+//
+// Most users should not use Generator directly, but rather declare
+// the templates in the template section of their genfile.yaml and
+// use gondola gen.
+type Generator struct {
+	// Comment is written right after the package clause.
+	Comment   string
+	pkgName   string
+	pkgPath   string
+	imports   map[string]string
+	names     map[string]bool
+	escapers  map[string]string
+	funcs     bytes.Buffer
+	inits     bytes.Buffer
+	generated map[generatedKey]bool
+	count     int
+}
+
+type generatedKey struct {
+	hash string
+	dot  reflect.Type
+}
+
+// NewGenerator returns a new Generator, which generates code
+// for the package with the given name and import path.
+func NewGenerator(pkgName string, pkgPath string) *Generator {
+	return &Generator{
+		pkgName:   pkgName,
+		pkgPath:   pkgPath,
+		imports:   make(map[string]string),
+		names:     map[string]bool{pkgName: true},
+		escapers:  make(map[string]string),
+		generated: make(map[generatedKey]bool),
+	}
+}
+
+// Add generates the code for the given compiled template, using dot
+// as the type of its data. The template is checked first, using
+// dot and vars (see Template.Check), and any errors are returned.
+func (g *Generator) Add(t *Template, dot reflect.Type, vars map[string]reflect.Type) error {
+	if t.prog == nil {
+		return errors.New("template must be compiled before generating code")
+	}
+	if err := t.Check(dot, vars); err != nil {
+		return err
+	}
+	queue := []*templateCall{{name: t.root, dot: dot}}
+	for len(queue) > 0 {
+		call := queue[0]
+		queue = queue[1:]
+		tr := t.trees[call.name]
+		if tr == nil {
+			continue
+		}
+		typ := g.dotType(call.dot)
+		hash := t.treeHash(call.name, tr)
+		key := generatedKey{hash: hash, dot: typ}
+		if g.generated[key] {
+			continue
+		}
+		g.generated[key] = true
+		g.count++
+		fn := fmt.Sprintf("tmpl%d", g.count)
+		tg := &treeGen{g: g, t: t, name: call.name, tree: tr}
+		src, err := tg.generate(fn, typ)
+		if err != nil {
+			log.Debugf("not generating code for %s (%s), it will be interpreted: %s", call.name, tr.ParseName, err)
+			templateutil.WalkTree(tr, func(n, p parse.Node) {
+				if tn, ok := n.(*parse.TemplateNode); ok {
+					queue = append(queue, &templateCall{name: tn.Name})
+				}
+			})
+			continue
+		}
+		queue = append(queue, tg.calls...)
+		g.funcs.Write(src)
+		dotExpr := "nil"
+		if typ != nil {
+			expr, _ := g.typeExpr(typ)
+			dotExpr = fmt.Sprintf("%s.TypeOf((*%s)(nil)).Elem()", g.importName("reflect"), expr)
+		}
+		fmt.Fprintf(&g.inits, "%s.RegisterCompiled(%q, %s, %s)\n", g.importName("gnd.la/template"), hash, dotExpr, fn)
+	}
+	return nil
+}
+
+// Source returns the generated code, formatted with gofmt.
+func (g *Generator) Source() ([]byte, error) {
+	var body bytes.Buffer
+	if len(g.escapers) > 0 {
+		names := make([]string, 0, len(g.escapers))
+		for k := range g.escapers {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		body.WriteString("var (\n")
+		for _, v := range names {
+			fmt.Fprintf(&body, "%s = %s.Escaper(%q)\n", g.escapers[v], g.importName("gnd.la/template"), v)
+		}
+		body.WriteString(")\n\n")
+	}
+	if g.inits.Len() > 0 {
+		body.WriteString("func init() {\n")
+		body.Write(g.inits.Bytes())
+		body.WriteString("}\n\n")
+	}
+	body.Write(g.funcs.Bytes())
+	used, err := usedImports(body.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// +build !%s\n\n", NoCompiledTag)
+	fmt.Fprintf(&buf, "package %s\n\n", g.pkgName)
+	if g.Comment != "" {
+		buf.WriteString(g.Comment)
+		buf.WriteString("\n\n")
+	}
+	var std, other []string
+	for k, v := range g.imports {
+		if used[v] {
+			if strings.Contains(strings.SplitN(k, "/", 2)[0], ".") {
+				other = append(other, k)
+			} else {
+				std = append(std, k)
+			}
+		}
+	}
+	if len(std)+len(other) > 0 {
+		sort.Strings(std)
+		sort.Strings(other)
+		buf.WriteString("import (\n")
+		for ii, v := range append(std, other...) {
+			if ii == len(std) && ii > 0 {
+				buf.WriteByte('\n')
+			}
+			if name := g.imports[v]; name != path.Base(v) {
+				fmt.Fprintf(&buf, "%s %q\n", name, v)
+			} else {
+				fmt.Fprintf(&buf, "%q\n", v)
+			}
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// usedImports returns the package names referenced by src.
+func usedImports(src []byte) (map[string]bool, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", append([]byte("package p\n"), src...), 0)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generated code: %s", err)
+	}
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+	return used, nil
+}
+
+func (g *Generator) importName(p string) string {
+	if name := g.imports[p]; name != "" {
+		return name
+	}
+	base := path.Base(p)
+	if idx := strings.IndexByte(base, '.'); idx > 0 {
+		// e.g. gopkgs.com/vfs.v1
+		base = base[:idx]
+	}
+	base = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return '_'
+	}, base)
+	name := base
+	for ii := 2; g.names[name] || token.Lookup(name).IsKeyword(); ii++ {
+		name = base + strconv.Itoa(ii)
+	}
+	g.names[name] = true
+	g.imports[p] = name
+	return name
+}
+
+func (g *Generator) escaper(name string) string {
+	if v := g.escapers[name]; v != "" {
+		return v
+	}
+	v := fmt.Sprintf("esc%d", len(g.escapers)+1)
+	g.escapers[name] = v
+	return v
+}
+
+func (g *Generator) canImport(p string) bool {
+	if p == "main" || strings.Contains(p, "/vendor/") {
+		return false
+	}
+	if p == "internal" || strings.HasPrefix(p, "internal/") {
+		return false
+	}
+	if idx := strings.LastIndex(p, "/internal"); idx >= 0 {
+		if rem := p[idx+len("/internal"):]; rem == "" || rem[0] == '/' {
+			root := p[:idx]
+			return g.pkgPath == root || strings.HasPrefix(g.pkgPath, root+"/")
+		}
+	}
+	return true
+}
+
+// typeExpr returns the Go expression for the given type in the
+// generated package, or false if the type can't be referenced
+// from it.
+func (g *Generator) typeExpr(t reflect.Type) (string, bool) {
+	if name := t.Name(); name != "" {
+		if strings.ContainsAny(name, "[]") || t.Kind() == reflect.UnsafePointer {
+			return "", false
+		}
+		if t.PkgPath() == "" {
+			return name, true
+		}
+		if t.PkgPath() == g.pkgPath {
+			return name, true
+		}
+		if !ast.IsExported(name) || !g.canImport(t.PkgPath()) {
+			return "", false
+		}
+		return g.importName(t.PkgPath()) + "." + name, true
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		if elem, ok := g.typeExpr(t.Elem()); ok {
+			return "*" + elem, true
+		}
+	case reflect.Slice:
+		if elem, ok := g.typeExpr(t.Elem()); ok {
+			return "[]" + elem, true
+		}
+	case reflect.Array:
+		if elem, ok := g.typeExpr(t.Elem()); ok {
+			return fmt.Sprintf("[%d]%s", t.Len(), elem), true
+		}
+	case reflect.Map:
+		key, ok1 := g.typeExpr(t.Key())
+		elem, ok2 := g.typeExpr(t.Elem())
+		if ok1 && ok2 {
+			return fmt.Sprintf("map[%s]%s", key, elem), true
+		}
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "interface{}", true
+		}
+	}
+	return "", false
+}
+
+// dotType returns the type used for the dot of a generated tree. Only
+// concrete types which can be referenced from the generated code are
+// used, since the generated function is selected by the type of the
+// dot at runtime. Otherwise, the dot is handled dynamically.
+func (g *Generator) dotType(t reflect.Type) reflect.Type {
+	t = knownType(t)
+	if t == nil || t.Kind() == reflect.Interface {
+		return nil
+	}
+	if _, ok := g.typeExpr(t); !ok {
+		return nil
+	}
+	return t
+}
+
+type templateCall struct {
+	name string
+	dot  reflect.Type
+}
+
+// genValue represents a value in the generated code.
+type genValue struct {
+	// Go expression, usually a variable
+	expr string
+	// nil means the value has interface{} type and it's
+	// handled dynamically, with nil meaning no value.
+	typ reflect.Type
+	// If non-empty, a bool expression which indicates if
+	// the value is valid. e.g. a field accessed from a
+	// nil pointer or a missing key in a map, which the
+	// interpreter handles as "no value".
+	valid string
+	// Known to be non-nil
+	nonNil bool
+	// Untyped constant
+	konst bool
+}
+
+type genScope struct {
+	dot  *genValue
+	root *genValue
+	vars map[string]*genValue
+	// Variables declared in the tree, in order
+	declared []string
+}
+
+func (s *genScope) child() *genScope {
+	c := &genScope{dot: s.dot, root: s.root, vars: make(map[string]*genValue, len(s.vars))}
+	for k, v := range s.vars {
+		c.vars[k] = v
+	}
+	c.declared = append(c.declared, s.declared...)
+	return c
+}
+
+type treeGen struct {
+	g     *Generator
+	t     *Template
+	name  string
+	tree  *parse.Tree
+	buf   bytes.Buffer
+	start int
+	n     int
+	calls []*templateCall
+}
+
+func (g *treeGen) generate(fn string, dot reflect.Type) ([]byte, error) {
+	tmpl := g.g.importName("gnd.la/template")
+	fmt.Fprintf(&g.buf, "// %s renders %q from %s.\n", fn, g.name, g.tree.ParseName)
+	fmt.Fprintf(&g.buf, "func %s(s *%s.State, w *%s.Buffer, dot interface{}) error {\n", fn, tmpl, g.g.importName("bytes"))
+	d := &genValue{expr: "d0"}
+	if dot != nil {
+		expr, _ := g.g.typeExpr(dot)
+		g.p("d0 := dot.(%s)", expr)
+		d.typ = dot
+		// The interpreter is used for nil pointers, see compiledFunc
+		d.nonNil = true
+	} else {
+		g.p("d0 := dot")
+	}
+	g.p("_ = d0")
+	g.start = g.buf.Len()
+	s := &genScope{dot: d, root: d, vars: make(map[string]*genValue)}
+	if err := g.walk(s, g.tree.Root); err != nil {
+		return nil, err
+	}
+	g.p("return nil")
+	g.p("}\n")
+	return g.buf.Bytes(), nil
+}
+
+func (g *treeGen) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+	g.buf.WriteByte('\n')
+}
+
+func (g *treeGen) temp() string {
+	g.n++
+	return fmt.Sprintf("v%d", g.n)
+}
+
+func (g *treeGen) unsupported(n parse.Node, format string, args ...interface{}) error {
+	return g.t.formatTreeErr(g.tree, n, fmt.Errorf(format, args...))
+}
+
+// returnErr emits the code for returning err (a Go expression)
+// prefixed with the location of n.
+func (g *treeGen) returnErr(n parse.Node, err string) {
+	loc := strings.TrimSuffix(g.t.formatTreeErr(g.tree, n, errors.New("")).Error(), ": ")
+	g.p("return %s.Errorf(\"%%s: %%s\", %q, %s)", g.g.importName("fmt"), loc, err)
+}
+
+func (g *treeGen) walk(s *genScope, n parse.Node) error {
+	switch x := n.(type) {
+	case *parse.ListNode:
+		for _, v := range x.Nodes {
+			if err := g.walk(s, v); err != nil {
+				return err
+			}
+		}
+	case *parse.TextNode:
+		text := x.Text
+		if g.buf.Len() == g.start && len(text) > 1 && strings.Contains(g.t.contentType, "html") && text[0] == '\n' && text[1] == '<' {
+			text = text[1:]
+		}
+		if len(text) > 0 {
+			g.p("w.WriteString(%s)", strconv.Quote(string(text)))
+		}
+	case *parse.ActionNode:
+		v, err := g.pipe(s, x.Pipe, true)
+		if err != nil {
+			return err
+		}
+		if v != nil && len(x.Pipe.Decl) == 0 {
+			g.print(x, v)
+		}
+	case *parse.IfNode:
+		return g.branch(s, parse.NodeIf, &x.BranchNode)
+	case *parse.WithNode:
+		return g.branch(s, parse.NodeWith, &x.BranchNode)
+	case *parse.RangeNode:
+		return g.rangeBranch(s, x)
+	case *parse.TemplateNode:
+		return g.template(s, x)
+	default:
+		return g.unsupported(n, "can't generate code for node %T", n)
+	}
+	return nil
+}
+
+func (g *treeGen) print(n parse.Node, v *genValue) {
+	if v.typ == nil {
+		g.p("if err := s.Print(%s); err != nil {", v.expr)
+		g.returnErr(n, "err")
+		g.p("}")
+		return
+	}
+	if v.valid != "" {
+		g.p("if %s {", v.valid)
+		defer g.p("}")
+	}
+	typ := v.typ
+	if typ.NumMethod() == 0 && reflect.PtrTo(typ).NumMethod() == 0 {
+		switch {
+		case typ == stringType:
+			g.p("w.WriteString(%s)", v.expr)
+			return
+		case typ.Kind() == reflect.String:
+			g.p("w.WriteString(string(%s))", v.expr)
+			return
+		case typ.Kind() == reflect.Bool:
+			g.p("w.WriteString(%s.FormatBool(bool(%s)))", g.g.importName("strconv"), v.expr)
+			return
+		case types.IsInt(typ):
+			g.p("w.WriteString(%s.FormatInt(int64(%s), 10))", g.g.importName("strconv"), v.expr)
+			return
+		case types.IsUint(typ):
+			g.p("w.WriteString(%s.FormatUint(uint64(%s), 10))", g.g.importName("strconv"), v.expr)
+			return
+		}
+	}
+	g.p("if err := s.Print(%s); err != nil {", v.expr)
+	g.returnErr(n, "err")
+	g.p("}")
+}
+
+// dynamic returns an expression for v as an interface{}, where
+// nil represents no value.
+func (g *treeGen) dynamic(v *genValue) string {
+	if v.typ == nil || v.valid == "" {
+		return v.expr
+	}
+	t := g.temp()
+	g.p("var %s interface{}", t)
+	g.p("if %s {", v.valid)
+	g.p("%s = %s", t, v.expr)
+	g.p("}")
+	return t
+}
+
+// truth returns an expression which evaluates v as a condition.
+func (g *treeGen) truth(v *genValue) string {
+	if v.typ == nil {
+		return fmt.Sprintf("s.IsTrue(%s)", v.expr)
+	}
+	var cond string
+	switch typ := v.typ; typ.Kind() {
+	case reflect.Bool:
+		cond = v.expr
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		cond = fmt.Sprintf("len(%s) > 0", v.expr)
+	case reflect.Chan, reflect.Func, reflect.Ptr, reflect.Interface:
+		if v.nonNil {
+			cond = "true"
+		} else {
+			cond = fmt.Sprintf("%s != nil", v.expr)
+		}
+	case reflect.Struct:
+		if typ == timeType {
+			cond = fmt.Sprintf("!%s.IsZero()", v.expr)
+		} else {
+			cond = "true"
+		}
+	default:
+		cond = fmt.Sprintf("%s != 0", v.expr)
+	}
+	if v.valid != "" {
+		cond = fmt.Sprintf("%s && (%s)", v.valid, cond)
+	}
+	return cond
+}
+
+// pipe returns the value of the given pipe, or nil if the pipe
+// doesn't produce a value which should be printed.
+func (g *treeGen) pipe(s *genScope, pipe *parse.PipeNode, declare bool) (*genValue, error) {
+	var final *genValue
+	for _, cmd := range pipe.Cmds {
+		v, err := g.command(s, cmd, final)
+		if err != nil {
+			return nil, err
+		}
+		final = v
+	}
+	if declare && len(pipe.Decl) > 0 {
+		if final == nil {
+			return nil, g.unsupported(pipe, "can't declare variable from a pipe without value")
+		}
+		if len(pipe.Decl) > 1 {
+			return nil, g.unsupported(pipe, "too many declarations")
+		}
+		if err := g.declare(s, pipe.Decl[0], final, pipe.IsAssign); err != nil {
+			return nil, err
+		}
+	}
+	return final, nil
+}
+
+func (g *treeGen) declare(s *genScope, n *parse.VariableNode, v *genValue, assign bool) error {
+	name := n.Ident[0][1:]
+	if assign {
+		prev := s.vars[name]
+		if prev == nil || prev.typ != v.typ || (prev.valid == "") != (v.valid == "") {
+			return g.unsupported(n, "can't assign to variable %s", n.Ident[0])
+		}
+		g.p("%s = %s", prev.expr, v.expr)
+		if prev.valid != "" {
+			g.p("%s = %s", prev.valid, v.valid)
+		}
+		return nil
+	}
+	decl := &genValue{expr: g.temp(), typ: v.typ, nonNil: v.nonNil}
+	switch {
+	case v.typ == nil:
+		g.p("var %s interface{} = %s", decl.expr, v.expr)
+	case v.konst:
+		if expr, ok := g.g.typeExpr(v.typ); ok {
+			g.p("var %s %s = %s", decl.expr, expr, v.expr)
+		} else {
+			g.p("%s := %s", decl.expr, v.expr)
+		}
+	default:
+		g.p("%s := %s", decl.expr, v.expr)
+	}
+	g.p("_ = %s", decl.expr)
+	if v.valid != "" {
+		decl.valid = decl.expr + "ok"
+		g.p("%s := %s", decl.valid, v.valid)
+	}
+	s.vars[name] = decl
+	s.declared = append(s.declared, name)
+	return nil
+}
+
+func (g *treeGen) command(s *genScope, cmd *parse.CommandNode, final *genValue) (*genValue, error) {
+	rest := cmd.Args[1:]
+	switch x := cmd.Args[0].(type) {
+	case *parse.FieldNode:
+		return g.fields(s, x, s.dot, x.Ident, rest, final)
+	case *parse.ChainNode:
+		base, err := g.arg(s, x.Node)
+		if err != nil {
+			return nil, err
+		}
+		return g.fields(s, x, base, x.Field, rest, final)
+	case *parse.VariableNode:
+		base, err := g.variable(s, x)
+		if err != nil {
+			return nil, err
+		}
+		if len(x.Ident) > 1 {
+			return g.fields(s, x, base, x.Ident[1:], rest, final)
+		}
+		if len(rest) > 0 || final != nil {
+			return nil, g.unsupported(x, "can't give arguments to variable %s", x.Ident[0])
+		}
+		return base, nil
+	case *parse.IdentifierNode:
+		return g.function(s, x, rest, final)
+	}
+	if len(rest) > 0 || final != nil {
+		return nil, g.unsupported(cmd, "can't give arguments to %s", cmd.Args[0])
+	}
+	return g.arg(s, cmd.Args[0])
+}
+
+func (g *treeGen) arg(s *genScope, n parse.Node) (*genValue, error) {
+	switch x := n.(type) {
+	case *parse.DotNode:
+		return s.dot, nil
+	case *parse.FieldNode:
+		return g.fields(s, x, s.dot, x.Ident, nil, nil)
+	case *parse.ChainNode:
+		base, err := g.arg(s, x.Node)
+		if err != nil {
+			return nil, err
+		}
+		return g.fields(s, x, base, x.Field, nil, nil)
+	case *parse.VariableNode:
+		base, err := g.variable(s, x)
+		if err != nil {
+			return nil, err
+		}
+		return g.fields(s, x, base, x.Ident[1:], nil, nil)
+	case *parse.IdentifierNode:
+		return g.function(s, x, nil, nil)
+	case *parse.PipeNode:
+		v, err := g.pipe(s, x, false)
+		if err == nil && v == nil {
+			err = g.unsupported(x, "pipe does not produce a value")
+		}
+		return v, err
+	case *parse.StringNode:
+		return &genValue{expr: strconv.Quote(x.Text), typ: stringType, konst: true}, nil
+	case *parse.BoolNode:
+		return &genValue{expr: strconv.FormatBool(x.True), typ: reflect.TypeOf(x.True), konst: true}, nil
+	case *parse.NumberNode:
+		switch {
+		case x.IsComplex:
+		case x.IsFloat && (strings.Contains(x.Text, ".") || strings.Contains(strings.ToLower(x.Text), "e")):
+			return &genValue{expr: strconv.FormatFloat(x.Float64, 'g', -1, 64), typ: reflect.TypeOf(x.Float64), konst: true}, nil
+		case x.IsInt:
+			return &genValue{expr: strconv.FormatInt(x.Int64, 10), typ: intType, konst: true}, nil
+		}
+		return nil, g.unsupported(x, "unsupported number %s", x.Text)
+	case *parse.NilNode:
+		return &genValue{expr: "nil"}, nil
+	}
+	return nil, g.unsupported(n, "can't generate code for argument %s", n)
+}
+
+func (g *treeGen) variable(s *genScope, n *parse.VariableNode) (*genValue, error) {
+	name := n.Ident[0][1:]
+	if name == "" {
+		return s.root, nil
+	}
+	if v := s.vars[name]; v != nil {
+		return v, nil
+	}
+	// Not declared in this tree (e.g. $Vars), use the runtime
+	t := g.temp()
+	g.p("%s, err := s.Variable(%q)", t, name)
+	g.p("if err != nil {")
+	g.returnErr(n, "err")
+	g.p("}")
+	return &genValue{expr: t}, nil
+}
+
+func (g *treeGen) args(s *genScope, args []parse.Node, final *genValue) ([]*genValue, error) {
+	values := make([]*genValue, len(args))
+	// Evaluate from right to left, like the interpreter
+	for ii := len(args) - 1; ii >= 0; ii-- {
+		v, err := g.arg(s, args[ii])
+		if err != nil {
+			return nil, err
+		}
+		values[ii] = v
+	}
+	if final != nil {
+		values = append(values, final)
+	}
+	return values, nil
+}
+
+func (g *treeGen) fields(s *genScope, n parse.Node, v *genValue, names []string, args []parse.Node, final *genValue) (*genValue, error) {
+	if len(names) == 0 {
+		if len(args) > 0 || final != nil {
+			return nil, g.unsupported(n, "can't give arguments to a non-function")
+		}
+		return v, nil
+	}
+	for ii, name := range names {
+		var values []*genValue
+		if ii == len(names)-1 {
+			var err error
+			if values, err = g.args(s, args, final); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if v, err = g.field(n, v, name, values); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// guard returns the condition required for accessing v or
+// calling a method on it.
+func guard(v *genValue) string {
+	var conds []string
+	if v.valid != "" {
+		conds = append(conds, v.valid)
+	}
+	if k := v.typ.Kind(); (k == reflect.Ptr || k == reflect.Interface) && !v.nonNil {
+		conds = append(conds, v.expr+" != nil")
+	}
+	return strings.Join(conds, " && ")
+}
+
+func (g *treeGen) field(n parse.Node, v *genValue, name string, args []*genValue) (*genValue, error) {
+	if v.typ == nil || v.konst {
+		return g.dynamicField(n, v, name, args), nil
+	}
+	typ := v.typ
+	if typ.Kind() == reflect.Map {
+		if typ.Key().Kind() != reflect.String || len(args) > 0 {
+			return g.dynamicField(n, v, name, args), nil
+		}
+		return g.mapIndex(v, name), nil
+	}
+	var method reflect.Method
+	var found bool
+	if typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Interface {
+		method, found = typ.MethodByName(name)
+	} else {
+		method, found = reflect.PtrTo(typ).MethodByName(name)
+	}
+	if found {
+		return g.method(n, v, method, args), nil
+	}
+	if len(args) > 0 {
+		return g.dynamicField(n, v, name, args), nil
+	}
+	st := typ
+	if st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if st.Kind() != reflect.Struct {
+		return g.dynamicField(n, v, name, args), nil
+	}
+	sf, ok := st.FieldByName(name)
+	if !ok || sf.PkgPath != "" || !embeddedByValue(st, sf.Index) {
+		return g.dynamicField(n, v, name, args), nil
+	}
+	expr := v.expr + "." + name
+	return g.guarded(v, sf.Type, func(res string) {
+		g.p("%s = %s", res, expr)
+	}, expr), nil
+}
+
+// embeddedByValue returns true iff the field with the given
+// index is not promoted from an embedded pointer, which might
+// be nil.
+func embeddedByValue(t reflect.Type, index []int) bool {
+	for _, v := range index[:len(index)-1] {
+		t = t.Field(v).Type
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+	}
+	return true
+}
+
+// guarded generates the code for a value of type typ derived from v. If
+// v needs no guard and direct is non-empty, it's used as the expression
+// for the value. Otherwise, assign is called to generate the code which
+// assigns the value to the given variable.
+func (g *treeGen) guarded(v *genValue, typ reflect.Type, assign func(string), direct string) *genValue {
+	res := &genValue{typ: knownType(typ)}
+	cond := guard(v)
+	if cond == "" && direct != "" {
+		res.expr = direct
+		return res
+	}
+	res.expr = g.temp()
+	if cond == "" {
+		g.p("var %s %s", res.expr, g.varType(res))
+		assign(res.expr)
+		return res
+	}
+	g.p("var %s %s", res.expr, g.varType(res))
+	if res.typ != nil {
+		res.valid = res.expr + "ok"
+		g.p("%s := false", res.valid)
+	}
+	g.p("if %s {", cond)
+	assign(res.expr)
+	if res.valid != "" {
+		g.p("%s = true", res.valid)
+	}
+	g.p("}")
+	return res
+}
+
+// varType returns the type used for declaring a variable holding v,
+// making v dynamic if its type can't be used in the generated code.
+func (g *treeGen) varType(v *genValue) string {
+	if v.typ != nil {
+		if expr, ok := g.g.typeExpr(v.typ); ok {
+			return expr
+		}
+		v.typ = nil
+	}
+	return "interface{}"
+}
+
+func (g *treeGen) mapIndex(v *genValue, key string) *genValue {
+	res := &genValue{expr: g.temp(), typ: knownType(v.typ.Elem())}
+	if res.typ == nil {
+		// the zero value for interface{} is already nil
+		if cond := guard(v); cond != "" {
+			g.p("var %s interface{}", res.expr)
+			g.p("if %s {", cond)
+			g.p("%s = %s[%q]", res.expr, v.expr, key)
+			g.p("}")
+		} else {
+			g.p("%s := %s[%q]", res.expr, v.expr, key)
+		}
+		return res
+	}
+	res.valid = res.expr + "ok"
+	if cond := guard(v); cond != "" {
+		g.p("var %s %s", res.expr, g.varType(res))
+		if res.typ == nil {
+			res.valid = ""
+			g.p("if %s {", cond)
+			g.p("if val, ok := %s[%q]; ok {", v.expr, key)
+			g.p("%s = val", res.expr)
+			g.p("}")
+			g.p("}")
+			return res
+		}
+		g.p("%s := false", res.valid)
+		g.p("if %s {", cond)
+		g.p("%s, %s = %s[%q]", res.expr, res.valid, v.expr, key)
+		g.p("}")
+		return res
+	}
+	g.p("%s, %s := %s[%q]", res.expr, res.valid, v.expr, key)
+	return res
+}
+
+func (g *treeGen) method(n parse.Node, v *genValue, m reflect.Method, args []*genValue) *genValue {
+	mt := m.Type
+	first := 0
+	if v.typ.Kind() != reflect.Interface {
+		// skip the receiver
+		first = 1
+	}
+	numOut := mt.NumOut()
+	if mt.IsVariadic() || mt.NumIn()-first != len(args) || numOut == 0 || numOut > 2 || (numOut == 2 && mt.Out(1) != errType) {
+		return g.dynamicField(n, v, m.Name, args)
+	}
+	in := make([]string, len(args))
+	for ii, a := range args {
+		expr, ok := g.convert(a, mt.In(first+ii))
+		if !ok {
+			return g.dynamicField(n, v, m.Name, args)
+		}
+		in[ii] = expr
+	}
+	call := fmt.Sprintf("%s.%s(%s)", v.expr, m.Name, strings.Join(in, ", "))
+	if numOut == 1 && guard(v) == "" {
+		res := &genValue{expr: g.temp(), typ: knownType(mt.Out(0))}
+		g.p("%s := %s", res.expr, call)
+		return res
+	}
+	return g.guarded(v, mt.Out(0), func(res string) {
+		if numOut == 1 {
+			g.p("%s = %s", res, call)
+			return
+		}
+		val := g.temp()
+		g.p("%s, err := %s", val, call)
+		g.p("if err != nil {")
+		g.returnErr(n, fmt.Sprintf("%s.Errorf(\"%%q returned an error: %%s\", %q, err)", g.g.importName("fmt"), m.Name))
+		g.p("}")
+		g.p("%s = %s", res, val)
+	}, "")
+}
+
+// convert returns an expression for passing a as an argument of type
+// typ. If it can't be done statically, it returns false.
+func (g *treeGen) convert(a *genValue, typ reflect.Type) (string, bool) {
+	if a.typ == nil {
+		return "", false
+	}
+	if a.konst {
+		k := typ.Kind()
+		switch {
+		case a.typ.Kind() == reflect.String && k == reflect.String:
+		case a.typ.Kind() == reflect.Bool && k == reflect.Bool:
+		case a.typ == intType && (types.IsNumeric(typ) || k == reflect.Interface && typ.NumMethod() == 0):
+		case a.typ.Kind() == reflect.Float64 && types.IsFloat(typ):
+		default:
+			return "", false
+		}
+		return a.expr, true
+	}
+	if !a.typ.AssignableTo(typ) {
+		return "", false
+	}
+	if a.valid == "" {
+		return a.expr, true
+	}
+	expr, ok := g.g.typeExpr(typ)
+	if !ok {
+		return "", false
+	}
+	t := g.temp()
+	g.p("var %s %s", t, expr)
+	g.p("if %s {", a.valid)
+	g.p("%s = %s", t, a.expr)
+	g.p("}")
+	return t, true
+}
+
+func (g *treeGen) dynamicArgs(args []*genValue) string {
+	var buf bytes.Buffer
+	for _, v := range args {
+		buf.WriteString(", ")
+		buf.WriteString(g.dynamic(v))
+	}
+	return buf.String()
+}
+
+func (g *treeGen) dynamicField(n parse.Node, v *genValue, name string, args []*genValue) *genValue {
+	recv := g.dynamic(v)
+	in := g.dynamicArgs(args)
+	t := g.temp()
+	g.p("%s, err := s.Field(%s, %q%s)", t, recv, name, in)
+	g.p("if err != nil {")
+	g.returnErr(n, "err")
+	g.p("}")
+	return &genValue{expr: t}
+}
+
+func (g *treeGen) function(s *genScope, n *parse.IdentifierNode, argNodes []parse.Node, final *genValue) (*genValue, error) {
+	switch n.Ident {
+	case topAssetsFuncName:
+		g.p("w.Write(s.TopAssets())")
+		return nil, nil
+	case bottomAssetsFuncName:
+		g.p("w.Write(s.BottomAssets())")
+		return nil, nil
+	case flushFuncName:
+		g.p("if err := s.Flush(); err != nil {")
+		g.returnErr(n, "err")
+		g.p("}")
+		return nil, nil
+	}
+	if strings.HasPrefix(n.Ident, "html_") && len(argNodes) == 0 {
+		if final == nil {
+			// Escaping the assets or a flush, see the compiler
+			return nil, nil
+		}
+		return g.escape(n, final), nil
+	}
+	info := g.t.funcMap[n.Ident]
+	if info == nil {
+		return nil, g.unsupported(n, "undefined function %q", n.Ident)
+	}
+	args, err := g.args(s, argNodes, final)
+	if err != nil {
+		return nil, err
+	}
+	r := g.temp()
+	g.p("%s, err := s.Call(%q%s)", r, n.Ident, g.dynamicArgs(args))
+	g.p("if err != nil {")
+	g.returnErr(n, "err")
+	g.p("}")
+	ft := reflect.TypeOf(info.f)
+	if ft.Kind() != reflect.Func || ft.NumOut() == 0 {
+		return &genValue{expr: r}, nil
+	}
+	out := ft.Out(0)
+	if out.Kind() == reflect.Interface {
+		return &genValue{expr: r}, nil
+	}
+	expr, ok := g.g.typeExpr(out)
+	if !ok {
+		return &genValue{expr: r}, nil
+	}
+	t := g.temp()
+	g.p("%s, ok := %s.(%s)", t, r, expr)
+	g.p("if !ok {")
+	g.returnErr(n, fmt.Sprintf("%s.Errorf(\"function %%q returned %%T, not %s (regenerate the templates code)\", %q, %s)", g.g.importName("fmt"), out, n.Ident, r))
+	g.p("}")
+	return &genValue{expr: t, typ: out}, nil
+}
+
+func (g *treeGen) escape(n *parse.IdentifierNode, v *genValue) *genValue {
+	name := n.Ident
+	if name == templateCommentEscaper && !g.t.tmpl.DropComments {
+		// See the compiler
+		return v
+	}
+	if typ := v.typ; typ != nil && typ.NumMethod() == 0 && reflect.PtrTo(typ).NumMethod() == 0 {
+		switch {
+		case types.IsNumeric(typ):
+			return v
+		case (typ == htmlType || typ == templateHtmlType) && name == templateHtmlEscaper:
+			return v
+		case (typ == jsType || typ == templateJsType) && name == templateJsEscaper:
+			return v
+		}
+	}
+	arg := g.dynamic(v)
+	t := g.temp()
+	g.p("%s := %s(%s)", t, g.g.escaper(name), arg)
+	return &genValue{expr: t, typ: stringType}
+}
+
+func (g *treeGen) branch(s *genScope, nt parse.NodeType, b *parse.BranchNode) error {
+	hasDecl := len(b.Pipe.Decl) > 0
+	if hasDecl {
+		g.p("{")
+		s = s.child()
+	}
+	v, err := g.pipe(s, b.Pipe, true)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return g.unsupported(b.Pipe, "pipe does not produce a value")
+	}
+	g.p("if %s {", g.truth(v))
+	list := s.child()
+	if nt == parse.NodeWith {
+		list.dot = &genValue{expr: v.expr, typ: v.typ, nonNil: true}
+	}
+	if err := g.walk(list, b.List); err != nil {
+		return err
+	}
+	if b.ElseList != nil {
+		g.p("} else {")
+		if err := g.walk(s.child(), b.ElseList); err != nil {
+			return err
+		}
+	}
+	g.p("}")
+	if hasDecl {
+		g.p("}")
+	}
+	return nil
+}
+
+func (g *treeGen) rangeBranch(s *genScope, x *parse.RangeNode) error {
+	v, err := g.pipe(s, x.Pipe, false)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return g.unsupported(x.Pipe, "pipe does not produce a value")
+	}
+	if len(x.Pipe.Decl) > 2 {
+		return g.unsupported(x.Pipe, "too many declarations")
+	}
+	list := s.child()
+	key := &genValue{expr: g.temp()}
+	elem := &genValue{expr: g.temp()}
+	body := func() error {
+		list.dot = elem
+		switch len(x.Pipe.Decl) {
+		case 1:
+			list.vars[x.Pipe.Decl[0].Ident[0][1:]] = elem
+		case 2:
+			list.vars[x.Pipe.Decl[0].Ident[0][1:]] = key
+			list.vars[x.Pipe.Decl[1].Ident[0][1:]] = elem
+		}
+		for _, d := range x.Pipe.Decl {
+			list.declared = append(list.declared, d.Ident[0][1:])
+		}
+		g.p("_, _ = %s, %s", key.expr, elem.expr)
+		return g.walk(list, x.List)
+	}
+	elseList := func() error {
+		if x.ElseList != nil {
+			g.p("} else {")
+			return g.walk(s.child(), x.ElseList)
+		}
+		return nil
+	}
+	var cond string
+	if v.valid != "" {
+		cond = v.valid + " && "
+	}
+	if typ := v.typ; typ != nil {
+		switch typ.Kind() {
+		case reflect.Array, reflect.Slice:
+			key.typ = intType
+			elem.typ = typ.Elem()
+			g.p("if %slen(%s) > 0 {", cond, v.expr)
+			g.p("for %s, %s := range %s {", key.expr, elem.expr, v.expr)
+			if err := body(); err != nil {
+				return err
+			}
+			g.p("}")
+			if err := elseList(); err != nil {
+				return err
+			}
+			g.p("}")
+			return nil
+		case reflect.Map:
+			if kt := typ.Key(); kt == stringType || kt == intType {
+				key.typ = kt
+				elem.typ = typ.Elem()
+				sortFunc := "Ints"
+				if kt == stringType {
+					sortFunc = "Strings"
+				}
+				keys := g.temp()
+				g.p("if %slen(%s) > 0 {", cond, v.expr)
+				g.p("%s := make([]%s, 0, len(%s))", keys, kt, v.expr)
+				g.p("for k := range %s {", v.expr)
+				g.p("%s = append(%s, k)", keys, keys)
+				g.p("}")
+				g.p("%s.%s(%s)", g.g.importName("sort"), sortFunc, keys)
+				g.p("for _, %s := range %s {", key.expr, keys)
+				g.p("%s := %s[%s]", elem.expr, v.expr, key.expr)
+				if err := body(); err != nil {
+					return err
+				}
+				g.p("}")
+				if err := elseList(); err != nil {
+					return err
+				}
+				g.p("}")
+				return nil
+			}
+		}
+	}
+	it := g.temp()
+	g.p("%s, err := s.Iter(%s)", it, g.dynamic(v))
+	g.p("if err != nil {")
+	g.returnErr(x, "err")
+	g.p("}")
+	ranged := it + "ranged"
+	if x.ElseList != nil {
+		g.p("%s := false", ranged)
+	}
+	g.p("for {")
+	g.p("next, %s, %s := %s.Next()", key.expr, elem.expr, it)
+	g.p("if !next {")
+	g.p("break")
+	g.p("}")
+	if x.ElseList != nil {
+		g.p("%s = true", ranged)
+	}
+	if err := body(); err != nil {
+		return err
+	}
+	g.p("}")
+	if x.ElseList != nil {
+		g.p("if !%s {", ranged)
+		if err := g.walk(s.child(), x.ElseList); err != nil {
+			return err
+		}
+		g.p("}")
+	}
+	return nil
+}
+
+func (g *treeGen) template(s *genScope, x *parse.TemplateNode) error {
+	dot := "nil"
+	call := &templateCall{name: x.Name}
+	if x.Pipe != nil {
+		v, err := g.pipe(s, x.Pipe, false)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			return g.unsupported(x.Pipe, "pipe does not produce a value")
+		}
+		dot = g.dynamic(v)
+		call.dot = v.typ
+	}
+	g.calls = append(g.calls, call)
+	ns := namespace(x.Name)
+	if pns := namespace(g.name); pns != "" {
+		ns = ns[len(pns):]
+	}
+	// Variables declared in this tree are visible to the
+	// executed template in the interpreter, make them
+	// available via the runtime.
+	for _, v := range s.declared {
+		g.p("s.Set(%q, %s)", v, g.dynamic(s.vars[v]))
+	}
+	g.p("if err := s.Template(%q, %q, %s); err != nil {", x.Name, ns, dot)
+	g.p("return err")
+	g.p("}")
+	for ii := len(s.declared) - 1; ii >= 0; ii-- {
+		g.p("s.Unset(%q)", s.declared[ii])
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package template
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type GenAuthor struct {
+	Name string
+}
+
+type GenItem struct {
+	Title  string
+	Author *GenAuthor
+	Tags   []string
+	Any    interface{}
+}
+
+func (i *GenItem) Upper() string {
+	return strings.ToUpper(i.Title)
+}
+
+func TestGenerate(t *testing.T) {
+	const text = "{{ .Title }} {{ .Upper }}{{ with .Author }}{{ .Name }}{{ end }}{{ range .Tags }}{{ . }}{{ end }}{{ .Any.Foo }}{{ template \"author\" .Author }}{{ define \"author\" }}{{ .Name }}{{ end }}"
+	tmpl := parseNamedText(t, "generate.html", text, nil, "text/html")
+	g := NewGenerator("views", "example.com/views")
+	if err := g.Add(tmpl, reflect.TypeOf(&GenItem{}), nil); err != nil {
+		t.Fatal(err)
+	}
+	src, err := g.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(src)
+	expected := []string{
+		"// +build !" + NoCompiledTag,
+		"package views",
+		"\"gnd.la/template\"",
+		"d0 := dot.(*template.GenItem)",
+		"esc1(d0.Title)",
+		":= d0.Upper()",
+		"if d0.Author != nil {",
+		"esc1(d0.Author.Name)",
+		"range d0.Tags",
+		"s.Field(d0.Any, \"Foo\")",
+		"s.Template(\"author\", \"\", d0.Author)",
+		"d0 := dot.(*template.GenAuthor)",
+		"reflect.TypeOf((**template.GenAuthor)(nil)).Elem()",
+	}
+	for _, v := range expected {
+		if !strings.Contains(code, v) {
+			t.Errorf("generated code does not contain %q:\n%s", v, code)
+		}
+	}
+}
+
+func TestGenerateCheck(t *testing.T) {
+	tmpl := parseNamedText(t, "generate.html", "{{ .Titel }}", nil, "text/html")
+	g := NewGenerator("views", "example.com/views")
+	if err := g.Add(tmpl, reflect.TypeOf(&GenItem{}), nil); err == nil {
+		t.Error("expecting an error when generating code for an invalid template")
+	}
+}
+
+func TestCompiled(t *testing.T) {
+	const text = "{{ range .Tags }}{{ . }},{{ end }}{{ .Title }}"
+	compiledTmpl := parseNamedText(t, "compiled.html", text, nil, "text/plain")
+	tr := compiledTmpl.trees[compiledTmpl.root]
+	// Mimic the generated code, but use the runtime for everything
+	RegisterCompiled(compiledTmpl.treeHash(compiledTmpl.root, tr), reflect.TypeOf(&GenItem{}), func(s *State, w *bytes.Buffer, dot interface{}) error {
+		tags, err := s.Field(dot, "Tags")
+		if err != nil {
+			return err
+		}
+		it, err := s.Iter(tags)
+		if err != nil {
+			return err
+		}
+		for {
+			next, _, v := it.Next()
+			if !next {
+				break
+			}
+			joined, err := s.Call("join", []string{v.(string), v.(string)}, "")
+			if err != nil {
+				return err
+			}
+			if err := s.Print(joined); err != nil {
+				return err
+			}
+			w.WriteString(",")
+		}
+		title, err := s.Field(dot, "Title")
+		if err != nil {
+			return err
+		}
+		return s.Print(title)
+	})
+	tmpl := parseNamedText(t, "compiled.html", text, nil, "text/plain")
+	cases := []struct {
+		data     interface{}
+		expected string
+	}{
+		{&GenItem{Title: "foo", Tags: []string{"a", "b"}}, "aa,bb,foo"},
+		// Not the registered type, uses the interpreter
+		{map[string]interface{}{"Title": "foo", "Tags": []string{"a", "b"}}, "a,b,foo"},
+		// nil pointers use the interpreter
+		{(*GenItem)(nil), ""},
+	}
+	for _, v := range cases {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, v.data); err != nil {
+			t.Error(err)
+			continue
+		}
+		if buf.String() != v.expected {
+			t.Errorf("expecting %q executing with %T, got %q", v.expected, v.data, buf.String())
+		}
+	}
+}
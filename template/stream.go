@@ -0,0 +1,54 @@
+package template
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// StreamErrorMarker is written to the output of a streaming HTML
+// template when an error happens after part of the output has been
+// already sent. In debug mode, the error message is written instead.
+const StreamErrorMarker = "<!-- gondola: template error -->"
+
+// StreamError is returned by Template.ExecuteContext when an error
+// happens while streaming after some output has been already written.
+// Since the headers have been already sent, callers should not try to
+// send an error page. The error marker has been already written.
+type StreamError struct {
+	// Err is the error returned by the template.
+	Err error
+}
+
+func (e *StreamError) Error() string {
+	return e.Err.Error()
+}
+
+// streamer writes the output rendered so far every time
+// the template executes an opFLUSH.
+type streamer struct {
+	t       *Template
+	w       io.Writer
+	buf     *bytes.Buffer
+	flushed bool
+}
+
+func (s *streamer) flush() error {
+	if !s.flushed {
+		if rw, ok := s.w.(http.ResponseWriter); ok {
+			rw.Header().Set("Content-Type", s.t.contentType)
+		}
+		s.flushed = true
+	}
+	if s.buf.Len() > 0 {
+		_, err := s.w.Write(s.buf.Bytes())
+		s.buf.Reset()
+		if err != nil {
+			return err
+		}
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
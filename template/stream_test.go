@@ -0,0 +1,127 @@
+package template
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type chunkRecorder struct {
+	*httptest.ResponseRecorder
+	chunks []string
+}
+
+func (r *chunkRecorder) Flush() {
+	r.chunks = append(r.chunks, r.Body.String())
+	r.ResponseRecorder.Flush()
+}
+
+const streamTemplate = "<html><head><title>{{ .Title }}</title></head><body>{{ .A }}{{ flush }}{{ call .B }}</body></html>"
+
+func streamTemplateData(err error) map[string]interface{} {
+	return map[string]interface{}{
+		"Title": "stream",
+		"A":     "a",
+		"B":     func() (string, error) { return "b", err },
+	}
+}
+
+func TestStreaming(t *testing.T) {
+	tmpl := parseNamedText(t, "stream.html", streamTemplate, nil, "text/html")
+	tmpl.Streaming = true
+	rec := &chunkRecorder{ResponseRecorder: httptest.NewRecorder()}
+	if err := tmpl.Execute(rec, streamTemplateData(nil)); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"<html><head><title>stream</title>",
+		"<html><head><title>stream</title></head><body>a",
+		"<html><head><title>stream</title></head><body>ab</body></html>",
+	}
+	if strings.Join(rec.chunks, "|") != strings.Join(expected, "|") {
+		t.Errorf("expecting chunks %q, got %q", expected, rec.chunks)
+	}
+	if rec.Header().Get("Content-Length") != "" {
+		t.Error("Content-Length must not be set when streaming")
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/html" {
+		t.Errorf("expecting Content-Type text/html, got %q", ct)
+	}
+}
+
+func TestStreamingDisabled(t *testing.T) {
+	tmpl := parseNamedText(t, "stream.html", streamTemplate, nil, "text/html")
+	rec := &chunkRecorder{ResponseRecorder: httptest.NewRecorder()}
+	if err := tmpl.Execute(rec, streamTemplateData(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.chunks) != 0 {
+		t.Errorf("expecting no flushes, got %q", rec.chunks)
+	}
+	if rec.Header().Get("Content-Length") == "" {
+		t.Error("Content-Length should be set when not streaming")
+	}
+}
+
+func TestStreamingError(t *testing.T) {
+	boom := errors.New("boom")
+	for _, debug := range []bool{false, true} {
+		tmpl := parseNamedText(t, "stream.html", streamTemplate, nil, "text/html")
+		tmpl.Streaming = true
+		tmpl.Debug = debug
+		rec := &chunkRecorder{ResponseRecorder: httptest.NewRecorder()}
+		err := tmpl.Execute(rec, streamTemplateData(boom))
+		serr, ok := err.(*StreamError)
+		if !ok {
+			t.Fatalf("expecting *StreamError, got %T (%v)", err, err)
+		}
+		if !strings.Contains(serr.Error(), "boom") {
+			t.Errorf("unexpected error %q", serr)
+		}
+		body := rec.Body.String()
+		if !strings.HasPrefix(body, "<html><head><title>stream</title></head><body>a") {
+			t.Errorf("flushed output was lost, got %q", body)
+		}
+		if strings.Contains(body, "</body>") {
+			t.Errorf("output after the error should be discarded, got %q", body)
+		}
+		var marker string
+		if debug {
+			marker = `<pre class="gondola-template-error">`
+		} else {
+			marker = StreamErrorMarker
+		}
+		if !strings.Contains(body, marker) {
+			t.Errorf("expecting error marker %q, got %q", marker, body)
+		}
+	}
+	// Errors before the first flush are returned as usual
+	tmpl := parseNamedText(t, "stream.html", "{{ call .B }}{{ flush }}", nil, "text/html")
+	tmpl.Streaming = true
+	rec := httptest.NewRecorder()
+	if err := tmpl.Execute(rec, streamTemplateData(boom)); err == nil {
+		t.Error("expecting an error")
+	} else if _, ok := err.(*StreamError); ok {
+		t.Error("error before flushing must not be a *StreamError")
+	}
+	if rec.Body.Len() > 0 {
+		t.Errorf("expecting no output, got %q", rec.Body.String())
+	}
+}
+
+func TestStreamingMinify(t *testing.T) {
+	const text = "<html><body><pre>a {{ flush }}  b</pre>  <p>c{{ flush }} d</p></body></html>"
+	tmpl := parseNamedText(t, "stream.html", text, nil, "text/html")
+	tmpl.Streaming = true
+	tmpl.Minify = true
+	rec := &chunkRecorder{ResponseRecorder: httptest.NewRecorder()}
+	if err := tmpl.Execute(rec, nil); err != nil {
+		t.Fatal(err)
+	}
+	// Minifying each chunk on its own would remove the whitespace
+	// inside the <pre> at the start of the second one and join c and d.
+	if body := rec.Body.String(); body != "<html><body><pre>a   b</pre>  <p>c d</p></body></html>" {
+		t.Errorf("streamed output was modified, got %q", body)
+	}
+}
@@ -138,6 +138,7 @@ const (
 	topAssetsFuncName     = "_gondola_topAssets"
 	AssetFuncName         = "asset"
 	bottomAssetsFuncName  = "_gondola_bottomAssets"
+	flushFuncName         = "flush"
 	topBoilerplate        = "{{ _gondola_topAssets }}"
 	bottomBoilerplate     = "{{ _gondola_bottomAssets }}"
 	nsSep                 = "."
@@ -166,6 +167,8 @@ type Hook struct {
 type Template struct {
 	AssetsManager *assets.Manager
 	Minify        bool
+	Streaming     bool
+	FragmentCache FragmentCache
 	namespace     []string
 	tmpl          *itemplate.Template
 	prog          *program
@@ -780,7 +783,9 @@ func (t *Template) load(name string, included bool, from string) error {
 		return err
 	}
 	if idx := strings.Index(s, "</head>"); idx >= 0 {
-		s = s[:idx] + fmt.Sprintf("{{ template %q . }}", topBoilerplateName) + s[idx:]
+		// When streaming, flush the output after the top assets, so
+		// the browser can start fetching them as soon as possible.
+		s = s[:idx] + fmt.Sprintf("{{ template %q . }}{{ %s }}", topBoilerplateName, flushFuncName) + s[idx:]
 	}
 	if idx := strings.Index(s, "</body>"); idx >= 0 {
 		s = s[:idx] + fmt.Sprintf("{{ template %q . }}", bottomBoilerplateName) + s[idx:]
@@ -790,6 +795,9 @@ func (t *Template) load(name string, included bool, from string) error {
 	if err != nil {
 		return err
 	}
+	// Replace {{ cache }} with {{ if }}, the end of the
+	// block is added once the template is parsed.
+	s = replaceCacheBlocks(name, s)
 	// The $Vars definition must be present at parse
 	// time, because otherwise the parser will throw an
 	// error when it finds a variable which wasn't
@@ -846,6 +854,9 @@ func (t *Template) load(name string, included bool, from string) error {
 	if err := t.replaceExtendTag(name, treeMap, from); err != nil {
 		return err
 	}
+	if err := addCacheEnds(treeMap); err != nil {
+		return err
+	}
 	var renames map[string]string
 	for k, v := range treeMap {
 		v.Root.Nodes = t.removeVarNopNodes(v, v.Root.Nodes)
@@ -1085,6 +1096,23 @@ func (t *Template) Execute(w io.Writer, data interface{}) error {
 	return t.ExecuteContext(w, data, nil, nil)
 }
 
+// ExecuteContext executes the template with the given data, context and
+// variables, writing the result to w. If w is an http.ResponseWriter, its
+// Content-Type header is set to the template content type.
+//
+// When Streaming is false, the output is buffered and written only if the
+// template executes successfully, setting also the Content-Length header.
+//
+// When Streaming is true, the output is written after the top assets (right
+// before </head>) and at every {{ flush }} found in the template, calling
+// w's Flush method if it implements http.Flusher. This lets the browser
+// start fetching the assets while the rest of the page is being rendered.
+// Content-Length is not set when streaming. Errors which happen before any
+// output is flushed are handled as usual. Errors after that point discard
+// the pending output, write an error marker (see StreamErrorMarker) and
+// return a *StreamError. Streamed output is never minified, even if
+// Minify is true, because a chunk might end inside an element whose
+// whitespace must be preserved (e.g. <pre>) or between two words.
 func (t *Template) ExecuteContext(w io.Writer, data interface{}, context interface{}, vars VarMap) error {
 	if profile.On && profile.Profiling() {
 		ev := profile.Start("template").Note("exec", t.qname(t.name))
@@ -1095,23 +1123,29 @@ func (t *Template) ExecuteContext(w io.Writer, data interface{}, context interfa
 		ev.AutoEnd()
 	}
 	buf := getBuffer()
-	err := t.prog.execute(buf, t.root, data, context, vars)
+	if t.Streaming {
+		st := &streamer{t: t, w: w, buf: buf}
+		err := t.prog.execute(buf, t.root, data, context, vars, st.flush)
+		if err == nil {
+			err = st.flush()
+		}
+		if err != nil && st.flushed {
+			// Headers have been already sent, so the error can't
+			// be reported to the client in any other way.
+			buf.Reset()
+			t.writeStreamError(buf, err)
+			w.Write(buf.Bytes())
+			err = &StreamError{Err: err}
+		}
+		putBuffer(buf)
+		return err
+	}
+	err := t.prog.execute(buf, t.root, data, context, vars, nil)
 	if err != nil {
 		return err
 	}
-	if t.Minify {
-		// Instead of using a new Buffer, make a copy of the []byte and Reset
-		// buf. This minimizes the number of allocations while momentarily
-		// using a bit more of memory than we need (exactly one byte per space
-		// removed in the output).
-		b := buf.Bytes()
-		bc := make([]byte, len(b))
-		copy(bc, b)
-		r := bytes.NewReader(bc)
-		buf.Reset()
-		if err := html.Minify(buf, r); err != nil {
-			return err
-		}
+	if err := t.minify(buf); err != nil {
+		return err
 	}
 	if rw, ok := w.(http.ResponseWriter); ok {
 		header := rw.Header()
@@ -1123,6 +1157,41 @@ func (t *Template) ExecuteContext(w io.Writer, data interface{}, context interfa
 	return err
 }
 
+func (t *Template) minify(buf *bytes.Buffer) error {
+	if !t.Minify {
+		return nil
+	}
+	// Instead of using a new Buffer, make a copy of the []byte and Reset
+	// buf. This minimizes the number of allocations while momentarily
+	// using a bit more of memory than we need (exactly one byte per space
+	// removed in the output).
+	b := buf.Bytes()
+	bc := make([]byte, len(b))
+	copy(bc, b)
+	r := bytes.NewReader(bc)
+	buf.Reset()
+	return html.Minify(buf, r)
+}
+
+// writeStreamError writes the marker for an error which happened
+// after some output was already streamed. In debug mode, the marker
+// includes the error message.
+func (t *Template) writeStreamError(buf *bytes.Buffer, err error) {
+	isHTML := strings.Contains(t.contentType, "html")
+	switch {
+	case isHTML && t.Debug:
+		buf.WriteString(`<pre class="gondola-template-error">`)
+		buf.WriteString(template.HTMLEscapeString(err.Error()))
+		buf.WriteString("</pre>")
+	case isHTML:
+		buf.WriteString(StreamErrorMarker)
+	case t.Debug:
+		buf.WriteString("\n")
+		buf.WriteString(err.Error())
+		buf.WriteString("\n")
+	}
+}
+
 // AddFuncs registers new functions which will be available to
 // the templates. Please, note that you must register the functions
 // before compiling a template that uses them, otherwise the template
@@ -1266,6 +1335,21 @@ func replaceBlocks(name string, s string) (string, error) {
 	return s, nil
 }
 
+// formatTreeErr prefixes err with the location of the given node.
+func (t *Template) formatTreeErr(tr *parse.Tree, node parse.Node, err error) error {
+	loc, _ := tr.ErrorContext(node)
+	if loc != "" {
+		// Might to adjust the column due to the prepend varNop nodes
+		file, line, col, ok := splitErrorContext(loc)
+		if ok {
+			col -= t.offsets[tr][line]
+			loc = fmt.Sprintf("%s:%d:%d", file, line, col)
+		}
+		err = fmt.Errorf("%s: %s", loc, err.Error())
+	}
+	return err
+}
+
 // splitErrorContext returns the error context as (file, line, column, ok)
 func splitErrorContext(loc string) (string, int, int, bool) {
 	p := strings.SplitN(loc, ":", 2)
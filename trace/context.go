@@ -0,0 +1,135 @@
+package trace
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+const (
+	// RequestIDHeader is the header used for propagating
+	// the request id.
+	RequestIDHeader = "X-Request-Id"
+	// TraceparentHeader is the header used for propagating
+	// the SpanContext, as defined by the W3C Trace Context
+	// specification.
+	TraceparentHeader = "traceparent"
+
+	traceparentVersion = "00"
+	traceIDLength      = 32
+	spanIDLength       = 16
+	maxRequestIDLength = 200
+)
+
+var (
+	errInvalidTraceparent = errors.New("invalid traceparent")
+	zeroTraceID           = strings.Repeat("0", traceIDLength)
+	zeroSpanID            = strings.Repeat("0", spanIDLength)
+)
+
+// SpanContext contains the information propagated between
+// processes to identify a Span.
+type SpanContext struct {
+	// TraceID is the identifier of the trace, as 32 hex characters.
+	TraceID string
+	// SpanID is the identifier of the Span, as 16 hex characters.
+	SpanID string
+	// RequestID is the identifier of the request which started
+	// the trace. It's not part of the traceparent header, it's
+	// propagated using RequestIDHeader.
+	RequestID string
+}
+
+// IsValid returns true iff the SpanContext has valid trace
+// and span ids.
+func (sc SpanContext) IsValid() bool {
+	return isID(sc.TraceID, traceIDLength) && sc.TraceID != zeroTraceID &&
+		isID(sc.SpanID, spanIDLength) && sc.SpanID != zeroSpanID
+}
+
+// Traceparent returns the SpanContext formatted as the value
+// for the traceparent header, or an empty string if the
+// SpanContext is not valid.
+func (sc SpanContext) Traceparent() string {
+	if !sc.IsValid() {
+		return ""
+	}
+	return traceparentVersion + "-" + sc.TraceID + "-" + sc.SpanID + "-01"
+}
+
+// ParseTraceparent parses the value of a traceparent header. Note
+// that the returned SpanContext has no RequestID.
+func ParseTraceparent(s string) (SpanContext, error) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) < 4 || !isID(parts[0], 2) || parts[0] == "ff" || !isID(parts[3], 2) {
+		return SpanContext{}, errInvalidTraceparent
+	}
+	if parts[0] == traceparentVersion && len(parts) != 4 {
+		return SpanContext{}, errInvalidTraceparent
+	}
+	sc := SpanContext{TraceID: parts[1], SpanID: parts[2]}
+	if !sc.IsValid() {
+		return SpanContext{}, errInvalidTraceparent
+	}
+	return sc, nil
+}
+
+// Extract returns the SpanContext propagated in the given headers.
+// If there's no valid traceparent, the returned SpanContext will be
+// invalid, but it might still contain a RequestID.
+func Extract(h http.Header) SpanContext {
+	sc, _ := ParseTraceparent(h.Get(TraceparentHeader))
+	sc.RequestID = ValidRequestID(h.Get(RequestIDHeader))
+	return sc
+}
+
+// Inject adds the headers for propagating the given SpanContext
+// to h. The request id header is only added if h doesn't contain
+// it already.
+func Inject(h http.Header, sc SpanContext) {
+	if tp := sc.Traceparent(); tp != "" {
+		h.Set(TraceparentHeader, tp)
+	}
+	if sc.RequestID != "" && h.Get(RequestIDHeader) == "" {
+		h.Set(RequestIDHeader, sc.RequestID)
+	}
+}
+
+// ValidRequestID returns id if it's acceptable as a request id
+// received from a client, or an empty string otherwise. Request
+// ids must contain at most 200 printable ASCII characters, without
+// spaces.
+func ValidRequestID(id string) string {
+	if id == "" || len(id) > maxRequestIDLength {
+		return ""
+	}
+	for ii := 0; ii < len(id); ii++ {
+		if id[ii] <= ' ' || id[ii] > '~' {
+			return ""
+		}
+	}
+	return id
+}
+
+func isID(s string, length int) bool {
+	if len(s) != length {
+		return false
+	}
+	for ii := 0; ii < len(s); ii++ {
+		c := s[ii]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func newID(length int) string {
+	b := make([]byte, length/2)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,19 @@
+// Package trace implements request tracing.
+//
+// A trace is a tree of Spans, each one of them representing a timed
+// operation (e.g. serving a request, executing a query or fetching an URL).
+// Tracing is disabled until an Exporter is set with SetExporter, and
+// every finished Span is then handed to it.
+//
+// Spans are passed explicitly to the code which creates their children.
+// The gnd.la/app package starts a Span for each request, honoring the
+// incoming X-Request-Id and traceparent headers, and the *gnd.la/orm.Orm
+// and *gnd.la/cache.Cache returned by gnd.la/app.Context record their
+// operations as children of the Context's current Span (see their
+// WithSpan methods). gnd.la/net/httpclient propagates the request id
+// and the trace in the outgoing requests.
+//
+// Users should not usually need to use this package directly,
+// besides setting an Exporter. To add spans for your own
+// operations, see gnd.la/app.Context.StartSpan.
+package trace
@@ -0,0 +1,140 @@
+package trace
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"gnd.la/log"
+)
+
+// Exporter is the interface implemented by the types which receive
+// the finished Spans. Export might be called concurrently from
+// several goroutines.
+type Exporter interface {
+	Export(s *Span) error
+}
+
+type exporterValue struct {
+	exporter Exporter
+}
+
+var exporter atomic.Value
+
+// SetExporter sets the Exporter which receives the finished Spans.
+// Setting a non-nil Exporter enables tracing, while setting it to
+// nil disables it.
+func SetExporter(e Exporter) {
+	exporter.Store(exporterValue{e})
+}
+
+// CurrentExporter returns the Exporter set by SetExporter.
+func CurrentExporter() Exporter {
+	if v, ok := exporter.Load().(exporterValue); ok {
+		return v.exporter
+	}
+	return nil
+}
+
+// Enabled returns true iff tracing is enabled.
+func Enabled() bool {
+	return CurrentExporter() != nil
+}
+
+func export(s *Span) {
+	if e := CurrentExporter(); e != nil {
+		if err := e.Export(s); err != nil {
+			log.Errorf("error exporting span %s: %s", s, err)
+		}
+	}
+}
+
+// MemoryExporter is an Exporter which keeps the Spans in memory.
+// It's intended for tests.
+type MemoryExporter struct {
+	mutex sync.Mutex
+	spans []*Span
+}
+
+// Export implements the Exporter interface.
+func (e *MemoryExporter) Export(s *Span) error {
+	e.mutex.Lock()
+	e.spans = append(e.spans, s)
+	e.mutex.Unlock()
+	return nil
+}
+
+// Spans returns the exported Spans, in the order they ended.
+func (e *MemoryExporter) Spans() []*Span {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return append([]*Span(nil), e.spans...)
+}
+
+// Trace returns the exported Spans with the given trace id, in
+// the order they ended.
+func (e *MemoryExporter) Trace(traceID string) []*Span {
+	var spans []*Span
+	for _, v := range e.Spans() {
+		if v.TraceID == traceID {
+			spans = append(spans, v)
+		}
+	}
+	return spans
+}
+
+// Reset removes all the exported Spans.
+func (e *MemoryExporter) Reset() {
+	e.mutex.Lock()
+	e.spans = nil
+	e.mutex.Unlock()
+}
+
+// JSONExporter is an Exporter which writes each Span as a JSON
+// object in its own line.
+type JSONExporter struct {
+	mutex sync.Mutex
+	out   io.Writer
+}
+
+// Export implements the Exporter interface.
+func (e *JSONExporter) Export(s *Span) error {
+	s.mutex.Lock()
+	data, err := json.Marshal(s)
+	s.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	_, err = e.out.Write(data)
+	return err
+}
+
+// Close closes the underlying io.Writer, if it implements io.Closer.
+func (e *JSONExporter) Close() error {
+	if c, ok := e.out.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// NewJSONExporter returns a new *JSONExporter which writes the
+// Spans to w.
+func NewJSONExporter(w io.Writer) *JSONExporter {
+	return &JSONExporter{out: w}
+}
+
+// NewJSONFileExporter returns a new *JSONExporter which appends
+// the Spans to the file at the given path, creating it if it doesn't
+// exist.
+func NewJSONFileExporter(path string) (*JSONExporter, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewJSONExporter(f), nil
+}
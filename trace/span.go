@@ -0,0 +1,151 @@
+package trace
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Span represents a timed operation in a trace. All the methods
+// on Span can be safely called on a nil *Span, which is what the
+// functions in this package return when tracing is disabled, so
+// callers don't need to check whether tracing is enabled.
+//
+//  defer parent.Child("orm.query").Set("model", name).End()
+type Span struct {
+	TraceID    string                 `json:"trace_id"`
+	SpanID     string                 `json:"span_id"`
+	ParentID   string                 `json:"parent_id,omitempty"`
+	RequestID  string                 `json:"request_id,omitempty"`
+	Name       string                 `json:"name"`
+	StartTime  time.Time              `json:"start"`
+	EndTime    time.Time              `json:"end"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	mutex      sync.Mutex
+}
+
+// New returns a new Span with the given name. If parent is valid,
+// the Span will be a child of it. Otherwise, a new trace is started.
+// The RequestID in parent, if any, is always used. If tracing is
+// disabled, New returns nil.
+func New(name string, parent SpanContext) *Span {
+	if !Enabled() {
+		return nil
+	}
+	s := &Span{
+		SpanID:    newID(spanIDLength),
+		RequestID: parent.RequestID,
+		Name:      name,
+		StartTime: time.Now(),
+	}
+	if parent.IsValid() {
+		s.TraceID = parent.TraceID
+		s.ParentID = parent.SpanID
+	} else {
+		s.TraceID = newID(traceIDLength)
+	}
+	return s
+}
+
+// Context returns the SpanContext for propagating this Span.
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return SpanContext{TraceID: s.TraceID, SpanID: s.SpanID, RequestID: s.RequestID}
+}
+
+// Child returns a new Span with the given name, as a child of s.
+// If s is nil, it returns nil.
+func (s *Span) Child(name string) *Span {
+	if s == nil {
+		return nil
+	}
+	return New(name, s.Context())
+}
+
+// Set sets an attribute on the Span and returns the same Span,
+// to allow chaining.
+func (s *Span) Set(key string, value interface{}) *Span {
+	if s != nil {
+		s.mutex.Lock()
+		if s.Attributes == nil {
+			s.Attributes = make(map[string]interface{})
+		}
+		s.Attributes[key] = value
+		s.mutex.Unlock()
+	}
+	return s
+}
+
+// Attribute returns the value of the attribute with the given
+// key, or nil if there's no such attribute.
+func (s *Span) Attribute(key string) interface{} {
+	if s == nil {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.Attributes[key]
+}
+
+// SetError records the given error on the Span. Calling SetError
+// with a nil error does nothing. It returns the same Span, to
+// allow chaining.
+func (s *Span) SetError(err error) *Span {
+	if s != nil && err != nil {
+		s.mutex.Lock()
+		s.Error = err.Error()
+		s.mutex.Unlock()
+	}
+	return s
+}
+
+// Duration returns the time elapsed between the start and the
+// end of the Span. If the Span hasn't ended yet, it returns the
+// time elapsed since it started.
+func (s *Span) Duration() time.Duration {
+	if s == nil {
+		return 0
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.EndTime.IsZero() {
+		return time.Since(s.StartTime)
+	}
+	return s.EndTime.Sub(s.StartTime)
+}
+
+// End finishes the Span, sending it to the Exporter. Calling
+// End more than once has no effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	if !s.EndTime.IsZero() {
+		s.mutex.Unlock()
+		return
+	}
+	s.EndTime = time.Now()
+	s.mutex.Unlock()
+	export(s)
+}
+
+// Ended returns true iff End has been called on the Span.
+func (s *Span) Ended() bool {
+	if s == nil {
+		return false
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return !s.EndTime.IsZero()
+}
+
+func (s *Span) String() string {
+	if s == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s (%s/%s)", s.Name, s.TraceID, s.SpanID)
+}
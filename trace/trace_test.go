@@ -0,0 +1,155 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func withExporter(t *testing.T, e Exporter, f func()) {
+	prev := CurrentExporter()
+	SetExporter(e)
+	defer SetExporter(prev)
+	f()
+}
+
+func TestTraceparent(t *testing.T) {
+	const tp = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc, err := ParseTraceparent(tp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sc.TraceID != "4bf92f3577b34da6a3ce929d0e0e4736" || sc.SpanID != "00f067aa0ba902b7" {
+		t.Errorf("bad SpanContext %+v", sc)
+	}
+	if s := sc.Traceparent(); s != tp {
+		t.Errorf("expecting traceparent %q, got %q", tp, s)
+	}
+	for _, v := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		if _, err := ParseTraceparent(v); err == nil {
+			t.Errorf("expecting an error parsing traceparent %q", v)
+		}
+	}
+}
+
+func TestPropagation(t *testing.T) {
+	in := make(http.Header)
+	in.Set(TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	in.Set(RequestIDHeader, "abc")
+	sc := Extract(in)
+	if !sc.IsValid() || sc.RequestID != "abc" {
+		t.Fatalf("bad extracted SpanContext %+v", sc)
+	}
+	in.Set(RequestIDHeader, "with space")
+	if id := Extract(in).RequestID; id != "" {
+		t.Errorf("expecting invalid request id to be ignored, got %q", id)
+	}
+	withExporter(t, &MemoryExporter{}, func() {
+		s := New("request", sc)
+		out := make(http.Header)
+		Inject(out, s.Context())
+		if out.Get(RequestIDHeader) != "abc" {
+			t.Errorf("expecting request id abc, got %q", out.Get(RequestIDHeader))
+		}
+		got, err := ParseTraceparent(out.Get(TraceparentHeader))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.TraceID != sc.TraceID || got.SpanID != s.SpanID {
+			t.Errorf("expecting trace %s and span %s, got %+v", sc.TraceID, s.SpanID, got)
+		}
+	})
+}
+
+func TestSpans(t *testing.T) {
+	if s := New("disabled", SpanContext{}); s != nil {
+		t.Fatalf("expecting nil span with tracing disabled, got %v", s)
+	}
+	var none *Span
+	if s := none.Child("child").Set("k", "v").SetError(errors.New("failed")); s != nil {
+		t.Fatalf("expecting nil child of nil span, got %v", s)
+	}
+	none.End()
+	exp := &MemoryExporter{}
+	withExporter(t, exp, func() {
+		root := New("root", SpanContext{RequestID: "req"})
+		query := root.Child("query").Set("model", "User")
+		nested := query.Child("nested").SetError(errors.New("failed"))
+		nested.End()
+		if !nested.Ended() || query.Ended() {
+			t.Errorf("expecting only nested to be ended")
+		}
+		query.End()
+		query.End()
+		done := make(chan struct{})
+		go func() {
+			bg := root.Child("background")
+			bg.Child("bg query").End()
+			bg.End()
+			close(done)
+		}()
+		<-done
+		root.End()
+		spans := exp.Trace(root.TraceID)
+		var names []string
+		for _, v := range spans {
+			names = append(names, v.Name)
+			if v.RequestID != "req" {
+				t.Errorf("expecting request id req in %v, got %q", v, v.RequestID)
+			}
+		}
+		expected := []string{"nested", "query", "bg query", "background", "root"}
+		if len(names) != len(expected) {
+			t.Fatalf("expecting spans %v, got %v", expected, names)
+		}
+		for ii, v := range expected {
+			if names[ii] != v {
+				t.Errorf("expecting span %d to be %q, got %q", ii, v, names[ii])
+			}
+		}
+		parents := map[string]*Span{
+			"nested":     spans[1],
+			"query":      root,
+			"bg query":   spans[3],
+			"background": root,
+		}
+		for ii, v := range spans[:4] {
+			if p := parents[v.Name]; v.ParentID != p.SpanID {
+				t.Errorf("expecting %v parent to be %v, got %q", v, p, spans[ii].ParentID)
+			}
+		}
+		if spans[0].Error != "failed" {
+			t.Errorf("expecting error failed, got %q", spans[0].Error)
+		}
+		if m := spans[1].Attribute("model"); m != "User" {
+			t.Errorf("expecting model User, got %v", m)
+		}
+	})
+}
+
+func TestJSONExporter(t *testing.T) {
+	var buf bytes.Buffer
+	withExporter(t, NewJSONExporter(&buf), func() {
+		New("a", SpanContext{}).Set("n", 1).End()
+	})
+	var m map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m["name"] != "a" || m["attributes"].(map[string]interface{})["n"] != float64(1) {
+		t.Errorf("bad exported span %s", buf.String())
+	}
+	if _, ok := m["trace_id"]; !ok {
+		t.Errorf("no trace_id in exported span %s", buf.String())
+	}
+}